@@ -54,61 +54,79 @@ func init() {
 }
 
 var fileDescriptor_cf5ca5e0c737570d = []byte{
-	// 862 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0xcd, 0x6b, 0x13, 0x4f,
-	0x18, 0xc7, 0x33, 0x97, 0x1f, 0x3f, 0x86, 0xfe, 0x5e, 0x5c, 0xc5, 0x97, 0x1e, 0xd6, 0xa2, 0xf7,
-	0x84, 0x56, 0xad, 0xf6, 0xbd, 0x69, 0x12, 0x53, 0x30, 0x51, 0x9b, 0xf8, 0x02, 0x5e, 0x64, 0x93,
-	0x7d, 0xda, 0x2e, 0xdd, 0x64, 0xd7, 0x99, 0xd9, 0xd4, 0x9e, 0xf4, 0x22, 0x08, 0x82, 0x28, 0x08,
-	0x82, 0xe0, 0x49, 0x10, 0x05, 0xff, 0x06, 0xc1, 0x9b, 0xc7, 0x1e, 0x7b, 0xb4, 0xe9, 0xc5, 0x63,
-	0x4f, 0x9e, 0x65, 0xbb, 0x99, 0xe9, 0x6e, 0x32, 0x96, 0x99, 0x4d, 0x6f, 0x4d, 0x77, 0x3f, 0xdf,
-	0xe7, 0x93, 0x27, 0x3b, 0xf3, 0x4c, 0x82, 0xc7, 0x19, 0xb4, 0x7c, 0x8f, 0x58, 0x6e, 0x8e, 0x02,
-	0xe9, 0x00, 0xc9, 0x59, 0xbe, 0x93, 0xb3, 0xec, 0x96, 0xd3, 0x0e, 0x5f, 0x3b, 0x4d, 0xc8, 0x75,
-	0xc6, 0x73, 0xbd, 0x3f, 0xb3, 0x3e, 0xf1, 0x98, 0x67, 0x5c, 0xe4, 0x48, 0x36, 0x42, 0xb2, 0x96,
-	0xef, 0x64, 0xe3, 0x48, 0xb6, 0x33, 0x3e, 0x3a, 0xad, 0x92, 0x4b, 0xe0, 0x51, 0x00, 0x94, 0x3d,
-	0x24, 0x40, 0x7d, 0xaf, 0x4d, 0x7b, 0x05, 0x26, 0x7e, 0x8d, 0xe1, 0x91, 0x7c, 0x78, 0x6b, 0x3d,
-	0xba, 0xd5, 0x78, 0x87, 0xf0, 0xc9, 0x1a, 0x34, 0x02, 0xc7, 0xb5, 0xab, 0x01, 0xb3, 0x1a, 0x2e,
-	0xd4, 0x99, 0xc5, 0xc0, 0x58, 0xc8, 0x2a, 0xa8, 0x64, 0x25, 0x64, 0x2d, 0x2a, 0x3c, 0xba, 0x98,
-	0x3e, 0x20, 0x32, 0xbe, 0x90, 0x31, 0xde, 0x23, 0x7c, 0xaa, 0x08, 0xb4, 0x49, 0x9c, 0x06, 0x24,
-	0xec, 0xd4, 0xc2, 0x65, 0x28, 0xd7, 0xcb, 0x0f, 0x91, 0x20, 0xfc, 0xc2, 0xe6, 0xf1, 0x5b, 0x96,
-	0x1d, 0xca, 0x3c, 0xb2, 0xb5, 0xec, 0x51, 0xa6, 0xd8, 0x3c, 0x09, 0xa9, 0xd7, 0x3c, 0x69, 0x80,
-	0x90, 0xdb, 0xc2, 0x7f, 0x97, 0x81, 0xd5, 0xd7, 0x2d, 0x62, 0x1b, 0x97, 0x95, 0xf2, 0xf8, 0xed,
-	0xdc, 0xe2, 0x8a, 0x26, 0x25, 0x4a, 0x3f, 0xc1, 0xb8, 0xe0, 0x7a, 0x14, 0xa2, 0xe2, 0x93, 0x4a,
-	0x31, 0x87, 0x00, 0x2f, 0x7f, 0x55, 0x9b, 0x13, 0x02, 0xaf, 0x11, 0xfe, 0xbf, 0xe2, 0x50, 0xd6,
-	0xeb, 0xcc, 0x1d, 0x8b, 0x6e, 0x50, 0x63, 0x56, 0x29, 0xaf, 0x1f, 0xe3, 0x36, 0x73, 0x29, 0xe9,
-	0x78, 0x53, 0x6a, 0xd0, 0xf2, 0x3a, 0x10, 0x5e, 0x50, 0x6c, 0xca, 0x21, 0xa0, 0xd7, 0x94, 0x38,
-	0x27, 0x04, 0xbe, 0x21, 0x3c, 0x56, 0x06, 0x76, 0xdf, 0x23, 0x1b, 0xab, 0xae, 0xb7, 0x59, 0x7a,
-	0x0c, 0xcd, 0x80, 0x39, 0x5e, 0xbb, 0x66, 0x6d, 0xf6, 0x94, 0xef, 0x4d, 0x18, 0x15, 0xd5, 0xcf,
-	0xfc, 0xc8, 0x18, 0x6e, 0x5b, 0x3d, 0xa6, 0x34, 0xf1, 0x1e, 0x3e, 0x20, 0x7c, 0xba, 0x0c, 0xac,
-	0x06, 0xbe, 0xeb, 0x34, 0xad, 0xf0, 0xc6, 0x2a, 0x50, 0x6a, 0xad, 0x01, 0x35, 0x96, 0x54, 0x6b,
-	0x49, 0x60, 0xee, 0x5b, 0x18, 0x2a, 0x43, 0x58, 0x7e, 0x45, 0xf8, 0x7c, 0x19, 0xd8, 0x4d, 0xab,
-	0x05, 0xd4, 0xb7, 0x9a, 0x20, 0xd3, 0xbd, 0xa1, 0x5a, 0xea, 0xa8, 0x14, 0xee, 0x5d, 0x39, 0x9e,
-	0x30, 0xf1, 0x06, 0xbe, 0x20, 0x7c, 0xae, 0x0c, 0xac, 0x58, 0x59, 0x91, 0xa9, 0x97, 0x54, 0xab,
-	0xc9, 0x79, 0x2e, 0x7d, 0x7d, 0xd8, 0x18, 0xa1, 0xfb, 0x1c, 0xe1, 0x7f, 0x6a, 0x60, 0xf9, 0xbe,
-	0xbb, 0x55, 0xea, 0x40, 0x9b, 0x51, 0x63, 0x4a, 0x71, 0x99, 0xc4, 0x18, 0xae, 0x35, 0x9d, 0x06,
-	0x4d, 0x8c, 0x84, 0xbc, 0x6d, 0xd7, 0xc1, 0x22, 0xcd, 0xf5, 0x3c, 0x63, 0xc4, 0x69, 0x04, 0x0c,
-	0xa8, 0xe2, 0x48, 0x90, 0x90, 0x7a, 0x23, 0x41, 0x1a, 0x90, 0x58, 0x3d, 0xd1, 0xd6, 0x30, 0xe0,
-	0xb7, 0xa4, 0xb1, 0xaf, 0xfc, 0x49, 0xb1, 0x30, 0x54, 0x46, 0xa2, 0x85, 0xe1, 0x50, 0x49, 0xd7,
-	0x42, 0x09, 0xa9, 0xd7, 0x42, 0x69, 0x80, 0x90, 0x7b, 0x89, 0xf0, 0x7f, 0x7c, 0xee, 0x16, 0xdc,
-	0x80, 0x32, 0x20, 0xc6, 0x8c, 0xd6, 0xb4, 0xee, 0x51, 0x5c, 0x6a, 0x36, 0x1d, 0x2c, 0x84, 0x9e,
-	0x21, 0x3c, 0x12, 0x4e, 0x9d, 0xde, 0x15, 0x6a, 0x5c, 0x53, 0x1e, 0x54, 0x1c, 0xe1, 0x2a, 0x53,
-	0x29, 0x48, 0xe1, 0xf1, 0x16, 0x61, 0x23, 0x76, 0xa9, 0x0a, 0xad, 0x46, 0x68, 0x33, 0xaf, 0x9b,
-	0xd9, 0x03, 0xb9, 0xd3, 0x42, 0x6a, 0x5e, 0x98, 0x7d, 0x46, 0xf8, 0x6c, 0xde, 0xb6, 0x6f, 0x91,
-	0xbb, 0xbe, 0x7d, 0x70, 0x7e, 0x6b, 0x79, 0x4c, 0x7c, 0x76, 0x45, 0xd5, 0x65, 0x25, 0xc5, 0xb9,
-	0x65, 0x69, 0xc8, 0x94, 0xc4, 0xb3, 0x1f, 0x2d, 0x90, 0xa4, 0xe6, 0x82, 0xc6, 0xd2, 0x92, 0x1a,
-	0x2e, 0xa6, 0x0f, 0x10, 0x72, 0x2f, 0x10, 0xfe, 0x37, 0xda, 0x8e, 0xc5, 0x28, 0x98, 0xd6, 0xd8,
-	0xc3, 0xfb, 0xf7, 0xff, 0x99, 0x54, 0x6c, 0xe2, 0x8c, 0x77, 0x3b, 0x20, 0x6b, 0x10, 0xf7, 0x51,
-	0x5b, 0x4d, 0xfd, 0x98, 0xde, 0x19, 0x6f, 0x90, 0x4e, 0x38, 0x55, 0x21, 0x95, 0x53, 0x3f, 0xa6,
-	0xe7, 0x34, 0x48, 0x27, 0xbe, 0x44, 0xd5, 0x60, 0x95, 0x00, 0x5d, 0xe7, 0xa7, 0xac, 0xe8, 0x3c,
-	0xac, 0xfa, 0x48, 0x0c, 0xa2, 0x7a, 0x5f, 0xa2, 0xe4, 0x09, 0x7d, 0x43, 0x89, 0x42, 0xdb, 0x8e,
-	0x0d, 0xf9, 0xc8, 0x50, 0x75, 0x28, 0xc9, 0x60, 0xdd, 0xa1, 0x24, 0xcf, 0x10, 0x96, 0x6f, 0x10,
-	0x3e, 0x51, 0x06, 0x16, 0xfe, 0x7b, 0x25, 0x80, 0x00, 0x22, 0xc1, 0x39, 0xd5, 0x47, 0x38, 0xc9,
-	0x71, 0xb7, 0xf9, 0xb4, 0xb8, 0xd0, 0xfa, 0x88, 0xf0, 0x99, 0x22, 0xb8, 0xc0, 0x60, 0xe0, 0x04,
-	0x6d, 0x14, 0x14, 0x27, 0x8b, 0x94, 0xe6, 0x8a, 0xc5, 0xe1, 0x42, 0xb8, 0xe8, 0x92, 0xbb, 0xbd,
-	0x6b, 0x66, 0x76, 0x76, 0xcd, 0xcc, 0xfe, 0xae, 0x89, 0x9e, 0x76, 0x4d, 0xf4, 0xa9, 0x6b, 0xa2,
-	0xef, 0x5d, 0x13, 0x6d, 0x77, 0x4d, 0xf4, 0xa3, 0x6b, 0xa2, 0x9f, 0x5d, 0x33, 0xb3, 0xdf, 0x35,
-	0xd1, 0xab, 0x3d, 0x33, 0xb3, 0xbd, 0x67, 0x66, 0x76, 0xf6, 0xcc, 0xcc, 0x83, 0xc9, 0x35, 0xef,
-	0xb0, 0xbe, 0xe3, 0x1d, 0xf1, 0x83, 0xc7, 0x4c, 0xfc, 0x75, 0xe3, 0xaf, 0x83, 0x5f, 0x3b, 0x2e,
-	0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x73, 0xcc, 0x7e, 0x83, 0x11, 0x00, 0x00,
+	// 1138 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcf, 0x8b, 0x23, 0x45,
+	0x14, 0xc7, 0x53, 0x17, 0xd1, 0x62, 0xfd, 0xd5, 0x8a, 0x3f, 0x56, 0x68, 0x45, 0xef, 0x09, 0xb3,
+	0xea, 0xe8, 0xce, 0xec, 0xee, 0x6c, 0x26, 0x99, 0xcd, 0x88, 0x89, 0x33, 0x93, 0xf8, 0x03, 0xbc,
+	0x48, 0xa5, 0xfb, 0xcd, 0xa4, 0xd8, 0x4e, 0xba, 0xad, 0xaa, 0xce, 0x38, 0x20, 0xe8, 0x45, 0x10,
+	0x04, 0x51, 0x10, 0x04, 0xc1, 0x93, 0x20, 0x0a, 0x82, 0xe0, 0x1f, 0x20, 0x78, 0x10, 0x3c, 0xce,
+	0x71, 0x8f, 0x4e, 0xe6, 0xe2, 0x71, 0xff, 0x04, 0xe9, 0xe9, 0x54, 0x4d, 0x2a, 0xa9, 0x0e, 0x55,
+	0x9d, 0xbd, 0x4d, 0xa6, 0xea, 0xfb, 0x7d, 0x9f, 0xae, 0x54, 0xbd, 0xf7, 0xaa, 0x83, 0xd7, 0x04,
+	0x0c, 0x93, 0x98, 0x91, 0xa8, 0xc6, 0x81, 0x8d, 0x81, 0xd5, 0x48, 0x42, 0x6b, 0x24, 0x1c, 0xd2,
+	0x51, 0xf6, 0x99, 0x06, 0x50, 0x1b, 0xaf, 0xd5, 0xa6, 0x7f, 0x56, 0x13, 0x16, 0x8b, 0xd8, 0x7b,
+	0x45, 0x4a, 0xaa, 0xb9, 0xa4, 0x4a, 0x12, 0x5a, 0x9d, 0x95, 0x54, 0xc7, 0x6b, 0x57, 0x37, 0x6c,
+	0x7c, 0x19, 0x7c, 0x9c, 0x02, 0x17, 0x1f, 0x31, 0xe0, 0x49, 0x3c, 0xe2, 0xd3, 0x00, 0xd7, 0xfe,
+	0x5e, 0xc3, 0x57, 0xea, 0xd9, 0xd4, 0x5e, 0x3e, 0xd5, 0xfb, 0x01, 0xe1, 0xa7, 0xba, 0xd0, 0x4f,
+	0x69, 0x14, 0x76, 0x52, 0x41, 0xfa, 0x11, 0xf4, 0x04, 0x11, 0xe0, 0x6d, 0x55, 0x2d, 0x50, 0xaa,
+	0x06, 0x65, 0x37, 0x0f, 0x7c, 0xf5, 0x76, 0x79, 0x83, 0x9c, 0xf8, 0xe5, 0x8a, 0xf7, 0x23, 0xc2,
+	0x4f, 0x37, 0x81, 0x07, 0x8c, 0xf6, 0x41, 0xa3, 0xb3, 0x33, 0x37, 0x49, 0x25, 0x5e, 0x7d, 0x05,
+	0x07, 0xc5, 0x97, 0x2d, 0x9e, 0x9c, 0xb2, 0x4b, 0xb9, 0x88, 0xd9, 0xc9, 0x6e, 0xcc, 0x85, 0xe5,
+	0xe2, 0x19, 0x94, 0x6e, 0x8b, 0x67, 0x34, 0x50, 0x70, 0x27, 0xf8, 0xe1, 0x16, 0x88, 0xde, 0x80,
+	0xb0, 0xd0, 0x7b, 0xcd, 0xca, 0x4f, 0x4e, 0x97, 0x14, 0xaf, 0x3b, 0xaa, 0x54, 0xe8, 0xcf, 0x30,
+	0x6e, 0x44, 0x31, 0x87, 0x3c, 0xf8, 0xba, 0x95, 0xcd, 0xa5, 0x40, 0x86, 0x7f, 0xc3, 0x59, 0xa7,
+	0x00, 0x3e, 0xc5, 0x8f, 0x74, 0xe2, 0xf1, 0x34, 0xbe, 0xdd, 0x63, 0xa8, 0xf9, 0x32, 0xfc, 0xba,
+	0xab, 0x4c, 0x45, 0xff, 0x03, 0xe1, 0x17, 0xda, 0x94, 0xe7, 0xcb, 0xb2, 0x77, 0x3c, 0x02, 0xc6,
+	0x07, 0x34, 0xd9, 0x1b, 0x03, 0x63, 0x34, 0x04, 0xee, 0xb5, 0xac, 0x9c, 0x97, 0x38, 0x48, 0xc4,
+	0xdd, 0xd5, 0x8d, 0x14, 0xf4, 0xb7, 0x08, 0x3f, 0xd1, 0x02, 0xd1, 0x3c, 0x19, 0x91, 0x21, 0x0d,
+	0x1a, 0xf1, 0xe8, 0x90, 0x1e, 0x79, 0x37, 0x6c, 0x77, 0x80, 0x26, 0x93, 0x78, 0x37, 0x4b, 0xaa,
+	0x15, 0xd3, 0xaf, 0x08, 0x3f, 0xd7, 0x9b, 0x1b, 0x96, 0xec, 0x5e, 0xd3, 0xca, 0xbd, 0x48, 0x2e,
+	0x19, 0x77, 0x56, 0x74, 0xd1, 0xbe, 0xf4, 0x2e, 0x0c, 0xe3, 0x31, 0x98, 0x71, 0x5b, 0x96, 0xf9,
+	0xb0, 0xd0, 0xc1, 0xed, 0x4b, 0x5f, 0x6a, 0xa4, 0xa0, 0x7f, 0x47, 0xf8, 0x6a, 0xb6, 0x3d, 0x8c,
+	0xf3, 0xb8, 0x77, 0xc7, 0x7a, 0x7f, 0x99, 0x0d, 0x24, 0x72, 0x6b, 0x65, 0x1f, 0x45, 0xfc, 0x1d,
+	0xc2, 0x4f, 0x2e, 0x4c, 0xf4, 0x6e, 0x96, 0x0b, 0x20, 0xf9, 0x6e, 0x95, 0x95, 0x6b, 0xa7, 0x27,
+	0x1b, 0x9f, 0xa6, 0xe2, 0x77, 0x09, 0xbf, 0xcb, 0x2d, 0x4f, 0xcf, 0xbc, 0xcc, 0xed, 0xf4, 0x2c,
+	0xaa, 0x67, 0xb3, 0x70, 0xbe, 0x0b, 0xb2, 0x01, 0xcb, 0x2c, 0x7c, 0x29, 0x70, 0xcb, 0xc2, 0xb3,
+	0x3a, 0x05, 0xf0, 0x17, 0xc2, 0x2f, 0xb5, 0x40, 0x7c, 0x10, 0xb3, 0xbb, 0x87, 0x51, 0x7c, 0xbc,
+	0xf3, 0x09, 0x04, 0xa9, 0xa0, 0xf1, 0xa8, 0x4b, 0x8e, 0xa7, 0xc8, 0xef, 0x5f, 0xf3, 0xda, 0xb6,
+	0x49, 0x62, 0xa9, 0x8d, 0xa4, 0xed, 0x3c, 0x20, 0x37, 0xf5, 0x0c, 0x3f, 0x21, 0xfc, 0x4c, 0x0b,
+	0x44, 0x17, 0x92, 0x88, 0x06, 0x24, 0x9b, 0xd8, 0x01, 0xce, 0xc9, 0x11, 0x70, 0x6f, 0xdb, 0x36,
+	0x96, 0x41, 0x2c, 0x79, 0x1b, 0x2b, 0x79, 0x28, 0xca, 0x3f, 0x11, 0x7e, 0xb1, 0x05, 0xe2, 0x1d,
+	0x32, 0x04, 0x9e, 0x90, 0x00, 0x4c, 0xb8, 0x6f, 0xdb, 0x86, 0x5a, 0xe6, 0x22, 0xb9, 0xdb, 0x0f,
+	0xc6, 0x4c, 0x3d, 0xc0, 0x6f, 0x08, 0x3f, 0x9f, 0x15, 0x82, 0xf6, 0x81, 0x09, 0x7d, 0xc7, 0xba,
+	0x90, 0xb4, 0x0f, 0x96, 0x40, 0xdf, 0x59, 0xd5, 0x46, 0xe1, 0x7e, 0x89, 0xf0, 0xa3, 0x5d, 0x20,
+	0x49, 0x12, 0x9d, 0xec, 0x8c, 0x61, 0x24, 0xb8, 0x77, 0xdd, 0xf2, 0x98, 0xcc, 0x68, 0x24, 0xd6,
+	0x46, 0x19, 0xa9, 0x42, 0xf9, 0x19, 0xe1, 0x67, 0xdf, 0xca, 0xe4, 0x8b, 0x5b, 0xda, 0xb3, 0xdb,
+	0x5d, 0x05, 0x6a, 0x89, 0xd7, 0x5c, 0xcd, 0x44, 0x6b, 0x96, 0xeb, 0x61, 0xd8, 0x03, 0xc2, 0x82,
+	0x41, 0x5d, 0x08, 0x46, 0xfb, 0xa9, 0x00, 0x6e, 0xd9, 0x2c, 0x1b, 0x94, 0x6e, 0xcd, 0xb2, 0xd1,
+	0x40, 0x3b, 0xe6, 0x79, 0x0e, 0x5b, 0xe0, 0xdb, 0x76, 0x48, 0x80, 0x45, 0x88, 0x8d, 0x95, 0x3c,
+	0xb4, 0x25, 0xcc, 0xda, 0xed, 0x72, 0x4b, 0x68, 0x50, 0xba, 0x2d, 0xa1, 0xd1, 0x40, 0xc1, 0x7d,
+	0x8d, 0xf0, 0xe3, 0xf2, 0x46, 0xd2, 0x88, 0x52, 0x2e, 0x80, 0x79, 0x9b, 0x4e, 0xf7, 0x98, 0xa9,
+	0x4a, 0x42, 0xdd, 0x28, 0x27, 0x56, 0x40, 0x5f, 0x20, 0x7c, 0x25, 0x2b, 0x8f, 0xd3, 0x11, 0xee,
+	0xbd, 0x69, 0x5d, 0x51, 0xa5, 0x44, 0xa2, 0x5c, 0x2f, 0xa1, 0x54, 0x1c, 0xdf, 0x23, 0xec, 0xcd,
+	0x0c, 0x75, 0x60, 0xd8, 0xcf, 0x68, 0x6e, 0xb9, 0x7a, 0x4e, 0x85, 0x92, 0x69, 0xab, 0xb4, 0x5e,
+	0xeb, 0xaf, 0xeb, 0x61, 0xb8, 0xc7, 0xde, 0x4b, 0xc2, 0x8b, 0x9b, 0xed, 0x30, 0x16, 0xea, 0xbb,
+	0x6b, 0xda, 0x1e, 0x2b, 0xa3, 0xdc, 0xad, 0xbf, 0x2e, 0x76, 0xd1, 0xf6, 0x7e, 0x7e, 0x40, 0x74,
+	0xcc, 0x2d, 0x87, 0xa3, 0x65, 0x24, 0xbc, 0x5d, 0xde, 0x40, 0xc1, 0x7d, 0x85, 0xf0, 0x63, 0x79,
+	0xdd, 0x50, 0x35, 0x6b, 0xc3, 0xa1, 0xd8, 0xcc, 0x17, 0xaa, 0xcd, 0x52, 0x5a, 0xad, 0x19, 0xdd,
+	0x4f, 0xd9, 0x11, 0xcc, 0xf2, 0xd8, 0x9d, 0xa6, 0x79, 0x99, 0x5b, 0x33, 0xba, 0xa8, 0xd6, 0x98,
+	0x3a, 0x50, 0x8a, 0xa9, 0x03, 0xab, 0x30, 0x75, 0xa0, 0x90, 0x29, 0x7b, 0xbd, 0xd4, 0x85, 0x43,
+	0x06, 0x7c, 0x20, 0x0b, 0x57, 0xde, 0xb8, 0xdb, 0x6e, 0x89, 0x45, 0xa9, 0xdb, 0xeb, 0x25, 0xb3,
+	0xc3, 0x5c, 0x51, 0xe2, 0x30, 0x0a, 0x67, 0xba, 0x91, 0x9c, 0xd0, 0xb6, 0x28, 0x99, 0xc4, 0xae,
+	0x45, 0xc9, 0xec, 0xa1, 0xdd, 0xc8, 0x5a, 0x20, 0xb2, 0x7f, 0x1f, 0xa4, 0x90, 0x42, 0x0e, 0x68,
+	0x7d, 0xf7, 0xd7, 0x75, 0x6e, 0x37, 0x32, 0x83, 0x5c, 0xbb, 0x8f, 0xcb, 0xda, 0xa0, 0x26, 0xed,
+	0x13, 0x26, 0x68, 0xf6, 0x10, 0xb6, 0x2f, 0x61, 0x96, 0x38, 0xb8, 0xdd, 0xc7, 0x97, 0x1a, 0x69,
+	0xcd, 0x5c, 0x13, 0x22, 0x10, 0x50, 0xb6, 0x99, 0x2b, 0x50, 0xbb, 0x35, 0x73, 0x85, 0x26, 0x5a,
+	0x36, 0xee, 0x09, 0xc2, 0xc4, 0x36, 0x11, 0xc1, 0x60, 0x2f, 0x01, 0x76, 0xb1, 0x37, 0x2c, 0xb3,
+	0xb1, 0x41, 0xe9, 0x96, 0x8d, 0x8d, 0x06, 0xda, 0x2a, 0x36, 0xe2, 0x74, 0xb4, 0xd8, 0x8e, 0x72,
+	0xcb, 0x55, 0x2c, 0x50, 0xbb, 0xad, 0x62, 0xa1, 0x89, 0x96, 0x14, 0xe5, 0xc6, 0xe8, 0x05, 0x03,
+	0x08, 0xd3, 0x08, 0x3c, 0xb7, 0xb6, 0x47, 0xca, 0xdc, 0x92, 0xe2, 0xa2, 0x5a, 0x4b, 0x3a, 0x79,
+	0x25, 0x96, 0x83, 0xfb, 0x71, 0x44, 0x03, 0x6a, 0xdd, 0x09, 0x9b, 0xc5, 0x6e, 0x49, 0xa7, 0xc8,
+	0x43, 0x52, 0x6e, 0x47, 0xa7, 0x67, 0x7e, 0xe5, 0xde, 0x99, 0x5f, 0xb9, 0x7f, 0xe6, 0xa3, 0xcf,
+	0x27, 0x3e, 0xfa, 0x65, 0xe2, 0xa3, 0x7f, 0x26, 0x3e, 0x3a, 0x9d, 0xf8, 0xe8, 0xdf, 0x89, 0x8f,
+	0xfe, 0x9b, 0xf8, 0x95, 0xfb, 0x13, 0x1f, 0x7d, 0x73, 0xee, 0x57, 0x4e, 0xcf, 0xfd, 0xca, 0xbd,
+	0x73, 0xbf, 0xf2, 0xe1, 0xfa, 0x51, 0x7c, 0x19, 0x9e, 0xc6, 0x4b, 0x7e, 0x3f, 0xd9, 0x9c, 0xfd,
+	0xdc, 0x7f, 0xe8, 0xe2, 0xc7, 0x93, 0x57, 0xff, 0x1f, 0x00, 0x49, 0xef, 0x54, 0x29, 0xd2, 0x19,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -132,6 +150,24 @@ type AdminServiceClient interface {
 	DescribeHistoryHost(ctx context.Context, in *DescribeHistoryHostRequest, opts ...grpc.CallOption) (*DescribeHistoryHostResponse, error)
 	GetShard(ctx context.Context, in *GetShardRequest, opts ...grpc.CallOption) (*GetShardResponse, error)
 	CloseShard(ctx context.Context, in *CloseShardRequest, opts ...grpc.CallOption) (*CloseShardResponse, error)
+	// MoveShard overrides the membership ring assignment of a history shard, so that it's owned
+	// by the given history host until the override expires.
+	MoveShard(ctx context.Context, in *MoveShardRequest, opts ...grpc.CallOption) (*MoveShardResponse, error)
+	// ListShardOwnershipOverrides returns the history shard ownership overrides that haven't expired yet.
+	ListShardOwnershipOverrides(ctx context.Context, in *ListShardOwnershipOverridesRequest, opts ...grpc.CallOption) (*ListShardOwnershipOverridesResponse, error)
+	// GetDynamicConfig returns the values of a dynamic config key on the host serving the request,
+	// including the overrides set with SetDynamicConfigOverride.
+	GetDynamicConfig(ctx context.Context, in *GetDynamicConfigRequest, opts ...grpc.CallOption) (*GetDynamicConfigResponse, error)
+	// SetDynamicConfigOverride overrides the value of a dynamic config key for the given constraints on
+	// all hosts until the override expires. An existing override of the same key and constraints is replaced.
+	SetDynamicConfigOverride(ctx context.Context, in *SetDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*SetDynamicConfigOverrideResponse, error)
+	// RemoveDynamicConfigOverride removes the override of a dynamic config key for the given constraints.
+	RemoveDynamicConfigOverride(ctx context.Context, in *RemoveDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*RemoveDynamicConfigOverrideResponse, error)
+	// ListDynamicConfigOverrides returns the dynamic config overrides that haven't expired yet.
+	ListDynamicConfigOverrides(ctx context.Context, in *ListDynamicConfigOverridesRequest, opts ...grpc.CallOption) (*ListDynamicConfigOverridesResponse, error)
+	// ListDynamicConfig returns the dynamic config keys the server reads and their values on the host serving
+	// the request, including the overrides set with SetDynamicConfigOverride.
+	ListDynamicConfig(ctx context.Context, in *ListDynamicConfigRequest, opts ...grpc.CallOption) (*ListDynamicConfigResponse, error)
 	ListHistoryTasks(ctx context.Context, in *ListHistoryTasksRequest, opts ...grpc.CallOption) (*ListHistoryTasksResponse, error)
 	RemoveTask(ctx context.Context, in *RemoveTaskRequest, opts ...grpc.CallOption) (*RemoveTaskResponse, error)
 	// Returns the raw history of specified workflow execution.  It fails with 'NotFound' if specified workflow
@@ -147,6 +183,9 @@ type AdminServiceClient interface {
 	GetDLQReplicationMessages(ctx context.Context, in *GetDLQReplicationMessagesRequest, opts ...grpc.CallOption) (*GetDLQReplicationMessagesResponse, error)
 	// ReapplyEvents applies stale events to the current workflow and current run.
 	ReapplyEvents(ctx context.Context, in *ReapplyEventsRequest, opts ...grpc.CallOption) (*ReapplyEventsResponse, error)
+	// ImportWorkflowExecution writes history batches of a workflow execution exported from another cluster
+	// through the history replication path.
+	ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error)
 	// AddSearchAttributes add custom search attributes and returns comprehensive information about them.
 	// Deprecated. Use operatorservice instead.
 	AddSearchAttributes(ctx context.Context, in *AddSearchAttributesRequest, opts ...grpc.CallOption) (*AddSearchAttributesResponse, error)
@@ -180,8 +219,21 @@ type AdminServiceClient interface {
 	ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
 	GetTaskQueueTasks(ctx context.Context, in *GetTaskQueueTasksRequest, opts ...grpc.CallOption) (*GetTaskQueueTasksResponse, error)
+	// DescribeTaskQueuePartitions returns backlog and rate statistics for every partition of a task queue.
+	DescribeTaskQueuePartitions(ctx context.Context, in *DescribeTaskQueuePartitionsRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionsResponse, error)
 	// DeleteWorkflowExecution force deletes a workflow's visibility record, current & concrete execution record and history if possible
 	DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error)
+	// StartBatchOperation starts a batch operation that the public StartBatchOperation API does not support yet,
+	// i.e. resetting or upserting search attributes of the workflows matching a visibility query.
+	StartBatchOperation(ctx context.Context, in *StartBatchOperationRequest, opts ...grpc.CallOption) (*StartBatchOperationResponse, error)
+	// CountWorkflowExecutions counts the workflow executions matching a visibility query. Unlike the public
+	// CountWorkflowExecutions API, the query may have a GROUP BY clause, and the count of each group is returned.
+	CountWorkflowExecutions(ctx context.Context, in *CountWorkflowExecutionsRequest, opts ...grpc.CallOption) (*CountWorkflowExecutionsResponse, error)
+	// DescribeSchedule returns the internal policies of a schedule and the outcomes of its most recent runs,
+	// which the public DescribeSchedule API doesn't have.
+	DescribeSchedule(ctx context.Context, in *DescribeScheduleRequest, opts ...grpc.CallOption) (*DescribeScheduleResponse, error)
+	// UpdateSchedulePolicies replaces the internal policies of a schedule, which the public schedule API can't set.
+	UpdateSchedulePolicies(ctx context.Context, in *UpdateSchedulePoliciesRequest, opts ...grpc.CallOption) (*UpdateSchedulePoliciesResponse, error)
 }
 
 type adminServiceClient struct {
@@ -237,6 +289,69 @@ func (c *adminServiceClient) CloseShard(ctx context.Context, in *CloseShardReque
 	return out, nil
 }
 
+func (c *adminServiceClient) MoveShard(ctx context.Context, in *MoveShardRequest, opts ...grpc.CallOption) (*MoveShardResponse, error) {
+	out := new(MoveShardResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/MoveShard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListShardOwnershipOverrides(ctx context.Context, in *ListShardOwnershipOverridesRequest, opts ...grpc.CallOption) (*ListShardOwnershipOverridesResponse, error) {
+	out := new(ListShardOwnershipOverridesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListShardOwnershipOverrides", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetDynamicConfig(ctx context.Context, in *GetDynamicConfigRequest, opts ...grpc.CallOption) (*GetDynamicConfigResponse, error) {
+	out := new(GetDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetDynamicConfigOverride(ctx context.Context, in *SetDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*SetDynamicConfigOverrideResponse, error) {
+	out := new(SetDynamicConfigOverrideResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/SetDynamicConfigOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemoveDynamicConfigOverride(ctx context.Context, in *RemoveDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*RemoveDynamicConfigOverrideResponse, error) {
+	out := new(RemoveDynamicConfigOverrideResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RemoveDynamicConfigOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDynamicConfigOverrides(ctx context.Context, in *ListDynamicConfigOverridesRequest, opts ...grpc.CallOption) (*ListDynamicConfigOverridesResponse, error) {
+	out := new(ListDynamicConfigOverridesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfigOverrides", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDynamicConfig(ctx context.Context, in *ListDynamicConfigRequest, opts ...grpc.CallOption) (*ListDynamicConfigResponse, error) {
+	out := new(ListDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ListHistoryTasks(ctx context.Context, in *ListHistoryTasksRequest, opts ...grpc.CallOption) (*ListHistoryTasksResponse, error) {
 	out := new(ListHistoryTasksResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListHistoryTasks", in, out, opts...)
@@ -300,6 +415,15 @@ func (c *adminServiceClient) ReapplyEvents(ctx context.Context, in *ReapplyEvent
 	return out, nil
 }
 
+func (c *adminServiceClient) ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error) {
+	out := new(ImportWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ImportWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) AddSearchAttributes(ctx context.Context, in *AddSearchAttributesRequest, opts ...grpc.CallOption) (*AddSearchAttributesResponse, error) {
 	out := new(AddSearchAttributesResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/AddSearchAttributes", in, out, opts...)
@@ -426,6 +550,15 @@ func (c *adminServiceClient) GetTaskQueueTasks(ctx context.Context, in *GetTaskQ
 	return out, nil
 }
 
+func (c *adminServiceClient) DescribeTaskQueuePartitions(ctx context.Context, in *DescribeTaskQueuePartitionsRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionsResponse, error) {
+	out := new(DescribeTaskQueuePartitionsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueuePartitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error) {
 	out := new(DeleteWorkflowExecutionResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution", in, out, opts...)
@@ -435,6 +568,42 @@ func (c *adminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *De
 	return out, nil
 }
 
+func (c *adminServiceClient) StartBatchOperation(ctx context.Context, in *StartBatchOperationRequest, opts ...grpc.CallOption) (*StartBatchOperationResponse, error) {
+	out := new(StartBatchOperationResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/StartBatchOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CountWorkflowExecutions(ctx context.Context, in *CountWorkflowExecutionsRequest, opts ...grpc.CallOption) (*CountWorkflowExecutionsResponse, error) {
+	out := new(CountWorkflowExecutionsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/CountWorkflowExecutions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeSchedule(ctx context.Context, in *DescribeScheduleRequest, opts ...grpc.CallOption) (*DescribeScheduleResponse, error) {
+	out := new(DescribeScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateSchedulePolicies(ctx context.Context, in *UpdateSchedulePoliciesRequest, opts ...grpc.CallOption) (*UpdateSchedulePoliciesResponse, error) {
+	out := new(UpdateSchedulePoliciesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateSchedulePolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 type AdminServiceServer interface {
 	// RebuildMutableState attempts to rebuild mutable state according to persisted history events.
@@ -446,6 +615,24 @@ type AdminServiceServer interface {
 	DescribeHistoryHost(context.Context, *DescribeHistoryHostRequest) (*DescribeHistoryHostResponse, error)
 	GetShard(context.Context, *GetShardRequest) (*GetShardResponse, error)
 	CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error)
+	// MoveShard overrides the membership ring assignment of a history shard, so that it's owned
+	// by the given history host until the override expires.
+	MoveShard(context.Context, *MoveShardRequest) (*MoveShardResponse, error)
+	// ListShardOwnershipOverrides returns the history shard ownership overrides that haven't expired yet.
+	ListShardOwnershipOverrides(context.Context, *ListShardOwnershipOverridesRequest) (*ListShardOwnershipOverridesResponse, error)
+	// GetDynamicConfig returns the values of a dynamic config key on the host serving the request,
+	// including the overrides set with SetDynamicConfigOverride.
+	GetDynamicConfig(context.Context, *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error)
+	// SetDynamicConfigOverride overrides the value of a dynamic config key for the given constraints on
+	// all hosts until the override expires. An existing override of the same key and constraints is replaced.
+	SetDynamicConfigOverride(context.Context, *SetDynamicConfigOverrideRequest) (*SetDynamicConfigOverrideResponse, error)
+	// RemoveDynamicConfigOverride removes the override of a dynamic config key for the given constraints.
+	RemoveDynamicConfigOverride(context.Context, *RemoveDynamicConfigOverrideRequest) (*RemoveDynamicConfigOverrideResponse, error)
+	// ListDynamicConfigOverrides returns the dynamic config overrides that haven't expired yet.
+	ListDynamicConfigOverrides(context.Context, *ListDynamicConfigOverridesRequest) (*ListDynamicConfigOverridesResponse, error)
+	// ListDynamicConfig returns the dynamic config keys the server reads and their values on the host serving
+	// the request, including the overrides set with SetDynamicConfigOverride.
+	ListDynamicConfig(context.Context, *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error)
 	ListHistoryTasks(context.Context, *ListHistoryTasksRequest) (*ListHistoryTasksResponse, error)
 	RemoveTask(context.Context, *RemoveTaskRequest) (*RemoveTaskResponse, error)
 	// Returns the raw history of specified workflow execution.  It fails with 'NotFound' if specified workflow
@@ -461,6 +648,9 @@ type AdminServiceServer interface {
 	GetDLQReplicationMessages(context.Context, *GetDLQReplicationMessagesRequest) (*GetDLQReplicationMessagesResponse, error)
 	// ReapplyEvents applies stale events to the current workflow and current run.
 	ReapplyEvents(context.Context, *ReapplyEventsRequest) (*ReapplyEventsResponse, error)
+	// ImportWorkflowExecution writes history batches of a workflow execution exported from another cluster
+	// through the history replication path.
+	ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error)
 	// AddSearchAttributes add custom search attributes and returns comprehensive information about them.
 	// Deprecated. Use operatorservice instead.
 	AddSearchAttributes(context.Context, *AddSearchAttributesRequest) (*AddSearchAttributesResponse, error)
@@ -494,8 +684,21 @@ type AdminServiceServer interface {
 	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
 	GetTaskQueueTasks(context.Context, *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error)
+	// DescribeTaskQueuePartitions returns backlog and rate statistics for every partition of a task queue.
+	DescribeTaskQueuePartitions(context.Context, *DescribeTaskQueuePartitionsRequest) (*DescribeTaskQueuePartitionsResponse, error)
 	// DeleteWorkflowExecution force deletes a workflow's visibility record, current & concrete execution record and history if possible
 	DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error)
+	// StartBatchOperation starts a batch operation that the public StartBatchOperation API does not support yet,
+	// i.e. resetting or upserting search attributes of the workflows matching a visibility query.
+	StartBatchOperation(context.Context, *StartBatchOperationRequest) (*StartBatchOperationResponse, error)
+	// CountWorkflowExecutions counts the workflow executions matching a visibility query. Unlike the public
+	// CountWorkflowExecutions API, the query may have a GROUP BY clause, and the count of each group is returned.
+	CountWorkflowExecutions(context.Context, *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error)
+	// DescribeSchedule returns the internal policies of a schedule and the outcomes of its most recent runs,
+	// which the public DescribeSchedule API doesn't have.
+	DescribeSchedule(context.Context, *DescribeScheduleRequest) (*DescribeScheduleResponse, error)
+	// UpdateSchedulePolicies replaces the internal policies of a schedule, which the public schedule API can't set.
+	UpdateSchedulePolicies(context.Context, *UpdateSchedulePoliciesRequest) (*UpdateSchedulePoliciesResponse, error)
 }
 
 // UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
@@ -517,6 +720,27 @@ func (*UnimplementedAdminServiceServer) GetShard(ctx context.Context, req *GetSh
 func (*UnimplementedAdminServiceServer) CloseShard(ctx context.Context, req *CloseShardRequest) (*CloseShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseShard not implemented")
 }
+func (*UnimplementedAdminServiceServer) MoveShard(ctx context.Context, req *MoveShardRequest) (*MoveShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveShard not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListShardOwnershipOverrides(ctx context.Context, req *ListShardOwnershipOverridesRequest) (*ListShardOwnershipOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShardOwnershipOverrides not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetDynamicConfig(ctx context.Context, req *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDynamicConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) SetDynamicConfigOverride(ctx context.Context, req *SetDynamicConfigOverrideRequest) (*SetDynamicConfigOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDynamicConfigOverride not implemented")
+}
+func (*UnimplementedAdminServiceServer) RemoveDynamicConfigOverride(ctx context.Context, req *RemoveDynamicConfigOverrideRequest) (*RemoveDynamicConfigOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDynamicConfigOverride not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListDynamicConfigOverrides(ctx context.Context, req *ListDynamicConfigOverridesRequest) (*ListDynamicConfigOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDynamicConfigOverrides not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListDynamicConfig(ctx context.Context, req *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDynamicConfig not implemented")
+}
 func (*UnimplementedAdminServiceServer) ListHistoryTasks(ctx context.Context, req *ListHistoryTasksRequest) (*ListHistoryTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListHistoryTasks not implemented")
 }
@@ -538,6 +762,9 @@ func (*UnimplementedAdminServiceServer) GetDLQReplicationMessages(ctx context.Co
 func (*UnimplementedAdminServiceServer) ReapplyEvents(ctx context.Context, req *ReapplyEventsRequest) (*ReapplyEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReapplyEvents not implemented")
 }
+func (*UnimplementedAdminServiceServer) ImportWorkflowExecution(ctx context.Context, req *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportWorkflowExecution not implemented")
+}
 func (*UnimplementedAdminServiceServer) AddSearchAttributes(ctx context.Context, req *AddSearchAttributesRequest) (*AddSearchAttributesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddSearchAttributes not implemented")
 }
@@ -580,9 +807,24 @@ func (*UnimplementedAdminServiceServer) ResendReplicationTasks(ctx context.Conte
 func (*UnimplementedAdminServiceServer) GetTaskQueueTasks(ctx context.Context, req *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTaskQueueTasks not implemented")
 }
+func (*UnimplementedAdminServiceServer) DescribeTaskQueuePartitions(ctx context.Context, req *DescribeTaskQueuePartitionsRequest) (*DescribeTaskQueuePartitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueuePartitions not implemented")
+}
 func (*UnimplementedAdminServiceServer) DeleteWorkflowExecution(ctx context.Context, req *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflowExecution not implemented")
 }
+func (*UnimplementedAdminServiceServer) StartBatchOperation(ctx context.Context, req *StartBatchOperationRequest) (*StartBatchOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartBatchOperation not implemented")
+}
+func (*UnimplementedAdminServiceServer) CountWorkflowExecutions(ctx context.Context, req *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountWorkflowExecutions not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeSchedule(ctx context.Context, req *DescribeScheduleRequest) (*DescribeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateSchedulePolicies(ctx context.Context, req *UpdateSchedulePoliciesRequest) (*UpdateSchedulePoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchedulePolicies not implemented")
+}
 
 func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
 	s.RegisterService(&_AdminService_serviceDesc, srv)
@@ -678,6 +920,132 @@ func _AdminService_CloseShard_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_MoveShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).MoveShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/MoveShard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).MoveShard(ctx, req.(*MoveShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListShardOwnershipOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShardOwnershipOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListShardOwnershipOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListShardOwnershipOverrides",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListShardOwnershipOverrides(ctx, req.(*ListShardOwnershipOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDynamicConfig(ctx, req.(*GetDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetDynamicConfigOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDynamicConfigOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetDynamicConfigOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/SetDynamicConfigOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetDynamicConfigOverride(ctx, req.(*SetDynamicConfigOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveDynamicConfigOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDynamicConfigOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveDynamicConfigOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RemoveDynamicConfigOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveDynamicConfigOverride(ctx, req.(*RemoveDynamicConfigOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDynamicConfigOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDynamicConfigOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDynamicConfigOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfigOverrides",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDynamicConfigOverrides(ctx, req.(*ListDynamicConfigOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDynamicConfig(ctx, req.(*ListDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ListHistoryTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListHistoryTasksRequest)
 	if err := dec(in); err != nil {
@@ -804,6 +1172,24 @@ func _AdminService_ReapplyEvents_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ImportWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ImportWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ImportWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ImportWorkflowExecution(ctx, req.(*ImportWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_AddSearchAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddSearchAttributesRequest)
 	if err := dec(in); err != nil {
@@ -1056,6 +1442,24 @@ func _AdminService_GetTaskQueueTasks_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_DescribeTaskQueuePartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTaskQueuePartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeTaskQueuePartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueuePartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeTaskQueuePartitions(ctx, req.(*DescribeTaskQueuePartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_DeleteWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteWorkflowExecutionRequest)
 	if err := dec(in); err != nil {
@@ -1074,6 +1478,78 @@ func _AdminService_DeleteWorkflowExecution_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_StartBatchOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartBatchOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartBatchOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/StartBatchOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartBatchOperation(ctx, req.(*StartBatchOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CountWorkflowExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountWorkflowExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CountWorkflowExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/CountWorkflowExecutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CountWorkflowExecutions(ctx, req.(*CountWorkflowExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeSchedule(ctx, req.(*DescribeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateSchedulePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSchedulePoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateSchedulePolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateSchedulePolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateSchedulePolicies(ctx, req.(*UpdateSchedulePoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.adminservice.v1.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -1098,6 +1574,34 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CloseShard",
 			Handler:    _AdminService_CloseShard_Handler,
 		},
+		{
+			MethodName: "MoveShard",
+			Handler:    _AdminService_MoveShard_Handler,
+		},
+		{
+			MethodName: "ListShardOwnershipOverrides",
+			Handler:    _AdminService_ListShardOwnershipOverrides_Handler,
+		},
+		{
+			MethodName: "GetDynamicConfig",
+			Handler:    _AdminService_GetDynamicConfig_Handler,
+		},
+		{
+			MethodName: "SetDynamicConfigOverride",
+			Handler:    _AdminService_SetDynamicConfigOverride_Handler,
+		},
+		{
+			MethodName: "RemoveDynamicConfigOverride",
+			Handler:    _AdminService_RemoveDynamicConfigOverride_Handler,
+		},
+		{
+			MethodName: "ListDynamicConfigOverrides",
+			Handler:    _AdminService_ListDynamicConfigOverrides_Handler,
+		},
+		{
+			MethodName: "ListDynamicConfig",
+			Handler:    _AdminService_ListDynamicConfig_Handler,
+		},
 		{
 			MethodName: "ListHistoryTasks",
 			Handler:    _AdminService_ListHistoryTasks_Handler,
@@ -1126,6 +1630,10 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReapplyEvents",
 			Handler:    _AdminService_ReapplyEvents_Handler,
 		},
+		{
+			MethodName: "ImportWorkflowExecution",
+			Handler:    _AdminService_ImportWorkflowExecution_Handler,
+		},
 		{
 			MethodName: "AddSearchAttributes",
 			Handler:    _AdminService_AddSearchAttributes_Handler,
@@ -1182,10 +1690,30 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTaskQueueTasks",
 			Handler:    _AdminService_GetTaskQueueTasks_Handler,
 		},
+		{
+			MethodName: "DescribeTaskQueuePartitions",
+			Handler:    _AdminService_DescribeTaskQueuePartitions_Handler,
+		},
 		{
 			MethodName: "DeleteWorkflowExecution",
 			Handler:    _AdminService_DeleteWorkflowExecution_Handler,
 		},
+		{
+			MethodName: "StartBatchOperation",
+			Handler:    _AdminService_StartBatchOperation_Handler,
+		},
+		{
+			MethodName: "CountWorkflowExecutions",
+			Handler:    _AdminService_CountWorkflowExecutions_Handler,
+		},
+		{
+			MethodName: "DescribeSchedule",
+			Handler:    _AdminService_DescribeSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateSchedulePolicies",
+			Handler:    _AdminService_UpdateSchedulePolicies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/adminservice/v1/service.proto",
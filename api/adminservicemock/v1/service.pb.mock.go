@@ -120,6 +120,26 @@ func (mr *MockAdminServiceClientMockRecorder) CloseShard(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceClient)(nil).CloseShard), varargs...)
 }
 
+// CountWorkflowExecutions mocks base method.
+func (m *MockAdminServiceClient) CountWorkflowExecutions(ctx context.Context, in *adminservice.CountWorkflowExecutionsRequest, opts ...grpc.CallOption) (*adminservice.CountWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CountWorkflowExecutions", varargs...)
+	ret0, _ := ret[0].(*adminservice.CountWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountWorkflowExecutions indicates an expected call of CountWorkflowExecutions.
+func (mr *MockAdminServiceClientMockRecorder) CountWorkflowExecutions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWorkflowExecutions", reflect.TypeOf((*MockAdminServiceClient)(nil).CountWorkflowExecutions), varargs...)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *adminservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -200,6 +220,46 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeSchedule mocks base method.
+func (m *MockAdminServiceClient) DescribeSchedule(ctx context.Context, in *adminservice.DescribeScheduleRequest, opts ...grpc.CallOption) (*adminservice.DescribeScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeSchedule", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSchedule indicates an expected call of DescribeSchedule.
+func (mr *MockAdminServiceClientMockRecorder) DescribeSchedule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSchedule", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeSchedule), varargs...)
+}
+
+// DescribeTaskQueuePartitions mocks base method.
+func (m *MockAdminServiceClient) DescribeTaskQueuePartitions(ctx context.Context, in *adminservice.DescribeTaskQueuePartitionsRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueuePartitionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTaskQueuePartitions", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskQueuePartitionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueuePartitions indicates an expected call of DescribeTaskQueuePartitions.
+func (mr *MockAdminServiceClientMockRecorder) DescribeTaskQueuePartitions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartitions", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskQueuePartitions), varargs...)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceClient) GetDLQMessages(ctx context.Context, in *adminservice.GetDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -240,6 +300,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetDLQReplicationMessages(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDLQReplicationMessages), varargs...)
 }
 
+// GetDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) GetDynamicConfig(ctx context.Context, in *adminservice.GetDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.GetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) GetDynamicConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDynamicConfig), varargs...)
+}
+
 // GetNamespaceReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) GetNamespaceReplicationMessages(ctx context.Context, in *adminservice.GetNamespaceReplicationMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetNamespaceReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -360,6 +440,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetWorkflowExecutionRawHistoryV2(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceClient)(nil).GetWorkflowExecutionRawHistoryV2), varargs...)
 }
 
+// ImportWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) ImportWorkflowExecution(ctx context.Context, in *adminservice.ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.ImportWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.ImportWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportWorkflowExecution indicates an expected call of ImportWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) ImportWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).ImportWorkflowExecution), varargs...)
+}
+
 // ListClusterMembers mocks base method.
 func (m *MockAdminServiceClient) ListClusterMembers(ctx context.Context, in *adminservice.ListClusterMembersRequest, opts ...grpc.CallOption) (*adminservice.ListClusterMembersResponse, error) {
 	m.ctrl.T.Helper()
@@ -400,6 +500,46 @@ func (mr *MockAdminServiceClientMockRecorder) ListClusters(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockAdminServiceClient)(nil).ListClusters), varargs...)
 }
 
+// ListDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) ListDynamicConfig(ctx context.Context, in *adminservice.ListDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.ListDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfig indicates an expected call of ListDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) ListDynamicConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).ListDynamicConfig), varargs...)
+}
+
+// ListDynamicConfigOverrides mocks base method.
+func (m *MockAdminServiceClient) ListDynamicConfigOverrides(ctx context.Context, in *adminservice.ListDynamicConfigOverridesRequest, opts ...grpc.CallOption) (*adminservice.ListDynamicConfigOverridesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDynamicConfigOverrides", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigOverridesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigOverrides indicates an expected call of ListDynamicConfigOverrides.
+func (mr *MockAdminServiceClientMockRecorder) ListDynamicConfigOverrides(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigOverrides", reflect.TypeOf((*MockAdminServiceClient)(nil).ListDynamicConfigOverrides), varargs...)
+}
+
 // ListHistoryTasks mocks base method.
 func (m *MockAdminServiceClient) ListHistoryTasks(ctx context.Context, in *adminservice.ListHistoryTasksRequest, opts ...grpc.CallOption) (*adminservice.ListHistoryTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -420,6 +560,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListHistoryTasks(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ListHistoryTasks), varargs...)
 }
 
+// ListShardOwnershipOverrides mocks base method.
+func (m *MockAdminServiceClient) ListShardOwnershipOverrides(ctx context.Context, in *adminservice.ListShardOwnershipOverridesRequest, opts ...grpc.CallOption) (*adminservice.ListShardOwnershipOverridesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListShardOwnershipOverrides", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListShardOwnershipOverridesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShardOwnershipOverrides indicates an expected call of ListShardOwnershipOverrides.
+func (mr *MockAdminServiceClientMockRecorder) ListShardOwnershipOverrides(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShardOwnershipOverrides", reflect.TypeOf((*MockAdminServiceClient)(nil).ListShardOwnershipOverrides), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -440,6 +600,26 @@ func (mr *MockAdminServiceClientMockRecorder) MergeDLQMessages(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).MergeDLQMessages), varargs...)
 }
 
+// MoveShard mocks base method.
+func (m *MockAdminServiceClient) MoveShard(ctx context.Context, in *adminservice.MoveShardRequest, opts ...grpc.CallOption) (*adminservice.MoveShardResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveShard", varargs...)
+	ret0, _ := ret[0].(*adminservice.MoveShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveShard indicates an expected call of MoveShard.
+func (mr *MockAdminServiceClientMockRecorder) MoveShard(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveShard", reflect.TypeOf((*MockAdminServiceClient)(nil).MoveShard), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -520,6 +700,26 @@ func (mr *MockAdminServiceClientMockRecorder) RefreshWorkflowTasks(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).RefreshWorkflowTasks), varargs...)
 }
 
+// RemoveDynamicConfigOverride mocks base method.
+func (m *MockAdminServiceClient) RemoveDynamicConfigOverride(ctx context.Context, in *adminservice.RemoveDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*adminservice.RemoveDynamicConfigOverrideResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveDynamicConfigOverride", varargs...)
+	ret0, _ := ret[0].(*adminservice.RemoveDynamicConfigOverrideResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveDynamicConfigOverride indicates an expected call of RemoveDynamicConfigOverride.
+func (mr *MockAdminServiceClientMockRecorder) RemoveDynamicConfigOverride(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDynamicConfigOverride", reflect.TypeOf((*MockAdminServiceClient)(nil).RemoveDynamicConfigOverride), varargs...)
+}
+
 // RemoveRemoteCluster mocks base method.
 func (m *MockAdminServiceClient) RemoveRemoteCluster(ctx context.Context, in *adminservice.RemoveRemoteClusterRequest, opts ...grpc.CallOption) (*adminservice.RemoveRemoteClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -600,6 +800,66 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// SetDynamicConfigOverride mocks base method.
+func (m *MockAdminServiceClient) SetDynamicConfigOverride(ctx context.Context, in *adminservice.SetDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*adminservice.SetDynamicConfigOverrideResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetDynamicConfigOverride", varargs...)
+	ret0, _ := ret[0].(*adminservice.SetDynamicConfigOverrideResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDynamicConfigOverride indicates an expected call of SetDynamicConfigOverride.
+func (mr *MockAdminServiceClientMockRecorder) SetDynamicConfigOverride(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDynamicConfigOverride", reflect.TypeOf((*MockAdminServiceClient)(nil).SetDynamicConfigOverride), varargs...)
+}
+
+// StartBatchOperation mocks base method.
+func (m *MockAdminServiceClient) StartBatchOperation(ctx context.Context, in *adminservice.StartBatchOperationRequest, opts ...grpc.CallOption) (*adminservice.StartBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartBatchOperation", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartBatchOperation indicates an expected call of StartBatchOperation.
+func (mr *MockAdminServiceClientMockRecorder) StartBatchOperation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBatchOperation", reflect.TypeOf((*MockAdminServiceClient)(nil).StartBatchOperation), varargs...)
+}
+
+// UpdateSchedulePolicies mocks base method.
+func (m *MockAdminServiceClient) UpdateSchedulePolicies(ctx context.Context, in *adminservice.UpdateSchedulePoliciesRequest, opts ...grpc.CallOption) (*adminservice.UpdateSchedulePoliciesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSchedulePolicies", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateSchedulePoliciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSchedulePolicies indicates an expected call of UpdateSchedulePolicies.
+func (mr *MockAdminServiceClientMockRecorder) UpdateSchedulePolicies(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedulePolicies", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateSchedulePolicies), varargs...)
+}
+
 // MockAdminServiceServer is a mock of AdminServiceServer interface.
 type MockAdminServiceServer struct {
 	ctrl     *gomock.Controller
@@ -668,6 +928,21 @@ func (mr *MockAdminServiceServerMockRecorder) CloseShard(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceServer)(nil).CloseShard), arg0, arg1)
 }
 
+// CountWorkflowExecutions mocks base method.
+func (m *MockAdminServiceServer) CountWorkflowExecutions(arg0 context.Context, arg1 *adminservice.CountWorkflowExecutionsRequest) (*adminservice.CountWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountWorkflowExecutions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CountWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountWorkflowExecutions indicates an expected call of CountWorkflowExecutions.
+func (mr *MockAdminServiceServerMockRecorder) CountWorkflowExecutions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWorkflowExecutions", reflect.TypeOf((*MockAdminServiceServer)(nil).CountWorkflowExecutions), arg0, arg1)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *adminservice.DeleteWorkflowExecutionRequest) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -728,6 +1003,36 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeSchedule mocks base method.
+func (m *MockAdminServiceServer) DescribeSchedule(arg0 context.Context, arg1 *adminservice.DescribeScheduleRequest) (*adminservice.DescribeScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSchedule indicates an expected call of DescribeSchedule.
+func (mr *MockAdminServiceServerMockRecorder) DescribeSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSchedule", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeSchedule), arg0, arg1)
+}
+
+// DescribeTaskQueuePartitions mocks base method.
+func (m *MockAdminServiceServer) DescribeTaskQueuePartitions(arg0 context.Context, arg1 *adminservice.DescribeTaskQueuePartitionsRequest) (*adminservice.DescribeTaskQueuePartitionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskQueuePartitions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskQueuePartitionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueuePartitions indicates an expected call of DescribeTaskQueuePartitions.
+func (mr *MockAdminServiceServerMockRecorder) DescribeTaskQueuePartitions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartitions", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskQueuePartitions), arg0, arg1)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceServer) GetDLQMessages(arg0 context.Context, arg1 *adminservice.GetDLQMessagesRequest) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -758,6 +1063,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetDLQReplicationMessages(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQReplicationMessages), arg0, arg1)
 }
 
+// GetDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) GetDynamicConfig(arg0 context.Context, arg1 *adminservice.GetDynamicConfigRequest) (*adminservice.GetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) GetDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDynamicConfig), arg0, arg1)
+}
+
 // GetNamespaceReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) GetNamespaceReplicationMessages(arg0 context.Context, arg1 *adminservice.GetNamespaceReplicationMessagesRequest) (*adminservice.GetNamespaceReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -848,6 +1168,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetWorkflowExecutionRawHistoryV2(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceServer)(nil).GetWorkflowExecutionRawHistoryV2), arg0, arg1)
 }
 
+// ImportWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) ImportWorkflowExecution(arg0 context.Context, arg1 *adminservice.ImportWorkflowExecutionRequest) (*adminservice.ImportWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ImportWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportWorkflowExecution indicates an expected call of ImportWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) ImportWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).ImportWorkflowExecution), arg0, arg1)
+}
+
 // ListClusterMembers mocks base method.
 func (m *MockAdminServiceServer) ListClusterMembers(arg0 context.Context, arg1 *adminservice.ListClusterMembersRequest) (*adminservice.ListClusterMembersResponse, error) {
 	m.ctrl.T.Helper()
@@ -878,6 +1213,36 @@ func (mr *MockAdminServiceServerMockRecorder) ListClusters(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockAdminServiceServer)(nil).ListClusters), arg0, arg1)
 }
 
+// ListDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) ListDynamicConfig(arg0 context.Context, arg1 *adminservice.ListDynamicConfigRequest) (*adminservice.ListDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfig indicates an expected call of ListDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) ListDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).ListDynamicConfig), arg0, arg1)
+}
+
+// ListDynamicConfigOverrides mocks base method.
+func (m *MockAdminServiceServer) ListDynamicConfigOverrides(arg0 context.Context, arg1 *adminservice.ListDynamicConfigOverridesRequest) (*adminservice.ListDynamicConfigOverridesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfigOverrides", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigOverridesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigOverrides indicates an expected call of ListDynamicConfigOverrides.
+func (mr *MockAdminServiceServerMockRecorder) ListDynamicConfigOverrides(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigOverrides", reflect.TypeOf((*MockAdminServiceServer)(nil).ListDynamicConfigOverrides), arg0, arg1)
+}
+
 // ListHistoryTasks mocks base method.
 func (m *MockAdminServiceServer) ListHistoryTasks(arg0 context.Context, arg1 *adminservice.ListHistoryTasksRequest) (*adminservice.ListHistoryTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -893,6 +1258,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListHistoryTasks(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ListHistoryTasks), arg0, arg1)
 }
 
+// ListShardOwnershipOverrides mocks base method.
+func (m *MockAdminServiceServer) ListShardOwnershipOverrides(arg0 context.Context, arg1 *adminservice.ListShardOwnershipOverridesRequest) (*adminservice.ListShardOwnershipOverridesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListShardOwnershipOverrides", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListShardOwnershipOverridesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShardOwnershipOverrides indicates an expected call of ListShardOwnershipOverrides.
+func (mr *MockAdminServiceServerMockRecorder) ListShardOwnershipOverrides(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShardOwnershipOverrides", reflect.TypeOf((*MockAdminServiceServer)(nil).ListShardOwnershipOverrides), arg0, arg1)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *adminservice.MergeDLQMessagesRequest) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -908,6 +1288,21 @@ func (mr *MockAdminServiceServerMockRecorder) MergeDLQMessages(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).MergeDLQMessages), arg0, arg1)
 }
 
+// MoveShard mocks base method.
+func (m *MockAdminServiceServer) MoveShard(arg0 context.Context, arg1 *adminservice.MoveShardRequest) (*adminservice.MoveShardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveShard", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.MoveShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveShard indicates an expected call of MoveShard.
+func (mr *MockAdminServiceServerMockRecorder) MoveShard(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveShard", reflect.TypeOf((*MockAdminServiceServer)(nil).MoveShard), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -968,6 +1363,21 @@ func (mr *MockAdminServiceServerMockRecorder) RefreshWorkflowTasks(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).RefreshWorkflowTasks), arg0, arg1)
 }
 
+// RemoveDynamicConfigOverride mocks base method.
+func (m *MockAdminServiceServer) RemoveDynamicConfigOverride(arg0 context.Context, arg1 *adminservice.RemoveDynamicConfigOverrideRequest) (*adminservice.RemoveDynamicConfigOverrideResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDynamicConfigOverride", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RemoveDynamicConfigOverrideResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveDynamicConfigOverride indicates an expected call of RemoveDynamicConfigOverride.
+func (mr *MockAdminServiceServerMockRecorder) RemoveDynamicConfigOverride(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDynamicConfigOverride", reflect.TypeOf((*MockAdminServiceServer)(nil).RemoveDynamicConfigOverride), arg0, arg1)
+}
+
 // RemoveRemoteCluster mocks base method.
 func (m *MockAdminServiceServer) RemoveRemoteCluster(arg0 context.Context, arg1 *adminservice.RemoveRemoteClusterRequest) (*adminservice.RemoveRemoteClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -1027,3 +1437,48 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
+
+// SetDynamicConfigOverride mocks base method.
+func (m *MockAdminServiceServer) SetDynamicConfigOverride(arg0 context.Context, arg1 *adminservice.SetDynamicConfigOverrideRequest) (*adminservice.SetDynamicConfigOverrideResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDynamicConfigOverride", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SetDynamicConfigOverrideResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDynamicConfigOverride indicates an expected call of SetDynamicConfigOverride.
+func (mr *MockAdminServiceServerMockRecorder) SetDynamicConfigOverride(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDynamicConfigOverride", reflect.TypeOf((*MockAdminServiceServer)(nil).SetDynamicConfigOverride), arg0, arg1)
+}
+
+// StartBatchOperation mocks base method.
+func (m *MockAdminServiceServer) StartBatchOperation(arg0 context.Context, arg1 *adminservice.StartBatchOperationRequest) (*adminservice.StartBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartBatchOperation", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartBatchOperation indicates an expected call of StartBatchOperation.
+func (mr *MockAdminServiceServerMockRecorder) StartBatchOperation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBatchOperation", reflect.TypeOf((*MockAdminServiceServer)(nil).StartBatchOperation), arg0, arg1)
+}
+
+// UpdateSchedulePolicies mocks base method.
+func (m *MockAdminServiceServer) UpdateSchedulePolicies(arg0 context.Context, arg1 *adminservice.UpdateSchedulePoliciesRequest) (*adminservice.UpdateSchedulePoliciesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSchedulePolicies", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateSchedulePoliciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSchedulePolicies indicates an expected call of UpdateSchedulePolicies.
+func (mr *MockAdminServiceServerMockRecorder) UpdateSchedulePolicies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedulePolicies", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateSchedulePolicies), arg0, arg1)
+}
@@ -106,9 +106,45 @@ func (WorkflowBackoffType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_004b7fefe981a755, []int{1}
 }
 
+// ResetType selects the workflow task a batch reset resets each workflow to.
+type ResetType int32
+
+const (
+	RESET_TYPE_UNSPECIFIED ResetType = 0
+	// The first completed workflow task.
+	RESET_TYPE_FIRST_WORKFLOW_TASK ResetType = 1
+	// The last completed workflow task.
+	RESET_TYPE_LAST_WORKFLOW_TASK ResetType = 2
+	// The first workflow task completed by a worker with the given binary checksum.
+	RESET_TYPE_BAD_BINARY ResetType = 3
+	// The first workflow task completed by a worker with the given build ID.
+	RESET_TYPE_BUILD_ID ResetType = 4
+)
+
+var ResetType_name = map[int32]string{
+	0: "Unspecified",
+	1: "FirstWorkflowTask",
+	2: "LastWorkflowTask",
+	3: "BadBinary",
+	4: "BuildId",
+}
+
+var ResetType_value = map[string]int32{
+	"Unspecified":       0,
+	"FirstWorkflowTask": 1,
+	"LastWorkflowTask":  2,
+	"BadBinary":         3,
+	"BuildId":           4,
+}
+
+func (ResetType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_004b7fefe981a755, []int{2}
+}
+
 func init() {
 	proto.RegisterEnum("temporal.server.api.enums.v1.WorkflowExecutionState", WorkflowExecutionState_name, WorkflowExecutionState_value)
 	proto.RegisterEnum("temporal.server.api.enums.v1.WorkflowBackoffType", WorkflowBackoffType_name, WorkflowBackoffType_value)
+	proto.RegisterEnum("temporal.server.api.enums.v1.ResetType", ResetType_name, ResetType_value)
 }
 
 func init() {
@@ -116,30 +152,35 @@ func init() {
 }
 
 var fileDescriptor_004b7fefe981a755 = []byte{
-	// 366 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2e, 0x49, 0xcd, 0x2d,
-	0xc8, 0x2f, 0x4a, 0xcc, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0x4b, 0x2d, 0xd2, 0x4f, 0x2c, 0xc8, 0xd4,
-	0x4f, 0xcd, 0x2b, 0xcd, 0x2d, 0xd6, 0x2f, 0x33, 0xd4, 0x2f, 0xcf, 0x2f, 0xca, 0x4e, 0xcb, 0xc9,
-	0x2f, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0x29, 0xd6, 0x83, 0x28, 0xd6, 0x4b,
-	0x2c, 0xc8, 0xd4, 0x03, 0x2b, 0xd6, 0x2b, 0x33, 0xd4, 0x5a, 0xc6, 0xc4, 0x25, 0x16, 0x0e, 0xd5,
-	0xe0, 0x5a, 0x91, 0x9a, 0x5c, 0x5a, 0x92, 0x99, 0x9f, 0x17, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xa4,
-	0xc1, 0xa5, 0x12, 0xee, 0x1f, 0xe4, 0xed, 0xe6, 0xe3, 0x1f, 0x1e, 0xef, 0x1a, 0xe1, 0xea, 0x1c,
-	0x1a, 0xe2, 0xe9, 0xef, 0x17, 0x1f, 0x1c, 0xe2, 0x18, 0xe2, 0x1a, 0x1f, 0xea, 0x17, 0x1c, 0xe0,
-	0xea, 0xec, 0xe9, 0xe6, 0xe9, 0xea, 0x22, 0xc0, 0x20, 0xa4, 0xc2, 0xa5, 0x80, 0x53, 0xa5, 0x73,
-	0x90, 0xab, 0x63, 0x88, 0xab, 0x8b, 0x00, 0x23, 0x5e, 0x55, 0x41, 0xa1, 0x7e, 0x7e, 0x9e, 0x7e,
-	0xee, 0x02, 0x4c, 0x42, 0x6a, 0x5c, 0x4a, 0xb8, 0xcd, 0xf2, 0xf7, 0x0d, 0xf0, 0x71, 0x05, 0x99,
-	0xc6, 0x2c, 0xa4, 0xcc, 0x25, 0x8f, 0x53, 0x5d, 0x94, 0xbf, 0xaf, 0x93, 0xa7, 0xab, 0x00, 0x8b,
-	0x90, 0x22, 0x97, 0x2c, 0x4e, 0x45, 0x61, 0xfe, 0x9e, 0x2e, 0x02, 0xac, 0x04, 0xec, 0x0b, 0x0a,
-	0x0a, 0x0d, 0x00, 0xd9, 0xc7, 0xa6, 0x55, 0xcb, 0x25, 0x0c, 0x0b, 0x27, 0xa7, 0xc4, 0xe4, 0xec,
-	0xfc, 0xb4, 0xb4, 0x90, 0xca, 0x82, 0x54, 0x21, 0x55, 0x2e, 0x45, 0xb8, 0x76, 0x27, 0x47, 0x67,
-	0x6f, 0x7f, 0x37, 0xb7, 0xf8, 0x90, 0xc8, 0x00, 0xf4, 0x10, 0x92, 0xe7, 0x92, 0xc6, 0xae, 0x2c,
-	0xc8, 0x35, 0x24, 0x28, 0x52, 0x80, 0x51, 0x48, 0x8e, 0x4b, 0x0a, 0xbb, 0x02, 0xe7, 0x20, 0x7f,
-	0x3f, 0x01, 0x26, 0xa7, 0xb8, 0x0b, 0x0f, 0xe5, 0x18, 0x6e, 0x3c, 0x94, 0x63, 0xf8, 0xf0, 0x50,
-	0x8e, 0xb1, 0xe1, 0x91, 0x1c, 0xe3, 0x8a, 0x47, 0x72, 0x8c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
-	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x8b, 0x47, 0x72, 0x0c, 0x1f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x1a, 0xe9, 0xf9,
-	0x7a, 0xf0, 0xe8, 0xcf, 0xcc, 0xc7, 0x96, 0x5c, 0xac, 0xc1, 0x8c, 0x24, 0x36, 0x70, 0x62, 0x31,
-	0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x0f, 0x05, 0x23, 0x5b, 0x02, 0x00, 0x00,
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0xd2, 0x4d, 0x6b, 0x13, 0x41,
+	0x18, 0x07, 0xf0, 0x9d, 0xb4, 0x16, 0x7c, 0x4e, 0xc3, 0x14, 0x2b, 0x56, 0x9d, 0xda, 0x5a, 0xa5,
+	0x44, 0xd8, 0xa5, 0x78, 0xf4, 0xb4, 0x2f, 0xb3, 0x32, 0x24, 0xdd, 0x09, 0xb3, 0xb3, 0xc6, 0xf4,
+	0xe0, 0xb2, 0x96, 0x89, 0x84, 0xbe, 0xcc, 0xb2, 0xd9, 0xa6, 0x7a, 0x10, 0xfc, 0x08, 0x7e, 0x06,
+	0x0f, 0xe2, 0x47, 0xf1, 0x98, 0x63, 0x8f, 0x66, 0x73, 0xf1, 0xd8, 0x8f, 0x20, 0x89, 0x36, 0x04,
+	0x4d, 0xec, 0x6d, 0x60, 0x7e, 0xcf, 0xf3, 0x87, 0x87, 0x3f, 0x3c, 0x2b, 0xf5, 0x69, 0x6e, 0x8a,
+	0xec, 0xc4, 0xe9, 0xeb, 0x62, 0xa0, 0x0b, 0x27, 0xcb, 0x7b, 0x8e, 0x3e, 0x3b, 0x3f, 0xed, 0x3b,
+	0x83, 0x7d, 0xe7, 0xc2, 0x14, 0xc7, 0xdd, 0x13, 0x73, 0x61, 0xe7, 0x85, 0x29, 0x0d, 0x79, 0x70,
+	0x8d, 0xed, 0xdf, 0xd8, 0xce, 0xf2, 0x9e, 0x3d, 0xc5, 0xf6, 0x60, 0xbf, 0xfe, 0xb5, 0x06, 0x1b,
+	0xed, 0x3f, 0x03, 0xec, 0xbd, 0x3e, 0x3a, 0x2f, 0x7b, 0xe6, 0x2c, 0x2e, 0xb3, 0x52, 0x93, 0x3d,
+	0xd8, 0x6d, 0x0b, 0xd9, 0x08, 0x9b, 0xa2, 0x9d, 0xb2, 0xd7, 0xcc, 0x4f, 0x14, 0x17, 0x51, 0x1a,
+	0x2b, 0x57, 0xb1, 0x34, 0x89, 0xe2, 0x16, 0xf3, 0x79, 0xc8, 0x59, 0x80, 0x2d, 0xb2, 0x0b, 0x8f,
+	0x96, 0x4a, 0x5f, 0x32, 0x57, 0xb1, 0x00, 0xa3, 0xff, 0x2a, 0x99, 0x44, 0x11, 0x8f, 0x5e, 0xe2,
+	0x1a, 0x79, 0x0a, 0x3b, 0xcb, 0x77, 0x89, 0x83, 0x56, 0x93, 0x4d, 0xb6, 0xad, 0x90, 0xc7, 0xb0,
+	0xb5, 0xd4, 0x1d, 0x8a, 0x03, 0x8f, 0x33, 0xbc, 0x4a, 0xb6, 0xe1, 0xe1, 0x52, 0xf4, 0x4a, 0xf0,
+	0x00, 0xdf, 0xba, 0x21, 0x4f, 0xca, 0xa4, 0x35, 0xc9, 0x5b, 0xab, 0x7f, 0x84, 0xf5, 0xeb, 0x3b,
+	0x79, 0xd9, 0xd1, 0xb1, 0xe9, 0x76, 0xd5, 0x87, 0x5c, 0x93, 0x27, 0xb0, 0x3d, 0x1b, 0xf7, 0x5c,
+	0xbf, 0x21, 0xc2, 0x30, 0x55, 0x9d, 0xd6, 0xdf, 0x17, 0xda, 0x82, 0xfb, 0x8b, 0x99, 0x64, 0x4a,
+	0x76, 0x30, 0x22, 0x14, 0x36, 0x17, 0x03, 0x5f, 0x8a, 0x08, 0xd7, 0xea, 0x5f, 0x10, 0xdc, 0x96,
+	0xba, 0xaf, 0xcb, 0x69, 0xea, 0x26, 0x6c, 0x48, 0x16, 0x33, 0xb5, 0x28, 0x6a, 0x07, 0xe8, 0xdc,
+	0x5f, 0xc8, 0x65, 0xac, 0xd2, 0xd9, 0x6a, 0xe5, 0xc6, 0x0d, 0x8c, 0x26, 0x77, 0x99, 0x33, 0x4d,
+	0xf7, 0x1f, 0x52, 0x23, 0xf7, 0xe0, 0xce, 0x1c, 0xf1, 0xdc, 0x20, 0xf5, 0x78, 0xe4, 0xca, 0x0e,
+	0x5e, 0x21, 0x77, 0x61, 0x7d, 0xfe, 0x2b, 0xe1, 0xcd, 0x20, 0xe5, 0x01, 0x5e, 0xf5, 0xde, 0x0c,
+	0x47, 0xd4, 0xba, 0x1c, 0x51, 0xeb, 0x6a, 0x44, 0xd1, 0xa7, 0x8a, 0xa2, 0x6f, 0x15, 0x45, 0xdf,
+	0x2b, 0x8a, 0x86, 0x15, 0x45, 0x3f, 0x2a, 0x8a, 0x7e, 0x56, 0xd4, 0xba, 0xaa, 0x28, 0xfa, 0x3c,
+	0xa6, 0xd6, 0x70, 0x4c, 0xad, 0xcb, 0x31, 0xb5, 0x0e, 0xf7, 0xde, 0x19, 0x7b, 0xd6, 0xd1, 0x9e,
+	0x59, 0xd4, 0xe9, 0x17, 0xd3, 0xc7, 0xdb, 0xb5, 0x69, 0xa3, 0x9f, 0xff, 0x1a, 0x00, 0x03, 0x83,
+	0xd0, 0x71, 0x00, 0x03, 0x00, 0x00,
 }
 
 func (x WorkflowExecutionState) String() string {
@@ -156,3 +197,10 @@ func (x WorkflowBackoffType) String() string {
 	}
 	return strconv.Itoa(int(x))
 }
+func (x ResetType) String() string {
+	s, ok := ResetType_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
@@ -29,6 +29,7 @@ package history
 
 import (
 	bytes "bytes"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -358,6 +359,247 @@ func (m *TaskRange) GetExclusiveMaxTaskKey() *TaskKey {
 	return nil
 }
 
+// ShardLoad describes the recent load of a history shard.
+type ShardLoad struct {
+	ShardId int32 `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// Rate of requests routed to the shard by workflow, per API.
+	RequestRates []*ApiRequestRate `protobuf:"bytes,2,rep,name=request_rates,json=requestRates,proto3" json:"request_rates,omitempty"`
+	// Pending task count of each queue category of the shard.
+	QueueLoads []*QueueLoad `protobuf:"bytes,3,rep,name=queue_loads,json=queueLoads,proto3" json:"queue_loads,omitempty"`
+	// Fraction of mutable state cache lookups served from the cache, over the same window as request_rates.
+	MutableStateCacheHitRate float64 `protobuf:"fixed64,4,opt,name=mutable_state_cache_hit_rate,json=mutableStateCacheHitRate,proto3" json:"mutable_state_cache_hit_rate,omitempty"`
+	// Workflows with the most requests, busiest first.
+	TopWorkflows []*WorkflowLoad `protobuf:"bytes,5,rep,name=top_workflows,json=topWorkflows,proto3" json:"top_workflows,omitempty"`
+}
+
+func (m *ShardLoad) Reset()      { *m = ShardLoad{} }
+func (*ShardLoad) ProtoMessage() {}
+func (*ShardLoad) Descriptor() ([]byte, []int) {
+	return fileDescriptor_670cd05c700ece14, []int{6}
+}
+func (m *ShardLoad) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardLoad) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardLoad.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShardLoad) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardLoad.Merge(m, src)
+}
+func (m *ShardLoad) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardLoad) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardLoad.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardLoad proto.InternalMessageInfo
+
+func (m *ShardLoad) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *ShardLoad) GetRequestRates() []*ApiRequestRate {
+	if m != nil {
+		return m.RequestRates
+	}
+	return nil
+}
+
+func (m *ShardLoad) GetQueueLoads() []*QueueLoad {
+	if m != nil {
+		return m.QueueLoads
+	}
+	return nil
+}
+
+func (m *ShardLoad) GetMutableStateCacheHitRate() float64 {
+	if m != nil {
+		return m.MutableStateCacheHitRate
+	}
+	return 0
+}
+
+func (m *ShardLoad) GetTopWorkflows() []*WorkflowLoad {
+	if m != nil {
+		return m.TopWorkflows
+	}
+	return nil
+}
+
+type ApiRequestRate struct {
+	Api               string  `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	RequestsPerSecond float64 `protobuf:"fixed64,2,opt,name=requests_per_second,json=requestsPerSecond,proto3" json:"requests_per_second,omitempty"`
+}
+
+func (m *ApiRequestRate) Reset()      { *m = ApiRequestRate{} }
+func (*ApiRequestRate) ProtoMessage() {}
+func (*ApiRequestRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_670cd05c700ece14, []int{7}
+}
+func (m *ApiRequestRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApiRequestRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApiRequestRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApiRequestRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiRequestRate.Merge(m, src)
+}
+func (m *ApiRequestRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApiRequestRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiRequestRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiRequestRate proto.InternalMessageInfo
+
+func (m *ApiRequestRate) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ApiRequestRate) GetRequestsPerSecond() float64 {
+	if m != nil {
+		return m.RequestsPerSecond
+	}
+	return 0
+}
+
+type QueueLoad struct {
+	Category         string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	PendingTaskCount int64  `protobuf:"varint,2,opt,name=pending_task_count,json=pendingTaskCount,proto3" json:"pending_task_count,omitempty"`
+}
+
+func (m *QueueLoad) Reset()      { *m = QueueLoad{} }
+func (*QueueLoad) ProtoMessage() {}
+func (*QueueLoad) Descriptor() ([]byte, []int) {
+	return fileDescriptor_670cd05c700ece14, []int{8}
+}
+func (m *QueueLoad) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueLoad) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueLoad.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueueLoad) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueLoad.Merge(m, src)
+}
+func (m *QueueLoad) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueLoad) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueLoad.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueLoad proto.InternalMessageInfo
+
+func (m *QueueLoad) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
+func (m *QueueLoad) GetPendingTaskCount() int64 {
+	if m != nil {
+		return m.PendingTaskCount
+	}
+	return 0
+}
+
+type WorkflowLoad struct {
+	NamespaceId  string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId   string `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RequestCount int64  `protobuf:"varint,3,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+}
+
+func (m *WorkflowLoad) Reset()      { *m = WorkflowLoad{} }
+func (*WorkflowLoad) ProtoMessage() {}
+func (*WorkflowLoad) Descriptor() ([]byte, []int) {
+	return fileDescriptor_670cd05c700ece14, []int{9}
+}
+func (m *WorkflowLoad) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowLoad) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowLoad.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowLoad) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowLoad.Merge(m, src)
+}
+func (m *WorkflowLoad) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowLoad) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowLoad.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowLoad proto.InternalMessageInfo
+
+func (m *WorkflowLoad) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *WorkflowLoad) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *WorkflowLoad) GetRequestCount() int64 {
+	if m != nil {
+		return m.RequestCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*TransientWorkflowTaskInfo)(nil), "temporal.server.api.history.v1.TransientWorkflowTaskInfo")
 	proto.RegisterType((*VersionHistoryItem)(nil), "temporal.server.api.history.v1.VersionHistoryItem")
@@ -365,6 +607,10 @@ func init() {
 	proto.RegisterType((*VersionHistories)(nil), "temporal.server.api.history.v1.VersionHistories")
 	proto.RegisterType((*TaskKey)(nil), "temporal.server.api.history.v1.TaskKey")
 	proto.RegisterType((*TaskRange)(nil), "temporal.server.api.history.v1.TaskRange")
+	proto.RegisterType((*ShardLoad)(nil), "temporal.server.api.history.v1.ShardLoad")
+	proto.RegisterType((*ApiRequestRate)(nil), "temporal.server.api.history.v1.ApiRequestRate")
+	proto.RegisterType((*QueueLoad)(nil), "temporal.server.api.history.v1.QueueLoad")
+	proto.RegisterType((*WorkflowLoad)(nil), "temporal.server.api.history.v1.WorkflowLoad")
 }
 
 func init() {
@@ -372,43 +618,61 @@ func init() {
 }
 
 var fileDescriptor_670cd05c700ece14 = []byte{
-	// 575 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x4b, 0x1b, 0x41,
-	0x14, 0xc7, 0x33, 0x89, 0x9a, 0x38, 0xb1, 0x22, 0x53, 0xb0, 0x31, 0xd0, 0x51, 0x03, 0x52, 0x0f,
-	0x32, 0x8b, 0xe9, 0xb1, 0xf4, 0x50, 0xa1, 0x60, 0xac, 0x5e, 0xb6, 0xa1, 0x85, 0x22, 0x2c, 0x93,
-	0xe4, 0x65, 0x1d, 0x92, 0x9d, 0x09, 0x33, 0x9b, 0x34, 0x1e, 0x0a, 0xfd, 0x08, 0x7e, 0x87, 0x5e,
-	0xfa, 0x4d, 0xda, 0xa3, 0x47, 0x6f, 0xad, 0xeb, 0xa5, 0x47, 0x3f, 0x42, 0xd9, 0xd9, 0xd9, 0x04,
-	0xdb, 0x62, 0xf1, 0x36, 0xf3, 0xe6, 0xff, 0x7e, 0xef, 0xff, 0xde, 0x3e, 0x16, 0xef, 0xc5, 0x10,
-	0x8d, 0x94, 0xe6, 0x43, 0xcf, 0x80, 0x9e, 0x80, 0xf6, 0xf8, 0x48, 0x78, 0x67, 0xc2, 0xc4, 0x4a,
-	0x9f, 0x7b, 0x93, 0x7d, 0x2f, 0x02, 0x63, 0x78, 0x08, 0x6c, 0xa4, 0x55, 0xac, 0x08, 0xcd, 0xd5,
-	0x2c, 0x53, 0x33, 0x3e, 0x12, 0xcc, 0xa9, 0xd9, 0x64, 0xbf, 0xbe, 0x19, 0x2a, 0x15, 0x0e, 0xc1,
-	0xb3, 0xea, 0xce, 0xb8, 0xef, 0xc5, 0x22, 0x02, 0x13, 0xf3, 0x68, 0x94, 0x01, 0xea, 0xdb, 0x3d,
-	0x18, 0x81, 0xec, 0x81, 0xec, 0x0a, 0x30, 0x5e, 0xa8, 0x42, 0x65, 0xe3, 0xf6, 0xe4, 0x24, 0x3b,
-	0x33, 0x47, 0xf7, 0x59, 0x69, 0x8c, 0xf1, 0x46, 0x5b, 0x73, 0x69, 0x04, 0xc8, 0xf8, 0xbd, 0xd2,
-	0x83, 0xfe, 0x50, 0x7d, 0x6c, 0x73, 0x33, 0x68, 0xc9, 0xbe, 0x22, 0xc7, 0x78, 0xd5, 0x25, 0x06,
-	0x66, 0xdc, 0xef, 0x8b, 0x69, 0xad, 0xb4, 0x55, 0xda, 0xad, 0x36, 0x77, 0xd8, 0xac, 0x81, 0xbb,
-	0xce, 0xd9, 0x61, 0x76, 0x7c, 0x3d, 0x01, 0x19, 0xfb, 0x8f, 0xdc, 0xc3, 0x5b, 0x9b, 0x7b, 0xb4,
-	0x50, 0x41, 0x6b, 0xc5, 0xa3, 0x85, 0x4a, 0x71, 0xad, 0xd4, 0x68, 0x61, 0xf2, 0x0e, 0xb4, 0x11,
-	0x4a, 0xba, 0x8c, 0x56, 0x0c, 0x11, 0xd9, 0xc0, 0x15, 0x48, 0x33, 0x03, 0xd1, 0xab, 0xa1, 0x2d,
-	0xb4, 0x5b, 0xf2, 0xcb, 0xf6, 0xde, 0xea, 0x91, 0x1a, 0x2e, 0x4f, 0xb2, 0x84, 0x5a, 0x31, 0x7b,
-	0x71, 0xd7, 0xc6, 0x27, 0xbc, 0x7a, 0x17, 0x45, 0xb6, 0xf1, 0x4a, 0x47, 0x73, 0xd9, 0x3d, 0x0b,
-	0x62, 0x35, 0x00, 0x69, 0x51, 0x2b, 0x7e, 0x35, 0x8b, 0xb5, 0xd3, 0x10, 0x39, 0xc4, 0x8b, 0x22,
-	0x86, 0xc8, 0xd4, 0x8a, 0xb6, 0xa1, 0x26, 0xbb, 0xff, 0x8b, 0xb0, 0xbf, 0xcd, 0xfa, 0x19, 0xa0,
-	0xf1, 0x05, 0xe1, 0xb5, 0x3b, 0xaf, 0x02, 0x0c, 0x79, 0x85, 0x9f, 0x76, 0xc7, 0x5a, 0xa7, 0xad,
-	0x38, 0x9b, 0x41, 0x3e, 0x48, 0x21, 0x7b, 0x30, 0xb5, 0x96, 0x16, 0xfd, 0xba, 0x13, 0xfd, 0x41,
-	0x4f, 0x15, 0xe4, 0x18, 0x2f, 0x9f, 0xe5, 0x3c, 0xe7, 0x92, 0x3d, 0xcc, 0xa5, 0x3f, 0x07, 0x34,
-	0x38, 0x2e, 0xa7, 0x5f, 0xf5, 0x0d, 0x9c, 0x93, 0x27, 0xb8, 0x1c, 0x73, 0x33, 0x98, 0xcf, 0x78,
-	0x29, 0xbd, 0xb6, 0x7a, 0xe4, 0x25, 0x5e, 0xee, 0x0b, 0x0d, 0x41, 0xba, 0x6c, 0x76, 0xc8, 0xd5,
-	0x66, 0x9d, 0x65, 0x9b, 0xc8, 0xf2, 0x4d, 0x64, 0xed, 0x7c, 0x13, 0x0f, 0x16, 0x2e, 0x7e, 0x6c,
-	0x22, 0xbf, 0x92, 0xa6, 0xa4, 0xc1, 0xc6, 0x37, 0x84, 0x97, 0xd3, 0x1a, 0x3e, 0x97, 0x21, 0x90,
-	0x53, 0xbc, 0x2e, 0x64, 0x77, 0x38, 0x36, 0x62, 0x02, 0x41, 0x24, 0x64, 0x60, 0x6b, 0x0e, 0xe0,
-	0xdc, 0x16, 0xad, 0x36, 0x9f, 0xfd, 0xaf, 0x17, 0x67, 0xd7, 0x7f, 0x3c, 0xc3, 0x9c, 0x08, 0x99,
-	0xf7, 0x70, 0x8a, 0xd7, 0x61, 0x3a, 0xa3, 0xf3, 0xe9, 0x9c, 0x5e, 0x7c, 0x20, 0x7d, 0x86, 0x39,
-	0xe1, 0x53, 0x17, 0x3c, 0xe8, 0x5c, 0x5e, 0xd3, 0xc2, 0xd5, 0x35, 0x2d, 0xdc, 0x5e, 0x53, 0xf4,
-	0x39, 0xa1, 0xe8, 0x6b, 0x42, 0xd1, 0xf7, 0x84, 0xa2, 0xcb, 0x84, 0xa2, 0x9f, 0x09, 0x45, 0xbf,
-	0x12, 0x5a, 0xb8, 0x4d, 0x28, 0xba, 0xb8, 0xa1, 0x85, 0xcb, 0x1b, 0x5a, 0xb8, 0xba, 0xa1, 0x85,
-	0x0f, 0x7b, 0xa1, 0x9a, 0x57, 0x15, 0xea, 0xdf, 0x3f, 0x82, 0x17, 0xee, 0xd8, 0x59, 0xb2, 0x13,
-	0x7d, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x70, 0x3d, 0xee, 0x87, 0x39, 0x04, 0x00, 0x00,
+	// 851 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x49, 0x6d, 0x8f, 0xdd, 0xc8, 0x4c, 0xa5, 0xe2, 0x5a, 0xb0, 0x49, 0x17, 0x55,
+	0x04, 0x29, 0x5a, 0xab, 0xe1, 0x88, 0x40, 0x6a, 0x2b, 0xa4, 0x38, 0xa4, 0x12, 0x1d, 0x07, 0x90,
+	0x50, 0xa5, 0xd5, 0x78, 0xf7, 0xd9, 0x1e, 0xd9, 0x3b, 0xb3, 0x99, 0x99, 0x75, 0x9c, 0x03, 0x12,
+	0x3f, 0xa1, 0xff, 0x81, 0x0b, 0xff, 0x04, 0x8e, 0x39, 0x56, 0x5c, 0x20, 0xce, 0x85, 0x63, 0x7f,
+	0x02, 0x9a, 0xd9, 0x59, 0x9b, 0x00, 0x6a, 0xe8, 0x6d, 0xde, 0x7b, 0xdf, 0xfb, 0xde, 0xf7, 0xde,
+	0xbc, 0x9d, 0x45, 0x07, 0x1a, 0xd2, 0x4c, 0x48, 0x3a, 0xef, 0x2b, 0x90, 0x0b, 0x90, 0x7d, 0x9a,
+	0xb1, 0xfe, 0x94, 0x29, 0x2d, 0xe4, 0x45, 0x7f, 0xf1, 0xb8, 0x9f, 0x82, 0x52, 0x74, 0x02, 0x61,
+	0x26, 0x85, 0x16, 0xd8, 0x2f, 0xd1, 0x61, 0x81, 0x0e, 0x69, 0xc6, 0x42, 0x87, 0x0e, 0x17, 0x8f,
+	0x7b, 0xbb, 0x13, 0x21, 0x26, 0x73, 0xe8, 0x5b, 0xf4, 0x28, 0x1f, 0xf7, 0x35, 0x4b, 0x41, 0x69,
+	0x9a, 0x66, 0x05, 0x41, 0xef, 0x61, 0x02, 0x19, 0xf0, 0x04, 0x78, 0xcc, 0x40, 0xf5, 0x27, 0x62,
+	0x22, 0xac, 0xdf, 0x9e, 0x1c, 0xe4, 0xd1, 0x5a, 0xd1, 0xdb, 0xa4, 0x04, 0x39, 0x7a, 0x70, 0x2a,
+	0x29, 0x57, 0x0c, 0xb8, 0xfe, 0x4e, 0xc8, 0xd9, 0x78, 0x2e, 0xce, 0x4f, 0xa9, 0x9a, 0x0d, 0xf8,
+	0x58, 0xe0, 0x13, 0xb4, 0xe3, 0x12, 0x23, 0x95, 0x8f, 0xc7, 0x6c, 0xd9, 0xad, 0xed, 0xd5, 0xf6,
+	0x5b, 0x87, 0x8f, 0xc2, 0x75, 0x03, 0x37, 0x95, 0x87, 0x47, 0xc5, 0xf1, 0xcb, 0x05, 0x70, 0x4d,
+	0xee, 0xba, 0xc0, 0xd0, 0xe6, 0x1e, 0x6f, 0x35, 0xbc, 0x4e, 0xf5, 0x78, 0xab, 0x51, 0xed, 0xd4,
+	0x82, 0x01, 0xc2, 0xdf, 0x82, 0x54, 0x4c, 0x70, 0x97, 0x31, 0xd0, 0x90, 0xe2, 0x07, 0xa8, 0x01,
+	0x26, 0x33, 0x62, 0x49, 0xd7, 0xdb, 0xf3, 0xf6, 0x6b, 0xa4, 0x6e, 0xed, 0x41, 0x82, 0xbb, 0xa8,
+	0xbe, 0x28, 0x12, 0xba, 0xd5, 0x22, 0xe2, 0xcc, 0xe0, 0x07, 0xb4, 0x73, 0x93, 0x0a, 0x3f, 0x44,
+	0xed, 0x91, 0xa4, 0x3c, 0x9e, 0x46, 0x5a, 0xcc, 0x80, 0x5b, 0xaa, 0x36, 0x69, 0x15, 0xbe, 0x53,
+	0xe3, 0xc2, 0x47, 0x68, 0x9b, 0x69, 0x48, 0x55, 0xb7, 0x6a, 0x1b, 0x3a, 0x0c, 0xdf, 0x7e, 0x23,
+	0xe1, 0xbf, 0xc5, 0x92, 0x82, 0x20, 0xf8, 0xc9, 0x43, 0x9d, 0x1b, 0x51, 0x06, 0x0a, 0x3f, 0x41,
+	0x1f, 0xc6, 0xb9, 0x94, 0xa6, 0x15, 0x27, 0x33, 0x2a, 0x07, 0xc9, 0x78, 0x02, 0x4b, 0x2b, 0x69,
+	0x9b, 0xf4, 0x1c, 0xe8, 0x1f, 0xec, 0x06, 0x81, 0x4f, 0x50, 0x73, 0x5a, 0xf2, 0x39, 0x95, 0xe1,
+	0xbb, 0xa9, 0x24, 0x1b, 0x82, 0x80, 0xa2, 0xba, 0xb9, 0xd5, 0xaf, 0xe0, 0x02, 0xbf, 0x8f, 0xea,
+	0x9a, 0xaa, 0xd9, 0x66, 0xc6, 0x77, 0x8c, 0x39, 0x48, 0xf0, 0xe7, 0xa8, 0x39, 0x66, 0x12, 0x22,
+	0xb3, 0x6c, 0x76, 0xc8, 0xad, 0xc3, 0x5e, 0x58, 0x6c, 0x62, 0x58, 0x6e, 0x62, 0x78, 0x5a, 0x6e,
+	0xe2, 0xd3, 0xad, 0x57, 0xbf, 0xef, 0x7a, 0xa4, 0x61, 0x52, 0x8c, 0x33, 0xf8, 0xc5, 0x43, 0x4d,
+	0x53, 0x83, 0x50, 0x3e, 0x01, 0xfc, 0x12, 0xdd, 0x67, 0x3c, 0x9e, 0xe7, 0x8a, 0x2d, 0x20, 0x4a,
+	0x19, 0x8f, 0x6c, 0xcd, 0x19, 0x5c, 0xd8, 0xa2, 0xad, 0xc3, 0x8f, 0x6f, 0xeb, 0xc5, 0xc9, 0x25,
+	0xf7, 0xd6, 0x34, 0xcf, 0x19, 0x2f, 0x7b, 0x78, 0x89, 0xee, 0xc3, 0x72, 0xcd, 0x4e, 0x97, 0x1b,
+	0xf6, 0xea, 0x3b, 0xb2, 0xaf, 0x69, 0x9e, 0xd3, 0xa5, 0x73, 0x06, 0xbf, 0x55, 0x51, 0x73, 0x38,
+	0xa5, 0x32, 0x39, 0x11, 0x34, 0x31, 0x4b, 0xa9, 0x8c, 0x51, 0x0e, 0x6c, 0x9b, 0xd4, 0xad, 0x3d,
+	0x48, 0xf0, 0x10, 0xdd, 0x95, 0x70, 0x96, 0x83, 0xd2, 0x91, 0xa4, 0xfa, 0xff, 0xdf, 0xd3, 0x93,
+	0x8c, 0x91, 0x22, 0x8f, 0x50, 0x0d, 0xa4, 0x2d, 0x37, 0x86, 0xc2, 0xc7, 0xa8, 0x75, 0x96, 0x43,
+	0x0e, 0xd1, 0x5c, 0xd0, 0x44, 0xb9, 0x2f, 0xee, 0x93, 0xdb, 0x28, 0x5f, 0x98, 0x14, 0xa3, 0x97,
+	0xa0, 0xb3, 0xf2, 0xa8, 0xf0, 0x17, 0xe8, 0x83, 0x34, 0xd7, 0x74, 0x34, 0x87, 0x48, 0x69, 0xaa,
+	0x21, 0x8a, 0x69, 0x3c, 0x85, 0x68, 0xca, 0x0a, 0xc1, 0xdd, 0xad, 0x3d, 0x6f, 0xdf, 0x23, 0x5d,
+	0x87, 0x19, 0x1a, 0xc8, 0x33, 0x83, 0x38, 0x62, 0x56, 0x0c, 0x7e, 0x81, 0xee, 0x6a, 0x91, 0x45,
+	0xe7, 0xee, 0x61, 0x50, 0xdd, 0x6d, 0xab, 0xe6, 0xe0, 0x36, 0x35, 0xe5, 0x4b, 0x62, 0x05, 0xb5,
+	0xb5, 0xc8, 0x4a, 0x87, 0x0a, 0x08, 0xda, 0xb9, 0xd9, 0x3e, 0xee, 0xa0, 0x1a, 0xcd, 0x98, 0x9d,
+	0x6d, 0x93, 0x98, 0x23, 0x0e, 0xd1, 0x3d, 0x37, 0x12, 0x15, 0x65, 0x20, 0x23, 0x05, 0xb1, 0xe0,
+	0x89, 0xbd, 0x5b, 0x8f, 0xbc, 0x57, 0x86, 0xbe, 0x06, 0x39, 0xb4, 0x81, 0xe0, 0x1b, 0xd4, 0x5c,
+	0xf7, 0x8f, 0x7b, 0xa8, 0x11, 0x53, 0x0d, 0x13, 0x21, 0x2f, 0x1c, 0xe7, 0xda, 0xc6, 0x07, 0x08,
+	0x9b, 0x77, 0x93, 0xf1, 0x49, 0xb1, 0x31, 0xb1, 0xc8, 0xb9, 0x76, 0x0f, 0x4a, 0xc7, 0x45, 0xcc,
+	0x16, 0x3c, 0x33, 0xfe, 0xe0, 0x1c, 0xb5, 0xff, 0xde, 0x88, 0x79, 0x57, 0x38, 0x4d, 0x41, 0x65,
+	0x34, 0x86, 0x72, 0x1b, 0x9a, 0xa4, 0xb5, 0xf6, 0x0d, 0x12, 0xbc, 0x8b, 0x5a, 0xe5, 0xb0, 0x0c,
+	0xa2, 0x6a, 0x11, 0xa8, 0x74, 0x0d, 0x12, 0xfc, 0xd1, 0x66, 0x65, 0x8a, 0xe2, 0x35, 0x5b, 0xbc,
+	0x5c, 0x01, 0x5b, 0xf8, 0xe9, 0xe8, 0xf2, 0xca, 0xaf, 0xbc, 0xbe, 0xf2, 0x2b, 0x6f, 0xae, 0x7c,
+	0xef, 0xc7, 0x95, 0xef, 0xfd, 0xbc, 0xf2, 0xbd, 0x5f, 0x57, 0xbe, 0x77, 0xb9, 0xf2, 0xbd, 0x3f,
+	0x56, 0xbe, 0xf7, 0xe7, 0xca, 0xaf, 0xbc, 0x59, 0xf9, 0xde, 0xab, 0x6b, 0xbf, 0x72, 0x79, 0xed,
+	0x57, 0x5e, 0x5f, 0xfb, 0x95, 0xef, 0x0f, 0x26, 0x62, 0x73, 0x2f, 0x4c, 0xfc, 0xf7, 0x9f, 0xe8,
+	0x33, 0x77, 0x1c, 0xdd, 0xb1, 0x9f, 0xf4, 0xa7, 0x7f, 0x0d, 0x00, 0xf9, 0x42, 0xf3, 0x42, 0xba,
+	0x06, 0x00, 0x00,
 }
 
 func (this *TransientWorkflowTaskInfo) Equal(that interface{}) bool {
@@ -589,6 +853,141 @@ func (this *TaskRange) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ShardLoad) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShardLoad)
+	if !ok {
+		that2, ok := that.(ShardLoad)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if len(this.RequestRates) != len(that1.RequestRates) {
+		return false
+	}
+	for i := range this.RequestRates {
+		if !this.RequestRates[i].Equal(that1.RequestRates[i]) {
+			return false
+		}
+	}
+	if len(this.QueueLoads) != len(that1.QueueLoads) {
+		return false
+	}
+	for i := range this.QueueLoads {
+		if !this.QueueLoads[i].Equal(that1.QueueLoads[i]) {
+			return false
+		}
+	}
+	if this.MutableStateCacheHitRate != that1.MutableStateCacheHitRate {
+		return false
+	}
+	if len(this.TopWorkflows) != len(that1.TopWorkflows) {
+		return false
+	}
+	for i := range this.TopWorkflows {
+		if !this.TopWorkflows[i].Equal(that1.TopWorkflows[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ApiRequestRate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApiRequestRate)
+	if !ok {
+		that2, ok := that.(ApiRequestRate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Api != that1.Api {
+		return false
+	}
+	if this.RequestsPerSecond != that1.RequestsPerSecond {
+		return false
+	}
+	return true
+}
+func (this *QueueLoad) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueueLoad)
+	if !ok {
+		that2, ok := that.(QueueLoad)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Category != that1.Category {
+		return false
+	}
+	if this.PendingTaskCount != that1.PendingTaskCount {
+		return false
+	}
+	return true
+}
+func (this *WorkflowLoad) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WorkflowLoad)
+	if !ok {
+		that2, ok := that.(WorkflowLoad)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.WorkflowId != that1.WorkflowId {
+		return false
+	}
+	if this.RequestCount != that1.RequestCount {
+		return false
+	}
+	return true
+}
 func (this *TransientWorkflowTaskInfo) GoString() string {
 	if this == nil {
 		return "nil"
@@ -664,13 +1063,67 @@ func (this *TaskRange) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func valueToGoStringMessage(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *ShardLoad) GoString() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	s := make([]string, 0, 9)
+	s = append(s, "&history.ShardLoad{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	if this.RequestRates != nil {
+		s = append(s, "RequestRates: "+fmt.Sprintf("%#v", this.RequestRates)+",\n")
+	}
+	if this.QueueLoads != nil {
+		s = append(s, "QueueLoads: "+fmt.Sprintf("%#v", this.QueueLoads)+",\n")
+	}
+	s = append(s, "MutableStateCacheHitRate: "+fmt.Sprintf("%#v", this.MutableStateCacheHitRate)+",\n")
+	if this.TopWorkflows != nil {
+		s = append(s, "TopWorkflows: "+fmt.Sprintf("%#v", this.TopWorkflows)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ApiRequestRate) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&history.ApiRequestRate{")
+	s = append(s, "Api: "+fmt.Sprintf("%#v", this.Api)+",\n")
+	s = append(s, "RequestsPerSecond: "+fmt.Sprintf("%#v", this.RequestsPerSecond)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *QueueLoad) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&history.QueueLoad{")
+	s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
+	s = append(s, "PendingTaskCount: "+fmt.Sprintf("%#v", this.PendingTaskCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WorkflowLoad) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&history.WorkflowLoad{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RequestCount: "+fmt.Sprintf("%#v", this.RequestCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringMessage(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
 }
 func (m *TransientWorkflowTaskInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -913,6 +1366,195 @@ func (m *TaskRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ShardLoad) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardLoad) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardLoad) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TopWorkflows) > 0 {
+		for iNdEx := len(m.TopWorkflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopWorkflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MutableStateCacheHitRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MutableStateCacheHitRate))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if len(m.QueueLoads) > 0 {
+		for iNdEx := len(m.QueueLoads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueueLoads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RequestRates) > 0 {
+		for iNdEx := len(m.RequestRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequestRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApiRequestRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApiRequestRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApiRequestRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequestsPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RequestsPerSecond))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.Api) > 0 {
+		i -= len(m.Api)
+		copy(dAtA[i:], m.Api)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Api)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueLoad) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueLoad) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueLoad) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingTaskCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.PendingTaskCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowLoad) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowLoad) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowLoad) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequestCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.RequestCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessage(v)
 	base := offset
@@ -1024,6 +1666,91 @@ func (m *TaskRange) Size() (n int) {
 	return n
 }
 
+func (m *ShardLoad) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovMessage(uint64(m.ShardId))
+	}
+	if len(m.RequestRates) > 0 {
+		for _, e := range m.RequestRates {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.QueueLoads) > 0 {
+		for _, e := range m.QueueLoads {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.MutableStateCacheHitRate != 0 {
+		n += 9
+	}
+	if len(m.TopWorkflows) > 0 {
+		for _, e := range m.TopWorkflows {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ApiRequestRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Api)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.RequestsPerSecond != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *QueueLoad) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.PendingTaskCount != 0 {
+		n += 1 + sovMessage(uint64(m.PendingTaskCount))
+	}
+	return n
+}
+
+func (m *WorkflowLoad) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.RequestCount != 0 {
+		n += 1 + sovMessage(uint64(m.RequestCount))
+	}
+	return n
+}
+
 func sovMessage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1110,6 +1837,69 @@ func (this *TaskRange) String() string {
 	}, "")
 	return s
 }
+func (this *ShardLoad) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRequestRates := "[]*ApiRequestRate{"
+	for _, f := range this.RequestRates {
+		repeatedStringForRequestRates += strings.Replace(f.String(), "ApiRequestRate", "ApiRequestRate", 1) + ","
+	}
+	repeatedStringForRequestRates += "}"
+	repeatedStringForQueueLoads := "[]*QueueLoad{"
+	for _, f := range this.QueueLoads {
+		repeatedStringForQueueLoads += strings.Replace(f.String(), "QueueLoad", "QueueLoad", 1) + ","
+	}
+	repeatedStringForQueueLoads += "}"
+	repeatedStringForTopWorkflows := "[]*WorkflowLoad{"
+	for _, f := range this.TopWorkflows {
+		repeatedStringForTopWorkflows += strings.Replace(f.String(), "WorkflowLoad", "WorkflowLoad", 1) + ","
+	}
+	repeatedStringForTopWorkflows += "}"
+	s := strings.Join([]string{`&ShardLoad{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`RequestRates:` + repeatedStringForRequestRates + `,`,
+		`QueueLoads:` + repeatedStringForQueueLoads + `,`,
+		`MutableStateCacheHitRate:` + fmt.Sprintf("%v", this.MutableStateCacheHitRate) + `,`,
+		`TopWorkflows:` + repeatedStringForTopWorkflows + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApiRequestRate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApiRequestRate{`,
+		`Api:` + fmt.Sprintf("%v", this.Api) + `,`,
+		`RequestsPerSecond:` + fmt.Sprintf("%v", this.RequestsPerSecond) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueLoad) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueLoad{`,
+		`Category:` + fmt.Sprintf("%v", this.Category) + `,`,
+		`PendingTaskCount:` + fmt.Sprintf("%v", this.PendingTaskCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowLoad) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowLoad{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RequestCount:` + fmt.Sprintf("%v", this.RequestCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1149,7 +1939,431 @@ func (m *TransientWorkflowTaskInfo) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistorySuffix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySuffix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistorySuffix = append(m.HistorySuffix, &v1.HistoryEvent{})
+			if err := m.HistorySuffix[len(m.HistorySuffix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionHistoryItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionHistoryItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionHistoryItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventId", wireType)
+			}
+			m.EventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.BranchToken == nil {
+				m.BranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &VersionHistoryItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionHistories) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionHistories: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionHistories: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentVersionHistoryIndex", wireType)
+			}
+			m.CurrentVersionHistoryIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentVersionHistoryIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Histories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Histories = append(m.Histories, &VersionHistory{})
+			if err := m.Histories[len(m.Histories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FireTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1176,8 +2390,10 @@ func (m *TransientWorkflowTaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HistorySuffix = append(m.HistorySuffix, &v1.HistoryEvent{})
-			if err := m.HistorySuffix[len(m.HistorySuffix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FireTime == nil {
+				m.FireTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FireTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1205,7 +2421,7 @@ func (m *TransientWorkflowTaskInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VersionHistoryItem) Unmarshal(dAtA []byte) error {
+func (m *TaskRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1228,17 +2444,17 @@ func (m *VersionHistoryItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VersionHistoryItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VersionHistoryItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveMinTaskKey", wireType)
 			}
-			m.EventId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -1248,16 +2464,33 @@ func (m *VersionHistoryItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InclusiveMinTaskKey == nil {
+				m.InclusiveMinTaskKey = &TaskKey{}
+			}
+			if err := m.InclusiveMinTaskKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExclusiveMaxTaskKey", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -1267,11 +2500,28 @@ func (m *VersionHistoryItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExclusiveMaxTaskKey == nil {
+				m.ExclusiveMaxTaskKey = &TaskKey{}
+			}
+			if err := m.ExclusiveMaxTaskKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -1296,7 +2546,7 @@ func (m *VersionHistoryItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VersionHistory) Unmarshal(dAtA []byte) error {
+func (m *ShardLoad) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1319,17 +2569,36 @@ func (m *VersionHistory) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VersionHistory: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShardLoad: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VersionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShardLoad: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestRates", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -1339,29 +2608,29 @@ func (m *VersionHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.BranchToken == nil {
-				m.BranchToken = []byte{}
+			m.RequestRates = append(m.RequestRates, &ApiRequestRate{})
+			if err := m.RequestRates[len(m.RequestRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueLoads", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1388,8 +2657,53 @@ func (m *VersionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &VersionHistoryItem{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.QueueLoads = append(m.QueueLoads, &QueueLoad{})
+			if err := m.QueueLoads[len(m.QueueLoads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MutableStateCacheHitRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MutableStateCacheHitRate = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopWorkflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopWorkflows = append(m.TopWorkflows, &WorkflowLoad{})
+			if err := m.TopWorkflows[len(m.TopWorkflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1417,7 +2731,7 @@ func (m *VersionHistory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VersionHistories) Unmarshal(dAtA []byte) error {
+func (m *ApiRequestRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1440,36 +2754,17 @@ func (m *VersionHistories) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VersionHistories: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApiRequestRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VersionHistories: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApiRequestRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentVersionHistoryIndex", wireType)
-			}
-			m.CurrentVersionHistoryIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentVersionHistoryIndex |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Histories", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Api", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -1479,26 +2774,35 @@ func (m *VersionHistories) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Histories = append(m.Histories, &VersionHistory{})
-			if err := m.Histories[len(m.Histories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Api = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestsPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RequestsPerSecond = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -1523,7 +2827,7 @@ func (m *VersionHistories) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskKey) Unmarshal(dAtA []byte) error {
+func (m *QueueLoad) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1546,17 +2850,17 @@ func (m *TaskKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueueLoad: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueueLoad: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
-			m.TaskId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -1566,16 +2870,29 @@ func (m *TaskKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FireTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTaskCount", wireType)
 			}
-			var msglen int
+			m.PendingTaskCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -1585,28 +2902,11 @@ func (m *TaskKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PendingTaskCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FireTime == nil {
-				m.FireTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FireTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -1631,7 +2931,7 @@ func (m *TaskKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskRange) Unmarshal(dAtA []byte) error {
+func (m *WorkflowLoad) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1654,17 +2954,17 @@ func (m *TaskRange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskRange: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowLoad: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowLoad: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveMinTaskKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -1674,33 +2974,29 @@ func (m *TaskRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InclusiveMinTaskKey == nil {
-				m.InclusiveMinTaskKey = &TaskKey{}
-			}
-			if err := m.InclusiveMinTaskKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExclusiveMaxTaskKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -1710,28 +3006,43 @@ func (m *TaskRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExclusiveMaxTaskKey == nil {
-				m.ExclusiveMaxTaskKey = &TaskKey{}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestCount", wireType)
 			}
-			if err := m.ExclusiveMaxTaskKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RequestCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -2022,6 +2022,102 @@ func (m *RemoveSignalMutableStateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RemoveSignalMutableStateResponse proto.InternalMessageInfo
 
+type UpsertWorkflowSearchAttributesRequest struct {
+	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowExecution *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	SearchAttributes  *v14.SearchAttributes  `protobuf:"bytes,3,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+}
+
+func (m *UpsertWorkflowSearchAttributesRequest) Reset()      { *m = UpsertWorkflowSearchAttributesRequest{} }
+func (*UpsertWorkflowSearchAttributesRequest) ProtoMessage() {}
+func (*UpsertWorkflowSearchAttributesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{30}
+}
+func (m *UpsertWorkflowSearchAttributesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpsertWorkflowSearchAttributesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpsertWorkflowSearchAttributesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpsertWorkflowSearchAttributesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertWorkflowSearchAttributesRequest.Merge(m, src)
+}
+func (m *UpsertWorkflowSearchAttributesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpsertWorkflowSearchAttributesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertWorkflowSearchAttributesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertWorkflowSearchAttributesRequest proto.InternalMessageInfo
+
+func (m *UpsertWorkflowSearchAttributesRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *UpsertWorkflowSearchAttributesRequest) GetWorkflowExecution() *v14.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *UpsertWorkflowSearchAttributesRequest) GetSearchAttributes() *v14.SearchAttributes {
+	if m != nil {
+		return m.SearchAttributes
+	}
+	return nil
+}
+
+type UpsertWorkflowSearchAttributesResponse struct {
+}
+
+func (m *UpsertWorkflowSearchAttributesResponse) Reset() {
+	*m = UpsertWorkflowSearchAttributesResponse{}
+}
+func (*UpsertWorkflowSearchAttributesResponse) ProtoMessage() {}
+func (*UpsertWorkflowSearchAttributesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{31}
+}
+func (m *UpsertWorkflowSearchAttributesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpsertWorkflowSearchAttributesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpsertWorkflowSearchAttributesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpsertWorkflowSearchAttributesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertWorkflowSearchAttributesResponse.Merge(m, src)
+}
+func (m *UpsertWorkflowSearchAttributesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpsertWorkflowSearchAttributesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertWorkflowSearchAttributesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertWorkflowSearchAttributesResponse proto.InternalMessageInfo
+
 type TerminateWorkflowExecutionRequest struct {
 	NamespaceId               string                                `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	TerminateRequest          *v1.TerminateWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=terminate_request,json=terminateRequest,proto3" json:"terminate_request,omitempty"`
@@ -2032,7 +2128,7 @@ type TerminateWorkflowExecutionRequest struct {
 func (m *TerminateWorkflowExecutionRequest) Reset()      { *m = TerminateWorkflowExecutionRequest{} }
 func (*TerminateWorkflowExecutionRequest) ProtoMessage() {}
 func (*TerminateWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{30}
+	return fileDescriptor_b8c78c1d460a3711, []int{32}
 }
 func (m *TerminateWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2095,7 +2191,7 @@ type TerminateWorkflowExecutionResponse struct {
 func (m *TerminateWorkflowExecutionResponse) Reset()      { *m = TerminateWorkflowExecutionResponse{} }
 func (*TerminateWorkflowExecutionResponse) ProtoMessage() {}
 func (*TerminateWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{31}
+	return fileDescriptor_b8c78c1d460a3711, []int{33}
 }
 func (m *TerminateWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2134,7 +2230,7 @@ type DeleteWorkflowExecutionRequest struct {
 func (m *DeleteWorkflowExecutionRequest) Reset()      { *m = DeleteWorkflowExecutionRequest{} }
 func (*DeleteWorkflowExecutionRequest) ProtoMessage() {}
 func (*DeleteWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{32}
+	return fileDescriptor_b8c78c1d460a3711, []int{34}
 }
 func (m *DeleteWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2197,7 +2293,7 @@ type DeleteWorkflowExecutionResponse struct {
 func (m *DeleteWorkflowExecutionResponse) Reset()      { *m = DeleteWorkflowExecutionResponse{} }
 func (*DeleteWorkflowExecutionResponse) ProtoMessage() {}
 func (*DeleteWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{33}
+	return fileDescriptor_b8c78c1d460a3711, []int{35}
 }
 func (m *DeleteWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2234,7 +2330,7 @@ type ResetWorkflowExecutionRequest struct {
 func (m *ResetWorkflowExecutionRequest) Reset()      { *m = ResetWorkflowExecutionRequest{} }
 func (*ResetWorkflowExecutionRequest) ProtoMessage() {}
 func (*ResetWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{34}
+	return fileDescriptor_b8c78c1d460a3711, []int{36}
 }
 func (m *ResetWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2284,7 +2380,7 @@ type ResetWorkflowExecutionResponse struct {
 func (m *ResetWorkflowExecutionResponse) Reset()      { *m = ResetWorkflowExecutionResponse{} }
 func (*ResetWorkflowExecutionResponse) ProtoMessage() {}
 func (*ResetWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{35}
+	return fileDescriptor_b8c78c1d460a3711, []int{37}
 }
 func (m *ResetWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2331,7 +2427,7 @@ type RequestCancelWorkflowExecutionRequest struct {
 func (m *RequestCancelWorkflowExecutionRequest) Reset()      { *m = RequestCancelWorkflowExecutionRequest{} }
 func (*RequestCancelWorkflowExecutionRequest) ProtoMessage() {}
 func (*RequestCancelWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{36}
+	return fileDescriptor_b8c78c1d460a3711, []int{38}
 }
 func (m *RequestCancelWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2403,7 +2499,7 @@ func (m *RequestCancelWorkflowExecutionResponse) Reset() {
 }
 func (*RequestCancelWorkflowExecutionResponse) ProtoMessage() {}
 func (*RequestCancelWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{37}
+	return fileDescriptor_b8c78c1d460a3711, []int{39}
 }
 func (m *RequestCancelWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2442,7 +2538,7 @@ type ScheduleWorkflowTaskRequest struct {
 func (m *ScheduleWorkflowTaskRequest) Reset()      { *m = ScheduleWorkflowTaskRequest{} }
 func (*ScheduleWorkflowTaskRequest) ProtoMessage() {}
 func (*ScheduleWorkflowTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{38}
+	return fileDescriptor_b8c78c1d460a3711, []int{40}
 }
 func (m *ScheduleWorkflowTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2505,7 +2601,7 @@ type ScheduleWorkflowTaskResponse struct {
 func (m *ScheduleWorkflowTaskResponse) Reset()      { *m = ScheduleWorkflowTaskResponse{} }
 func (*ScheduleWorkflowTaskResponse) ProtoMessage() {}
 func (*ScheduleWorkflowTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{39}
+	return fileDescriptor_b8c78c1d460a3711, []int{41}
 }
 func (m *ScheduleWorkflowTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2545,7 +2641,7 @@ func (m *VerifyFirstWorkflowTaskScheduledRequest) Reset() {
 }
 func (*VerifyFirstWorkflowTaskScheduledRequest) ProtoMessage() {}
 func (*VerifyFirstWorkflowTaskScheduledRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{40}
+	return fileDescriptor_b8c78c1d460a3711, []int{42}
 }
 func (m *VerifyFirstWorkflowTaskScheduledRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2603,7 +2699,7 @@ func (m *VerifyFirstWorkflowTaskScheduledResponse) Reset() {
 }
 func (*VerifyFirstWorkflowTaskScheduledResponse) ProtoMessage() {}
 func (*VerifyFirstWorkflowTaskScheduledResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{41}
+	return fileDescriptor_b8c78c1d460a3711, []int{43}
 }
 func (m *VerifyFirstWorkflowTaskScheduledResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2632,7 +2728,6 @@ func (m *VerifyFirstWorkflowTaskScheduledResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VerifyFirstWorkflowTaskScheduledResponse proto.InternalMessageInfo
 
-// *
 // RecordChildExecutionCompletedRequest is used for reporting the completion of child execution to parent workflow
 // execution which started it.  When a child execution is completed it creates this request and calls the
 // RecordChildExecutionCompleted API with the workflowExecution of parent.  It also sets the completedExecution of the
@@ -2651,7 +2746,7 @@ type RecordChildExecutionCompletedRequest struct {
 func (m *RecordChildExecutionCompletedRequest) Reset()      { *m = RecordChildExecutionCompletedRequest{} }
 func (*RecordChildExecutionCompletedRequest) ProtoMessage() {}
 func (*RecordChildExecutionCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{42}
+	return fileDescriptor_b8c78c1d460a3711, []int{44}
 }
 func (m *RecordChildExecutionCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2735,7 +2830,7 @@ type RecordChildExecutionCompletedResponse struct {
 func (m *RecordChildExecutionCompletedResponse) Reset()      { *m = RecordChildExecutionCompletedResponse{} }
 func (*RecordChildExecutionCompletedResponse) ProtoMessage() {}
 func (*RecordChildExecutionCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{43}
+	return fileDescriptor_b8c78c1d460a3711, []int{45}
 }
 func (m *RecordChildExecutionCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2778,7 +2873,7 @@ func (m *VerifyChildExecutionCompletionRecordedRequest) Reset() {
 }
 func (*VerifyChildExecutionCompletionRecordedRequest) ProtoMessage() {}
 func (*VerifyChildExecutionCompletionRecordedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{44}
+	return fileDescriptor_b8c78c1d460a3711, []int{46}
 }
 func (m *VerifyChildExecutionCompletionRecordedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2857,7 +2952,7 @@ func (m *VerifyChildExecutionCompletionRecordedResponse) Reset() {
 }
 func (*VerifyChildExecutionCompletionRecordedResponse) ProtoMessage() {}
 func (*VerifyChildExecutionCompletionRecordedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{45}
+	return fileDescriptor_b8c78c1d460a3711, []int{47}
 }
 func (m *VerifyChildExecutionCompletionRecordedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2894,7 +2989,7 @@ type DescribeWorkflowExecutionRequest struct {
 func (m *DescribeWorkflowExecutionRequest) Reset()      { *m = DescribeWorkflowExecutionRequest{} }
 func (*DescribeWorkflowExecutionRequest) ProtoMessage() {}
 func (*DescribeWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{46}
+	return fileDescriptor_b8c78c1d460a3711, []int{48}
 }
 func (m *DescribeWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2948,7 +3043,7 @@ type DescribeWorkflowExecutionResponse struct {
 func (m *DescribeWorkflowExecutionResponse) Reset()      { *m = DescribeWorkflowExecutionResponse{} }
 func (*DescribeWorkflowExecutionResponse) ProtoMessage() {}
 func (*DescribeWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{47}
+	return fileDescriptor_b8c78c1d460a3711, []int{49}
 }
 func (m *DescribeWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3024,7 +3119,7 @@ type ReplicateEventsV2Request struct {
 func (m *ReplicateEventsV2Request) Reset()      { *m = ReplicateEventsV2Request{} }
 func (*ReplicateEventsV2Request) ProtoMessage() {}
 func (*ReplicateEventsV2Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{48}
+	return fileDescriptor_b8c78c1d460a3711, []int{50}
 }
 func (m *ReplicateEventsV2Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3096,7 +3191,7 @@ type ReplicateWorkflowStateRequest struct {
 func (m *ReplicateWorkflowStateRequest) Reset()      { *m = ReplicateWorkflowStateRequest{} }
 func (*ReplicateWorkflowStateRequest) ProtoMessage() {}
 func (*ReplicateWorkflowStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{49}
+	return fileDescriptor_b8c78c1d460a3711, []int{51}
 }
 func (m *ReplicateWorkflowStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3145,7 +3240,7 @@ type ReplicateEventsV2Response struct {
 func (m *ReplicateEventsV2Response) Reset()      { *m = ReplicateEventsV2Response{} }
 func (*ReplicateEventsV2Response) ProtoMessage() {}
 func (*ReplicateEventsV2Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{50}
+	return fileDescriptor_b8c78c1d460a3711, []int{52}
 }
 func (m *ReplicateEventsV2Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3174,6 +3269,110 @@ func (m *ReplicateEventsV2Response) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ReplicateEventsV2Response proto.InternalMessageInfo
 
+type ImportWorkflowExecutionRequest struct {
+	NamespaceId string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Execution   *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	// History batches in event order. Batches already written are skipped.
+	HistoryBatches []*v14.DataBlob `protobuf:"bytes,3,rep,name=history_batches,json=historyBatches,proto3" json:"history_batches,omitempty"`
+	// Version history of the branch the history batches belong to.
+	VersionHistory *v18.VersionHistory `protobuf:"bytes,4,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
+}
+
+func (m *ImportWorkflowExecutionRequest) Reset()      { *m = ImportWorkflowExecutionRequest{} }
+func (*ImportWorkflowExecutionRequest) ProtoMessage() {}
+func (*ImportWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{53}
+}
+func (m *ImportWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *ImportWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *ImportWorkflowExecutionRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *ImportWorkflowExecutionRequest) GetExecution() *v14.WorkflowExecution {
+	if m != nil {
+		return m.Execution
+	}
+	return nil
+}
+
+func (m *ImportWorkflowExecutionRequest) GetHistoryBatches() []*v14.DataBlob {
+	if m != nil {
+		return m.HistoryBatches
+	}
+	return nil
+}
+
+func (m *ImportWorkflowExecutionRequest) GetVersionHistory() *v18.VersionHistory {
+	if m != nil {
+		return m.VersionHistory
+	}
+	return nil
+}
+
+type ImportWorkflowExecutionResponse struct {
+}
+
+func (m *ImportWorkflowExecutionResponse) Reset()      { *m = ImportWorkflowExecutionResponse{} }
+func (*ImportWorkflowExecutionResponse) ProtoMessage() {}
+func (*ImportWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{54}
+}
+func (m *ImportWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *ImportWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportWorkflowExecutionResponse proto.InternalMessageInfo
+
 type SyncShardStatusRequest struct {
 	SourceCluster string     `protobuf:"bytes,1,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
 	ShardId       int32      `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
@@ -3183,7 +3382,7 @@ type SyncShardStatusRequest struct {
 func (m *SyncShardStatusRequest) Reset()      { *m = SyncShardStatusRequest{} }
 func (*SyncShardStatusRequest) ProtoMessage() {}
 func (*SyncShardStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{51}
+	return fileDescriptor_b8c78c1d460a3711, []int{55}
 }
 func (m *SyncShardStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3239,7 +3438,7 @@ type SyncShardStatusResponse struct {
 func (m *SyncShardStatusResponse) Reset()      { *m = SyncShardStatusResponse{} }
 func (*SyncShardStatusResponse) ProtoMessage() {}
 func (*SyncShardStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{52}
+	return fileDescriptor_b8c78c1d460a3711, []int{56}
 }
 func (m *SyncShardStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3288,7 +3487,7 @@ type SyncActivityRequest struct {
 func (m *SyncActivityRequest) Reset()      { *m = SyncActivityRequest{} }
 func (*SyncActivityRequest) ProtoMessage() {}
 func (*SyncActivityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{53}
+	return fileDescriptor_b8c78c1d460a3711, []int{57}
 }
 func (m *SyncActivityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3421,7 +3620,7 @@ type SyncActivityResponse struct {
 func (m *SyncActivityResponse) Reset()      { *m = SyncActivityResponse{} }
 func (*SyncActivityResponse) ProtoMessage() {}
 func (*SyncActivityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{54}
+	return fileDescriptor_b8c78c1d460a3711, []int{58}
 }
 func (m *SyncActivityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3458,7 +3657,7 @@ type DescribeMutableStateRequest struct {
 func (m *DescribeMutableStateRequest) Reset()      { *m = DescribeMutableStateRequest{} }
 func (*DescribeMutableStateRequest) ProtoMessage() {}
 func (*DescribeMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{55}
+	return fileDescriptor_b8c78c1d460a3711, []int{59}
 }
 func (m *DescribeMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3509,7 +3708,7 @@ type DescribeMutableStateResponse struct {
 func (m *DescribeMutableStateResponse) Reset()      { *m = DescribeMutableStateResponse{} }
 func (*DescribeMutableStateResponse) ProtoMessage() {}
 func (*DescribeMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{56}
+	return fileDescriptor_b8c78c1d460a3711, []int{60}
 }
 func (m *DescribeMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3559,12 +3758,14 @@ type DescribeHistoryHostRequest struct {
 	ShardId           int32                  `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	NamespaceId       string                 `protobuf:"bytes,3,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowExecution *v14.WorkflowExecution `protobuf:"bytes,4,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	// Include the load of every shard owned by the host in the response.
+	IncludeShardLoads bool `protobuf:"varint,5,opt,name=include_shard_loads,json=includeShardLoads,proto3" json:"include_shard_loads,omitempty"`
 }
 
 func (m *DescribeHistoryHostRequest) Reset()      { *m = DescribeHistoryHostRequest{} }
 func (*DescribeHistoryHostRequest) ProtoMessage() {}
 func (*DescribeHistoryHostRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{57}
+	return fileDescriptor_b8c78c1d460a3711, []int{61}
 }
 func (m *DescribeHistoryHostRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3621,17 +3822,25 @@ func (m *DescribeHistoryHostRequest) GetWorkflowExecution() *v14.WorkflowExecuti
 	return nil
 }
 
+func (m *DescribeHistoryHostRequest) GetIncludeShardLoads() bool {
+	if m != nil {
+		return m.IncludeShardLoads
+	}
+	return false
+}
+
 type DescribeHistoryHostResponse struct {
 	ShardsNumber   int32                    `protobuf:"varint,1,opt,name=shards_number,json=shardsNumber,proto3" json:"shards_number,omitempty"`
 	ShardIds       []int32                  `protobuf:"varint,2,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
 	NamespaceCache *v113.NamespaceCacheInfo `protobuf:"bytes,3,opt,name=namespace_cache,json=namespaceCache,proto3" json:"namespace_cache,omitempty"`
 	Address        string                   `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	ShardLoads     []*v18.ShardLoad         `protobuf:"bytes,6,rep,name=shard_loads,json=shardLoads,proto3" json:"shard_loads,omitempty"`
 }
 
 func (m *DescribeHistoryHostResponse) Reset()      { *m = DescribeHistoryHostResponse{} }
 func (*DescribeHistoryHostResponse) ProtoMessage() {}
 func (*DescribeHistoryHostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{58}
+	return fileDescriptor_b8c78c1d460a3711, []int{62}
 }
 func (m *DescribeHistoryHostResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3688,6 +3897,13 @@ func (m *DescribeHistoryHostResponse) GetAddress() string {
 	return ""
 }
 
+func (m *DescribeHistoryHostResponse) GetShardLoads() []*v18.ShardLoad {
+	if m != nil {
+		return m.ShardLoads
+	}
+	return nil
+}
+
 type CloseShardRequest struct {
 	ShardId int32 `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 }
@@ -3695,7 +3911,7 @@ type CloseShardRequest struct {
 func (m *CloseShardRequest) Reset()      { *m = CloseShardRequest{} }
 func (*CloseShardRequest) ProtoMessage() {}
 func (*CloseShardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{59}
+	return fileDescriptor_b8c78c1d460a3711, []int{63}
 }
 func (m *CloseShardRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3737,7 +3953,7 @@ type CloseShardResponse struct {
 func (m *CloseShardResponse) Reset()      { *m = CloseShardResponse{} }
 func (*CloseShardResponse) ProtoMessage() {}
 func (*CloseShardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{60}
+	return fileDescriptor_b8c78c1d460a3711, []int{64}
 }
 func (m *CloseShardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3773,7 +3989,7 @@ type GetShardRequest struct {
 func (m *GetShardRequest) Reset()      { *m = GetShardRequest{} }
 func (*GetShardRequest) ProtoMessage() {}
 func (*GetShardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{61}
+	return fileDescriptor_b8c78c1d460a3711, []int{65}
 }
 func (m *GetShardRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3816,7 +4032,7 @@ type GetShardResponse struct {
 func (m *GetShardResponse) Reset()      { *m = GetShardResponse{} }
 func (*GetShardResponse) ProtoMessage() {}
 func (*GetShardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{62}
+	return fileDescriptor_b8c78c1d460a3711, []int{66}
 }
 func (m *GetShardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3852,24 +4068,25 @@ func (m *GetShardResponse) GetShardInfo() *v112.ShardInfo {
 	return nil
 }
 
-type RemoveTaskRequest struct {
-	ShardId        int32            `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	Category       v17.TaskCategory `protobuf:"varint,2,opt,name=category,proto3,enum=temporal.server.api.enums.v1.TaskCategory" json:"category,omitempty"`
-	TaskId         int64            `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	VisibilityTime *time.Time       `protobuf:"bytes,4,opt,name=visibility_time,json=visibilityTime,proto3,stdtime" json:"visibility_time,omitempty"`
+type HandoffShardRequest struct {
+	ShardId int32 `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// Address of the host handing off the shard.
+	PreviousOwner string `protobuf:"bytes,2,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
+	// Workflows most recently used on the previous owner, most recent first.
+	Workflows []*HandoffShardWorkflow `protobuf:"bytes,3,rep,name=workflows,proto3" json:"workflows,omitempty"`
 }
 
-func (m *RemoveTaskRequest) Reset()      { *m = RemoveTaskRequest{} }
-func (*RemoveTaskRequest) ProtoMessage() {}
-func (*RemoveTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{63}
+func (m *HandoffShardRequest) Reset()      { *m = HandoffShardRequest{} }
+func (*HandoffShardRequest) ProtoMessage() {}
+func (*HandoffShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{67}
 }
-func (m *RemoveTaskRequest) XXX_Unmarshal(b []byte) error {
+func (m *HandoffShardRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HandoffShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveTaskRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HandoffShardRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3879,60 +4096,56 @@ func (m *RemoveTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *RemoveTaskRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveTaskRequest.Merge(m, src)
+func (m *HandoffShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandoffShardRequest.Merge(m, src)
 }
-func (m *RemoveTaskRequest) XXX_Size() int {
+func (m *HandoffShardRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveTaskRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveTaskRequest.DiscardUnknown(m)
+func (m *HandoffShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandoffShardRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveTaskRequest proto.InternalMessageInfo
+var xxx_messageInfo_HandoffShardRequest proto.InternalMessageInfo
 
-func (m *RemoveTaskRequest) GetShardId() int32 {
+func (m *HandoffShardRequest) GetShardId() int32 {
 	if m != nil {
 		return m.ShardId
 	}
 	return 0
 }
 
-func (m *RemoveTaskRequest) GetCategory() v17.TaskCategory {
-	if m != nil {
-		return m.Category
-	}
-	return v17.TASK_CATEGORY_UNSPECIFIED
-}
-
-func (m *RemoveTaskRequest) GetTaskId() int64 {
+func (m *HandoffShardRequest) GetPreviousOwner() string {
 	if m != nil {
-		return m.TaskId
+		return m.PreviousOwner
 	}
-	return 0
+	return ""
 }
 
-func (m *RemoveTaskRequest) GetVisibilityTime() *time.Time {
+func (m *HandoffShardRequest) GetWorkflows() []*HandoffShardWorkflow {
 	if m != nil {
-		return m.VisibilityTime
+		return m.Workflows
 	}
 	return nil
 }
 
-type RemoveTaskResponse struct {
+type HandoffShardWorkflow struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId  string `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId       string `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 }
 
-func (m *RemoveTaskResponse) Reset()      { *m = RemoveTaskResponse{} }
-func (*RemoveTaskResponse) ProtoMessage() {}
-func (*RemoveTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{64}
+func (m *HandoffShardWorkflow) Reset()      { *m = HandoffShardWorkflow{} }
+func (*HandoffShardWorkflow) ProtoMessage() {}
+func (*HandoffShardWorkflow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{68}
 }
-func (m *RemoveTaskResponse) XXX_Unmarshal(b []byte) error {
+func (m *HandoffShardWorkflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HandoffShardWorkflow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveTaskResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HandoffShardWorkflow.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3942,10 +4155,176 @@ func (m *RemoveTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *RemoveTaskResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveTaskResponse.Merge(m, src)
+func (m *HandoffShardWorkflow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandoffShardWorkflow.Merge(m, src)
 }
-func (m *RemoveTaskResponse) XXX_Size() int {
+func (m *HandoffShardWorkflow) XXX_Size() int {
+	return m.Size()
+}
+func (m *HandoffShardWorkflow) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandoffShardWorkflow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandoffShardWorkflow proto.InternalMessageInfo
+
+func (m *HandoffShardWorkflow) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *HandoffShardWorkflow) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *HandoffShardWorkflow) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+type HandoffShardResponse struct {
+	PrewarmedWorkflows int32 `protobuf:"varint,1,opt,name=prewarmed_workflows,json=prewarmedWorkflows,proto3" json:"prewarmed_workflows,omitempty"`
+}
+
+func (m *HandoffShardResponse) Reset()      { *m = HandoffShardResponse{} }
+func (*HandoffShardResponse) ProtoMessage() {}
+func (*HandoffShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{69}
+}
+func (m *HandoffShardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HandoffShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HandoffShardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HandoffShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandoffShardResponse.Merge(m, src)
+}
+func (m *HandoffShardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *HandoffShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandoffShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandoffShardResponse proto.InternalMessageInfo
+
+func (m *HandoffShardResponse) GetPrewarmedWorkflows() int32 {
+	if m != nil {
+		return m.PrewarmedWorkflows
+	}
+	return 0
+}
+
+type RemoveTaskRequest struct {
+	ShardId        int32            `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	Category       v17.TaskCategory `protobuf:"varint,2,opt,name=category,proto3,enum=temporal.server.api.enums.v1.TaskCategory" json:"category,omitempty"`
+	TaskId         int64            `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	VisibilityTime *time.Time       `protobuf:"bytes,4,opt,name=visibility_time,json=visibilityTime,proto3,stdtime" json:"visibility_time,omitempty"`
+}
+
+func (m *RemoveTaskRequest) Reset()      { *m = RemoveTaskRequest{} }
+func (*RemoveTaskRequest) ProtoMessage() {}
+func (*RemoveTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{70}
+}
+func (m *RemoveTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveTaskRequest.Merge(m, src)
+}
+func (m *RemoveTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveTaskRequest proto.InternalMessageInfo
+
+func (m *RemoveTaskRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *RemoveTaskRequest) GetCategory() v17.TaskCategory {
+	if m != nil {
+		return m.Category
+	}
+	return v17.TASK_CATEGORY_UNSPECIFIED
+}
+
+func (m *RemoveTaskRequest) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *RemoveTaskRequest) GetVisibilityTime() *time.Time {
+	if m != nil {
+		return m.VisibilityTime
+	}
+	return nil
+}
+
+type RemoveTaskResponse struct {
+}
+
+func (m *RemoveTaskResponse) Reset()      { *m = RemoveTaskResponse{} }
+func (*RemoveTaskResponse) ProtoMessage() {}
+func (*RemoveTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{71}
+}
+func (m *RemoveTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveTaskResponse.Merge(m, src)
+}
+func (m *RemoveTaskResponse) XXX_Size() int {
 	return m.Size()
 }
 func (m *RemoveTaskResponse) XXX_DiscardUnknown() {
@@ -3962,7 +4341,7 @@ type GetReplicationMessagesRequest struct {
 func (m *GetReplicationMessagesRequest) Reset()      { *m = GetReplicationMessagesRequest{} }
 func (*GetReplicationMessagesRequest) ProtoMessage() {}
 func (*GetReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{65}
+	return fileDescriptor_b8c78c1d460a3711, []int{72}
 }
 func (m *GetReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4012,7 +4391,7 @@ type GetReplicationMessagesResponse struct {
 func (m *GetReplicationMessagesResponse) Reset()      { *m = GetReplicationMessagesResponse{} }
 func (*GetReplicationMessagesResponse) ProtoMessage() {}
 func (*GetReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{66}
+	return fileDescriptor_b8c78c1d460a3711, []int{73}
 }
 func (m *GetReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4055,7 +4434,7 @@ type GetDLQReplicationMessagesRequest struct {
 func (m *GetDLQReplicationMessagesRequest) Reset()      { *m = GetDLQReplicationMessagesRequest{} }
 func (*GetDLQReplicationMessagesRequest) ProtoMessage() {}
 func (*GetDLQReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{67}
+	return fileDescriptor_b8c78c1d460a3711, []int{74}
 }
 func (m *GetDLQReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4098,7 +4477,7 @@ type GetDLQReplicationMessagesResponse struct {
 func (m *GetDLQReplicationMessagesResponse) Reset()      { *m = GetDLQReplicationMessagesResponse{} }
 func (*GetDLQReplicationMessagesResponse) ProtoMessage() {}
 func (*GetDLQReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{68}
+	return fileDescriptor_b8c78c1d460a3711, []int{75}
 }
 func (m *GetDLQReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4142,7 +4521,7 @@ type QueryWorkflowRequest struct {
 func (m *QueryWorkflowRequest) Reset()      { *m = QueryWorkflowRequest{} }
 func (*QueryWorkflowRequest) ProtoMessage() {}
 func (*QueryWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{69}
+	return fileDescriptor_b8c78c1d460a3711, []int{76}
 }
 func (m *QueryWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4192,7 +4571,7 @@ type QueryWorkflowResponse struct {
 func (m *QueryWorkflowResponse) Reset()      { *m = QueryWorkflowResponse{} }
 func (*QueryWorkflowResponse) ProtoMessage() {}
 func (*QueryWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{70}
+	return fileDescriptor_b8c78c1d460a3711, []int{77}
 }
 func (m *QueryWorkflowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4236,7 +4615,7 @@ type ReapplyEventsRequest struct {
 func (m *ReapplyEventsRequest) Reset()      { *m = ReapplyEventsRequest{} }
 func (*ReapplyEventsRequest) ProtoMessage() {}
 func (*ReapplyEventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{71}
+	return fileDescriptor_b8c78c1d460a3711, []int{78}
 }
 func (m *ReapplyEventsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4285,7 +4664,7 @@ type ReapplyEventsResponse struct {
 func (m *ReapplyEventsResponse) Reset()      { *m = ReapplyEventsResponse{} }
 func (*ReapplyEventsResponse) ProtoMessage() {}
 func (*ReapplyEventsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{72}
+	return fileDescriptor_b8c78c1d460a3711, []int{79}
 }
 func (m *ReapplyEventsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4326,7 +4705,7 @@ type GetDLQMessagesRequest struct {
 func (m *GetDLQMessagesRequest) Reset()      { *m = GetDLQMessagesRequest{} }
 func (*GetDLQMessagesRequest) ProtoMessage() {}
 func (*GetDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{73}
+	return fileDescriptor_b8c78c1d460a3711, []int{80}
 }
 func (m *GetDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4406,7 +4785,7 @@ type GetDLQMessagesResponse struct {
 func (m *GetDLQMessagesResponse) Reset()      { *m = GetDLQMessagesResponse{} }
 func (*GetDLQMessagesResponse) ProtoMessage() {}
 func (*GetDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{74}
+	return fileDescriptor_b8c78c1d460a3711, []int{81}
 }
 func (m *GetDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4466,7 +4845,7 @@ type PurgeDLQMessagesRequest struct {
 func (m *PurgeDLQMessagesRequest) Reset()      { *m = PurgeDLQMessagesRequest{} }
 func (*PurgeDLQMessagesRequest) ProtoMessage() {}
 func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{75}
+	return fileDescriptor_b8c78c1d460a3711, []int{82}
 }
 func (m *PurgeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4529,7 +4908,7 @@ type PurgeDLQMessagesResponse struct {
 func (m *PurgeDLQMessagesResponse) Reset()      { *m = PurgeDLQMessagesResponse{} }
 func (*PurgeDLQMessagesResponse) ProtoMessage() {}
 func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{76}
+	return fileDescriptor_b8c78c1d460a3711, []int{83}
 }
 func (m *PurgeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4570,7 +4949,7 @@ type MergeDLQMessagesRequest struct {
 func (m *MergeDLQMessagesRequest) Reset()      { *m = MergeDLQMessagesRequest{} }
 func (*MergeDLQMessagesRequest) ProtoMessage() {}
 func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{77}
+	return fileDescriptor_b8c78c1d460a3711, []int{84}
 }
 func (m *MergeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4648,7 +5027,7 @@ type MergeDLQMessagesResponse struct {
 func (m *MergeDLQMessagesResponse) Reset()      { *m = MergeDLQMessagesResponse{} }
 func (*MergeDLQMessagesResponse) ProtoMessage() {}
 func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{78}
+	return fileDescriptor_b8c78c1d460a3711, []int{85}
 }
 func (m *MergeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4692,7 +5071,7 @@ type RefreshWorkflowTasksRequest struct {
 func (m *RefreshWorkflowTasksRequest) Reset()      { *m = RefreshWorkflowTasksRequest{} }
 func (*RefreshWorkflowTasksRequest) ProtoMessage() {}
 func (*RefreshWorkflowTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{79}
+	return fileDescriptor_b8c78c1d460a3711, []int{86}
 }
 func (m *RefreshWorkflowTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4741,7 +5120,7 @@ type RefreshWorkflowTasksResponse struct {
 func (m *RefreshWorkflowTasksResponse) Reset()      { *m = RefreshWorkflowTasksResponse{} }
 func (*RefreshWorkflowTasksResponse) ProtoMessage() {}
 func (*RefreshWorkflowTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{80}
+	return fileDescriptor_b8c78c1d460a3711, []int{87}
 }
 func (m *RefreshWorkflowTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4780,7 +5159,7 @@ func (m *GenerateLastHistoryReplicationTasksRequest) Reset() {
 }
 func (*GenerateLastHistoryReplicationTasksRequest) ProtoMessage() {}
 func (*GenerateLastHistoryReplicationTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{81}
+	return fileDescriptor_b8c78c1d460a3711, []int{88}
 }
 func (m *GenerateLastHistoryReplicationTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4831,7 +5210,7 @@ func (m *GenerateLastHistoryReplicationTasksResponse) Reset() {
 }
 func (*GenerateLastHistoryReplicationTasksResponse) ProtoMessage() {}
 func (*GenerateLastHistoryReplicationTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{82}
+	return fileDescriptor_b8c78c1d460a3711, []int{89}
 }
 func (m *GenerateLastHistoryReplicationTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4868,7 +5247,7 @@ type GetReplicationStatusRequest struct {
 func (m *GetReplicationStatusRequest) Reset()      { *m = GetReplicationStatusRequest{} }
 func (*GetReplicationStatusRequest) ProtoMessage() {}
 func (*GetReplicationStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{83}
+	return fileDescriptor_b8c78c1d460a3711, []int{90}
 }
 func (m *GetReplicationStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4911,7 +5290,7 @@ type GetReplicationStatusResponse struct {
 func (m *GetReplicationStatusResponse) Reset()      { *m = GetReplicationStatusResponse{} }
 func (*GetReplicationStatusResponse) ProtoMessage() {}
 func (*GetReplicationStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{84}
+	return fileDescriptor_b8c78c1d460a3711, []int{91}
 }
 func (m *GetReplicationStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4961,7 +5340,7 @@ type ShardReplicationStatus struct {
 func (m *ShardReplicationStatus) Reset()      { *m = ShardReplicationStatus{} }
 func (*ShardReplicationStatus) ProtoMessage() {}
 func (*ShardReplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{85}
+	return fileDescriptor_b8c78c1d460a3711, []int{92}
 }
 func (m *ShardReplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5041,7 +5420,7 @@ type HandoverNamespaceInfo struct {
 func (m *HandoverNamespaceInfo) Reset()      { *m = HandoverNamespaceInfo{} }
 func (*HandoverNamespaceInfo) ProtoMessage() {}
 func (*HandoverNamespaceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{86}
+	return fileDescriptor_b8c78c1d460a3711, []int{93}
 }
 func (m *HandoverNamespaceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5087,7 +5466,7 @@ type ShardReplicationStatusPerCluster struct {
 func (m *ShardReplicationStatusPerCluster) Reset()      { *m = ShardReplicationStatusPerCluster{} }
 func (*ShardReplicationStatusPerCluster) ProtoMessage() {}
 func (*ShardReplicationStatusPerCluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{87}
+	return fileDescriptor_b8c78c1d460a3711, []int{94}
 }
 func (m *ShardReplicationStatusPerCluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5138,7 +5517,7 @@ type RebuildMutableStateRequest struct {
 func (m *RebuildMutableStateRequest) Reset()      { *m = RebuildMutableStateRequest{} }
 func (*RebuildMutableStateRequest) ProtoMessage() {}
 func (*RebuildMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{88}
+	return fileDescriptor_b8c78c1d460a3711, []int{95}
 }
 func (m *RebuildMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5187,7 +5566,7 @@ type RebuildMutableStateResponse struct {
 func (m *RebuildMutableStateResponse) Reset()      { *m = RebuildMutableStateResponse{} }
 func (*RebuildMutableStateResponse) ProtoMessage() {}
 func (*RebuildMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{89}
+	return fileDescriptor_b8c78c1d460a3711, []int{96}
 }
 func (m *RebuildMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5226,7 +5605,7 @@ type DeleteWorkflowVisibilityRecordRequest struct {
 func (m *DeleteWorkflowVisibilityRecordRequest) Reset()      { *m = DeleteWorkflowVisibilityRecordRequest{} }
 func (*DeleteWorkflowVisibilityRecordRequest) ProtoMessage() {}
 func (*DeleteWorkflowVisibilityRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{90}
+	return fileDescriptor_b8c78c1d460a3711, []int{97}
 }
 func (m *DeleteWorkflowVisibilityRecordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5291,7 +5670,7 @@ func (m *DeleteWorkflowVisibilityRecordResponse) Reset() {
 }
 func (*DeleteWorkflowVisibilityRecordResponse) ProtoMessage() {}
 func (*DeleteWorkflowVisibilityRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{91}
+	return fileDescriptor_b8c78c1d460a3711, []int{98}
 }
 func (m *DeleteWorkflowVisibilityRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5331,7 +5710,7 @@ type UpdateWorkflowRequest struct {
 func (m *UpdateWorkflowRequest) Reset()      { *m = UpdateWorkflowRequest{} }
 func (*UpdateWorkflowRequest) ProtoMessage() {}
 func (*UpdateWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{92}
+	return fileDescriptor_b8c78c1d460a3711, []int{99}
 }
 func (m *UpdateWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5381,7 +5760,7 @@ type UpdateWorkflowResponse struct {
 func (m *UpdateWorkflowResponse) Reset()      { *m = UpdateWorkflowResponse{} }
 func (*UpdateWorkflowResponse) ProtoMessage() {}
 func (*UpdateWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{93}
+	return fileDescriptor_b8c78c1d460a3711, []int{100}
 }
 func (m *UpdateWorkflowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5449,6 +5828,8 @@ func init() {
 	proto.RegisterType((*SignalWithStartWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.SignalWithStartWorkflowExecutionResponse")
 	proto.RegisterType((*RemoveSignalMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.RemoveSignalMutableStateRequest")
 	proto.RegisterType((*RemoveSignalMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.RemoveSignalMutableStateResponse")
+	proto.RegisterType((*UpsertWorkflowSearchAttributesRequest)(nil), "temporal.server.api.historyservice.v1.UpsertWorkflowSearchAttributesRequest")
+	proto.RegisterType((*UpsertWorkflowSearchAttributesResponse)(nil), "temporal.server.api.historyservice.v1.UpsertWorkflowSearchAttributesResponse")
 	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.TerminateWorkflowExecutionRequest")
 	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.TerminateWorkflowExecutionResponse")
 	proto.RegisterType((*DeleteWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.DeleteWorkflowExecutionRequest")
@@ -5470,6 +5851,8 @@ func init() {
 	proto.RegisterType((*ReplicateEventsV2Request)(nil), "temporal.server.api.historyservice.v1.ReplicateEventsV2Request")
 	proto.RegisterType((*ReplicateWorkflowStateRequest)(nil), "temporal.server.api.historyservice.v1.ReplicateWorkflowStateRequest")
 	proto.RegisterType((*ReplicateEventsV2Response)(nil), "temporal.server.api.historyservice.v1.ReplicateEventsV2Response")
+	proto.RegisterType((*ImportWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.ImportWorkflowExecutionRequest")
+	proto.RegisterType((*ImportWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.ImportWorkflowExecutionResponse")
 	proto.RegisterType((*SyncShardStatusRequest)(nil), "temporal.server.api.historyservice.v1.SyncShardStatusRequest")
 	proto.RegisterType((*SyncShardStatusResponse)(nil), "temporal.server.api.historyservice.v1.SyncShardStatusResponse")
 	proto.RegisterType((*SyncActivityRequest)(nil), "temporal.server.api.historyservice.v1.SyncActivityRequest")
@@ -5482,6 +5865,9 @@ func init() {
 	proto.RegisterType((*CloseShardResponse)(nil), "temporal.server.api.historyservice.v1.CloseShardResponse")
 	proto.RegisterType((*GetShardRequest)(nil), "temporal.server.api.historyservice.v1.GetShardRequest")
 	proto.RegisterType((*GetShardResponse)(nil), "temporal.server.api.historyservice.v1.GetShardResponse")
+	proto.RegisterType((*HandoffShardRequest)(nil), "temporal.server.api.historyservice.v1.HandoffShardRequest")
+	proto.RegisterType((*HandoffShardWorkflow)(nil), "temporal.server.api.historyservice.v1.HandoffShardWorkflow")
+	proto.RegisterType((*HandoffShardResponse)(nil), "temporal.server.api.historyservice.v1.HandoffShardResponse")
 	proto.RegisterType((*RemoveTaskRequest)(nil), "temporal.server.api.historyservice.v1.RemoveTaskRequest")
 	proto.RegisterType((*RemoveTaskResponse)(nil), "temporal.server.api.historyservice.v1.RemoveTaskResponse")
 	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesRequest")
@@ -5523,286 +5909,299 @@ func init() {
 }
 
 var fileDescriptor_b8c78c1d460a3711 = []byte{
-	// 4461 bytes of a gzipped FileDescriptorProto
+	// 4668 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x6c, 0x1c, 0xc9,
-	0x75, 0x6a, 0xce, 0x0c, 0x39, 0x7c, 0x24, 0xe7, 0xd3, 0xfc, 0x0d, 0x49, 0x69, 0x44, 0xf5, 0x8a,
-	0x12, 0x57, 0xbb, 0x1a, 0xea, 0x63, 0x7b, 0x65, 0xc5, 0xbb, 0x1b, 0x89, 0xfa, 0x51, 0x90, 0x64,
-	0x6e, 0x93, 0x2b, 0x2d, 0xd6, 0x5e, 0xf7, 0x36, 0xbb, 0x8b, 0x64, 0x87, 0x33, 0xdd, 0xa3, 0xae,
-	0x1e, 0x92, 0xb3, 0x39, 0xe4, 0x63, 0x24, 0x88, 0x9d, 0x20, 0x59, 0x20, 0x17, 0x23, 0x70, 0x80,
-	0x20, 0x40, 0x10, 0x5f, 0x82, 0x1c, 0x72, 0x08, 0x7c, 0xc8, 0x25, 0x01, 0x82, 0x1c, 0x17, 0xb9,
-	0xc4, 0x48, 0x80, 0x38, 0xab, 0x3d, 0xc4, 0x41, 0x72, 0xf0, 0x31, 0x08, 0x72, 0x08, 0xea, 0xd7,
-	0xd3, 0xbf, 0xf9, 0x34, 0x29, 0x45, 0x6b, 0x7b, 0x6f, 0x9c, 0xaa, 0xf7, 0x5e, 0xd5, 0xfb, 0x57,
-	0xbd, 0x7a, 0x4d, 0xf8, 0x9a, 0x87, 0x1a, 0x4d, 0xc7, 0xd5, 0xeb, 0x2b, 0x18, 0xb9, 0xfb, 0xc8,
-	0x5d, 0xd1, 0x9b, 0xd6, 0xca, 0xae, 0x85, 0x3d, 0xc7, 0x6d, 0x93, 0x11, 0xcb, 0x40, 0x2b, 0xfb,
-	0x97, 0x57, 0x5c, 0xf4, 0xb4, 0x85, 0xb0, 0xa7, 0xb9, 0x08, 0x37, 0x1d, 0x1b, 0xa3, 0x5a, 0xd3,
-	0x75, 0x3c, 0x47, 0x5e, 0x12, 0xd8, 0x35, 0x86, 0x5d, 0xd3, 0x9b, 0x56, 0x2d, 0x8c, 0x5d, 0xdb,
-	0xbf, 0x3c, 0x5f, 0xdd, 0x71, 0x9c, 0x9d, 0x3a, 0x5a, 0xa1, 0x48, 0x5b, 0xad, 0xed, 0x15, 0xb3,
-	0xe5, 0xea, 0x9e, 0xe5, 0xd8, 0x8c, 0xcc, 0xfc, 0xe9, 0xe8, 0xbc, 0x67, 0x35, 0x10, 0xf6, 0xf4,
-	0x46, 0x93, 0x03, 0x9c, 0x31, 0x51, 0x13, 0xd9, 0x26, 0xb2, 0x0d, 0x0b, 0xe1, 0x95, 0x1d, 0x67,
-	0xc7, 0xa1, 0xe3, 0xf4, 0x2f, 0x0e, 0x72, 0xd6, 0x67, 0x84, 0x70, 0x60, 0x38, 0x8d, 0x86, 0x63,
-	0x93, 0x9d, 0x37, 0x10, 0xc6, 0xfa, 0x0e, 0xdf, 0xf0, 0xfc, 0x52, 0x08, 0x8a, 0xef, 0x34, 0x0e,
-	0x76, 0x3e, 0x04, 0xe6, 0xe9, 0x78, 0xef, 0x69, 0x0b, 0xb5, 0x50, 0x1c, 0x30, 0xbc, 0x2a, 0xb2,
-	0x5b, 0x0d, 0x4c, 0x80, 0x0e, 0x1c, 0x77, 0x6f, 0xbb, 0xee, 0x1c, 0x70, 0xa8, 0x73, 0x21, 0x28,
-	0x31, 0x19, 0xa7, 0xf6, 0x4a, 0x08, 0xee, 0x69, 0x0b, 0x25, 0xed, 0x2d, 0xcc, 0xc2, 0xb6, 0x6e,
-	0xd5, 0x5b, 0x6e, 0xc2, 0xce, 0x2e, 0x24, 0x29, 0xd6, 0xa8, 0x3b, 0xc6, 0x5e, 0x1c, 0xf6, 0xf5,
-	0x1e, 0x46, 0x10, 0x87, 0x7e, 0x35, 0x09, 0xda, 0x67, 0x9d, 0x49, 0x9e, 0x83, 0xbe, 0xd6, 0x13,
-	0x34, 0x22, 0xa5, 0xf3, 0x3d, 0x81, 0x89, 0x12, 0x38, 0xe0, 0xc5, 0x24, 0xc0, 0xee, 0x52, 0xad,
-	0x25, 0x81, 0xdb, 0x7a, 0x03, 0xe1, 0xa6, 0x6e, 0x24, 0x48, 0xee, 0x52, 0x12, 0xbc, 0x8b, 0x9a,
-	0x75, 0xcb, 0xa0, 0x46, 0x1b, 0xc7, 0xb8, 0x9a, 0x84, 0xd1, 0x44, 0x2e, 0xb6, 0xb0, 0x87, 0x6c,
-	0xb6, 0x06, 0x3a, 0x44, 0x46, 0x8b, 0xa0, 0x63, 0x8e, 0xf4, 0xf6, 0x00, 0x48, 0x82, 0x29, 0xad,
-	0xd1, 0xf2, 0xf4, 0xad, 0x3a, 0xd2, 0xb0, 0xa7, 0x7b, 0x62, 0xd5, 0xaf, 0x24, 0x5a, 0x55, 0x5f,
-	0xa7, 0x9d, 0xbf, 0x9e, 0xb4, 0xb0, 0x6e, 0x36, 0x2c, 0xbb, 0x2f, 0xae, 0xf2, 0xbb, 0xc3, 0x70,
-	0x6a, 0xc3, 0xd3, 0x5d, 0xef, 0x09, 0x5f, 0xee, 0xb6, 0x60, 0x4b, 0x65, 0x08, 0xf2, 0x19, 0x18,
-	0xf7, 0x65, 0xab, 0x59, 0x66, 0x45, 0x5a, 0x94, 0x96, 0x47, 0xd5, 0x31, 0x7f, 0x6c, 0xcd, 0x94,
-	0x0d, 0x98, 0xc0, 0x84, 0x86, 0xc6, 0x17, 0xa9, 0x0c, 0x2d, 0x4a, 0xcb, 0x63, 0x57, 0xde, 0xf2,
-	0x15, 0x45, 0xc3, 0x48, 0x84, 0xa1, 0xda, 0xfe, 0xe5, 0x5a, 0xcf, 0x95, 0xd5, 0x71, 0x4a, 0x54,
-	0xec, 0x63, 0x17, 0xa6, 0x9b, 0xba, 0x8b, 0x6c, 0x4f, 0xf3, 0x25, 0xaf, 0x59, 0xf6, 0xb6, 0x53,
-	0xc9, 0xd0, 0xc5, 0xbe, 0x54, 0x4b, 0x0a, 0x5d, 0xbe, 0x45, 0xee, 0x5f, 0xae, 0xad, 0x53, 0x6c,
-	0x7f, 0x95, 0x35, 0x7b, 0xdb, 0x51, 0x27, 0x9b, 0xf1, 0x41, 0xb9, 0x02, 0x23, 0xba, 0x47, 0xa8,
-	0x79, 0x95, 0xec, 0xa2, 0xb4, 0x9c, 0x53, 0xc5, 0x4f, 0xb9, 0x01, 0x8a, 0xaf, 0xc1, 0xce, 0x2e,
-	0xd0, 0x61, 0xd3, 0x62, 0xe1, 0x4f, 0x23, 0x71, 0xae, 0x92, 0xa3, 0x1b, 0x9a, 0xaf, 0xb1, 0x20,
-	0x58, 0x13, 0x41, 0xb0, 0xb6, 0x29, 0x82, 0xe0, 0xcd, 0xec, 0xc7, 0x3f, 0x3e, 0x2d, 0xa9, 0xa7,
-	0x0f, 0xa2, 0x9c, 0xdf, 0xf6, 0x29, 0x11, 0x58, 0x79, 0x17, 0xe6, 0x0c, 0xc7, 0xf6, 0x2c, 0xbb,
-	0x85, 0x34, 0x1d, 0x6b, 0x36, 0x3a, 0xd0, 0x2c, 0xdb, 0xf2, 0x2c, 0xdd, 0x73, 0xdc, 0xca, 0xf0,
-	0xa2, 0xb4, 0x5c, 0xb8, 0x72, 0x31, 0x2c, 0x63, 0xea, 0x5d, 0x84, 0xd9, 0x55, 0x8e, 0x77, 0x03,
-	0x3f, 0x42, 0x07, 0x6b, 0x02, 0x49, 0x9d, 0x31, 0x12, 0xc7, 0xe5, 0x87, 0x50, 0x16, 0x33, 0xa6,
-	0xc6, 0x43, 0x50, 0x65, 0x84, 0xf2, 0xb1, 0x18, 0x5e, 0x81, 0x4f, 0x92, 0x35, 0xee, 0xb0, 0x3f,
-	0xd5, 0x92, 0x8f, 0xca, 0x47, 0xe4, 0xc7, 0x30, 0x53, 0xd7, 0xb1, 0xa7, 0x19, 0x4e, 0xa3, 0x59,
-	0x47, 0x54, 0x32, 0x2e, 0xc2, 0xad, 0xba, 0x57, 0xc9, 0x27, 0xd1, 0xe4, 0x21, 0x86, 0xea, 0xa8,
-	0x5d, 0x77, 0x74, 0x13, 0xab, 0x53, 0x04, 0x7f, 0xd5, 0x47, 0x57, 0x29, 0xb6, 0xfc, 0x2d, 0x58,
-	0xd8, 0xb6, 0x5c, 0xec, 0x69, 0xbe, 0x16, 0x48, 0x14, 0xd1, 0xb6, 0x74, 0x63, 0xcf, 0xd9, 0xde,
-	0xae, 0x8c, 0x52, 0xe2, 0x73, 0x31, 0xc1, 0xdf, 0xe2, 0xd9, 0xe9, 0x66, 0xf6, 0x7b, 0x44, 0xee,
-	0x15, 0x4a, 0x43, 0x98, 0xdd, 0xa6, 0x8e, 0xf7, 0x6e, 0x32, 0x02, 0xca, 0x21, 0x54, 0xbb, 0x99,
-	0x24, 0xf3, 0x1a, 0x79, 0x1a, 0x86, 0xdd, 0x96, 0xdd, 0xf1, 0x83, 0x9c, 0xdb, 0xb2, 0xd7, 0x4c,
-	0xf9, 0x6d, 0xc8, 0xd1, 0x50, 0xcc, 0x2d, 0xff, 0xd5, 0x44, 0x63, 0xa4, 0x10, 0x84, 0xcb, 0xc7,
-	0xc8, 0xf0, 0x1c, 0x77, 0x95, 0xfc, 0x54, 0x19, 0x9e, 0xf2, 0x9f, 0x12, 0xcc, 0xdc, 0x45, 0xde,
-	0x43, 0x16, 0x16, 0x36, 0x48, 0x54, 0x48, 0xe1, 0x80, 0x77, 0x61, 0xd4, 0x37, 0xc7, 0xf8, 0x16,
-	0xc2, 0x22, 0x8e, 0xf3, 0xd6, 0xc1, 0x95, 0xaf, 0xc2, 0x0c, 0x3a, 0x6c, 0x22, 0xc3, 0x43, 0xa6,
-	0x66, 0xa3, 0x43, 0x4f, 0x43, 0xfb, 0xc4, 0xe3, 0x2c, 0x93, 0x7a, 0x59, 0x46, 0x9d, 0x14, 0xb3,
-	0x8f, 0xd0, 0xa1, 0x77, 0x9b, 0xcc, 0xad, 0x99, 0xf2, 0x25, 0x98, 0x32, 0x5a, 0x2e, 0x75, 0xcd,
-	0x2d, 0x57, 0xb7, 0x8d, 0x5d, 0xcd, 0x73, 0xf6, 0x90, 0x4d, 0x9d, 0x67, 0x5c, 0x95, 0xf9, 0xdc,
-	0x4d, 0x3a, 0xb5, 0x49, 0x66, 0x94, 0x1f, 0xe7, 0x61, 0x36, 0xc6, 0x2d, 0x97, 0x70, 0x88, 0x17,
-	0xe9, 0x18, 0xbc, 0xac, 0xc1, 0x44, 0xc7, 0x4c, 0xda, 0x4d, 0xc4, 0x05, 0x73, 0xb6, 0x1f, 0xb1,
-	0xcd, 0x76, 0x13, 0xa9, 0xe3, 0x07, 0x81, 0x5f, 0xb2, 0x02, 0x13, 0x49, 0xd2, 0x18, 0xb3, 0x03,
-	0x52, 0xf8, 0x2a, 0xcc, 0x35, 0x5d, 0xb4, 0x6f, 0x39, 0x2d, 0xac, 0xd1, 0xc0, 0x85, 0xcc, 0x0e,
-	0x7c, 0x96, 0xc2, 0xcf, 0x08, 0x80, 0x0d, 0x36, 0x2f, 0x50, 0x2f, 0xc2, 0x24, 0x75, 0x17, 0x66,
-	0xdb, 0x3e, 0x52, 0x8e, 0x22, 0x95, 0xc8, 0xd4, 0x1d, 0x32, 0x23, 0xc0, 0x57, 0x01, 0xa8, 0xd9,
-	0xd3, 0x23, 0x0c, 0x8d, 0x03, 0x31, 0xae, 0xfc, 0x13, 0x0e, 0x61, 0x8c, 0x58, 0xf8, 0x3b, 0xe4,
-	0x87, 0x3a, 0xea, 0x89, 0x3f, 0xe5, 0x75, 0x28, 0x63, 0xcf, 0x32, 0xf6, 0xda, 0x5a, 0x80, 0xd6,
-	0x48, 0x0a, 0x5a, 0x45, 0x86, 0xee, 0x0f, 0xc8, 0xbf, 0x0a, 0xaf, 0xc5, 0x28, 0x6a, 0xd8, 0xd8,
-	0x45, 0x66, 0xab, 0x8e, 0x34, 0xcf, 0x61, 0x52, 0xa1, 0x21, 0xd2, 0x69, 0x79, 0x95, 0xb1, 0xc1,
-	0x9c, 0x75, 0x29, 0xb2, 0xcc, 0x06, 0x27, 0xb8, 0xe9, 0x50, 0x21, 0x6e, 0x32, 0x6a, 0x5d, 0x6d,
-	0x70, 0xa2, 0x9b, 0x0d, 0xca, 0xdf, 0x80, 0x82, 0x6f, 0x1e, 0x34, 0x0b, 0x57, 0x8a, 0x34, 0xa2,
-	0x26, 0x27, 0x12, 0x3f, 0xb0, 0xc6, 0x4c, 0x8e, 0x59, 0xaf, 0x6f, 0x6a, 0xf4, 0xa7, 0xfc, 0x04,
-	0x8a, 0x21, 0xe2, 0x2d, 0x5c, 0x29, 0x51, 0xea, 0xb5, 0x2e, 0xf1, 0x3a, 0x91, 0x6c, 0x0b, 0xab,
-	0x85, 0x20, 0xdd, 0x16, 0x96, 0x3f, 0x80, 0xf2, 0x3e, 0x39, 0x52, 0x38, 0xb6, 0xc6, 0xce, 0x73,
-	0x16, 0xc2, 0x95, 0x32, 0x15, 0xe5, 0xa5, 0x5a, 0x8f, 0xc3, 0x3b, 0x0b, 0x3b, 0x14, 0xf1, 0x9e,
-	0xc0, 0x53, 0x4b, 0xfb, 0x91, 0x11, 0xf9, 0x2d, 0x38, 0x69, 0x11, 0xf3, 0x8d, 0xaa, 0x11, 0xd9,
-	0xc4, 0x51, 0xcd, 0x8a, 0xbc, 0x28, 0x2d, 0xe7, 0xd5, 0x8a, 0x85, 0x37, 0xc2, 0x5a, 0xb9, 0xcd,
-	0xe6, 0xe5, 0x2f, 0xc1, 0x6c, 0xcc, 0x92, 0xbd, 0x43, 0x1a, 0x2f, 0x27, 0x59, 0x00, 0x09, 0x5b,
-	0xf3, 0xe6, 0x21, 0x89, 0x9e, 0x57, 0x61, 0x86, 0x23, 0xf8, 0x39, 0x95, 0x07, 0xd9, 0x29, 0x1a,
-	0xeb, 0x26, 0xe9, 0x6c, 0xc7, 0xc9, 0x49, 0xc8, 0xbd, 0x9f, 0xcd, 0xe7, 0x4b, 0xa3, 0xf7, 0xb3,
-	0xf9, 0xd1, 0x12, 0xdc, 0xcf, 0xe6, 0xa1, 0x34, 0x76, 0x3f, 0x9b, 0x1f, 0x2f, 0x4d, 0xdc, 0xcf,
-	0xe6, 0x0b, 0xa5, 0xa2, 0xf2, 0x5f, 0x12, 0xcc, 0xae, 0x3b, 0xf5, 0xfa, 0x2f, 0x48, 0x40, 0xfd,
-	0xa3, 0x3c, 0x54, 0xe2, 0xec, 0x7e, 0x11, 0x51, 0xbf, 0x88, 0xa8, 0xcf, 0x3d, 0xa2, 0x8e, 0x77,
-	0x8d, 0xa8, 0x89, 0xb1, 0xa9, 0xf0, 0xdc, 0x62, 0xd3, 0xcf, 0x66, 0xc0, 0xee, 0x11, 0x11, 0xcb,
-	0x47, 0x89, 0x88, 0x72, 0xba, 0x88, 0x38, 0x51, 0x2a, 0x28, 0xdf, 0x91, 0x60, 0x41, 0x45, 0x18,
-	0x79, 0x91, 0xa0, 0xfd, 0x12, 0xe2, 0xa1, 0x52, 0x85, 0x93, 0xc9, 0x5b, 0x61, 0xb1, 0x4a, 0xf9,
-	0x41, 0x06, 0x16, 0x55, 0x64, 0x38, 0xae, 0x19, 0x3c, 0x9f, 0x73, 0xef, 0x4e, 0xb1, 0xe1, 0xf7,
-	0x40, 0x8e, 0xdf, 0xd4, 0xd2, 0xef, 0xbc, 0x1c, 0xbb, 0xa2, 0xc9, 0xaf, 0x83, 0x2c, 0x5c, 0xd0,
-	0x8c, 0x86, 0xaf, 0x92, 0x3f, 0x23, 0x22, 0xcb, 0x2c, 0x8c, 0x50, 0xdf, 0xf5, 0x23, 0xd6, 0x30,
-	0xf9, 0xb9, 0x66, 0xca, 0xa7, 0x00, 0xc4, 0x95, 0x9c, 0x07, 0xa6, 0x51, 0x75, 0x94, 0x8f, 0xac,
-	0x99, 0xf2, 0x87, 0x30, 0xde, 0x74, 0xea, 0x75, 0xff, 0x46, 0xcd, 0x62, 0xd2, 0x9b, 0x7d, 0x6f,
-	0xd4, 0x24, 0x09, 0x04, 0x25, 0x17, 0x54, 0xb4, 0x3a, 0x46, 0x48, 0x0a, 0x21, 0xfa, 0x57, 0x96,
-	0x91, 0x23, 0x5e, 0x59, 0xfe, 0x24, 0x0f, 0x67, 0x7a, 0xa8, 0x8a, 0x27, 0x9f, 0x58, 0xce, 0x90,
-	0x8e, 0x9c, 0x33, 0x7a, 0xe6, 0x83, 0xa1, 0x9e, 0xf9, 0x20, 0x9d, 0xd2, 0x96, 0xa1, 0xd4, 0x25,
-	0xdf, 0x14, 0x70, 0x98, 0x6e, 0x2c, 0x8d, 0xe5, 0xe2, 0x69, 0x2c, 0x50, 0x4e, 0x18, 0x0e, 0x97,
-	0x13, 0xae, 0x41, 0x85, 0xc7, 0xf7, 0x40, 0x31, 0x81, 0x9f, 0xb4, 0x46, 0xe8, 0x49, 0x6b, 0x86,
-	0xcd, 0x77, 0x0a, 0x04, 0xfc, 0x9c, 0xf5, 0x14, 0x66, 0x3d, 0x57, 0xb7, 0xb1, 0x45, 0x96, 0x0d,
-	0x5d, 0x86, 0xf9, 0x0d, 0xfb, 0xab, 0xfd, 0x02, 0xee, 0xa6, 0x40, 0x0f, 0x2a, 0x8f, 0xd6, 0x44,
-	0xa6, 0xbd, 0xa4, 0x29, 0x79, 0x07, 0x4e, 0x25, 0xd4, 0x3e, 0x02, 0xa9, 0x6e, 0x34, 0x45, 0xaa,
-	0x9b, 0x8f, 0xf9, 0x55, 0x27, 0xeb, 0x9d, 0x81, 0xf1, 0x50, 0xc2, 0x19, 0xa3, 0x09, 0x67, 0x6c,
-	0x2b, 0x90, 0x69, 0xee, 0x42, 0xa1, 0xa3, 0x4e, 0x5a, 0x73, 0x19, 0x1f, 0xb0, 0xe6, 0x32, 0xe1,
-	0xe3, 0xd1, 0x0a, 0xcb, 0x2a, 0x8c, 0x0b, 0x4d, 0x53, 0x32, 0x13, 0x03, 0x92, 0x19, 0xe3, 0x58,
-	0x94, 0x88, 0x03, 0x23, 0x4f, 0x5b, 0x88, 0x67, 0xbb, 0xcc, 0xf2, 0xd8, 0x95, 0x77, 0x6b, 0x03,
-	0x95, 0xd1, 0x6b, 0x7d, 0xbd, 0xa7, 0xf6, 0x0e, 0xa3, 0x7b, 0xdb, 0xf6, 0xdc, 0xb6, 0x2a, 0x56,
-	0xe9, 0xb8, 0x6e, 0xf1, 0x68, 0xae, 0x3b, 0xff, 0x21, 0x8c, 0x07, 0x29, 0xcb, 0x25, 0xc8, 0xec,
-	0xa1, 0x36, 0x8f, 0xa3, 0xe4, 0x4f, 0xf9, 0x3a, 0xe4, 0xf6, 0xf5, 0x7a, 0xab, 0xcb, 0x11, 0x8f,
-	0x56, 0xb2, 0x83, 0xde, 0x4a, 0xa8, 0xb5, 0x55, 0x86, 0x72, 0x7d, 0xe8, 0x9a, 0xc4, 0xf2, 0x4f,
-	0x20, 0x9a, 0xdf, 0x30, 0x3c, 0x6b, 0xdf, 0xf2, 0xda, 0x5f, 0x44, 0xf3, 0xb4, 0xd1, 0x3c, 0x28,
-	0xb9, 0x17, 0x18, 0xcd, 0xff, 0x2e, 0x2b, 0xa2, 0x79, 0xa2, 0xaa, 0x78, 0x34, 0x7f, 0x04, 0xc5,
-	0x88, 0xb8, 0x78, 0x3c, 0x5f, 0x0a, 0xf3, 0x12, 0x08, 0x34, 0xec, 0x00, 0xd7, 0xa6, 0x22, 0x54,
-	0x0b, 0x61, 0x91, 0xc6, 0xfc, 0x6f, 0xe8, 0x28, 0xfe, 0x17, 0x08, 0xb0, 0x99, 0x70, 0x80, 0x45,
-	0x50, 0x15, 0x67, 0x58, 0x3e, 0xa4, 0x45, 0xe2, 0x46, 0x76, 0xc0, 0x05, 0x17, 0x38, 0x9d, 0x1b,
-	0x8c, 0xcc, 0x46, 0x28, 0x8a, 0x3c, 0x84, 0xf2, 0x2e, 0xd2, 0x5d, 0x6f, 0x0b, 0xe9, 0x9e, 0x66,
-	0x22, 0x4f, 0xb7, 0xea, 0x98, 0x57, 0x81, 0xfb, 0x57, 0x3a, 0x4b, 0x3e, 0xea, 0x2d, 0x86, 0x19,
-	0x4f, 0x99, 0xc3, 0x47, 0x4e, 0x99, 0x17, 0x03, 0x8e, 0xe3, 0x3b, 0x14, 0xb5, 0x91, 0xd1, 0x8e,
-	0x37, 0x3c, 0x12, 0x13, 0x1d, 0x2b, 0xca, 0x1f, 0xd1, 0x8a, 0x7e, 0x28, 0xc1, 0x2b, 0xcc, 0x58,
-	0x42, 0x61, 0x8d, 0x17, 0x72, 0x53, 0xf9, 0xbc, 0x03, 0x25, 0x5e, 0x3e, 0x46, 0x91, 0x77, 0x85,
-	0x5b, 0x7d, 0xfd, 0x66, 0x80, 0x2d, 0xa8, 0x45, 0x41, 0x9d, 0x0f, 0x28, 0xbf, 0x39, 0x04, 0x67,
-	0x7b, 0x23, 0x72, 0x27, 0xc0, 0x9d, 0xe3, 0x81, 0x78, 0x4d, 0xe1, 0x5e, 0x70, 0xef, 0x79, 0x05,
-	0x7e, 0x72, 0x17, 0x0c, 0x7b, 0x1e, 0x82, 0x82, 0xce, 0x1d, 0x93, 0x26, 0x5d, 0x5c, 0x19, 0xa2,
-	0xb9, 0xe6, 0xad, 0xa3, 0x06, 0x11, 0xbe, 0xd0, 0x84, 0x1e, 0x98, 0xc2, 0xca, 0x5f, 0x4a, 0x24,
-	0x62, 0xc7, 0x84, 0x70, 0x47, 0xb7, 0xea, 0xa9, 0xb4, 0xb7, 0x0b, 0x85, 0x6d, 0x8a, 0x13, 0xd1,
-	0xdd, 0x8d, 0xa3, 0xe8, 0x2e, 0xb4, 0xba, 0x3a, 0xb1, 0x1d, 0xfc, 0xa9, 0xbc, 0x42, 0xe2, 0x56,
-	0x57, 0x14, 0x7e, 0xad, 0xf8, 0xa1, 0x04, 0x4a, 0x3c, 0xba, 0xdd, 0x13, 0x9e, 0x97, 0x82, 0xb1,
-	0x66, 0xd0, 0xd7, 0xc3, 0xbc, 0xad, 0x0e, 0xc0, 0x5b, 0xbf, 0x2d, 0x04, 0xc2, 0x81, 0x60, 0x70,
-	0x9d, 0xb8, 0x54, 0x0f, 0x3c, 0x6e, 0x20, 0xaf, 0x42, 0xc9, 0xd0, 0x6d, 0x03, 0xf9, 0x59, 0x06,
-	0xb1, 0xfd, 0xe7, 0xd5, 0x22, 0x1b, 0x57, 0xc5, 0x70, 0xd0, 0x4b, 0x83, 0x34, 0x5f, 0x92, 0x97,
-	0xf6, 0xda, 0x42, 0xdc, 0x4b, 0xcf, 0xf9, 0x4e, 0xda, 0x05, 0x8f, 0x6b, 0x3c, 0x60, 0xc8, 0x41,
-	0xc0, 0xff, 0x7f, 0x43, 0xee, 0xba, 0x7a, 0x77, 0x43, 0x4e, 0x42, 0xe1, 0x6c, 0xfd, 0x15, 0x35,
-	0xe4, 0x38, 0xff, 0x54, 0xc3, 0xa9, 0x18, 0xfb, 0x15, 0x28, 0x84, 0xed, 0x25, 0x85, 0x15, 0xf7,
-	0x5b, 0x5f, 0x9d, 0x08, 0x99, 0x9c, 0xb2, 0x94, 0x6c, 0x6f, 0x3e, 0x12, 0x67, 0xee, 0xef, 0x87,
-	0xa0, 0xba, 0x61, 0xed, 0xd8, 0x7a, 0xfd, 0x38, 0xaf, 0xd1, 0xdb, 0x50, 0xc0, 0x94, 0x48, 0x84,
-	0xb1, 0xb7, 0xfb, 0x3f, 0x47, 0xf7, 0x5c, 0x5b, 0x9d, 0x60, 0x64, 0xc5, 0x56, 0x2c, 0x58, 0x40,
-	0x87, 0x1e, 0x72, 0xc9, 0x4a, 0x09, 0xa7, 0xd3, 0x4c, 0xda, 0xd3, 0xe9, 0x9c, 0xa0, 0x16, 0x9b,
-	0x92, 0x6b, 0x30, 0x69, 0xec, 0x5a, 0x75, 0xb3, 0xb3, 0x8e, 0x63, 0xd7, 0xdb, 0xf4, 0xf0, 0x92,
-	0x57, 0xcb, 0x74, 0x4a, 0x20, 0x7d, 0xdd, 0xae, 0xb7, 0x95, 0x33, 0x70, 0xba, 0x2b, 0x2f, 0x5c,
-	0xd6, 0xff, 0x28, 0xc1, 0x79, 0x0e, 0x63, 0x79, 0xbb, 0xc7, 0x6e, 0x01, 0xf8, 0xb6, 0x04, 0x73,
-	0x5c, 0xea, 0x07, 0x96, 0xb7, 0xab, 0x25, 0xf5, 0x03, 0xdc, 0x1b, 0x54, 0x01, 0xfd, 0x36, 0xa4,
-	0xce, 0xe0, 0x30, 0xa0, 0xb0, 0xb3, 0x1b, 0xb0, 0xdc, 0x9f, 0x44, 0xcf, 0x97, 0x5c, 0xe5, 0x6f,
-	0x24, 0x38, 0xad, 0xa2, 0x86, 0xb3, 0x8f, 0x18, 0xa5, 0x23, 0x3e, 0x20, 0xbc, 0xb8, 0x1b, 0x4b,
-	0xf8, 0xaa, 0x91, 0x89, 0x5c, 0x35, 0x14, 0x85, 0x84, 0xbd, 0x6e, 0xdb, 0x17, 0xba, 0x1f, 0x82,
-	0x33, 0x9b, 0xc8, 0x6d, 0x58, 0xb6, 0xee, 0xa1, 0xe3, 0x68, 0xdd, 0x81, 0xb2, 0x27, 0xe8, 0x44,
-	0x94, 0x7d, 0xb3, 0xaf, 0xb2, 0xfb, 0xee, 0x40, 0x2d, 0xf9, 0xc4, 0x7f, 0x06, 0x7c, 0xee, 0x2c,
-	0x28, 0xbd, 0x38, 0xe2, 0xa2, 0xff, 0x1f, 0x09, 0xaa, 0xb7, 0x10, 0x49, 0x55, 0xc7, 0x91, 0xfb,
-	0x8b, 0xb3, 0xae, 0x57, 0xa1, 0xe4, 0x53, 0xe6, 0x15, 0x78, 0x7e, 0x1b, 0xf6, 0xeb, 0xe3, 0xbc,
-	0x54, 0x4f, 0x1f, 0x08, 0xea, 0x0e, 0x46, 0xc9, 0x12, 0x92, 0xd9, 0x5c, 0x34, 0x2c, 0x75, 0xe5,
-	0x9d, 0xcb, 0xe7, 0xcf, 0x25, 0x38, 0x45, 0x0b, 0xc4, 0xc7, 0xec, 0x47, 0x72, 0x09, 0x8d, 0xd4,
-	0xfd, 0x48, 0x3d, 0x57, 0x56, 0xc7, 0x29, 0x51, 0x11, 0x6b, 0xde, 0x80, 0x6a, 0x37, 0xf0, 0xde,
-	0x11, 0xe6, 0x0f, 0x33, 0xb0, 0xc4, 0x89, 0xb0, 0x0c, 0x78, 0x1c, 0x56, 0x1b, 0x5d, 0xb2, 0xf8,
-	0x9d, 0x01, 0x78, 0x1d, 0x60, 0x0b, 0x91, 0x44, 0x2e, 0xbf, 0x19, 0xf0, 0x3f, 0xde, 0x8a, 0x14,
-	0xaf, 0x9b, 0x54, 0x04, 0xc8, 0x9a, 0x80, 0x10, 0xf5, 0x93, 0x3e, 0xee, 0x9b, 0x7d, 0xf1, 0xee,
-	0x9b, 0xeb, 0xe6, 0xbe, 0xcb, 0x70, 0xae, 0x9f, 0x44, 0xb8, 0x89, 0x7e, 0x3c, 0x04, 0x0b, 0xe2,
-	0xfe, 0x1f, 0xbc, 0x72, 0x7c, 0x2e, 0xfc, 0xf7, 0x2a, 0xcc, 0x58, 0x58, 0x4b, 0x68, 0x92, 0xa2,
-	0xba, 0xc9, 0xab, 0x93, 0x16, 0xbe, 0x13, 0xed, 0x7e, 0xea, 0x5c, 0xfb, 0xb3, 0x47, 0xbc, 0xf6,
-	0x57, 0xe1, 0x64, 0xb2, 0x44, 0xb8, 0xc8, 0xfe, 0x5d, 0x82, 0xf3, 0x8f, 0x91, 0x6b, 0x6d, 0xb7,
-	0x63, 0x8b, 0xfb, 0x95, 0x94, 0xcf, 0x85, 0xf8, 0x7c, 0x49, 0x64, 0x8e, 0x28, 0x89, 0x0b, 0xb0,
-	0xdc, 0x9f, 0x51, 0x2e, 0x95, 0xff, 0xcd, 0x90, 0xbb, 0x0c, 0xb9, 0xd9, 0xad, 0x12, 0x73, 0xf4,
-	0x77, 0x71, 0x94, 0x7b, 0xd8, 0x8b, 0x13, 0x49, 0x0d, 0x78, 0x93, 0x64, 0xc0, 0xe1, 0x7d, 0x57,
-	0x2f, 0xb3, 0x29, 0xdf, 0xd1, 0xd7, 0x4c, 0xf9, 0x7d, 0x98, 0x14, 0x77, 0x36, 0xf3, 0x38, 0xbe,
-	0x2d, 0xfb, 0x54, 0x3a, 0x7b, 0x59, 0xf7, 0x6f, 0x9b, 0xf4, 0xa9, 0x84, 0xd6, 0x1f, 0x73, 0x69,
-	0xea, 0x8f, 0xc5, 0x0e, 0x3a, 0x2b, 0x40, 0xfa, 0x0a, 0x1f, 0x3e, 0x9a, 0xc2, 0xe5, 0x6b, 0x50,
-	0x89, 0x89, 0x47, 0x24, 0xce, 0x11, 0xfe, 0x26, 0x15, 0x96, 0x11, 0xcf, 0x9f, 0xca, 0x79, 0x92,
-	0x06, 0x7a, 0x6a, 0x5f, 0xe4, 0xc4, 0x0c, 0x5c, 0x64, 0x46, 0x95, 0x08, 0x49, 0x63, 0x13, 0xa1,
-	0x93, 0xca, 0x60, 0x36, 0xa1, 0x14, 0x6d, 0xa7, 0x4d, 0x6f, 0x2e, 0xc5, 0x48, 0xfb, 0xac, 0xac,
-	0x42, 0x91, 0x45, 0xdd, 0x63, 0x9c, 0xc9, 0x0a, 0x46, 0x88, 0xcb, 0x6e, 0x06, 0x98, 0xed, 0x66,
-	0x80, 0xbd, 0x34, 0x92, 0xeb, 0xa5, 0x91, 0x63, 0x1b, 0x83, 0x72, 0x09, 0x6a, 0x83, 0x2a, 0x8a,
-	0xeb, 0xf6, 0x4f, 0x25, 0x58, 0xbc, 0x85, 0xb0, 0xe1, 0x5a, 0x5b, 0xc7, 0x3a, 0x11, 0x7e, 0x03,
-	0x46, 0xd2, 0xd6, 0x27, 0xfa, 0x2d, 0xab, 0x0a, 0x8a, 0xca, 0x1f, 0x64, 0xe1, 0x4c, 0x0f, 0x68,
-	0x7e, 0xdc, 0xf9, 0x26, 0x94, 0x3a, 0xef, 0x82, 0x86, 0x63, 0x6f, 0x5b, 0x3b, 0xbc, 0x2c, 0x7a,
-	0x39, 0x79, 0x2f, 0x89, 0xea, 0x5f, 0xa5, 0x88, 0x6a, 0x11, 0x85, 0x07, 0xe4, 0x1d, 0x98, 0x4d,
-	0x78, 0x7e, 0xa4, 0x0d, 0xe0, 0x8c, 0xe1, 0x95, 0x14, 0x8b, 0xb0, 0x77, 0xce, 0x83, 0xa4, 0x61,
-	0xf9, 0x9b, 0x20, 0x37, 0x91, 0x6d, 0x5a, 0xf6, 0x8e, 0xc6, 0x4b, 0xa3, 0x16, 0xc2, 0x95, 0x0c,
-	0x2d, 0xb6, 0x5e, 0xec, 0xbe, 0xc6, 0x3a, 0xc3, 0x11, 0xf5, 0x0d, 0xba, 0x42, 0xb9, 0x19, 0x1a,
-	0xb4, 0x10, 0x96, 0xbf, 0x05, 0x25, 0x41, 0x9d, 0x9a, 0xb9, 0x4b, 0xdb, 0xba, 0x08, 0xed, 0xab,
-	0x7d, 0x69, 0x87, 0x8d, 0x8a, 0xae, 0x50, 0x6c, 0x06, 0xa6, 0x5c, 0x64, 0xcb, 0x08, 0xa6, 0x05,
-	0xfd, 0x70, 0xfa, 0xcf, 0xf5, 0xd3, 0x04, 0x5f, 0x24, 0xf6, 0x1c, 0x3c, 0xd9, 0x8c, 0x4f, 0x28,
-	0xbf, 0x91, 0x81, 0x8a, 0xca, 0xbf, 0xa0, 0x40, 0x34, 0x92, 0xe2, 0xc7, 0x57, 0x3e, 0x17, 0xe9,
-	0x6a, 0x1b, 0xa6, 0xc3, 0x4d, 0x48, 0x6d, 0xcd, 0xf2, 0x50, 0x43, 0x68, 0xf0, 0x4a, 0xaa, 0x46,
-	0xa4, 0xf6, 0x9a, 0x87, 0x1a, 0xea, 0xe4, 0x7e, 0x6c, 0x0c, 0xcb, 0xd7, 0x60, 0x98, 0xe6, 0x1f,
-	0xcc, 0x33, 0x5b, 0xd7, 0x87, 0x9e, 0x5b, 0xba, 0xa7, 0xdf, 0xac, 0x3b, 0x5b, 0x2a, 0x87, 0x97,
-	0xef, 0x40, 0xc1, 0x46, 0x07, 0xb4, 0x9f, 0x87, 0x53, 0xc8, 0x0d, 0x48, 0x61, 0xdc, 0x46, 0x07,
-	0x6a, 0x8b, 0x65, 0x2e, 0xcc, 0xaf, 0x4a, 0x5c, 0x07, 0x4f, 0x82, 0xdd, 0x4c, 0x42, 0x11, 0x5a,
-	0xac, 0x63, 0x8a, 0xf9, 0xe3, 0xb5, 0x44, 0x21, 0x04, 0x3e, 0x55, 0x09, 0x4a, 0x3b, 0x54, 0x41,
-	0x88, 0x74, 0x4d, 0x2d, 0x41, 0xc1, 0x45, 0x0d, 0xc7, 0x43, 0x9a, 0x51, 0x6f, 0x61, 0x0f, 0xb9,
-	0x54, 0x85, 0xa3, 0xea, 0x04, 0x1b, 0x5d, 0x65, 0x83, 0xca, 0x02, 0xcc, 0x25, 0x18, 0x0b, 0x8f,
-	0x80, 0x7f, 0x2c, 0xc1, 0xcc, 0x46, 0xdb, 0x36, 0x36, 0x76, 0x75, 0xd7, 0xe4, 0x4d, 0x54, 0x7c,
-	0xff, 0x4b, 0x50, 0xc0, 0x4e, 0xcb, 0x35, 0x3a, 0xe4, 0x99, 0x29, 0x4d, 0xb0, 0x51, 0x4e, 0x5e,
-	0x9e, 0x83, 0x3c, 0x26, 0xc8, 0xa2, 0x0d, 0x24, 0xa7, 0x8e, 0xd0, 0xdf, 0x6b, 0xa6, 0x7c, 0x03,
-	0xc6, 0x58, 0x37, 0x17, 0x7b, 0xed, 0xcb, 0x0c, 0xf8, 0xda, 0x07, 0x0c, 0x89, 0x0c, 0x2b, 0x73,
-	0x30, 0x1b, 0xdb, 0x1e, 0xdf, 0xfa, 0x4f, 0x72, 0x30, 0x49, 0xe6, 0x84, 0xd3, 0xa7, 0x70, 0x80,
-	0xd3, 0x30, 0xe6, 0xab, 0x86, 0x6f, 0x7b, 0x54, 0x05, 0x31, 0xb4, 0x66, 0x06, 0x2e, 0x8f, 0x99,
-	0xe0, 0x87, 0x06, 0x15, 0x18, 0x11, 0xb9, 0x8c, 0x25, 0x40, 0xf1, 0xb3, 0xcb, 0x4b, 0x76, 0xae,
-	0xcb, 0x4b, 0x76, 0xbc, 0x83, 0x62, 0xf8, 0x68, 0x1d, 0x14, 0x49, 0xbd, 0x32, 0x23, 0x89, 0xbd,
-	0x32, 0xd1, 0xb7, 0xde, 0xfc, 0x51, 0xde, 0x7a, 0xd7, 0x79, 0x63, 0x67, 0xe7, 0x0d, 0x86, 0xd2,
-	0x1a, 0x1d, 0x90, 0x56, 0x99, 0x20, 0xfb, 0x6f, 0x27, 0x94, 0xe2, 0x75, 0x18, 0x11, 0x4f, 0xb6,
-	0x30, 0xe0, 0x93, 0xad, 0x40, 0x08, 0xbe, 0x3c, 0x8f, 0x85, 0x5f, 0x9e, 0x57, 0x61, 0x9c, 0xb5,
-	0xfd, 0xf1, 0x6f, 0x69, 0xc6, 0x07, 0xfc, 0x96, 0x66, 0x8c, 0x76, 0x03, 0xf2, 0xcf, 0x68, 0x2e,
-	0x01, 0xfd, 0x0c, 0x86, 0x46, 0x72, 0xe4, 0x6a, 0x96, 0x89, 0x6c, 0xcf, 0xf2, 0xda, 0xb4, 0x4b,
-	0x65, 0x54, 0x95, 0xc9, 0xdc, 0x13, 0x3a, 0xb5, 0xc6, 0x67, 0xe4, 0x27, 0x50, 0x8c, 0x44, 0x3f,
-	0xde, 0x80, 0x59, 0x4b, 0x17, 0xf7, 0xd4, 0x42, 0x38, 0xe6, 0x29, 0x33, 0x30, 0x15, 0xb6, 0x74,
-	0xee, 0x02, 0xdf, 0x91, 0x60, 0x41, 0x1c, 0x0d, 0x5e, 0x72, 0xaf, 0xb5, 0xf2, 0xdf, 0x12, 0x9c,
-	0x4c, 0xde, 0x0b, 0x3f, 0xa1, 0xec, 0xc2, 0xa4, 0xa1, 0x1b, 0xbb, 0x28, 0xfc, 0xf5, 0xdd, 0xb1,
-	0x83, 0x62, 0x99, 0x12, 0x0d, 0x0e, 0xc9, 0x36, 0xcc, 0x98, 0xba, 0xa7, 0x6f, 0xe9, 0x38, 0xba,
-	0xd8, 0xd0, 0x31, 0x17, 0x9b, 0x12, 0x74, 0x83, 0xa3, 0xca, 0x3f, 0x49, 0x30, 0x2f, 0x58, 0xe7,
-	0x2a, 0xbb, 0xe7, 0xe0, 0xe0, 0xbb, 0xe6, 0xae, 0x83, 0x3d, 0x4d, 0x37, 0x4d, 0x17, 0x61, 0x2c,
-	0xb4, 0x40, 0xc6, 0x6e, 0xb0, 0xa1, 0x5e, 0x41, 0x34, 0xaa, 0xc3, 0xcc, 0xa0, 0xf9, 0x3c, 0x7b,
-	0xfc, 0x7c, 0xae, 0xfc, 0x6b, 0xc0, 0xc0, 0x42, 0x9c, 0x71, 0x9d, 0xbe, 0x02, 0x13, 0x74, 0x9f,
-	0x58, 0xb3, 0x5b, 0x8d, 0x2d, 0x9e, 0x22, 0x72, 0xea, 0x38, 0x1b, 0x7c, 0x44, 0xc7, 0xe4, 0x05,
-	0x18, 0x15, 0xcc, 0xb1, 0x77, 0xf3, 0x9c, 0x9a, 0xe7, 0xdc, 0x61, 0xf9, 0x03, 0x28, 0x76, 0xd8,
-	0xa3, 0xaa, 0xec, 0xf9, 0x49, 0xa1, 0x0f, 0x4b, 0x58, 0xf0, 0x5b, 0x27, 0x56, 0x09, 0x1e, 0x3d,
-	0x2f, 0x15, 0xec, 0xd0, 0x18, 0x8d, 0x11, 0x5c, 0xec, 0xac, 0x2f, 0x48, 0xfc, 0xbc, 0x9f, 0xcd,
-	0x67, 0x4b, 0x39, 0xa5, 0x06, 0xe5, 0xd5, 0xba, 0x83, 0x11, 0x4d, 0x30, 0x42, 0x61, 0x41, 0x6d,
-	0x48, 0x21, 0x6d, 0x28, 0x53, 0x20, 0x07, 0xe1, 0xb9, 0x1f, 0xbe, 0x0e, 0xc5, 0xbb, 0xc8, 0x1b,
-	0x94, 0xc6, 0x87, 0x50, 0xea, 0x40, 0x73, 0x41, 0x3e, 0x00, 0xe0, 0xe0, 0xe4, 0x4c, 0xcd, 0x7c,
-	0xe2, 0xe2, 0x20, 0x66, 0x4a, 0xc9, 0x50, 0xd6, 0x99, 0x90, 0xc9, 0x9f, 0xca, 0x3f, 0x4b, 0x50,
-	0x66, 0xef, 0x10, 0xc1, 0xd2, 0x58, 0xf7, 0x2d, 0xc9, 0x77, 0x20, 0x4f, 0x8e, 0x07, 0x3b, 0x24,
-	0x64, 0x0d, 0xd1, 0xce, 0xeb, 0x0b, 0xbd, 0xfb, 0xba, 0xd9, 0x0b, 0x22, 0xc3, 0x50, 0x7d, 0xdc,
-	0x60, 0x8b, 0x56, 0x26, 0xd4, 0xa2, 0xb5, 0x06, 0xc5, 0x7d, 0x0b, 0x5b, 0x5b, 0x56, 0x9d, 0xb6,
-	0x50, 0xa4, 0x69, 0xfe, 0x29, 0x74, 0x10, 0xe9, 0x91, 0x60, 0x0a, 0xe4, 0x20, 0x6f, 0xa2, 0x2e,
-	0x28, 0xc1, 0xa9, 0xbb, 0xc8, 0x53, 0x3b, 0x1f, 0x16, 0x3f, 0x64, 0x1f, 0x15, 0xfb, 0xe7, 0x99,
-	0x07, 0x30, 0x4c, 0x5b, 0x1a, 0x89, 0x03, 0x66, 0xba, 0x1a, 0x58, 0xe0, 0xcb, 0x64, 0x56, 0xa7,
-	0xf5, 0x7f, 0xd2, 0xe6, 0x47, 0x95, 0xd3, 0x20, 0x6e, 0xc9, 0x8f, 0x45, 0xb4, 0xb5, 0x87, 0x9f,
-	0x21, 0xc6, 0xf8, 0x18, 0xb1, 0x4c, 0xe5, 0xfb, 0x43, 0x50, 0xed, 0xb6, 0x25, 0xae, 0xf6, 0x5f,
-	0x83, 0x02, 0x53, 0x09, 0xff, 0x02, 0x5a, 0xec, 0xed, 0xbd, 0x01, 0x5b, 0x59, 0x7a, 0x93, 0x67,
-	0xc6, 0x21, 0x46, 0x59, 0x1b, 0x23, 0xf3, 0x57, 0x31, 0x36, 0xdf, 0x06, 0x39, 0x0e, 0x14, 0xec,
-	0x48, 0xcc, 0xb1, 0x8e, 0xc4, 0x87, 0xe1, 0x8e, 0xc4, 0x37, 0x52, 0xca, 0xce, 0xdf, 0x59, 0xa7,
-	0x49, 0x51, 0xf9, 0x08, 0x16, 0xef, 0x22, 0xef, 0xd6, 0x83, 0x77, 0x7a, 0xe8, 0xec, 0x31, 0xff,
-	0x34, 0x84, 0x78, 0x85, 0x90, 0x4d, 0xda, 0xb5, 0xfd, 0xbb, 0x14, 0xfd, 0x5a, 0x84, 0xfc, 0x85,
-	0x95, 0xdf, 0x92, 0xe0, 0x4c, 0x8f, 0xc5, 0xb9, 0x76, 0x3e, 0x84, 0x72, 0x80, 0x2c, 0x6f, 0xfc,
-	0x91, 0xa2, 0xf7, 0xc5, 0x81, 0x37, 0xa1, 0x96, 0xdc, 0xf0, 0x00, 0x56, 0xbe, 0x2b, 0xc1, 0x14,
-	0xed, 0xde, 0x14, 0xd1, 0x38, 0x45, 0xe6, 0xfe, 0x7a, 0xb4, 0xe8, 0xf0, 0xe5, 0xbe, 0x45, 0x87,
-	0xa4, 0xa5, 0x3a, 0x85, 0x86, 0x3d, 0x98, 0x8e, 0x00, 0x70, 0x39, 0xa8, 0x90, 0x8f, 0xb4, 0x5a,
-	0x7d, 0x25, 0xed, 0x52, 0xbc, 0xdf, 0xc9, 0xa7, 0xa3, 0xfc, 0xbe, 0x04, 0x53, 0x2a, 0xd2, 0x9b,
-	0xcd, 0x3a, 0x2b, 0x0e, 0xe2, 0x14, 0x9c, 0x6f, 0x44, 0x39, 0x4f, 0xee, 0xb7, 0x0e, 0x7e, 0x84,
-	0xcf, 0xd4, 0x11, 0x5f, 0xae, 0xc3, 0xfd, 0x2c, 0x4c, 0x47, 0x00, 0xf8, 0x4e, 0xff, 0x62, 0x08,
-	0xa6, 0x99, 0xad, 0x44, 0xad, 0xf3, 0x36, 0x64, 0xfd, 0xa6, 0xfa, 0x42, 0xf0, 0x76, 0x9f, 0x14,
-	0x31, 0x6f, 0x21, 0xdd, 0x7c, 0x80, 0x3c, 0x0f, 0xb9, 0xb4, 0x05, 0x8c, 0xb6, 0x0b, 0x52, 0xf4,
-	0x5e, 0xc9, 0x3f, 0x7e, 0x07, 0xcb, 0x24, 0xdd, 0xc1, 0xde, 0x80, 0x8a, 0x65, 0x13, 0x08, 0x6b,
-	0x1f, 0x69, 0xc8, 0xf6, 0xc3, 0x49, 0xa7, 0x52, 0x37, 0xed, 0xcf, 0xdf, 0xb6, 0x85, 0xb3, 0xaf,
-	0x99, 0xf2, 0x05, 0x28, 0x37, 0xf4, 0x43, 0xab, 0xd1, 0x6a, 0x68, 0x4d, 0x02, 0x8f, 0xad, 0x8f,
-	0xd8, 0x17, 0xf4, 0x39, 0xb5, 0xc8, 0x27, 0xd6, 0xf5, 0x1d, 0xb4, 0x61, 0x7d, 0x84, 0xe4, 0x73,
-	0x50, 0xa4, 0xdd, 0xf6, 0x14, 0x90, 0x35, 0x87, 0x0f, 0xd3, 0xe6, 0x70, 0xda, 0x84, 0x4f, 0xc0,
-	0xd8, 0xd7, 0x70, 0xff, 0xc1, 0x3e, 0xa6, 0x0e, 0xc9, 0x8b, 0x1b, 0xd2, 0x73, 0x12, 0x58, 0xa2,
-	0x5f, 0x0e, 0x3d, 0x47, 0xbf, 0x4c, 0xe2, 0x35, 0x93, 0xc4, 0xeb, 0xbf, 0x48, 0x30, 0xbb, 0xde,
-	0x72, 0x77, 0xd0, 0xcf, 0xa3, 0x75, 0x28, 0xf3, 0x50, 0x89, 0x33, 0x27, 0x3a, 0xbc, 0x86, 0x60,
-	0xf6, 0x21, 0xfa, 0x39, 0xe5, 0xfc, 0x85, 0xf8, 0xc5, 0x4d, 0xa8, 0xc4, 0x05, 0xc6, 0x1d, 0x23,
-	0x81, 0x86, 0x94, 0x44, 0xe3, 0xfb, 0xf4, 0x63, 0xb2, 0x6d, 0x17, 0xe1, 0xdd, 0x60, 0x45, 0x30,
-	0x4d, 0xf0, 0x7c, 0x3f, 0x1a, 0x3c, 0x7f, 0x79, 0xc0, 0xe0, 0xd9, 0x75, 0xd5, 0x4e, 0x0c, 0xa5,
-	0xdf, 0x97, 0x25, 0xc1, 0x71, 0xa3, 0xf9, 0x9e, 0x04, 0x17, 0xee, 0x22, 0x1b, 0xb9, 0xba, 0x87,
-	0x1e, 0xe8, 0xd8, 0x13, 0xf7, 0xdd, 0x88, 0xfb, 0xbd, 0x8c, 0xeb, 0xeb, 0x45, 0x78, 0x6d, 0xa0,
-	0x9d, 0x71, 0x4e, 0xee, 0xc0, 0x42, 0xf8, 0xec, 0x15, 0xae, 0x9d, 0x9d, 0x87, 0x62, 0xb8, 0x34,
-	0xc7, 0xce, 0x0d, 0xa3, 0x6a, 0x21, 0x54, 0x9b, 0xc3, 0x4a, 0x0b, 0x4e, 0x26, 0xd3, 0xe1, 0x86,
-	0xf1, 0x2e, 0x0c, 0xb3, 0xbb, 0x14, 0x3f, 0x77, 0xbc, 0x39, 0xe0, 0xc1, 0x90, 0xdf, 0x2e, 0xa2,
-	0x64, 0x39, 0x31, 0xe5, 0x6f, 0x87, 0x61, 0x26, 0x19, 0xa4, 0xd7, 0x2d, 0xe1, 0xcb, 0x30, 0xdb,
-	0xd0, 0x0f, 0xb5, 0x68, 0xec, 0xed, 0x7c, 0x00, 0x36, 0xd5, 0xd0, 0x0f, 0xa3, 0x27, 0x2f, 0x53,
-	0x7e, 0x00, 0x25, 0x46, 0xb1, 0xee, 0x18, 0x7a, 0x7d, 0xd0, 0x5a, 0xe0, 0x30, 0x39, 0xfc, 0x57,
-	0x24, 0x95, 0x1d, 0x90, 0x1f, 0x10, 0x54, 0x5a, 0x31, 0xfa, 0x28, 0x2e, 0x5a, 0x56, 0xc2, 0x7f,
-	0xe7, 0x58, 0xa2, 0xa9, 0xa9, 0x21, 0xc5, 0xb0, 0xc3, 0x72, 0x44, 0x5b, 0xf2, 0x6f, 0x4b, 0x30,
-	0xb9, 0xab, 0xdb, 0xa6, 0xb3, 0xcf, 0x8f, 0xfd, 0xd4, 0x0c, 0xc9, 0xd5, 0x32, 0xcd, 0x87, 0x47,
-	0x5d, 0x36, 0x70, 0x8f, 0x13, 0xf6, 0x6f, 0xb5, 0x7c, 0x13, 0xf2, 0x6e, 0x6c, 0x42, 0x6e, 0xc2,
-	0xd9, 0x44, 0x4d, 0x44, 0xef, 0x58, 0x83, 0x96, 0x15, 0x17, 0xe3, 0x8a, 0x7b, 0x1c, 0xba, 0x75,
-	0xcd, 0x7f, 0x57, 0x82, 0xc9, 0x04, 0x11, 0x25, 0x7c, 0xbc, 0xf4, 0x41, 0xf8, 0xaa, 0x70, 0xf7,
-	0x58, 0x52, 0x59, 0x47, 0x2e, 0x5f, 0x2f, 0x70, 0x75, 0x98, 0xff, 0xb6, 0x04, 0xb3, 0x5d, 0xc4,
-	0x95, 0xb0, 0x21, 0x35, 0xbc, 0xa1, 0xaf, 0x0d, 0xb8, 0xa1, 0xd8, 0x02, 0xf4, 0x12, 0x11, 0xb8,
-	0xc0, 0xbc, 0x07, 0xd3, 0x89, 0x30, 0xf2, 0xdb, 0x70, 0xd2, 0xb7, 0x92, 0x24, 0x67, 0x91, 0xa8,
-	0xb3, 0xcc, 0x09, 0x98, 0x98, 0xc7, 0x28, 0x7f, 0x26, 0xc1, 0x62, 0x3f, 0x79, 0xc8, 0x0a, 0x4c,
-	0xe8, 0xc6, 0x1e, 0x32, 0x23, 0x64, 0xc7, 0xe8, 0x20, 0x77, 0xbd, 0x0f, 0x60, 0x3e, 0x00, 0x13,
-	0xb5, 0x8e, 0x41, 0xbf, 0xf7, 0x99, 0xf5, 0x49, 0x86, 0x8d, 0x42, 0xf9, 0x1d, 0x09, 0xe6, 0x55,
-	0xb4, 0xd5, 0xb2, 0xea, 0xe6, 0xcb, 0x2e, 0x3f, 0x9e, 0x22, 0x89, 0x31, 0x61, 0x27, 0x3c, 0x5e,
-	0xff, 0xf5, 0x10, 0x2c, 0x85, 0xbb, 0xdf, 0x3a, 0xac, 0xb0, 0x67, 0xe1, 0x97, 0xf1, 0xff, 0x29,
-	0xd6, 0x61, 0x32, 0xf8, 0x44, 0xc4, 0xff, 0x9b, 0xc0, 0xc0, 0x0f, 0x25, 0xe5, 0xc0, 0x7b, 0x10,
-	0xfb, 0xd7, 0x01, 0x21, 0x8a, 0xb4, 0x07, 0x30, 0x5d, 0xad, 0xc5, 0xa7, 0x48, 0x8b, 0x5c, 0x54,
-	0xc7, 0xcb, 0x70, 0xae, 0x9f, 0xe0, 0xb8, 0x8c, 0x7f, 0x4f, 0x82, 0xe9, 0x77, 0x9b, 0x66, 0xe0,
-	0x3d, 0x2c, 0x85, 0x4c, 0xd7, 0xa3, 0xc7, 0x92, 0xfe, 0x57, 0xcc, 0xc4, 0xb5, 0x3a, 0x87, 0x91,
-	0x06, 0xcc, 0x44, 0x21, 0x78, 0x52, 0xdd, 0x88, 0xdd, 0x67, 0xdf, 0x48, 0xbd, 0x58, 0xf4, 0x42,
-	0x7b, 0xb3, 0xf9, 0xc9, 0xa7, 0xd5, 0x13, 0x3f, 0xfa, 0xb4, 0x7a, 0xe2, 0xa7, 0x9f, 0x56, 0xa5,
-	0x5f, 0x7f, 0x56, 0x95, 0x7e, 0xf0, 0xac, 0x2a, 0xfd, 0xc3, 0xb3, 0xaa, 0xf4, 0xc9, 0xb3, 0xaa,
-	0xf4, 0x6f, 0xcf, 0xaa, 0xd2, 0x4f, 0x9e, 0x55, 0x4f, 0xfc, 0xf4, 0x59, 0x55, 0xfa, 0xf8, 0xb3,
-	0xea, 0x89, 0x4f, 0x3e, 0xab, 0x9e, 0xf8, 0xd1, 0x67, 0xd5, 0x13, 0xef, 0x5f, 0xdf, 0x71, 0x3a,
-	0x4b, 0x5b, 0x4e, 0xcf, 0x7f, 0x1b, 0xf9, 0x4b, 0xe1, 0x91, 0xad, 0x61, 0xaa, 0xc6, 0xab, 0xff,
-	0x17, 0x00, 0x00, 0xff, 0xff, 0x93, 0x8b, 0x01, 0x49, 0x75, 0x52, 0x00, 0x00,
+	0x75, 0xea, 0xf9, 0x90, 0x33, 0x8f, 0xe4, 0xcc, 0xb0, 0xf9, 0x1b, 0x91, 0xd2, 0x88, 0xea, 0x15,
+	0x25, 0xae, 0x76, 0x35, 0x5c, 0x49, 0xb6, 0x77, 0x2d, 0x7b, 0xbd, 0x91, 0x28, 0x89, 0xa2, 0x20,
+	0x69, 0xb9, 0x4d, 0x4a, 0xda, 0xac, 0xbd, 0xee, 0x6d, 0x76, 0x17, 0x39, 0x1d, 0xce, 0x74, 0x8f,
+	0xba, 0x7a, 0x48, 0xce, 0xe6, 0x90, 0x8f, 0x91, 0x9f, 0x13, 0x24, 0x0b, 0xe4, 0x62, 0x04, 0x0e,
+	0x10, 0x04, 0x08, 0x62, 0x04, 0x08, 0x12, 0x24, 0x87, 0xc0, 0x87, 0x5c, 0x12, 0x20, 0xc8, 0x71,
+	0x91, 0x93, 0x91, 0x1c, 0x9c, 0xd5, 0x1e, 0xe2, 0x20, 0x39, 0xf8, 0x18, 0x24, 0x39, 0x04, 0xf5,
+	0xeb, 0xe9, 0xdf, 0xfc, 0x48, 0x29, 0x5a, 0xdb, 0x7b, 0x9b, 0xae, 0x7a, 0xef, 0x55, 0xbd, 0x6f,
+	0x55, 0xbd, 0x7a, 0x35, 0xf0, 0x55, 0x0f, 0x35, 0x9a, 0x8e, 0xab, 0xd7, 0x57, 0x30, 0x72, 0xf7,
+	0x91, 0xbb, 0xa2, 0x37, 0xad, 0x95, 0x9a, 0x85, 0x3d, 0xc7, 0x6d, 0x93, 0x16, 0xcb, 0x40, 0x2b,
+	0xfb, 0x97, 0x57, 0x5c, 0xf4, 0xa4, 0x85, 0xb0, 0xa7, 0xb9, 0x08, 0x37, 0x1d, 0x1b, 0xa3, 0x6a,
+	0xd3, 0x75, 0x3c, 0x47, 0x5e, 0x12, 0xd8, 0x55, 0x86, 0x5d, 0xd5, 0x9b, 0x56, 0x35, 0x8c, 0x5d,
+	0xdd, 0xbf, 0x3c, 0x5f, 0xd9, 0x75, 0x9c, 0xdd, 0x3a, 0x5a, 0xa1, 0x48, 0xdb, 0xad, 0x9d, 0x15,
+	0xb3, 0xe5, 0xea, 0x9e, 0xe5, 0xd8, 0x8c, 0xcc, 0xfc, 0x99, 0x68, 0xbf, 0x67, 0x35, 0x10, 0xf6,
+	0xf4, 0x46, 0x93, 0x03, 0x9c, 0x35, 0x51, 0x13, 0xd9, 0x26, 0xb2, 0x0d, 0x0b, 0xe1, 0x95, 0x5d,
+	0x67, 0xd7, 0xa1, 0xed, 0xf4, 0x17, 0x07, 0x39, 0xe7, 0x33, 0x42, 0x38, 0x30, 0x9c, 0x46, 0xc3,
+	0xb1, 0xc9, 0xcc, 0x1b, 0x08, 0x63, 0x7d, 0x97, 0x4f, 0x78, 0x7e, 0x29, 0x04, 0xc5, 0x67, 0x1a,
+	0x07, 0xbb, 0x10, 0x02, 0xf3, 0x74, 0xbc, 0xf7, 0xa4, 0x85, 0x5a, 0x28, 0x0e, 0x18, 0x1e, 0x15,
+	0xd9, 0xad, 0x06, 0x26, 0x40, 0x07, 0x8e, 0xbb, 0xb7, 0x53, 0x77, 0x0e, 0x38, 0xd4, 0xf9, 0x10,
+	0x94, 0xe8, 0x8c, 0x53, 0x7b, 0x29, 0x04, 0xf7, 0xa4, 0x85, 0xdc, 0x76, 0x3f, 0x16, 0x76, 0x74,
+	0xab, 0xde, 0x72, 0x13, 0x66, 0x76, 0x31, 0x49, 0xb1, 0x46, 0xdd, 0x31, 0xf6, 0xe2, 0xb0, 0xaf,
+	0xf6, 0x30, 0x82, 0x38, 0xf4, 0xcb, 0x49, 0xd0, 0x3e, 0xeb, 0x4c, 0xf2, 0x1c, 0xf4, 0x95, 0x9e,
+	0xa0, 0x11, 0x29, 0x5d, 0xe8, 0x09, 0x4c, 0x94, 0xc0, 0x01, 0x2f, 0x25, 0x01, 0x76, 0x97, 0x6a,
+	0x35, 0x09, 0xdc, 0xd6, 0x1b, 0x08, 0x37, 0x75, 0x23, 0x41, 0x72, 0xaf, 0x25, 0xc1, 0xbb, 0xa8,
+	0x59, 0xb7, 0x0c, 0x6a, 0xb4, 0x71, 0x8c, 0xab, 0x49, 0x18, 0x4d, 0xe4, 0x62, 0x0b, 0x7b, 0xc8,
+	0x66, 0x63, 0xa0, 0x43, 0x64, 0xb4, 0x08, 0x3a, 0xe6, 0x48, 0x6f, 0x0d, 0x80, 0x24, 0x98, 0xd2,
+	0x1a, 0x2d, 0x4f, 0xdf, 0xae, 0x23, 0x0d, 0x7b, 0xba, 0x27, 0x46, 0xfd, 0x52, 0xa2, 0x55, 0xf5,
+	0x75, 0xda, 0xf9, 0x6b, 0x49, 0x03, 0xeb, 0x66, 0xc3, 0xb2, 0xfb, 0xe2, 0x2a, 0xbf, 0x3d, 0x02,
+	0xa7, 0x37, 0x3d, 0xdd, 0xf5, 0x1e, 0xf3, 0xe1, 0x6e, 0x09, 0xb6, 0x54, 0x86, 0x20, 0x9f, 0x85,
+	0x71, 0x5f, 0xb6, 0x9a, 0x65, 0x96, 0xa5, 0x45, 0x69, 0x39, 0xaf, 0x8e, 0xf9, 0x6d, 0xeb, 0xa6,
+	0x6c, 0xc0, 0x04, 0x26, 0x34, 0x34, 0x3e, 0x48, 0x39, 0xb5, 0x28, 0x2d, 0x8f, 0x5d, 0xf9, 0x9a,
+	0xaf, 0x28, 0x1a, 0x46, 0x22, 0x0c, 0x55, 0xf7, 0x2f, 0x57, 0x7b, 0x8e, 0xac, 0x8e, 0x53, 0xa2,
+	0x62, 0x1e, 0x35, 0x98, 0x69, 0xea, 0x2e, 0xb2, 0x3d, 0xcd, 0x97, 0xbc, 0x66, 0xd9, 0x3b, 0x4e,
+	0x39, 0x4d, 0x07, 0xfb, 0x42, 0x35, 0x29, 0x74, 0xf9, 0x16, 0xb9, 0x7f, 0xb9, 0xba, 0x41, 0xb1,
+	0xfd, 0x51, 0xd6, 0xed, 0x1d, 0x47, 0x9d, 0x6a, 0xc6, 0x1b, 0xe5, 0x32, 0x8c, 0xea, 0x1e, 0xa1,
+	0xe6, 0x95, 0x33, 0x8b, 0xd2, 0x72, 0x56, 0x15, 0x9f, 0x72, 0x03, 0x14, 0x5f, 0x83, 0x9d, 0x59,
+	0xa0, 0xc3, 0xa6, 0xc5, 0xc2, 0x9f, 0x46, 0xe2, 0x5c, 0x39, 0x4b, 0x27, 0x34, 0x5f, 0x65, 0x41,
+	0xb0, 0x2a, 0x82, 0x60, 0x75, 0x4b, 0x04, 0xc1, 0x1b, 0x99, 0x8f, 0x7e, 0x78, 0x46, 0x52, 0xcf,
+	0x1c, 0x44, 0x39, 0xbf, 0xe5, 0x53, 0x22, 0xb0, 0x72, 0x0d, 0x4e, 0x1a, 0x8e, 0xed, 0x59, 0x76,
+	0x0b, 0x69, 0x3a, 0xd6, 0x6c, 0x74, 0xa0, 0x59, 0xb6, 0xe5, 0x59, 0xba, 0xe7, 0xb8, 0xe5, 0x91,
+	0x45, 0x69, 0xb9, 0x70, 0xe5, 0x52, 0x58, 0xc6, 0xd4, 0xbb, 0x08, 0xb3, 0xab, 0x1c, 0xef, 0x3a,
+	0x7e, 0x80, 0x0e, 0xd6, 0x05, 0x92, 0x3a, 0x6b, 0x24, 0xb6, 0xcb, 0xf7, 0x61, 0x52, 0xf4, 0x98,
+	0x1a, 0x0f, 0x41, 0xe5, 0x51, 0xca, 0xc7, 0x62, 0x78, 0x04, 0xde, 0x49, 0xc6, 0xb8, 0xcd, 0x7e,
+	0xaa, 0x25, 0x1f, 0x95, 0xb7, 0xc8, 0x8f, 0x60, 0xb6, 0xae, 0x63, 0x4f, 0x33, 0x9c, 0x46, 0xb3,
+	0x8e, 0xa8, 0x64, 0x5c, 0x84, 0x5b, 0x75, 0xaf, 0x9c, 0x4b, 0xa2, 0xc9, 0x43, 0x0c, 0xd5, 0x51,
+	0xbb, 0xee, 0xe8, 0x26, 0x56, 0xa7, 0x09, 0xfe, 0xaa, 0x8f, 0xae, 0x52, 0x6c, 0xf9, 0x9b, 0xb0,
+	0xb0, 0x63, 0xb9, 0xd8, 0xd3, 0x7c, 0x2d, 0x90, 0x28, 0xa2, 0x6d, 0xeb, 0xc6, 0x9e, 0xb3, 0xb3,
+	0x53, 0xce, 0x53, 0xe2, 0x27, 0x63, 0x82, 0xbf, 0xc9, 0x57, 0xa7, 0x1b, 0x99, 0xef, 0x10, 0xb9,
+	0x97, 0x29, 0x0d, 0x61, 0x76, 0x5b, 0x3a, 0xde, 0xbb, 0xc1, 0x08, 0x28, 0x87, 0x50, 0xe9, 0x66,
+	0x92, 0xcc, 0x6b, 0xe4, 0x19, 0x18, 0x71, 0x5b, 0x76, 0xc7, 0x0f, 0xb2, 0x6e, 0xcb, 0x5e, 0x37,
+	0xe5, 0xb7, 0x20, 0x4b, 0x43, 0x31, 0xb7, 0xfc, 0x97, 0x13, 0x8d, 0x91, 0x42, 0x10, 0x2e, 0x1f,
+	0x21, 0xc3, 0x73, 0xdc, 0x55, 0xf2, 0xa9, 0x32, 0x3c, 0xe5, 0x3f, 0x24, 0x98, 0x5d, 0x43, 0xde,
+	0x7d, 0x16, 0x16, 0x36, 0x3d, 0xdd, 0x43, 0x43, 0x38, 0xe0, 0x1a, 0xe4, 0x7d, 0x73, 0x8c, 0x4f,
+	0x21, 0x2c, 0xe2, 0x38, 0x6f, 0x1d, 0x5c, 0xf9, 0x2a, 0xcc, 0xa2, 0xc3, 0x26, 0x32, 0x3c, 0x64,
+	0x6a, 0x36, 0x3a, 0xf4, 0x34, 0xb4, 0x4f, 0x3c, 0xce, 0x32, 0xa9, 0x97, 0xa5, 0xd5, 0x29, 0xd1,
+	0xfb, 0x00, 0x1d, 0x7a, 0xb7, 0x48, 0xdf, 0xba, 0x29, 0xbf, 0x06, 0xd3, 0x46, 0xcb, 0xa5, 0xae,
+	0xb9, 0xed, 0xea, 0xb6, 0x51, 0xd3, 0x3c, 0x67, 0x0f, 0xd9, 0xd4, 0x79, 0xc6, 0x55, 0x99, 0xf7,
+	0xdd, 0xa0, 0x5d, 0x5b, 0xa4, 0x47, 0xf9, 0x61, 0x0e, 0xe6, 0x62, 0xdc, 0x72, 0x09, 0x87, 0x78,
+	0x91, 0x8e, 0xc1, 0xcb, 0x3a, 0x4c, 0x74, 0xcc, 0xa4, 0xdd, 0x44, 0x5c, 0x30, 0xe7, 0xfa, 0x11,
+	0xdb, 0x6a, 0x37, 0x91, 0x3a, 0x7e, 0x10, 0xf8, 0x92, 0x15, 0x98, 0x48, 0x92, 0xc6, 0x98, 0x1d,
+	0x90, 0xc2, 0x97, 0xe1, 0x64, 0xd3, 0x45, 0xfb, 0x96, 0xd3, 0xc2, 0x1a, 0x0d, 0x5c, 0xc8, 0xec,
+	0xc0, 0x67, 0x28, 0xfc, 0xac, 0x00, 0xd8, 0x64, 0xfd, 0x02, 0xf5, 0x12, 0x4c, 0x51, 0x77, 0x61,
+	0xb6, 0xed, 0x23, 0x65, 0x29, 0x52, 0x89, 0x74, 0xdd, 0x26, 0x3d, 0x02, 0x7c, 0x15, 0x80, 0x9a,
+	0x3d, 0xdd, 0xc2, 0x94, 0x47, 0x92, 0xb8, 0xf2, 0x77, 0x38, 0x84, 0x31, 0x62, 0xe1, 0xef, 0x90,
+	0x0f, 0x35, 0xef, 0x89, 0x9f, 0xf2, 0x06, 0x4c, 0x62, 0xcf, 0x32, 0xf6, 0xda, 0x5a, 0x80, 0xd6,
+	0xe8, 0x10, 0xb4, 0x8a, 0x0c, 0xdd, 0x6f, 0x90, 0x7f, 0x11, 0x5e, 0x89, 0x51, 0xd4, 0xb0, 0x51,
+	0x43, 0x66, 0xab, 0x8e, 0x34, 0xcf, 0x61, 0x52, 0xa1, 0x21, 0xd2, 0x69, 0x79, 0xe5, 0xb1, 0xc1,
+	0x9c, 0x75, 0x29, 0x32, 0xcc, 0x26, 0x27, 0xb8, 0xe5, 0x50, 0x21, 0x6e, 0x31, 0x6a, 0x5d, 0x6d,
+	0x70, 0xa2, 0x9b, 0x0d, 0xca, 0x5f, 0x87, 0x82, 0x6f, 0x1e, 0x74, 0x15, 0x2e, 0x17, 0x69, 0x44,
+	0x4d, 0x5e, 0x48, 0xfc, 0xc0, 0x1a, 0x33, 0x39, 0x66, 0xbd, 0xbe, 0xa9, 0xd1, 0x4f, 0xf9, 0x31,
+	0x14, 0x43, 0xc4, 0x5b, 0xb8, 0x5c, 0xa2, 0xd4, 0xab, 0x5d, 0xe2, 0x75, 0x22, 0xd9, 0x16, 0x56,
+	0x0b, 0x41, 0xba, 0x2d, 0x2c, 0xbf, 0x0f, 0x93, 0xfb, 0xc8, 0xc5, 0x24, 0xa2, 0xb2, 0xfd, 0x9c,
+	0x85, 0x70, 0x79, 0x92, 0x8a, 0xf2, 0xb5, 0x6a, 0x8f, 0xcd, 0x3b, 0x0b, 0x3b, 0x14, 0xf1, 0x8e,
+	0xc0, 0x53, 0x4b, 0xfb, 0x91, 0x16, 0xf9, 0x6b, 0x70, 0xca, 0xc2, 0x1a, 0x13, 0x79, 0x50, 0x8d,
+	0xc8, 0x26, 0x8e, 0x6a, 0x96, 0xe5, 0x45, 0x69, 0x39, 0xa7, 0x96, 0x2d, 0xbc, 0x19, 0xd6, 0xca,
+	0x2d, 0xd6, 0x2f, 0x7f, 0x01, 0xe6, 0x62, 0x96, 0xec, 0x1d, 0xd2, 0x78, 0x39, 0xc5, 0x02, 0x48,
+	0xd8, 0x9a, 0xb7, 0x0e, 0x49, 0xf4, 0xbc, 0x0a, 0xb3, 0x1c, 0xc1, 0x5f, 0x53, 0x79, 0x90, 0x9d,
+	0xa6, 0xb1, 0x6e, 0x8a, 0xf6, 0x76, 0x9c, 0x9c, 0x84, 0xdc, 0xbb, 0x99, 0x5c, 0xae, 0x94, 0xbf,
+	0x9b, 0xc9, 0xe5, 0x4b, 0x70, 0x37, 0x93, 0x83, 0xd2, 0xd8, 0xdd, 0x4c, 0x6e, 0xbc, 0x34, 0x71,
+	0x37, 0x93, 0x2b, 0x94, 0x8a, 0xca, 0x7f, 0x4a, 0x30, 0xb7, 0xe1, 0xd4, 0xeb, 0x3f, 0x23, 0x01,
+	0xf5, 0x0f, 0x72, 0x50, 0x8e, 0xb3, 0xfb, 0x79, 0x44, 0xfd, 0x3c, 0xa2, 0x3e, 0xf3, 0x88, 0x3a,
+	0xde, 0x35, 0xa2, 0x26, 0xc6, 0xa6, 0xc2, 0x33, 0x8b, 0x4d, 0x3f, 0x99, 0x01, 0xbb, 0x47, 0x44,
+	0x9c, 0x3c, 0x4a, 0x44, 0x94, 0x87, 0x8b, 0x88, 0x13, 0xa5, 0x82, 0xf2, 0x5b, 0x12, 0x2c, 0xa8,
+	0x08, 0x23, 0x2f, 0x12, 0xb4, 0x5f, 0x40, 0x3c, 0x54, 0x2a, 0x70, 0x2a, 0x79, 0x2a, 0x2c, 0x56,
+	0x29, 0xdf, 0x4b, 0xc3, 0xa2, 0x8a, 0x0c, 0xc7, 0x35, 0x83, 0xfb, 0x73, 0xee, 0xdd, 0x43, 0x4c,
+	0xf8, 0x5d, 0x90, 0xe3, 0x27, 0xb5, 0xe1, 0x67, 0x3e, 0x19, 0x3b, 0xa2, 0xc9, 0xaf, 0x82, 0x2c,
+	0x5c, 0xd0, 0x8c, 0x86, 0xaf, 0x92, 0xdf, 0x23, 0x22, 0xcb, 0x1c, 0x8c, 0x52, 0xdf, 0xf5, 0x23,
+	0xd6, 0x08, 0xf9, 0x5c, 0x37, 0xe5, 0xd3, 0x00, 0xe2, 0x48, 0xce, 0x03, 0x53, 0x5e, 0xcd, 0xf3,
+	0x96, 0x75, 0x53, 0xfe, 0x00, 0xc6, 0x9b, 0x4e, 0xbd, 0xee, 0x9f, 0xa8, 0x59, 0x4c, 0x7a, 0xb3,
+	0xef, 0x89, 0x9a, 0x2c, 0x02, 0x41, 0xc9, 0x05, 0x15, 0xad, 0x8e, 0x11, 0x92, 0x42, 0x88, 0xfe,
+	0x91, 0x65, 0xf4, 0x88, 0x47, 0x96, 0x3f, 0xca, 0xc1, 0xd9, 0x1e, 0xaa, 0xe2, 0x8b, 0x4f, 0x6c,
+	0xcd, 0x90, 0x8e, 0xbc, 0x66, 0xf4, 0x5c, 0x0f, 0x52, 0x3d, 0xd7, 0x83, 0xe1, 0x94, 0xb6, 0x0c,
+	0xa5, 0x2e, 0xeb, 0x4d, 0x01, 0x87, 0xe9, 0xc6, 0x96, 0xb1, 0x6c, 0x7c, 0x19, 0x0b, 0xa4, 0x13,
+	0x46, 0xc2, 0xe9, 0x84, 0x37, 0xa0, 0xcc, 0xe3, 0x7b, 0xc7, 0xcd, 0xc5, 0x4e, 0x6b, 0x94, 0xee,
+	0xb4, 0x66, 0x59, 0x7f, 0x27, 0x41, 0xc0, 0x7a, 0xe5, 0x27, 0x30, 0xe7, 0xb9, 0xba, 0x8d, 0x2d,
+	0x32, 0x6c, 0xe8, 0x30, 0xcc, 0x4f, 0xd8, 0x5f, 0xee, 0x17, 0x70, 0xb7, 0x04, 0x7a, 0x50, 0x79,
+	0x34, 0x27, 0x32, 0xe3, 0x25, 0x75, 0xc9, 0xbb, 0x70, 0x3a, 0x21, 0xf7, 0x11, 0x58, 0xea, 0xf2,
+	0x43, 0x2c, 0x75, 0xf3, 0x31, 0xbf, 0xf2, 0xfb, 0x88, 0x77, 0x87, 0x16, 0x9c, 0x31, 0xba, 0xe0,
+	0x8c, 0x6d, 0x07, 0x56, 0x9a, 0x35, 0x28, 0x74, 0xd4, 0x49, 0x73, 0x2e, 0xe3, 0x03, 0xe6, 0x5c,
+	0x26, 0x7c, 0x3c, 0xd2, 0x23, 0xaf, 0xc2, 0xb8, 0xd0, 0x34, 0x25, 0x33, 0x31, 0x20, 0x99, 0x31,
+	0x8e, 0x45, 0x89, 0x38, 0x30, 0x4a, 0x52, 0xbb, 0x6c, 0xb5, 0x4b, 0x2f, 0x8f, 0x5d, 0x79, 0x58,
+	0x1d, 0x28, 0x8d, 0x5e, 0xed, 0xeb, 0x3d, 0xd5, 0x77, 0x18, 0xdd, 0x5b, 0xb6, 0xe7, 0xb6, 0x55,
+	0x31, 0x4a, 0xc7, 0x75, 0x8b, 0x47, 0x73, 0xdd, 0xf9, 0x0f, 0x60, 0x3c, 0x48, 0x59, 0x2e, 0x41,
+	0x7a, 0x0f, 0xb5, 0x79, 0x1c, 0x25, 0x3f, 0xe5, 0x6b, 0x90, 0xdd, 0xd7, 0xeb, 0xad, 0x2e, 0x5b,
+	0x3c, 0x9a, 0xc9, 0x0e, 0x7a, 0x2b, 0xa1, 0xd6, 0x56, 0x19, 0xca, 0xb5, 0xd4, 0x1b, 0x12, 0x5b,
+	0x7f, 0x02, 0xd1, 0xfc, 0xba, 0xe1, 0x59, 0xfb, 0x96, 0xd7, 0xfe, 0x3c, 0x9a, 0x0f, 0x1b, 0xcd,
+	0x83, 0x92, 0x7b, 0x8e, 0xd1, 0xfc, 0xef, 0x33, 0x22, 0x9a, 0x27, 0xaa, 0x8a, 0x47, 0xf3, 0x07,
+	0x50, 0x8c, 0x88, 0x8b, 0xc7, 0xf3, 0xa5, 0x30, 0x2f, 0x81, 0x40, 0xc3, 0x36, 0x70, 0x6d, 0x2a,
+	0x42, 0xb5, 0x10, 0x16, 0x69, 0xcc, 0xff, 0x52, 0x47, 0xf1, 0xbf, 0x40, 0x80, 0x4d, 0x87, 0x03,
+	0x2c, 0x82, 0x8a, 0xd8, 0xc3, 0xf2, 0x26, 0x2d, 0x12, 0x37, 0x32, 0x03, 0x0e, 0xb8, 0xc0, 0xe9,
+	0x5c, 0x67, 0x64, 0x36, 0x43, 0x51, 0xe4, 0x3e, 0x4c, 0xd6, 0x90, 0xee, 0x7a, 0xdb, 0x48, 0xf7,
+	0x34, 0x13, 0x79, 0xba, 0x55, 0xc7, 0xe5, 0xec, 0x80, 0x99, 0xce, 0x92, 0x8f, 0x7a, 0x93, 0x61,
+	0xc6, 0x97, 0xcc, 0x91, 0x23, 0x2f, 0x99, 0x97, 0x02, 0x8e, 0xe3, 0x3b, 0x14, 0xb5, 0x91, 0x7c,
+	0xc7, 0x1b, 0x1e, 0x88, 0x8e, 0x8e, 0x15, 0xe5, 0x8e, 0x68, 0x45, 0xdf, 0x97, 0xe0, 0x25, 0x66,
+	0x2c, 0xa1, 0xb0, 0xc6, 0x13, 0xb9, 0x43, 0xf9, 0xbc, 0x03, 0x25, 0x9e, 0x3e, 0x46, 0x91, 0x7b,
+	0x85, 0x9b, 0x7d, 0xfd, 0x66, 0x80, 0x29, 0xa8, 0x45, 0x41, 0x9d, 0x37, 0x28, 0xbf, 0x9a, 0x82,
+	0x73, 0xbd, 0x11, 0xb9, 0x13, 0xe0, 0xce, 0xf6, 0x40, 0xdc, 0xa6, 0x70, 0x2f, 0xb8, 0xf3, 0xac,
+	0x02, 0x3f, 0x39, 0x0b, 0x86, 0x3d, 0x0f, 0x41, 0x41, 0xe7, 0x8e, 0x49, 0x17, 0x5d, 0x5c, 0x4e,
+	0x2d, 0xa6, 0x07, 0xba, 0x64, 0xe9, 0x12, 0x44, 0xf8, 0x40, 0x13, 0x7a, 0xa0, 0x0b, 0x2b, 0x7f,
+	0x21, 0xc1, 0x22, 0xeb, 0x0b, 0x4d, 0x8f, 0x24, 0xf6, 0x87, 0xd2, 0x5e, 0x0d, 0x0a, 0x3b, 0x14,
+	0x27, 0xa2, 0xbb, 0xeb, 0x47, 0xd1, 0x5d, 0x68, 0x74, 0x75, 0x62, 0x27, 0xf8, 0xa9, 0xbc, 0x04,
+	0x67, 0x7b, 0xa0, 0xf0, 0x63, 0xc5, 0xf7, 0x25, 0x50, 0xe2, 0xd1, 0xed, 0x8e, 0xf0, 0xbc, 0x21,
+	0x18, 0x6b, 0x06, 0x7d, 0x3d, 0xcc, 0xdb, 0xea, 0x00, 0xbc, 0xf5, 0x9b, 0x42, 0x20, 0x1c, 0x08,
+	0x06, 0x37, 0xe0, 0xa5, 0x9e, 0x78, 0xdc, 0x40, 0x5e, 0x86, 0x92, 0xa1, 0xdb, 0x06, 0xf2, 0x57,
+	0x19, 0xc4, 0xe6, 0x9f, 0x53, 0x8b, 0xac, 0x5d, 0x15, 0xcd, 0x41, 0x2f, 0x0d, 0xd2, 0x7c, 0x41,
+	0x5e, 0xda, 0x6b, 0x0a, 0x71, 0x2f, 0x3d, 0x0f, 0xe7, 0x7a, 0xe3, 0x71, 0x8d, 0x07, 0x0c, 0x39,
+	0x08, 0xf8, 0xff, 0x6f, 0xc8, 0x5d, 0x47, 0xef, 0x6e, 0xc8, 0x49, 0x28, 0x9c, 0xad, 0xbf, 0xa6,
+	0x86, 0x1c, 0xe7, 0x9f, 0x6a, 0x78, 0x28, 0xc6, 0x7e, 0x01, 0x0a, 0x61, 0x7b, 0x19, 0xc2, 0x8a,
+	0xfb, 0x8d, 0xaf, 0x4e, 0x84, 0x4c, 0x4e, 0x59, 0x4a, 0xb6, 0x37, 0x1f, 0x89, 0x33, 0xf7, 0x0f,
+	0x29, 0xa8, 0x6c, 0x5a, 0xbb, 0xb6, 0x5e, 0x3f, 0xce, 0x6d, 0xf4, 0x0e, 0x14, 0x30, 0x25, 0x12,
+	0x61, 0xec, 0xad, 0xfe, 0xd7, 0xd1, 0x3d, 0xc7, 0x56, 0x27, 0x18, 0x59, 0x31, 0x15, 0x0b, 0x16,
+	0xd0, 0xa1, 0x87, 0x5c, 0x32, 0x52, 0xc2, 0xee, 0x34, 0x3d, 0xec, 0xee, 0xf4, 0xa4, 0xa0, 0x16,
+	0xeb, 0x92, 0xab, 0x30, 0x65, 0xd4, 0xac, 0xba, 0xd9, 0x19, 0xc7, 0xb1, 0xeb, 0x6d, 0xba, 0x79,
+	0xc9, 0xa9, 0x93, 0xb4, 0x4b, 0x20, 0xbd, 0x6d, 0xd7, 0xdb, 0xca, 0x59, 0x38, 0xd3, 0x95, 0x17,
+	0x2e, 0xeb, 0x7f, 0x92, 0xe0, 0x02, 0x87, 0xb1, 0xbc, 0xda, 0xb1, 0x4b, 0x00, 0xbe, 0x25, 0xc1,
+	0x49, 0x2e, 0xf5, 0x03, 0xcb, 0xab, 0x69, 0x49, 0xf5, 0x00, 0x77, 0x06, 0x55, 0x40, 0xbf, 0x09,
+	0xa9, 0xb3, 0x38, 0x0c, 0x28, 0xec, 0xec, 0x3a, 0x2c, 0xf7, 0x27, 0xd1, 0xf3, 0x26, 0x57, 0xf9,
+	0x5b, 0x09, 0xce, 0xa8, 0xa8, 0xe1, 0xec, 0x23, 0x46, 0xe9, 0x88, 0x17, 0x08, 0xcf, 0xef, 0xc4,
+	0x12, 0x3e, 0x6a, 0xa4, 0x23, 0x47, 0x0d, 0x45, 0x81, 0xc5, 0xee, 0xd3, 0xe7, 0xba, 0xff, 0x1f,
+	0x09, 0x96, 0x1e, 0x36, 0x31, 0xea, 0x88, 0x67, 0x13, 0xe9, 0xae, 0x51, 0xbb, 0xee, 0x79, 0xae,
+	0xb5, 0xdd, 0xf2, 0x10, 0xfe, 0x4c, 0x70, 0xfa, 0x10, 0x26, 0x31, 0x9d, 0x97, 0xa6, 0xfb, 0x13,
+	0xe3, 0x6e, 0xb5, 0xdc, 0x8d, 0x70, 0x8c, 0x91, 0x12, 0x8e, 0xb4, 0x28, 0xcb, 0x70, 0xbe, 0x1f,
+	0xf3, 0xc2, 0x47, 0x52, 0x70, 0x76, 0x0b, 0xb9, 0x0d, 0xcb, 0xd6, 0x3d, 0x74, 0x1c, 0xef, 0x70,
+	0x60, 0xd2, 0x13, 0x74, 0x22, 0x4e, 0x71, 0xa3, 0xaf, 0x53, 0xf4, 0x9d, 0x81, 0x5a, 0xf2, 0x89,
+	0xff, 0x04, 0xc4, 0xa6, 0x73, 0xa0, 0xf4, 0xe2, 0x88, 0x8b, 0xfe, 0xbf, 0x25, 0xa8, 0xdc, 0x44,
+	0x75, 0x74, 0x3c, 0xb9, 0x3f, 0x3f, 0xdb, 0x7c, 0x19, 0x4a, 0x3e, 0x65, 0x7e, 0x53, 0xc1, 0xb3,
+	0x06, 0xfe, 0x3d, 0x02, 0xbf, 0xd2, 0xa0, 0x17, 0x29, 0x75, 0x07, 0xa3, 0x64, 0x09, 0xc9, 0xac,
+	0x2f, 0x1a, 0xbe, 0xbb, 0xf2, 0xce, 0xe5, 0xf3, 0xa7, 0x12, 0x9c, 0xa6, 0x89, 0xf4, 0x63, 0xd6,
+	0x6d, 0xb9, 0x84, 0xc6, 0xd0, 0x75, 0x5b, 0x3d, 0x47, 0x56, 0xc7, 0x29, 0x51, 0x11, 0x93, 0x5f,
+	0x87, 0x4a, 0x37, 0xf0, 0xde, 0x91, 0xf8, 0xf7, 0xd3, 0xb0, 0xc4, 0x89, 0xb0, 0x9d, 0xc2, 0x71,
+	0x58, 0x6d, 0x74, 0xd9, 0xed, 0xdc, 0x1e, 0x80, 0xd7, 0x01, 0xa6, 0x10, 0xd9, 0xf0, 0xc8, 0x6f,
+	0x06, 0xfc, 0x8f, 0x97, 0x6c, 0xc5, 0xf3, 0x4b, 0x65, 0x01, 0xb2, 0x2e, 0x20, 0x44, 0x9e, 0xa9,
+	0x8f, 0xfb, 0x66, 0x9e, 0xbf, 0xfb, 0x66, 0xbb, 0xb9, 0xef, 0x32, 0x9c, 0xef, 0x27, 0x11, 0x6e,
+	0xa2, 0x1f, 0xa5, 0x60, 0x41, 0xe4, 0x49, 0x82, 0x47, 0xb3, 0xcf, 0x84, 0xff, 0x5e, 0x85, 0x59,
+	0x0b, 0x6b, 0x09, 0xc5, 0x64, 0x54, 0x37, 0x39, 0x75, 0xca, 0xc2, 0xb7, 0xa3, 0x55, 0x62, 0x9d,
+	0xf4, 0x48, 0xe6, 0x88, 0xe9, 0x91, 0x0a, 0x9c, 0x4a, 0x96, 0x08, 0x17, 0xd9, 0xbf, 0x49, 0x70,
+	0xe1, 0x11, 0x72, 0xad, 0x9d, 0x76, 0x6c, 0x70, 0x81, 0xf7, 0xd9, 0x48, 0x9b, 0xfa, 0x92, 0x48,
+	0x1f, 0x51, 0x12, 0x17, 0x61, 0xb9, 0x3f, 0xa3, 0x5c, 0x2a, 0xff, 0x9b, 0x86, 0x73, 0xec, 0x04,
+	0xbc, 0x4a, 0xcc, 0xd1, 0x9f, 0xc5, 0x51, 0xce, 0xab, 0xcf, 0x4f, 0x24, 0x55, 0xe0, 0xc5, 0xa4,
+	0x01, 0x87, 0xf7, 0x5d, 0x7d, 0x92, 0x75, 0xf9, 0x8e, 0xbe, 0x6e, 0xca, 0xef, 0xc1, 0x94, 0x38,
+	0xdb, 0x9a, 0xc7, 0xf1, 0x6d, 0xd9, 0xa7, 0xd2, 0x99, 0xcb, 0x86, 0x7f, 0x2a, 0xa7, 0x57, 0x4a,
+	0x34, 0x4f, 0x9b, 0x1d, 0x26, 0x4f, 0x5b, 0xec, 0xa0, 0xd3, 0x86, 0x8e, 0xc2, 0x47, 0x8e, 0xa6,
+	0x70, 0x72, 0xd7, 0x15, 0x13, 0x8f, 0x58, 0x38, 0x47, 0xf9, 0xdd, 0x5d, 0x58, 0x46, 0x7c, 0xfd,
+	0x54, 0x2e, 0xc0, 0x52, 0x1f, 0xed, 0x8b, 0x35, 0x31, 0x0d, 0x97, 0x98, 0x51, 0x25, 0x42, 0xd2,
+	0xd8, 0x44, 0xe8, 0x0c, 0x65, 0x30, 0x5b, 0x50, 0x8a, 0x96, 0x1d, 0x0f, 0x6f, 0x2e, 0xc5, 0x48,
+	0x99, 0xb1, 0xac, 0x42, 0x91, 0x45, 0xdd, 0x63, 0xec, 0xc9, 0x0a, 0x46, 0x88, 0xcb, 0x6e, 0x06,
+	0x98, 0xe9, 0x66, 0x80, 0xbd, 0x34, 0x92, 0xed, 0xa5, 0x91, 0x63, 0x1b, 0x83, 0xf2, 0x1a, 0x54,
+	0x07, 0x55, 0x14, 0xd7, 0xed, 0x1f, 0x4b, 0xb0, 0x78, 0x13, 0x61, 0xc3, 0xb5, 0xb6, 0x8f, 0xb5,
+	0x23, 0xfc, 0x3a, 0x8c, 0x0e, 0x9b, 0xc7, 0xe9, 0x37, 0xac, 0x2a, 0x28, 0x2a, 0xbf, 0x97, 0x81,
+	0xb3, 0x3d, 0xa0, 0xf9, 0x76, 0xe7, 0x1b, 0x50, 0xea, 0xdc, 0x9f, 0x1a, 0x8e, 0xbd, 0x63, 0xed,
+	0xf2, 0xf4, 0xf1, 0xe5, 0xe4, 0xb9, 0x24, 0xaa, 0x7f, 0x95, 0x22, 0xaa, 0x45, 0x14, 0x6e, 0x90,
+	0x77, 0x61, 0x2e, 0xe1, 0x9a, 0x96, 0x16, 0xca, 0x33, 0x86, 0x57, 0x86, 0x18, 0x84, 0xdd, 0x07,
+	0x1f, 0x24, 0x35, 0xcb, 0xdf, 0x00, 0xb9, 0x89, 0x6c, 0xd3, 0xb2, 0x77, 0x35, 0x9e, 0x42, 0xb6,
+	0xe8, 0xf1, 0x8c, 0x24, 0xa5, 0x2f, 0x75, 0x1f, 0x63, 0x83, 0xe1, 0x88, 0x3c, 0x10, 0x1d, 0x61,
+	0xb2, 0x19, 0x6a, 0xb4, 0x10, 0x96, 0xbf, 0x09, 0x25, 0x41, 0x9d, 0x9a, 0xb9, 0x4b, 0xcb, 0xdf,
+	0x08, 0xed, 0xab, 0x7d, 0x69, 0x87, 0x8d, 0x8a, 0x8e, 0x50, 0x6c, 0x06, 0xba, 0x5c, 0x64, 0xcb,
+	0x08, 0x66, 0x04, 0xfd, 0xf0, 0xf2, 0x9f, 0xed, 0xa7, 0x09, 0x3e, 0x48, 0xec, 0xda, 0x7c, 0xaa,
+	0x19, 0xef, 0x50, 0x7e, 0x25, 0x0d, 0x65, 0x95, 0xbf, 0x34, 0x41, 0x34, 0x92, 0xe2, 0x47, 0x57,
+	0x3e, 0x13, 0xcb, 0xd5, 0x0e, 0xcc, 0x84, 0x8b, 0xb5, 0xda, 0x9a, 0xe5, 0xa1, 0x86, 0xd0, 0xe0,
+	0x95, 0xa1, 0x0a, 0xb6, 0xda, 0xeb, 0x1e, 0x6a, 0xa8, 0x53, 0xfb, 0xb1, 0x36, 0x2c, 0xbf, 0x01,
+	0x23, 0x74, 0xfd, 0xc1, 0xe5, 0x4c, 0xef, 0x0b, 0xb1, 0x9b, 0xba, 0xa7, 0xdf, 0xa8, 0x3b, 0xdb,
+	0x2a, 0x87, 0x97, 0x6f, 0x43, 0x81, 0xbc, 0x78, 0x20, 0x47, 0x03, 0x4e, 0x21, 0x3b, 0x20, 0x85,
+	0x71, 0x1b, 0x1d, 0xa8, 0x2d, 0xb6, 0x72, 0x61, 0x7e, 0x54, 0xe2, 0x3a, 0x78, 0x1c, 0xac, 0xfa,
+	0x12, 0x8a, 0xd0, 0x62, 0x95, 0x65, 0xcc, 0x1f, 0xdf, 0x48, 0x14, 0x42, 0xe0, 0x49, 0x4f, 0x50,
+	0xda, 0xa1, 0x4c, 0x4b, 0xa4, 0xba, 0x6c, 0x09, 0x0a, 0x2e, 0x6a, 0x38, 0x1e, 0xd2, 0x8c, 0x7a,
+	0x0b, 0x7b, 0xc8, 0xa5, 0x2a, 0xcc, 0xab, 0x13, 0xac, 0x75, 0x95, 0x35, 0x2a, 0x0b, 0x70, 0x32,
+	0xc1, 0x58, 0x78, 0x04, 0xfc, 0xcb, 0x14, 0x54, 0xd6, 0xc9, 0x6c, 0x8e, 0x75, 0xe4, 0x7b, 0x66,
+	0x85, 0xad, 0xeb, 0x50, 0x14, 0x76, 0xb3, 0xad, 0x7b, 0x46, 0xcd, 0xf7, 0xfd, 0xfe, 0xea, 0x29,
+	0x70, 0xc4, 0x1b, 0x0c, 0x8f, 0xd4, 0xde, 0x45, 0x4c, 0x91, 0xdb, 0x4a, 0x75, 0x38, 0x23, 0x54,
+	0x0b, 0x61, 0x03, 0x24, 0xe7, 0xe8, 0xae, 0x12, 0xe3, 0x52, 0xfd, 0x43, 0x09, 0x66, 0x37, 0xdb,
+	0xb6, 0xb1, 0x59, 0xd3, 0x5d, 0x93, 0x97, 0xf0, 0x71, 0x69, 0x2e, 0x41, 0x01, 0x3b, 0x2d, 0xd7,
+	0xe8, 0x28, 0x8d, 0xc9, 0x73, 0x82, 0xb5, 0x72, 0xa5, 0xc9, 0x27, 0x21, 0x87, 0x09, 0xb2, 0x28,
+	0x42, 0xca, 0xaa, 0xa3, 0xf4, 0x7b, 0xdd, 0x94, 0xaf, 0xc3, 0x18, 0xab, 0x25, 0x64, 0x77, 0xcd,
+	0xe9, 0x01, 0xef, 0x9a, 0x81, 0x21, 0x91, 0x66, 0xe5, 0x24, 0xcc, 0xc5, 0xa6, 0xc7, 0xa7, 0xfe,
+	0xa3, 0x2c, 0x4c, 0x91, 0x3e, 0x11, 0x4a, 0x87, 0xb0, 0x82, 0x33, 0x30, 0xe6, 0x1b, 0x3c, 0x9f,
+	0x76, 0x5e, 0x05, 0xd1, 0xb4, 0x6e, 0x06, 0x8e, 0xe4, 0xe9, 0xe0, 0x33, 0x97, 0x32, 0x8c, 0x8a,
+	0x1d, 0x02, 0xdb, 0x56, 0x88, 0xcf, 0x2e, 0x75, 0x14, 0xd9, 0x2e, 0x75, 0x14, 0xf1, 0xfa, 0x9d,
+	0x91, 0xa3, 0xd5, 0xef, 0x24, 0x55, 0x6a, 0x8d, 0x26, 0x56, 0x6a, 0x45, 0x2b, 0x0d, 0x72, 0x47,
+	0xa9, 0x34, 0xd8, 0xe0, 0x65, 0xc5, 0x9d, 0x1b, 0x40, 0x4a, 0x2b, 0x3f, 0x20, 0xad, 0x49, 0x82,
+	0xec, 0xdf, 0xdc, 0x51, 0x8a, 0xd7, 0x60, 0x54, 0x14, 0x0c, 0xc0, 0x80, 0x05, 0x03, 0x02, 0x21,
+	0x58, 0xf7, 0x30, 0x16, 0xae, 0x7b, 0x58, 0x85, 0x71, 0x3a, 0x4f, 0xf1, 0x92, 0x6b, 0x7c, 0xc0,
+	0x97, 0x5c, 0x63, 0xb4, 0x16, 0x95, 0x7d, 0x90, 0xbc, 0x15, 0x25, 0x42, 0xcc, 0x02, 0xb9, 0x9a,
+	0x65, 0x22, 0xdb, 0xb3, 0xbc, 0x36, 0xad, 0x91, 0xca, 0xab, 0x32, 0xe9, 0x7b, 0x4c, 0xbb, 0xd6,
+	0x79, 0x4f, 0x92, 0x23, 0x17, 0x9e, 0x89, 0x23, 0xcf, 0xc2, 0x74, 0xd8, 0xd2, 0xb9, 0x0b, 0x90,
+	0xca, 0x56, 0xb1, 0xe1, 0x7a, 0xc1, 0x95, 0xfe, 0xca, 0x7f, 0x49, 0x70, 0x2a, 0x79, 0x2e, 0x7c,
+	0xdf, 0x57, 0x83, 0x29, 0x43, 0x37, 0x6a, 0x28, 0xfc, 0xf6, 0xf3, 0xd8, 0x4b, 0xcd, 0x24, 0x25,
+	0x1a, 0x6c, 0x92, 0x6d, 0x98, 0x35, 0x75, 0x4f, 0xdf, 0xd6, 0x71, 0x74, 0xb0, 0xd4, 0x31, 0x07,
+	0x9b, 0x16, 0x74, 0x83, 0xad, 0xca, 0x6f, 0xa4, 0x60, 0x5e, 0xb0, 0xce, 0x55, 0x76, 0xc7, 0xc1,
+	0xc1, 0x5b, 0xf5, 0x9a, 0x83, 0x3d, 0x4d, 0x37, 0x4d, 0x17, 0x61, 0x2c, 0xb4, 0x40, 0xda, 0xae,
+	0xb3, 0xa6, 0x5e, 0x41, 0x34, 0xaa, 0xc3, 0xf4, 0xa0, 0xbb, 0xa4, 0xcc, 0xb3, 0x39, 0xd4, 0x5b,
+	0xb6, 0x51, 0x6f, 0x99, 0x48, 0x63, 0xf3, 0xa3, 0x2e, 0x28, 0xb2, 0x63, 0xbc, 0x8b, 0x06, 0xe7,
+	0x7b, 0xa4, 0x43, 0xf9, 0xb3, 0x14, 0x2c, 0x24, 0x4a, 0x82, 0xdb, 0xc0, 0x4b, 0x30, 0x41, 0xe9,
+	0x60, 0xcd, 0x6e, 0x35, 0xb6, 0xf9, 0x92, 0x92, 0x55, 0xc7, 0x59, 0xe3, 0x03, 0xda, 0x26, 0x2f,
+	0x40, 0x5e, 0x08, 0x83, 0x55, 0x79, 0x64, 0xd5, 0x1c, 0x97, 0x06, 0x79, 0x00, 0x54, 0xec, 0x88,
+	0x83, 0xaa, 0xbe, 0xe7, 0x03, 0x58, 0x1f, 0x96, 0xb0, 0xec, 0x17, 0xfa, 0xac, 0x12, 0x3c, 0xba,
+	0x6b, 0x2d, 0xd8, 0xa1, 0x36, 0x1a, 0x53, 0xb8, 0x9a, 0x58, 0x15, 0x9b, 0xf8, 0x94, 0xef, 0xc2,
+	0x58, 0x50, 0x04, 0x23, 0x8b, 0xe9, 0xb0, 0x74, 0x93, 0x1d, 0xdb, 0x97, 0x8d, 0x0a, 0x58, 0xfc,
+	0xc4, 0x77, 0x33, 0xb9, 0x4c, 0x29, 0xab, 0x54, 0x61, 0x72, 0xb5, 0xee, 0x60, 0x26, 0x3f, 0x61,
+	0x2c, 0x41, 0x4b, 0x90, 0x42, 0x96, 0xa0, 0x4c, 0x83, 0x1c, 0x84, 0xe7, 0x31, 0xe0, 0x55, 0x28,
+	0xae, 0x21, 0x6f, 0x50, 0x1a, 0x1f, 0x40, 0xa9, 0x03, 0xcd, 0x95, 0x72, 0x0f, 0x80, 0x83, 0x93,
+	0x53, 0x12, 0xf3, 0xc7, 0x4b, 0x83, 0xb8, 0x08, 0x25, 0x43, 0xc5, 0x98, 0xc7, 0xe2, 0xa7, 0xf2,
+	0x57, 0x12, 0x4c, 0xdd, 0xd1, 0x6d, 0xd3, 0xd9, 0xd9, 0x19, 0x70, 0x52, 0x64, 0xa7, 0xe1, 0x17,
+	0x36, 0x3b, 0x07, 0x76, 0x67, 0x7b, 0x28, 0x5a, 0xdf, 0x26, 0x8d, 0xf2, 0xcf, 0x43, 0xde, 0x3f,
+	0x9e, 0xf2, 0xcd, 0xd6, 0x57, 0x06, 0x2c, 0x38, 0x0a, 0x4e, 0x48, 0x18, 0xbe, 0xda, 0xa1, 0xa6,
+	0x3c, 0x81, 0xe9, 0x24, 0x90, 0xe7, 0xb8, 0x97, 0x50, 0xd6, 0xc2, 0x43, 0xfa, 0xda, 0x58, 0x81,
+	0xa9, 0xa6, 0x8b, 0x0e, 0x74, 0xb7, 0x11, 0xb8, 0x31, 0xc1, 0x5c, 0x64, 0xb2, 0xdf, 0xf5, 0xd8,
+	0x9f, 0xfb, 0x3f, 0x4b, 0x30, 0xc9, 0xae, 0x3c, 0x83, 0xd9, 0xe5, 0x1e, 0xe2, 0xbe, 0x0d, 0x39,
+	0x43, 0xf7, 0xd0, 0x2e, 0x59, 0x9f, 0x52, 0xf4, 0x91, 0xc7, 0xc5, 0xde, 0x4f, 0x48, 0x58, 0xb1,
+	0x02, 0xc3, 0x50, 0x7d, 0xdc, 0x60, 0x35, 0x68, 0x3a, 0x54, 0x0d, 0xba, 0x0e, 0xc5, 0x7d, 0x0b,
+	0x5b, 0xdb, 0x56, 0x9d, 0x56, 0x6b, 0x0d, 0x53, 0x67, 0x58, 0xe8, 0x20, 0xd2, 0xfd, 0xdf, 0x34,
+	0xc8, 0x41, 0xde, 0x44, 0x6a, 0x5d, 0x82, 0xd3, 0x6b, 0xc8, 0x53, 0x3b, 0xff, 0x61, 0x70, 0x9f,
+	0xfd, 0x7f, 0x81, 0xbf, 0x79, 0xbd, 0x07, 0x23, 0xb4, 0x7a, 0x9a, 0x08, 0x2e, 0xdd, 0x35, 0x3a,
+	0x04, 0xfe, 0x04, 0x81, 0x5d, 0x75, 0xf8, 0x9f, 0xb4, 0xce, 0x5a, 0xe5, 0x34, 0x88, 0x19, 0xf0,
+	0x3d, 0x30, 0xad, 0x22, 0xe4, 0x4a, 0x1e, 0xe3, 0x6d, 0x24, 0xac, 0x28, 0xdf, 0x4d, 0x41, 0xa5,
+	0xdb, 0x94, 0xb8, 0x66, 0x7f, 0x09, 0x0a, 0x4c, 0x25, 0xfc, 0xcf, 0x16, 0xc4, 0xdc, 0xde, 0x1d,
+	0xd0, 0x88, 0x7b, 0x93, 0x67, 0xde, 0x28, 0x5a, 0x59, 0xc5, 0xf4, 0x04, 0x0e, 0xb6, 0xcd, 0xb7,
+	0x41, 0x8e, 0x03, 0x05, 0x8b, 0x9f, 0xb3, 0xac, 0xf8, 0xf9, 0x7e, 0xb8, 0xf8, 0xf9, 0xf5, 0x21,
+	0x65, 0xe7, 0xcf, 0xac, 0x53, 0x0f, 0xad, 0x7c, 0x08, 0x8b, 0x6b, 0xc8, 0xbb, 0x79, 0xef, 0x9d,
+	0x1e, 0x3a, 0x7b, 0xc4, 0x5f, 0xa1, 0x91, 0x30, 0x24, 0x64, 0x33, 0xec, 0xd8, 0x7e, 0x3a, 0x22,
+	0xef, 0xf1, 0x5f, 0x58, 0xf9, 0x35, 0x09, 0xce, 0xf6, 0x18, 0x9c, 0x6b, 0xe7, 0x03, 0x98, 0x0c,
+	0x90, 0xe5, 0x35, 0x86, 0x52, 0x34, 0xe5, 0x32, 0xf0, 0x24, 0xd4, 0x92, 0x1b, 0x6e, 0xc0, 0xca,
+	0xb7, 0x25, 0x98, 0xa6, 0x85, 0xe2, 0x7e, 0x04, 0x1a, 0x7c, 0x9b, 0xf6, 0x76, 0x34, 0x6f, 0xf7,
+	0xc5, 0xbe, 0x79, 0xbb, 0xa4, 0xa1, 0x3a, 0xb9, 0xba, 0x3d, 0x98, 0x89, 0x00, 0x70, 0x39, 0xa8,
+	0x90, 0x8b, 0x54, 0x75, 0x7e, 0x69, 0xd8, 0xa1, 0x18, 0xb6, 0xea, 0xd3, 0x51, 0x7e, 0x57, 0x82,
+	0x69, 0x15, 0xe9, 0xcd, 0x66, 0x9d, 0xe5, 0xd7, 0x87, 0xa9, 0xaf, 0xd8, 0x8c, 0x72, 0x9e, 0xfc,
+	0xb4, 0x23, 0xf8, 0x7f, 0x1f, 0x4c, 0x1d, 0xf1, 0xe1, 0x3a, 0xdc, 0xcf, 0xc1, 0x4c, 0x04, 0x80,
+	0xcf, 0xf4, 0xcf, 0x53, 0x30, 0xc3, 0x6c, 0x25, 0x6a, 0x9d, 0xb7, 0x20, 0xe3, 0xbf, 0xdf, 0x29,
+	0x04, 0x13, 0x64, 0x49, 0x11, 0xf3, 0x26, 0xd2, 0xcd, 0x7b, 0xc8, 0xf3, 0x90, 0x4b, 0xab, 0x4d,
+	0x69, 0x65, 0x32, 0x45, 0xef, 0xb5, 0xd3, 0x8b, 0x1f, 0xb8, 0xd3, 0x49, 0x07, 0xee, 0xd7, 0xa1,
+	0x4c, 0x37, 0x5e, 0xd8, 0xda, 0x47, 0x1a, 0xb2, 0xfd, 0x70, 0xd2, 0x49, 0x76, 0xcf, 0xf8, 0xfd,
+	0xb7, 0x6c, 0xe1, 0xec, 0xeb, 0xa6, 0x7c, 0x11, 0x26, 0x1b, 0xfa, 0xa1, 0xd5, 0x68, 0x35, 0xb4,
+	0x26, 0x81, 0xc7, 0xd6, 0x87, 0xec, 0xcf, 0x3a, 0xb2, 0x6a, 0x91, 0x77, 0x6c, 0xe8, 0xbb, 0x68,
+	0xd3, 0xfa, 0x10, 0xc9, 0xe7, 0xa1, 0x48, 0x1f, 0xf6, 0x50, 0x40, 0xf6, 0x0e, 0x65, 0x84, 0xbe,
+	0x43, 0xa1, 0xef, 0x7d, 0x08, 0x18, 0x7b, 0x78, 0xfb, 0xef, 0xec, 0x7f, 0x1b, 0x42, 0xf2, 0xe2,
+	0x86, 0xf4, 0x8c, 0x04, 0x96, 0xe8, 0x97, 0xa9, 0x67, 0xe8, 0x97, 0x49, 0xbc, 0xa6, 0x93, 0x78,
+	0xfd, 0x17, 0xf2, 0xa6, 0xba, 0xe5, 0xee, 0xa2, 0x9f, 0x46, 0xeb, 0x50, 0xe6, 0xa1, 0x1c, 0x67,
+	0x4e, 0x14, 0x93, 0xa6, 0x60, 0xee, 0x3e, 0xfa, 0x29, 0xe5, 0xfc, 0xb9, 0xf8, 0xc5, 0x0d, 0x28,
+	0xdf, 0x47, 0xc9, 0xd2, 0x4c, 0xa2, 0x21, 0x25, 0xd1, 0xf8, 0x2e, 0x7d, 0xb7, 0xba, 0xe3, 0x22,
+	0x5c, 0x0b, 0x26, 0xd5, 0x87, 0x09, 0x9e, 0xef, 0x45, 0x83, 0xe7, 0xcf, 0x0d, 0x18, 0x3c, 0xbb,
+	0x8e, 0xda, 0x89, 0xa1, 0xf4, 0x29, 0x6b, 0x12, 0x1c, 0x37, 0x9a, 0xef, 0x48, 0x70, 0x71, 0x0d,
+	0xd9, 0xc8, 0xd5, 0x3d, 0x74, 0x8f, 0x24, 0x7e, 0x78, 0x72, 0x23, 0xe2, 0x7e, 0x2f, 0x22, 0x57,
+	0x71, 0x09, 0x5e, 0x19, 0x68, 0x66, 0x9c, 0x93, 0xdb, 0xb0, 0x10, 0xde, 0x7b, 0x85, 0x13, 0xa5,
+	0x17, 0xa0, 0x18, 0xce, 0x6e, 0xb3, 0x7d, 0x43, 0x5e, 0x2d, 0x84, 0xd2, 0xdb, 0x58, 0x69, 0xc1,
+	0xa9, 0x64, 0x3a, 0xdc, 0x30, 0x1e, 0xc2, 0x08, 0x3b, 0x08, 0xf3, 0x7d, 0xc7, 0x9b, 0x03, 0x6e,
+	0x0c, 0xf9, 0x01, 0x22, 0x4a, 0x96, 0x13, 0x53, 0xfe, 0x6e, 0x04, 0x66, 0x93, 0x41, 0x7a, 0x9d,
+	0x12, 0xbe, 0x08, 0x73, 0x0d, 0xfd, 0x50, 0x8b, 0xc6, 0xde, 0xce, 0x5b, 0xd3, 0xe9, 0x86, 0x7e,
+	0x18, 0xdd, 0x79, 0x99, 0xf2, 0x3d, 0x28, 0x89, 0x63, 0xb2, 0xa1, 0xd7, 0x07, 0x4d, 0xfc, 0x8e,
+	0x90, 0xcd, 0x7f, 0x59, 0x52, 0x0b, 0xfc, 0x90, 0x6c, 0xe8, 0x75, 0xd2, 0x29, 0x7f, 0x18, 0x17,
+	0x2d, 0xbb, 0x05, 0x7b, 0xe7, 0x58, 0xa2, 0xa9, 0xaa, 0x21, 0xc5, 0xb0, 0xcd, 0x72, 0x44, 0x5b,
+	0xf2, 0xaf, 0x4b, 0x30, 0x55, 0x23, 0x27, 0xb4, 0x7d, 0xbe, 0xed, 0xa7, 0x66, 0x48, 0xf2, 0x02,
+	0xc3, 0xbc, 0x71, 0xec, 0x32, 0x81, 0x3b, 0x9c, 0xb0, 0x9f, 0x92, 0xe0, 0x93, 0x90, 0x6b, 0xb1,
+	0x0e, 0xb9, 0x09, 0xe7, 0x12, 0x35, 0x11, 0x3d, 0x63, 0x0d, 0x9a, 0x43, 0x5e, 0x8c, 0x2b, 0xee,
+	0x51, 0xe8, 0xd4, 0x35, 0xff, 0x6d, 0x09, 0xa6, 0x12, 0x44, 0x94, 0xf0, 0x4e, 0xf2, 0xfd, 0xf0,
+	0x51, 0x61, 0xed, 0x58, 0x52, 0xd9, 0x40, 0x2e, 0x1f, 0x2f, 0x70, 0x74, 0x98, 0xff, 0x96, 0x04,
+	0x73, 0x5d, 0xc4, 0x95, 0x30, 0x21, 0x35, 0x3c, 0xa1, 0xaf, 0x0e, 0x93, 0x20, 0x08, 0x0e, 0x40,
+	0x0f, 0x11, 0x81, 0x03, 0xcc, 0xbb, 0x30, 0x93, 0x08, 0x23, 0xbf, 0x05, 0xa7, 0x7c, 0x2b, 0x49,
+	0x72, 0x16, 0x89, 0x3a, 0xcb, 0x49, 0x01, 0x13, 0xf3, 0x18, 0xe5, 0x4f, 0x24, 0x58, 0xec, 0x27,
+	0x0f, 0xf2, 0xd0, 0x5a, 0x37, 0xf6, 0x90, 0x19, 0x21, 0x3b, 0x46, 0x1b, 0xb9, 0xeb, 0xbd, 0x0f,
+	0xf3, 0x01, 0x98, 0xa8, 0x75, 0x0c, 0xfa, 0xb4, 0x70, 0xce, 0x27, 0x19, 0x36, 0x0a, 0xe5, 0x37,
+	0x25, 0x98, 0x57, 0xd1, 0x76, 0xcb, 0xaa, 0x9b, 0x2f, 0x3a, 0xd7, 0x7c, 0x1a, 0x16, 0x12, 0x67,
+	0xc2, 0xe3, 0xf5, 0xdf, 0xa4, 0x60, 0x29, 0x5c, 0x40, 0xda, 0x61, 0x85, 0x55, 0x56, 0xbc, 0x88,
+	0x1b, 0xc3, 0x0d, 0x98, 0x0a, 0xde, 0xb2, 0xba, 0xde, 0xa0, 0xc1, 0x91, 0x5f, 0x9e, 0x04, 0xae,
+	0x54, 0xd9, 0xbf, 0x94, 0x84, 0x28, 0xd2, 0x32, 0xda, 0xe1, 0x72, 0x2d, 0x3e, 0x45, 0x9a, 0x55,
+	0xa4, 0x3a, 0x5e, 0x86, 0xf3, 0xfd, 0x04, 0xc7, 0x65, 0xfc, 0x3b, 0x12, 0xcc, 0x3c, 0x6c, 0x9a,
+	0x81, 0x2b, 0xe5, 0x21, 0x64, 0xba, 0x11, 0xdd, 0x96, 0xf4, 0x3f, 0x62, 0x26, 0x8e, 0xd5, 0xd9,
+	0x8c, 0x34, 0x60, 0x36, 0x0a, 0xc1, 0x17, 0xd5, 0xcd, 0xd8, 0x79, 0xf6, 0xf5, 0xa1, 0x07, 0x8b,
+	0x1e, 0x68, 0x6f, 0x34, 0x3f, 0xfe, 0xa4, 0x72, 0xe2, 0x07, 0x9f, 0x54, 0x4e, 0xfc, 0xf8, 0x93,
+	0x8a, 0xf4, 0xcb, 0x4f, 0x2b, 0xd2, 0xf7, 0x9e, 0x56, 0xa4, 0x7f, 0x7c, 0x5a, 0x91, 0x3e, 0x7e,
+	0x5a, 0x91, 0xfe, 0xf5, 0x69, 0x45, 0xfa, 0xd1, 0xd3, 0xca, 0x89, 0x1f, 0x3f, 0xad, 0x48, 0x1f,
+	0x7d, 0x5a, 0x39, 0xf1, 0xf1, 0xa7, 0x95, 0x13, 0x3f, 0xf8, 0xb4, 0x72, 0xe2, 0xbd, 0x6b, 0xbb,
+	0x4e, 0x67, 0x68, 0xcb, 0xe9, 0xf9, 0x0f, 0xb5, 0x5f, 0x09, 0xb7, 0x6c, 0x8f, 0x50, 0x35, 0x5e,
+	0xfd, 0xbf, 0x01, 0x00, 0x20, 0x99, 0x8e, 0xd5, 0xe0, 0x56, 0x00, 0x00,
 }
 
 func (this *StartWorkflowExecutionRequest) Equal(that interface{}) bool {
@@ -6816,6 +7215,57 @@ func (this *RemoveSignalMutableStateResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *UpsertWorkflowSearchAttributesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpsertWorkflowSearchAttributesRequest)
+	if !ok {
+		that2, ok := that.(UpsertWorkflowSearchAttributesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+		return false
+	}
+	if !this.SearchAttributes.Equal(that1.SearchAttributes) {
+		return false
+	}
+	return true
+}
+func (this *UpsertWorkflowSearchAttributesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpsertWorkflowSearchAttributesResponse)
+	if !ok {
+		that2, ok := that.(UpsertWorkflowSearchAttributesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 func (this *TerminateWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -7422,14 +7872,14 @@ func (this *ReplicateEventsV2Response) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *SyncShardStatusRequest) Equal(that interface{}) bool {
+func (this *ImportWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SyncShardStatusRequest)
+	that1, ok := that.(*ImportWorkflowExecutionRequest)
 	if !ok {
-		that2, ok := that.(SyncShardStatusRequest)
+		that2, ok := that.(ImportWorkflowExecutionRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7441,29 +7891,88 @@ func (this *SyncShardStatusRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.SourceCluster != that1.SourceCluster {
+	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if this.ShardId != that1.ShardId {
+	if !this.Execution.Equal(that1.Execution) {
 		return false
 	}
-	if that1.StatusTime == nil {
-		if this.StatusTime != nil {
+	if len(this.HistoryBatches) != len(that1.HistoryBatches) {
+		return false
+	}
+	for i := range this.HistoryBatches {
+		if !this.HistoryBatches[i].Equal(that1.HistoryBatches[i]) {
 			return false
 		}
-	} else if !this.StatusTime.Equal(*that1.StatusTime) {
+	}
+	if !this.VersionHistory.Equal(that1.VersionHistory) {
 		return false
 	}
 	return true
 }
-func (this *SyncShardStatusResponse) Equal(that interface{}) bool {
+func (this *ImportWorkflowExecutionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SyncShardStatusResponse)
+	that1, ok := that.(*ImportWorkflowExecutionResponse)
 	if !ok {
-		that2, ok := that.(SyncShardStatusResponse)
+		that2, ok := that.(ImportWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *SyncShardStatusRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SyncShardStatusRequest)
+	if !ok {
+		that2, ok := that.(SyncShardStatusRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SourceCluster != that1.SourceCluster {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if that1.StatusTime == nil {
+		if this.StatusTime != nil {
+			return false
+		}
+	} else if !this.StatusTime.Equal(*that1.StatusTime) {
+		return false
+	}
+	return true
+}
+func (this *SyncShardStatusResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SyncShardStatusResponse)
+	if !ok {
+		that2, ok := that.(SyncShardStatusResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7658,6 +8167,9 @@ func (this *DescribeHistoryHostRequest) Equal(that interface{}) bool {
 	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
 		return false
 	}
+	if this.IncludeShardLoads != that1.IncludeShardLoads {
+		return false
+	}
 	return true
 }
 func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
@@ -7696,6 +8208,14 @@ func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
 	if this.Address != that1.Address {
 		return false
 	}
+	if len(this.ShardLoads) != len(that1.ShardLoads) {
+		return false
+	}
+	for i := range this.ShardLoads {
+		if !this.ShardLoads[i].Equal(that1.ShardLoads[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *CloseShardRequest) Equal(that interface{}) bool {
@@ -7791,6 +8311,95 @@ func (this *GetShardResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *HandoffShardRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HandoffShardRequest)
+	if !ok {
+		that2, ok := that.(HandoffShardRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.PreviousOwner != that1.PreviousOwner {
+		return false
+	}
+	if len(this.Workflows) != len(that1.Workflows) {
+		return false
+	}
+	for i := range this.Workflows {
+		if !this.Workflows[i].Equal(that1.Workflows[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *HandoffShardWorkflow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HandoffShardWorkflow)
+	if !ok {
+		that2, ok := that.(HandoffShardWorkflow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.WorkflowId != that1.WorkflowId {
+		return false
+	}
+	if this.RunId != that1.RunId {
+		return false
+	}
+	return true
+}
+func (this *HandoffShardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HandoffShardResponse)
+	if !ok {
+		that2, ok := that.(HandoffShardResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PrewarmedWorkflows != that1.PrewarmedWorkflows {
+		return false
+	}
+	return true
+}
 func (this *RemoveTaskRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -9166,6 +9775,31 @@ func (this *RemoveSignalMutableStateResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *UpsertWorkflowSearchAttributesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&historyservice.UpsertWorkflowSearchAttributesRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	if this.SearchAttributes != nil {
+		s = append(s, "SearchAttributes: "+fmt.Sprintf("%#v", this.SearchAttributes)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpsertWorkflowSearchAttributesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.UpsertWorkflowSearchAttributesResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *TerminateWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
@@ -9461,6 +10095,34 @@ func (this *ReplicateEventsV2Response) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ImportWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&historyservice.ImportWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	if this.HistoryBatches != nil {
+		s = append(s, "HistoryBatches: "+fmt.Sprintf("%#v", this.HistoryBatches)+",\n")
+	}
+	if this.VersionHistory != nil {
+		s = append(s, "VersionHistory: "+fmt.Sprintf("%#v", this.VersionHistory)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ImportWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.ImportWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *SyncShardStatusRequest) GoString() string {
 	if this == nil {
 		return "nil"
@@ -9552,7 +10214,7 @@ func (this *DescribeHistoryHostRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 9)
 	s = append(s, "&historyservice.DescribeHistoryHostRequest{")
 	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
 	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
@@ -9560,6 +10222,7 @@ func (this *DescribeHistoryHostRequest) GoString() string {
 	if this.WorkflowExecution != nil {
 		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
 	}
+	s = append(s, "IncludeShardLoads: "+fmt.Sprintf("%#v", this.IncludeShardLoads)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -9567,7 +10230,7 @@ func (this *DescribeHistoryHostResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 9)
 	s = append(s, "&historyservice.DescribeHistoryHostResponse{")
 	s = append(s, "ShardsNumber: "+fmt.Sprintf("%#v", this.ShardsNumber)+",\n")
 	s = append(s, "ShardIds: "+fmt.Sprintf("%#v", this.ShardIds)+",\n")
@@ -9575,6 +10238,9 @@ func (this *DescribeHistoryHostResponse) GoString() string {
 		s = append(s, "NamespaceCache: "+fmt.Sprintf("%#v", this.NamespaceCache)+",\n")
 	}
 	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	if this.ShardLoads != nil {
+		s = append(s, "ShardLoads: "+fmt.Sprintf("%#v", this.ShardLoads)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -9619,6 +10285,42 @@ func (this *GetShardResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *HandoffShardRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&historyservice.HandoffShardRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "PreviousOwner: "+fmt.Sprintf("%#v", this.PreviousOwner)+",\n")
+	if this.Workflows != nil {
+		s = append(s, "Workflows: "+fmt.Sprintf("%#v", this.Workflows)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HandoffShardWorkflow) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&historyservice.HandoffShardWorkflow{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HandoffShardResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.HandoffShardResponse{")
+	s = append(s, "PrewarmedWorkflows: "+fmt.Sprintf("%#v", this.PrewarmedWorkflows)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *RemoveTaskRequest) GoString() string {
 	if this == nil {
 		return "nil"
@@ -11736,7 +12438,7 @@ func (m *RemoveSignalMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *TerminateWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+func (m *UpsertWorkflowSearchAttributesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11746,29 +12448,19 @@ func (m *TerminateWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TerminateWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpsertWorkflowSearchAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TerminateWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpsertWorkflowSearchAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChildWorkflowOnly {
-		i--
-		if m.ChildWorkflowOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ExternalWorkflowExecution != nil {
+	if m.SearchAttributes != nil {
 		{
-			size, err := m.ExternalWorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SearchAttributes.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -11778,9 +12470,9 @@ func (m *TerminateWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (i
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.TerminateRequest != nil {
+	if m.WorkflowExecution != nil {
 		{
-			size, err := m.TerminateRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -11800,7 +12492,7 @@ func (m *TerminateWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *TerminateWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+func (m *UpsertWorkflowSearchAttributesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11810,12 +12502,12 @@ func (m *TerminateWorkflowExecutionResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *TerminateWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpsertWorkflowSearchAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TerminateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpsertWorkflowSearchAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11823,7 +12515,7 @@ func (m *TerminateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+func (m *TerminateWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11833,19 +12525,19 @@ func (m *DeleteWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TerminateWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TerminateWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ClosedWorkflowOnly {
+	if m.ChildWorkflowOnly {
 		i--
-		if m.ClosedWorkflowOnly {
+		if m.ChildWorkflowOnly {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -11853,14 +12545,9 @@ func (m *DeleteWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.WorkflowVersion != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.WorkflowVersion))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.WorkflowExecution != nil {
+	if m.ExternalWorkflowExecution != nil {
 		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExternalWorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -11868,12 +12555,104 @@ func (m *DeleteWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+	if m.TerminateRequest != nil {
+		{
+			size, err := m.TerminateRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TerminateWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerminateWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TerminateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClosedWorkflowOnly {
+		i--
+		if m.ClosedWorkflowOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WorkflowVersion != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.WorkflowVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -12715,6 +13494,97 @@ func (m *ReplicateEventsV2Response) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *ImportWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VersionHistory != nil {
+		{
+			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.HistoryBatches) > 0 {
+		for iNdEx := len(m.HistoryBatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoryBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncShardStatusRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12736,12 +13606,12 @@ func (m *SyncShardStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if m.StatusTime != nil {
-		n78, err78 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
-		if err78 != nil {
-			return 0, err78
+		n82, err82 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
+		if err82 != nil {
+			return 0, err82
 		}
-		i -= n78
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n78))
+		i -= n82
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n82))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -12852,22 +13722,22 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.LastHeartbeatTime != nil {
-		n82, err82 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
-		if err82 != nil {
-			return 0, err82
+		n86, err86 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
+		if err86 != nil {
+			return 0, err86
 		}
-		i -= n82
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n82))
+		i -= n86
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n86))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.StartedTime != nil {
-		n83, err83 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err83 != nil {
-			return 0, err83
+		n87, err87 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err87 != nil {
+			return 0, err87
 		}
-		i -= n83
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n83))
+		i -= n87
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n87))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -12877,12 +13747,12 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x38
 	}
 	if m.ScheduledTime != nil {
-		n84, err84 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err84 != nil {
-			return 0, err84
+		n88, err88 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err88 != nil {
+			return 0, err88
 		}
-		i -= n84
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n84))
+		i -= n88
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n88))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -13052,6 +13922,16 @@ func (m *DescribeHistoryHostRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.IncludeShardLoads {
+		i--
+		if m.IncludeShardLoads {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WorkflowExecution != nil {
 		{
 			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
@@ -13106,6 +13986,20 @@ func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.ShardLoads) > 0 {
+		for iNdEx := len(m.ShardLoads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShardLoads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -13126,21 +14020,21 @@ func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x1a
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA91 := make([]byte, len(m.ShardIds)*10)
-		var j90 int
+		dAtA95 := make([]byte, len(m.ShardIds)*10)
+		var j94 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA91[j90] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA95[j94] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j90++
+				j94++
 			}
-			dAtA91[j90] = uint8(num)
-			j90++
+			dAtA95[j94] = uint8(num)
+			j94++
 		}
-		i -= j90
-		copy(dAtA[i:], dAtA91[:j90])
-		i = encodeVarintRequestResponse(dAtA, i, uint64(j90))
+		i -= j94
+		copy(dAtA[i:], dAtA95[:j94])
+		i = encodeVarintRequestResponse(dAtA, i, uint64(j94))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -13266,7 +14160,7 @@ func (m *GetShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveTaskRequest) Marshal() (dAtA []byte, err error) {
+func (m *HandoffShardRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -13276,35 +14170,36 @@ func (m *RemoveTaskRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *HandoffShardRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HandoffShardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.VisibilityTime != nil {
-		n93, err93 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err93 != nil {
-			return 0, err93
+	if len(m.Workflows) > 0 {
+		for iNdEx := len(m.Workflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Workflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= n93
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n93))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.TaskId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskId))
-		i--
-		dAtA[i] = 0x18
 	}
-	if m.Category != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Category))
+	if len(m.PreviousOwner) > 0 {
+		i -= len(m.PreviousOwner)
+		copy(dAtA[i:], m.PreviousOwner)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.PreviousOwner)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.ShardId != 0 {
 		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
@@ -13314,7 +14209,7 @@ func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveTaskResponse) Marshal() (dAtA []byte, err error) {
+func (m *HandoffShardWorkflow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -13324,20 +14219,41 @@ func (m *RemoveTaskResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *HandoffShardWorkflow) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HandoffShardWorkflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *HandoffShardResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -13347,17 +14263,116 @@ func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *HandoffShardResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HandoffShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ClusterName) > 0 {
+	if m.PrewarmedWorkflows != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PrewarmedWorkflows))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VisibilityTime != nil {
+		n97, err97 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err97 != nil {
+			return 0, err97
+		}
+		i -= n97
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n97))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Category != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Category))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClusterName) > 0 {
 		i -= len(m.ClusterName)
 		copy(dAtA[i:], m.ClusterName)
 		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
@@ -14125,12 +15140,12 @@ func (m *ShardReplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if m.MaxReplicationTaskVisibilityTime != nil {
-		n100, err100 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.MaxReplicationTaskVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.MaxReplicationTaskVisibilityTime):])
-		if err100 != nil {
-			return 0, err100
+		n104, err104 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.MaxReplicationTaskVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.MaxReplicationTaskVisibilityTime):])
+		if err104 != nil {
+			return 0, err104
 		}
-		i -= n100
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n100))
+		i -= n104
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n104))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -14187,12 +15202,12 @@ func (m *ShardReplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		}
 	}
 	if m.ShardLocalTime != nil {
-		n103, err103 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ShardLocalTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ShardLocalTime):])
-		if err103 != nil {
-			return 0, err103
+		n107, err107 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ShardLocalTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ShardLocalTime):])
+		if err107 != nil {
+			return 0, err107
 		}
-		i -= n103
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n103))
+		i -= n107
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n107))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -14258,12 +15273,12 @@ func (m *ShardReplicationStatusPerCluster) MarshalToSizedBuffer(dAtA []byte) (in
 	var l int
 	_ = l
 	if m.AckedTaskVisibilityTime != nil {
-		n104, err104 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AckedTaskVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AckedTaskVisibilityTime):])
-		if err104 != nil {
-			return 0, err104
+		n108, err108 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AckedTaskVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AckedTaskVisibilityTime):])
+		if err108 != nil {
+			return 0, err108
 		}
-		i -= n104
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n104))
+		i -= n108
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n108))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -14361,22 +15376,22 @@ func (m *DeleteWorkflowVisibilityRecordRequest) MarshalToSizedBuffer(dAtA []byte
 	var l int
 	_ = l
 	if m.WorkflowCloseTime != nil {
-		n106, err106 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowCloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowCloseTime):])
-		if err106 != nil {
-			return 0, err106
+		n110, err110 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowCloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowCloseTime):])
+		if err110 != nil {
+			return 0, err110
 		}
-		i -= n106
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n106))
+		i -= n110
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n110))
 		i--
 		dAtA[i] = 0x22
 	}
 	if m.WorkflowStartTime != nil {
-		n107, err107 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowStartTime):])
-		if err107 != nil {
-			return 0, err107
+		n111, err111 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowStartTime):])
+		if err111 != nil {
+			return 0, err111
 		}
-		i -= n107
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n107))
+		i -= n111
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n111))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -15208,6 +16223,36 @@ func (m *RemoveSignalMutableStateResponse) Size() (n int) {
 	return n
 }
 
+func (m *UpsertWorkflowSearchAttributesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.SearchAttributes != nil {
+		l = m.SearchAttributes.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UpsertWorkflowSearchAttributesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *TerminateWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15593,6 +16638,42 @@ func (m *ReplicateEventsV2Response) Size() (n int) {
 	return n
 }
 
+func (m *ImportWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.HistoryBatches) > 0 {
+		for _, e := range m.HistoryBatches {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if m.VersionHistory != nil {
+		l = m.VersionHistory.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ImportWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *SyncShardStatusRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15747,6 +16828,9 @@ func (m *DescribeHistoryHostRequest) Size() (n int) {
 		l = m.WorkflowExecution.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.IncludeShardLoads {
+		n += 2
+	}
 	return n
 }
 
@@ -15774,6 +16858,12 @@ func (m *DescribeHistoryHostResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if len(m.ShardLoads) > 0 {
+		for _, e := range m.ShardLoads {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -15823,6 +16913,61 @@ func (m *GetShardResponse) Size() (n int) {
 	return n
 }
 
+func (m *HandoffShardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	l = len(m.PreviousOwner)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.Workflows) > 0 {
+		for _, e := range m.Workflows {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HandoffShardWorkflow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *HandoffShardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PrewarmedWorkflows != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PrewarmedWorkflows))
+	}
+	return n
+}
+
 func (m *RemoveTaskRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16756,6 +17901,27 @@ func (this *RemoveSignalMutableStateResponse) String() string {
 	}, "")
 	return s
 }
+func (this *UpsertWorkflowSearchAttributesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpsertWorkflowSearchAttributesRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`SearchAttributes:` + strings.Replace(fmt.Sprintf("%v", this.SearchAttributes), "SearchAttributes", "v14.SearchAttributes", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpsertWorkflowSearchAttributesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpsertWorkflowSearchAttributesResponse{`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *TerminateWorkflowExecutionRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -17010,6 +18176,33 @@ func (this *ReplicateEventsV2Response) String() string {
 	}, "")
 	return s
 }
+func (this *ImportWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHistoryBatches := "[]*DataBlob{"
+	for _, f := range this.HistoryBatches {
+		repeatedStringForHistoryBatches += strings.Replace(fmt.Sprintf("%v", f), "DataBlob", "v14.DataBlob", 1) + ","
+	}
+	repeatedStringForHistoryBatches += "}"
+	s := strings.Join([]string{`&ImportWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`HistoryBatches:` + repeatedStringForHistoryBatches + `,`,
+		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v18.VersionHistory", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImportWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImportWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SyncShardStatusRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -17094,6 +18287,7 @@ func (this *DescribeHistoryHostRequest) String() string {
 		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`IncludeShardLoads:` + fmt.Sprintf("%v", this.IncludeShardLoads) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17102,11 +18296,17 @@ func (this *DescribeHistoryHostResponse) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForShardLoads := "[]*ShardLoad{"
+	for _, f := range this.ShardLoads {
+		repeatedStringForShardLoads += strings.Replace(fmt.Sprintf("%v", f), "ShardLoad", "v18.ShardLoad", 1) + ","
+	}
+	repeatedStringForShardLoads += "}"
 	s := strings.Join([]string{`&DescribeHistoryHostResponse{`,
 		`ShardsNumber:` + fmt.Sprintf("%v", this.ShardsNumber) + `,`,
 		`ShardIds:` + fmt.Sprintf("%v", this.ShardIds) + `,`,
 		`NamespaceCache:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceCache), "NamespaceCacheInfo", "v113.NamespaceCacheInfo", 1) + `,`,
 		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`ShardLoads:` + repeatedStringForShardLoads + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17150,7 +18350,46 @@ func (this *GetShardResponse) String() string {
 	}, "")
 	return s
 }
-func (this *RemoveTaskRequest) String() string {
+func (this *HandoffShardRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForWorkflows := "[]*HandoffShardWorkflow{"
+	for _, f := range this.Workflows {
+		repeatedStringForWorkflows += strings.Replace(f.String(), "HandoffShardWorkflow", "HandoffShardWorkflow", 1) + ","
+	}
+	repeatedStringForWorkflows += "}"
+	s := strings.Join([]string{`&HandoffShardRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`PreviousOwner:` + fmt.Sprintf("%v", this.PreviousOwner) + `,`,
+		`Workflows:` + repeatedStringForWorkflows + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HandoffShardWorkflow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HandoffShardWorkflow{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HandoffShardResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HandoffShardResponse{`,
+		`PrewarmedWorkflows:` + fmt.Sprintf("%v", this.PrewarmedWorkflows) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveTaskRequest) String() string {
 	if this == nil {
 		return "nil"
 	}
@@ -22619,6 +23858,216 @@ func (m *RemoveSignalMutableStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UpsertWorkflowSearchAttributesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpsertWorkflowSearchAttributesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpsertWorkflowSearchAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SearchAttributes == nil {
+				m.SearchAttributes = &v14.SearchAttributes{}
+			}
+			if err := m.SearchAttributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpsertWorkflowSearchAttributesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpsertWorkflowSearchAttributesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpsertWorkflowSearchAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -25312,7 +26761,7 @@ func (m *ReplicateEventsV2Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *ImportWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25335,15 +26784,15 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncShardStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncShardStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25371,30 +26820,11 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25421,7 +26851,270 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StatusTime == nil {
+			if m.Execution == nil {
+				m.Execution = &v14.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryBatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoryBatches = append(m.HistoryBatches, &v14.DataBlob{})
+			if err := m.HistoryBatches[len(m.HistoryBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionHistory == nil {
+				m.VersionHistory = &v18.VersionHistory{}
+			}
+			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncShardStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncShardStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StatusTime == nil {
 				m.StatusTime = new(time.Time)
 			}
 			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StatusTime, dAtA[iNdEx:postIndex]); err != nil {
@@ -26425,6 +28118,26 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeShardLoads", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeShardLoads = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -26613,7 +28326,251 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardLoads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShardLoads = append(m.ShardLoads, &v18.ShardLoad{})
+			if err := m.ShardLoads[len(m.ShardLoads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloseShardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloseShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloseShardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloseShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetShardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetShardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -26623,24 +28580,11 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -26665,7 +28609,7 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
+func (m *GetShardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26688,17 +28632,17 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloseShardRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetShardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardInfo", wireType)
 			}
-			m.ShardId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -26708,11 +28652,28 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ShardInfo == nil {
+				m.ShardInfo = &v112.ShardInfo{}
+			}
+			if err := m.ShardInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -26737,7 +28698,7 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
+func (m *HandoffShardRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26760,12 +28721,97 @@ func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloseShardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: HandoffShardRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HandoffShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Workflows = append(m.Workflows, &HandoffShardWorkflow{})
+			if err := m.Workflows[len(m.Workflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -26790,7 +28836,7 @@ func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetShardRequest) Unmarshal(dAtA []byte) error {
+func (m *HandoffShardWorkflow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26813,17 +28859,17 @@ func (m *GetShardRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetShardRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: HandoffShardWorkflow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HandoffShardWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			m.ShardId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -26833,11 +28879,88 @@ func (m *GetShardRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -26862,7 +28985,7 @@ func (m *GetShardRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetShardResponse) Unmarshal(dAtA []byte) error {
+func (m *HandoffShardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26885,17 +29008,17 @@ func (m *GetShardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetShardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: HandoffShardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HandoffShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardInfo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrewarmedWorkflows", wireType)
 			}
-			var msglen int
+			m.PrewarmedWorkflows = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -26905,28 +29028,11 @@ func (m *GetShardResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PrewarmedWorkflows |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ShardInfo == nil {
-				m.ShardInfo = &v112.ShardInfo{}
-			}
-			if err := m.ShardInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
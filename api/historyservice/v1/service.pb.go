@@ -54,85 +54,89 @@ func init() {
 }
 
 var fileDescriptor_655983da427ae822 = []byte{
-	// 1239 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0x4f, 0x6b, 0x24, 0x45,
-	0x18, 0xc6, 0xa7, 0x2e, 0x22, 0x85, 0xae, 0xda, 0x8a, 0x7f, 0xa2, 0x36, 0xa2, 0xe8, 0x71, 0xc2,
-	0xee, 0x82, 0xee, 0x6e, 0xb2, 0xae, 0x99, 0x49, 0x32, 0xc9, 0x6e, 0xa2, 0x66, 0x26, 0x1b, 0xc1,
-	0x8b, 0xf4, 0xf4, 0xbc, 0xc9, 0x14, 0xe9, 0x4c, 0xb7, 0x55, 0xd5, 0xa3, 0x73, 0x10, 0x04, 0x4f,
-	0x82, 0xa0, 0x08, 0x82, 0x27, 0xc1, 0x93, 0x22, 0x08, 0x82, 0x20, 0x08, 0x82, 0x17, 0x05, 0x4f,
-	0x92, 0x9b, 0x7b, 0x34, 0x93, 0x8b, 0xc7, 0xfd, 0x08, 0xd2, 0xd3, 0x53, 0x95, 0xa9, 0xee, 0xea,
-	0x99, 0xaa, 0x9e, 0xb9, 0xed, 0x4e, 0xea, 0xf9, 0xf5, 0x53, 0x55, 0xef, 0xbc, 0xf5, 0x74, 0x0d,
-	0xbe, 0xca, 0xe1, 0x24, 0x0a, 0xa9, 0x17, 0x2c, 0x33, 0xa0, 0x7d, 0xa0, 0xcb, 0x5e, 0x44, 0x96,
-	0xbb, 0x84, 0xf1, 0x90, 0x0e, 0x92, 0x4f, 0x88, 0x0f, 0xcb, 0xfd, 0xcb, 0xcb, 0xe3, 0x7f, 0x56,
-	0x23, 0x1a, 0xf2, 0xd0, 0x79, 0x59, 0x88, 0xaa, 0xa9, 0xa8, 0xea, 0x45, 0xa4, 0xaa, 0x8a, 0xaa,
-	0xfd, 0xcb, 0x4b, 0xab, 0x66, 0x6c, 0x0a, 0xef, 0xc7, 0xc0, 0xf8, 0x7b, 0x14, 0x58, 0x14, 0xf6,
-	0xd8, 0xf8, 0x21, 0x57, 0xfe, 0x58, 0xc1, 0x97, 0xb6, 0xd2, 0xc1, 0xad, 0x74, 0xb0, 0xf3, 0x1d,
-	0xc2, 0x4f, 0xb6, 0xb8, 0x47, 0xf9, 0x3b, 0x21, 0x3d, 0x3e, 0x0c, 0xc2, 0x0f, 0x36, 0x3e, 0x04,
-	0x3f, 0xe6, 0x24, 0xec, 0x39, 0xeb, 0x55, 0x23, 0x4f, 0x55, 0xbd, 0xbc, 0x99, 0x5a, 0x58, 0xda,
-	0x98, 0x93, 0x92, 0x4e, 0xe0, 0xc5, 0x8a, 0xf3, 0x25, 0xc2, 0x8f, 0x34, 0x80, 0xef, 0xc6, 0xdc,
-	0x6b, 0x07, 0xd0, 0xe2, 0x1e, 0x07, 0xe7, 0xa6, 0x21, 0x3c, 0xa3, 0x13, 0xde, 0x5e, 0x2f, 0x2b,
-	0x97, 0xa6, 0xbe, 0x42, 0xf8, 0xd1, 0xb7, 0xc3, 0x20, 0x50, 0x5c, 0x99, 0x62, 0xb3, 0x42, 0x61,
-	0xeb, 0x56, 0x69, 0xbd, 0xf4, 0xf5, 0x2d, 0xc2, 0x4f, 0x34, 0x81, 0x01, 0x6f, 0x71, 0xe2, 0x1f,
-	0x0f, 0xf6, 0x3d, 0x76, 0xbc, 0x17, 0x43, 0x0c, 0x4e, 0xcd, 0x90, 0xad, 0x13, 0x0b, 0x7f, 0xf5,
-	0xb9, 0x18, 0xd2, 0xe3, 0x4f, 0x08, 0x3f, 0xd3, 0x04, 0x3f, 0xa4, 0x1d, 0xb1, 0xed, 0xc9, 0xa8,
-	0x51, 0x1d, 0x40, 0xc7, 0x69, 0x18, 0x3f, 0xa4, 0x80, 0x20, 0xdc, 0x6e, 0xcd, 0x0f, 0xd2, 0x58,
-	0x5e, 0xf3, 0x39, 0xe9, 0x13, 0x3e, 0x28, 0x6f, 0x59, 0x43, 0x28, 0x67, 0x59, 0x0b, 0x92, 0x96,
-	0x7f, 0x45, 0xf8, 0xb9, 0xf4, 0xbf, 0xca, 0xdc, 0xea, 0xe1, 0x49, 0x14, 0x40, 0xe2, 0xfa, 0xb6,
-	0xf9, 0x6e, 0x16, 0x42, 0x84, 0xf1, 0x3b, 0x0b, 0x61, 0x65, 0x96, 0x3b, 0x37, 0x74, 0xd3, 0x23,
-	0x81, 0xd5, 0x72, 0x17, 0x10, 0xec, 0x97, 0xbb, 0x10, 0x24, 0x2d, 0xff, 0x82, 0xf0, 0xb3, 0xf9,
-	0x6d, 0xd9, 0x02, 0x8f, 0xf2, 0x36, 0x78, 0xdc, 0xd9, 0x2e, 0xbd, 0xb5, 0x92, 0x21, 0x6c, 0xdf,
-	0x5e, 0x04, 0x4a, 0x57, 0x27, 0x93, 0x43, 0x4b, 0xd7, 0x89, 0x16, 0x52, 0xb2, 0x4e, 0x0a, 0x58,
-	0xba, 0x3a, 0x99, 0x1c, 0x5a, 0xae, 0x4e, 0xf2, 0x84, 0x92, 0x75, 0xa2, 0x03, 0x65, 0xea, 0x24,
-	0x3f, 0x3b, 0xaf, 0xe7, 0x43, 0x62, 0x7a, 0x7b, 0x8e, 0x15, 0x1a, 0x33, 0xec, 0xeb, 0x64, 0x0a,
-	0x4a, 0x1a, 0xff, 0x01, 0xe1, 0xa7, 0x5a, 0xe4, 0xa8, 0xe7, 0x05, 0xf9, 0xc4, 0x60, 0x7c, 0xd6,
-	0xeb, 0xf5, 0xc2, 0xf0, 0xe6, 0xbc, 0x18, 0x69, 0xf6, 0x4f, 0x84, 0x5f, 0x18, 0x8f, 0x22, 0xbc,
-	0x5b, 0x90, 0x73, 0xde, 0xb4, 0x7b, 0x5c, 0x21, 0x48, 0xd8, 0x7f, 0x6b, 0x61, 0x3c, 0x39, 0x8f,
-	0x1f, 0x11, 0x7e, 0xba, 0x09, 0x27, 0x61, 0x1f, 0x52, 0x91, 0x12, 0x37, 0x36, 0x8d, 0xf7, 0x57,
-	0x0f, 0x10, 0xbe, 0x1b, 0x73, 0x73, 0xa4, 0xdf, 0x9f, 0x11, 0x5e, 0xda, 0x07, 0x7a, 0x42, 0x7a,
-	0x1e, 0x87, 0xfc, 0x8a, 0x9b, 0x7e, 0x91, 0x8a, 0x11, 0xc2, 0xf3, 0xf6, 0x02, 0x48, 0x4a, 0x69,
-	0xaf, 0x43, 0xd2, 0x5c, 0xca, 0x97, 0x76, 0x81, 0xde, 0xb6, 0xb4, 0x0b, 0x31, 0xd2, 0x6c, 0x12,
-	0xdc, 0x47, 0x01, 0xab, 0x7c, 0x70, 0xd7, 0xcb, 0x6d, 0x83, 0x7b, 0x11, 0x45, 0x3a, 0xfd, 0x1d,
-	0x61, 0x77, 0x0c, 0x4d, 0xfb, 0x49, 0xde, 0xf1, 0x8e, 0xf1, 0xb3, 0xa6, 0x61, 0x84, 0xf3, 0xdd,
-	0x05, 0xd1, 0x94, 0x34, 0xdd, 0xf2, 0xbb, 0xd0, 0x89, 0x03, 0x98, 0x3c, 0xfd, 0x8d, 0xd3, 0xb4,
-	0x4e, 0x6c, 0x9b, 0xa6, 0xf5, 0x0c, 0xa5, 0xd5, 0x1d, 0x00, 0x25, 0x87, 0x83, 0x4d, 0x42, 0x19,
-	0x57, 0x72, 0xec, 0x58, 0xd9, 0x31, 0x6e, 0x75, 0xb3, 0x40, 0xb6, 0xad, 0x6e, 0x36, 0x4f, 0xce,
-	0xe3, 0x37, 0x84, 0x9f, 0x4f, 0x13, 0x4b, 0xbd, 0x4b, 0x82, 0x8e, 0xdc, 0x8e, 0x8b, 0x20, 0x72,
-	0xc7, 0x2a, 0xf7, 0x14, 0x50, 0xc4, 0x0c, 0x76, 0x16, 0x03, 0x93, 0xf6, 0xff, 0x41, 0xf8, 0x95,
-	0x74, 0xb6, 0xda, 0xb1, 0xa3, 0xba, 0x4a, 0x48, 0xd0, 0x71, 0xf6, 0xad, 0x16, 0x6f, 0x16, 0x4e,
-	0x4c, 0xe8, 0xee, 0x82, 0xa9, 0x4a, 0xc8, 0x5a, 0x07, 0xe6, 0x53, 0xd2, 0xd6, 0xf4, 0xc7, 0x86,
-	0x71, 0x63, 0x2b, 0x20, 0xd8, 0x86, 0xac, 0x29, 0x20, 0x69, 0xf9, 0x6b, 0x84, 0x1f, 0x6b, 0x42,
-	0x14, 0x10, 0xdf, 0xe3, 0xb0, 0xd1, 0x87, 0x1e, 0x67, 0x07, 0x57, 0x9c, 0x5b, 0xc6, 0x5b, 0x9e,
-	0x51, 0x0a, 0x8b, 0x6f, 0x94, 0x07, 0x28, 0xb7, 0x19, 0xad, 0x41, 0xcf, 0x6f, 0x75, 0x3d, 0xda,
-	0x49, 0x8e, 0xcf, 0x98, 0x19, 0xdf, 0x66, 0x64, 0x74, 0xb6, 0xb7, 0x19, 0x39, 0xb9, 0x34, 0xf5,
-	0x29, 0xc2, 0x0f, 0x25, 0x7f, 0x15, 0x11, 0xd0, 0xb9, 0x61, 0x81, 0x14, 0x22, 0x61, 0x67, 0xa5,
-	0x94, 0x56, 0xe9, 0xb9, 0x62, 0x8f, 0x95, 0xb8, 0x53, 0xb3, 0x2c, 0x10, 0x5d, 0xd4, 0xa9, 0xcf,
-	0xc5, 0x90, 0x1e, 0xbf, 0x41, 0xf8, 0x71, 0x31, 0x64, 0x7c, 0xaf, 0xb6, 0x15, 0x32, 0xee, 0xac,
-	0x59, 0xe2, 0x27, 0xb4, 0xc2, 0x61, 0x6d, 0x1e, 0x84, 0x34, 0xf8, 0x09, 0xc2, 0xb8, 0x1e, 0x84,
-	0x0c, 0x46, 0xfb, 0xed, 0x5c, 0x33, 0x84, 0x5e, 0x48, 0x84, 0x9d, 0xeb, 0x25, 0x94, 0xd2, 0xc5,
-	0x47, 0xf8, 0xc1, 0x06, 0xf0, 0xd4, 0xc2, 0xab, 0xe6, 0x57, 0x6e, 0x8a, 0x81, 0xd7, 0xac, 0x75,
-	0xca, 0x22, 0xa4, 0x99, 0x75, 0x74, 0x66, 0x5f, 0xb3, 0x8a, 0xb9, 0x93, 0x27, 0xf5, 0xf5, 0x12,
-	0x4a, 0x25, 0xaf, 0x35, 0x80, 0x8b, 0x9e, 0x40, 0xc2, 0xde, 0x2e, 0x30, 0xe6, 0x1d, 0x01, 0x33,
-	0xce, 0x6b, 0x7a, 0xb9, 0x6d, 0x5e, 0x2b, 0xa2, 0x28, 0x8d, 0xbe, 0x01, 0x7c, 0x7d, 0x67, 0x4f,
-	0x67, 0xb6, 0x61, 0xfe, 0x18, 0x3d, 0xc1, 0xb6, 0xd1, 0x4f, 0x01, 0x49, 0xcb, 0x9f, 0x21, 0xfc,
-	0xf0, 0x5e, 0x0c, 0x74, 0x20, 0x4e, 0x03, 0xc7, 0xb4, 0xfb, 0x28, 0x2a, 0x61, 0x6d, 0xb5, 0x9c,
-	0x58, 0xb1, 0xd3, 0x04, 0x2f, 0x8a, 0x82, 0x41, 0xda, 0xfa, 0x8d, 0xed, 0x28, 0x2a, 0x5b, 0x3b,
-	0x19, 0xb1, 0xb4, 0xf3, 0x39, 0xc2, 0x97, 0xd2, 0x55, 0x94, 0xbb, 0xb8, 0x6a, 0xb5, 0xf8, 0xd9,
-	0xad, 0xbb, 0x59, 0x52, 0xad, 0x5e, 0x9b, 0xc7, 0xf4, 0x08, 0x26, 0x3d, 0x19, 0x5f, 0x9b, 0x67,
-	0x84, 0xd6, 0xd7, 0xe6, 0x39, 0xbd, 0xe2, 0x6b, 0x17, 0x4a, 0xfa, 0xca, 0x0a, 0x6d, 0x7d, 0xe5,
-	0xf5, 0x99, 0xeb, 0xfc, 0x43, 0x0a, 0xac, 0x3b, 0x99, 0x9f, 0x99, 0xc5, 0x75, 0x7e, 0x5e, 0x6c,
-	0x7f, 0x9d, 0xaf, 0x63, 0x48, 0x8f, 0x7f, 0x23, 0xfc, 0x52, 0x03, 0x7a, 0x40, 0x3d, 0x0e, 0x3b,
-	0x1e, 0xe3, 0xe3, 0x13, 0x69, 0xe2, 0x8b, 0x9b, 0x5a, 0xde, 0x33, 0x2e, 0x9e, 0x99, 0x2c, 0x31,
-	0x83, 0xe6, 0x22, 0x91, 0xca, 0xa2, 0xab, 0xcd, 0x72, 0x9c, 0xd3, 0x6a, 0xa5, 0x3a, 0xad, 0x1a,
-	0xd6, 0xea, 0x73, 0x31, 0x94, 0x04, 0xd2, 0x84, 0x76, 0x4c, 0x82, 0x8e, 0x12, 0x92, 0xd6, 0x8c,
-	0xf7, 0x34, 0xa7, 0xb5, 0x4d, 0x20, 0x5a, 0x84, 0xf2, 0xf2, 0xaf, 0x5e, 0x66, 0x1c, 0x10, 0x46,
-	0xda, 0x24, 0x18, 0xa5, 0xbd, 0xe4, 0x25, 0xc3, 0xf8, 0xe5, 0x7f, 0x3a, 0xc6, 0xf6, 0xe5, 0x7f,
-	0x16, 0x4d, 0xe9, 0x9e, 0x77, 0xa3, 0xce, 0xc4, 0xdd, 0x91, 0x71, 0xf7, 0x54, 0x65, 0xb6, 0xdd,
-	0x33, 0xab, 0x16, 0x8e, 0x6a, 0xd1, 0xe9, 0x99, 0x5b, 0xb9, 0x77, 0xe6, 0x56, 0xee, 0x9f, 0xb9,
-	0xe8, 0xe3, 0xa1, 0x8b, 0xbe, 0x1f, 0xba, 0xe8, 0xaf, 0xa1, 0x8b, 0x4e, 0x87, 0x2e, 0xfa, 0x77,
-	0xe8, 0xa2, 0xff, 0x86, 0x6e, 0xe5, 0xfe, 0xd0, 0x45, 0x5f, 0x9c, 0xbb, 0x95, 0xd3, 0x73, 0xb7,
-	0x72, 0xef, 0xdc, 0xad, 0xbc, 0x7b, 0xe3, 0x28, 0xbc, 0x78, 0x30, 0x09, 0xa7, 0xfe, 0x80, 0xbc,
-	0xa2, 0x7e, 0xd2, 0x7e, 0x60, 0xf4, 0xfb, 0xf1, 0xd5, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xfe,
-	0xee, 0xcb, 0x93, 0xdb, 0x1e, 0x00, 0x00,
+	// 1306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x9a, 0xcf, 0x6b, 0x6b, 0x45,
+	0x14, 0xc7, 0x33, 0x1b, 0x91, 0x41, 0x9f, 0x7a, 0x15, 0x7f, 0x3c, 0xf5, 0x22, 0x8a, 0x2e, 0x53,
+	0xde, 0x7b, 0xa0, 0xef, 0x47, 0x9f, 0xb5, 0x4d, 0xdb, 0xb4, 0xef, 0x35, 0x6a, 0x93, 0xb6, 0x82,
+	0x1b, 0x99, 0xdc, 0x9c, 0x36, 0x43, 0x6f, 0x73, 0xaf, 0x33, 0x73, 0xa3, 0x59, 0x08, 0x82, 0x2b,
+	0x41, 0x50, 0x04, 0xc1, 0x95, 0xe0, 0x4a, 0x11, 0x04, 0x41, 0x10, 0x04, 0xe1, 0xad, 0x04, 0x57,
+	0xd2, 0x9d, 0x6f, 0x69, 0xd3, 0x8d, 0xcb, 0xf7, 0x27, 0x48, 0x72, 0x33, 0xd3, 0x4c, 0xee, 0xdc,
+	0x64, 0xe6, 0x26, 0xbb, 0x36, 0x99, 0xef, 0x67, 0xbe, 0x33, 0x73, 0xee, 0x99, 0x33, 0x73, 0x83,
+	0xaf, 0x09, 0x38, 0x89, 0x23, 0x46, 0xc2, 0x25, 0x0e, 0xac, 0x0b, 0x6c, 0x89, 0xc4, 0x74, 0xa9,
+	0x4d, 0xb9, 0x88, 0x58, 0x6f, 0xf0, 0x09, 0x0d, 0x60, 0xa9, 0x7b, 0x65, 0x69, 0xf4, 0x67, 0x39,
+	0x66, 0x91, 0x88, 0xbc, 0x57, 0xa5, 0xa8, 0x9c, 0x8a, 0xca, 0x24, 0xa6, 0x65, 0x5d, 0x54, 0xee,
+	0x5e, 0xb9, 0xbc, 0x6c, 0xc7, 0x66, 0xf0, 0x61, 0x02, 0x5c, 0x7c, 0xc0, 0x80, 0xc7, 0x51, 0x87,
+	0x8f, 0x3a, 0xb9, 0x7a, 0x6f, 0x05, 0x5f, 0xda, 0x4a, 0x1b, 0x37, 0xd2, 0xc6, 0xde, 0x0f, 0x08,
+	0x3f, 0xdd, 0x10, 0x84, 0x89, 0xf7, 0x22, 0x76, 0x7c, 0x18, 0x46, 0x1f, 0x6d, 0x7c, 0x0c, 0x41,
+	0x22, 0x68, 0xd4, 0xf1, 0xd6, 0xcb, 0x56, 0x9e, 0xca, 0x66, 0x79, 0x3d, 0xb5, 0x70, 0x79, 0x63,
+	0x4e, 0x4a, 0x3a, 0x80, 0x97, 0x4b, 0xde, 0xd7, 0x08, 0x3f, 0x56, 0x05, 0x51, 0x4b, 0x04, 0x69,
+	0x86, 0xd0, 0x10, 0x44, 0x80, 0x77, 0xdb, 0x12, 0x3e, 0xa1, 0x93, 0xde, 0xde, 0x2c, 0x2a, 0x57,
+	0xa6, 0xbe, 0x41, 0xf8, 0xf1, 0x77, 0xa3, 0x30, 0xd4, 0x5c, 0xd9, 0x62, 0x27, 0x85, 0xd2, 0xd6,
+	0x4a, 0x61, 0xbd, 0xf2, 0xf5, 0x3d, 0xc2, 0x4f, 0xd5, 0x81, 0x83, 0x68, 0x08, 0x1a, 0x1c, 0xf7,
+	0xf6, 0x08, 0x3f, 0xde, 0x4d, 0x20, 0x01, 0x6f, 0xcd, 0x92, 0x6d, 0x12, 0x4b, 0x7f, 0x95, 0xb9,
+	0x18, 0xca, 0xe3, 0x2f, 0x08, 0x3f, 0x57, 0x87, 0x20, 0x62, 0x2d, 0xb9, 0xec, 0x83, 0x56, 0xc3,
+	0x38, 0x80, 0x96, 0x57, 0xb5, 0xee, 0x24, 0x87, 0x20, 0xdd, 0x6e, 0xcd, 0x0f, 0x32, 0x58, 0x5e,
+	0x0d, 0x04, 0xed, 0x52, 0xd1, 0x2b, 0x6e, 0xd9, 0x40, 0x28, 0x66, 0xd9, 0x08, 0x52, 0x96, 0x7f,
+	0x47, 0xf8, 0x85, 0xf4, 0x5f, 0x6d, 0x6c, 0x95, 0xe8, 0x24, 0x0e, 0x61, 0xe0, 0xfa, 0x8e, 0xfd,
+	0x6a, 0xe6, 0x42, 0xa4, 0xf1, 0xbb, 0x0b, 0x61, 0x4d, 0x4c, 0x77, 0xa6, 0xe9, 0x26, 0xa1, 0xa1,
+	0xd3, 0x74, 0xe7, 0x10, 0xdc, 0xa7, 0x3b, 0x17, 0xa4, 0x2c, 0xff, 0x86, 0xf0, 0xf3, 0xd9, 0x65,
+	0xd9, 0x02, 0xc2, 0x44, 0x13, 0x88, 0xf0, 0xb6, 0x0b, 0x2f, 0xad, 0x62, 0x48, 0xdb, 0x77, 0x16,
+	0x81, 0x32, 0xc5, 0xc9, 0x78, 0xd3, 0xc2, 0x71, 0x62, 0x84, 0x14, 0x8c, 0x93, 0x1c, 0x96, 0x29,
+	0x4e, 0xc6, 0x9b, 0x16, 0x8b, 0x93, 0x2c, 0xa1, 0x60, 0x9c, 0x98, 0x40, 0x13, 0x71, 0x92, 0x1d,
+	0x1d, 0xe9, 0x04, 0x30, 0x30, 0xbd, 0x3d, 0xc7, 0x0c, 0x8d, 0x18, 0xee, 0x71, 0x32, 0x05, 0xa5,
+	0x8c, 0xff, 0x84, 0xf0, 0x33, 0x0d, 0x7a, 0xd4, 0x21, 0x61, 0xb6, 0x62, 0xb0, 0xde, 0xeb, 0xcd,
+	0x7a, 0x69, 0x78, 0x73, 0x5e, 0x8c, 0x32, 0xfb, 0x27, 0xc2, 0x2f, 0x8d, 0x5a, 0x51, 0xd1, 0xce,
+	0xa9, 0x73, 0xde, 0x76, 0xeb, 0x2e, 0x17, 0x24, 0xed, 0xbf, 0xb3, 0x30, 0x9e, 0x1a, 0xc7, 0xcf,
+	0x08, 0x3f, 0x5b, 0x87, 0x93, 0xa8, 0x0b, 0xa9, 0x48, 0x2b, 0x37, 0x36, 0xad, 0xd7, 0xd7, 0x0c,
+	0x90, 0xbe, 0xab, 0x73, 0x73, 0x94, 0xdf, 0x7b, 0x08, 0xfb, 0xfb, 0x31, 0x87, 0x8b, 0x51, 0x35,
+	0x80, 0xb0, 0xa0, 0xbd, 0x2a, 0x04, 0xa3, 0xcd, 0x44, 0x00, 0xf7, 0x76, 0x2c, 0x7b, 0x9b, 0x8e,
+	0x91, 0xde, 0x6b, 0x0b, 0xa2, 0xa9, 0x11, 0xfc, 0x8a, 0xf0, 0xe5, 0x3d, 0x60, 0x27, 0xb4, 0x43,
+	0x04, 0x64, 0x63, 0xc6, 0x36, 0x15, 0xe4, 0x23, 0xa4, 0xf3, 0xed, 0x05, 0x90, 0xb4, 0x87, 0x73,
+	0x1d, 0x42, 0x10, 0x50, 0xfc, 0xe1, 0xcc, 0xd1, 0xbb, 0x3e, 0x9c, 0xb9, 0x18, 0x65, 0x76, 0x70,
+	0xf4, 0x18, 0x96, 0x88, 0xc5, 0x8f, 0x1e, 0x66, 0xb9, 0xeb, 0xd1, 0x23, 0x8f, 0xa2, 0x85, 0xf3,
+	0x08, 0x9a, 0x66, 0xc4, 0xac, 0xe3, 0x1d, 0xeb, 0xbe, 0xa6, 0x61, 0x5c, 0xc3, 0x79, 0x16, 0x4d,
+	0x3b, 0x0f, 0x34, 0x82, 0x36, 0xb4, 0x92, 0x10, 0xc6, 0xeb, 0x17, 0xeb, 0xf3, 0x80, 0x49, 0xec,
+	0x7a, 0x1e, 0x30, 0x33, 0xb4, 0x64, 0x7d, 0x00, 0x8c, 0x1e, 0xf6, 0x36, 0x29, 0xe3, 0x42, 0xab,
+	0xc4, 0x47, 0xca, 0x96, 0x75, 0xb2, 0x9e, 0x05, 0x72, 0x4d, 0xd6, 0xb3, 0x79, 0x6a, 0x1c, 0x7f,
+	0x20, 0xfc, 0x62, 0x5a, 0x73, 0x55, 0xda, 0x34, 0x6c, 0xa9, 0xe5, 0xb8, 0x28, 0xa5, 0xee, 0x3a,
+	0x55, 0x6e, 0x39, 0x14, 0x39, 0x82, 0x9d, 0xc5, 0xc0, 0x94, 0xfd, 0x7f, 0x10, 0x7e, 0x2d, 0x1d,
+	0xad, 0xb1, 0xed, 0x30, 0xae, 0x06, 0x24, 0x68, 0x79, 0x7b, 0x4e, 0x93, 0x37, 0x0b, 0x27, 0x07,
+	0xb4, 0xbf, 0x60, 0xaa, 0x56, 0x26, 0xae, 0x03, 0x0f, 0x18, 0x6d, 0x1a, 0xf2, 0x63, 0xd5, 0x3a,
+	0xb1, 0xe5, 0x10, 0x5c, 0xcb, 0xc4, 0x29, 0x20, 0x65, 0xf9, 0x5b, 0x84, 0x9f, 0xa8, 0x43, 0x1c,
+	0xd2, 0x80, 0x08, 0xd8, 0xe8, 0x42, 0x47, 0xf0, 0x83, 0xab, 0xde, 0x8a, 0xf5, 0x92, 0x4f, 0x28,
+	0xa5, 0xc5, 0xb7, 0x8a, 0x03, 0xb4, 0xbd, 0x66, 0x7b, 0x00, 0x11, 0xc5, 0xf7, 0x9a, 0x1c, 0xbd,
+	0xeb, 0x5e, 0x93, 0x8b, 0xd1, 0x2e, 0x8f, 0x1a, 0xbd, 0x4e, 0xd0, 0x68, 0x13, 0xd6, 0x1a, 0x54,
+	0x2b, 0x09, 0xb7, 0xbe, 0x3c, 0x9a, 0xd0, 0xb9, 0x5e, 0x1e, 0x65, 0xe4, 0xca, 0xd4, 0xe7, 0x08,
+	0x3f, 0x32, 0xf8, 0x56, 0x56, 0xdc, 0xde, 0x4d, 0x07, 0xa4, 0x14, 0x49, 0x3b, 0xb7, 0x0a, 0x69,
+	0xb5, 0x0d, 0x42, 0x06, 0xa4, 0x56, 0x5d, 0xae, 0x39, 0x46, 0xb3, 0xa9, 0xb2, 0xac, 0xcc, 0xc5,
+	0x50, 0x1e, 0xbf, 0x43, 0xf8, 0x49, 0xd9, 0x64, 0x74, 0x8d, 0xb9, 0x15, 0x71, 0xe1, 0xad, 0x3a,
+	0xe2, 0xc7, 0xb4, 0xd2, 0xe1, 0xda, 0x3c, 0x08, 0x65, 0xf0, 0x33, 0x84, 0x71, 0x25, 0x8c, 0x38,
+	0x0c, 0xd7, 0xdb, 0xbb, 0x6e, 0x09, 0xbd, 0x90, 0x48, 0x3b, 0x37, 0x0a, 0x28, 0x95, 0x8b, 0x4f,
+	0xf0, 0xc3, 0x55, 0x10, 0xa9, 0x85, 0xd7, 0xed, 0x6f, 0x38, 0x35, 0x03, 0x6f, 0x38, 0xeb, 0xb4,
+	0xa8, 0xde, 0x22, 0x9d, 0x56, 0x74, 0x78, 0x98, 0x7a, 0xb0, 0x8d, 0xea, 0x71, 0x91, 0x6b, 0x54,
+	0xeb, 0x5a, 0x6d, 0x41, 0xd2, 0xe3, 0xca, 0xb0, 0xd8, 0xb9, 0xee, 0x74, 0xc2, 0x19, 0x2f, 0x71,
+	0x6e, 0x14, 0x50, 0x6a, 0x85, 0x6e, 0x15, 0x84, 0x4c, 0xa6, 0x34, 0xea, 0xd4, 0x80, 0x73, 0x72,
+	0x04, 0xdc, 0xba, 0xd0, 0x35, 0xcb, 0x5d, 0x0b, 0xdd, 0x3c, 0x8a, 0xb6, 0x43, 0x56, 0x41, 0xac,
+	0xef, 0xec, 0x9a, 0xcc, 0x56, 0xed, 0xbb, 0x31, 0x13, 0x5c, 0x77, 0xc8, 0x29, 0x20, 0x65, 0xf9,
+	0x0b, 0x84, 0x1f, 0xdd, 0x4d, 0x80, 0xf5, 0x64, 0xfa, 0xf7, 0x6c, 0x63, 0x46, 0x53, 0x49, 0x6b,
+	0xcb, 0xc5, 0xc4, 0x9a, 0x9d, 0x3a, 0x90, 0x38, 0x0e, 0x7b, 0xe9, 0x9e, 0x69, 0x6d, 0x47, 0x53,
+	0xb9, 0xda, 0x99, 0x10, 0x2b, 0x3b, 0x5f, 0x22, 0x7c, 0x29, 0x9d, 0x45, 0xb5, 0x8a, 0xcb, 0x4e,
+	0x93, 0x3f, 0xb9, 0x74, 0xb7, 0x0b, 0xaa, 0xf5, 0x37, 0x26, 0x09, 0x3b, 0x82, 0x71, 0x4f, 0xd6,
+	0x6f, 0x4c, 0x26, 0x84, 0xce, 0x6f, 0x4c, 0x32, 0x7a, 0xcd, 0x57, 0x0d, 0x0a, 0xfa, 0xaa, 0xc1,
+	0x7c, 0xbe, 0x6a, 0x90, 0xeb, 0x2b, 0x7d, 0x93, 0x73, 0xc8, 0x80, 0xb7, 0xc7, 0x0f, 0x1e, 0xdc,
+	0xe1, 0x4d, 0x4e, 0x56, 0xec, 0xfe, 0x26, 0xc7, 0xc4, 0x50, 0x1e, 0xff, 0x46, 0xf8, 0x95, 0x2a,
+	0x74, 0x80, 0x11, 0x01, 0x3b, 0x84, 0x8b, 0xd1, 0xee, 0x38, 0xf6, 0xe0, 0xa6, 0x96, 0x77, 0xad,
+	0x83, 0x67, 0x26, 0x4b, 0x8e, 0xa0, 0xbe, 0x48, 0xa4, 0x36, 0xe9, 0x7a, 0xb2, 0x1c, 0xd5, 0x8c,
+	0x6b, 0x85, 0x32, 0xad, 0x5e, 0x38, 0x56, 0xe6, 0x62, 0x68, 0xd5, 0x50, 0x1d, 0x9a, 0x09, 0x0d,
+	0x5b, 0x5a, 0xc1, 0xb6, 0x6a, 0xbd, 0xa6, 0x19, 0xad, 0x6b, 0x35, 0x64, 0x44, 0x68, 0xb7, 0x26,
+	0xfa, 0x2d, 0xd0, 0x01, 0xe5, 0xb4, 0x49, 0xc3, 0x61, 0xe5, 0x39, 0x38, 0x9d, 0x59, 0xdf, 0x9a,
+	0x4c, 0xc7, 0xb8, 0xde, 0x9a, 0xcc, 0xa2, 0x69, 0xd9, 0x73, 0x3f, 0x6e, 0x8d, 0x5d, 0xba, 0x59,
+	0x67, 0x4f, 0x5d, 0xe6, 0x9a, 0x3d, 0x27, 0xd5, 0xd2, 0xd1, 0x5a, 0x7c, 0x7a, 0xe6, 0x97, 0xee,
+	0x9f, 0xf9, 0xa5, 0x07, 0x67, 0x3e, 0xfa, 0xb4, 0xef, 0xa3, 0x1f, 0xfb, 0x3e, 0xfa, 0xab, 0xef,
+	0xa3, 0xd3, 0xbe, 0x8f, 0xfe, 0xed, 0xfb, 0xe8, 0xbf, 0xbe, 0x5f, 0x7a, 0xd0, 0xf7, 0xd1, 0x57,
+	0xe7, 0x7e, 0xe9, 0xf4, 0xdc, 0x2f, 0xdd, 0x3f, 0xf7, 0x4b, 0xef, 0xdf, 0x3c, 0x8a, 0x2e, 0x3a,
+	0xa6, 0xd1, 0xd4, 0xdf, 0x0e, 0xdc, 0xd2, 0x3f, 0x69, 0x3e, 0x34, 0xfc, 0xe9, 0xc0, 0xb5, 0xff,
+	0x07, 0x00, 0xf1, 0x85, 0xac, 0x2d, 0xd6, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -222,6 +226,9 @@ type HistoryServiceClient interface {
 	// RemoveSignalMutableState is used to remove a signal request Id that was previously recorded.  This is currently
 	// used to clean execution info when signal workflow task finished.
 	RemoveSignalMutableState(ctx context.Context, in *RemoveSignalMutableStateRequest, opts ...grpc.CallOption) (*RemoveSignalMutableStateResponse, error)
+	// UpsertWorkflowSearchAttributes upserts search attributes of a running workflow on behalf of an operator,
+	// without adding an event to its history.
+	UpsertWorkflowSearchAttributes(ctx context.Context, in *UpsertWorkflowSearchAttributesRequest, opts ...grpc.CallOption) (*UpsertWorkflowSearchAttributesResponse, error)
 	// TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
 	// in the history and immediately terminating the execution instance.
 	TerminateWorkflowExecution(ctx context.Context, in *TerminateWorkflowExecutionRequest, opts ...grpc.CallOption) (*TerminateWorkflowExecutionResponse, error)
@@ -263,6 +270,8 @@ type HistoryServiceClient interface {
 	// DescribeWorkflowExecution returns information about the specified workflow execution.
 	DescribeWorkflowExecution(ctx context.Context, in *DescribeWorkflowExecutionRequest, opts ...grpc.CallOption) (*DescribeWorkflowExecutionResponse, error)
 	ReplicateEventsV2(ctx context.Context, in *ReplicateEventsV2Request, opts ...grpc.CallOption) (*ReplicateEventsV2Response, error)
+	// ImportWorkflowExecution applies history batches exported from another cluster to a workflow execution.
+	ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error)
 	// SyncShardStatus sync the status between shards.
 	SyncShardStatus(ctx context.Context, in *SyncShardStatusRequest, opts ...grpc.CallOption) (*SyncShardStatusResponse, error)
 	// SyncActivity sync the activity status.
@@ -275,6 +284,9 @@ type HistoryServiceClient interface {
 	CloseShard(ctx context.Context, in *CloseShardRequest, opts ...grpc.CallOption) (*CloseShardResponse, error)
 	// GetShard gets the ShardInfo
 	GetShard(ctx context.Context, in *GetShardRequest, opts ...grpc.CallOption) (*GetShardResponse, error)
+	// HandoffShard notifies the new owner of a shard that the previous owner gracefully released it,
+	// so it acquires the shard and prewarms its caches with the workflows recently used on the previous owner.
+	HandoffShard(ctx context.Context, in *HandoffShardRequest, opts ...grpc.CallOption) (*HandoffShardResponse, error)
 	// RemoveTask remove task based on type, taskid, shardid.
 	RemoveTask(ctx context.Context, in *RemoveTaskRequest, opts ...grpc.CallOption) (*RemoveTaskResponse, error)
 	// GetReplicationMessages return replication messages based on the read level
@@ -452,6 +464,15 @@ func (c *historyServiceClient) RemoveSignalMutableState(ctx context.Context, in
 	return out, nil
 }
 
+func (c *historyServiceClient) UpsertWorkflowSearchAttributes(ctx context.Context, in *UpsertWorkflowSearchAttributesRequest, opts ...grpc.CallOption) (*UpsertWorkflowSearchAttributesResponse, error) {
+	out := new(UpsertWorkflowSearchAttributesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/UpsertWorkflowSearchAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) TerminateWorkflowExecution(ctx context.Context, in *TerminateWorkflowExecutionRequest, opts ...grpc.CallOption) (*TerminateWorkflowExecutionResponse, error) {
 	out := new(TerminateWorkflowExecutionResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/TerminateWorkflowExecution", in, out, opts...)
@@ -542,6 +563,15 @@ func (c *historyServiceClient) ReplicateEventsV2(ctx context.Context, in *Replic
 	return out, nil
 }
 
+func (c *historyServiceClient) ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error) {
+	out := new(ImportWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/ImportWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) SyncShardStatus(ctx context.Context, in *SyncShardStatusRequest, opts ...grpc.CallOption) (*SyncShardStatusResponse, error) {
 	out := new(SyncShardStatusResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/SyncShardStatus", in, out, opts...)
@@ -596,6 +626,15 @@ func (c *historyServiceClient) GetShard(ctx context.Context, in *GetShardRequest
 	return out, nil
 }
 
+func (c *historyServiceClient) HandoffShard(ctx context.Context, in *HandoffShardRequest, opts ...grpc.CallOption) (*HandoffShardResponse, error) {
+	out := new(HandoffShardResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/HandoffShard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) RemoveTask(ctx context.Context, in *RemoveTaskRequest, opts ...grpc.CallOption) (*RemoveTaskResponse, error) {
 	out := new(RemoveTaskResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/RemoveTask", in, out, opts...)
@@ -799,6 +838,9 @@ type HistoryServiceServer interface {
 	// RemoveSignalMutableState is used to remove a signal request Id that was previously recorded.  This is currently
 	// used to clean execution info when signal workflow task finished.
 	RemoveSignalMutableState(context.Context, *RemoveSignalMutableStateRequest) (*RemoveSignalMutableStateResponse, error)
+	// UpsertWorkflowSearchAttributes upserts search attributes of a running workflow on behalf of an operator,
+	// without adding an event to its history.
+	UpsertWorkflowSearchAttributes(context.Context, *UpsertWorkflowSearchAttributesRequest) (*UpsertWorkflowSearchAttributesResponse, error)
 	// TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
 	// in the history and immediately terminating the execution instance.
 	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest) (*TerminateWorkflowExecutionResponse, error)
@@ -840,6 +882,8 @@ type HistoryServiceServer interface {
 	// DescribeWorkflowExecution returns information about the specified workflow execution.
 	DescribeWorkflowExecution(context.Context, *DescribeWorkflowExecutionRequest) (*DescribeWorkflowExecutionResponse, error)
 	ReplicateEventsV2(context.Context, *ReplicateEventsV2Request) (*ReplicateEventsV2Response, error)
+	// ImportWorkflowExecution applies history batches exported from another cluster to a workflow execution.
+	ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error)
 	// SyncShardStatus sync the status between shards.
 	SyncShardStatus(context.Context, *SyncShardStatusRequest) (*SyncShardStatusResponse, error)
 	// SyncActivity sync the activity status.
@@ -852,6 +896,9 @@ type HistoryServiceServer interface {
 	CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error)
 	// GetShard gets the ShardInfo
 	GetShard(context.Context, *GetShardRequest) (*GetShardResponse, error)
+	// HandoffShard notifies the new owner of a shard that the previous owner gracefully released it,
+	// so it acquires the shard and prewarms its caches with the workflows recently used on the previous owner.
+	HandoffShard(context.Context, *HandoffShardRequest) (*HandoffShardResponse, error)
 	// RemoveTask remove task based on type, taskid, shardid.
 	RemoveTask(context.Context, *RemoveTaskRequest) (*RemoveTaskResponse, error)
 	// GetReplicationMessages return replication messages based on the read level
@@ -935,6 +982,9 @@ func (*UnimplementedHistoryServiceServer) SignalWithStartWorkflowExecution(ctx c
 func (*UnimplementedHistoryServiceServer) RemoveSignalMutableState(ctx context.Context, req *RemoveSignalMutableStateRequest) (*RemoveSignalMutableStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveSignalMutableState not implemented")
 }
+func (*UnimplementedHistoryServiceServer) UpsertWorkflowSearchAttributes(ctx context.Context, req *UpsertWorkflowSearchAttributesRequest) (*UpsertWorkflowSearchAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertWorkflowSearchAttributes not implemented")
+}
 func (*UnimplementedHistoryServiceServer) TerminateWorkflowExecution(ctx context.Context, req *TerminateWorkflowExecutionRequest) (*TerminateWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TerminateWorkflowExecution not implemented")
 }
@@ -965,6 +1015,9 @@ func (*UnimplementedHistoryServiceServer) DescribeWorkflowExecution(ctx context.
 func (*UnimplementedHistoryServiceServer) ReplicateEventsV2(ctx context.Context, req *ReplicateEventsV2Request) (*ReplicateEventsV2Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReplicateEventsV2 not implemented")
 }
+func (*UnimplementedHistoryServiceServer) ImportWorkflowExecution(ctx context.Context, req *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportWorkflowExecution not implemented")
+}
 func (*UnimplementedHistoryServiceServer) SyncShardStatus(ctx context.Context, req *SyncShardStatusRequest) (*SyncShardStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncShardStatus not implemented")
 }
@@ -983,6 +1036,9 @@ func (*UnimplementedHistoryServiceServer) CloseShard(ctx context.Context, req *C
 func (*UnimplementedHistoryServiceServer) GetShard(ctx context.Context, req *GetShardRequest) (*GetShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetShard not implemented")
 }
+func (*UnimplementedHistoryServiceServer) HandoffShard(ctx context.Context, req *HandoffShardRequest) (*HandoffShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandoffShard not implemented")
+}
 func (*UnimplementedHistoryServiceServer) RemoveTask(ctx context.Context, req *RemoveTaskRequest) (*RemoveTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveTask not implemented")
 }
@@ -1300,6 +1356,24 @@ func _HistoryService_RemoveSignalMutableState_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_UpsertWorkflowSearchAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertWorkflowSearchAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UpsertWorkflowSearchAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/UpsertWorkflowSearchAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UpsertWorkflowSearchAttributes(ctx, req.(*UpsertWorkflowSearchAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_TerminateWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TerminateWorkflowExecutionRequest)
 	if err := dec(in); err != nil {
@@ -1480,6 +1554,24 @@ func _HistoryService_ReplicateEventsV2_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_ImportWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ImportWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/ImportWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ImportWorkflowExecution(ctx, req.(*ImportWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_SyncShardStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SyncShardStatusRequest)
 	if err := dec(in); err != nil {
@@ -1588,6 +1680,24 @@ func _HistoryService_GetShard_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_HandoffShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandoffShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).HandoffShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/HandoffShard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).HandoffShard(ctx, req.(*HandoffShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_RemoveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveTaskRequest)
 	if err := dec(in); err != nil {
@@ -1904,6 +2014,10 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveSignalMutableState",
 			Handler:    _HistoryService_RemoveSignalMutableState_Handler,
 		},
+		{
+			MethodName: "UpsertWorkflowSearchAttributes",
+			Handler:    _HistoryService_UpsertWorkflowSearchAttributes_Handler,
+		},
 		{
 			MethodName: "TerminateWorkflowExecution",
 			Handler:    _HistoryService_TerminateWorkflowExecution_Handler,
@@ -1944,6 +2058,10 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReplicateEventsV2",
 			Handler:    _HistoryService_ReplicateEventsV2_Handler,
 		},
+		{
+			MethodName: "ImportWorkflowExecution",
+			Handler:    _HistoryService_ImportWorkflowExecution_Handler,
+		},
 		{
 			MethodName: "SyncShardStatus",
 			Handler:    _HistoryService_SyncShardStatus_Handler,
@@ -1968,6 +2086,10 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetShard",
 			Handler:    _HistoryService_GetShard_Handler,
 		},
+		{
+			MethodName: "HandoffShard",
+			Handler:    _HistoryService_HandoffShard_Handler,
+		},
 		{
 			MethodName: "RemoveTask",
 			Handler:    _HistoryService_RemoveTask_Handler,
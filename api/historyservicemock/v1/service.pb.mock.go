@@ -320,6 +320,46 @@ func (mr *MockHistoryServiceClientMockRecorder) GetShard(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShard", reflect.TypeOf((*MockHistoryServiceClient)(nil).GetShard), varargs...)
 }
 
+// HandoffShard mocks base method.
+func (m *MockHistoryServiceClient) HandoffShard(ctx context.Context, in *historyservice.HandoffShardRequest, opts ...grpc.CallOption) (*historyservice.HandoffShardResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "HandoffShard", varargs...)
+	ret0, _ := ret[0].(*historyservice.HandoffShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandoffShard indicates an expected call of HandoffShard.
+func (mr *MockHistoryServiceClientMockRecorder) HandoffShard(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandoffShard", reflect.TypeOf((*MockHistoryServiceClient)(nil).HandoffShard), varargs...)
+}
+
+// ImportWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) ImportWorkflowExecution(ctx context.Context, in *historyservice.ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.ImportWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.ImportWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportWorkflowExecution indicates an expected call of ImportWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) ImportWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).ImportWorkflowExecution), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockHistoryServiceClient) MergeDLQMessages(ctx context.Context, in *historyservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*historyservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -920,6 +960,26 @@ func (mr *MockHistoryServiceClientMockRecorder) UpdateWorkflow(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflow", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpdateWorkflow), varargs...)
 }
 
+// UpsertWorkflowSearchAttributes mocks base method.
+func (m *MockHistoryServiceClient) UpsertWorkflowSearchAttributes(ctx context.Context, in *historyservice.UpsertWorkflowSearchAttributesRequest, opts ...grpc.CallOption) (*historyservice.UpsertWorkflowSearchAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertWorkflowSearchAttributes", varargs...)
+	ret0, _ := ret[0].(*historyservice.UpsertWorkflowSearchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWorkflowSearchAttributes indicates an expected call of UpsertWorkflowSearchAttributes.
+func (mr *MockHistoryServiceClientMockRecorder) UpsertWorkflowSearchAttributes(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowSearchAttributes", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpsertWorkflowSearchAttributes), varargs...)
+}
+
 // VerifyChildExecutionCompletionRecorded mocks base method.
 func (m *MockHistoryServiceClient) VerifyChildExecutionCompletionRecorded(ctx context.Context, in *historyservice.VerifyChildExecutionCompletionRecordedRequest, opts ...grpc.CallOption) (*historyservice.VerifyChildExecutionCompletionRecordedResponse, error) {
 	m.ctrl.T.Helper()
@@ -1178,6 +1238,36 @@ func (mr *MockHistoryServiceServerMockRecorder) GetShard(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShard", reflect.TypeOf((*MockHistoryServiceServer)(nil).GetShard), arg0, arg1)
 }
 
+// HandoffShard mocks base method.
+func (m *MockHistoryServiceServer) HandoffShard(arg0 context.Context, arg1 *historyservice.HandoffShardRequest) (*historyservice.HandoffShardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandoffShard", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.HandoffShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandoffShard indicates an expected call of HandoffShard.
+func (mr *MockHistoryServiceServerMockRecorder) HandoffShard(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandoffShard", reflect.TypeOf((*MockHistoryServiceServer)(nil).HandoffShard), arg0, arg1)
+}
+
+// ImportWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) ImportWorkflowExecution(arg0 context.Context, arg1 *historyservice.ImportWorkflowExecutionRequest) (*historyservice.ImportWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ImportWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportWorkflowExecution indicates an expected call of ImportWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) ImportWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).ImportWorkflowExecution), arg0, arg1)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockHistoryServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *historyservice.MergeDLQMessagesRequest) (*historyservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1628,6 +1718,21 @@ func (mr *MockHistoryServiceServerMockRecorder) UpdateWorkflow(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflow", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpdateWorkflow), arg0, arg1)
 }
 
+// UpsertWorkflowSearchAttributes mocks base method.
+func (m *MockHistoryServiceServer) UpsertWorkflowSearchAttributes(arg0 context.Context, arg1 *historyservice.UpsertWorkflowSearchAttributesRequest) (*historyservice.UpsertWorkflowSearchAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkflowSearchAttributes", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UpsertWorkflowSearchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWorkflowSearchAttributes indicates an expected call of UpsertWorkflowSearchAttributes.
+func (mr *MockHistoryServiceServerMockRecorder) UpsertWorkflowSearchAttributes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowSearchAttributes", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpsertWorkflowSearchAttributes), arg0, arg1)
+}
+
 // VerifyChildExecutionCompletionRecorded mocks base method.
 func (m *MockHistoryServiceServer) VerifyChildExecutionCompletionRecorded(arg0 context.Context, arg1 *historyservice.VerifyChildExecutionCompletionRecordedRequest) (*historyservice.VerifyChildExecutionCompletionRecordedResponse, error) {
 	m.ctrl.T.Helper()
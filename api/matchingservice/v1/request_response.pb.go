@@ -50,7 +50,8 @@ import (
 	v16 "go.temporal.io/server/api/clock/v1"
 	v15 "go.temporal.io/server/api/enums/v1"
 	v13 "go.temporal.io/server/api/history/v1"
-	v18 "go.temporal.io/server/api/persistence/v1"
+	v19 "go.temporal.io/server/api/persistence/v1"
+	v18 "go.temporal.io/server/api/taskqueue/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -540,6 +541,8 @@ type AddWorkflowTaskRequest struct {
 	ForwardedSource        string           `protobuf:"bytes,6,opt,name=forwarded_source,json=forwardedSource,proto3" json:"forwarded_source,omitempty"`
 	Source                 v15.TaskSource   `protobuf:"varint,7,opt,name=source,proto3,enum=temporal.server.api.enums.v1.TaskSource" json:"source,omitempty"`
 	Clock                  *v16.VectorClock `protobuf:"bytes,9,opt,name=clock,proto3" json:"clock,omitempty"`
+	Priority               int32            `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
+	FairnessKey            string           `protobuf:"bytes,11,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 }
 
 func (m *AddWorkflowTaskRequest) Reset()      { *m = AddWorkflowTaskRequest{} }
@@ -630,6 +633,20 @@ func (m *AddWorkflowTaskRequest) GetClock() *v16.VectorClock {
 	return nil
 }
 
+func (m *AddWorkflowTaskRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *AddWorkflowTaskRequest) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 type AddWorkflowTaskResponse struct {
 }
 
@@ -676,6 +693,8 @@ type AddActivityTaskRequest struct {
 	ForwardedSource        string           `protobuf:"bytes,7,opt,name=forwarded_source,json=forwardedSource,proto3" json:"forwarded_source,omitempty"`
 	Source                 v15.TaskSource   `protobuf:"varint,8,opt,name=source,proto3,enum=temporal.server.api.enums.v1.TaskSource" json:"source,omitempty"`
 	Clock                  *v16.VectorClock `protobuf:"bytes,9,opt,name=clock,proto3" json:"clock,omitempty"`
+	Priority               int32            `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
+	FairnessKey            string           `protobuf:"bytes,11,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 }
 
 func (m *AddActivityTaskRequest) Reset()      { *m = AddActivityTaskRequest{} }
@@ -766,6 +785,20 @@ func (m *AddActivityTaskRequest) GetClock() *v16.VectorClock {
 	return nil
 }
 
+func (m *AddActivityTaskRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *AddActivityTaskRequest) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 type AddActivityTaskResponse struct {
 }
 
@@ -1126,6 +1159,8 @@ var xxx_messageInfo_CancelOutstandingPollResponse proto.InternalMessageInfo
 type DescribeTaskQueueRequest struct {
 	NamespaceId string                       `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	DescRequest *v1.DescribeTaskQueueRequest `protobuf:"bytes,2,opt,name=desc_request,json=descRequest,proto3" json:"desc_request,omitempty"`
+	// If set, the response includes backlog statistics of the partition.
+	IncludeBacklogStats bool `protobuf:"varint,3,opt,name=include_backlog_stats,json=includeBacklogStats,proto3" json:"include_backlog_stats,omitempty"`
 }
 
 func (m *DescribeTaskQueueRequest) Reset()      { *m = DescribeTaskQueueRequest{} }
@@ -1174,9 +1209,18 @@ func (m *DescribeTaskQueueRequest) GetDescRequest() *v1.DescribeTaskQueueRequest
 	return nil
 }
 
+func (m *DescribeTaskQueueRequest) GetIncludeBacklogStats() bool {
+	if m != nil {
+		return m.IncludeBacklogStats
+	}
+	return false
+}
+
 type DescribeTaskQueueResponse struct {
 	Pollers         []*v14.PollerInfo    `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
 	TaskQueueStatus *v14.TaskQueueStatus `protobuf:"bytes,2,opt,name=task_queue_status,json=taskQueueStatus,proto3" json:"task_queue_status,omitempty"`
+	// Backlog statistics of the partition, if requested.
+	BacklogStats *v18.BacklogStats `protobuf:"bytes,3,opt,name=backlog_stats,json=backlogStats,proto3" json:"backlog_stats,omitempty"`
 }
 
 func (m *DescribeTaskQueueResponse) Reset()      { *m = DescribeTaskQueueResponse{} }
@@ -1225,6 +1269,13 @@ func (m *DescribeTaskQueueResponse) GetTaskQueueStatus() *v14.TaskQueueStatus {
 	return nil
 }
 
+func (m *DescribeTaskQueueResponse) GetBacklogStats() *v18.BacklogStats {
+	if m != nil {
+		return m.BacklogStats
+	}
+	return nil
+}
+
 type ListTaskQueuePartitionsRequest struct {
 	Namespace   string         `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	NamespaceId string         `protobuf:"bytes,3,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -1527,7 +1578,7 @@ type InvalidateTaskQueueMetadataRequest struct {
 	TaskQueue     string            `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
 	TaskQueueType v17.TaskQueueType `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
 	// The task queue versioning data should be invalidated and replaced with this data, if set.
-	VersioningData *v18.VersioningData `protobuf:"bytes,4,opt,name=versioning_data,json=versioningData,proto3" json:"versioning_data,omitempty"`
+	VersioningData *v19.VersioningData `protobuf:"bytes,4,opt,name=versioning_data,json=versioningData,proto3" json:"versioning_data,omitempty"`
 }
 
 func (m *InvalidateTaskQueueMetadataRequest) Reset()      { *m = InvalidateTaskQueueMetadataRequest{} }
@@ -1583,7 +1634,7 @@ func (m *InvalidateTaskQueueMetadataRequest) GetTaskQueueType() v17.TaskQueueTyp
 	return v17.TASK_QUEUE_TYPE_UNSPECIFIED
 }
 
-func (m *InvalidateTaskQueueMetadataRequest) GetVersioningData() *v18.VersioningData {
+func (m *InvalidateTaskQueueMetadataRequest) GetVersioningData() *v19.VersioningData {
 	if m != nil {
 		return m.VersioningData
 	}
@@ -1635,6 +1686,12 @@ type GetTaskQueueMetadataRequest struct {
 	// it can use any invalid value (ex: [0]).
 	// If the data is up to date, no value will be returned.
 	WantVersioningDataCurhash []byte `protobuf:"bytes,3,opt,name=want_versioning_data_curhash,json=wantVersioningDataCurhash,proto3" json:"want_versioning_data_curhash,omitempty"`
+	// The type of the task queue whose partition counts are requested. Only used together with
+	// want_partition_counts; versioning data is always read from the root workflow queue.
+	TaskQueueType v17.TaskQueueType `protobuf:"varint,4,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	// If set, the requester wants the number of partitions currently active for writes and reads,
+	// as decided by the root partition when partition autoscaling is enabled.
+	WantPartitionCounts bool `protobuf:"varint,5,opt,name=want_partition_counts,json=wantPartitionCounts,proto3" json:"want_partition_counts,omitempty"`
 }
 
 func (m *GetTaskQueueMetadataRequest) Reset()      { *m = GetTaskQueueMetadataRequest{} }
@@ -1690,11 +1747,31 @@ func (m *GetTaskQueueMetadataRequest) GetWantVersioningDataCurhash() []byte {
 	return nil
 }
 
+func (m *GetTaskQueueMetadataRequest) GetTaskQueueType() v17.TaskQueueType {
+	if m != nil {
+		return m.TaskQueueType
+	}
+	return v17.TASK_QUEUE_TYPE_UNSPECIFIED
+}
+
+func (m *GetTaskQueueMetadataRequest) GetWantPartitionCounts() bool {
+	if m != nil {
+		return m.WantPartitionCounts
+	}
+	return false
+}
+
 type GetTaskQueueMetadataResponse struct {
 	// Types that are valid to be assigned to VersioningDataResp:
 	//	*GetTaskQueueMetadataResponse_VersioningData
 	//	*GetTaskQueueMetadataResponse_MatchedReqHash
 	VersioningDataResp isGetTaskQueueMetadataResponse_VersioningDataResp `protobuf_oneof:"versioning_data_resp"`
+	// Number of partitions that currently accept new tasks. Zero if partition counts were not
+	// requested or partition autoscaling is disabled.
+	NumWritePartitions int32 `protobuf:"varint,3,opt,name=num_write_partitions,json=numWritePartitions,proto3" json:"num_write_partitions,omitempty"`
+	// Number of partitions that are still polled. May exceed num_write_partitions while retired
+	// partitions drain their backlog.
+	NumReadPartitions int32 `protobuf:"varint,4,opt,name=num_read_partitions,json=numReadPartitions,proto3" json:"num_read_partitions,omitempty"`
 }
 
 func (m *GetTaskQueueMetadataResponse) Reset()      { *m = GetTaskQueueMetadataResponse{} }
@@ -1737,7 +1814,7 @@ type isGetTaskQueueMetadataResponse_VersioningDataResp interface {
 }
 
 type GetTaskQueueMetadataResponse_VersioningData struct {
-	VersioningData *v18.VersioningData `protobuf:"bytes,1,opt,name=versioning_data,json=versioningData,proto3,oneof" json:"versioning_data,omitempty"`
+	VersioningData *v19.VersioningData `protobuf:"bytes,1,opt,name=versioning_data,json=versioningData,proto3,oneof" json:"versioning_data,omitempty"`
 }
 type GetTaskQueueMetadataResponse_MatchedReqHash struct {
 	MatchedReqHash bool `protobuf:"varint,2,opt,name=matched_req_hash,json=matchedReqHash,proto3,oneof" json:"matched_req_hash,omitempty"`
@@ -1755,7 +1832,7 @@ func (m *GetTaskQueueMetadataResponse) GetVersioningDataResp() isGetTaskQueueMet
 	return nil
 }
 
-func (m *GetTaskQueueMetadataResponse) GetVersioningData() *v18.VersioningData {
+func (m *GetTaskQueueMetadataResponse) GetVersioningData() *v19.VersioningData {
 	if x, ok := m.GetVersioningDataResp().(*GetTaskQueueMetadataResponse_VersioningData); ok {
 		return x.VersioningData
 	}
@@ -1769,6 +1846,20 @@ func (m *GetTaskQueueMetadataResponse) GetMatchedReqHash() bool {
 	return false
 }
 
+func (m *GetTaskQueueMetadataResponse) GetNumWritePartitions() int32 {
+	if m != nil {
+		return m.NumWritePartitions
+	}
+	return 0
+}
+
+func (m *GetTaskQueueMetadataResponse) GetNumReadPartitions() int32 {
+	if m != nil {
+		return m.NumReadPartitions
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*GetTaskQueueMetadataResponse) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1812,133 +1903,143 @@ func init() {
 }
 
 var fileDescriptor_a429a3813476c583 = []byte{
-	// 2014 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x73, 0xdb, 0xc6,
-	0x15, 0x17, 0x28, 0x4a, 0x22, 0x1f, 0x29, 0x89, 0x42, 0x13, 0x99, 0x92, 0x25, 0x48, 0xa6, 0xf3,
-	0x21, 0x7b, 0x5c, 0x6a, 0xac, 0x4e, 0x3c, 0x49, 0xda, 0x8c, 0x23, 0xd1, 0x1e, 0x5b, 0xa9, 0xd3,
-	0xc8, 0xb0, 0x9a, 0x76, 0xdc, 0x66, 0x90, 0x15, 0xb0, 0x22, 0x51, 0x81, 0x00, 0x85, 0x5d, 0x50,
-	0x51, 0x4f, 0xcd, 0xa1, 0xd3, 0x53, 0x67, 0xd2, 0xe9, 0xa5, 0x9d, 0x5e, 0x7a, 0xea, 0xb4, 0xf7,
-	0xfe, 0x03, 0xbd, 0xf5, 0xd0, 0x83, 0x8f, 0xb9, 0xb5, 0x96, 0x2f, 0x9d, 0xe9, 0x25, 0xfd, 0x0f,
-	0x3a, 0xfb, 0x01, 0x80, 0x00, 0x41, 0x91, 0xa2, 0x9d, 0x3a, 0x37, 0xe2, 0x7d, 0xed, 0x7b, 0x6f,
-	0x7f, 0xef, 0x03, 0x20, 0xbc, 0x47, 0x71, 0xbb, 0xe3, 0xf9, 0xc8, 0xd9, 0x24, 0xd8, 0xef, 0x62,
-	0x7f, 0x13, 0x75, 0xec, 0xcd, 0x36, 0xa2, 0x66, 0xcb, 0x76, 0x9b, 0x8c, 0x64, 0x9b, 0x78, 0xb3,
-	0x7b, 0x73, 0xd3, 0xc7, 0xc7, 0x01, 0x26, 0xd4, 0xf0, 0x31, 0xe9, 0x78, 0x2e, 0xc1, 0xf5, 0x8e,
-	0xef, 0x51, 0x4f, 0x7d, 0x23, 0x54, 0xaf, 0x0b, 0xf5, 0x3a, 0xea, 0xd8, 0xf5, 0x94, 0x7a, 0xbd,
-	0x7b, 0x73, 0x59, 0x6b, 0x7a, 0x5e, 0xd3, 0xc1, 0x9b, 0x5c, 0xeb, 0x20, 0x38, 0xdc, 0xb4, 0x02,
-	0x1f, 0x51, 0xdb, 0x73, 0x85, 0x9d, 0xe5, 0xb5, 0x34, 0x9f, 0xda, 0x6d, 0x4c, 0x28, 0x6a, 0x77,
-	0xa4, 0xc0, 0x15, 0x0b, 0x77, 0xb0, 0x6b, 0x61, 0xd7, 0xb4, 0x31, 0xd9, 0x6c, 0x7a, 0x4d, 0x8f,
-	0xd3, 0xf9, 0x2f, 0x29, 0xf2, 0x5a, 0x14, 0x0a, 0x8b, 0xc1, 0xf4, 0xda, 0x6d, 0xcf, 0x65, 0xae,
-	0xb7, 0x31, 0x21, 0xa8, 0x29, 0x3d, 0x5e, 0x7e, 0x23, 0x21, 0x85, 0xdd, 0xa0, 0x4d, 0x98, 0x10,
-	0x45, 0xe4, 0xc8, 0x38, 0x0e, 0x70, 0x10, 0xca, 0xbd, 0x99, 0x90, 0x63, 0x6c, 0xce, 0xed, 0x37,
-	0x78, 0x35, 0x21, 0x78, 0x1c, 0x60, 0xff, 0xb4, 0x5f, 0xe8, 0x7a, 0x56, 0x9a, 0x4d, 0xc7, 0x33,
-	0x8f, 0xfa, 0x65, 0xdf, 0xcc, 0x92, 0x4d, 0x38, 0x2a, 0x05, 0x6f, 0x64, 0x09, 0xb6, 0x6c, 0x42,
-	0xbd, 0x2c, 0x17, 0xea, 0x59, 0xd2, 0x1d, 0xec, 0x13, 0x9b, 0x50, 0xec, 0x8a, 0x5b, 0x66, 0xc6,
-	0x89, 0x94, 0xbf, 0x95, 0x88, 0xeb, 0xc4, 0xf3, 0x8f, 0x0e, 0x1d, 0xef, 0x64, 0x28, 0x24, 0x6a,
-	0xff, 0x51, 0x60, 0x65, 0xcf, 0x73, 0x9c, 0x1f, 0x49, 0x8d, 0x7d, 0x44, 0x8e, 0x1e, 0xb2, 0xd4,
-	0xe9, 0x42, 0x5e, 0xbd, 0x02, 0x65, 0x17, 0xb5, 0x31, 0xe9, 0x20, 0x13, 0x1b, 0xb6, 0x55, 0x55,
-	0xd6, 0x95, 0x8d, 0xa2, 0x5e, 0x8a, 0x68, 0xbb, 0x96, 0x7a, 0x19, 0x8a, 0x1d, 0xcf, 0x71, 0xb0,
-	0xcf, 0xf8, 0x39, 0xce, 0x2f, 0x08, 0xc2, 0xae, 0xa5, 0x7e, 0x0a, 0x65, 0xf6, 0xdb, 0x90, 0xe7,
-	0x57, 0x27, 0xd7, 0x95, 0x8d, 0xd2, 0xd6, 0x7b, 0x51, 0x7c, 0x1c, 0x83, 0x29, 0x7f, 0xeb, 0xdd,
-	0x9b, 0xf5, 0xf3, 0x9c, 0xd2, 0x4b, 0xcc, 0x64, 0xe8, 0xe1, 0x35, 0xa8, 0x1c, 0x7a, 0xfe, 0x09,
-	0xf2, 0x2d, 0x6c, 0x19, 0xc4, 0x0b, 0x7c, 0x13, 0x57, 0xf3, 0xdc, 0x8b, 0xf9, 0x88, 0xfe, 0x88,
-	0x93, 0x6b, 0xbf, 0x2e, 0xc2, 0xea, 0x00, 0xc3, 0x22, 0x2b, 0xea, 0x2a, 0x00, 0x07, 0x17, 0xf5,
-	0x8e, 0xb0, 0xcb, 0x83, 0x2d, 0xeb, 0x45, 0x46, 0xd9, 0x67, 0x04, 0xf5, 0xc7, 0xa0, 0x86, 0xbe,
-	0x1a, 0xf8, 0x33, 0x6c, 0x06, 0xac, 0x2a, 0x78, 0xcc, 0xa5, 0xad, 0x6b, 0xc9, 0x98, 0x04, 0xa4,
-	0x59, 0x28, 0xe1, 0x69, 0x77, 0x43, 0x05, 0x7d, 0xe1, 0x24, 0x4d, 0x52, 0x77, 0x61, 0x36, 0xb2,
-	0x4c, 0x4f, 0x3b, 0x58, 0x26, 0xea, 0xb5, 0x61, 0x46, 0xf7, 0x4f, 0x3b, 0x58, 0x2f, 0x9f, 0xf4,
-	0x3c, 0xa9, 0xef, 0xc0, 0x52, 0xc7, 0xc7, 0x5d, 0xdb, 0x0b, 0x88, 0x41, 0x28, 0xf2, 0x29, 0xb6,
-	0x0c, 0xdc, 0xc5, 0x2e, 0x65, 0xf7, 0xc3, 0x32, 0x33, 0xa9, 0x2f, 0x86, 0x02, 0x8f, 0x04, 0xff,
-	0x2e, 0x63, 0xef, 0x5a, 0xea, 0x06, 0x54, 0xfa, 0x34, 0xa6, 0xb8, 0xc6, 0x1c, 0x49, 0x4a, 0x56,
-	0x61, 0x06, 0x51, 0xe6, 0x1b, 0xad, 0x4e, 0xaf, 0x2b, 0x1b, 0x53, 0x7a, 0xf8, 0xa8, 0xd6, 0x60,
-	0xd6, 0xc5, 0x9f, 0xd1, 0xd8, 0xc0, 0x0c, 0x37, 0x50, 0x62, 0xc4, 0x50, 0xfb, 0x06, 0xa8, 0x07,
-	0xc8, 0x3c, 0x72, 0xbc, 0xa6, 0x61, 0x7a, 0x81, 0x4b, 0x8d, 0x96, 0xed, 0xd2, 0x6a, 0x81, 0x0b,
-	0x56, 0x24, 0xa7, 0xc1, 0x18, 0xf7, 0x6d, 0x97, 0xaa, 0x6f, 0x43, 0x95, 0x50, 0xdb, 0x3c, 0x3a,
-	0x8d, 0x73, 0x6e, 0x60, 0x17, 0x1d, 0x38, 0xd8, 0xaa, 0x16, 0xd7, 0x95, 0x8d, 0x82, 0xbe, 0x28,
-	0xf8, 0x51, 0x3a, 0xef, 0x0a, 0xae, 0xfa, 0x2e, 0x4c, 0xf1, 0x1a, 0xaf, 0x42, 0x56, 0x36, 0x39,
-	0xab, 0x37, 0x99, 0x0f, 0x19, 0x41, 0x17, 0x2a, 0xea, 0x31, 0x5c, 0xa2, 0x3e, 0x72, 0x89, 0xcd,
-	0xc2, 0x88, 0xef, 0x06, 0x91, 0xa3, 0x6a, 0x89, 0x5b, 0x7b, 0xa7, 0x9e, 0xd5, 0x4f, 0x65, 0x49,
-	0x33, 0xb3, 0xfb, 0xa1, 0x7a, 0x2f, 0xde, 0x76, 0xdd, 0x43, 0x4f, 0x7f, 0x95, 0x66, 0xb1, 0xd4,
-	0x26, 0xac, 0xf6, 0xc3, 0xcb, 0x88, 0xbb, 0x5d, 0xb5, 0x9c, 0x15, 0x46, 0xd4, 0xee, 0xf8, 0x99,
-	0x11, 0xa4, 0x97, 0xfb, 0x40, 0x16, 0xf1, 0x58, 0x55, 0x1f, 0xf8, 0xc8, 0x35, 0x5b, 0x12, 0xe8,
-	0x73, 0x1c, 0xe8, 0x25, 0x41, 0x13, 0x50, 0xbf, 0x07, 0x73, 0xc4, 0x6c, 0x61, 0x2b, 0x70, 0xb0,
-	0x65, 0xb0, 0x06, 0x5f, 0x9d, 0xe7, 0x87, 0x2f, 0xd7, 0x45, 0xf7, 0xaf, 0x87, 0xdd, 0xbf, 0xbe,
-	0x1f, 0x76, 0xff, 0x9d, 0xfc, 0x17, 0xff, 0x5c, 0x53, 0xf4, 0xd9, 0x48, 0x8f, 0x71, 0xd4, 0x06,
-	0x94, 0x43, 0x4c, 0x71, 0x33, 0x95, 0x11, 0xcd, 0x94, 0xa4, 0x16, 0x37, 0xe2, 0xc0, 0x0c, 0xbb,
-	0x15, 0x1b, 0x93, 0xea, 0xc2, 0xfa, 0xe4, 0x46, 0x69, 0x4b, 0xaf, 0x8f, 0x36, 0xcc, 0xea, 0xe7,
-	0xd6, 0x7b, 0xfd, 0xa1, 0x30, 0x7a, 0xd7, 0xa5, 0xfe, 0xa9, 0x1e, 0x1e, 0xb1, 0xfc, 0x29, 0x94,
-	0x7b, 0x19, 0x6a, 0x05, 0x26, 0x8f, 0xf0, 0xa9, 0xec, 0x7d, 0xec, 0x27, 0x03, 0x56, 0x17, 0x39,
-	0x01, 0x96, 0xb5, 0x3f, 0x22, 0xb0, 0xb8, 0xca, 0xbb, 0xb9, 0xb7, 0x95, 0x0f, 0xf2, 0x85, 0xd9,
-	0xca, 0x5c, 0xd4, 0x7d, 0xb7, 0x4d, 0x6a, 0x77, 0x6d, 0x7a, 0xfa, 0x8d, 0xea, 0xbe, 0x83, 0x9c,
-	0x1a, 0xbb, 0xfb, 0xfe, 0xa3, 0x20, 0xba, 0x6f, 0x86, 0xe1, 0x97, 0xdd, 0x7d, 0xd7, 0xa0, 0x84,
-	0xa4, 0x57, 0x2c, 0x8d, 0x93, 0x3c, 0x00, 0x08, 0x49, 0xbb, 0x16, 0x6b, 0xcf, 0x91, 0x00, 0x6f,
-	0xcf, 0xf9, 0xf3, 0xdb, 0x73, 0x14, 0x23, 0x6f, 0xcf, 0xa8, 0xe7, 0x49, 0xbd, 0x05, 0x53, 0xb6,
-	0xdb, 0x09, 0x28, 0x6f, 0xac, 0xa5, 0xad, 0xf5, 0x41, 0x26, 0xf6, 0xd0, 0xa9, 0xe3, 0x21, 0x8b,
-	0xe8, 0x42, 0x3c, 0xa3, 0x20, 0xa7, 0xc7, 0x2b, 0xc8, 0xc7, 0xb0, 0x14, 0x12, 0x0c, 0xea, 0x19,
-	0xa6, 0xe3, 0x11, 0xcc, 0x0d, 0x7a, 0x01, 0xe5, 0xcd, 0xba, 0xb4, 0xb5, 0xd4, 0x67, 0xf3, 0x8e,
-	0x5c, 0x01, 0x77, 0xf2, 0xbf, 0x63, 0x26, 0x17, 0x43, 0x0b, 0xfb, 0x5e, 0x83, 0xe9, 0xef, 0x0b,
-	0xf5, 0xbe, 0x62, 0x2f, 0x8c, 0x53, 0xec, 0xfb, 0xb0, 0xc8, 0x1f, 0xfb, 0xbd, 0x2b, 0x8e, 0xe6,
-	0xdd, 0xb7, 0xb8, 0x7a, 0xca, 0xb5, 0x07, 0xb0, 0xd0, 0xc2, 0xc8, 0xa7, 0x07, 0x18, 0xd1, 0xc8,
-	0x20, 0x8c, 0x66, 0xb0, 0x12, 0x69, 0x86, 0xd6, 0x7a, 0xe6, 0x5f, 0x29, 0x39, 0xff, 0x30, 0x68,
-	0x66, 0xe0, 0xfb, 0x6c, 0x6a, 0x48, 0x92, 0x91, 0xba, 0xb7, 0xf2, 0x88, 0x49, 0xb9, 0x2c, 0xed,
-	0x6c, 0x0b, 0x33, 0x8f, 0x12, 0xb7, 0xf8, 0x61, 0x6f, 0x38, 0x16, 0xa6, 0xc8, 0x76, 0x48, 0x75,
-	0x76, 0x44, 0x48, 0xc5, 0xf1, 0xdc, 0x11, 0x9a, 0xfd, 0xfb, 0xc7, 0xdc, 0xd8, 0xfb, 0xc7, 0xb7,
-	0x7b, 0xca, 0x34, 0xea, 0x54, 0x7c, 0x7a, 0x14, 0xe3, 0xda, 0xfb, 0x41, 0xc8, 0x50, 0x6f, 0xc1,
-	0x74, 0x0b, 0x23, 0x0b, 0xfb, 0x72, 0x32, 0x68, 0x83, 0x8e, 0xbc, 0xcf, 0xa5, 0x74, 0x29, 0x5d,
-	0xfb, 0x3c, 0x0f, 0x8b, 0xdb, 0x96, 0xd5, 0xdb, 0xdb, 0x2f, 0xd0, 0x36, 0xef, 0x41, 0xf1, 0x39,
-	0x5a, 0x48, 0xac, 0xab, 0x36, 0x64, 0xcf, 0x12, 0x03, 0x7a, 0xf2, 0x02, 0x03, 0x9a, 0x77, 0x36,
-	0x31, 0x8f, 0x6f, 0x80, 0x1a, 0x63, 0x24, 0xb5, 0xab, 0x55, 0x22, 0x4e, 0xb8, 0x3d, 0xa5, 0x0a,
-	0x58, 0xd6, 0x8a, 0x44, 0xf4, 0xd4, 0x85, 0x0b, 0x98, 0xef, 0x80, 0x21, 0xae, 0xb3, 0xfa, 0xf9,
-	0x74, 0x66, 0x3f, 0x57, 0xdf, 0x87, 0x69, 0x29, 0xc0, 0x9a, 0xc6, 0xdc, 0xd6, 0x46, 0xe6, 0x48,
-	0xe6, 0xef, 0x42, 0x61, 0xe0, 0x42, 0x53, 0x97, 0x7a, 0xea, 0x6d, 0x98, 0xe2, 0xaf, 0x55, 0xb2,
-	0xae, 0xaf, 0x65, 0x1a, 0xe0, 0x12, 0xcc, 0xc0, 0xc7, 0xd8, 0xa4, 0x9e, 0xdf, 0x60, 0x8f, 0xba,
-	0xd0, 0xab, 0x2d, 0xc1, 0xa5, 0x3e, 0x08, 0x88, 0x59, 0x52, 0xfb, 0x95, 0x80, 0x47, 0xef, 0xb0,
-	0x79, 0xf9, 0xf0, 0xc8, 0xbf, 0x48, 0x78, 0x4c, 0x8d, 0x03, 0x8f, 0xe9, 0x17, 0x0f, 0x8f, 0x99,
-	0x61, 0xf0, 0x28, 0xbc, 0x24, 0x78, 0x7c, 0x90, 0x2f, 0x4c, 0x56, 0xf2, 0x12, 0x24, 0x49, 0x20,
-	0x48, 0x90, 0xfc, 0x32, 0x07, 0xaf, 0xf0, 0xdd, 0x2c, 0xbc, 0xc3, 0x0b, 0x40, 0x24, 0x79, 0xb3,
-	0xb9, 0xf1, 0x6e, 0xf6, 0x31, 0xcc, 0xf2, 0x65, 0x31, 0xb5, 0xa1, 0xbd, 0x35, 0x74, 0x43, 0xcb,
-	0xf2, 0x5a, 0x2f, 0x73, 0x5b, 0x63, 0xac, 0x66, 0x7f, 0x51, 0xe0, 0xd5, 0x94, 0x45, 0xb9, 0x92,
-	0x35, 0xa0, 0x1c, 0x3a, 0x48, 0x02, 0x87, 0xf2, 0x44, 0x8c, 0x32, 0x61, 0x4a, 0xd2, 0x15, 0xa6,
-	0xa4, 0x7e, 0x1f, 0xe6, 0x42, 0x23, 0x3f, 0xc3, 0x26, 0xc5, 0xd6, 0x90, 0xb5, 0x59, 0xac, 0xcb,
-	0x52, 0x56, 0x9f, 0x3d, 0xee, 0x7d, 0xac, 0xfd, 0x36, 0x07, 0xeb, 0xc2, 0x3d, 0x8b, 0xcb, 0xb1,
-	0xbc, 0x36, 0xbc, 0x76, 0xc7, 0xc1, 0x4c, 0xf8, 0xff, 0x7c, 0x7f, 0x97, 0x60, 0x86, 0x1b, 0x89,
-	0x96, 0xc6, 0x69, 0xf6, 0xb8, 0x6b, 0xa9, 0x2e, 0x2c, 0x98, 0xa1, 0x53, 0xd1, 0xe5, 0x8a, 0xf2,
-	0xdf, 0x1e, 0x7a, 0xb9, 0xc3, 0xc2, 0xd3, 0x2b, 0x66, 0x8a, 0x52, 0xbb, 0x0a, 0x57, 0xce, 0xd1,
-	0x92, 0x70, 0xff, 0xaf, 0x02, 0x2b, 0x0d, 0xe4, 0x9a, 0xd8, 0xf9, 0x28, 0xa0, 0x84, 0x22, 0xd7,
-	0xb2, 0xdd, 0xe6, 0x5e, 0xcf, 0x36, 0x3f, 0x42, 0xda, 0x1e, 0xc0, 0x7c, 0x9c, 0x36, 0xb1, 0x2a,
-	0xe4, 0x78, 0x7d, 0xa7, 0x72, 0x97, 0x28, 0x6c, 0x9e, 0x2c, 0xbe, 0x2a, 0xcc, 0xd2, 0xde, 0xc7,
-	0x17, 0x33, 0x3d, 0x13, 0xaf, 0x40, 0xf9, 0xe4, 0x2b, 0x50, 0x6d, 0x0d, 0x56, 0x07, 0x84, 0x2c,
-	0x93, 0xf2, 0x07, 0x05, 0xaa, 0x77, 0x30, 0x31, 0x7d, 0xfb, 0x00, 0x8f, 0xf3, 0x02, 0xf6, 0x53,
-	0x28, 0x5b, 0x98, 0x98, 0xd1, 0x25, 0xe7, 0xd2, 0x1f, 0x07, 0x06, 0x5c, 0xf2, 0xa0, 0x33, 0xf5,
-	0x12, 0x33, 0x17, 0xde, 0xeb, 0x5f, 0x15, 0x58, 0xca, 0x90, 0x94, 0xd5, 0x79, 0x1b, 0x66, 0x44,
-	0xa0, 0xa4, 0xaa, 0xf0, 0xd7, 0xe2, 0xd7, 0xcf, 0xc9, 0xdd, 0x9e, 0x48, 0x89, 0x7b, 0xe8, 0xe9,
-	0xa1, 0x96, 0xfa, 0x31, 0x2c, 0xf4, 0xdc, 0x26, 0xa1, 0x88, 0x06, 0x44, 0x46, 0x70, 0x7d, 0x94,
-	0x6b, 0x78, 0xc4, 0x35, 0xf4, 0x79, 0x9a, 0x24, 0xd4, 0xfe, 0xa4, 0x80, 0xf6, 0xc0, 0x26, 0x34,
-	0x12, 0xdc, 0x43, 0x3e, 0xb5, 0xd9, 0x80, 0x21, 0x61, 0x6a, 0x57, 0xa0, 0x18, 0x6f, 0x87, 0x22,
-	0xaf, 0x31, 0xa1, 0x2f, 0xf1, 0x93, 0x5f, 0x4f, 0x01, 0xd7, 0x7e, 0x9f, 0x83, 0xb5, 0x81, 0x8e,
-	0xca, 0x2c, 0xff, 0x1c, 0xb4, 0xf8, 0xe5, 0x2f, 0xce, 0x56, 0x27, 0x92, 0x94, 0xc9, 0x7f, 0x6b,
-	0x94, 0xc3, 0x23, 0xfb, 0x1f, 0x62, 0x8a, 0x2c, 0x44, 0x91, 0x7e, 0x19, 0xa5, 0x5f, 0x88, 0x63,
-	0x1f, 0xd8, 0xd9, 0x89, 0x6f, 0x4f, 0xfd, 0x67, 0xe7, 0x9e, 0xeb, 0xec, 0x93, 0xf4, 0xa7, 0x91,
-	0xf8, 0x6c, 0x76, 0x89, 0xb5, 0x1f, 0x76, 0x2c, 0x44, 0x31, 0x1b, 0x0b, 0xd8, 0xdf, 0x09, 0x6c,
-	0xc7, 0xda, 0xb5, 0x3e, 0xf2, 0x2d, 0xec, 0xdb, 0x6e, 0xf3, 0x02, 0x35, 0xf2, 0x09, 0xcc, 0x24,
-	0xcb, 0xa3, 0x31, 0xb4, 0x3c, 0x86, 0x1f, 0xac, 0x87, 0x36, 0x6b, 0xaf, 0xc3, 0xd5, 0x73, 0xc5,
-	0x65, 0xa5, 0xff, 0x51, 0x81, 0xb5, 0x7b, 0x98, 0x3e, 0x6f, 0x30, 0x8f, 0xd3, 0xc1, 0xbc, 0x3f,
-	0x34, 0x98, 0x21, 0xa7, 0xc6, 0x91, 0x7c, 0xae, 0xc0, 0xfa, 0x60, 0x61, 0x89, 0xc7, 0x4f, 0xa0,
-	0x10, 0x7e, 0xc6, 0x97, 0xf3, 0x78, 0xfb, 0x39, 0x3c, 0x10, 0x86, 0xf4, 0xc8, 0x64, 0xed, 0x37,
-	0x39, 0xa8, 0xed, 0xba, 0x5d, 0xe4, 0xd8, 0x2c, 0xa5, 0x11, 0x30, 0x22, 0xcc, 0x8c, 0x9e, 0xa9,
-	0xd5, 0xbe, 0x0a, 0x2d, 0xf6, 0xf6, 0xed, 0x8c, 0x51, 0x32, 0x39, 0xfe, 0x28, 0xf9, 0x09, 0xcc,
-	0x77, 0xb1, 0x4f, 0x6c, 0xcf, 0xb5, 0xdd, 0xa6, 0xc1, 0x3c, 0x95, 0xf3, 0x76, 0x2b, 0x73, 0x6f,
-	0xec, 0xf9, 0x33, 0x45, 0x6c, 0x8f, 0xa1, 0xea, 0x1d, 0x16, 0xe3, 0x5c, 0x37, 0xf1, 0xcc, 0x10,
-	0x76, 0x6e, 0x4a, 0x62, 0x84, 0x5d, 0xbe, 0x87, 0xe9, 0xd7, 0x98, 0xb3, 0xdb, 0xb0, 0x72, 0x82,
-	0x5c, 0x6a, 0xa4, 0x42, 0x35, 0xcc, 0xc0, 0x6f, 0x21, 0xd2, 0xe2, 0x09, 0x2c, 0xeb, 0x4b, 0x4c,
-	0x26, 0x19, 0x52, 0x43, 0x08, 0xd4, 0xfe, 0xa6, 0xc0, 0x4a, 0xb6, 0x8b, 0x11, 0xba, 0xfa, 0xf2,
-	0xa8, 0x8c, 0x9b, 0xc7, 0xfb, 0x13, 0xe9, 0x4c, 0xaa, 0xd7, 0xa1, 0xc2, 0xbf, 0xd2, 0x8a, 0xb5,
-	0xc8, 0xe0, 0x4e, 0xb3, 0x28, 0x0b, 0x4c, 0x56, 0x72, 0x74, 0x7c, 0x7c, 0x1f, 0x91, 0xd6, 0xce,
-	0x22, 0xbc, 0x92, 0x8e, 0x93, 0xa1, 0x74, 0xc7, 0x7f, 0xf2, 0x54, 0x9b, 0xf8, 0xf2, 0xa9, 0x36,
-	0xf1, 0xd5, 0x53, 0x4d, 0xf9, 0xc5, 0x99, 0xa6, 0xfc, 0xf9, 0x4c, 0x53, 0xfe, 0x7e, 0xa6, 0x29,
-	0x4f, 0xce, 0x34, 0xe5, 0x5f, 0x67, 0x9a, 0xf2, 0xef, 0x33, 0x6d, 0xe2, 0xab, 0x33, 0x4d, 0xf9,
-	0xe2, 0x99, 0x36, 0xf1, 0xe4, 0x99, 0x36, 0xf1, 0xe5, 0x33, 0x6d, 0xe2, 0xf1, 0xf7, 0x9a, 0x5e,
-	0x1c, 0x81, 0xed, 0x9d, 0xff, 0x1f, 0xea, 0x77, 0x53, 0xa4, 0x83, 0x69, 0xfe, 0xaa, 0xf4, 0x9d,
-	0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x74, 0x6f, 0xf1, 0x84, 0x1d, 0x00, 0x00,
+	// 2171 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x92, 0x94, 0x44, 0x3e, 0x52, 0x12, 0xb5, 0xb6, 0xe5, 0x95, 0x2c, 0x51, 0x32, 0x9d,
+	0x0f, 0xc5, 0x48, 0xa9, 0x9a, 0x45, 0x8c, 0x24, 0x6d, 0xe0, 0x58, 0xb4, 0x61, 0x2b, 0x71, 0x1a,
+	0x79, 0xa5, 0x26, 0x85, 0xdb, 0x60, 0x33, 0xda, 0x1d, 0x51, 0x5b, 0x2d, 0x77, 0xa9, 0x9d, 0x59,
+	0x2a, 0xea, 0xa9, 0x3d, 0xf4, 0xd0, 0x43, 0x81, 0x14, 0xbd, 0xb4, 0xb7, 0xa2, 0x05, 0x8a, 0xf6,
+	0xbf, 0xe8, 0xa9, 0xe8, 0xa1, 0x40, 0x7d, 0xcc, 0xad, 0xb5, 0x7c, 0x29, 0xd0, 0x4b, 0xfa, 0x1f,
+	0x14, 0x33, 0x3b, 0xfb, 0xc9, 0xa5, 0x48, 0xd1, 0x4e, 0xdd, 0xdc, 0x38, 0xf3, 0x3e, 0xe6, 0x7d,
+	0xfc, 0xe6, 0xbd, 0x37, 0x4b, 0x78, 0x87, 0xe2, 0x4e, 0xd7, 0x71, 0x91, 0xb5, 0x41, 0xb0, 0xdb,
+	0xc3, 0xee, 0x06, 0xea, 0x9a, 0x1b, 0x1d, 0x44, 0xf5, 0x03, 0xd3, 0x6e, 0xb3, 0x2d, 0x53, 0xc7,
+	0x1b, 0xbd, 0x1b, 0x1b, 0x2e, 0x3e, 0xf2, 0x30, 0xa1, 0x9a, 0x8b, 0x49, 0xd7, 0xb1, 0x09, 0x6e,
+	0x74, 0x5d, 0x87, 0x3a, 0xf2, 0x2b, 0x81, 0x78, 0xc3, 0x17, 0x6f, 0xa0, 0xae, 0xd9, 0x48, 0x89,
+	0x37, 0x7a, 0x37, 0x96, 0x6a, 0x6d, 0xc7, 0x69, 0x5b, 0x78, 0x83, 0x4b, 0xed, 0x79, 0xfb, 0x1b,
+	0x86, 0xe7, 0x22, 0x6a, 0x3a, 0xb6, 0xaf, 0x67, 0x69, 0x35, 0x4d, 0xa7, 0x66, 0x07, 0x13, 0x8a,
+	0x3a, 0x5d, 0xc1, 0x70, 0xd5, 0xc0, 0x5d, 0x6c, 0x1b, 0xd8, 0xd6, 0x4d, 0x4c, 0x36, 0xda, 0x4e,
+	0xdb, 0xe1, 0xfb, 0xfc, 0x97, 0x60, 0x79, 0x29, 0x74, 0x85, 0xf9, 0xa0, 0x3b, 0x9d, 0x8e, 0x63,
+	0x33, 0xd3, 0x3b, 0x98, 0x10, 0xd4, 0x16, 0x16, 0x2f, 0xbd, 0x92, 0xe0, 0xc2, 0xb6, 0xd7, 0x21,
+	0x8c, 0x89, 0x22, 0x72, 0xa8, 0x1d, 0x79, 0xd8, 0x0b, 0xf8, 0x5e, 0x4d, 0xf0, 0x31, 0x32, 0xa7,
+	0xf6, 0x2b, 0xbc, 0x96, 0x60, 0x3c, 0xf2, 0xb0, 0x7b, 0xd2, 0xcf, 0x74, 0x3d, 0x2b, 0xcc, 0xba,
+	0xe5, 0xe8, 0x87, 0xfd, 0xbc, 0xaf, 0x66, 0xf1, 0x26, 0x0c, 0x15, 0x8c, 0xaf, 0x67, 0x31, 0x1e,
+	0x98, 0x84, 0x3a, 0x59, 0x26, 0x34, 0xb2, 0xb8, 0xbb, 0xd8, 0x25, 0x26, 0xa1, 0xd8, 0xd6, 0x71,
+	0xa0, 0x9c, 0x08, 0xfe, 0x9b, 0x09, 0xbf, 0x8e, 0x1d, 0xf7, 0x70, 0xdf, 0x72, 0x8e, 0x87, 0x42,
+	0x22, 0xfb, 0x9c, 0xc1, 0xf1, 0xab, 0xff, 0x5b, 0x82, 0xe5, 0x6d, 0xc7, 0xb2, 0x3e, 0x16, 0x27,
+	0xec, 0x22, 0x72, 0xf8, 0x90, 0xb1, 0xaa, 0xbe, 0x7e, 0xf9, 0x2a, 0x54, 0x6c, 0xd4, 0xc1, 0xa4,
+	0x8b, 0x74, 0xac, 0x99, 0x86, 0x22, 0xad, 0x49, 0xeb, 0x25, 0xb5, 0x1c, 0xee, 0x6d, 0x19, 0xf2,
+	0x15, 0x28, 0x75, 0x1d, 0xcb, 0xc2, 0x2e, 0xa3, 0xe7, 0x38, 0xbd, 0xe8, 0x6f, 0x6c, 0x19, 0xf2,
+	0xa7, 0x50, 0x61, 0xbf, 0x35, 0x61, 0xaf, 0x92, 0x5f, 0x93, 0xd6, 0xcb, 0xcd, 0x77, 0x42, 0x3b,
+	0x39, 0x66, 0x53, 0xfe, 0x35, 0x7a, 0x37, 0x1a, 0x67, 0x19, 0xa5, 0x96, 0x99, 0xca, 0xc0, 0xc2,
+	0xd7, 0xa0, 0xba, 0xef, 0xb8, 0xc7, 0xc8, 0x35, 0xb0, 0xa1, 0x11, 0xc7, 0x73, 0x75, 0xac, 0x14,
+	0xb8, 0x15, 0x73, 0xe1, 0xfe, 0x0e, 0xdf, 0xae, 0xff, 0xa2, 0x04, 0x2b, 0x03, 0x14, 0xfb, 0x51,
+	0x94, 0x57, 0x00, 0x38, 0x18, 0xa9, 0x73, 0x88, 0x6d, 0xee, 0x6c, 0x45, 0x2d, 0xb1, 0x9d, 0x5d,
+	0xb6, 0x21, 0x7f, 0x1f, 0xe4, 0xc0, 0x56, 0x0d, 0x7f, 0x86, 0x75, 0x8f, 0xdd, 0x22, 0xee, 0x73,
+	0xb9, 0xf9, 0x5a, 0xd2, 0x27, 0xff, 0x0a, 0x30, 0x57, 0x82, 0xd3, 0xee, 0x06, 0x02, 0xea, 0xfc,
+	0x71, 0x7a, 0x4b, 0xde, 0x82, 0x99, 0x50, 0x33, 0x3d, 0xe9, 0x62, 0x11, 0xa8, 0x97, 0x86, 0x29,
+	0xdd, 0x3d, 0xe9, 0x62, 0xb5, 0x72, 0x1c, 0x5b, 0xc9, 0x6f, 0xc1, 0x62, 0xd7, 0xc5, 0x3d, 0xd3,
+	0xf1, 0x88, 0x46, 0x28, 0x72, 0x29, 0x36, 0x34, 0xdc, 0xc3, 0x36, 0x65, 0xf9, 0x61, 0x91, 0xc9,
+	0xab, 0x0b, 0x01, 0xc3, 0x8e, 0x4f, 0xbf, 0xcb, 0xc8, 0x5b, 0x86, 0xbc, 0x0e, 0xd5, 0x3e, 0x89,
+	0x49, 0x2e, 0x31, 0x4b, 0x92, 0x9c, 0x0a, 0x4c, 0x23, 0xca, 0x6c, 0xa3, 0xca, 0xd4, 0x9a, 0xb4,
+	0x3e, 0xa9, 0x06, 0x4b, 0xb9, 0x0e, 0x33, 0x36, 0xfe, 0x8c, 0x46, 0x0a, 0xa6, 0xb9, 0x82, 0x32,
+	0xdb, 0x0c, 0xa4, 0x5f, 0x07, 0x79, 0x0f, 0xe9, 0x87, 0x96, 0xd3, 0xd6, 0x74, 0xc7, 0xb3, 0xa9,
+	0x76, 0x60, 0xda, 0x54, 0x29, 0x72, 0xc6, 0xaa, 0xa0, 0xb4, 0x18, 0xe1, 0xbe, 0x69, 0x53, 0xf9,
+	0x4d, 0x50, 0x08, 0x35, 0xf5, 0xc3, 0x93, 0x28, 0xe6, 0x1a, 0xb6, 0xd1, 0x9e, 0x85, 0x0d, 0xa5,
+	0xb4, 0x26, 0xad, 0x17, 0xd5, 0x05, 0x9f, 0x1e, 0x86, 0xf3, 0xae, 0x4f, 0x95, 0xdf, 0x86, 0x49,
+	0x5e, 0x13, 0x14, 0xc8, 0x8a, 0x26, 0x27, 0xc5, 0x83, 0xf9, 0x90, 0x6d, 0xa8, 0xbe, 0x88, 0x7c,
+	0x04, 0x97, 0xa9, 0x8b, 0x6c, 0x62, 0x32, 0x37, 0xa2, 0xdc, 0x20, 0x72, 0xa8, 0x94, 0xb9, 0xb6,
+	0xb7, 0x1a, 0x59, 0xf5, 0x57, 0x94, 0x00, 0xa6, 0x76, 0x37, 0x10, 0x8f, 0xe3, 0x6d, 0xcb, 0xde,
+	0x77, 0xd4, 0x4b, 0x34, 0x8b, 0x24, 0xb7, 0x61, 0xa5, 0x1f, 0x5e, 0x5a, 0x54, 0x1d, 0x95, 0x4a,
+	0x96, 0x1b, 0xe1, 0xf5, 0xe6, 0x67, 0x86, 0x90, 0x5e, 0xea, 0x03, 0x59, 0x48, 0x63, 0xb7, 0x7a,
+	0xcf, 0x45, 0xb6, 0x7e, 0x20, 0x80, 0x3e, 0xcb, 0x81, 0x5e, 0xf6, 0xf7, 0x7c, 0xa8, 0xdf, 0x83,
+	0x59, 0xa2, 0x1f, 0x60, 0xc3, 0xb3, 0xb0, 0xa1, 0xb1, 0x86, 0xa0, 0xcc, 0xf1, 0xc3, 0x97, 0x1a,
+	0x7e, 0xb7, 0x68, 0x04, 0xdd, 0xa2, 0xb1, 0x1b, 0x74, 0x8b, 0xcd, 0xc2, 0xe7, 0xff, 0x58, 0x95,
+	0xd4, 0x99, 0x50, 0x8e, 0x51, 0xe4, 0x16, 0x54, 0x02, 0x4c, 0x71, 0x35, 0xd5, 0x11, 0xd5, 0x94,
+	0x85, 0x14, 0x57, 0x62, 0xc1, 0x34, 0xcb, 0x8a, 0x89, 0x89, 0x32, 0xbf, 0x96, 0x5f, 0x2f, 0x37,
+	0xd5, 0xc6, 0x68, 0xcd, 0xaf, 0x71, 0xe6, 0x7d, 0x6f, 0x3c, 0xf4, 0x95, 0xde, 0xb5, 0xa9, 0x7b,
+	0xa2, 0x06, 0x47, 0x2c, 0x7d, 0x0a, 0x95, 0x38, 0x41, 0xae, 0x42, 0xfe, 0x10, 0x9f, 0x88, 0xda,
+	0xc7, 0x7e, 0x32, 0x60, 0xf5, 0x90, 0xe5, 0x61, 0x25, 0x97, 0x95, 0x91, 0x41, 0xc0, 0xe2, 0x22,
+	0x6f, 0xe7, 0xde, 0x94, 0xde, 0x2b, 0x14, 0x67, 0xaa, 0xb3, 0x61, 0xf5, 0xbd, 0xad, 0x53, 0xb3,
+	0x67, 0xd2, 0x93, 0xff, 0xab, 0xea, 0x3b, 0xc8, 0xa8, 0xb1, 0xab, 0xef, 0xdf, 0x8a, 0xb0, 0x32,
+	0x40, 0xf1, 0x8b, 0xae, 0xbe, 0xab, 0x50, 0x46, 0xc2, 0x2a, 0x16, 0xc6, 0x3c, 0x77, 0x00, 0x82,
+	0xad, 0x2d, 0x83, 0x95, 0xe7, 0x90, 0x81, 0x97, 0xe7, 0xc2, 0xd9, 0xe5, 0x39, 0xf4, 0x91, 0x97,
+	0x67, 0x14, 0x5b, 0xc9, 0x37, 0x61, 0xd2, 0xb4, 0xbb, 0x1e, 0xe5, 0x85, 0xb5, 0xdc, 0x5c, 0x1b,
+	0xa4, 0x62, 0x1b, 0x9d, 0x58, 0x0e, 0x32, 0x88, 0xea, 0xb3, 0x67, 0x5c, 0xc8, 0xa9, 0xf1, 0x2e,
+	0xe4, 0x23, 0x58, 0x0c, 0x36, 0x34, 0xea, 0x68, 0xba, 0xe5, 0x10, 0xcc, 0x15, 0x3a, 0x1e, 0xe5,
+	0xc5, 0xba, 0xdc, 0x5c, 0xec, 0xd3, 0x79, 0x47, 0x8c, 0x8c, 0x9b, 0x85, 0x5f, 0x33, 0x95, 0x0b,
+	0x81, 0x86, 0x5d, 0xa7, 0xc5, 0xe4, 0x77, 0x7d, 0xf1, 0xbe, 0xcb, 0x5e, 0x1c, 0xe7, 0xb2, 0xef,
+	0xc2, 0x02, 0x5f, 0xf6, 0x5b, 0x57, 0x1a, 0xcd, 0xba, 0x0b, 0x5c, 0x3c, 0x65, 0xda, 0x03, 0x98,
+	0x3f, 0xc0, 0xc8, 0xa5, 0x7b, 0x18, 0xd1, 0x50, 0x21, 0x8c, 0xa6, 0xb0, 0x1a, 0x4a, 0x06, 0xda,
+	0x62, 0xfd, 0xaf, 0x9c, 0xec, 0x7f, 0x18, 0x6a, 0xba, 0xe7, 0xba, 0xac, 0x6b, 0x88, 0x2d, 0x2d,
+	0x95, 0xb7, 0xca, 0x88, 0x41, 0xb9, 0x22, 0xf4, 0xdc, 0xf6, 0xd5, 0xec, 0x24, 0xb2, 0xf8, 0x41,
+	0xdc, 0x1d, 0x03, 0x53, 0x64, 0x5a, 0x44, 0x99, 0x19, 0x11, 0x52, 0x91, 0x3f, 0x77, 0x7c, 0xc9,
+	0xfe, 0xf9, 0x63, 0x76, 0xec, 0xf9, 0xe3, 0x1b, 0xb1, 0x6b, 0x1a, 0x56, 0x2a, 0xde, 0x3d, 0x4a,
+	0xd1, 0xdd, 0xfb, 0x6e, 0x40, 0x90, 0x6f, 0xc2, 0xd4, 0x01, 0x46, 0x06, 0x76, 0x45, 0x67, 0xa8,
+	0x0d, 0x3a, 0xf2, 0x3e, 0xe7, 0x52, 0x05, 0x77, 0xfd, 0xcf, 0x05, 0x58, 0xb8, 0x6d, 0x18, 0xf1,
+	0xda, 0x7e, 0x8e, 0xb2, 0x79, 0x0f, 0x4a, 0xcf, 0x50, 0x42, 0x22, 0x59, 0xb9, 0x25, 0x6a, 0x96,
+	0xdf, 0xa0, 0xf3, 0xe7, 0x68, 0xd0, 0x25, 0x1a, 0xfc, 0x64, 0xf3, 0x50, 0x84, 0x91, 0xd4, 0xac,
+	0x56, 0x0d, 0x29, 0xc1, 0xf4, 0x94, 0xba, 0xc0, 0xe2, 0xae, 0x08, 0x44, 0x4f, 0x9e, 0xfb, 0x02,
+	0xf3, 0x19, 0x30, 0xc0, 0x75, 0x56, 0x3d, 0x9f, 0xca, 0xac, 0xe7, 0xf2, 0xbb, 0x30, 0x25, 0x18,
+	0x58, 0xd1, 0x98, 0x6d, 0xae, 0x67, 0xb6, 0x64, 0xfe, 0x76, 0x0a, 0x1c, 0xf7, 0x25, 0x55, 0x21,
+	0x27, 0xdf, 0x82, 0x49, 0xfe, 0x0c, 0x53, 0x4a, 0xe9, 0x04, 0xc4, 0x14, 0x70, 0x0e, 0xa6, 0xe0,
+	0x23, 0xac, 0x53, 0xc7, 0x6d, 0xb1, 0xa5, 0xea, 0xcb, 0xc9, 0x4b, 0x50, 0xec, 0xba, 0xa6, 0xe3,
+	0x9a, 0xd4, 0x1f, 0xf1, 0x26, 0xd5, 0x70, 0xcd, 0x40, 0xb0, 0x8f, 0x4c, 0xd7, 0xc6, 0x84, 0x68,
+	0xac, 0x7b, 0x97, 0x7d, 0x10, 0x04, 0x7b, 0xef, 0xe3, 0x93, 0xfa, 0x22, 0x5c, 0xee, 0x43, 0x90,
+	0xdf, 0x8a, 0xea, 0x7f, 0xf1, 0xd1, 0x15, 0xef, 0x55, 0x2f, 0x1e, 0x5d, 0x85, 0xe7, 0x89, 0xae,
+	0xc9, 0x71, 0xd0, 0x35, 0xf5, 0xfc, 0xd1, 0x35, 0x3d, 0x0c, 0x5d, 0xc5, 0xaf, 0x27, 0xba, 0xde,
+	0x2b, 0x14, 0xf3, 0xd5, 0x82, 0xc0, 0x58, 0x12, 0x47, 0x02, 0x63, 0x3f, 0xcb, 0xc1, 0x45, 0x3e,
+	0x19, 0x06, 0x10, 0x38, 0x07, 0xc2, 0x92, 0xc0, 0xc8, 0x8d, 0x07, 0x8c, 0x47, 0x30, 0xc3, 0x47,
+	0xd5, 0xd4, 0x7c, 0xf8, 0xc6, 0xd0, 0xf9, 0x30, 0xcb, 0x6a, 0xb5, 0xc2, 0x75, 0x8d, 0x31, 0x18,
+	0xfe, 0x49, 0x82, 0x4b, 0x29, 0x8d, 0x62, 0x20, 0x6c, 0x41, 0x25, 0x30, 0x90, 0x78, 0x16, 0x55,
+	0xa4, 0x11, 0xfb, 0x5b, 0x59, 0x98, 0xc2, 0x84, 0xe4, 0xf7, 0x61, 0x36, 0x50, 0xf2, 0x23, 0xac,
+	0x53, 0x6c, 0x0c, 0x19, 0xda, 0xfd, 0x61, 0x5d, 0xf0, 0xaa, 0x33, 0x47, 0xf1, 0x65, 0xfd, 0x57,
+	0x39, 0x58, 0xf3, 0xcd, 0x33, 0x38, 0x1f, 0x8b, 0x6b, 0xcb, 0xe9, 0x74, 0x2d, 0xcc, 0x98, 0xff,
+	0xc7, 0xf9, 0xbb, 0x0c, 0xd3, 0x5c, 0x49, 0x38, 0xb2, 0x4e, 0xb1, 0xe5, 0x96, 0x21, 0xdb, 0x30,
+	0xaf, 0x07, 0x46, 0x85, 0xc9, 0xf5, 0xab, 0xc7, 0xed, 0xa1, 0xc9, 0x1d, 0xe6, 0x9e, 0x5a, 0xd5,
+	0x53, 0x3b, 0xf5, 0x6b, 0x70, 0xf5, 0x0c, 0x29, 0x01, 0xf7, 0xff, 0x48, 0xb0, 0xdc, 0x42, 0xb6,
+	0x8e, 0xad, 0x0f, 0x3d, 0x4a, 0x28, 0xb2, 0x0d, 0xd3, 0x6e, 0x6f, 0xc7, 0xde, 0x12, 0x23, 0x84,
+	0xed, 0x01, 0xcc, 0x45, 0x61, 0xf3, 0x07, 0x95, 0x1c, 0x2f, 0x0f, 0xa9, 0xd8, 0x25, 0xea, 0x02,
+	0x0f, 0x16, 0x1f, 0x54, 0x66, 0x68, 0x7c, 0xf9, 0x7c, 0x7a, 0x77, 0xe2, 0x01, 0x56, 0x48, 0x3e,
+	0xc0, 0xea, 0xab, 0xb0, 0x32, 0xc0, 0x65, 0x11, 0x94, 0xbf, 0x4b, 0xa0, 0xdc, 0xc1, 0x44, 0x77,
+	0xcd, 0x3d, 0x3c, 0xce, 0xf3, 0xef, 0x87, 0x50, 0x31, 0x30, 0xd1, 0xc3, 0x24, 0xe7, 0xd2, 0x9f,
+	0x26, 0x06, 0x24, 0x79, 0xd0, 0x99, 0x6a, 0x99, 0xa9, 0x0b, 0x0c, 0x68, 0xc2, 0x25, 0xd3, 0xd6,
+	0x2d, 0xcf, 0xc0, 0x5a, 0xf0, 0xbd, 0x86, 0x50, 0x44, 0x09, 0x8f, 0x55, 0x51, 0xbd, 0x20, 0x88,
+	0x9b, 0x3e, 0x6d, 0x87, 0x91, 0xea, 0x3f, 0xcf, 0xc1, 0x62, 0x86, 0x76, 0x71, 0xa3, 0x6f, 0xc1,
+	0xb4, 0x1f, 0x1c, 0xa2, 0x48, 0xfc, 0x21, 0xff, 0xf2, 0x19, 0xf1, 0xde, 0xf6, 0xc3, 0xc8, 0xbe,
+	0x98, 0x04, 0x52, 0xf2, 0x47, 0x30, 0x1f, 0x43, 0x00, 0xb3, 0xc6, 0x23, 0xc2, 0xeb, 0xeb, 0xa3,
+	0xa4, 0x6e, 0x87, 0x4b, 0xa8, 0x73, 0x34, 0xb9, 0x21, 0xef, 0xc0, 0x4c, 0xbf, 0x8b, 0xe5, 0x66,
+	0x23, 0xb3, 0x6b, 0x24, 0x54, 0xc7, 0xbd, 0x57, 0x2b, 0x7b, 0xf1, 0x58, 0xfc, 0x41, 0x82, 0xda,
+	0x03, 0x93, 0xd0, 0xf0, 0xf4, 0x6d, 0xe4, 0x52, 0x93, 0x35, 0x4a, 0x12, 0x84, 0x78, 0x19, 0x4a,
+	0xd1, 0x90, 0xec, 0x27, 0x38, 0xda, 0xe8, 0x43, 0x40, 0xfe, 0xab, 0xa9, 0x24, 0xf5, 0xdf, 0xe4,
+	0x60, 0x75, 0xa0, 0xa1, 0x22, 0x75, 0x3f, 0x86, 0x5a, 0xf4, 0x06, 0x8e, 0x52, 0xd0, 0x0d, 0x39,
+	0x45, 0x46, 0xdf, 0x18, 0xe5, 0xf0, 0x50, 0xff, 0x07, 0x98, 0x22, 0x03, 0x51, 0xa4, 0x5e, 0x41,
+	0xe9, 0xef, 0x02, 0x91, 0x0d, 0xec, 0xec, 0xc4, 0x27, 0xb8, 0xfe, 0xb3, 0x73, 0xcf, 0x74, 0xf6,
+	0x71, 0xfa, 0x0b, 0x51, 0x74, 0x36, 0x4b, 0x62, 0xfd, 0x7b, 0x5d, 0x03, 0x51, 0xcc, 0xfa, 0x13,
+	0x76, 0x37, 0x3d, 0xd3, 0x32, 0xb6, 0x8c, 0x0f, 0x5d, 0x03, 0xbb, 0xa6, 0xdd, 0x3e, 0xc7, 0x65,
+	0xfd, 0x04, 0xa6, 0x93, 0xf7, 0xb4, 0x35, 0xf4, 0x9e, 0x0e, 0x3f, 0x58, 0x0d, 0x74, 0xd6, 0x5f,
+	0x86, 0x6b, 0x67, 0xb2, 0x8b, 0x92, 0xf3, 0x5b, 0x09, 0x56, 0xef, 0x61, 0xfa, 0xac, 0xce, 0x3c,
+	0x4a, 0x3b, 0xf3, 0xee, 0x50, 0x67, 0x86, 0x9c, 0x1a, 0x79, 0xf2, 0x53, 0x09, 0xd6, 0x06, 0x33,
+	0x0b, 0x3c, 0x7e, 0x02, 0xc5, 0xe0, 0xdf, 0x0f, 0x45, 0x1a, 0xb1, 0xb7, 0x0d, 0x53, 0xaa, 0x86,
+	0x2a, 0xeb, 0xbf, 0xcc, 0x41, 0x7d, 0xcb, 0xee, 0x21, 0xcb, 0x64, 0x21, 0x0d, 0x81, 0x11, 0x62,
+	0x66, 0xf4, 0x48, 0xad, 0xf4, 0xdd, 0xd0, 0x52, 0xbc, 0x81, 0x64, 0xf4, 0xb4, 0xfc, 0xf8, 0x3d,
+	0xed, 0x07, 0x30, 0xd7, 0xc3, 0x2e, 0x31, 0x1d, 0xdb, 0xb4, 0xdb, 0x1a, 0xb3, 0x54, 0x34, 0xfe,
+	0x66, 0x66, 0x25, 0x8b, 0xfd, 0x07, 0xe5, 0x4f, 0xc1, 0x81, 0xe8, 0x1d, 0xe6, 0xe3, 0x6c, 0x2f,
+	0xb1, 0x66, 0x08, 0x3b, 0x33, 0x24, 0x22, 0x74, 0xbf, 0xcb, 0xc1, 0x95, 0x7b, 0x98, 0x7e, 0x85,
+	0x31, 0xbb, 0x05, 0xcb, 0xc7, 0xc8, 0xa6, 0x5a, 0xca, 0x55, 0x4d, 0xf7, 0xdc, 0x03, 0x44, 0x0e,
+	0x78, 0x00, 0x2b, 0xea, 0x22, 0xe3, 0x49, 0xba, 0xd4, 0xf2, 0x19, 0xb2, 0x82, 0x5e, 0x18, 0x3f,
+	0xe8, 0x4d, 0xb8, 0xc4, 0xcd, 0x09, 0xeb, 0x91, 0xff, 0xb7, 0x06, 0xe1, 0x8f, 0xac, 0xa2, 0x7a,
+	0x81, 0x11, 0xc3, 0x8a, 0xc2, 0xff, 0xd8, 0x20, 0xf5, 0xdf, 0xe7, 0x60, 0x39, 0x3b, 0x48, 0x21,
+	0xbe, 0xfb, 0x32, 0x29, 0x8d, 0x9b, 0xc9, 0xfb, 0x13, 0xe9, 0x5c, 0xca, 0xd7, 0xa1, 0xca, 0x3f,
+	0x97, 0xfb, 0x13, 0xa2, 0xc6, 0xc3, 0xc6, 0xe2, 0x5c, 0x64, 0xbc, 0x82, 0xa2, 0xe2, 0xa3, 0xfb,
+	0x2c, 0x5a, 0xdf, 0x84, 0x8b, 0xb6, 0xd7, 0xd1, 0x8e, 0x5d, 0x93, 0x26, 0x8a, 0x6e, 0x9e, 0xbf,
+	0x8a, 0x64, 0xdb, 0xeb, 0x7c, 0xcc, 0x48, 0xb1, 0x82, 0xdd, 0x80, 0x0b, 0x4c, 0xc2, 0xc5, 0xc8,
+	0x88, 0x0b, 0x14, 0xb8, 0xc0, 0xbc, 0xed, 0x75, 0x54, 0x8c, 0x8c, 0x88, 0x7f, 0x73, 0x01, 0x2e,
+	0xa6, 0x73, 0xc9, 0x6e, 0xe2, 0xa6, 0xfb, 0xf8, 0x49, 0x6d, 0xe2, 0x8b, 0x27, 0xb5, 0x89, 0x2f,
+	0x9f, 0xd4, 0xa4, 0x9f, 0x9c, 0xd6, 0xa4, 0x3f, 0x9e, 0xd6, 0xa4, 0xbf, 0x9e, 0xd6, 0xa4, 0xc7,
+	0xa7, 0x35, 0xe9, 0x9f, 0xa7, 0x35, 0xe9, 0x5f, 0xa7, 0xb5, 0x89, 0x2f, 0x4f, 0x6b, 0xd2, 0xe7,
+	0x4f, 0x6b, 0x13, 0x8f, 0x9f, 0xd6, 0x26, 0xbe, 0x78, 0x5a, 0x9b, 0x78, 0xf4, 0x9d, 0xb6, 0x13,
+	0xc5, 0xc8, 0x74, 0xce, 0xfe, 0x7b, 0xfd, 0xdb, 0xa9, 0xad, 0xbd, 0x29, 0xfe, 0xac, 0xfd, 0xd6,
+	0x7f, 0x07, 0x00, 0x92, 0x16, 0xb9, 0x8c, 0x9f, 0x1f, 0x00, 0x00,
 }
 
 func (this *PollWorkflowTaskQueueRequest) Equal(that interface{}) bool {
@@ -2237,6 +2338,12 @@ func (this *AddWorkflowTaskRequest) Equal(that interface{}) bool {
 	if !this.Clock.Equal(that1.Clock) {
 		return false
 	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if this.FairnessKey != that1.FairnessKey {
+		return false
+	}
 	return true
 }
 func (this *AddWorkflowTaskResponse) Equal(that interface{}) bool {
@@ -2309,6 +2416,12 @@ func (this *AddActivityTaskRequest) Equal(that interface{}) bool {
 	if !this.Clock.Equal(that1.Clock) {
 		return false
 	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if this.FairnessKey != that1.FairnessKey {
+		return false
+	}
 	return true
 }
 func (this *AddActivityTaskResponse) Equal(that interface{}) bool {
@@ -2525,6 +2638,9 @@ func (this *DescribeTaskQueueRequest) Equal(that interface{}) bool {
 	if !this.DescRequest.Equal(that1.DescRequest) {
 		return false
 	}
+	if this.IncludeBacklogStats != that1.IncludeBacklogStats {
+		return false
+	}
 	return true
 }
 func (this *DescribeTaskQueueResponse) Equal(that interface{}) bool {
@@ -2557,6 +2673,9 @@ func (this *DescribeTaskQueueResponse) Equal(that interface{}) bool {
 	if !this.TaskQueueStatus.Equal(that1.TaskQueueStatus) {
 		return false
 	}
+	if !this.BacklogStats.Equal(that1.BacklogStats) {
+		return false
+	}
 	return true
 }
 func (this *ListTaskQueuePartitionsRequest) Equal(that interface{}) bool {
@@ -2807,6 +2926,12 @@ func (this *GetTaskQueueMetadataRequest) Equal(that interface{}) bool {
 	if !bytes.Equal(this.WantVersioningDataCurhash, that1.WantVersioningDataCurhash) {
 		return false
 	}
+	if this.TaskQueueType != that1.TaskQueueType {
+		return false
+	}
+	if this.WantPartitionCounts != that1.WantPartitionCounts {
+		return false
+	}
 	return true
 }
 func (this *GetTaskQueueMetadataResponse) Equal(that interface{}) bool {
@@ -2837,6 +2962,12 @@ func (this *GetTaskQueueMetadataResponse) Equal(that interface{}) bool {
 	} else if !this.VersioningDataResp.Equal(that1.VersioningDataResp) {
 		return false
 	}
+	if this.NumWritePartitions != that1.NumWritePartitions {
+		return false
+	}
+	if this.NumReadPartitions != that1.NumReadPartitions {
+		return false
+	}
 	return true
 }
 func (this *GetTaskQueueMetadataResponse_VersioningData) Equal(that interface{}) bool {
@@ -3005,7 +3136,7 @@ func (this *AddWorkflowTaskRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 14)
 	s = append(s, "&matchingservice.AddWorkflowTaskRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.Execution != nil {
@@ -3021,6 +3152,8 @@ func (this *AddWorkflowTaskRequest) GoString() string {
 	if this.Clock != nil {
 		s = append(s, "Clock: "+fmt.Sprintf("%#v", this.Clock)+",\n")
 	}
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
+	s = append(s, "FairnessKey: "+fmt.Sprintf("%#v", this.FairnessKey)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3037,7 +3170,7 @@ func (this *AddActivityTaskRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 14)
 	s = append(s, "&matchingservice.AddActivityTaskRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.Execution != nil {
@@ -3053,6 +3186,8 @@ func (this *AddActivityTaskRequest) GoString() string {
 	if this.Clock != nil {
 		s = append(s, "Clock: "+fmt.Sprintf("%#v", this.Clock)+",\n")
 	}
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
+	s = append(s, "FairnessKey: "+fmt.Sprintf("%#v", this.FairnessKey)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3151,12 +3286,13 @@ func (this *DescribeTaskQueueRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&matchingservice.DescribeTaskQueueRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.DescRequest != nil {
 		s = append(s, "DescRequest: "+fmt.Sprintf("%#v", this.DescRequest)+",\n")
 	}
+	s = append(s, "IncludeBacklogStats: "+fmt.Sprintf("%#v", this.IncludeBacklogStats)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3164,7 +3300,7 @@ func (this *DescribeTaskQueueResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&matchingservice.DescribeTaskQueueResponse{")
 	if this.Pollers != nil {
 		s = append(s, "Pollers: "+fmt.Sprintf("%#v", this.Pollers)+",\n")
@@ -3172,6 +3308,9 @@ func (this *DescribeTaskQueueResponse) GoString() string {
 	if this.TaskQueueStatus != nil {
 		s = append(s, "TaskQueueStatus: "+fmt.Sprintf("%#v", this.TaskQueueStatus)+",\n")
 	}
+	if this.BacklogStats != nil {
+		s = append(s, "BacklogStats: "+fmt.Sprintf("%#v", this.BacklogStats)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3279,11 +3418,13 @@ func (this *GetTaskQueueMetadataRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 9)
 	s = append(s, "&matchingservice.GetTaskQueueMetadataRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
 	s = append(s, "WantVersioningDataCurhash: "+fmt.Sprintf("%#v", this.WantVersioningDataCurhash)+",\n")
+	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
+	s = append(s, "WantPartitionCounts: "+fmt.Sprintf("%#v", this.WantPartitionCounts)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3291,11 +3432,13 @@ func (this *GetTaskQueueMetadataResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&matchingservice.GetTaskQueueMetadataResponse{")
 	if this.VersioningDataResp != nil {
 		s = append(s, "VersioningDataResp: "+fmt.Sprintf("%#v", this.VersioningDataResp)+",\n")
 	}
+	s = append(s, "NumWritePartitions: "+fmt.Sprintf("%#v", this.NumWritePartitions)+",\n")
+	s = append(s, "NumReadPartitions: "+fmt.Sprintf("%#v", this.NumReadPartitions)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3820,6 +3963,18 @@ func (m *AddWorkflowTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Priority != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.Clock != nil {
 		{
 			size, err := m.Clock.MarshalToSizedBuffer(dAtA[:i])
@@ -3936,6 +4091,18 @@ func (m *AddActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Priority != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.Clock != nil {
 		{
 			size, err := m.Clock.MarshalToSizedBuffer(dAtA[:i])
@@ -4321,6 +4488,16 @@ func (m *DescribeTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.IncludeBacklogStats {
+		i--
+		if m.IncludeBacklogStats {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.DescRequest != nil {
 		{
 			size, err := m.DescRequest.MarshalToSizedBuffer(dAtA[:i])
@@ -4363,6 +4540,18 @@ func (m *DescribeTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.BacklogStats != nil {
+		{
+			size, err := m.BacklogStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TaskQueueStatus != nil {
 		{
 			size, err := m.TaskQueueStatus.MarshalToSizedBuffer(dAtA[:i])
@@ -4731,6 +4920,21 @@ func (m *GetTaskQueueMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.WantPartitionCounts {
+		i--
+		if m.WantPartitionCounts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TaskQueueType != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskQueueType))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.WantVersioningDataCurhash) > 0 {
 		i -= len(m.WantVersioningDataCurhash)
 		copy(dAtA[i:], m.WantVersioningDataCurhash)
@@ -4775,6 +4979,16 @@ func (m *GetTaskQueueMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.NumReadPartitions != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.NumReadPartitions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NumWritePartitions != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.NumWritePartitions))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.VersioningDataResp != nil {
 		{
 			size := m.VersioningDataResp.Size()
@@ -5070,6 +5284,13 @@ func (m *AddWorkflowTaskRequest) Size() (n int) {
 		l = m.Clock.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -5118,6 +5339,13 @@ func (m *AddActivityTaskRequest) Size() (n int) {
 		l = m.Clock.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -5253,6 +5481,9 @@ func (m *DescribeTaskQueueRequest) Size() (n int) {
 		l = m.DescRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.IncludeBacklogStats {
+		n += 2
+	}
 	return n
 }
 
@@ -5272,6 +5503,10 @@ func (m *DescribeTaskQueueResponse) Size() (n int) {
 		l = m.TaskQueueStatus.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.BacklogStats != nil {
+		l = m.BacklogStats.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -5424,6 +5659,12 @@ func (m *GetTaskQueueMetadataRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.TaskQueueType != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
+	}
+	if m.WantPartitionCounts {
+		n += 2
+	}
 	return n
 }
 
@@ -5436,6 +5677,12 @@ func (m *GetTaskQueueMetadataResponse) Size() (n int) {
 	if m.VersioningDataResp != nil {
 		n += m.VersioningDataResp.Size()
 	}
+	if m.NumWritePartitions != 0 {
+		n += 1 + sovRequestResponse(uint64(m.NumWritePartitions))
+	}
+	if m.NumReadPartitions != 0 {
+		n += 1 + sovRequestResponse(uint64(m.NumReadPartitions))
+	}
 	return n
 }
 
@@ -5566,6 +5813,8 @@ func (this *AddWorkflowTaskRequest) String() string {
 		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
 		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
 		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v16.VectorClock", 1) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`FairnessKey:` + fmt.Sprintf("%v", this.FairnessKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5592,6 +5841,8 @@ func (this *AddActivityTaskRequest) String() string {
 		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
 		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
 		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v16.VectorClock", 1) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`FairnessKey:` + fmt.Sprintf("%v", this.FairnessKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5680,6 +5931,7 @@ func (this *DescribeTaskQueueRequest) String() string {
 	s := strings.Join([]string{`&DescribeTaskQueueRequest{`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`DescRequest:` + strings.Replace(fmt.Sprintf("%v", this.DescRequest), "DescribeTaskQueueRequest", "v1.DescribeTaskQueueRequest", 1) + `,`,
+		`IncludeBacklogStats:` + fmt.Sprintf("%v", this.IncludeBacklogStats) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5696,6 +5948,7 @@ func (this *DescribeTaskQueueResponse) String() string {
 	s := strings.Join([]string{`&DescribeTaskQueueResponse{`,
 		`Pollers:` + repeatedStringForPollers + `,`,
 		`TaskQueueStatus:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueueStatus), "TaskQueueStatus", "v14.TaskQueueStatus", 1) + `,`,
+		`BacklogStats:` + strings.Replace(fmt.Sprintf("%v", this.BacklogStats), "BacklogStats", "v18.BacklogStats", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5782,7 +6035,7 @@ func (this *InvalidateTaskQueueMetadataRequest) String() string {
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
 		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
-		`VersioningData:` + strings.Replace(fmt.Sprintf("%v", this.VersioningData), "VersioningData", "v18.VersioningData", 1) + `,`,
+		`VersioningData:` + strings.Replace(fmt.Sprintf("%v", this.VersioningData), "VersioningData", "v19.VersioningData", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5804,6 +6057,8 @@ func (this *GetTaskQueueMetadataRequest) String() string {
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
 		`WantVersioningDataCurhash:` + fmt.Sprintf("%v", this.WantVersioningDataCurhash) + `,`,
+		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
+		`WantPartitionCounts:` + fmt.Sprintf("%v", this.WantPartitionCounts) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5814,6 +6069,8 @@ func (this *GetTaskQueueMetadataResponse) String() string {
 	}
 	s := strings.Join([]string{`&GetTaskQueueMetadataResponse{`,
 		`VersioningDataResp:` + fmt.Sprintf("%v", this.VersioningDataResp) + `,`,
+		`NumWritePartitions:` + fmt.Sprintf("%v", this.NumWritePartitions) + `,`,
+		`NumReadPartitions:` + fmt.Sprintf("%v", this.NumReadPartitions) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5823,7 +6080,7 @@ func (this *GetTaskQueueMetadataResponse_VersioningData) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&GetTaskQueueMetadataResponse_VersioningData{`,
-		`VersioningData:` + strings.Replace(fmt.Sprintf("%v", this.VersioningData), "VersioningData", "v18.VersioningData", 1) + `,`,
+		`VersioningData:` + strings.Replace(fmt.Sprintf("%v", this.VersioningData), "VersioningData", "v19.VersioningData", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7710,6 +7967,57 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -8062,6 +8370,57 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -9017,6 +9376,26 @@ func (m *DescribeTaskQueueRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeBacklogStats", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeBacklogStats = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -9140,6 +9519,42 @@ func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BacklogStats == nil {
+				m.BacklogStats = &v18.BacklogStats{}
+			}
+			if err := m.BacklogStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -9964,7 +10379,7 @@ func (m *InvalidateTaskQueueMetadataRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.VersioningData == nil {
-				m.VersioningData = &v18.VersioningData{}
+				m.VersioningData = &v19.VersioningData{}
 			}
 			if err := m.VersioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -10174,6 +10589,45 @@ func (m *GetTaskQueueMetadataRequest) Unmarshal(dAtA []byte) error {
 				m.WantVersioningDataCurhash = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
+			}
+			m.TaskQueueType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskQueueType |= v17.TaskQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WantPartitionCounts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WantPartitionCounts = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -10256,7 +10710,7 @@ func (m *GetTaskQueueMetadataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &v18.VersioningData{}
+			v := &v19.VersioningData{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -10283,6 +10737,44 @@ func (m *GetTaskQueueMetadataResponse) Unmarshal(dAtA []byte) error {
 			}
 			b := bool(v != 0)
 			m.VersioningDataResp = &GetTaskQueueMetadataResponse_MatchedReqHash{b}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumWritePartitions", wireType)
+			}
+			m.NumWritePartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumWritePartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumReadPartitions", wireType)
+			}
+			m.NumReadPartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumReadPartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -34,17 +34,23 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v11 "go.temporal.io/api/enums/v1"
 	v1 "go.temporal.io/api/version/v1"
+	v12 "go.temporal.io/server/api/enums/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -65,6 +71,8 @@ type ClusterMetadata struct {
 	IsGlobalNamespaceEnabled bool                              `protobuf:"varint,9,opt,name=is_global_namespace_enabled,json=isGlobalNamespaceEnabled,proto3" json:"is_global_namespace_enabled,omitempty"`
 	IsConnectionEnabled      bool                              `protobuf:"varint,10,opt,name=is_connection_enabled,json=isConnectionEnabled,proto3" json:"is_connection_enabled,omitempty"`
 	UseClusterIdMembership   bool                              `protobuf:"varint,11,opt,name=use_cluster_id_membership,json=useClusterIdMembership,proto3" json:"use_cluster_id_membership,omitempty"`
+	ShardOwnershipOverrides  []*ShardOwnershipOverride         `protobuf:"bytes,12,rep,name=shard_ownership_overrides,json=shardOwnershipOverrides,proto3" json:"shard_ownership_overrides,omitempty"`
+	DynamicConfigOverrides   []*DynamicConfigOverride          `protobuf:"bytes,13,rep,name=dynamic_config_overrides,json=dynamicConfigOverrides,proto3" json:"dynamic_config_overrides,omitempty"`
 }
 
 func (m *ClusterMetadata) Reset()      { *m = ClusterMetadata{} }
@@ -176,6 +184,20 @@ func (m *ClusterMetadata) GetUseClusterIdMembership() bool {
 	return false
 }
 
+func (m *ClusterMetadata) GetShardOwnershipOverrides() []*ShardOwnershipOverride {
+	if m != nil {
+		return m.ShardOwnershipOverrides
+	}
+	return nil
+}
+
+func (m *ClusterMetadata) GetDynamicConfigOverrides() []*DynamicConfigOverride {
+	if m != nil {
+		return m.DynamicConfigOverrides
+	}
+	return nil
+}
+
 type IndexSearchAttributes struct {
 	CustomSearchAttributes map[string]v11.IndexedValueType `protobuf:"bytes,1,rep,name=custom_search_attributes,json=customSearchAttributes,proto3" json:"custom_search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=temporal.api.enums.v1.IndexedValueType"`
 }
@@ -219,11 +241,228 @@ func (m *IndexSearchAttributes) GetCustomSearchAttributes() map[string]v11.Index
 	return nil
 }
 
+// ShardOwnershipOverride pins a history shard to a specific history host,
+// regardless of the membership ring assignment, until it expires.
+type ShardOwnershipOverride struct {
+	ShardId     int32      `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	HostAddress string     `protobuf:"bytes,2,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
+	ExpireTime  *time.Time `protobuf:"bytes,3,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time,omitempty"`
+}
+
+func (m *ShardOwnershipOverride) Reset()      { *m = ShardOwnershipOverride{} }
+func (*ShardOwnershipOverride) ProtoMessage() {}
+func (*ShardOwnershipOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f4771d63f405884, []int{2}
+}
+func (m *ShardOwnershipOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardOwnershipOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardOwnershipOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShardOwnershipOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardOwnershipOverride.Merge(m, src)
+}
+func (m *ShardOwnershipOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardOwnershipOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardOwnershipOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardOwnershipOverride proto.InternalMessageInfo
+
+func (m *ShardOwnershipOverride) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *ShardOwnershipOverride) GetHostAddress() string {
+	if m != nil {
+		return m.HostAddress
+	}
+	return ""
+}
+
+func (m *ShardOwnershipOverride) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+// DynamicConfigOverride overrides the value of a dynamic config key for the given
+// constraints on all hosts, on top of the configured dynamic config client, until it expires.
+type DynamicConfigOverride struct {
+	Key         string                    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Constraints *DynamicConfigConstraints `protobuf:"bytes,2,opt,name=constraints,proto3" json:"constraints,omitempty"`
+	// The value in YAML, as it would be written in the dynamic config file.
+	Value      string     `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	ExpireTime *time.Time `protobuf:"bytes,4,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time,omitempty"`
+}
+
+func (m *DynamicConfigOverride) Reset()      { *m = DynamicConfigOverride{} }
+func (*DynamicConfigOverride) ProtoMessage() {}
+func (*DynamicConfigOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f4771d63f405884, []int{3}
+}
+func (m *DynamicConfigOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicConfigOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicConfigOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicConfigOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicConfigOverride.Merge(m, src)
+}
+func (m *DynamicConfigOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicConfigOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicConfigOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicConfigOverride proto.InternalMessageInfo
+
+func (m *DynamicConfigOverride) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *DynamicConfigOverride) GetConstraints() *DynamicConfigConstraints {
+	if m != nil {
+		return m.Constraints
+	}
+	return nil
+}
+
+func (m *DynamicConfigOverride) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *DynamicConfigOverride) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+type DynamicConfigConstraints struct {
+	Namespace     string            `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NamespaceId   string            `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	TaskQueueName string            `protobuf:"bytes,3,opt,name=task_queue_name,json=taskQueueName,proto3" json:"task_queue_name,omitempty"`
+	TaskQueueType v11.TaskQueueType `protobuf:"varint,4,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	ShardId       int32             `protobuf:"varint,5,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	TaskType      v12.TaskType      `protobuf:"varint,6,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
+}
+
+func (m *DynamicConfigConstraints) Reset()      { *m = DynamicConfigConstraints{} }
+func (*DynamicConfigConstraints) ProtoMessage() {}
+func (*DynamicConfigConstraints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f4771d63f405884, []int{4}
+}
+func (m *DynamicConfigConstraints) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicConfigConstraints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicConfigConstraints.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicConfigConstraints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicConfigConstraints.Merge(m, src)
+}
+func (m *DynamicConfigConstraints) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicConfigConstraints) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicConfigConstraints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicConfigConstraints proto.InternalMessageInfo
+
+func (m *DynamicConfigConstraints) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *DynamicConfigConstraints) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *DynamicConfigConstraints) GetTaskQueueName() string {
+	if m != nil {
+		return m.TaskQueueName
+	}
+	return ""
+}
+
+func (m *DynamicConfigConstraints) GetTaskQueueType() v11.TaskQueueType {
+	if m != nil {
+		return m.TaskQueueType
+	}
+	return v11.TASK_QUEUE_TYPE_UNSPECIFIED
+}
+
+func (m *DynamicConfigConstraints) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *DynamicConfigConstraints) GetTaskType() v12.TaskType {
+	if m != nil {
+		return m.TaskType
+	}
+	return v12.TASK_TYPE_UNSPECIFIED
+}
+
 func init() {
 	proto.RegisterType((*ClusterMetadata)(nil), "temporal.server.api.persistence.v1.ClusterMetadata")
 	proto.RegisterMapType((map[string]*IndexSearchAttributes)(nil), "temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry")
 	proto.RegisterType((*IndexSearchAttributes)(nil), "temporal.server.api.persistence.v1.IndexSearchAttributes")
 	proto.RegisterMapType((map[string]v11.IndexedValueType)(nil), "temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry")
+	proto.RegisterType((*ShardOwnershipOverride)(nil), "temporal.server.api.persistence.v1.ShardOwnershipOverride")
+	proto.RegisterType((*DynamicConfigOverride)(nil), "temporal.server.api.persistence.v1.DynamicConfigOverride")
+	proto.RegisterType((*DynamicConfigConstraints)(nil), "temporal.server.api.persistence.v1.DynamicConfigConstraints")
 }
 
 func init() {
@@ -231,49 +470,71 @@ func init() {
 }
 
 var fileDescriptor_1f4771d63f405884 = []byte{
-	// 670 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0x13, 0x41,
-	0x14, 0xef, 0x50, 0x41, 0x98, 0x12, 0xd0, 0x25, 0xe0, 0x58, 0xe2, 0xa6, 0x12, 0x0d, 0x3d, 0x6d,
-	0x43, 0xf5, 0x00, 0x2a, 0x07, 0x6c, 0x90, 0x70, 0x00, 0x93, 0xa2, 0x1c, 0xbc, 0x6c, 0xa6, 0xbb,
-	0x0f, 0x3a, 0xba, 0x3b, 0xb3, 0x99, 0x99, 0xdd, 0xd8, 0x9b, 0x89, 0x89, 0x57, 0xfd, 0x02, 0xde,
-	0x8d, 0x9f, 0xc4, 0x23, 0x47, 0x8e, 0x52, 0x2e, 0x1e, 0xf9, 0x08, 0x66, 0x67, 0x77, 0xdb, 0x42,
-	0x8a, 0x1a, 0x6f, 0x3b, 0xef, 0xf7, 0x67, 0xde, 0xfc, 0xe6, 0xed, 0xe0, 0x0d, 0x0d, 0x61, 0x24,
-	0x24, 0x0d, 0x1a, 0x0a, 0x64, 0x02, 0xb2, 0x41, 0x23, 0xd6, 0x88, 0x40, 0x2a, 0xa6, 0x34, 0x70,
-	0x0f, 0x1a, 0xc9, 0x5a, 0xc3, 0x0b, 0x62, 0xa5, 0x41, 0xba, 0x21, 0x68, 0xea, 0x53, 0x4d, 0x9d,
-	0x48, 0x0a, 0x2d, 0xac, 0x95, 0x42, 0xea, 0x64, 0x52, 0x87, 0x46, 0xcc, 0x19, 0x91, 0x3a, 0xc9,
-	0x5a, 0x75, 0xc0, 0x31, 0xbe, 0xc0, 0xe3, 0x50, 0x19, 0x47, 0x11, 0x86, 0x82, 0x67, 0x3e, 0xd5,
-	0x87, 0x97, 0x38, 0x49, 0x6a, 0x20, 0x78, 0xca, 0x0a, 0x41, 0x29, 0x7a, 0x0c, 0x19, 0x6d, 0xe5,
-	0xfb, 0x14, 0x9e, 0x6f, 0x65, 0x9d, 0xec, 0xe5, 0x8d, 0x58, 0xf7, 0xf1, 0x6c, 0xd1, 0x1c, 0xa7,
-	0x21, 0x10, 0x54, 0x43, 0xf5, 0x99, 0x76, 0x25, 0xaf, 0xed, 0xd3, 0x10, 0x2c, 0x07, 0x2f, 0x74,
-	0x99, 0xd2, 0x42, 0xf6, 0x5c, 0xd5, 0xa5, 0xd2, 0x77, 0x3d, 0x11, 0x73, 0x4d, 0x26, 0x6a, 0xa8,
-	0x3e, 0xd9, 0xbe, 0x9d, 0x43, 0x07, 0x29, 0xd2, 0x4a, 0x01, 0xeb, 0x1e, 0xc6, 0x85, 0x25, 0xf3,
-	0x49, 0xd9, 0x18, 0xce, 0xe4, 0x95, 0x5d, 0xdf, 0xda, 0xc1, 0xb3, 0x79, 0x87, 0x2e, 0xe3, 0x47,
-	0x82, 0xdc, 0xa8, 0xa1, 0x7a, 0xa5, 0xf9, 0xc0, 0x19, 0x64, 0x91, 0x86, 0x90, 0x33, 0x9c, 0x64,
-	0xcd, 0x39, 0xcc, 0x3e, 0x77, 0xf9, 0x91, 0x68, 0x57, 0x92, 0xe1, 0xc2, 0xfa, 0x84, 0xf0, 0x1d,
-	0xc6, 0x7d, 0x78, 0xef, 0x2a, 0xa0, 0xd2, 0xeb, 0xba, 0x54, 0x6b, 0xc9, 0x3a, 0xb1, 0x06, 0x45,
-	0x26, 0x6b, 0xe5, 0x7a, 0xa5, 0xb9, 0xef, 0xfc, 0x3d, 0x60, 0xe7, 0x4a, 0x22, 0xce, 0x6e, 0x6a,
-	0x79, 0x60, 0x1c, 0xb7, 0x06, 0x86, 0xdb, 0x5c, 0xcb, 0x5e, 0x7b, 0x91, 0x8d, 0xc3, 0xac, 0x55,
-	0x3c, 0x5f, 0x1c, 0x98, 0xfa, 0xbe, 0x04, 0xa5, 0xc8, 0x94, 0x39, 0xf5, 0x5c, 0x5e, 0xde, 0xca,
-	0xaa, 0xd6, 0x33, 0x5c, 0x3d, 0xa2, 0x2c, 0x10, 0x09, 0x48, 0x77, 0x98, 0x81, 0x27, 0x21, 0x04,
-	0xae, 0xc9, 0xcd, 0x1a, 0xaa, 0x97, 0xdb, 0xa4, 0x60, 0x0c, 0xce, 0x9d, 0xe3, 0xd6, 0x3a, 0x26,
-	0x8c, 0x33, 0xcd, 0x68, 0xe0, 0x5e, 0x75, 0x21, 0xd3, 0x46, 0xbb, 0x94, 0xe3, 0x2f, 0x2e, 0x5b,
-	0x58, 0x9b, 0x78, 0x99, 0x29, 0xf7, 0x38, 0x10, 0x1d, 0x1a, 0x98, 0x6b, 0x56, 0x11, 0xf5, 0xc0,
-	0x05, 0x4e, 0x3b, 0x01, 0xf8, 0x64, 0xa6, 0x86, 0xea, 0xd3, 0x6d, 0xc2, 0xd4, 0x8e, 0x61, 0xec,
-	0x17, 0x84, 0xed, 0x0c, 0xb7, 0x9a, 0x78, 0x91, 0x29, 0xd7, 0x13, 0x9c, 0x83, 0xa7, 0xd3, 0x9e,
-	0x0b, 0x21, 0x36, 0xc2, 0x05, 0xa6, 0x5a, 0x03, 0xac, 0xd0, 0x6c, 0xe0, 0xbb, 0xb1, 0x02, 0x77,
-	0x38, 0x08, 0x6e, 0x08, 0x61, 0x07, 0xa4, 0xea, 0xb2, 0x88, 0x54, 0x8c, 0x6e, 0x29, 0x56, 0xd0,
-	0x2a, 0xc6, 0x62, 0x6f, 0x80, 0x56, 0x3f, 0x22, 0x5c, 0xbd, 0xfe, 0x12, 0xac, 0x5b, 0xb8, 0xfc,
-	0x0e, 0x7a, 0xf9, 0xa0, 0xa6, 0x9f, 0xd6, 0x4b, 0x3c, 0x99, 0xd0, 0x20, 0x06, 0x33, 0x92, 0x95,
-	0xe6, 0xc6, 0xbf, 0xdc, 0xfa, 0xd8, 0x0d, 0xda, 0x99, 0xcf, 0x93, 0x89, 0x75, 0xb4, 0xf2, 0x75,
-	0x02, 0x2f, 0x8e, 0x25, 0x59, 0x9f, 0x11, 0x26, 0x5e, 0xac, 0xb4, 0x08, 0xc7, 0x0c, 0x1e, 0x32,
-	0x83, 0xf7, 0xfa, 0xbf, 0x5b, 0x70, 0x5a, 0xc6, 0x79, 0xfc, 0xfc, 0x2d, 0x79, 0x63, 0xc1, 0xaa,
-	0xc4, 0xcb, 0x7f, 0x90, 0x8d, 0x49, 0x6c, 0x73, 0x34, 0xb1, 0xb9, 0xe6, 0xea, 0xe5, 0x9f, 0xcf,
-	0x3c, 0x32, 0x83, 0x0e, 0xc1, 0x3f, 0x4c, 0xa9, 0xaf, 0x7a, 0x11, 0x8c, 0xe4, 0xf3, 0xfc, 0xed,
-	0xc9, 0x99, 0x5d, 0x3a, 0x3d, 0xb3, 0x4b, 0x17, 0x67, 0x36, 0xfa, 0xd0, 0xb7, 0xd1, 0xb7, 0xbe,
-	0x8d, 0x7e, 0xf4, 0x6d, 0x74, 0xd2, 0xb7, 0xd1, 0xcf, 0xbe, 0x8d, 0x7e, 0xf5, 0xed, 0xd2, 0x45,
-	0xdf, 0x46, 0x5f, 0xce, 0xed, 0xd2, 0xc9, 0xb9, 0x5d, 0x3a, 0x3d, 0xb7, 0x4b, 0x6f, 0x1e, 0x1f,
-	0x8b, 0xe1, 0x5e, 0x4c, 0x5c, 0xff, 0x64, 0x3e, 0x1d, 0x59, 0x76, 0xa6, 0xcc, 0xfb, 0xf5, 0xe8,
-	0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6e, 0x7d, 0x0e, 0x79, 0x6b, 0x05, 0x00, 0x00,
+	// 1011 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0xf7, 0x26, 0x4d, 0x9a, 0xac, 0xf3, 0x07, 0xb6, 0x24, 0x55, 0x5c, 0x50, 0xdd, 0x4c, 0x49,
+	0x7c, 0x92, 0x27, 0x86, 0x43, 0x53, 0xda, 0x43, 0x6a, 0x4a, 0x27, 0x33, 0x34, 0x19, 0xd4, 0xd0,
+	0x03, 0x07, 0x34, 0x6b, 0xe9, 0xd9, 0x5e, 0x6a, 0xed, 0x0a, 0xed, 0xca, 0xd4, 0x37, 0x66, 0x98,
+	0xe1, 0x4a, 0x6f, 0x0c, 0x07, 0xee, 0x5c, 0xf9, 0x16, 0x1c, 0x73, 0xec, 0x0d, 0xe2, 0x5c, 0x38,
+	0xf6, 0x23, 0x30, 0xda, 0x95, 0x64, 0x3b, 0xa3, 0x40, 0xe8, 0x4d, 0xfb, 0xde, 0xef, 0xfd, 0xde,
+	0xdf, 0x7d, 0x2b, 0xbc, 0xaf, 0x20, 0x8c, 0x44, 0x4c, 0x07, 0x4d, 0x09, 0xf1, 0x10, 0xe2, 0x26,
+	0x8d, 0x58, 0x33, 0x82, 0x58, 0x32, 0xa9, 0x80, 0xfb, 0xd0, 0x1c, 0xee, 0x35, 0xfd, 0x41, 0x22,
+	0x15, 0xc4, 0x5e, 0x08, 0x8a, 0x06, 0x54, 0x51, 0x27, 0x8a, 0x85, 0x12, 0x64, 0x3b, 0x37, 0x75,
+	0x8c, 0xa9, 0x43, 0x23, 0xe6, 0x4c, 0x99, 0x3a, 0xc3, 0xbd, 0xda, 0xed, 0x9e, 0x10, 0xbd, 0x01,
+	0x34, 0xb5, 0x45, 0x27, 0xe9, 0x36, 0x15, 0x0b, 0x41, 0x2a, 0x1a, 0x46, 0x86, 0xa4, 0x76, 0x27,
+	0x80, 0x08, 0x78, 0x00, 0xdc, 0x67, 0x20, 0x9b, 0x3d, 0xd1, 0x13, 0x5a, 0xae, 0xbf, 0x32, 0x48,
+	0xe1, 0x47, 0xc7, 0x06, 0x3c, 0x09, 0xa5, 0x8e, 0x4a, 0x84, 0xa1, 0xe0, 0x19, 0x66, 0xa7, 0x1c,
+	0xa3, 0xa8, 0x7c, 0xe1, 0x7d, 0x9b, 0x40, 0x02, 0x19, 0xee, 0xc3, 0x19, 0xdc, 0x30, 0x0d, 0x56,
+	0xf0, 0x14, 0x19, 0x82, 0x94, 0xb4, 0x97, 0xc3, 0x76, 0xcb, 0xaa, 0x32, 0xc3, 0x6a, 0x80, 0xdb,
+	0x3f, 0x2f, 0xe1, 0xf5, 0xb6, 0x29, 0xcf, 0xd3, 0xac, 0x3a, 0xe4, 0x0e, 0x5e, 0xc9, 0x2b, 0xc6,
+	0x69, 0x08, 0x16, 0xaa, 0xa3, 0xc6, 0xb2, 0x5b, 0xcd, 0x64, 0x47, 0x34, 0x04, 0xe2, 0xe0, 0x1b,
+	0x7d, 0x26, 0x95, 0x88, 0x47, 0x9e, 0xec, 0xd3, 0x38, 0xf0, 0x7c, 0x91, 0x70, 0x65, 0xcd, 0xd5,
+	0x51, 0x63, 0xc1, 0x7d, 0x37, 0x53, 0x3d, 0x4b, 0x35, 0xed, 0x54, 0x41, 0x3e, 0xc0, 0x38, 0xa7,
+	0x64, 0x81, 0x35, 0xaf, 0x09, 0x97, 0x33, 0xc9, 0x61, 0x40, 0x9e, 0xe0, 0x95, 0x2c, 0x15, 0x8f,
+	0xf1, 0xae, 0xb0, 0xae, 0xd5, 0x51, 0xa3, 0xda, 0xba, 0xeb, 0x14, 0x0d, 0x4a, 0x3b, 0x93, 0x21,
+	0x9c, 0xe1, 0x9e, 0xf3, 0xdc, 0x7c, 0x1e, 0xf2, 0xae, 0x70, 0xab, 0xc3, 0xc9, 0x81, 0xfc, 0x88,
+	0xf0, 0x4d, 0xc6, 0x03, 0x78, 0xe9, 0x49, 0xa0, 0xb1, 0xdf, 0xf7, 0xa8, 0x52, 0x31, 0xeb, 0x24,
+	0x0a, 0xa4, 0xb5, 0x50, 0x9f, 0x6f, 0x54, 0x5b, 0x47, 0xce, 0x7f, 0x77, 0xdd, 0xb9, 0x50, 0x11,
+	0xe7, 0x30, 0xa5, 0x7c, 0xa6, 0x19, 0x0f, 0x0a, 0xc2, 0xc7, 0x5c, 0xc5, 0x23, 0x77, 0x83, 0x95,
+	0xe9, 0xc8, 0x2e, 0x5e, 0xcf, 0x13, 0xa6, 0x41, 0x10, 0x83, 0x94, 0xd6, 0xa2, 0xce, 0x7a, 0x2d,
+	0x13, 0x1f, 0x18, 0x29, 0x79, 0x80, 0x6b, 0x5d, 0xca, 0x06, 0x62, 0x08, 0xb1, 0x37, 0xa9, 0x81,
+	0x1f, 0x43, 0x08, 0x5c, 0x59, 0xd7, 0xeb, 0xa8, 0x31, 0xef, 0x5a, 0x39, 0xa2, 0xc8, 0x3b, 0xd3,
+	0x93, 0x7b, 0xd8, 0x62, 0x9c, 0x29, 0x46, 0x07, 0xde, 0x45, 0x16, 0x6b, 0x49, 0xdb, 0x6e, 0x66,
+	0xfa, 0xcf, 0x66, 0x29, 0xc8, 0x43, 0x7c, 0x8b, 0x49, 0xaf, 0x37, 0x10, 0x1d, 0x3a, 0xd0, 0x6d,
+	0x96, 0x11, 0xf5, 0xc1, 0x03, 0x4e, 0x3b, 0x03, 0x08, 0xac, 0xe5, 0x3a, 0x6a, 0x2c, 0xb9, 0x16,
+	0x93, 0x4f, 0x34, 0xe2, 0x28, 0x07, 0x3c, 0x36, 0x7a, 0xd2, 0xc2, 0x1b, 0x4c, 0x7a, 0xbe, 0xe0,
+	0x1c, 0x7c, 0x95, 0xc6, 0x9c, 0x1b, 0x62, 0x6d, 0x78, 0x83, 0xc9, 0x76, 0xa1, 0xcb, 0x6d, 0xf6,
+	0xf1, 0x56, 0x22, 0xc1, 0x9b, 0x0c, 0x82, 0x17, 0x42, 0xd8, 0x81, 0x58, 0xf6, 0x59, 0x64, 0x55,
+	0xb5, 0xdd, 0x66, 0x22, 0xa1, 0x9d, 0x8f, 0xc5, 0xd3, 0x42, 0x4b, 0x86, 0x78, 0xcb, 0xcc, 0x99,
+	0xf8, 0x8e, 0x1b, 0x91, 0x97, 0x26, 0x13, 0xb3, 0x00, 0xa4, 0xb5, 0xa2, 0x1b, 0x7b, 0xff, 0x2a,
+	0x8d, 0xd5, 0x23, 0x79, 0x9c, 0x73, 0x1c, 0x67, 0x14, 0xee, 0x4d, 0x59, 0x2a, 0x97, 0x44, 0x62,
+	0x2b, 0x18, 0x71, 0x1a, 0x32, 0x3f, 0xcd, 0xb5, 0xcb, 0x7a, 0x53, 0x6e, 0x57, 0xb5, 0xdb, 0xfd,
+	0xab, 0xb8, 0xfd, 0xd4, 0x70, 0xb4, 0x35, 0x45, 0xe1, 0x75, 0x33, 0x28, 0x13, 0xcb, 0xda, 0x0f,
+	0x08, 0xd7, 0x2e, 0x9f, 0x38, 0xf2, 0x0e, 0x9e, 0x7f, 0x01, 0xa3, 0xec, 0x56, 0xa6, 0x9f, 0xe4,
+	0x18, 0x2f, 0x0c, 0xe9, 0x20, 0x01, 0x7d, 0xff, 0xae, 0x18, 0x52, 0xa9, 0x03, 0xd7, 0xf0, 0xdc,
+	0x9f, 0xbb, 0x87, 0xb6, 0x7f, 0x9d, 0xc3, 0x1b, 0xa5, 0x20, 0xf2, 0x13, 0xc2, 0x96, 0x9f, 0x48,
+	0x25, 0xc2, 0x92, 0x5b, 0x86, 0x74, 0x55, 0xbe, 0x7c, 0xeb, 0x10, 0x9c, 0xb6, 0x66, 0x2e, 0xbf,
+	0x6c, 0x9b, 0x7e, 0xa9, 0xb2, 0x16, 0xe3, 0x5b, 0xff, 0x62, 0x56, 0x52, 0xb1, 0x87, 0xd3, 0x15,
+	0x5b, 0x6b, 0xed, 0xce, 0x6e, 0x1a, 0xbd, 0x28, 0x8b, 0x08, 0x21, 0x78, 0x9e, 0x42, 0x4f, 0x46,
+	0x11, 0x4c, 0xd7, 0xe7, 0x17, 0x84, 0x37, 0xcb, 0xc7, 0x89, 0x6c, 0xe1, 0x25, 0x33, 0xad, 0x2c,
+	0xd0, 0x4e, 0x17, 0xdc, 0xeb, 0xfa, 0x7c, 0x18, 0xa4, 0xbb, 0xb5, 0x2f, 0xa4, 0x2a, 0x96, 0xc2,
+	0x9c, 0xd9, 0xad, 0xa9, 0x2c, 0xdf, 0x08, 0x07, 0xb8, 0x0a, 0x2f, 0x23, 0x16, 0x83, 0x97, 0xbe,
+	0x35, 0x7a, 0x59, 0x56, 0x5b, 0x35, 0xc7, 0x3c, 0x44, 0x4e, 0xfe, 0x10, 0x39, 0x27, 0xf9, 0x43,
+	0xf4, 0xe8, 0xda, 0xab, 0x3f, 0x6f, 0x23, 0x17, 0x1b, 0xa3, 0x54, 0xbc, 0x3d, 0x46, 0x78, 0xa3,
+	0x74, 0xe6, 0x4a, 0x4a, 0xf1, 0x35, 0xae, 0xfa, 0x82, 0x4b, 0x15, 0x53, 0xc6, 0x95, 0xcc, 0x46,
+	0xe8, 0xc1, 0xff, 0x9e, 0xea, 0xf6, 0x84, 0xc3, 0x9d, 0x26, 0x24, 0xef, 0xe5, 0xa5, 0x36, 0x5b,
+	0xdf, 0x1c, 0x2e, 0x26, 0x79, 0xed, 0x2d, 0x92, 0xfc, 0x7d, 0x0e, 0x5b, 0x97, 0x85, 0x40, 0xde,
+	0xc7, 0xcb, 0xc5, 0x52, 0xcb, 0xb2, 0x9d, 0x08, 0xd2, 0x2e, 0x14, 0x87, 0xb4, 0x49, 0x59, 0x17,
+	0x0a, 0xd9, 0x61, 0x40, 0x76, 0xf0, 0xfa, 0xe4, 0xf1, 0x35, 0xef, 0xa0, 0x49, 0x60, 0x35, 0x15,
+	0x7f, 0x91, 0x4a, 0xf5, 0x4b, 0xf8, 0xf9, 0x0c, 0x4e, 0x8d, 0x22, 0x93, 0xcc, 0x5a, 0xeb, 0xee,
+	0x25, 0x33, 0x75, 0x92, 0x9b, 0xeb, 0x81, 0x5a, 0x55, 0xd3, 0xc7, 0x99, 0xc9, 0x59, 0x98, 0x9d,
+	0x9c, 0x36, 0x5e, 0xd6, 0x8e, 0xb4, 0x8b, 0x45, 0xed, 0x62, 0xa7, 0xb4, 0x4b, 0x33, 0x9e, 0xb4,
+	0x93, 0x25, 0x95, 0x7d, 0x3d, 0xfa, 0xe6, 0xf4, 0xcc, 0xae, 0xbc, 0x3e, 0xb3, 0x2b, 0x6f, 0xce,
+	0x6c, 0xf4, 0xfd, 0xd8, 0x46, 0xbf, 0x8d, 0x6d, 0xf4, 0xc7, 0xd8, 0x46, 0xa7, 0x63, 0x1b, 0xfd,
+	0x35, 0xb6, 0xd1, 0xdf, 0x63, 0xbb, 0xf2, 0x66, 0x6c, 0xa3, 0x57, 0xe7, 0x76, 0xe5, 0xf4, 0xdc,
+	0xae, 0xbc, 0x3e, 0xb7, 0x2b, 0x5f, 0x7d, 0xdc, 0x13, 0x13, 0x4f, 0x4c, 0x5c, 0xfe, 0xa7, 0xf5,
+	0xc9, 0xd4, 0xb1, 0xb3, 0xa8, 0xbb, 0xf8, 0xd1, 0x3f, 0x03, 0x00, 0xc9, 0x82, 0xc8, 0x8c, 0xa2,
+	0x09, 0x00, 0x00,
 }
 
 func (this *ClusterMetadata) Equal(that interface{}) bool {
@@ -333,6 +594,22 @@ func (this *ClusterMetadata) Equal(that interface{}) bool {
 	if this.UseClusterIdMembership != that1.UseClusterIdMembership {
 		return false
 	}
+	if len(this.ShardOwnershipOverrides) != len(that1.ShardOwnershipOverrides) {
+		return false
+	}
+	for i := range this.ShardOwnershipOverrides {
+		if !this.ShardOwnershipOverrides[i].Equal(that1.ShardOwnershipOverrides[i]) {
+			return false
+		}
+	}
+	if len(this.DynamicConfigOverrides) != len(that1.DynamicConfigOverrides) {
+		return false
+	}
+	for i := range this.DynamicConfigOverrides {
+		if !this.DynamicConfigOverrides[i].Equal(that1.DynamicConfigOverrides[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *IndexSearchAttributes) Equal(that interface{}) bool {
@@ -364,11 +641,121 @@ func (this *IndexSearchAttributes) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ShardOwnershipOverride) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShardOwnershipOverride)
+	if !ok {
+		that2, ok := that.(ShardOwnershipOverride)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.HostAddress != that1.HostAddress {
+		return false
+	}
+	if that1.ExpireTime == nil {
+		if this.ExpireTime != nil {
+			return false
+		}
+	} else if !this.ExpireTime.Equal(*that1.ExpireTime) {
+		return false
+	}
+	return true
+}
+func (this *DynamicConfigOverride) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DynamicConfigOverride)
+	if !ok {
+		that2, ok := that.(DynamicConfigOverride)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if !this.Constraints.Equal(that1.Constraints) {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if that1.ExpireTime == nil {
+		if this.ExpireTime != nil {
+			return false
+		}
+	} else if !this.ExpireTime.Equal(*that1.ExpireTime) {
+		return false
+	}
+	return true
+}
+func (this *DynamicConfigConstraints) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DynamicConfigConstraints)
+	if !ok {
+		that2, ok := that.(DynamicConfigConstraints)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.TaskQueueName != that1.TaskQueueName {
+		return false
+	}
+	if this.TaskQueueType != that1.TaskQueueType {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.TaskType != that1.TaskType {
+		return false
+	}
+	return true
+}
 func (this *ClusterMetadata) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 15)
+	s := make([]string, 0, 17)
 	s = append(s, "&persistence.ClusterMetadata{")
 	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
 	s = append(s, "HistoryShardCount: "+fmt.Sprintf("%#v", this.HistoryShardCount)+",\n")
@@ -395,6 +782,12 @@ func (this *ClusterMetadata) GoString() string {
 	s = append(s, "IsGlobalNamespaceEnabled: "+fmt.Sprintf("%#v", this.IsGlobalNamespaceEnabled)+",\n")
 	s = append(s, "IsConnectionEnabled: "+fmt.Sprintf("%#v", this.IsConnectionEnabled)+",\n")
 	s = append(s, "UseClusterIdMembership: "+fmt.Sprintf("%#v", this.UseClusterIdMembership)+",\n")
+	if this.ShardOwnershipOverrides != nil {
+		s = append(s, "ShardOwnershipOverrides: "+fmt.Sprintf("%#v", this.ShardOwnershipOverrides)+",\n")
+	}
+	if this.DynamicConfigOverrides != nil {
+		s = append(s, "DynamicConfigOverrides: "+fmt.Sprintf("%#v", this.DynamicConfigOverrides)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -420,6 +813,48 @@ func (this *IndexSearchAttributes) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ShardOwnershipOverride) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&persistence.ShardOwnershipOverride{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
+	s = append(s, "ExpireTime: "+fmt.Sprintf("%#v", this.ExpireTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DynamicConfigOverride) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&persistence.DynamicConfigOverride{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	if this.Constraints != nil {
+		s = append(s, "Constraints: "+fmt.Sprintf("%#v", this.Constraints)+",\n")
+	}
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "ExpireTime: "+fmt.Sprintf("%#v", this.ExpireTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DynamicConfigConstraints) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&persistence.DynamicConfigConstraints{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueueName: "+fmt.Sprintf("%#v", this.TaskQueueName)+",\n")
+	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "TaskType: "+fmt.Sprintf("%#v", this.TaskType)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringClusterMetadata(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -448,11 +883,39 @@ func (m *ClusterMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.UseClusterIdMembership {
-		i--
-		if m.UseClusterIdMembership {
-			dAtA[i] = 1
-		} else {
+	if len(m.DynamicConfigOverrides) > 0 {
+		for iNdEx := len(m.DynamicConfigOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DynamicConfigOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ShardOwnershipOverrides) > 0 {
+		for iNdEx := len(m.ShardOwnershipOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShardOwnershipOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.UseClusterIdMembership {
+		i--
+		if m.UseClusterIdMembership {
+			dAtA[i] = 1
+		} else {
 			dAtA[i] = 0
 		}
 		i--
@@ -595,6 +1058,169 @@ func (m *IndexSearchAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ShardOwnershipOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardOwnershipOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardOwnershipOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpireTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpireTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.HostAddress) > 0 {
+		i -= len(m.HostAddress)
+		copy(dAtA[i:], m.HostAddress)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.HostAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicConfigOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicConfigOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicConfigOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpireTime != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpireTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Constraints != nil {
+		{
+			size, err := m.Constraints.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicConfigConstraints) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicConfigConstraints) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicConfigConstraints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskType != 0 {
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TaskQueueType != 0 {
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(m.TaskQueueType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TaskQueueName) > 0 {
+		i -= len(m.TaskQueueName)
+		copy(dAtA[i:], m.TaskQueueName)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.TaskQueueName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintClusterMetadata(dAtA []byte, offset int, v uint64) int {
 	offset -= sovClusterMetadata(v)
 	base := offset
@@ -659,6 +1285,18 @@ func (m *ClusterMetadata) Size() (n int) {
 	if m.UseClusterIdMembership {
 		n += 2
 	}
+	if len(m.ShardOwnershipOverrides) > 0 {
+		for _, e := range m.ShardOwnershipOverrides {
+			l = e.Size()
+			n += 1 + l + sovClusterMetadata(uint64(l))
+		}
+	}
+	if len(m.DynamicConfigOverrides) > 0 {
+		for _, e := range m.DynamicConfigOverrides {
+			l = e.Size()
+			n += 1 + l + sovClusterMetadata(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -679,6 +1317,81 @@ func (m *IndexSearchAttributes) Size() (n int) {
 	return n
 }
 
+func (m *ShardOwnershipOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovClusterMetadata(uint64(m.ShardId))
+	}
+	l = len(m.HostAddress)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if m.ExpireTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *DynamicConfigOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if m.Constraints != nil {
+		l = m.Constraints.Size()
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if m.ExpireTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *DynamicConfigConstraints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	l = len(m.TaskQueueName)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if m.TaskQueueType != 0 {
+		n += 1 + sovClusterMetadata(uint64(m.TaskQueueType))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovClusterMetadata(uint64(m.ShardId))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovClusterMetadata(uint64(m.TaskType))
+	}
+	return n
+}
+
 func sovClusterMetadata(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -689,6 +1402,16 @@ func (this *ClusterMetadata) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForShardOwnershipOverrides := "[]*ShardOwnershipOverride{"
+	for _, f := range this.ShardOwnershipOverrides {
+		repeatedStringForShardOwnershipOverrides += strings.Replace(f.String(), "ShardOwnershipOverride", "ShardOwnershipOverride", 1) + ","
+	}
+	repeatedStringForShardOwnershipOverrides += "}"
+	repeatedStringForDynamicConfigOverrides := "[]*DynamicConfigOverride{"
+	for _, f := range this.DynamicConfigOverrides {
+		repeatedStringForDynamicConfigOverrides += strings.Replace(f.String(), "DynamicConfigOverride", "DynamicConfigOverride", 1) + ","
+	}
+	repeatedStringForDynamicConfigOverrides += "}"
 	keysForIndexSearchAttributes := make([]string, 0, len(this.IndexSearchAttributes))
 	for k, _ := range this.IndexSearchAttributes {
 		keysForIndexSearchAttributes = append(keysForIndexSearchAttributes, k)
@@ -711,6 +1434,8 @@ func (this *ClusterMetadata) String() string {
 		`IsGlobalNamespaceEnabled:` + fmt.Sprintf("%v", this.IsGlobalNamespaceEnabled) + `,`,
 		`IsConnectionEnabled:` + fmt.Sprintf("%v", this.IsConnectionEnabled) + `,`,
 		`UseClusterIdMembership:` + fmt.Sprintf("%v", this.UseClusterIdMembership) + `,`,
+		`ShardOwnershipOverrides:` + repeatedStringForShardOwnershipOverrides + `,`,
+		`DynamicConfigOverrides:` + repeatedStringForDynamicConfigOverrides + `,`,
 		`}`,
 	}, "")
 	return s
@@ -735,6 +1460,46 @@ func (this *IndexSearchAttributes) String() string {
 	}, "")
 	return s
 }
+func (this *ShardOwnershipOverride) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShardOwnershipOverride{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
+		`ExpireTime:` + strings.Replace(fmt.Sprintf("%v", this.ExpireTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DynamicConfigOverride) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DynamicConfigOverride{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Constraints:` + strings.Replace(this.Constraints.String(), "DynamicConfigConstraints", "DynamicConfigConstraints", 1) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`ExpireTime:` + strings.Replace(fmt.Sprintf("%v", this.ExpireTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DynamicConfigConstraints) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DynamicConfigConstraints{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueueName:` + fmt.Sprintf("%v", this.TaskQueueName) + `,`,
+		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`TaskType:` + fmt.Sprintf("%v", this.TaskType) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringClusterMetadata(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1150,6 +1915,74 @@ func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.UseClusterIdMembership = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardOwnershipOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShardOwnershipOverrides = append(m.ShardOwnershipOverrides, &ShardOwnershipOverride{})
+			if err := m.ShardOwnershipOverrides[len(m.ShardOwnershipOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicConfigOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DynamicConfigOverrides = append(m.DynamicConfigOverrides, &DynamicConfigOverride{})
+			if err := m.DynamicConfigOverrides[len(m.DynamicConfigOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
@@ -1340,6 +2173,541 @@ func (m *IndexSearchAttributes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ShardOwnershipOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardOwnershipOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardOwnershipOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicConfigOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicConfigOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicConfigOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Constraints == nil {
+				m.Constraints = &DynamicConfigConstraints{}
+			}
+			if err := m.Constraints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicConfigConstraints) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicConfigConstraints: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicConfigConstraints: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskQueueName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
+			}
+			m.TaskQueueType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskQueueType |= v11.TaskQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= v12.TaskType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipClusterMetadata(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -241,6 +241,10 @@ type WorkflowExecutionInfo struct {
 	CloseVisibilityTaskId        int64      `protobuf:"varint,65,opt,name=close_visibility_task_id,json=closeVisibilityTaskId,proto3" json:"close_visibility_task_id,omitempty"`
 	CloseTime                    *time.Time `protobuf:"bytes,66,opt,name=close_time,json=closeTime,proto3,stdtime" json:"close_time,omitempty"`
 	CloseVisibilityTaskCompleted bool       `protobuf:"varint,67,opt,name=close_visibility_task_completed,json=closeVisibilityTaskCompleted,proto3" json:"close_visibility_task_completed,omitempty"`
+	// Priority of the workflow tasks of this execution, see common/taskpriority.
+	Priority int32 `protobuf:"varint,68,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Fairness key of the workflow tasks of this execution, see common/taskfairness.
+	FairnessKey string `protobuf:"bytes,69,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 }
 
 func (m *WorkflowExecutionInfo) Reset()      { *m = WorkflowExecutionInfo{} }
@@ -674,6 +678,20 @@ func (m *WorkflowExecutionInfo) GetCloseVisibilityTaskCompleted() bool {
 	return false
 }
 
+func (m *WorkflowExecutionInfo) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionInfo) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 type ExecutionStats struct {
 	HistorySize int64 `protobuf:"varint,1,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
 }
@@ -1544,6 +1562,10 @@ type ActivityInfo struct {
 	ScheduledEventId            int64          `protobuf:"varint,30,opt,name=scheduled_event_id,json=scheduledEventId,proto3" json:"scheduled_event_id,omitempty"`
 	LastHeartbeatDetails        *v11.Payloads  `protobuf:"bytes,31,opt,name=last_heartbeat_details,json=lastHeartbeatDetails,proto3" json:"last_heartbeat_details,omitempty"`
 	LastHeartbeatUpdateTime     *time.Time     `protobuf:"bytes,32,opt,name=last_heartbeat_update_time,json=lastHeartbeatUpdateTime,proto3,stdtime" json:"last_heartbeat_update_time,omitempty"`
+	// Priority of the activity task, defaults to the priority of the workflow.
+	Priority int32 `protobuf:"varint,33,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Fairness key of the activity task, defaults to the fairness key of the workflow.
+	FairnessKey string `protobuf:"bytes,34,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 }
 
 func (m *ActivityInfo) Reset()      { *m = ActivityInfo{} }
@@ -1781,6 +1803,20 @@ func (m *ActivityInfo) GetLastHeartbeatUpdateTime() *time.Time {
 	return nil
 }
 
+func (m *ActivityInfo) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *ActivityInfo) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 // timer_map column
 type TimerInfo struct {
 	Version        int64      `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -2215,223 +2251,226 @@ func init() {
 }
 
 var fileDescriptor_67a714d0e7ba9f37 = []byte{
-	// 3454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4d, 0x73, 0xdb, 0xd6,
-	0xb5, 0xa6, 0x05, 0x89, 0xe0, 0x21, 0x45, 0x41, 0xd0, 0x17, 0xa4, 0xc8, 0x94, 0xcc, 0xd8, 0x89,
-	0x9c, 0x38, 0x54, 0x24, 0x3b, 0xcf, 0xf9, 0x78, 0x2f, 0x7e, 0x92, 0x6c, 0x27, 0xe4, 0x73, 0x1c,
-	0x07, 0x52, 0xe2, 0x4c, 0xde, 0xcb, 0x70, 0x20, 0xe0, 0x52, 0xc2, 0x23, 0x08, 0xd0, 0x00, 0x28,
-	0x99, 0x99, 0x2e, 0xb2, 0xe8, 0x74, 0x9d, 0xee, 0xfa, 0x13, 0xba, 0xec, 0xa6, 0xfb, 0x2e, 0x32,
-	0x9d, 0xae, 0x3a, 0xd9, 0x35, 0xbb, 0x36, 0xce, 0xa6, 0x9b, 0x4e, 0x32, 0xfd, 0x05, 0x9d, 0x7b,
-	0xee, 0xbd, 0x20, 0x00, 0x42, 0x12, 0xe5, 0xc6, 0x8b, 0xec, 0x88, 0x7b, 0x3e, 0xee, 0x39, 0xe7,
-	0x9e, 0x7b, 0xbe, 0x2e, 0xe1, 0x46, 0x48, 0x3a, 0x5d, 0xcf, 0x37, 0x9c, 0xf5, 0x80, 0xf8, 0x47,
-	0xc4, 0x5f, 0x37, 0xba, 0xf6, 0x7a, 0x97, 0xf8, 0x81, 0x1d, 0x84, 0xc4, 0x35, 0xc9, 0xfa, 0xd1,
-	0xc6, 0x3a, 0x79, 0x42, 0xcc, 0x5e, 0x68, 0x7b, 0x6e, 0x50, 0xeb, 0xfa, 0x5e, 0xe8, 0xa9, 0x55,
-	0x41, 0x54, 0x63, 0x44, 0x35, 0xa3, 0x6b, 0xd7, 0x62, 0x44, 0xb5, 0xa3, 0x8d, 0xa5, 0xca, 0x81,
-	0xe7, 0x1d, 0x38, 0x64, 0x1d, 0x29, 0xf6, 0x7b, 0xad, 0x75, 0xab, 0xe7, 0x1b, 0x94, 0x09, 0xe3,
-	0xb1, 0xb4, 0x92, 0x86, 0x87, 0x76, 0x87, 0x04, 0xa1, 0xd1, 0xe9, 0x72, 0x84, 0xcb, 0x16, 0xe9,
-	0x12, 0xd7, 0x22, 0xae, 0x69, 0x93, 0x60, 0xfd, 0xc0, 0x3b, 0xf0, 0x70, 0x1d, 0x7f, 0x71, 0x94,
-	0x2b, 0x91, 0xf0, 0x54, 0x6a, 0xd3, 0xeb, 0x74, 0x3c, 0x97, 0x0a, 0xdc, 0x21, 0x41, 0x60, 0x1c,
-	0x90, 0x4c, 0x2c, 0xe2, 0xf6, 0x3a, 0x01, 0x45, 0x3a, 0xf6, 0xfc, 0x76, 0xcb, 0xf1, 0x8e, 0x39,
-	0xd6, 0xd5, 0x04, 0x56, 0xcb, 0xb0, 0x9d, 0x9e, 0x4f, 0x86, 0x99, 0xbd, 0x94, 0x40, 0x13, 0x3c,
-	0x86, 0xf1, 0x5e, 0xc9, 0xb2, 0xab, 0xe9, 0x78, 0x66, 0x7b, 0x18, 0xf7, 0x5a, 0x16, 0x6e, 0x24,
-	0x27, 0x53, 0x8b, 0xa3, 0xbe, 0x7a, 0x2a, 0x6a, 0x4a, 0xa5, 0x97, 0x4f, 0x45, 0x0e, 0x8d, 0xa0,
-	0xcd, 0x11, 0xaf, 0x67, 0x21, 0x1e, 0xda, 0x41, 0xe8, 0xf9, 0xfd, 0x61, 0x71, 0xd7, 0x47, 0x70,
-	0x99, 0xc7, 0x3d, 0xd2, 0x23, 0xdc, 0x5d, 0xaa, 0x7f, 0xcc, 0x43, 0x61, 0xf7, 0xd0, 0xf0, 0xad,
-	0xba, 0xdb, 0xf2, 0xd4, 0x45, 0x90, 0x03, 0xfa, 0xd1, 0xb4, 0x2d, 0x2d, 0xb7, 0x9a, 0x5b, 0x1b,
-	0xd7, 0xf3, 0xf8, 0x5d, 0xb7, 0x28, 0xc8, 0x37, 0xdc, 0x03, 0x42, 0x41, 0x17, 0x57, 0x73, 0x6b,
-	0x63, 0x7a, 0x1e, 0xbf, 0xeb, 0x96, 0x3a, 0x0b, 0xe3, 0xde, 0xb1, 0x4b, 0x7c, 0x6d, 0x6c, 0x35,
-	0xb7, 0x56, 0xd0, 0xd9, 0x87, 0x7a, 0x1d, 0xd4, 0x20, 0xf4, 0x1c, 0xe2, 0x36, 0x03, 0xdb, 0x35,
-	0x49, 0xd3, 0x27, 0x2e, 0x39, 0xd6, 0x26, 0x90, 0xab, 0xc2, 0x20, 0xbb, 0x14, 0xa0, 0xd3, 0x75,
-	0x75, 0x0b, 0x8a, 0xbd, 0xae, 0x65, 0x84, 0xa4, 0x49, 0x7d, 0x4d, 0xcb, 0xaf, 0xe6, 0xd6, 0x8a,
-	0x9b, 0x4b, 0x35, 0xe6, 0x88, 0x35, 0xe1, 0x88, 0xb5, 0x3d, 0xe1, 0x88, 0xdb, 0xd2, 0x57, 0x7f,
-	0x5d, 0xc9, 0xe9, 0xc0, 0x88, 0xe8, 0xb2, 0x7a, 0x07, 0x2a, 0xae, 0xd1, 0x21, 0x41, 0xd7, 0x30,
-	0x49, 0xd3, 0xf5, 0x42, 0xbb, 0x65, 0x9b, 0xe8, 0xd5, 0xcd, 0x23, 0x6a, 0x00, 0xcf, 0xd5, 0x0a,
-	0x28, 0xf7, 0x72, 0x84, 0xf5, 0x20, 0x86, 0xf4, 0x09, 0xc3, 0x51, 0x7f, 0x99, 0x83, 0x45, 0x9f,
-	0x74, 0x1d, 0x41, 0x6b, 0x39, 0x8f, 0x9b, 0x86, 0xd9, 0x6e, 0x3a, 0xe4, 0x88, 0x38, 0xda, 0xe4,
-	0xea, 0xd8, 0x5a, 0x71, 0xb3, 0x5e, 0x3b, 0xfb, 0x92, 0xd5, 0x22, 0xab, 0xd6, 0xf4, 0x01, 0xbb,
-	0x3b, 0xce, 0xe3, 0x2d, 0xb3, 0x7d, 0x9f, 0xf2, 0xba, 0xeb, 0x86, 0x7e, 0x5f, 0x9f, 0xf7, 0x33,
-	0x81, 0x6a, 0x1b, 0x14, 0x3c, 0xa7, 0xc1, 0xde, 0x81, 0xa6, 0xe0, 0xe6, 0x5b, 0xe7, 0xdb, 0xfc,
-	0x23, 0xca, 0x45, 0xb0, 0x0d, 0xd8, 0xa6, 0xe5, 0xc7, 0x89, 0x45, 0xd5, 0x80, 0x12, 0xdb, 0x2c,
-	0x08, 0x8d, 0x90, 0x04, 0xda, 0x34, 0x6e, 0xf4, 0xee, 0x33, 0x6c, 0xb4, 0x8b, 0x0c, 0xd8, 0x2e,
-	0xc5, 0xc7, 0x83, 0x95, 0xa5, 0x3a, 0xbc, 0x70, 0x8a, 0x19, 0x54, 0x05, 0xc6, 0xda, 0xa4, 0x8f,
-	0x3e, 0x57, 0xd0, 0xe9, 0x4f, 0xea, 0x54, 0x47, 0x86, 0xd3, 0x23, 0xdc, 0xd9, 0xd8, 0xc7, 0xdb,
-	0x17, 0xdf, 0xcc, 0x2d, 0x85, 0x30, 0x93, 0xa1, 0x54, 0x9c, 0xc5, 0x38, 0x63, 0xf1, 0x5e, 0x9c,
-	0x45, 0x71, 0x73, 0x63, 0x14, 0x7d, 0x12, 0x9c, 0xe3, 0xbb, 0xba, 0xa0, 0xa4, 0x35, 0xcc, 0xd8,
-	0xf2, 0x4e, 0x72, 0xcb, 0xda, 0xc8, 0x5b, 0x22, 0xdb, 0xd8, 0x7e, 0x0d, 0x49, 0x96, 0x94, 0xf1,
-	0x86, 0x24, 0x8f, 0x2b, 0x13, 0x0d, 0x49, 0x96, 0x95, 0x42, 0x43, 0x92, 0x41, 0x29, 0x36, 0x24,
-	0xb9, 0xa8, 0x94, 0x1a, 0x92, 0x5c, 0x52, 0x26, 0x1b, 0x92, 0x5c, 0x56, 0xa6, 0x1a, 0x92, 0x3c,
-	0xa5, 0x28, 0xd5, 0xaf, 0x57, 0x60, 0xee, 0x11, 0x8f, 0x31, 0x77, 0x45, 0x52, 0xc0, 0x4b, 0x7d,
-	0x19, 0x4a, 0x83, 0x7b, 0xc1, 0x2f, 0x76, 0x41, 0x2f, 0x46, 0x6b, 0x75, 0x4b, 0x5d, 0x81, 0xa2,
-	0x88, 0x4f, 0xe2, 0x7e, 0x17, 0x74, 0x10, 0x4b, 0x75, 0x4b, 0xad, 0xc1, 0x4c, 0xd7, 0xf0, 0x89,
-	0x1b, 0x36, 0x13, 0xac, 0xd8, 0x85, 0x9f, 0x66, 0xa0, 0x07, 0x31, 0x86, 0xd7, 0x41, 0xe5, 0xf8,
-	0x71, 0xbe, 0x12, 0xa2, 0x2b, 0x0c, 0xf2, 0x68, 0xc0, 0xbd, 0x0a, 0x93, 0x1c, 0xdb, 0xef, 0xb9,
-	0x14, 0x71, 0x9c, 0x89, 0xc8, 0x16, 0xf5, 0x9e, 0x9b, 0x90, 0xc0, 0x76, 0xed, 0xd0, 0x36, 0x42,
-	0x82, 0x51, 0x6a, 0x02, 0xbd, 0x83, 0x4b, 0x50, 0x17, 0x90, 0xba, 0xa5, 0xbe, 0x05, 0x8b, 0xa6,
-	0xd7, 0xe9, 0x3a, 0x04, 0x6f, 0x31, 0x39, 0xa2, 0x94, 0xfb, 0x46, 0x68, 0x1e, 0x52, 0xaa, 0x3c,
-	0x52, 0xcd, 0x0f, 0x10, 0xee, 0x52, 0xf8, 0x36, 0x05, 0xd7, 0x2d, 0xf5, 0x12, 0x00, 0x0d, 0xc0,
-	0x4d, 0xf4, 0x5f, 0x0c, 0x1a, 0x05, 0xbd, 0x40, 0x57, 0xf0, 0xa4, 0xa8, 0x6e, 0x91, 0x52, 0x61,
-	0xbf, 0x4b, 0xd0, 0x24, 0x1a, 0x30, 0xdd, 0x04, 0x64, 0xaf, 0xdf, 0x25, 0xd4, 0x20, 0xea, 0xe7,
-	0xb0, 0x14, 0x61, 0x47, 0xc9, 0x1a, 0x83, 0x9c, 0xd7, 0x0b, 0xb5, 0x22, 0xba, 0xc9, 0xe2, 0x50,
-	0x9c, 0xbb, 0xc3, 0x13, 0xf2, 0xb6, 0xf4, 0x1b, 0x1a, 0xe6, 0xb4, 0xe3, 0xf4, 0xc9, 0xee, 0x31,
-	0x06, 0xea, 0x47, 0x30, 0x1b, 0xb1, 0xa7, 0xc6, 0x13, 0x8c, 0x4b, 0xa3, 0x31, 0x8e, 0x34, 0xd1,
-	0x7b, 0x11, 0xcb, 0x7d, 0xb8, 0x64, 0x91, 0x96, 0xd1, 0x73, 0x62, 0x87, 0x87, 0xf6, 0x10, 0xbc,
-	0x27, 0x47, 0xe3, 0xbd, 0xc4, 0xb9, 0x88, 0x83, 0xde, 0x33, 0x82, 0xb6, 0xd8, 0xe3, 0x55, 0x50,
-	0x1d, 0x23, 0x08, 0xf9, 0xb9, 0x20, 0x77, 0xdb, 0xd2, 0xa6, 0xf1, 0x58, 0xa6, 0x28, 0x04, 0x0f,
-	0x84, 0x52, 0xd4, 0x2d, 0xf5, 0x35, 0x98, 0x41, 0xe4, 0x96, 0xed, 0x47, 0x24, 0xb6, 0xa5, 0xa9,
-	0x88, 0xad, 0x50, 0xd0, 0x3d, 0x0a, 0x41, 0x92, 0xba, 0xa5, 0xfe, 0x0f, 0xbc, 0x88, 0xe8, 0x49,
-	0xe1, 0x83, 0xd0, 0xf0, 0xa9, 0xcf, 0x44, 0xe4, 0x33, 0x48, 0x5e, 0xa1, 0xa8, 0x71, 0x09, 0x77,
-	0x19, 0x9e, 0x60, 0x76, 0x1b, 0x00, 0x29, 0x59, 0x5a, 0x9a, 0x1d, 0x31, 0x2d, 0x15, 0x90, 0x06,
-	0xb3, 0x52, 0x03, 0x50, 0xc2, 0x66, 0x3c, 0xbb, 0xcd, 0x8d, 0xc8, 0xa6, 0x4c, 0x29, 0x3f, 0x1e,
-	0x64, 0xb8, 0x4d, 0x98, 0x4b, 0x2a, 0x25, 0x12, 0xdb, 0x3c, 0xea, 0x32, 0x73, 0x1c, 0xd3, 0x43,
-	0xe4, 0xb3, 0x7b, 0xb0, 0x9a, 0x32, 0x84, 0x79, 0x48, 0xac, 0x9e, 0x13, 0x37, 0xc5, 0x02, 0xcb,
-	0x8b, 0x71, 0xf2, 0x5d, 0x81, 0x25, 0x0c, 0xb1, 0x0d, 0x95, 0x33, 0x0c, 0xaa, 0x21, 0x97, 0xa5,
-	0xe3, 0x93, 0x8d, 0xb9, 0x9b, 0x96, 0x5f, 0x78, 0xd4, 0xe2, 0x68, 0x1e, 0x95, 0x50, 0x50, 0xb8,
-	0xd2, 0x90, 0x51, 0x8c, 0x90, 0x06, 0xdd, 0x50, 0x5b, 0xc2, 0xb0, 0x9c, 0xa0, 0xd9, 0x62, 0xa0,
-	0xc4, 0xa5, 0x4c, 0x28, 0x83, 0xc7, 0xf3, 0xc2, 0x88, 0xc7, 0xb3, 0x90, 0xa1, 0x2a, 0x9e, 0x93,
-	0x01, 0xcb, 0x27, 0xd9, 0x1c, 0x37, 0x58, 0x1e, 0x71, 0x83, 0xc5, 0xcc, 0x13, 0xc1, 0x2d, 0xae,
-	0x81, 0x62, 0x1a, 0xae, 0x49, 0x9c, 0xa6, 0x4f, 0x1e, 0xf7, 0x48, 0x10, 0x12, 0x4b, 0xbb, 0xb4,
-	0x9a, 0x5b, 0x93, 0xf5, 0x29, 0xb6, 0xae, 0x8b, 0x65, 0xd5, 0x87, 0xab, 0x49, 0x69, 0x3c, 0xdf,
-	0x3e, 0xb0, 0x5d, 0xc3, 0x49, 0x8b, 0x55, 0x19, 0x51, 0xac, 0xcb, 0x71, 0xb1, 0x3e, 0xe4, 0xcc,
-	0x92, 0xe2, 0xdd, 0x02, 0x2d, 0xb9, 0x27, 0x97, 0x92, 0xfa, 0xc9, 0x0a, 0x46, 0xca, 0xb9, 0x38,
-	0x13, 0x2e, 0x6c, 0xdd, 0x52, 0x5f, 0x81, 0xe9, 0xa4, 0x5e, 0x94, 0x62, 0x15, 0x29, 0x92, 0x8a,
-	0x31, 0xdc, 0x20, 0xb4, 0xcd, 0x76, 0xbf, 0x19, 0x0b, 0xd7, 0x97, 0x19, 0x2e, 0x03, 0xec, 0x45,
-	0x41, 0xfb, 0x00, 0x56, 0x39, 0xae, 0x50, 0xba, 0x19, 0x7a, 0xcd, 0xc1, 0xd5, 0xa6, 0x5e, 0x58,
-	0x1d, 0xcd, 0x0b, 0x97, 0x19, 0x23, 0xa1, 0xf0, 0x9e, 0xb7, 0x2b, 0x2e, 0x3b, 0x75, 0x47, 0x0d,
-	0xf2, 0xc2, 0x01, 0x5f, 0x64, 0x15, 0x34, 0xff, 0x54, 0x3f, 0x86, 0x79, 0x9f, 0x84, 0x7e, 0x9f,
-	0x27, 0x30, 0xa7, 0x69, 0xbb, 0x21, 0xf1, 0x8f, 0x0c, 0x47, 0xbb, 0x32, 0xda, 0xc6, 0xb3, 0x48,
-	0xce, 0x92, 0x9c, 0x53, 0xe7, 0xc4, 0x03, 0xb6, 0x1d, 0xe3, 0x89, 0xdd, 0xe9, 0x75, 0x06, 0x6c,
-	0xaf, 0x9e, 0x87, 0xed, 0x07, 0x8c, 0x3a, 0x62, 0x7b, 0x33, 0xcd, 0x96, 0xab, 0x11, 0x68, 0x2f,
-	0xa1, 0x5a, 0x09, 0x2a, 0x7e, 0xaf, 0x02, 0xf5, 0x6d, 0x5a, 0x3c, 0x53, 0xaa, 0x7d, 0xc3, 0x6c,
-	0x7b, 0xad, 0x56, 0xd3, 0xf4, 0x48, 0xab, 0x65, 0x9b, 0x36, 0x71, 0x43, 0xed, 0xe5, 0xd5, 0xdc,
-	0x5a, 0x4e, 0x5f, 0x40, 0x84, 0x6d, 0x06, 0xdf, 0x19, 0x80, 0xd5, 0x0e, 0x54, 0x33, 0x32, 0x25,
-	0x79, 0xd2, 0xb5, 0x99, 0xb8, 0xcc, 0x49, 0xd7, 0x46, 0x74, 0xd2, 0x95, 0xa1, 0x94, 0x79, 0x37,
-	0xe2, 0xc4, 0xdb, 0x85, 0x15, 0x26, 0xaa, 0xeb, 0xb9, 0x4d, 0xfc, 0x65, 0xec, 0x3b, 0xa4, 0x49,
-	0x7c, 0xdf, 0xf3, 0x31, 0xaf, 0x07, 0xda, 0xb5, 0xd5, 0xb1, 0xb5, 0x82, 0xfe, 0x02, 0x02, 0x1f,
-	0x78, 0xae, 0x2e, 0x90, 0xee, 0x52, 0x1c, 0x9a, 0xe1, 0x03, 0x75, 0x0d, 0x94, 0x43, 0x23, 0x60,
-	0xf4, 0xcd, 0xae, 0xe7, 0xd8, 0x66, 0x5f, 0x7b, 0x05, 0xef, 0x61, 0xf9, 0xd0, 0x08, 0x90, 0xe2,
-	0x21, 0xae, 0xaa, 0x2f, 0xc2, 0xa4, 0xe9, 0x7b, 0x6e, 0xe4, 0x7f, 0xda, 0xab, 0xe8, 0xa9, 0x25,
-	0xba, 0x28, 0x7c, 0x89, 0xd6, 0x6a, 0x81, 0x7d, 0x40, 0xef, 0xa6, 0xe9, 0xf5, 0xdc, 0x50, 0xab,
-	0x61, 0x4c, 0x2d, 0xb2, 0xb5, 0x1d, 0xba, 0xa4, 0x7e, 0x04, 0xd3, 0x46, 0x2f, 0xf4, 0x9a, 0x3e,
-	0x09, 0x48, 0xd8, 0xec, 0x7a, 0xb6, 0x1b, 0x06, 0xda, 0x0d, 0xb4, 0xca, 0xd5, 0x41, 0xb9, 0x49,
-	0xeb, 0xcc, 0xa8, 0xe5, 0x3c, 0xda, 0xa8, 0xe9, 0x14, 0xfb, 0x21, 0x22, 0xeb, 0x53, 0x94, 0x3e,
-	0xb6, 0xa0, 0xfe, 0x02, 0xa6, 0x03, 0x62, 0xf8, 0xe6, 0x21, 0x3d, 0x64, 0xdf, 0xde, 0xef, 0xd1,
-	0x26, 0xe0, 0x26, 0x36, 0x01, 0x1f, 0x8e, 0x52, 0xc1, 0x66, 0xd6, 0x9d, 0xb5, 0x5d, 0x64, 0xb9,
-	0x15, 0x71, 0x64, 0x5d, 0x81, 0x12, 0xa4, 0x96, 0xd5, 0x47, 0x20, 0x75, 0x48, 0xc7, 0xd3, 0xde,
-	0xc0, 0x0d, 0x77, 0x9e, 0x7d, 0xc3, 0x0f, 0x48, 0xc7, 0x63, 0x9b, 0x20, 0x43, 0xf5, 0x73, 0x98,
-	0xe6, 0x09, 0xb2, 0xc9, 0x1a, 0x66, 0x9b, 0x04, 0xda, 0x7f, 0xa0, 0xa5, 0x5e, 0xcf, 0xdc, 0x85,
-	0xb7, 0xd5, 0x74, 0x07, 0x9e, 0x3e, 0xdf, 0x17, 0x74, 0xba, 0x72, 0x94, 0x5a, 0x51, 0x6f, 0xc0,
-	0x3c, 0xaf, 0x48, 0x22, 0x67, 0xe5, 0xe5, 0xeb, 0x2d, 0x3c, 0xd9, 0x19, 0x84, 0x46, 0x22, 0xb2,
-	0x32, 0xf6, 0x7f, 0x61, 0x6a, 0x80, 0x4e, 0xdb, 0xad, 0x40, 0x7b, 0x13, 0x25, 0xda, 0x1c, 0x45,
-	0xef, 0x88, 0x19, 0x6d, 0x17, 0x02, 0xbd, 0x4c, 0x12, 0xdf, 0x89, 0xbc, 0x43, 0x45, 0x49, 0xdf,
-	0x9d, 0xb7, 0xce, 0x9b, 0x77, 0xf4, 0x5e, 0xfa, 0xd6, 0xdc, 0x84, 0x85, 0xa1, 0x5a, 0x2c, 0x7c,
-	0x82, 0x5a, 0xbf, 0xcd, 0x8a, 0x90, 0x64, 0x3d, 0xb6, 0xf7, 0x84, 0x6a, 0x7d, 0x13, 0xe6, 0xb1,
-	0xb5, 0x6c, 0x86, 0xbe, 0xe1, 0x06, 0x36, 0x4a, 0xc4, 0x1c, 0xfc, 0x1d, 0x24, 0x9a, 0x45, 0xe8,
-	0x5e, 0x04, 0x64, 0x9e, 0xfe, 0x1e, 0x94, 0x93, 0x15, 0xb3, 0xf6, 0x9f, 0x23, 0x2a, 0x30, 0x49,
-	0xe2, 0x75, 0xb2, 0xba, 0x0e, 0xb3, 0x2e, 0x39, 0x1e, 0x3e, 0xa7, 0xff, 0x62, 0xed, 0x8b, 0x4b,
-	0x8e, 0x53, 0xa7, 0x74, 0x1f, 0x4a, 0xbc, 0xd9, 0xc0, 0xb1, 0x90, 0xf6, 0x2e, 0xee, 0x7b, 0x2d,
-	0xf3, 0x88, 0x10, 0x83, 0xb9, 0x8c, 0x19, 0x7a, 0xfe, 0x0e, 0xfd, 0x14, 0xad, 0x0b, 0x7e, 0xa8,
-	0x6f, 0x82, 0x36, 0xd4, 0xba, 0x88, 0xca, 0xed, 0x36, 0xeb, 0x44, 0x52, 0xfd, 0x8b, 0x28, 0xde,
-	0x6e, 0xc0, 0xbc, 0xe9, 0x78, 0x01, 0xb7, 0x5b, 0x8b, 0xf8, 0x51, 0xa9, 0xfc, 0xdf, 0xcc, 0xd8,
-	0x08, 0xdd, 0xe3, 0x40, 0x5e, 0x2e, 0xdf, 0x02, 0x8d, 0x11, 0x1d, 0xd9, 0x81, 0xbd, 0x6f, 0x3b,
-	0x76, 0xd8, 0x8f, 0xc8, 0xb6, 0x90, 0x6c, 0x0e, 0xe1, 0x9f, 0x44, 0x60, 0x4e, 0x78, 0x1b, 0x80,
-	0xef, 0x46, 0x6d, 0xbd, 0x3d, 0x6a, 0xad, 0xcb, 0x64, 0xa0, 0x76, 0xbe, 0x0b, 0x2b, 0xd9, 0x3b,
-	0xf3, 0x46, 0x8b, 0x58, 0xda, 0x0e, 0xc6, 0xc6, 0xe5, 0x0c, 0x01, 0x76, 0x04, 0xce, 0x92, 0x05,
-	0x73, 0x99, 0xb1, 0x23, 0x63, 0x4a, 0xf0, 0x46, 0xb2, 0xdf, 0x5e, 0x49, 0x06, 0x40, 0x3e, 0x9e,
-	0x3b, 0xda, 0xa8, 0x3d, 0x34, 0xfa, 0x8e, 0x67, 0x58, 0xf1, 0x86, 0xfe, 0x53, 0x28, 0x44, 0x01,
-	0xe3, 0x27, 0xe5, 0x1c, 0xb5, 0xeb, 0x51, 0x73, 0xde, 0x90, 0x64, 0x45, 0x99, 0x6e, 0x48, 0xf2,
-	0x75, 0xe5, 0xb5, 0x86, 0x24, 0xbf, 0xa6, 0xd4, 0x1a, 0x92, 0xbc, 0xae, 0xbc, 0xde, 0x90, 0xe4,
-	0xd7, 0x95, 0x8d, 0x86, 0x24, 0x6f, 0x28, 0x9b, 0x0d, 0x49, 0xde, 0x54, 0x6e, 0x54, 0x6f, 0x40,
-	0x39, 0x79, 0xc9, 0x69, 0x4a, 0xe0, 0x71, 0xa9, 0x19, 0xd8, 0x5f, 0x10, 0x94, 0x71, 0x4c, 0x2f,
-	0xf2, 0xb5, 0x5d, 0xfb, 0x0b, 0x52, 0xfd, 0x21, 0x07, 0xf3, 0x43, 0x21, 0x11, 0x27, 0x0a, 0x58,
-	0x4f, 0xf9, 0x84, 0x5e, 0xbd, 0x58, 0x3d, 0x95, 0xe3, 0xf5, 0x14, 0x02, 0x06, 0xf5, 0xd4, 0x1c,
-	0x4c, 0xf0, 0x8b, 0xc1, 0x06, 0x00, 0xe3, 0x3e, 0x5e, 0x86, 0x06, 0x8c, 0xe3, 0xf5, 0xc4, 0x6e,
-	0xbf, 0xbc, 0x79, 0x33, 0xf3, 0x16, 0xe0, 0xe8, 0x32, 0x33, 0x34, 0xf3, 0xc9, 0x06, 0xb2, 0x50,
-	0xef, 0xc1, 0x04, 0xfd, 0xd1, 0x0b, 0x70, 0x16, 0x50, 0x8e, 0x0f, 0x48, 0xce, 0xe6, 0xd2, 0x0b,
-	0x74, 0x4e, 0x5d, 0xfd, 0xe7, 0x04, 0x28, 0x09, 0xb7, 0xff, 0xa9, 0x06, 0x1d, 0x03, 0x1b, 0x8c,
-	0xc5, 0x6d, 0xb0, 0x03, 0x05, 0xd6, 0xb0, 0xf4, 0xbb, 0x84, 0x8b, 0xfe, 0xd2, 0xe9, 0x76, 0xc0,
-	0x16, 0xa5, 0xdf, 0x25, 0xba, 0x1c, 0xf2, 0x5f, 0x6a, 0x0d, 0x66, 0x42, 0xc3, 0x3f, 0x20, 0xa9,
-	0x21, 0x0a, 0x1b, 0x76, 0x4c, 0x33, 0x50, 0x6a, 0x88, 0xc2, 0xf1, 0xe3, 0x32, 0x4f, 0xb0, 0x41,
-	0x03, 0x83, 0x24, 0x87, 0x28, 0x1c, 0x9b, 0x2b, 0x90, 0x67, 0xea, 0xb3, 0x45, 0x16, 0xd7, 0x92,
-	0x93, 0x0d, 0x39, 0x3d, 0xd9, 0x78, 0x07, 0x96, 0x38, 0x0b, 0xf3, 0xd0, 0x76, 0xac, 0xc1, 0xb6,
-	0x9e, 0xeb, 0xf4, 0x71, 0x10, 0x22, 0xeb, 0x0b, 0x0c, 0x63, 0x87, 0x22, 0x88, 0xdd, 0x3f, 0x74,
-	0x9d, 0x3e, 0xce, 0x7b, 0x87, 0x5b, 0x4b, 0x60, 0x4d, 0x7a, 0x90, 0x6e, 0x27, 0x35, 0xc8, 0x8b,
-	0x10, 0x58, 0x64, 0xd3, 0x64, 0xfe, 0xa9, 0x2e, 0x40, 0x5e, 0x44, 0xab, 0x12, 0x42, 0x26, 0x42,
-	0x16, 0x9e, 0xea, 0x30, 0x15, 0x8f, 0x2b, 0x34, 0x46, 0x4d, 0x8e, 0xda, 0x48, 0x0f, 0x08, 0x31,
-	0x50, 0x5d, 0x07, 0xd5, 0x22, 0x34, 0xd8, 0x34, 0x8d, 0x56, 0x48, 0xfc, 0x26, 0x86, 0x23, 0x6d,
-	0x0a, 0x15, 0x54, 0x18, 0x64, 0x8b, 0x02, 0x76, 0xe8, 0xba, 0xfa, 0xeb, 0x1c, 0xb0, 0x80, 0x15,
-	0x1f, 0xe0, 0x50, 0x11, 0x2d, 0x12, 0x1a, 0x36, 0x0e, 0x66, 0xa9, 0x18, 0x0f, 0x46, 0xc9, 0xe0,
-	0x69, 0xa7, 0xad, 0xe1, 0x16, 0x83, 0xb1, 0x8e, 0x11, 0xb4, 0xef, 0x30, 0xae, 0xef, 0x5f, 0xd0,
-	0x17, 0xcd, 0x93, 0x80, 0x4b, 0xff, 0x07, 0x8b, 0x27, 0x52, 0xaa, 0xb7, 0x61, 0xd9, 0x34, 0xdc,
-	0x66, 0xd0, 0xb6, 0xbb, 0xf1, 0x50, 0x4c, 0x43, 0xaa, 0x4d, 0x1b, 0x83, 0x1c, 0x2a, 0xba, 0x68,
-	0x1a, 0xee, 0x6e, 0xdb, 0xee, 0x0e, 0xc2, 0xf0, 0x16, 0x47, 0xd8, 0x2e, 0x43, 0x29, 0xae, 0x20,
-	0x8b, 0x65, 0xd5, 0xdf, 0x4b, 0x30, 0x13, 0x1b, 0xe2, 0xfe, 0x6c, 0xee, 0x5d, 0xcc, 0xd7, 0xc6,
-	0x93, 0xbe, 0x76, 0x05, 0xca, 0xa9, 0xa1, 0x12, 0x9b, 0x27, 0x96, 0x5a, 0xf1, 0x81, 0x52, 0x15,
-	0x26, 0x5d, 0xf2, 0x24, 0x86, 0xc4, 0xc6, 0x87, 0x45, 0xba, 0x28, 0x70, 0xb2, 0xbd, 0x5f, 0x3e,
-	0xc1, 0xfb, 0x2f, 0x43, 0x69, 0xdf, 0x37, 0x5c, 0xf3, 0xb0, 0x19, 0x7a, 0x6d, 0xc2, 0xae, 0x40,
-	0x49, 0x2f, 0xb2, 0xb5, 0x3d, 0xba, 0x24, 0x6a, 0x16, 0x6a, 0x94, 0x04, 0xea, 0x24, 0xa2, 0xd2,
-	0x9a, 0x45, 0xef, 0xb9, 0xdb, 0x31, 0x82, 0xd8, 0xbd, 0x99, 0x3a, 0xeb, 0xde, 0x28, 0xcf, 0x78,
-	0x6f, 0x96, 0x01, 0x84, 0x50, 0x7c, 0x5c, 0x57, 0xd0, 0x65, 0x26, 0x4a, 0xdd, 0x6a, 0x48, 0x72,
-	0x41, 0x81, 0x68, 0x4c, 0x1d, 0x0d, 0xa8, 0xab, 0xff, 0x18, 0x03, 0x35, 0x55, 0x6c, 0xfc, 0xbc,
-	0xdd, 0x26, 0x66, 0xea, 0x89, 0xb3, 0x4c, 0x9d, 0x7f, 0x46, 0x53, 0x27, 0x8b, 0x31, 0xf9, 0xfc,
-	0xc5, 0x58, 0x72, 0x72, 0x59, 0x38, 0xff, 0xe4, 0xf2, 0xb4, 0x3a, 0x12, 0x4e, 0xa9, 0x23, 0xab,
-	0x3f, 0x48, 0x30, 0x49, 0x39, 0xfc, 0x7c, 0x32, 0xf3, 0x5d, 0x28, 0xf1, 0x21, 0x10, 0xe3, 0x33,
-	0x8e, 0x7c, 0xaa, 0x27, 0x14, 0x27, 0x7c, 0xd4, 0x83, 0x3c, 0x8a, 0xe1, 0xe0, 0x43, 0x25, 0xb1,
-	0x51, 0xa4, 0x18, 0x80, 0x20, 0xbf, 0x09, 0xe4, 0xb7, 0x31, 0x5a, 0xe5, 0xc4, 0x47, 0x23, 0xc8,
-	0x3e, 0x9a, 0x5e, 0xc6, 0x16, 0xe3, 0x8e, 0x99, 0x4f, 0x3a, 0xe6, 0x35, 0x88, 0x62, 0x4d, 0x34,
-	0x06, 0x95, 0x71, 0x5c, 0x33, 0x25, 0xd6, 0xc5, 0x08, 0x74, 0x11, 0xe4, 0x28, 0x4c, 0xb1, 0x77,
-	0xd1, 0x3c, 0xe1, 0xd1, 0x29, 0xe6, 0xde, 0x70, 0x96, 0x7b, 0x17, 0x9f, 0xd1, 0xbd, 0xd3, 0x11,
-	0xb0, 0x34, 0x1c, 0x01, 0xaf, 0x81, 0x62, 0x38, 0x3e, 0x31, 0x2c, 0x91, 0xb9, 0x88, 0x85, 0xd1,
-	0x4f, 0xd6, 0xa7, 0xf8, 0xfa, 0x16, 0x5f, 0xae, 0xfe, 0xee, 0x22, 0x28, 0x22, 0x79, 0x45, 0x4e,
-	0x17, 0x53, 0x23, 0x97, 0x50, 0x23, 0xed, 0x8d, 0x17, 0xcf, 0xf4, 0xc6, 0xb1, 0x53, 0xbc, 0x51,
-	0x3a, 0xd1, 0x1b, 0xc7, 0xff, 0xfd, 0xc0, 0x33, 0x91, 0x3c, 0xdf, 0x9f, 0x2e, 0xbe, 0x54, 0x7f,
-	0x55, 0x86, 0xd2, 0x96, 0x19, 0xda, 0x47, 0x76, 0xd8, 0x47, 0x73, 0xc5, 0x76, 0xcd, 0x25, 0x77,
-	0xbd, 0x05, 0x5a, 0x3a, 0xb7, 0x45, 0x2f, 0x69, 0xec, 0x75, 0x76, 0x2e, 0x99, 0xe1, 0xc4, 0x43,
-	0xda, 0x7b, 0x50, 0x4e, 0x8d, 0x98, 0xa5, 0x51, 0x1b, 0xf8, 0x20, 0x31, 0x4e, 0x5e, 0x03, 0x65,
-	0xe8, 0xb9, 0x81, 0xc5, 0xe4, 0x72, 0x90, 0x7c, 0x62, 0xd8, 0x81, 0x52, 0x62, 0x96, 0x3f, 0xaa,
-	0x79, 0x8a, 0x41, 0x6c, 0x7e, 0xbf, 0x02, 0x45, 0x83, 0x9b, 0x46, 0x64, 0xf1, 0x82, 0x0e, 0x62,
-	0x89, 0xd5, 0xd1, 0xb1, 0x76, 0x8a, 0xbf, 0x10, 0xfa, 0x51, 0x23, 0xf5, 0x19, 0x2c, 0x9e, 0x3c,
-	0x65, 0x86, 0xd1, 0xa6, 0xb2, 0xf3, 0x41, 0xf6, 0x7c, 0x39, 0xc5, 0x7b, 0x90, 0x23, 0xce, 0xf1,
-	0x9c, 0x18, 0xe3, 0xbd, 0x23, 0xf2, 0x05, 0xe5, 0xbd, 0x87, 0x63, 0x1a, 0x2a, 0x6b, 0x9a, 0xf1,
-	0x88, 0xcf, 0x89, 0x33, 0x2c, 0x7b, 0x24, 0xb9, 0xde, 0x87, 0xe9, 0x43, 0x62, 0xf8, 0xe1, 0x3e,
-	0x31, 0xc2, 0xf3, 0xbe, 0x21, 0x2a, 0x11, 0xa5, 0xe0, 0x96, 0xf5, 0xf0, 0x51, 0xce, 0x7e, 0xf8,
-	0xc8, 0x7c, 0x4b, 0x60, 0xb5, 0x51, 0xd6, 0x5b, 0x02, 0x15, 0xcd, 0x8f, 0x9e, 0x83, 0x68, 0x8f,
-	0xaa, 0xb0, 0xd0, 0x19, 0x8a, 0x5c, 0xc6, 0x9a, 0xd0, 0xf8, 0x88, 0x7f, 0x3a, 0x39, 0xe2, 0x4f,
-	0xf6, 0x57, 0x6a, 0xba, 0xbf, 0xba, 0x36, 0x70, 0x63, 0xdb, 0x22, 0x6e, 0x68, 0x87, 0x7d, 0x7c,
-	0x86, 0xc4, 0xf7, 0x0a, 0x5c, 0xaf, 0xf3, 0xe5, 0xcc, 0xb9, 0xf2, 0x6c, 0xe6, 0x5c, 0xf9, 0xe4,
-	0x67, 0x85, 0xb9, 0xe7, 0xf3, 0xac, 0x30, 0xff, 0x7c, 0x9e, 0x15, 0x16, 0x4e, 0x79, 0x56, 0xd8,
-	0x83, 0x39, 0x46, 0x95, 0x9e, 0x68, 0x6a, 0x23, 0x5e, 0xef, 0x19, 0x24, 0x4f, 0xcd, 0x32, 0x4f,
-	0x7d, 0xac, 0x58, 0x3c, 0xfd, 0xb1, 0x62, 0x84, 0xd7, 0x83, 0xa5, 0xb3, 0x5f, 0x0f, 0x1e, 0x80,
-	0xca, 0xb8, 0xb0, 0x99, 0x2a, 0xfb, 0x63, 0x1b, 0x7f, 0x7f, 0x5c, 0x4d, 0x56, 0x1f, 0x1c, 0x48,
-	0x53, 0xc6, 0x3d, 0xf6, 0x53, 0x57, 0x90, 0xf6, 0xbe, 0x11, 0x84, 0x7c, 0x85, 0x36, 0xf0, 0x31,
-	0x7e, 0x34, 0x5d, 0x11, 0x7f, 0xe0, 0x6a, 0xcb, 0xe8, 0x6a, 0x0b, 0x11, 0xd5, 0x23, 0x84, 0x47,
-	0x2e, 0x97, 0xdd, 0xc2, 0x54, 0x4e, 0x68, 0x61, 0x3e, 0x81, 0x79, 0xdc, 0x64, 0x70, 0xb5, 0x45,
-	0x37, 0xbc, 0x92, 0x25, 0xfe, 0xd0, 0xc0, 0x2c, 0xd0, 0x67, 0x29, 0xfd, 0xfb, 0x82, 0x5c, 0xf4,
-	0xae, 0x9f, 0xc3, 0x52, 0x8a, 0x6f, 0xfc, 0xe5, 0x7c, 0x75, 0xd4, 0xa7, 0xd9, 0x04, 0xef, 0xc1,
-	0x13, 0x7a, 0x43, 0x92, 0xc7, 0x14, 0xa9, 0x21, 0xc9, 0x13, 0x4a, 0xbe, 0x21, 0xc9, 0x97, 0x94,
-	0x4a, 0xf5, 0xcf, 0x39, 0x28, 0x60, 0xb5, 0x7a, 0x46, 0x16, 0xcc, 0xca, 0x41, 0x17, 0x33, 0x73,
-	0xd0, 0x16, 0x14, 0xd1, 0x4f, 0x79, 0x86, 0x1e, 0x1b, 0xf5, 0xbf, 0x6c, 0x8c, 0x48, 0x64, 0xa0,
-	0x78, 0x20, 0x92, 0x70, 0x1f, 0x8c, 0x2d, 0x3c, 0x06, 0x2d, 0x82, 0xcc, 0xe2, 0x55, 0x34, 0x40,
-	0xca, 0xe3, 0x77, 0xdd, 0xaa, 0xfe, 0x45, 0x02, 0x15, 0xc7, 0x33, 0xc9, 0xbf, 0x01, 0x9d, 0x9a,
-	0xdf, 0x07, 0x83, 0xe9, 0xec, 0xfc, 0x1e, 0xc1, 0x13, 0xf9, 0x3d, 0xcb, 0x24, 0x63, 0x99, 0x26,
-	0xa9, 0xc1, 0x8c, 0xc0, 0x8c, 0xd7, 0x55, 0x7c, 0xf4, 0xc5, 0x41, 0xb1, 0x61, 0xd6, 0x15, 0x10,
-	0x1c, 0x44, 0xb3, 0xc9, 0xc6, 0x5e, 0x22, 0xb9, 0xb3, 0x71, 0x56, 0xe6, 0x70, 0x53, 0xce, 0x1e,
-	0x6e, 0x2e, 0x43, 0x21, 0x2a, 0xf0, 0x44, 0xc6, 0x8e, 0x16, 0xce, 0xf9, 0x9f, 0x9e, 0x4f, 0xa3,
-	0xff, 0x22, 0xb1, 0x2c, 0xc9, 0xe3, 0x73, 0x11, 0xeb, 0xbd, 0xb5, 0x13, 0xba, 0x86, 0x87, 0xe2,
-	0x45, 0x20, 0x20, 0x2c, 0x72, 0x8b, 0x7f, 0x2d, 0xc5, 0x96, 0xa8, 0x1c, 0xe9, 0xa3, 0x88, 0xe6,
-	0x60, 0x4a, 0xf2, 0x10, 0x70, 0x60, 0x3f, 0xce, 0xde, 0x27, 0x26, 0xcf, 0xfb, 0x3e, 0xc1, 0xe8,
-	0x86, 0x2a, 0xe1, 0xf2, 0x50, 0x25, 0x1c, 0xfd, 0x0f, 0x2d, 0xaf, 0xc8, 0xd5, 0x3f, 0xe4, 0x60,
-	0x9a, 0x5b, 0x74, 0x07, 0xf3, 0xe7, 0xf3, 0x72, 0xac, 0xcc, 0xcc, 0x3d, 0x96, 0xfd, 0x2f, 0x80,
-	0x6c, 0x93, 0x49, 0xd9, 0x26, 0xab, 0x7e, 0x9d, 0x03, 0xd8, 0xc5, 0xd7, 0xd4, 0xe7, 0x25, 0x7b,
-	0xb2, 0x36, 0x1c, 0x4b, 0xd7, 0x86, 0xd9, 0xe2, 0xe6, 0xb3, 0xc5, 0x4d, 0xfd, 0x0b, 0x90, 0x05,
-	0x2d, 0x59, 0x29, 0x54, 0xbf, 0xcc, 0x81, 0xbc, 0x73, 0x48, 0xcc, 0x76, 0xd0, 0xeb, 0xa4, 0x95,
-	0x18, 0x1f, 0x28, 0x71, 0x07, 0x26, 0x5a, 0x8e, 0x71, 0xe4, 0xf9, 0x28, 0x72, 0x79, 0xf3, 0xfa,
-	0xe9, 0xbd, 0x88, 0xe0, 0x78, 0x0f, 0x69, 0x74, 0x4e, 0x3b, 0xf8, 0x2b, 0xe6, 0x18, 0x36, 0x69,
-	0xec, 0x63, 0xfb, 0xff, 0xbf, 0xf9, 0xae, 0x72, 0xe1, 0xdb, 0xef, 0x2a, 0x17, 0x7e, 0xfc, 0xae,
-	0x92, 0xfb, 0xf2, 0x69, 0x25, 0xf7, 0xdb, 0xa7, 0x95, 0xdc, 0x9f, 0x9e, 0x56, 0x72, 0xdf, 0x3c,
-	0xad, 0xe4, 0xfe, 0xf6, 0xb4, 0x92, 0xfb, 0xfb, 0xd3, 0xca, 0x85, 0x1f, 0x9f, 0x56, 0x72, 0x5f,
-	0x7d, 0x5f, 0xb9, 0xf0, 0xcd, 0xf7, 0x95, 0x0b, 0xdf, 0x7e, 0x5f, 0xb9, 0xf0, 0xd9, 0xcd, 0x03,
-	0x6f, 0x20, 0x83, 0xed, 0x9d, 0xfc, 0x37, 0xe5, 0x77, 0x62, 0x9f, 0xfb, 0x13, 0x18, 0x34, 0x6f,
-	0xfc, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x68, 0x4c, 0xf8, 0x12, 0x2f, 0x00, 0x00,
+	// 3490 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0xcd, 0x73, 0xdb, 0xc6,
+	0xf5, 0xa6, 0x05, 0x89, 0xe0, 0x23, 0x45, 0x41, 0xd0, 0x17, 0xa4, 0xc8, 0x94, 0xcc, 0xd8, 0x89,
+	0x9c, 0x38, 0x54, 0x24, 0x3b, 0x3f, 0xe7, 0xe3, 0xd7, 0xb8, 0x92, 0x2c, 0x27, 0x64, 0x1c, 0xc7,
+	0x81, 0x94, 0x38, 0x93, 0x36, 0xc3, 0x81, 0x80, 0xa5, 0x84, 0x0a, 0x04, 0x68, 0x00, 0x94, 0xcc,
+	0x4c, 0x0f, 0x99, 0x69, 0xff, 0x80, 0xf4, 0xd6, 0x3f, 0xa1, 0xc7, 0x5e, 0x7a, 0xef, 0xa1, 0xed,
+	0xf4, 0xd4, 0xc9, 0xad, 0xb9, 0xb5, 0x71, 0x2e, 0xbd, 0x74, 0x92, 0xe9, 0x5f, 0xd0, 0xd9, 0xb7,
+	0xbb, 0x20, 0x00, 0x42, 0x12, 0xe5, 0xc6, 0x87, 0xdc, 0x88, 0x7d, 0x1f, 0xfb, 0xf6, 0xed, 0xdb,
+	0xf7, 0x49, 0xb8, 0x11, 0x92, 0x76, 0xc7, 0xf3, 0x0d, 0x67, 0x35, 0x20, 0xfe, 0x11, 0xf1, 0x57,
+	0x8d, 0x8e, 0xbd, 0xda, 0x21, 0x7e, 0x60, 0x07, 0x21, 0x71, 0x4d, 0xb2, 0x7a, 0xb4, 0xb6, 0x4a,
+	0x1e, 0x13, 0xb3, 0x1b, 0xda, 0x9e, 0x1b, 0xd4, 0x3a, 0xbe, 0x17, 0x7a, 0x6a, 0x55, 0x10, 0xd5,
+	0x18, 0x51, 0xcd, 0xe8, 0xd8, 0xb5, 0x18, 0x51, 0xed, 0x68, 0x6d, 0xa1, 0xb2, 0xef, 0x79, 0xfb,
+	0x0e, 0x59, 0x45, 0x8a, 0xbd, 0x6e, 0x6b, 0xd5, 0xea, 0xfa, 0x06, 0x65, 0xc2, 0x78, 0x2c, 0x2c,
+	0xa5, 0xe1, 0xa1, 0xdd, 0x26, 0x41, 0x68, 0xb4, 0x3b, 0x1c, 0xe1, 0xb2, 0x45, 0x3a, 0xc4, 0xb5,
+	0x88, 0x6b, 0xda, 0x24, 0x58, 0xdd, 0xf7, 0xf6, 0x3d, 0x5c, 0xc7, 0x5f, 0x1c, 0xe5, 0x4a, 0x24,
+	0x3c, 0x95, 0xda, 0xf4, 0xda, 0x6d, 0xcf, 0xa5, 0x02, 0xb7, 0x49, 0x10, 0x18, 0xfb, 0x24, 0x13,
+	0x8b, 0xb8, 0xdd, 0x76, 0x40, 0x91, 0x8e, 0x3d, 0xff, 0xb0, 0xe5, 0x78, 0xc7, 0x1c, 0xeb, 0x6a,
+	0x02, 0xab, 0x65, 0xd8, 0x4e, 0xd7, 0x27, 0x83, 0xcc, 0x5e, 0x48, 0xa0, 0x09, 0x1e, 0x83, 0x78,
+	0x2f, 0x65, 0xe9, 0xd5, 0x74, 0x3c, 0xf3, 0x70, 0x10, 0xf7, 0x5a, 0x16, 0x6e, 0x24, 0x27, 0x3b,
+	0x16, 0x47, 0x7d, 0xf9, 0x54, 0xd4, 0xd4, 0x91, 0x5e, 0x3c, 0x15, 0x39, 0x34, 0x82, 0x43, 0x8e,
+	0x78, 0x3d, 0x0b, 0xf1, 0xc0, 0x0e, 0x42, 0xcf, 0xef, 0x0d, 0x8a, 0xbb, 0x3a, 0x84, 0xc9, 0x3c,
+	0xea, 0x92, 0x2e, 0xe1, 0xe6, 0x52, 0xfd, 0x4b, 0x1e, 0x0a, 0x3b, 0x07, 0x86, 0x6f, 0xd5, 0xdd,
+	0x96, 0xa7, 0xce, 0x83, 0x1c, 0xd0, 0x8f, 0xa6, 0x6d, 0x69, 0xb9, 0xe5, 0xdc, 0xca, 0xa8, 0x9e,
+	0xc7, 0xef, 0xba, 0x45, 0x41, 0xbe, 0xe1, 0xee, 0x13, 0x0a, 0xba, 0xb8, 0x9c, 0x5b, 0x19, 0xd1,
+	0xf3, 0xf8, 0x5d, 0xb7, 0xd4, 0x69, 0x18, 0xf5, 0x8e, 0x5d, 0xe2, 0x6b, 0x23, 0xcb, 0xb9, 0x95,
+	0x82, 0xce, 0x3e, 0xd4, 0xeb, 0xa0, 0x06, 0xa1, 0xe7, 0x10, 0xb7, 0x19, 0xd8, 0xae, 0x49, 0x9a,
+	0x3e, 0x71, 0xc9, 0xb1, 0x36, 0x86, 0x5c, 0x15, 0x06, 0xd9, 0xa1, 0x00, 0x9d, 0xae, 0xab, 0x1b,
+	0x50, 0xec, 0x76, 0x2c, 0x23, 0x24, 0x4d, 0x6a, 0x6b, 0x5a, 0x7e, 0x39, 0xb7, 0x52, 0x5c, 0x5f,
+	0xa8, 0x31, 0x43, 0xac, 0x09, 0x43, 0xac, 0xed, 0x0a, 0x43, 0xdc, 0x94, 0xbe, 0xfc, 0xc7, 0x52,
+	0x4e, 0x07, 0x46, 0x44, 0x97, 0xd5, 0x3b, 0x50, 0x71, 0x8d, 0x36, 0x09, 0x3a, 0x86, 0x49, 0x9a,
+	0xae, 0x17, 0xda, 0x2d, 0xdb, 0x44, 0xab, 0x6e, 0x1e, 0x51, 0x05, 0x78, 0xae, 0x56, 0x40, 0xb9,
+	0x17, 0x23, 0xac, 0xfb, 0x31, 0xa4, 0x8f, 0x19, 0x8e, 0xfa, 0xeb, 0x1c, 0xcc, 0xfb, 0xa4, 0xe3,
+	0x08, 0x5a, 0xcb, 0x79, 0xd4, 0x34, 0xcc, 0xc3, 0xa6, 0x43, 0x8e, 0x88, 0xa3, 0x8d, 0x2f, 0x8f,
+	0xac, 0x14, 0xd7, 0xeb, 0xb5, 0xb3, 0x1f, 0x59, 0x2d, 0xd2, 0x6a, 0x4d, 0xef, 0xb3, 0xbb, 0xe3,
+	0x3c, 0xda, 0x30, 0x0f, 0xef, 0x51, 0x5e, 0xdb, 0x6e, 0xe8, 0xf7, 0xf4, 0x59, 0x3f, 0x13, 0xa8,
+	0x1e, 0x82, 0x82, 0xf7, 0xd4, 0xdf, 0x3b, 0xd0, 0x14, 0xdc, 0x7c, 0xe3, 0x7c, 0x9b, 0x7f, 0x48,
+	0xb9, 0x08, 0xb6, 0x01, 0xdb, 0xb4, 0xfc, 0x28, 0xb1, 0xa8, 0x1a, 0x50, 0x62, 0x9b, 0x05, 0xa1,
+	0x11, 0x92, 0x40, 0x9b, 0xc4, 0x8d, 0xde, 0x7e, 0x8a, 0x8d, 0x76, 0x90, 0x01, 0xdb, 0xa5, 0xf8,
+	0xa8, 0xbf, 0xb2, 0x50, 0x87, 0xe7, 0x4e, 0x51, 0x83, 0xaa, 0xc0, 0xc8, 0x21, 0xe9, 0xa1, 0xcd,
+	0x15, 0x74, 0xfa, 0x93, 0x1a, 0xd5, 0x91, 0xe1, 0x74, 0x09, 0x37, 0x36, 0xf6, 0xf1, 0xe6, 0xc5,
+	0xd7, 0x73, 0x0b, 0x21, 0x4c, 0x65, 0x1c, 0x2a, 0xce, 0x62, 0x94, 0xb1, 0x78, 0x27, 0xce, 0xa2,
+	0xb8, 0xbe, 0x36, 0xcc, 0x79, 0x12, 0x9c, 0xe3, 0xbb, 0xba, 0xa0, 0xa4, 0x4f, 0x98, 0xb1, 0xe5,
+	0x9d, 0xe4, 0x96, 0xb5, 0xa1, 0xb7, 0x44, 0xb6, 0xb1, 0xfd, 0x1a, 0x92, 0x2c, 0x29, 0xa3, 0x0d,
+	0x49, 0x1e, 0x55, 0xc6, 0x1a, 0x92, 0x2c, 0x2b, 0x85, 0x86, 0x24, 0x83, 0x52, 0x6c, 0x48, 0x72,
+	0x51, 0x29, 0x35, 0x24, 0xb9, 0xa4, 0x8c, 0x37, 0x24, 0xb9, 0xac, 0x4c, 0x34, 0x24, 0x79, 0x42,
+	0x51, 0xaa, 0xbf, 0x5a, 0x86, 0x99, 0x87, 0xdc, 0xc7, 0x6c, 0x8b, 0xa0, 0x80, 0x8f, 0xfa, 0x32,
+	0x94, 0xfa, 0xef, 0x82, 0x3f, 0xec, 0x82, 0x5e, 0x8c, 0xd6, 0xea, 0x96, 0xba, 0x04, 0x45, 0xe1,
+	0x9f, 0xc4, 0xfb, 0x2e, 0xe8, 0x20, 0x96, 0xea, 0x96, 0x5a, 0x83, 0xa9, 0x8e, 0xe1, 0x13, 0x37,
+	0x6c, 0x26, 0x58, 0xb1, 0x07, 0x3f, 0xc9, 0x40, 0xf7, 0x63, 0x0c, 0xaf, 0x83, 0xca, 0xf1, 0xe3,
+	0x7c, 0x25, 0x44, 0x57, 0x18, 0xe4, 0x61, 0x9f, 0x7b, 0x15, 0xc6, 0x39, 0xb6, 0xdf, 0x75, 0x29,
+	0xe2, 0x28, 0x13, 0x91, 0x2d, 0xea, 0x5d, 0x37, 0x21, 0x81, 0xed, 0xda, 0xa1, 0x6d, 0x84, 0x04,
+	0xbd, 0xd4, 0x18, 0x5a, 0x07, 0x97, 0xa0, 0x2e, 0x20, 0x75, 0x4b, 0x7d, 0x03, 0xe6, 0x4d, 0xaf,
+	0xdd, 0x71, 0x08, 0xbe, 0x62, 0x72, 0x44, 0x29, 0xf7, 0x8c, 0xd0, 0x3c, 0xa0, 0x54, 0x79, 0xa4,
+	0x9a, 0xed, 0x23, 0x6c, 0x53, 0xf8, 0x26, 0x05, 0xd7, 0x2d, 0xf5, 0x12, 0x00, 0x75, 0xc0, 0x4d,
+	0xb4, 0x5f, 0x74, 0x1a, 0x05, 0xbd, 0x40, 0x57, 0xf0, 0xa6, 0xe8, 0xd9, 0xa2, 0x43, 0x85, 0xbd,
+	0x0e, 0x41, 0x95, 0x68, 0xc0, 0xce, 0x26, 0x20, 0xbb, 0xbd, 0x0e, 0xa1, 0x0a, 0x51, 0x3f, 0x83,
+	0x85, 0x08, 0x3b, 0x0a, 0xd6, 0xe8, 0xe4, 0xbc, 0x6e, 0xa8, 0x15, 0xd1, 0x4c, 0xe6, 0x07, 0xfc,
+	0xdc, 0x1d, 0x1e, 0x90, 0x37, 0xa5, 0xdf, 0x52, 0x37, 0xa7, 0x1d, 0xa7, 0x6f, 0x76, 0x97, 0x31,
+	0x50, 0x3f, 0x84, 0xe9, 0x88, 0xbd, 0xdf, 0xed, 0x33, 0x2e, 0x0d, 0xc7, 0x38, 0x3a, 0x89, 0xde,
+	0x8d, 0x58, 0xee, 0xc1, 0x25, 0x8b, 0xb4, 0x8c, 0xae, 0x13, 0xbb, 0x3c, 0xd4, 0x87, 0xe0, 0x3d,
+	0x3e, 0x1c, 0xef, 0x05, 0xce, 0x45, 0x5c, 0xf4, 0xae, 0x11, 0x1c, 0x8a, 0x3d, 0x5e, 0x06, 0xd5,
+	0x31, 0x82, 0x90, 0xdf, 0x0b, 0x72, 0xb7, 0x2d, 0x6d, 0x12, 0xaf, 0x65, 0x82, 0x42, 0xf0, 0x42,
+	0x28, 0x45, 0xdd, 0x52, 0x5f, 0x81, 0x29, 0x44, 0x6e, 0xd9, 0x7e, 0x44, 0x62, 0x5b, 0x9a, 0x8a,
+	0xd8, 0x0a, 0x05, 0xdd, 0xb5, 0x7d, 0x4e, 0x52, 0xb7, 0xd4, 0xf7, 0xe0, 0x79, 0x44, 0x4f, 0x0a,
+	0x1f, 0x84, 0x86, 0x4f, 0x6d, 0x26, 0x22, 0x9f, 0x42, 0xf2, 0x0a, 0x45, 0x8d, 0x4b, 0xb8, 0xc3,
+	0xf0, 0x04, 0xb3, 0xdb, 0x00, 0x48, 0xc9, 0xc2, 0xd2, 0xf4, 0x90, 0x61, 0xa9, 0x80, 0x34, 0x74,
+	0x55, 0x6d, 0x00, 0x4a, 0xd8, 0x8c, 0x47, 0xb7, 0x99, 0x21, 0xd9, 0x94, 0x29, 0xe5, 0x47, 0xfd,
+	0x08, 0xb7, 0x0e, 0x33, 0xc9, 0x43, 0x89, 0xc0, 0x36, 0x8b, 0x67, 0x99, 0x3a, 0x8e, 0x9d, 0x43,
+	0xc4, 0xb3, 0xbb, 0xb0, 0x9c, 0x52, 0x84, 0x79, 0x40, 0xac, 0xae, 0x13, 0x57, 0xc5, 0x1c, 0x8b,
+	0x8b, 0x71, 0xf2, 0x1d, 0x81, 0x25, 0x14, 0xb1, 0x09, 0x95, 0x33, 0x14, 0xaa, 0x21, 0x97, 0x85,
+	0xe3, 0x93, 0x95, 0xb9, 0x93, 0x96, 0x5f, 0x58, 0xd4, 0xfc, 0x70, 0x16, 0x95, 0x38, 0xa0, 0x30,
+	0xa5, 0x01, 0xa5, 0x18, 0x21, 0x75, 0xba, 0xa1, 0xb6, 0x80, 0x6e, 0x39, 0x41, 0xb3, 0xc1, 0x40,
+	0x89, 0x47, 0x99, 0x38, 0x0c, 0x5e, 0xcf, 0x73, 0x43, 0x5e, 0xcf, 0x5c, 0xc6, 0x51, 0xf1, 0x9e,
+	0x0c, 0x58, 0x3c, 0x49, 0xe7, 0xb8, 0xc1, 0xe2, 0x90, 0x1b, 0xcc, 0x67, 0xde, 0x08, 0x6e, 0x71,
+	0x0d, 0x14, 0xd3, 0x70, 0x4d, 0xe2, 0x34, 0x7d, 0xf2, 0xa8, 0x4b, 0x82, 0x90, 0x58, 0xda, 0xa5,
+	0xe5, 0xdc, 0x8a, 0xac, 0x4f, 0xb0, 0x75, 0x5d, 0x2c, 0xab, 0x3e, 0x5c, 0x4d, 0x4a, 0xe3, 0xf9,
+	0xf6, 0xbe, 0xed, 0x1a, 0x4e, 0x5a, 0xac, 0xca, 0x90, 0x62, 0x5d, 0x8e, 0x8b, 0xf5, 0x01, 0x67,
+	0x96, 0x14, 0xef, 0x16, 0x68, 0xc9, 0x3d, 0xb9, 0x94, 0xd4, 0x4e, 0x96, 0xd0, 0x53, 0xce, 0xc4,
+	0x99, 0x70, 0x61, 0xeb, 0x96, 0xfa, 0x12, 0x4c, 0x26, 0xcf, 0x45, 0x29, 0x96, 0x91, 0x22, 0x79,
+	0x30, 0x86, 0x1b, 0x84, 0xb6, 0x79, 0xd8, 0x6b, 0xc6, 0xdc, 0xf5, 0x65, 0x86, 0xcb, 0x00, 0xbb,
+	0x91, 0xd3, 0xde, 0x87, 0x65, 0x8e, 0x2b, 0x0e, 0xdd, 0x0c, 0xbd, 0x66, 0xff, 0x69, 0x53, 0x2b,
+	0xac, 0x0e, 0x67, 0x85, 0x8b, 0x8c, 0x91, 0x38, 0xf0, 0xae, 0xb7, 0x23, 0x1e, 0x3b, 0x35, 0x47,
+	0x0d, 0xf2, 0xc2, 0x00, 0x9f, 0x67, 0x19, 0x34, 0xff, 0x54, 0x3f, 0x82, 0x59, 0x9f, 0x84, 0x7e,
+	0x8f, 0x07, 0x30, 0xa7, 0x69, 0xbb, 0x21, 0xf1, 0x8f, 0x0c, 0x47, 0xbb, 0x32, 0xdc, 0xc6, 0xd3,
+	0x48, 0xce, 0x82, 0x9c, 0x53, 0xe7, 0xc4, 0x7d, 0xb6, 0x6d, 0xe3, 0xb1, 0xdd, 0xee, 0xb6, 0xfb,
+	0x6c, 0xaf, 0x9e, 0x87, 0xed, 0xfb, 0x8c, 0x3a, 0x62, 0x7b, 0x33, 0xcd, 0x96, 0x1f, 0x23, 0xd0,
+	0x5e, 0xc0, 0x63, 0x25, 0xa8, 0xf8, 0xbb, 0x0a, 0xd4, 0x37, 0x61, 0x9e, 0x51, 0xed, 0x19, 0xe6,
+	0xa1, 0xd7, 0x6a, 0x35, 0x4d, 0x8f, 0xb4, 0x5a, 0xb6, 0x69, 0x13, 0x37, 0xd4, 0x5e, 0x5c, 0xce,
+	0xad, 0xe4, 0xf4, 0x39, 0x44, 0xd8, 0x64, 0xf0, 0xad, 0x3e, 0x58, 0x6d, 0x43, 0x35, 0x23, 0x52,
+	0x92, 0xc7, 0x1d, 0x9b, 0x89, 0xcb, 0x8c, 0x74, 0x65, 0x48, 0x23, 0x5d, 0x1a, 0x08, 0x99, 0xdb,
+	0x11, 0x27, 0x5e, 0x2e, 0x2c, 0x31, 0x51, 0x5d, 0xcf, 0x6d, 0xe2, 0x2f, 0x63, 0xcf, 0x21, 0x4d,
+	0xe2, 0xfb, 0x9e, 0x8f, 0x71, 0x3d, 0xd0, 0xae, 0x2d, 0x8f, 0xac, 0x14, 0xf4, 0xe7, 0x10, 0x78,
+	0xdf, 0x73, 0x75, 0x81, 0xb4, 0x4d, 0x71, 0x68, 0x84, 0x0f, 0xd4, 0x15, 0x50, 0x0e, 0x8c, 0x80,
+	0xd1, 0x37, 0x3b, 0x9e, 0x63, 0x9b, 0x3d, 0xed, 0x25, 0x7c, 0x87, 0xe5, 0x03, 0x23, 0x40, 0x8a,
+	0x07, 0xb8, 0xaa, 0x3e, 0x0f, 0xe3, 0xa6, 0xef, 0xb9, 0x91, 0xfd, 0x69, 0x2f, 0xa3, 0xa5, 0x96,
+	0xe8, 0xa2, 0xb0, 0x25, 0x9a, 0xab, 0x05, 0xf6, 0x3e, 0x7d, 0x9b, 0xa6, 0xd7, 0x75, 0x43, 0xad,
+	0x86, 0x3e, 0xb5, 0xc8, 0xd6, 0xb6, 0xe8, 0x92, 0xfa, 0x21, 0x4c, 0x1a, 0xdd, 0xd0, 0x6b, 0xfa,
+	0x24, 0x20, 0x61, 0xb3, 0xe3, 0xd9, 0x6e, 0x18, 0x68, 0x37, 0x50, 0x2b, 0x57, 0xfb, 0xe9, 0x26,
+	0xcd, 0x33, 0xa3, 0x92, 0xf3, 0x68, 0xad, 0xa6, 0x53, 0xec, 0x07, 0x88, 0xac, 0x4f, 0x50, 0xfa,
+	0xd8, 0x82, 0xfa, 0x4b, 0x98, 0x0c, 0x88, 0xe1, 0x9b, 0x07, 0xf4, 0x92, 0x7d, 0x7b, 0xaf, 0x4b,
+	0x8b, 0x80, 0x9b, 0x58, 0x04, 0x7c, 0x30, 0x4c, 0x06, 0x9b, 0x99, 0x77, 0xd6, 0x76, 0x90, 0xe5,
+	0x46, 0xc4, 0x91, 0x55, 0x05, 0x4a, 0x90, 0x5a, 0x56, 0x1f, 0x82, 0xd4, 0x26, 0x6d, 0x4f, 0x7b,
+	0x0d, 0x37, 0xdc, 0x7a, 0xfa, 0x0d, 0xdf, 0x27, 0x6d, 0x8f, 0x6d, 0x82, 0x0c, 0xd5, 0xcf, 0x60,
+	0x92, 0x07, 0xc8, 0x26, 0x2b, 0x98, 0x6d, 0x12, 0x68, 0xff, 0x87, 0x9a, 0x7a, 0x35, 0x73, 0x17,
+	0x86, 0xd5, 0xa3, 0x3b, 0xf0, 0xf0, 0xf9, 0xae, 0xa0, 0xd3, 0x95, 0xa3, 0xd4, 0x8a, 0x7a, 0x03,
+	0x66, 0x79, 0x46, 0x12, 0x19, 0x2b, 0x4f, 0x5f, 0x6f, 0xe1, 0xcd, 0x4e, 0x21, 0x34, 0x12, 0x91,
+	0xa5, 0xb1, 0x3f, 0x83, 0x89, 0x3e, 0x7a, 0x10, 0x1a, 0x61, 0xa0, 0xbd, 0x8e, 0x12, 0xad, 0x0f,
+	0x73, 0xee, 0x88, 0x19, 0x2d, 0x17, 0x02, 0xbd, 0x4c, 0x12, 0xdf, 0x89, 0xb8, 0xe3, 0x77, 0x07,
+	0xdf, 0xce, 0x1b, 0xe7, 0x8d, 0x3b, 0x7a, 0x37, 0xfd, 0x6a, 0x6e, 0xc2, 0xdc, 0x40, 0x2e, 0x16,
+	0x3e, 0xc6, 0x53, 0xbf, 0xc9, 0x92, 0x90, 0x64, 0x3e, 0xb6, 0xfb, 0x98, 0x9e, 0xfa, 0x26, 0xcc,
+	0xd2, 0xb3, 0x92, 0x66, 0xe8, 0x1b, 0x6e, 0x60, 0xa3, 0x44, 0xcc, 0xc0, 0xdf, 0x42, 0xa2, 0x69,
+	0x84, 0xee, 0x46, 0x40, 0x66, 0xe9, 0xef, 0x40, 0x39, 0x99, 0x31, 0x6b, 0xff, 0x3f, 0xe4, 0x01,
+	0xc6, 0x49, 0x3c, 0x4f, 0x56, 0x57, 0x61, 0xda, 0x25, 0xc7, 0x83, 0xf7, 0xf4, 0x13, 0x56, 0xbe,
+	0xb8, 0xe4, 0x38, 0x75, 0x4b, 0xf7, 0xa0, 0xc4, 0x8b, 0x0d, 0x6c, 0x0b, 0x69, 0x6f, 0xe3, 0xbe,
+	0xd7, 0x32, 0xaf, 0x08, 0x31, 0x98, 0xc9, 0x98, 0xa1, 0xe7, 0x6f, 0xd1, 0x4f, 0x51, 0xba, 0xe0,
+	0x87, 0xfa, 0x3a, 0x68, 0x03, 0xa5, 0x8b, 0xc8, 0xdc, 0x6e, 0xb3, 0x4a, 0x24, 0x55, 0xbf, 0x88,
+	0xe4, 0xed, 0x06, 0xcc, 0x9a, 0x8e, 0x17, 0x70, 0xbd, 0xb5, 0x88, 0x1f, 0xa5, 0xca, 0x3f, 0x65,
+	0xca, 0x46, 0xe8, 0x2e, 0x07, 0xf2, 0x74, 0xf9, 0x16, 0x68, 0x8c, 0xe8, 0xc8, 0x0e, 0xec, 0x3d,
+	0xdb, 0xb1, 0xc3, 0x5e, 0x44, 0xb6, 0x81, 0x64, 0x33, 0x08, 0xff, 0x38, 0x02, 0x73, 0xc2, 0xdb,
+	0x00, 0x7c, 0x37, 0xaa, 0xeb, 0xcd, 0x61, 0x73, 0x5d, 0x26, 0x03, 0xd5, 0xf3, 0x36, 0x2c, 0x65,
+	0xef, 0xcc, 0x0b, 0x2d, 0x62, 0x69, 0x5b, 0xe8, 0x1b, 0x17, 0x33, 0x04, 0xd8, 0x12, 0x38, 0xea,
+	0x02, 0xc8, 0x1d, 0xdf, 0xf6, 0x7c, 0x3b, 0xec, 0x69, 0x77, 0x30, 0xd8, 0x44, 0xdf, 0xd4, 0x41,
+	0xb6, 0x0c, 0xdb, 0x77, 0x49, 0x10, 0x34, 0x69, 0xed, 0xbd, 0xcd, 0x2a, 0x45, 0xb1, 0xf6, 0x1e,
+	0xe9, 0x2d, 0x58, 0x30, 0x93, 0xe9, 0x7a, 0x32, 0x9a, 0x0c, 0xaf, 0x25, 0xcb, 0xf5, 0xa5, 0xa4,
+	0xff, 0xe4, 0xdd, 0xbd, 0xa3, 0xb5, 0xda, 0x03, 0xa3, 0xe7, 0x78, 0x86, 0x15, 0xef, 0x07, 0x7c,
+	0x02, 0x85, 0xc8, 0xdf, 0xfc, 0xa0, 0x9c, 0xa3, 0x6a, 0x3f, 0xaa, 0xed, 0x1b, 0x92, 0xac, 0x28,
+	0x93, 0x0d, 0x49, 0xbe, 0xae, 0xbc, 0xd2, 0x90, 0xe4, 0x57, 0x94, 0x5a, 0x43, 0x92, 0x57, 0x95,
+	0x57, 0x1b, 0x92, 0xfc, 0xaa, 0xb2, 0xd6, 0x90, 0xe4, 0x35, 0x65, 0xbd, 0x21, 0xc9, 0xeb, 0xca,
+	0x8d, 0xea, 0x0d, 0x28, 0x27, 0x7d, 0x04, 0x55, 0x18, 0x77, 0x6b, 0xcd, 0xc0, 0xfe, 0x9c, 0xa0,
+	0x8c, 0x23, 0x7a, 0x91, 0xaf, 0xed, 0xd8, 0x9f, 0x93, 0xea, 0x77, 0x39, 0x98, 0x1d, 0xf0, 0xa8,
+	0x94, 0x9a, 0x60, 0x3a, 0xe6, 0x13, 0xfa, 0x72, 0x63, 0xe9, 0x58, 0x8e, 0xa7, 0x63, 0x08, 0xe8,
+	0xa7, 0x63, 0x33, 0x30, 0xc6, 0xdf, 0x15, 0xeb, 0x1f, 0x8c, 0xfa, 0xf8, 0x96, 0x1a, 0x30, 0x8a,
+	0xaf, 0x1b, 0x9b, 0x05, 0xe5, 0xf5, 0x9b, 0x99, 0x8f, 0x08, 0x3b, 0x9f, 0x99, 0x9e, 0x9d, 0x37,
+	0x46, 0x90, 0x85, 0x7a, 0x17, 0xc6, 0xe8, 0x8f, 0x6e, 0x80, 0xad, 0x84, 0x72, 0xbc, 0xbf, 0x72,
+	0x36, 0x97, 0x6e, 0xa0, 0x73, 0xea, 0xea, 0x7f, 0xc6, 0x40, 0x49, 0xbc, 0x9a, 0x1f, 0xaa, 0x4f,
+	0xd2, 0xd7, 0xc1, 0x48, 0x5c, 0x07, 0x5b, 0x50, 0x60, 0xf5, 0x4e, 0xaf, 0x43, 0xb8, 0xe8, 0x2f,
+	0x9c, 0xae, 0x07, 0xac, 0x70, 0x7a, 0x1d, 0xa2, 0xcb, 0x21, 0xff, 0x45, 0x3b, 0x20, 0xa1, 0xe1,
+	0xef, 0x93, 0x54, 0x0f, 0x86, 0xf5, 0x4a, 0x26, 0x19, 0x28, 0xd5, 0x83, 0xe1, 0xf8, 0x71, 0x99,
+	0xc7, 0x10, 0x5d, 0x61, 0x90, 0x64, 0x0f, 0x86, 0x63, 0xf3, 0x03, 0xe4, 0xd9, 0xf1, 0xd9, 0x22,
+	0x73, 0x8b, 0xc9, 0xc6, 0x88, 0x9c, 0x6e, 0x8c, 0xbc, 0x05, 0x0b, 0x9c, 0x85, 0x79, 0x60, 0x3b,
+	0x56, 0x7f, 0x5b, 0xcf, 0x75, 0x7a, 0xd8, 0x47, 0x91, 0xf5, 0x39, 0x86, 0xb1, 0x45, 0x11, 0xc4,
+	0xee, 0x1f, 0xb8, 0x4e, 0x8f, 0x4a, 0x9b, 0x51, 0x99, 0x02, 0xab, 0xf1, 0x83, 0x74, 0x35, 0xaa,
+	0x41, 0x5e, 0x78, 0xd0, 0x22, 0x6b, 0x46, 0xf3, 0x4f, 0x75, 0x0e, 0xf2, 0xc2, 0xd9, 0x95, 0x10,
+	0x32, 0x16, 0x32, 0xef, 0x56, 0x87, 0x89, 0xb8, 0x5b, 0xa2, 0x2e, 0x6e, 0x7c, 0xd8, 0x3a, 0xbc,
+	0x4f, 0x48, 0x41, 0x54, 0x56, 0x8b, 0x50, 0x5f, 0xd5, 0x34, 0x5a, 0x21, 0xf1, 0x9b, 0xe8, 0xcd,
+	0xb4, 0x09, 0x3c, 0xa0, 0xc2, 0x20, 0x1b, 0x14, 0xb0, 0x45, 0xd7, 0xd5, 0xdf, 0xe4, 0x80, 0xf9,
+	0xbb, 0x78, 0xff, 0x87, 0x8a, 0x68, 0x91, 0xd0, 0xb0, 0xb1, 0xaf, 0x4b, 0xc5, 0xb8, 0x3f, 0x4c,
+	0x02, 0x90, 0x36, 0xda, 0x1a, 0x6e, 0xd1, 0xef, 0x0a, 0x19, 0xc1, 0xe1, 0x1d, 0xc6, 0xf5, 0xdd,
+	0x0b, 0xfa, 0xbc, 0x79, 0x12, 0x70, 0xe1, 0xe7, 0x30, 0x7f, 0x22, 0xa5, 0x7a, 0x1b, 0x16, 0x4d,
+	0xc3, 0x6d, 0x06, 0x87, 0x76, 0x27, 0xee, 0xc9, 0xa9, 0x4b, 0xb5, 0x69, 0x5d, 0x91, 0xc3, 0x83,
+	0xce, 0x9b, 0x86, 0xbb, 0x73, 0x68, 0x77, 0xfa, 0x5e, 0x7c, 0x83, 0x23, 0x6c, 0x96, 0xa1, 0x14,
+	0x3f, 0x20, 0xf3, 0x65, 0xd5, 0x3f, 0x48, 0x30, 0x15, 0xeb, 0x01, 0xff, 0x68, 0xde, 0x5d, 0xcc,
+	0xd6, 0x46, 0x93, 0xb6, 0x76, 0x05, 0xca, 0xa9, 0x9e, 0x14, 0x6b, 0x47, 0x96, 0x5a, 0xf1, 0x7e,
+	0x54, 0x15, 0xc6, 0x5d, 0xf2, 0x38, 0x86, 0xc4, 0xba, 0x8f, 0x45, 0xba, 0x28, 0x70, 0xb2, 0xad,
+	0x5f, 0x3e, 0xc1, 0xfa, 0x2f, 0x43, 0x69, 0xcf, 0x37, 0x5c, 0xf3, 0xa0, 0x19, 0x7a, 0x87, 0x84,
+	0x3d, 0x81, 0x92, 0x5e, 0x64, 0x6b, 0xbb, 0x74, 0x49, 0xa4, 0x3c, 0x54, 0x29, 0x09, 0xd4, 0x71,
+	0x44, 0xa5, 0x29, 0x8f, 0xde, 0x75, 0x37, 0x63, 0x04, 0xb1, 0x77, 0x33, 0x71, 0xd6, 0xbb, 0x51,
+	0x9e, 0xf2, 0xdd, 0x2c, 0x02, 0x08, 0xa1, 0x78, 0xb7, 0xaf, 0xa0, 0xcb, 0x4c, 0x94, 0xba, 0xd5,
+	0x90, 0xe4, 0x82, 0x02, 0x51, 0x97, 0x3b, 0xea, 0x6f, 0x57, 0xff, 0x3d, 0x02, 0x6a, 0x2a, 0x57,
+	0xf9, 0x71, 0x9b, 0x4d, 0x4c, 0xd5, 0x63, 0x67, 0xa9, 0x3a, 0xff, 0x94, 0xaa, 0x4e, 0xe6, 0x72,
+	0xf2, 0xf9, 0x73, 0xb9, 0x64, 0xe3, 0xb3, 0x70, 0xfe, 0xc6, 0xe7, 0x69, 0x69, 0x28, 0x9c, 0x92,
+	0x86, 0x56, 0xbf, 0x93, 0x60, 0x9c, 0x72, 0xf8, 0xf1, 0x44, 0xe6, 0x6d, 0x28, 0xf1, 0x1e, 0x12,
+	0xe3, 0x33, 0x8a, 0x7c, 0xaa, 0x27, 0x24, 0x27, 0xbc, 0x53, 0x84, 0x3c, 0x8a, 0x61, 0xff, 0x43,
+	0x25, 0xb1, 0x4e, 0xa6, 0xe8, 0x9f, 0x20, 0xbf, 0x31, 0xe4, 0xb7, 0x36, 0x5c, 0xe6, 0xc4, 0x3b,
+	0x2b, 0xc8, 0x7e, 0xea, 0x78, 0x70, 0x31, 0x6e, 0x98, 0xf9, 0xa4, 0x61, 0x5e, 0x83, 0xc8, 0xd7,
+	0x44, 0x5d, 0x54, 0x19, 0x13, 0xf0, 0x09, 0xb1, 0x2e, 0x3a, 0xa8, 0xf3, 0x20, 0x47, 0x6e, 0x8a,
+	0x8d, 0x55, 0xf3, 0x84, 0x7b, 0xa7, 0x98, 0x79, 0xc3, 0x59, 0xe6, 0x5d, 0x7c, 0x4a, 0xf3, 0x4e,
+	0x7b, 0xc0, 0xd2, 0xa0, 0x07, 0xbc, 0x06, 0x8a, 0xe1, 0xf8, 0xc4, 0xb0, 0x44, 0xe4, 0x22, 0x16,
+	0x7a, 0x3f, 0x59, 0x9f, 0xe0, 0xeb, 0x1b, 0x7c, 0xb9, 0xfa, 0xfb, 0x8b, 0xa0, 0x88, 0xe0, 0x15,
+	0x19, 0x5d, 0xec, 0x18, 0xb9, 0xc4, 0x31, 0xd2, 0xd6, 0x78, 0xf1, 0x4c, 0x6b, 0x1c, 0x39, 0xc5,
+	0x1a, 0xa5, 0x13, 0xad, 0x71, 0xf4, 0x7f, 0x77, 0x3c, 0x63, 0xc9, 0xfb, 0xfd, 0xe1, 0xfc, 0x4b,
+	0xf5, 0xcf, 0x65, 0x28, 0x6d, 0x98, 0xa1, 0x7d, 0x64, 0x87, 0x3d, 0x54, 0x57, 0x6c, 0xd7, 0x5c,
+	0x72, 0xd7, 0x5b, 0xa0, 0xa5, 0x63, 0x5b, 0x34, 0x88, 0x63, 0xc3, 0xdd, 0x99, 0x64, 0x84, 0x13,
+	0x73, 0xb8, 0x77, 0xa0, 0x9c, 0xea, 0x50, 0x4b, 0xc3, 0xd6, 0xff, 0x41, 0xa2, 0x1b, 0xbd, 0x02,
+	0xca, 0xc0, 0xb4, 0x82, 0xf9, 0xe4, 0x72, 0x90, 0x9c, 0x50, 0x6c, 0x41, 0x29, 0x31, 0x0a, 0x18,
+	0x56, 0x3d, 0xc5, 0x20, 0xd6, 0xfe, 0x5f, 0x82, 0xa2, 0xc1, 0x55, 0x23, 0xa2, 0x78, 0x41, 0x07,
+	0xb1, 0xc4, 0xf2, 0xe8, 0x58, 0x39, 0xc5, 0x07, 0x8c, 0x7e, 0x54, 0x48, 0x7d, 0x0a, 0xf3, 0x27,
+	0x37, 0xa9, 0x61, 0xb8, 0xa6, 0xee, 0x6c, 0x90, 0xdd, 0x9e, 0x4e, 0xf1, 0xee, 0xc7, 0x88, 0x73,
+	0x4c, 0x23, 0x63, 0xbc, 0xb7, 0x44, 0xbc, 0xa0, 0xbc, 0x77, 0x61, 0x96, 0xcb, 0x9a, 0x66, 0x3c,
+	0xe4, 0x34, 0x72, 0x8a, 0x45, 0x8f, 0x24, 0xd7, 0x7b, 0x30, 0x79, 0x40, 0x0c, 0x3f, 0xdc, 0x23,
+	0x46, 0x78, 0xde, 0x11, 0xa4, 0x12, 0x51, 0x0a, 0x6e, 0x59, 0x73, 0x93, 0x72, 0xf6, 0xdc, 0x24,
+	0x73, 0x14, 0xc1, 0x72, 0xa3, 0xac, 0x51, 0x04, 0x15, 0xcd, 0x8f, 0xa6, 0x49, 0xb4, 0x46, 0x55,
+	0x98, 0xeb, 0x0c, 0x45, 0x2c, 0x63, 0x45, 0x68, 0x7c, 0x42, 0x30, 0x99, 0x9c, 0x10, 0x24, 0xeb,
+	0x2b, 0x35, 0x5d, 0x5f, 0x5d, 0xeb, 0x9b, 0xb1, 0x6d, 0x11, 0x37, 0xa4, 0xfd, 0x91, 0x29, 0x31,
+	0xee, 0xc0, 0xf5, 0x3a, 0x5f, 0xce, 0x6c, 0x4b, 0x4f, 0x67, 0xb6, 0xa5, 0x4f, 0x9e, 0x4a, 0xcc,
+	0x3c, 0x9b, 0xa9, 0xc4, 0xec, 0xb3, 0x99, 0x4a, 0xcc, 0x9d, 0x32, 0x95, 0xd8, 0x85, 0x19, 0x46,
+	0x95, 0x6e, 0x88, 0x6a, 0x43, 0x3e, 0xef, 0x29, 0x24, 0x4f, 0xb5, 0x42, 0x4f, 0x9d, 0x75, 0xcc,
+	0x9f, 0x3e, 0xeb, 0x18, 0x62, 0xf8, 0xb0, 0x70, 0xf6, 0xf0, 0xe1, 0x3e, 0xa8, 0x8c, 0x0b, 0x6b,
+	0xc9, 0xb2, 0xff, 0xc5, 0xf1, 0xf1, 0xe5, 0x72, 0x32, 0xfb, 0xe0, 0x40, 0x1a, 0x32, 0xee, 0xb2,
+	0x9f, 0xba, 0x82, 0xb4, 0xf7, 0x68, 0xbb, 0x96, 0xad, 0xd0, 0x02, 0x3e, 0xc6, 0x8f, 0x86, 0x2b,
+	0xe2, 0xf7, 0x4d, 0x6d, 0x11, 0x4d, 0x6d, 0x2e, 0xa2, 0x7a, 0x88, 0xf0, 0xc8, 0xe4, 0xb2, 0x4b,
+	0x98, 0xca, 0x09, 0x25, 0xcc, 0xc7, 0x30, 0x8b, 0x9b, 0xf4, 0x9f, 0xb6, 0xa8, 0x86, 0x97, 0xb2,
+	0xc4, 0x1f, 0x68, 0x98, 0x05, 0xfa, 0x34, 0xa5, 0x7f, 0x57, 0x90, 0x8b, 0xda, 0xf5, 0x33, 0x58,
+	0x48, 0xf1, 0x8d, 0x0f, 0xde, 0x97, 0x87, 0x9d, 0xec, 0x26, 0x78, 0xc7, 0x26, 0xf0, 0xf1, 0xd6,
+	0xe4, 0xe5, 0x33, 0x5a, 0x93, 0xd5, 0x81, 0xd6, 0x64, 0x43, 0x92, 0x47, 0x14, 0xa9, 0x21, 0xc9,
+	0x63, 0x4a, 0xbe, 0x21, 0xc9, 0x97, 0x94, 0x4a, 0xf5, 0x6f, 0x39, 0x28, 0x50, 0xce, 0xfe, 0x19,
+	0x41, 0x34, 0x2b, 0x84, 0x5d, 0xcc, 0x0c, 0x61, 0x1b, 0x50, 0x44, 0x33, 0xe7, 0x01, 0x7e, 0x64,
+	0xc8, 0x23, 0x03, 0x23, 0x12, 0x01, 0x2c, 0xee, 0xc7, 0x24, 0xdc, 0x07, 0xc2, 0xbe, 0x0b, 0x9b,
+	0x07, 0x99, 0xb9, 0xbb, 0xa8, 0xff, 0x94, 0xc7, 0xef, 0xba, 0x55, 0xfd, 0xbb, 0x04, 0x2a, 0x76,
+	0x77, 0x92, 0x7f, 0x42, 0x3a, 0x35, 0x3d, 0xe8, 0xb7, 0xc5, 0xb3, 0xd3, 0x83, 0x08, 0x9e, 0x48,
+	0x0f, 0xb2, 0x54, 0x32, 0x92, 0xa9, 0x92, 0x1a, 0x4c, 0x09, 0xcc, 0x78, 0x5a, 0xc6, 0x3b, 0x67,
+	0x1c, 0x14, 0xeb, 0x85, 0x5d, 0x01, 0xc1, 0x41, 0xd4, 0xaa, 0xac, 0x6b, 0x26, 0x72, 0x03, 0xd6,
+	0x0d, 0xcb, 0xec, 0x8d, 0xca, 0xd9, 0xbd, 0xd1, 0x45, 0x28, 0x44, 0xf9, 0xa1, 0x08, 0xf8, 0xd1,
+	0xc2, 0x39, 0xff, 0x51, 0xf4, 0x49, 0xf4, 0x4f, 0x28, 0x16, 0x64, 0xb9, 0x7b, 0x2f, 0x62, 0xba,
+	0xb8, 0x72, 0x42, 0xd1, 0xf1, 0x40, 0xcc, 0x23, 0x02, 0xc2, 0x1c, 0xbf, 0xf8, 0xcf, 0x54, 0x6c,
+	0x89, 0xca, 0x91, 0xbe, 0x8a, 0xa8, 0x8d, 0xa6, 0x24, 0x2f, 0x01, 0xc7, 0x05, 0xa3, 0x6c, 0x3a,
+	0x32, 0x7e, 0xde, 0xe9, 0x08, 0xa3, 0x1b, 0x48, 0xa4, 0xcb, 0x03, 0x89, 0x74, 0xf4, 0x2f, 0xb8,
+	0xbc, 0x22, 0x57, 0xff, 0x98, 0x83, 0x49, 0xae, 0xd1, 0x2d, 0x0c, 0xbf, 0xcf, 0xca, 0xb0, 0x32,
+	0x03, 0xff, 0x48, 0xf6, 0x7f, 0x10, 0xb2, 0x55, 0x26, 0x65, 0xab, 0xac, 0xfa, 0xa7, 0x1c, 0xc0,
+	0x0e, 0xce, 0x72, 0x9f, 0x95, 0xec, 0xc9, 0xd4, 0x72, 0x24, 0x9d, 0x5a, 0x66, 0x8b, 0x9b, 0xcf,
+	0x16, 0x37, 0xf5, 0x1f, 0x44, 0xe6, 0xb4, 0x64, 0xa5, 0x50, 0xfd, 0x22, 0x07, 0xf2, 0xd6, 0x01,
+	0x31, 0x0f, 0x83, 0x6e, 0x3b, 0x7d, 0x88, 0xd1, 0xfe, 0x21, 0xee, 0xc0, 0x58, 0xcb, 0x31, 0x8e,
+	0x3c, 0x1f, 0x45, 0x2e, 0xaf, 0x5f, 0x3f, 0xbd, 0x94, 0x11, 0x1c, 0xef, 0x22, 0x8d, 0xce, 0x69,
+	0xfb, 0x7f, 0x04, 0x1d, 0xc1, 0x1a, 0x8f, 0x7d, 0x6c, 0xfe, 0xe2, 0xab, 0x6f, 0x2a, 0x17, 0xbe,
+	0xfe, 0xa6, 0x72, 0xe1, 0xfb, 0x6f, 0x2a, 0xb9, 0x2f, 0x9e, 0x54, 0x72, 0xbf, 0x7b, 0x52, 0xc9,
+	0xfd, 0xf5, 0x49, 0x25, 0xf7, 0xd5, 0x93, 0x4a, 0xee, 0x9f, 0x4f, 0x2a, 0xb9, 0x7f, 0x3d, 0xa9,
+	0x5c, 0xf8, 0xfe, 0x49, 0x25, 0xf7, 0xe5, 0xb7, 0x95, 0x0b, 0x5f, 0x7d, 0x5b, 0xb9, 0xf0, 0xf5,
+	0xb7, 0x95, 0x0b, 0x9f, 0xde, 0xdc, 0xf7, 0xfa, 0x32, 0xd8, 0xde, 0xc9, 0x7f, 0x92, 0x7e, 0x2b,
+	0xf6, 0xb9, 0x37, 0x86, 0x4e, 0xf3, 0xc6, 0x7f, 0x07, 0x00, 0x64, 0xb9, 0x13, 0xd5, 0x90, 0x2f,
+	0x00, 0x00,
 }
 
 func (this *ShardInfo) Equal(that interface{}) bool {
@@ -2784,6 +2823,12 @@ func (this *WorkflowExecutionInfo) Equal(that interface{}) bool {
 	if this.CloseVisibilityTaskCompleted != that1.CloseVisibilityTaskCompleted {
 		return false
 	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if this.FairnessKey != that1.FairnessKey {
+		return false
+	}
 	return true
 }
 func (this *ExecutionStats) Equal(that interface{}) bool {
@@ -3367,6 +3412,12 @@ func (this *ActivityInfo) Equal(that interface{}) bool {
 	} else if !this.LastHeartbeatUpdateTime.Equal(*that1.LastHeartbeatUpdateTime) {
 		return false
 	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if this.FairnessKey != that1.FairnessKey {
+		return false
+	}
 	return true
 }
 func (this *TimerInfo) Equal(that interface{}) bool {
@@ -3620,7 +3671,7 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 61)
+	s := make([]string, 0, 63)
 	s = append(s, "&persistence.WorkflowExecutionInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -3711,6 +3762,8 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	s = append(s, "CloseVisibilityTaskId: "+fmt.Sprintf("%#v", this.CloseVisibilityTaskId)+",\n")
 	s = append(s, "CloseTime: "+fmt.Sprintf("%#v", this.CloseTime)+",\n")
 	s = append(s, "CloseVisibilityTaskCompleted: "+fmt.Sprintf("%#v", this.CloseVisibilityTaskCompleted)+",\n")
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
+	s = append(s, "FairnessKey: "+fmt.Sprintf("%#v", this.FairnessKey)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3864,7 +3917,7 @@ func (this *ActivityInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 33)
+	s := make([]string, 0, 35)
 	s = append(s, "&persistence.ActivityInfo{")
 	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
 	s = append(s, "ScheduledEventBatchId: "+fmt.Sprintf("%#v", this.ScheduledEventBatchId)+",\n")
@@ -3899,6 +3952,8 @@ func (this *ActivityInfo) GoString() string {
 		s = append(s, "LastHeartbeatDetails: "+fmt.Sprintf("%#v", this.LastHeartbeatDetails)+",\n")
 	}
 	s = append(s, "LastHeartbeatUpdateTime: "+fmt.Sprintf("%#v", this.LastHeartbeatUpdateTime)+",\n")
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
+	s = append(s, "FairnessKey: "+fmt.Sprintf("%#v", this.FairnessKey)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -4134,6 +4189,22 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintExecutions(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.Priority != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xa0
+	}
 	if m.CloseVisibilityTaskCompleted {
 		i--
 		if m.CloseVisibilityTaskCompleted {
@@ -5368,6 +5439,22 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintExecutions(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.Priority != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
 	if m.LastHeartbeatUpdateTime != nil {
 		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
 		if err34 != nil {
@@ -6231,6 +6318,13 @@ func (m *WorkflowExecutionInfo) Size() (n int) {
 	if m.CloseVisibilityTaskCompleted {
 		n += 3
 	}
+	if m.Priority != 0 {
+		n += 2 + sovExecutions(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 2 + l + sovExecutions(uint64(l))
+	}
 	return n
 }
 
@@ -6655,6 +6749,13 @@ func (m *ActivityInfo) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime)
 		n += 2 + l + sovExecutions(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 2 + sovExecutions(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 2 + l + sovExecutions(uint64(l))
+	}
 	return n
 }
 
@@ -6935,6 +7036,8 @@ func (this *WorkflowExecutionInfo) String() string {
 		`CloseVisibilityTaskId:` + fmt.Sprintf("%v", this.CloseVisibilityTaskId) + `,`,
 		`CloseTime:` + strings.Replace(fmt.Sprintf("%v", this.CloseTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`CloseVisibilityTaskCompleted:` + fmt.Sprintf("%v", this.CloseVisibilityTaskCompleted) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`FairnessKey:` + fmt.Sprintf("%v", this.FairnessKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7119,6 +7222,8 @@ func (this *ActivityInfo) String() string {
 		`ScheduledEventId:` + fmt.Sprintf("%v", this.ScheduledEventId) + `,`,
 		`LastHeartbeatDetails:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatDetails), "Payloads", "v11.Payloads", 1) + `,`,
 		`LastHeartbeatUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatUpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`FairnessKey:` + fmt.Sprintf("%v", this.FairnessKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -9593,6 +9698,57 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.CloseVisibilityTaskCompleted = bool(v != 0)
+		case 68:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 69:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
@@ -12583,6 +12739,57 @@ func (m *ActivityInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
@@ -117,6 +117,8 @@ type TaskInfo struct {
 	CreateTime       *time.Time      `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3,stdtime" json:"create_time,omitempty"`
 	ExpiryTime       *time.Time      `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
 	Clock            *v1.VectorClock `protobuf:"bytes,7,opt,name=clock,proto3" json:"clock,omitempty"`
+	Priority         int32           `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	FairnessKey      string          `protobuf:"bytes,9,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 }
 
 func (m *TaskInfo) Reset()      { *m = TaskInfo{} }
@@ -200,6 +202,20 @@ func (m *TaskInfo) GetClock() *v1.VectorClock {
 	return nil
 }
 
+func (m *TaskInfo) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *TaskInfo) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 // task_queue column
 type TaskQueueInfo struct {
 	NamespaceId    string            `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -421,53 +437,55 @@ func init() {
 }
 
 var fileDescriptor_f9c734e3b35cf986 = []byte{
-	// 734 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xee, 0xd2, 0xd2, 0x3f, 0xd3, 0xdf, 0xaf, 0xc0, 0x24, 0xc6, 0x06, 0x93, 0x05, 0x1a, 0xa3,
-	0x68, 0xc8, 0x6e, 0x40, 0x0f, 0x26, 0xc6, 0x28, 0x88, 0x87, 0x0a, 0x31, 0x61, 0x03, 0x1c, 0xf4,
-	0xd0, 0x0c, 0x3b, 0x6f, 0xeb, 0xda, 0xed, 0xcc, 0x3a, 0x33, 0xbb, 0xd8, 0x9b, 0x1f, 0x81, 0x8f,
-	0xe1, 0x67, 0xf0, 0x13, 0x78, 0xf0, 0xc0, 0x91, 0x78, 0x51, 0xca, 0xc5, 0x23, 0x1f, 0xc1, 0xcc,
-	0x6c, 0xb7, 0xd0, 0x08, 0xb1, 0x26, 0xde, 0xf6, 0x9d, 0xf7, 0x79, 0x9e, 0x79, 0xe7, 0x79, 0x66,
-	0x5a, 0xe4, 0x28, 0xe8, 0x45, 0x5c, 0x90, 0xd0, 0x95, 0x20, 0x12, 0x10, 0x2e, 0x89, 0x02, 0x37,
-	0x02, 0x21, 0x03, 0xa9, 0x80, 0xf9, 0xe0, 0x26, 0xab, 0xae, 0x22, 0xb2, 0x2b, 0x9d, 0x48, 0x70,
-	0xc5, 0x71, 0x23, 0xc3, 0x3b, 0x29, 0xde, 0x21, 0x51, 0xe0, 0x5c, 0xc2, 0x3b, 0xc9, 0xea, 0xfc,
-	0x42, 0x87, 0xf3, 0x4e, 0x08, 0xae, 0x61, 0x1c, 0xc4, 0x6d, 0x57, 0x05, 0x3d, 0x90, 0x8a, 0xf4,
-	0xa2, 0x54, 0x64, 0x7e, 0x89, 0x42, 0x04, 0x8c, 0x02, 0xf3, 0x03, 0x90, 0x6e, 0x87, 0x77, 0xb8,
-	0x59, 0x37, 0x5f, 0x43, 0xc8, 0x9d, 0xd1, 0x5c, 0x7a, 0x20, 0x60, 0x71, 0x4f, 0x66, 0xa3, 0xb4,
-	0xde, 0xc7, 0x10, 0xc3, 0x10, 0x77, 0x77, 0x0c, 0xa7, 0xdb, 0xa6, 0xab, 0xb1, 0x3d, 0x90, 0x92,
-	0x74, 0x32, 0xe0, 0xfd, 0xab, 0x0e, 0xea, 0x87, 0xdc, 0xef, 0xfe, 0x86, 0x6d, 0x30, 0x34, 0xb7,
-	0x1e, 0x86, 0xdc, 0x27, 0x0a, 0xe8, 0x2e, 0x91, 0xdd, 0x26, 0x6b, 0x73, 0xfc, 0x0c, 0x15, 0x28,
-	0x51, 0xa4, 0x6e, 0x2d, 0x5a, 0xcb, 0xd5, 0xb5, 0x15, 0xe7, 0xcf, 0x46, 0x38, 0x19, 0xd7, 0x33,
-	0x4c, 0x7c, 0x13, 0x95, 0xcc, 0xfc, 0x01, 0xad, 0x4f, 0x2d, 0x5a, 0xcb, 0x79, 0xaf, 0xa8, 0xcb,
-	0x26, 0x6d, 0x7c, 0x9b, 0x42, 0xe5, 0xd1, 0x3e, 0x4b, 0xe8, 0x3f, 0x46, 0x7a, 0x20, 0x23, 0xe2,
-	0x83, 0x86, 0xea, 0xfd, 0x2a, 0x5e, 0x75, 0xb4, 0xd6, 0xa4, 0x78, 0x01, 0x55, 0x0f, 0xb9, 0xe8,
-	0xb6, 0x43, 0x7e, 0x98, 0x89, 0x55, 0x3c, 0x94, 0x2d, 0x35, 0x29, 0xbe, 0x81, 0x8a, 0x22, 0x66,
-	0xba, 0x97, 0x37, 0xbd, 0x69, 0x11, 0xb3, 0x26, 0xc5, 0x2b, 0x08, 0x4b, 0xff, 0x2d, 0xd0, 0x38,
-	0x04, 0xda, 0x82, 0x04, 0x98, 0xd2, 0x90, 0x82, 0x99, 0x65, 0x76, 0xd4, 0x79, 0xa1, 0x1b, 0x4d,
-	0x8a, 0xd7, 0x51, 0xd5, 0x17, 0x40, 0x14, 0xb4, 0x74, 0x7e, 0xf5, 0x69, 0x73, 0xee, 0x79, 0x27,
-	0x0d, 0xd7, 0xc9, 0xc2, 0x75, 0x76, 0xb3, 0x70, 0x37, 0x0a, 0x47, 0xdf, 0x17, 0x2c, 0x0f, 0xa5,
-	0x24, 0xbd, 0xac, 0x25, 0xe0, 0x43, 0x14, 0x88, 0x7e, 0x2a, 0x51, 0x9c, 0x54, 0x22, 0x25, 0x19,
-	0x89, 0xa7, 0x68, 0xda, 0xa4, 0x54, 0x2f, 0x19, 0xf2, 0xbd, 0x2b, 0x7d, 0x37, 0x08, 0xed, 0xf8,
-	0x3e, 0xf8, 0x8a, 0x8b, 0xe7, 0xba, 0xf4, 0x52, 0x5e, 0xe3, 0x6b, 0x1e, 0xfd, 0xaf, 0xcd, 0xdd,
-	0xd1, 0xf7, 0x62, 0x52, 0x87, 0x31, 0x2a, 0xe8, 0x72, 0x68, 0xad, 0xf9, 0xc6, 0xeb, 0xa8, 0x62,
-	0xe2, 0x53, 0xfd, 0x08, 0x8c, 0xaf, 0xb5, 0xb5, 0xdb, 0x17, 0xd3, 0xe8, 0x31, 0xcc, 0x35, 0xcd,
-	0x82, 0x37, 0xfb, 0xed, 0xf6, 0x23, 0xf0, 0xca, 0x9a, 0xa6, 0xbf, 0xf0, 0x23, 0x54, 0xe8, 0x06,
-	0x2c, 0xb5, 0x7c, 0x02, 0xf6, 0x56, 0xc0, 0xa8, 0x67, 0x18, 0xf8, 0x16, 0xaa, 0x10, 0xbf, 0xdb,
-	0x0a, 0x21, 0x81, 0xd0, 0x44, 0x91, 0xf7, 0xca, 0xc4, 0xef, 0x6e, 0xeb, 0xfa, 0x5f, 0xd8, 0xfc,
-	0x12, 0xcd, 0x86, 0x44, 0xaa, 0x56, 0x1c, 0xd1, 0x51, 0xe2, 0xa5, 0x09, 0x75, 0x6a, 0x9a, 0xb9,
-	0x67, 0x88, 0x46, 0xeb, 0x0d, 0x9a, 0x49, 0xf4, 0x43, 0xe0, 0x2c, 0x60, 0x9d, 0x96, 0x79, 0x34,
-	0x65, 0x23, 0xb5, 0x36, 0xc9, 0xa3, 0xd9, 0x1f, 0x51, 0x37, 0x89, 0x22, 0x5e, 0x2d, 0x19, 0xab,
-	0x1b, 0x9f, 0x2d, 0x54, 0x1b, 0x87, 0xe0, 0x1d, 0x34, 0xe3, 0xc7, 0x42, 0xe8, 0xeb, 0x4c, 0xa1,
-	0x4d, 0xe2, 0x50, 0x0d, 0x1f, 0xe9, 0xf2, 0xb8, 0xc1, 0xa3, 0x5f, 0x87, 0x4b, 0xdb, 0x34, 0xe9,
-	0x2b, 0x4e, 0xc1, 0xab, 0x0d, 0x05, 0x36, 0x53, 0x3e, 0xde, 0x43, 0x73, 0x3e, 0xef, 0x45, 0x44,
-	0x05, 0x07, 0x21, 0xb4, 0x42, 0x20, 0x09, 0xc8, 0xfa, 0xd4, 0x62, 0xfe, 0xaf, 0x44, 0x67, 0x2f,
-	0x24, 0xb6, 0x8d, 0x42, 0x83, 0xa0, 0x92, 0x0e, 0x77, 0x0b, 0xfa, 0xf8, 0x09, 0xaa, 0xb4, 0x03,
-	0x31, 0x74, 0xda, 0x9a, 0xd0, 0xe9, 0xb2, 0xa6, 0x18, 0x8f, 0xaf, 0xfb, 0x2d, 0xd9, 0x78, 0x77,
-	0x7c, 0x6a, 0xe7, 0x4e, 0x4e, 0xed, 0xdc, 0xf9, 0xa9, 0x6d, 0x7d, 0x1c, 0xd8, 0xd6, 0xa7, 0x81,
-	0x6d, 0x7d, 0x19, 0xd8, 0xd6, 0xf1, 0xc0, 0xb6, 0x7e, 0x0c, 0x6c, 0xeb, 0xe7, 0xc0, 0xce, 0x9d,
-	0x0f, 0x6c, 0xeb, 0xe8, 0xcc, 0xce, 0x1d, 0x9f, 0xd9, 0xb9, 0x93, 0x33, 0x3b, 0xf7, 0xfa, 0x61,
-	0x87, 0x5f, 0x1c, 0x2b, 0xe0, 0xd7, 0xff, 0x19, 0x3c, 0xbe, 0x54, 0x1e, 0x14, 0xcd, 0xa0, 0x0f,
-	0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x4b, 0xc0, 0xf0, 0x45, 0x06, 0x00, 0x00,
+	// 766 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xf3, 0x44,
+	0x14, 0x8d, 0xbf, 0xfc, 0x4f, 0x20, 0x6d, 0x47, 0x42, 0x44, 0x41, 0x72, 0xd3, 0x08, 0x41, 0x40,
+	0x95, 0xad, 0x16, 0x16, 0x48, 0x08, 0x41, 0x4b, 0x59, 0x84, 0x56, 0x48, 0xb5, 0xda, 0x2e, 0x60,
+	0x61, 0x4d, 0x3d, 0x37, 0x61, 0xb0, 0xe3, 0x31, 0x33, 0x63, 0x97, 0xec, 0x78, 0x84, 0x6e, 0x79,
+	0x03, 0x9e, 0x81, 0x27, 0x60, 0xc1, 0xa2, 0xcb, 0xee, 0xa0, 0xe9, 0x86, 0x65, 0x1f, 0x01, 0xcd,
+	0x38, 0x76, 0x1b, 0xd1, 0x8a, 0x20, 0x7d, 0xbb, 0xb9, 0x73, 0xcf, 0x39, 0x73, 0xe7, 0x9e, 0xb9,
+	0x36, 0x72, 0x14, 0xcc, 0x12, 0x2e, 0x48, 0xe4, 0x4a, 0x10, 0x19, 0x08, 0x97, 0x24, 0xcc, 0x4d,
+	0x40, 0x48, 0x26, 0x15, 0xc4, 0x01, 0xb8, 0xd9, 0x9e, 0xab, 0x88, 0x0c, 0xa5, 0x93, 0x08, 0xae,
+	0x38, 0x1e, 0x16, 0x78, 0x27, 0xc7, 0x3b, 0x24, 0x61, 0xce, 0x13, 0xbc, 0x93, 0xed, 0xf5, 0xb7,
+	0xa7, 0x9c, 0x4f, 0x23, 0x70, 0x0d, 0xe3, 0x32, 0x9d, 0xb8, 0x8a, 0xcd, 0x40, 0x2a, 0x32, 0x4b,
+	0x72, 0x91, 0xfe, 0x0e, 0x85, 0x04, 0x62, 0x0a, 0x71, 0xc0, 0x40, 0xba, 0x53, 0x3e, 0xe5, 0x66,
+	0xdf, 0xac, 0x96, 0x90, 0xf7, 0xca, 0xba, 0x74, 0x41, 0x10, 0xa7, 0x33, 0x59, 0x94, 0xe2, 0xff,
+	0x98, 0x42, 0x0a, 0x4b, 0xdc, 0xfb, 0x2b, 0x38, 0x9d, 0x36, 0x59, 0x8d, 0x9d, 0x81, 0x94, 0x64,
+	0x5a, 0x00, 0x3f, 0x7c, 0xee, 0xa2, 0x41, 0xc4, 0x83, 0xf0, 0x5f, 0xd8, 0x61, 0x8c, 0xb6, 0x0e,
+	0xa2, 0x88, 0x07, 0x44, 0x01, 0x3d, 0x23, 0x32, 0x1c, 0xc7, 0x13, 0x8e, 0xbf, 0x40, 0x35, 0x4a,
+	0x14, 0xe9, 0x59, 0x03, 0x6b, 0xd4, 0xd9, 0xdf, 0x75, 0xfe, 0xbb, 0x11, 0x4e, 0xc1, 0xf5, 0x0c,
+	0x13, 0xbf, 0x8d, 0x9a, 0xa6, 0x7e, 0x46, 0x7b, 0xaf, 0x06, 0xd6, 0xa8, 0xea, 0x35, 0x74, 0x38,
+	0xa6, 0xc3, 0x5f, 0xaa, 0xa8, 0x55, 0x9e, 0xb3, 0x83, 0xde, 0x88, 0xc9, 0x0c, 0x64, 0x42, 0x02,
+	0xd0, 0x50, 0x7d, 0x5e, 0xdb, 0xeb, 0x94, 0x7b, 0x63, 0x8a, 0xb7, 0x51, 0xe7, 0x8a, 0x8b, 0x70,
+	0x12, 0xf1, 0xab, 0x42, 0xac, 0xed, 0xa1, 0x62, 0x6b, 0x4c, 0xf1, 0x5b, 0xa8, 0x21, 0xd2, 0x58,
+	0xe7, 0xaa, 0x26, 0x57, 0x17, 0x69, 0x3c, 0xa6, 0x78, 0x17, 0x61, 0x19, 0x7c, 0x0f, 0x34, 0x8d,
+	0x80, 0xfa, 0x90, 0x41, 0xac, 0x34, 0xa4, 0x66, 0x6a, 0xd9, 0x2c, 0x33, 0x5f, 0xe9, 0xc4, 0x98,
+	0xe2, 0x03, 0xd4, 0x09, 0x04, 0x10, 0x05, 0xbe, 0xf6, 0xaf, 0x57, 0x37, 0xf7, 0xee, 0x3b, 0xb9,
+	0xb9, 0x4e, 0x61, 0xae, 0x73, 0x56, 0x98, 0x7b, 0x58, 0xbb, 0xfe, 0x73, 0xdb, 0xf2, 0x50, 0x4e,
+	0xd2, 0xdb, 0x5a, 0x02, 0x7e, 0x4a, 0x98, 0x98, 0xe7, 0x12, 0x8d, 0x75, 0x25, 0x72, 0x92, 0x91,
+	0xf8, 0x1c, 0xd5, 0x8d, 0x4b, 0xbd, 0xa6, 0x21, 0x7f, 0xf0, 0x6c, 0xdf, 0x0d, 0x42, 0x77, 0xfc,
+	0x02, 0x02, 0xc5, 0xc5, 0x97, 0x3a, 0xf4, 0x72, 0x1e, 0xee, 0xa3, 0x56, 0x22, 0x18, 0x17, 0x4c,
+	0xcd, 0x7b, 0xad, 0x81, 0x35, 0xaa, 0x7b, 0x65, 0xac, 0x7b, 0x3d, 0x21, 0x4c, 0xc4, 0x20, 0xa5,
+	0x1f, 0xc2, 0xbc, 0xd7, 0xce, 0x7b, 0x5d, 0xec, 0x1d, 0xc3, 0x7c, 0xf8, 0x47, 0x15, 0xbd, 0xa9,
+	0xbd, 0x39, 0xd5, 0xcf, 0x6a, 0x5d, 0x83, 0x30, 0xaa, 0xe9, 0x70, 0xe9, 0x8c, 0x59, 0xe3, 0x03,
+	0xd4, 0x36, 0xee, 0xab, 0x79, 0x02, 0xc6, 0x96, 0xee, 0xfe, 0xbb, 0x8f, 0x97, 0xd1, 0xb7, 0x30,
+	0xaf, 0xbc, 0x78, 0x37, 0xe6, 0xbc, 0xb3, 0x79, 0x02, 0x5e, 0x4b, 0xd3, 0xf4, 0x0a, 0x7f, 0x82,
+	0x6a, 0x21, 0x8b, 0x73, 0xc7, 0xd6, 0x60, 0x1f, 0xb3, 0x98, 0x7a, 0x86, 0x81, 0xdf, 0x41, 0x6d,
+	0x12, 0x84, 0x7e, 0x04, 0x19, 0x44, 0xc6, 0xc9, 0xaa, 0xd7, 0x22, 0x41, 0x78, 0xa2, 0xe3, 0xd7,
+	0xe1, 0xd2, 0xd7, 0x68, 0x33, 0x22, 0x52, 0xf9, 0x69, 0x42, 0xcb, 0x07, 0xd3, 0x5c, 0x53, 0xa7,
+	0xab, 0x99, 0xe7, 0x86, 0x68, 0xb4, 0xbe, 0x43, 0x1b, 0x99, 0x9e, 0x23, 0x1e, 0xb3, 0x78, 0xea,
+	0x9b, 0x99, 0x6b, 0x19, 0xa9, 0xfd, 0x75, 0x66, 0xee, 0xa2, 0xa4, 0x1e, 0x11, 0x45, 0xbc, 0x6e,
+	0xb6, 0x12, 0x0f, 0x7f, 0xb3, 0x50, 0x77, 0x15, 0x82, 0x4f, 0xd1, 0x46, 0x90, 0x0a, 0xa1, 0xa7,
+	0x81, 0xc2, 0x84, 0xa4, 0x91, 0x5a, 0xce, 0xf8, 0x68, 0xb5, 0xc1, 0xe5, 0xc7, 0xe5, 0xc9, 0x31,
+	0x63, 0xfa, 0x0d, 0xa7, 0xe0, 0x75, 0x97, 0x02, 0x47, 0x39, 0x1f, 0x9f, 0xa3, 0xad, 0x80, 0xcf,
+	0x12, 0xa2, 0xd8, 0x65, 0x04, 0x7e, 0x04, 0x24, 0x03, 0xd9, 0x7b, 0x35, 0xa8, 0xfe, 0x2f, 0xd1,
+	0xcd, 0x47, 0x89, 0x13, 0xa3, 0x30, 0x24, 0xa8, 0xa9, 0xcd, 0x3d, 0x86, 0x39, 0xfe, 0x0c, 0xb5,
+	0x27, 0x4c, 0x2c, 0x3b, 0x6d, 0xad, 0xd9, 0xe9, 0x96, 0xa6, 0x98, 0x1e, 0xbf, 0xf4, 0x29, 0x3a,
+	0xfc, 0xe1, 0xe6, 0xce, 0xae, 0xdc, 0xde, 0xd9, 0x95, 0x87, 0x3b, 0xdb, 0xfa, 0x79, 0x61, 0x5b,
+	0xbf, 0x2e, 0x6c, 0xeb, 0xf7, 0x85, 0x6d, 0xdd, 0x2c, 0x6c, 0xeb, 0xaf, 0x85, 0x6d, 0xfd, 0xbd,
+	0xb0, 0x2b, 0x0f, 0x0b, 0xdb, 0xba, 0xbe, 0xb7, 0x2b, 0x37, 0xf7, 0x76, 0xe5, 0xf6, 0xde, 0xae,
+	0x7c, 0xfb, 0xf1, 0x94, 0x3f, 0x5e, 0x8b, 0xf1, 0x97, 0xff, 0x25, 0x9f, 0x3e, 0x09, 0x2f, 0x1b,
+	0xa6, 0xd0, 0x8f, 0xfe, 0x19, 0x00, 0x44, 0xe7, 0x8d, 0x52, 0x84, 0x06, 0x00, 0x00,
 }
 
 func (this *AllocatedTaskInfo) Equal(that interface{}) bool {
@@ -545,6 +563,12 @@ func (this *TaskInfo) Equal(that interface{}) bool {
 	if !this.Clock.Equal(that1.Clock) {
 		return false
 	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if this.FairnessKey != that1.FairnessKey {
+		return false
+	}
 	return true
 }
 func (this *TaskQueueInfo) Equal(that interface{}) bool {
@@ -680,7 +704,7 @@ func (this *TaskInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 13)
 	s = append(s, "&persistence.TaskInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -691,6 +715,8 @@ func (this *TaskInfo) GoString() string {
 	if this.Clock != nil {
 		s = append(s, "Clock: "+fmt.Sprintf("%#v", this.Clock)+",\n")
 	}
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
+	s = append(s, "FairnessKey: "+fmt.Sprintf("%#v", this.FairnessKey)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -807,6 +833,18 @@ func (m *TaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintTasks(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Priority != 0 {
+		i = encodeVarintTasks(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.Clock != nil {
 		{
 			size, err := m.Clock.MarshalToSizedBuffer(dAtA[:i])
@@ -1099,6 +1137,13 @@ func (m *TaskInfo) Size() (n int) {
 		l = m.Clock.Size()
 		n += 1 + l + sovTasks(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovTasks(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovTasks(uint64(l))
+	}
 	return n
 }
 
@@ -1204,6 +1249,8 @@ func (this *TaskInfo) String() string {
 		`CreateTime:` + strings.Replace(fmt.Sprintf("%v", this.CreateTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`ExpiryTime:` + strings.Replace(fmt.Sprintf("%v", this.ExpiryTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v1.VectorClock", 1) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`FairnessKey:` + fmt.Sprintf("%v", this.FairnessKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1620,6 +1667,57 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTasks(dAtA[iNdEx:])
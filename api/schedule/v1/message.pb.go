@@ -129,6 +129,83 @@ func (m *BufferedStart) GetManual() bool {
 	return false
 }
 
+// Outcome of a workflow run started by the schedule.
+type RunResult struct {
+	// Run id is the first execution run id of the chain that the schedule started
+	Execution      *v11.WorkflowExecution     `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	ScheduleTime   *time.Time                 `protobuf:"bytes,2,opt,name=schedule_time,json=scheduleTime,proto3,stdtime" json:"schedule_time,omitempty"`
+	CloseTime      *time.Time                 `protobuf:"bytes,3,opt,name=close_time,json=closeTime,proto3,stdtime" json:"close_time,omitempty"`
+	Status         v1.WorkflowExecutionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"status,omitempty"`
+	FailureMessage string                     `protobuf:"bytes,5,opt,name=failure_message,json=failureMessage,proto3" json:"failure_message,omitempty"`
+}
+
+func (m *RunResult) Reset()      { *m = RunResult{} }
+func (*RunResult) ProtoMessage() {}
+func (*RunResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6461b6986ba20ee7, []int{1}
+}
+func (m *RunResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RunResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RunResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RunResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunResult.Merge(m, src)
+}
+func (m *RunResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *RunResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunResult proto.InternalMessageInfo
+
+func (m *RunResult) GetExecution() *v11.WorkflowExecution {
+	if m != nil {
+		return m.Execution
+	}
+	return nil
+}
+
+func (m *RunResult) GetScheduleTime() *time.Time {
+	if m != nil {
+		return m.ScheduleTime
+	}
+	return nil
+}
+
+func (m *RunResult) GetCloseTime() *time.Time {
+	if m != nil {
+		return m.CloseTime
+	}
+	return nil
+}
+
+func (m *RunResult) GetStatus() v1.WorkflowExecutionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return v1.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED
+}
+
+func (m *RunResult) GetFailureMessage() string {
+	if m != nil {
+		return m.FailureMessage
+	}
+	return ""
+}
+
 type InternalState struct {
 	Namespace   string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	NamespaceId string `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -143,12 +220,16 @@ type InternalState struct {
 	// conflict token is implemented as simple sequence number
 	ConflictToken int64 `protobuf:"varint,7,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
 	NeedRefresh   bool  `protobuf:"varint,9,opt,name=need_refresh,json=needRefresh,proto3" json:"need_refresh,omitempty"`
+	// outcomes of the most recent closed runs, oldest first
+	RecentRunResults []*RunResult `protobuf:"bytes,10,rep,name=recent_run_results,json=recentRunResults,proto3" json:"recent_run_results,omitempty"`
+	// number of failed runs since the last successful one
+	ConsecutiveFailures int64 `protobuf:"varint,11,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
 }
 
 func (m *InternalState) Reset()      { *m = InternalState{} }
 func (*InternalState) ProtoMessage() {}
 func (*InternalState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6461b6986ba20ee7, []int{1}
+	return fileDescriptor_6461b6986ba20ee7, []int{2}
 }
 func (m *InternalState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -240,17 +321,78 @@ func (m *InternalState) GetNeedRefresh() bool {
 	return false
 }
 
+func (m *InternalState) GetRecentRunResults() []*RunResult {
+	if m != nil {
+		return m.RecentRunResults
+	}
+	return nil
+}
+
+func (m *InternalState) GetConsecutiveFailures() int64 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
+// Per-schedule policies that aren't part of the public schedule API.
+type InternalPolicies struct {
+	// consecutive failed runs before pausing, if pause-on-failure is set. zero means use
+	// the namespace default.
+	PauseAfterFailures int64 `protobuf:"varint,1,opt,name=pause_after_failures,json=pauseAfterFailures,proto3" json:"pause_after_failures,omitempty"`
+}
+
+func (m *InternalPolicies) Reset()      { *m = InternalPolicies{} }
+func (*InternalPolicies) ProtoMessage() {}
+func (*InternalPolicies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6461b6986ba20ee7, []int{3}
+}
+func (m *InternalPolicies) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InternalPolicies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InternalPolicies.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InternalPolicies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InternalPolicies.Merge(m, src)
+}
+func (m *InternalPolicies) XXX_Size() int {
+	return m.Size()
+}
+func (m *InternalPolicies) XXX_DiscardUnknown() {
+	xxx_messageInfo_InternalPolicies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InternalPolicies proto.InternalMessageInfo
+
+func (m *InternalPolicies) GetPauseAfterFailures() int64 {
+	if m != nil {
+		return m.PauseAfterFailures
+	}
+	return 0
+}
+
 type StartScheduleArgs struct {
 	Schedule     *v13.Schedule      `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	Info         *v13.ScheduleInfo  `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
 	InitialPatch *v13.SchedulePatch `protobuf:"bytes,3,opt,name=initial_patch,json=initialPatch,proto3" json:"initial_patch,omitempty"`
 	State        *InternalState     `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Policies     *InternalPolicies  `protobuf:"bytes,5,opt,name=policies,proto3" json:"policies,omitempty"`
 }
 
 func (m *StartScheduleArgs) Reset()      { *m = StartScheduleArgs{} }
 func (*StartScheduleArgs) ProtoMessage() {}
 func (*StartScheduleArgs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6461b6986ba20ee7, []int{2}
+	return fileDescriptor_6461b6986ba20ee7, []int{4}
 }
 func (m *StartScheduleArgs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -307,6 +449,13 @@ func (m *StartScheduleArgs) GetState() *InternalState {
 	return nil
 }
 
+func (m *StartScheduleArgs) GetPolicies() *InternalPolicies {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
 type FullUpdateRequest struct {
 	Schedule      *v13.Schedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	ConflictToken int64         `protobuf:"varint,2,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
@@ -315,7 +464,7 @@ type FullUpdateRequest struct {
 func (m *FullUpdateRequest) Reset()      { *m = FullUpdateRequest{} }
 func (*FullUpdateRequest) ProtoMessage() {}
 func (*FullUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6461b6986ba20ee7, []int{3}
+	return fileDescriptor_6461b6986ba20ee7, []int{5}
 }
 func (m *FullUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,15 +508,17 @@ func (m *FullUpdateRequest) GetConflictToken() int64 {
 }
 
 type DescribeResponse struct {
-	Schedule      *v13.Schedule     `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
-	Info          *v13.ScheduleInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
-	ConflictToken int64             `protobuf:"varint,3,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
+	Schedule         *v13.Schedule     `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Info             *v13.ScheduleInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	ConflictToken    int64             `protobuf:"varint,3,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
+	RecentRunResults []*RunResult      `protobuf:"bytes,4,rep,name=recent_run_results,json=recentRunResults,proto3" json:"recent_run_results,omitempty"`
+	Policies         *InternalPolicies `protobuf:"bytes,5,opt,name=policies,proto3" json:"policies,omitempty"`
 }
 
 func (m *DescribeResponse) Reset()      { *m = DescribeResponse{} }
 func (*DescribeResponse) ProtoMessage() {}
 func (*DescribeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6461b6986ba20ee7, []int{4}
+	return fileDescriptor_6461b6986ba20ee7, []int{6}
 }
 func (m *DescribeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -417,6 +568,20 @@ func (m *DescribeResponse) GetConflictToken() int64 {
 	return 0
 }
 
+func (m *DescribeResponse) GetRecentRunResults() []*RunResult {
+	if m != nil {
+		return m.RecentRunResults
+	}
+	return nil
+}
+
+func (m *DescribeResponse) GetPolicies() *InternalPolicies {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
 type WatchWorkflowRequest struct {
 	// Note: this will be sent to the activity with empty execution.run_id, and
 	// the run id that we started in first_execution_run_id.
@@ -428,7 +593,7 @@ type WatchWorkflowRequest struct {
 func (m *WatchWorkflowRequest) Reset()      { *m = WatchWorkflowRequest{} }
 func (*WatchWorkflowRequest) ProtoMessage() {}
 func (*WatchWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6461b6986ba20ee7, []int{5}
+	return fileDescriptor_6461b6986ba20ee7, []int{7}
 }
 func (m *WatchWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -489,7 +654,7 @@ type WatchWorkflowResponse struct {
 func (m *WatchWorkflowResponse) Reset()      { *m = WatchWorkflowResponse{} }
 func (*WatchWorkflowResponse) ProtoMessage() {}
 func (*WatchWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6461b6986ba20ee7, []int{6}
+	return fileDescriptor_6461b6986ba20ee7, []int{8}
 }
 func (m *WatchWorkflowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,7 +745,7 @@ type StartWorkflowRequest struct {
 func (m *StartWorkflowRequest) Reset()      { *m = StartWorkflowRequest{} }
 func (*StartWorkflowRequest) ProtoMessage() {}
 func (*StartWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6461b6986ba20ee7, []int{7}
+	return fileDescriptor_6461b6986ba20ee7, []int{9}
 }
 func (m *StartWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,7 +803,7 @@ type StartWorkflowResponse struct {
 func (m *StartWorkflowResponse) Reset()      { *m = StartWorkflowResponse{} }
 func (*StartWorkflowResponse) ProtoMessage() {}
 func (*StartWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6461b6986ba20ee7, []int{8}
+	return fileDescriptor_6461b6986ba20ee7, []int{10}
 }
 func (m *StartWorkflowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -692,7 +857,7 @@ type CancelWorkflowRequest struct {
 func (m *CancelWorkflowRequest) Reset()      { *m = CancelWorkflowRequest{} }
 func (*CancelWorkflowRequest) ProtoMessage() {}
 func (*CancelWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6461b6986ba20ee7, []int{9}
+	return fileDescriptor_6461b6986ba20ee7, []int{11}
 }
 func (m *CancelWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -760,7 +925,7 @@ type TerminateWorkflowRequest struct {
 func (m *TerminateWorkflowRequest) Reset()      { *m = TerminateWorkflowRequest{} }
 func (*TerminateWorkflowRequest) ProtoMessage() {}
 func (*TerminateWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6461b6986ba20ee7, []int{10}
+	return fileDescriptor_6461b6986ba20ee7, []int{12}
 }
 func (m *TerminateWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -819,7 +984,9 @@ func (m *TerminateWorkflowRequest) GetReason() string {
 
 func init() {
 	proto.RegisterType((*BufferedStart)(nil), "temporal.server.api.schedule.v1.BufferedStart")
+	proto.RegisterType((*RunResult)(nil), "temporal.server.api.schedule.v1.RunResult")
 	proto.RegisterType((*InternalState)(nil), "temporal.server.api.schedule.v1.InternalState")
+	proto.RegisterType((*InternalPolicies)(nil), "temporal.server.api.schedule.v1.InternalPolicies")
 	proto.RegisterType((*StartScheduleArgs)(nil), "temporal.server.api.schedule.v1.StartScheduleArgs")
 	proto.RegisterType((*FullUpdateRequest)(nil), "temporal.server.api.schedule.v1.FullUpdateRequest")
 	proto.RegisterType((*DescribeResponse)(nil), "temporal.server.api.schedule.v1.DescribeResponse")
@@ -836,77 +1003,88 @@ func init() {
 }
 
 var fileDescriptor_6461b6986ba20ee7 = []byte{
-	// 1117 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xda, 0x8e, 0x63, 0x8f, 0x63, 0x37, 0xd9, 0x26, 0x91, 0x15, 0x60, 0xe3, 0x5a, 0xb4,
-	0x04, 0x09, 0xd6, 0x4a, 0x2a, 0x71, 0x28, 0xa8, 0x52, 0x93, 0x12, 0x62, 0x04, 0xc2, 0x9a, 0x04,
-	0x82, 0xb8, 0xac, 0x26, 0xbb, 0x63, 0x67, 0xd5, 0xf1, 0xcc, 0x32, 0x33, 0x9b, 0x36, 0x9c, 0x38,
-	0x70, 0x45, 0xea, 0x1f, 0xe0, 0xc4, 0x85, 0x2b, 0x42, 0xf0, 0x1b, 0x38, 0xe6, 0xd8, 0x1b, 0xc4,
-	0xb9, 0x20, 0x71, 0xe9, 0x4f, 0x40, 0x33, 0x3b, 0xe3, 0xc4, 0x4e, 0xd2, 0x04, 0x8a, 0x84, 0xb8,
-	0xed, 0x7b, 0xfb, 0xbe, 0x6f, 0xde, 0xbe, 0xf7, 0xbd, 0x37, 0x0b, 0xde, 0x96, 0x78, 0x90, 0x30,
-	0x8e, 0x48, 0x5b, 0x60, 0x7e, 0x80, 0x79, 0x1b, 0x25, 0x71, 0x5b, 0x84, 0xfb, 0x38, 0x4a, 0x09,
-	0x6e, 0x1f, 0xac, 0xb6, 0x07, 0x58, 0x08, 0xd4, 0xc7, 0x7e, 0xc2, 0x99, 0x64, 0xee, 0xb2, 0x0d,
-	0xf7, 0xb3, 0x70, 0x1f, 0x25, 0xb1, 0x6f, 0xc3, 0xfd, 0x83, 0xd5, 0xa5, 0xd7, 0x47, 0x7c, 0x8a,
-	0x28, 0x64, 0x83, 0x01, 0xa3, 0xe7, 0x68, 0x96, 0x6e, 0x8f, 0x45, 0xf5, 0x50, 0x4c, 0x52, 0x7e,
-	0xfe, 0xb4, 0x09, 0x32, 0x4c, 0xd3, 0x81, 0x50, 0x41, 0xa3, 0xf3, 0x5e, 0x18, 0xf5, 0x98, 0xf1,
-	0x47, 0x3d, 0xc2, 0x1e, 0x9b, 0xa8, 0x3b, 0x63, 0x51, 0x97, 0x7e, 0xe1, 0xd2, 0x3b, 0x63, 0x71,
-	0x96, 0x44, 0x7d, 0x6d, 0x1c, 0xea, 0x70, 0x8e, 0xbf, 0x4c, 0xb1, 0x90, 0x01, 0xc7, 0x22, 0x61,
-	0x54, 0x58, 0xdc, 0x72, 0x9f, 0xb1, 0x3e, 0xc1, 0x6d, 0x6d, 0xed, 0xa5, 0xbd, 0xb6, 0x8c, 0x07,
-	0x58, 0x48, 0x34, 0x48, 0x4c, 0xc0, 0xad, 0x08, 0x27, 0x98, 0x46, 0x98, 0x86, 0x31, 0x16, 0xed,
-	0x3e, 0xeb, 0x33, 0xed, 0xd7, 0x4f, 0x59, 0x48, 0xeb, 0x9b, 0x3c, 0xa8, 0xad, 0xa7, 0xbd, 0x1e,
-	0xe6, 0x38, 0xda, 0x96, 0x88, 0x4b, 0x77, 0x03, 0xcc, 0x50, 0x36, 0x88, 0x29, 0x22, 0x81, 0xe2,
-	0x6b, 0x38, 0x4d, 0x67, 0xa5, 0xba, 0xb6, 0xe4, 0x67, 0x87, 0xf9, 0xf6, 0x30, 0x7f, 0xc7, 0x1e,
-	0xb6, 0x5e, 0x7c, 0xfa, 0xdb, 0xb2, 0x03, 0xab, 0x06, 0xa5, 0xfc, 0xee, 0x03, 0x50, 0x45, 0xa1,
-	0x4c, 0x2d, 0x47, 0xfe, 0x9a, 0x1c, 0x20, 0x03, 0x69, 0x8a, 0x6d, 0x50, 0x67, 0x07, 0x98, 0x13,
-	0x94, 0x04, 0x09, 0x23, 0x71, 0x78, 0xd8, 0x28, 0x34, 0x9d, 0x95, 0xfa, 0xda, 0x5b, 0xfe, 0x48,
-	0x10, 0x4a, 0x09, 0xba, 0xf8, 0xfe, 0xc1, 0xaa, 0xbf, 0x6d, 0xea, 0xfb, 0x49, 0x06, 0xea, 0x6a,
-	0x0c, 0xac, 0xb1, 0xb3, 0xa6, 0xbb, 0x08, 0x4a, 0x03, 0x44, 0x53, 0x44, 0x1a, 0xc5, 0xa6, 0xb3,
-	0x52, 0x86, 0xc6, 0x6a, 0x7d, 0x5b, 0x04, 0xb5, 0x0e, 0x95, 0x98, 0x53, 0x44, 0xb6, 0x25, 0x92,
-	0xd8, 0x7d, 0x15, 0x54, 0x28, 0x1a, 0x60, 0x91, 0xa0, 0x30, 0xab, 0x41, 0x05, 0x9e, 0x3a, 0xdc,
-	0x5b, 0x60, 0x66, 0x64, 0x04, 0x71, 0xa4, 0x3f, 0xb0, 0x02, 0xab, 0x23, 0x5f, 0x27, 0x72, 0x97,
-	0x41, 0xd5, 0xb6, 0x5c, 0x45, 0x94, 0x75, 0x04, 0xb0, 0xae, 0x4e, 0xe4, 0x76, 0xc1, 0x4d, 0x82,
-	0x84, 0x0c, 0x12, 0xce, 0x42, 0x2c, 0x04, 0x8e, 0xb2, 0x5a, 0x15, 0xae, 0x59, 0xab, 0x39, 0x05,
-	0xee, 0x5a, 0xac, 0x2e, 0xd9, 0x2e, 0xb8, 0xb1, 0x67, 0x7a, 0x19, 0x08, 0xd5, 0x4c, 0xd1, 0x28,
-	0x36, 0x0b, 0x2b, 0xd5, 0x35, 0xdf, 0xbf, 0x62, 0x88, 0xfc, 0x31, 0x0d, 0xc0, 0xfa, 0xde, 0x59,
-	0x53, 0xb8, 0x9f, 0x81, 0x45, 0x9d, 0x6a, 0xc8, 0x06, 0x09, 0xc1, 0x32, 0x66, 0x54, 0x69, 0x31,
-	0x25, 0xb2, 0x31, 0xa5, 0xb3, 0x6d, 0x8e, 0xf7, 0x24, 0x9b, 0x41, 0x45, 0xdb, 0x45, 0x87, 0x84,
-	0xa1, 0x48, 0xc0, 0x79, 0x85, 0xdf, 0x18, 0xc1, 0xa1, 0x46, 0xbb, 0x1f, 0x83, 0xb9, 0x90, 0x51,
-	0x19, 0xd3, 0x14, 0x47, 0x81, 0x99, 0xc9, 0x46, 0xe9, 0x22, 0x4a, 0xf3, 0x52, 0x71, 0x6e, 0x66,
-	0x8f, 0x70, 0x76, 0x04, 0x35, 0x1e, 0xf7, 0x36, 0xa8, 0x87, 0x8c, 0xf6, 0x48, 0x1c, 0xca, 0x40,
-	0xb2, 0x47, 0x98, 0x36, 0xa6, 0x9b, 0xce, 0x4a, 0x01, 0xd6, 0xac, 0x77, 0x47, 0x39, 0x75, 0xf3,
-	0x30, 0x8e, 0x02, 0x8e, 0x7b, 0x1c, 0x8b, 0xfd, 0x46, 0x45, 0x4b, 0xa1, 0xaa, 0x7c, 0x30, 0x73,
-	0xb5, 0xbe, 0xcf, 0x83, 0x39, 0xfd, 0xed, 0x56, 0x55, 0x0f, 0x78, 0x5f, 0xb8, 0xf7, 0x41, 0xd9,
-	0xd6, 0xcc, 0x8c, 0x45, 0x6b, 0x3c, 0xcb, 0xb3, 0x15, 0xb5, 0x48, 0x38, 0xc2, 0xb8, 0xf7, 0x40,
-	0x31, 0xa6, 0x3d, 0x66, 0xc6, 0xe1, 0xce, 0xd5, 0xd8, 0x0e, 0xed, 0x31, 0xa8, 0x31, 0xee, 0x47,
-	0xa0, 0x16, 0xd3, 0x58, 0xc6, 0x88, 0x04, 0x09, 0x92, 0xe1, 0xbe, 0xd1, 0xc9, 0x1b, 0x57, 0x93,
-	0x74, 0x55, 0x38, 0x9c, 0x31, 0x68, 0x6d, 0xb9, 0x0f, 0xc1, 0x94, 0x50, 0x32, 0xd7, 0x63, 0x70,
-	0x1d, 0x7d, 0x8c, 0x0d, 0x07, 0xcc, 0xc0, 0xad, 0xaf, 0xc0, 0xdc, 0x66, 0x4a, 0xc8, 0xa7, 0x49,
-	0xa4, 0x9c, 0xd9, 0x92, 0x7a, 0xe9, 0x22, 0x9d, 0x6f, 0x62, 0xfe, 0x82, 0x26, 0xb6, 0x7e, 0x76,
-	0xc0, 0xec, 0x43, 0x2c, 0x42, 0x1e, 0xef, 0x61, 0x68, 0xf6, 0xe2, 0x7f, 0xda, 0xa0, 0xf3, 0x79,
-	0x17, 0x2e, 0xca, 0xfb, 0x47, 0x07, 0xcc, 0xef, 0xaa, 0x1e, 0xec, 0x9a, 0x3d, 0x6f, 0xeb, 0xf6,
-	0x01, 0xa8, 0xe0, 0x27, 0x38, 0x4c, 0xd5, 0x78, 0x98, 0xe6, 0xbe, 0x79, 0xd9, 0x58, 0x59, 0xec,
-	0xfb, 0x16, 0x00, 0x4f, 0xb1, 0xee, 0x5d, 0xb0, 0xd8, 0x8b, 0xb9, 0x90, 0xc1, 0xc8, 0x15, 0xf0,
-	0x94, 0xaa, 0x1d, 0x54, 0xd4, 0x3b, 0xe8, 0xa6, 0x7e, 0x7b, 0x0a, 0x4d, 0x69, 0x27, 0x72, 0x5f,
-	0x01, 0x15, 0xc2, 0x68, 0x5f, 0xad, 0x5a, 0xa2, 0x87, 0xba, 0x0c, 0xcb, 0xca, 0xd1, 0x65, 0x84,
-	0xb4, 0xfe, 0x74, 0xc0, 0xc2, 0x44, 0xce, 0xa6, 0xe0, 0x9b, 0xa0, 0xa4, 0xa4, 0x90, 0x0a, 0x5d,
-	0xee, 0xfa, 0x59, 0x21, 0x8d, 0x2d, 0xe7, 0x73, 0x09, 0x6f, 0x6b, 0x14, 0x34, 0x68, 0xf7, 0x1e,
-	0x28, 0x99, 0x85, 0x92, 0xbf, 0xde, 0x42, 0xd9, 0xca, 0x41, 0x83, 0x70, 0xdf, 0x03, 0xd3, 0x76,
-	0x75, 0x14, 0xae, 0xb7, 0x3a, 0xb6, 0x72, 0xd0, 0x42, 0xd6, 0x67, 0x41, 0x3d, 0xe3, 0xb1, 0xfb,
-	0xa7, 0xf5, 0x5d, 0x1e, 0xcc, 0xeb, 0xd9, 0x9f, 0xec, 0xd0, 0xe7, 0x60, 0xda, 0xdc, 0xc4, 0x26,
-	0xcb, 0xfb, 0xe3, 0x07, 0x4d, 0xdc, 0xdc, 0x5a, 0x27, 0x67, 0x79, 0x4e, 0x4b, 0x9e, 0xb1, 0x40,
-	0x4b, 0xf7, 0x82, 0xfd, 0x5a, 0xfc, 0xf7, 0xf7, 0xeb, 0xd4, 0x3f, 0xdd, 0xaf, 0x1f, 0x16, 0xcb,
-	0x85, 0xd9, 0x62, 0xeb, 0x09, 0x58, 0x98, 0x28, 0x8f, 0x11, 0xc3, 0x02, 0x28, 0x19, 0xa1, 0x65,
-	0xf7, 0xe5, 0x14, 0xd7, 0xd2, 0xda, 0x02, 0x37, 0x38, 0x46, 0x24, 0xbb, 0x91, 0xfe, 0xde, 0xff,
-	0x40, 0x4d, 0x01, 0xf5, 0x61, 0xea, 0x4d, 0xeb, 0x27, 0x07, 0x2c, 0x6c, 0x20, 0x1a, 0x62, 0x32,
-	0xd9, 0x9a, 0xd7, 0x00, 0xb0, 0x3f, 0x49, 0x71, 0xa4, 0x65, 0x50, 0x81, 0x15, 0xe3, 0xe9, 0x44,
-	0xee, 0x12, 0x28, 0xc7, 0x11, 0xa6, 0x32, 0x96, 0x87, 0x66, 0x08, 0x46, 0xf6, 0xf8, 0xdc, 0x4d,
-	0xbd, 0xc4, 0xdc, 0x2d, 0x2a, 0x0d, 0x23, 0xc1, 0xa8, 0xbe, 0xc1, 0x2a, 0xd0, 0x58, 0xad, 0x5f,
-	0x1c, 0xd0, 0xd8, 0xc1, 0x5c, 0xfd, 0x1c, 0x49, 0xfc, 0x3f, 0x4a, 0x7c, 0x3d, 0x3a, 0x3a, 0xf6,
-	0x72, 0xcf, 0x8e, 0xbd, 0xdc, 0xf3, 0x63, 0xcf, 0xf9, 0x7a, 0xe8, 0x39, 0x3f, 0x0c, 0x3d, 0xe7,
-	0xd7, 0xa1, 0xe7, 0x1c, 0x0d, 0x3d, 0xe7, 0xf7, 0xa1, 0xe7, 0xfc, 0x31, 0xf4, 0x72, 0xcf, 0x87,
-	0x9e, 0xf3, 0xf4, 0xc4, 0xcb, 0x1d, 0x9d, 0x78, 0xb9, 0x67, 0x27, 0x5e, 0xee, 0x0b, 0xbf, 0xcf,
-	0x4e, 0xb3, 0x88, 0xd9, 0x25, 0x3f, 0xf9, 0xef, 0xda, 0xe7, 0xbd, 0x92, 0xee, 0xfe, 0xdd, 0xbf,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xa8, 0x9f, 0xa4, 0x54, 0x17, 0x0c, 0x00, 0x00,
+	// 1290 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4f, 0x6f, 0x1c, 0x35,
+	0x14, 0xdf, 0xd9, 0x4d, 0xd2, 0xdd, 0xb7, 0xd9, 0x6d, 0xe2, 0x26, 0xd1, 0x2a, 0xc0, 0x26, 0x5d,
+	0xd1, 0x36, 0x20, 0x98, 0x25, 0xa9, 0xc4, 0xa1, 0xa0, 0xa2, 0xa6, 0x7f, 0x68, 0x10, 0x15, 0x91,
+	0x53, 0x68, 0xc5, 0x65, 0xe4, 0xcc, 0x78, 0xb7, 0xa3, 0x7a, 0xed, 0x61, 0xec, 0x49, 0x5b, 0x4e,
+	0x1c, 0xf8, 0x00, 0x3d, 0x23, 0x71, 0xe7, 0x8a, 0x90, 0xf8, 0x0c, 0x5c, 0x90, 0x7a, 0xec, 0x09,
+	0xe8, 0xf6, 0x82, 0xc4, 0xa5, 0xe2, 0x13, 0x20, 0x7b, 0xec, 0xd9, 0x6c, 0xfe, 0xb4, 0x29, 0xa9,
+	0x84, 0xb8, 0x8d, 0x9f, 0xdf, 0xef, 0x67, 0xfb, 0xbd, 0xdf, 0xf3, 0xf3, 0xc0, 0xbb, 0x8a, 0x0e,
+	0x12, 0x91, 0x12, 0xd6, 0x95, 0x34, 0xdd, 0xa1, 0x69, 0x97, 0x24, 0x71, 0x57, 0x86, 0x77, 0x68,
+	0x94, 0x31, 0xda, 0xdd, 0x59, 0xed, 0x0e, 0xa8, 0x94, 0xa4, 0x4f, 0xfd, 0x24, 0x15, 0x4a, 0xa0,
+	0x25, 0xe7, 0xee, 0xe7, 0xee, 0x3e, 0x49, 0x62, 0xdf, 0xb9, 0xfb, 0x3b, 0xab, 0x8b, 0x6f, 0x16,
+	0x7c, 0x9a, 0x28, 0x14, 0x83, 0x81, 0xe0, 0xfb, 0x68, 0x16, 0xcf, 0x8c, 0x79, 0xf5, 0x48, 0xcc,
+	0xb2, 0x74, 0xff, 0x6a, 0x7b, 0xc8, 0x28, 0xcf, 0x06, 0x52, 0x3b, 0x15, 0xeb, 0x3d, 0xd7, 0xeb,
+	0x9e, 0x48, 0xef, 0xf6, 0x98, 0xb8, 0x67, 0xbd, 0xce, 0x8e, 0x79, 0x1d, 0x7a, 0xc2, 0xc5, 0xf7,
+	0xc7, 0xfc, 0x1c, 0x89, 0x3e, 0x6d, 0x1c, 0x1a, 0xf7, 0x94, 0x7e, 0x95, 0x51, 0xa9, 0x82, 0x94,
+	0xca, 0x44, 0x70, 0xe9, 0x70, 0x4b, 0x7d, 0x21, 0xfa, 0x8c, 0x76, 0xcd, 0x68, 0x3b, 0xeb, 0x75,
+	0x55, 0x3c, 0xa0, 0x52, 0x91, 0x41, 0x62, 0x1d, 0x4e, 0x47, 0x34, 0xa1, 0x3c, 0xa2, 0x3c, 0x8c,
+	0xa9, 0xec, 0xf6, 0x45, 0x5f, 0x18, 0xbb, 0xf9, 0xca, 0x5d, 0x3a, 0xdf, 0x96, 0xa1, 0xb1, 0x9e,
+	0xf5, 0x7a, 0x34, 0xa5, 0xd1, 0x96, 0x22, 0xa9, 0x42, 0x97, 0x61, 0x9a, 0x8b, 0x41, 0xcc, 0x09,
+	0x0b, 0x34, 0x5f, 0xcb, 0x5b, 0xf6, 0x56, 0xea, 0x6b, 0x8b, 0x7e, 0xbe, 0x98, 0xef, 0x16, 0xf3,
+	0x6f, 0xba, 0xc5, 0xd6, 0x27, 0x1e, 0xfe, 0xbe, 0xe4, 0xe1, 0xba, 0x45, 0x69, 0x3b, 0xba, 0x04,
+	0x75, 0x12, 0xaa, 0xcc, 0x71, 0x94, 0x8f, 0xc8, 0x01, 0x39, 0xc8, 0x50, 0x6c, 0x41, 0x53, 0xec,
+	0xd0, 0x94, 0x91, 0x24, 0x48, 0x04, 0x8b, 0xc3, 0x07, 0xad, 0xca, 0xb2, 0xb7, 0xd2, 0x5c, 0x7b,
+	0xc7, 0x2f, 0x04, 0xa1, 0x95, 0x60, 0x82, 0xef, 0xef, 0xac, 0xfa, 0x5b, 0x36, 0xbe, 0x9f, 0xe5,
+	0xa0, 0x4d, 0x83, 0xc1, 0x0d, 0xb1, 0x7b, 0x88, 0x16, 0x60, 0x6a, 0x40, 0x78, 0x46, 0x58, 0x6b,
+	0x62, 0xd9, 0x5b, 0xa9, 0x62, 0x3b, 0xea, 0xfc, 0x5a, 0x86, 0x1a, 0xce, 0x38, 0xa6, 0x32, 0x63,
+	0x0a, 0x7d, 0x0c, 0x35, 0x7a, 0x9f, 0x86, 0x99, 0x8a, 0x05, 0xb7, 0xe7, 0x7f, 0x6b, 0x7c, 0xd5,
+	0x5c, 0x65, 0x7a, 0xd9, 0x5b, 0x36, 0x5d, 0x57, 0x1d, 0x00, 0x8f, 0xb0, 0xe8, 0x2a, 0x34, 0x5c,
+	0xda, 0x5f, 0x2e, 0x10, 0xd3, 0x0e, 0x66, 0x42, 0xf1, 0x11, 0x40, 0xc8, 0x84, 0xb4, 0x1c, 0x95,
+	0x23, 0x72, 0xd4, 0x0c, 0xc6, 0x10, 0x5c, 0x83, 0x29, 0xa9, 0x88, 0xca, 0xa4, 0x39, 0x76, 0x73,
+	0xcd, 0x3f, 0x24, 0x86, 0xfb, 0x0e, 0xb3, 0x65, 0x50, 0xd8, 0xa2, 0xd1, 0x39, 0x38, 0x69, 0x2b,
+	0x27, 0xb0, 0x12, 0x6e, 0x4d, 0x2e, 0x7b, 0x2b, 0x35, 0xdc, 0xb4, 0xe6, 0x1b, 0xb9, 0xb5, 0xf3,
+	0xdd, 0x24, 0x34, 0x36, 0xb8, 0xa2, 0x29, 0x27, 0x4c, 0x73, 0x50, 0xf4, 0x3a, 0xd4, 0x38, 0x19,
+	0x50, 0x99, 0x90, 0x30, 0xd7, 0x54, 0x0d, 0x8f, 0x0c, 0xe8, 0x34, 0x4c, 0x17, 0x83, 0x20, 0x8e,
+	0x4c, 0x9c, 0x6a, 0xb8, 0x5e, 0xd8, 0x36, 0x22, 0xb4, 0x04, 0xf5, 0x22, 0x96, 0x71, 0xd4, 0xaa,
+	0x1a, 0x0f, 0x70, 0xa6, 0x8d, 0x08, 0x6d, 0xc2, 0x29, 0x46, 0xa4, 0x0a, 0x92, 0x54, 0x84, 0x54,
+	0x4a, 0x1a, 0xbd, 0x5c, 0xb8, 0x66, 0x35, 0x78, 0xd3, 0x61, 0x4d, 0xd8, 0x6e, 0xc1, 0xc9, 0x6d,
+	0x5b, 0x1b, 0x81, 0xd4, 0xc5, 0xa1, 0xe3, 0x57, 0x59, 0xa9, 0xef, 0x8e, 0xdf, 0xc1, 0x97, 0x92,
+	0x3f, 0x56, 0x53, 0xb8, 0xb9, 0xbd, 0x7b, 0x28, 0xd1, 0x17, 0xb0, 0x60, 0xb6, 0x1a, 0x8a, 0x41,
+	0xc2, 0xa8, 0x0e, 0xb4, 0xae, 0xed, 0x8c, 0x29, 0x13, 0xce, 0xfa, 0xda, 0xf2, 0x61, 0x6a, 0xdb,
+	0x24, 0x0f, 0x98, 0x20, 0x91, 0xc4, 0x73, 0x1a, 0x7f, 0xb9, 0x80, 0x5b, 0xe1, 0xde, 0x80, 0xd9,
+	0x50, 0x70, 0x15, 0xf3, 0x8c, 0x46, 0x81, 0x4d, 0x49, 0x6b, 0xea, 0x20, 0x4a, 0x3b, 0xa9, 0x39,
+	0xaf, 0xe5, 0x9f, 0x78, 0xa6, 0x80, 0x5a, 0x0b, 0x3a, 0x03, 0xcd, 0x50, 0xf0, 0x1e, 0x8b, 0x43,
+	0x15, 0x28, 0x71, 0x97, 0xf2, 0xd6, 0x89, 0x65, 0x6f, 0xa5, 0x82, 0x1b, 0xce, 0x7a, 0x53, 0x1b,
+	0x4d, 0xf2, 0x28, 0x8d, 0x82, 0x94, 0xf6, 0x52, 0x2a, 0xef, 0xb4, 0x6a, 0xa6, 0xb4, 0xea, 0xda,
+	0x86, 0x73, 0x13, 0xba, 0x0d, 0x28, 0xa5, 0x21, 0xe5, 0x2a, 0x48, 0x33, 0x77, 0x56, 0xd9, 0x02,
+	0x13, 0xcc, 0xb7, 0x5f, 0x18, 0xcc, 0xa2, 0x32, 0xf1, 0x4c, 0xce, 0x52, 0x18, 0x24, 0x5a, 0x85,
+	0xb9, 0x50, 0xdf, 0x89, 0x5a, 0xaf, 0x3b, 0xd4, 0x1d, 0x5a, 0xb6, 0xea, 0x66, 0xa7, 0xa7, 0x76,
+	0xcd, 0xd9, 0x53, 0xc9, 0xce, 0x15, 0x98, 0x71, 0xda, 0x34, 0xd7, 0x42, 0x4c, 0x25, 0x7a, 0x0f,
+	0xe6, 0x12, 0x92, 0x49, 0x1a, 0x90, 0x9e, 0xa2, 0xe9, 0x88, 0xc6, 0x33, 0x34, 0xc8, 0xcc, 0x5d,
+	0xd2, 0x53, 0x05, 0xcb, 0xdf, 0x65, 0x98, 0x35, 0xe9, 0x74, 0x17, 0xcf, 0xa5, 0xb4, 0x2f, 0xd1,
+	0x45, 0xa8, 0xba, 0x9d, 0xdb, 0x9b, 0xa3, 0x33, 0x1e, 0xf8, 0xdd, 0xe7, 0x72, 0x48, 0x5c, 0x60,
+	0xd0, 0x05, 0x98, 0x88, 0x79, 0x4f, 0xd8, 0x8b, 0xe2, 0xec, 0x8b, 0xb1, 0x1b, 0xbc, 0x27, 0xb0,
+	0xc1, 0xa0, 0x4f, 0xa1, 0x11, 0xf3, 0x58, 0xc5, 0x84, 0x05, 0x09, 0x51, 0xe1, 0x1d, 0x2b, 0xfd,
+	0x73, 0x2f, 0x26, 0xd9, 0xd4, 0xee, 0x78, 0xda, 0xa2, 0xcd, 0x08, 0x5d, 0x81, 0x49, 0x5d, 0xf5,
+	0xd4, 0x5c, 0x19, 0x47, 0x91, 0xfc, 0x58, 0xbd, 0xe3, 0x1c, 0x8c, 0x6e, 0x40, 0x35, 0xb1, 0x31,
+	0xb6, 0xda, 0x5e, 0x3d, 0x32, 0x91, 0x4b, 0x0e, 0x2e, 0x28, 0x3a, 0x5f, 0xc3, 0xec, 0xb5, 0x8c,
+	0xb1, 0xcf, 0x93, 0x48, 0xaf, 0x91, 0xb7, 0xc5, 0x63, 0xc7, 0x7c, 0xbf, 0xcc, 0xcb, 0x07, 0xc8,
+	0xbc, 0xf3, 0x5b, 0x19, 0x66, 0xae, 0x50, 0x19, 0xa6, 0xf1, 0x36, 0xc5, 0xb6, 0x13, 0xff, 0xa7,
+	0xf9, 0xde, 0xbf, 0xef, 0xca, 0x41, 0xe5, 0x79, 0x70, 0xed, 0x4d, 0xbc, 0x82, 0xda, 0x7b, 0xc5,
+	0xc9, 0xfd, 0xd1, 0x83, 0xb9, 0x5b, 0x5a, 0x7b, 0xae, 0x0d, 0xb9, 0x04, 0x8f, 0xf5, 0xe3, 0xca,
+	0x31, 0xfa, 0xf1, 0x79, 0x58, 0xe8, 0xc5, 0xa9, 0x54, 0x41, 0x61, 0x32, 0x31, 0x89, 0x23, 0x23,
+	0xf2, 0x1a, 0x3e, 0x65, 0x66, 0x47, 0xd0, 0x8c, 0x6f, 0x44, 0xe8, 0x35, 0xa8, 0x31, 0xc1, 0xfb,
+	0xfa, 0x15, 0xc2, 0xcc, 0x31, 0xab, 0xb8, 0xaa, 0x0d, 0x9b, 0x82, 0xb1, 0xce, 0x5f, 0x1e, 0xcc,
+	0xef, 0xd9, 0xb3, 0x55, 0xc6, 0xa8, 0xe7, 0x7a, 0xc7, 0xea, 0xb9, 0x17, 0x60, 0xca, 0xf6, 0x86,
+	0xf2, 0xd1, 0x7a, 0xc3, 0xf5, 0x12, 0xb6, 0x08, 0xf4, 0x21, 0x9c, 0x70, 0x5d, 0xa0, 0x72, 0xb4,
+	0x2e, 0x70, 0xbd, 0x84, 0x1d, 0x64, 0x7d, 0x06, 0x9a, 0x39, 0x8f, 0xbb, 0x0e, 0x3b, 0xdf, 0x97,
+	0x61, 0xce, 0xdc, 0x79, 0x7b, 0x33, 0x74, 0x1b, 0x4e, 0xd8, 0x47, 0xaa, 0xdd, 0xe5, 0xc5, 0xf1,
+	0x85, 0xf6, 0x3c, 0x6a, 0x8d, 0xa0, 0x77, 0xf3, 0x8c, 0x42, 0x9e, 0xb3, 0x60, 0x47, 0xf7, 0x9c,
+	0x56, 0x39, 0xf1, 0xea, 0x5b, 0xe5, 0xe4, 0xbf, 0x6d, 0x95, 0x9f, 0x4c, 0x54, 0x2b, 0x33, 0x13,
+	0x9d, 0xfb, 0x30, 0xbf, 0x27, 0x3c, 0x56, 0x0c, 0xf3, 0x30, 0x65, 0x85, 0x96, 0x3f, 0x7d, 0x26,
+	0x53, 0x23, 0xad, 0xeb, 0x70, 0x32, 0xa5, 0x84, 0xe5, 0x8f, 0x8b, 0x97, 0x7b, 0x21, 0x36, 0x34,
+	0xd0, 0x2c, 0xa6, 0x67, 0x3a, 0x3f, 0x79, 0x30, 0x7f, 0x99, 0xf0, 0x90, 0xb2, 0xbd, 0xa9, 0x79,
+	0x03, 0xc0, 0xfd, 0x3f, 0xc4, 0x91, 0x91, 0x41, 0x0d, 0xd7, 0xac, 0x65, 0x23, 0x42, 0x8b, 0x50,
+	0x8d, 0x23, 0xca, 0x55, 0xac, 0x1e, 0xd8, 0x22, 0x28, 0xc6, 0xe3, 0x75, 0x37, 0x79, 0x8c, 0xba,
+	0x5b, 0xd0, 0x1a, 0x26, 0x52, 0x70, 0xf3, 0x18, 0xa9, 0x61, 0x3b, 0xea, 0xfc, 0xec, 0x41, 0xeb,
+	0x26, 0x4d, 0xf5, 0x7f, 0x83, 0xa2, 0xff, 0xa3, 0x8d, 0xaf, 0x47, 0x8f, 0x9e, 0xb4, 0x4b, 0x8f,
+	0x9f, 0xb4, 0x4b, 0xcf, 0x9e, 0xb4, 0xbd, 0x6f, 0x86, 0x6d, 0xef, 0x87, 0x61, 0xdb, 0xfb, 0x65,
+	0xd8, 0xf6, 0x1e, 0x0d, 0xdb, 0xde, 0x1f, 0xc3, 0xb6, 0xf7, 0xe7, 0xb0, 0x5d, 0x7a, 0x36, 0x6c,
+	0x7b, 0x0f, 0x9f, 0xb6, 0x4b, 0x8f, 0x9e, 0xb6, 0x4b, 0x8f, 0x9f, 0xb6, 0x4b, 0x5f, 0xfa, 0x7d,
+	0x31, 0xda, 0x45, 0x2c, 0x0e, 0xf9, 0xff, 0xfd, 0xc0, 0x7d, 0x6f, 0x4f, 0x99, 0xec, 0x9f, 0xff,
+	0x67, 0x00, 0x87, 0xba, 0x91, 0xf5, 0x32, 0x0f, 0x00, 0x00,
 }
 
 func (this *BufferedStart) Equal(that interface{}) bool {
@@ -950,6 +1128,50 @@ func (this *BufferedStart) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RunResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RunResult)
+	if !ok {
+		that2, ok := that.(RunResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if that1.ScheduleTime == nil {
+		if this.ScheduleTime != nil {
+			return false
+		}
+	} else if !this.ScheduleTime.Equal(*that1.ScheduleTime) {
+		return false
+	}
+	if that1.CloseTime == nil {
+		if this.CloseTime != nil {
+			return false
+		}
+	} else if !this.CloseTime.Equal(*that1.CloseTime) {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.FailureMessage != that1.FailureMessage {
+		return false
+	}
+	return true
+}
 func (this *InternalState) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1005,6 +1227,41 @@ func (this *InternalState) Equal(that interface{}) bool {
 	if this.NeedRefresh != that1.NeedRefresh {
 		return false
 	}
+	if len(this.RecentRunResults) != len(that1.RecentRunResults) {
+		return false
+	}
+	for i := range this.RecentRunResults {
+		if !this.RecentRunResults[i].Equal(that1.RecentRunResults[i]) {
+			return false
+		}
+	}
+	if this.ConsecutiveFailures != that1.ConsecutiveFailures {
+		return false
+	}
+	return true
+}
+func (this *InternalPolicies) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InternalPolicies)
+	if !ok {
+		that2, ok := that.(InternalPolicies)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PauseAfterFailures != that1.PauseAfterFailures {
+		return false
+	}
 	return true
 }
 func (this *StartScheduleArgs) Equal(that interface{}) bool {
@@ -1038,6 +1295,9 @@ func (this *StartScheduleArgs) Equal(that interface{}) bool {
 	if !this.State.Equal(that1.State) {
 		return false
 	}
+	if !this.Policies.Equal(that1.Policies) {
+		return false
+	}
 	return true
 }
 func (this *FullUpdateRequest) Equal(that interface{}) bool {
@@ -1095,6 +1355,17 @@ func (this *DescribeResponse) Equal(that interface{}) bool {
 	if this.ConflictToken != that1.ConflictToken {
 		return false
 	}
+	if len(this.RecentRunResults) != len(that1.RecentRunResults) {
+		return false
+	}
+	for i := range this.RecentRunResults {
+		if !this.RecentRunResults[i].Equal(that1.RecentRunResults[i]) {
+			return false
+		}
+	}
+	if !this.Policies.Equal(that1.Policies) {
+		return false
+	}
 	return true
 }
 func (this *WatchWorkflowRequest) Equal(that interface{}) bool {
@@ -1348,11 +1619,27 @@ func (this *BufferedStart) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *RunResult) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&schedule.RunResult{")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "ScheduleTime: "+fmt.Sprintf("%#v", this.ScheduleTime)+",\n")
+	s = append(s, "CloseTime: "+fmt.Sprintf("%#v", this.CloseTime)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "FailureMessage: "+fmt.Sprintf("%#v", this.FailureMessage)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *InternalState) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 13)
+	s := make([]string, 0, 15)
 	s = append(s, "&schedule.InternalState{")
 	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
@@ -1369,6 +1656,20 @@ func (this *InternalState) GoString() string {
 	}
 	s = append(s, "ConflictToken: "+fmt.Sprintf("%#v", this.ConflictToken)+",\n")
 	s = append(s, "NeedRefresh: "+fmt.Sprintf("%#v", this.NeedRefresh)+",\n")
+	if this.RecentRunResults != nil {
+		s = append(s, "RecentRunResults: "+fmt.Sprintf("%#v", this.RecentRunResults)+",\n")
+	}
+	s = append(s, "ConsecutiveFailures: "+fmt.Sprintf("%#v", this.ConsecutiveFailures)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *InternalPolicies) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&schedule.InternalPolicies{")
+	s = append(s, "PauseAfterFailures: "+fmt.Sprintf("%#v", this.PauseAfterFailures)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1376,7 +1677,7 @@ func (this *StartScheduleArgs) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 9)
 	s = append(s, "&schedule.StartScheduleArgs{")
 	if this.Schedule != nil {
 		s = append(s, "Schedule: "+fmt.Sprintf("%#v", this.Schedule)+",\n")
@@ -1390,6 +1691,9 @@ func (this *StartScheduleArgs) GoString() string {
 	if this.State != nil {
 		s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
 	}
+	if this.Policies != nil {
+		s = append(s, "Policies: "+fmt.Sprintf("%#v", this.Policies)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1410,7 +1714,7 @@ func (this *DescribeResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 9)
 	s = append(s, "&schedule.DescribeResponse{")
 	if this.Schedule != nil {
 		s = append(s, "Schedule: "+fmt.Sprintf("%#v", this.Schedule)+",\n")
@@ -1419,6 +1723,12 @@ func (this *DescribeResponse) GoString() string {
 		s = append(s, "Info: "+fmt.Sprintf("%#v", this.Info)+",\n")
 	}
 	s = append(s, "ConflictToken: "+fmt.Sprintf("%#v", this.ConflictToken)+",\n")
+	if this.RecentRunResults != nil {
+		s = append(s, "RecentRunResults: "+fmt.Sprintf("%#v", this.RecentRunResults)+",\n")
+	}
+	if this.Policies != nil {
+		s = append(s, "Policies: "+fmt.Sprintf("%#v", this.Policies)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1590,7 +1900,7 @@ func (m *BufferedStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *InternalState) Marshal() (dAtA []byte, err error) {
+func (m *RunResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1600,53 +1910,51 @@ func (m *InternalState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InternalState) MarshalTo(dAtA []byte) (int, error) {
+func (m *RunResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InternalState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RunResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NeedRefresh {
-		i--
-		if m.NeedRefresh {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.FailureMessage) > 0 {
+		i -= len(m.FailureMessage)
+		copy(dAtA[i:], m.FailureMessage)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.FailureMessage)))
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x2a
 	}
-	if len(m.ScheduleId) > 0 {
-		i -= len(m.ScheduleId)
-		copy(dAtA[i:], m.ScheduleId)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.ScheduleId)))
+	if m.Status != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x20
 	}
-	if m.ConflictToken != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.ConflictToken))
+	if m.CloseTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintMessage(dAtA, i, uint64(n3))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x1a
 	}
-	if m.ContinuedFailure != nil {
-		{
-			size, err := m.ContinuedFailure.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
+	if m.ScheduleTime != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduleTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduleTime):])
+		if err4 != nil {
+			return 0, err4
 		}
+		i -= n4
+		i = encodeVarintMessage(dAtA, i, uint64(n4))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x12
 	}
-	if m.LastCompletionResult != nil {
+	if m.Execution != nil {
 		{
-			size, err := m.LastCompletionResult.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1654,14 +1962,102 @@ func (m *InternalState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintMessage(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0xa
 	}
-	if len(m.BufferedStarts) > 0 {
-		for iNdEx := len(m.BufferedStarts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BufferedStarts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+	return len(dAtA) - i, nil
+}
+
+func (m *InternalState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InternalState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InternalState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.RecentRunResults) > 0 {
+		for iNdEx := len(m.RecentRunResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentRunResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.NeedRefresh {
+		i--
+		if m.NeedRefresh {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ConflictToken != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ConflictToken))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ContinuedFailure != nil {
+		{
+			size, err := m.ContinuedFailure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LastCompletionResult != nil {
+		{
+			size, err := m.LastCompletionResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BufferedStarts) > 0 {
+		for iNdEx := len(m.BufferedStarts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BufferedStarts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
 				}
 				i -= size
 				i = encodeVarintMessage(dAtA, i, uint64(size))
@@ -1671,12 +2067,12 @@ func (m *InternalState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.LastProcessedTime != nil {
-		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastProcessedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastProcessedTime):])
-		if err5 != nil {
-			return 0, err5
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastProcessedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastProcessedTime):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n5
-		i = encodeVarintMessage(dAtA, i, uint64(n5))
+		i -= n8
+		i = encodeVarintMessage(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1697,6 +2093,34 @@ func (m *InternalState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InternalPolicies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InternalPolicies) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InternalPolicies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PauseAfterFailures != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.PauseAfterFailures))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *StartScheduleArgs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1717,6 +2141,18 @@ func (m *StartScheduleArgs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Policies != nil {
+		{
+			size, err := m.Policies.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.State != nil {
 		{
 			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
@@ -1828,6 +2264,32 @@ func (m *DescribeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Policies != nil {
+		{
+			size, err := m.Policies.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RecentRunResults) > 0 {
+		for iNdEx := len(m.RecentRunResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentRunResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.ConflictToken != 0 {
 		i = encodeVarintMessage(dAtA, i, uint64(m.ConflictToken))
 		i--
@@ -2071,12 +2533,12 @@ func (m *StartWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.RealStartTime != nil {
-		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RealStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RealStartTime):])
-		if err19 != nil {
-			return 0, err19
+		n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RealStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RealStartTime):])
+		if err24 != nil {
+			return 0, err24
 		}
-		i -= n19
-		i = encodeVarintMessage(dAtA, i, uint64(n19))
+		i -= n24
+		i = encodeVarintMessage(dAtA, i, uint64(n24))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2236,6 +2698,34 @@ func (m *BufferedStart) Size() (n int) {
 	return n
 }
 
+func (m *RunResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ScheduleTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduleTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.CloseTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovMessage(uint64(m.Status))
+	}
+	l = len(m.FailureMessage)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
 func (m *InternalState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2278,6 +2768,27 @@ func (m *InternalState) Size() (n int) {
 	if m.NeedRefresh {
 		n += 2
 	}
+	if len(m.RecentRunResults) > 0 {
+		for _, e := range m.RecentRunResults {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovMessage(uint64(m.ConsecutiveFailures))
+	}
+	return n
+}
+
+func (m *InternalPolicies) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PauseAfterFailures != 0 {
+		n += 1 + sovMessage(uint64(m.PauseAfterFailures))
+	}
 	return n
 }
 
@@ -2303,6 +2814,10 @@ func (m *StartScheduleArgs) Size() (n int) {
 		l = m.State.Size()
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	if m.Policies != nil {
+		l = m.Policies.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -2339,6 +2854,16 @@ func (m *DescribeResponse) Size() (n int) {
 	if m.ConflictToken != 0 {
 		n += 1 + sovMessage(uint64(m.ConflictToken))
 	}
+	if len(m.RecentRunResults) > 0 {
+		for _, e := range m.RecentRunResults {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.Policies != nil {
+		l = m.Policies.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -2508,6 +3033,20 @@ func (this *BufferedStart) String() string {
 	}, "")
 	return s
 }
+func (this *RunResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RunResult{`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
+		`ScheduleTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`CloseTime:` + strings.Replace(fmt.Sprintf("%v", this.CloseTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`FailureMessage:` + fmt.Sprintf("%v", this.FailureMessage) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *InternalState) String() string {
 	if this == nil {
 		return "nil"
@@ -2517,6 +3056,11 @@ func (this *InternalState) String() string {
 		repeatedStringForBufferedStarts += strings.Replace(f.String(), "BufferedStart", "BufferedStart", 1) + ","
 	}
 	repeatedStringForBufferedStarts += "}"
+	repeatedStringForRecentRunResults := "[]*RunResult{"
+	for _, f := range this.RecentRunResults {
+		repeatedStringForRecentRunResults += strings.Replace(f.String(), "RunResult", "RunResult", 1) + ","
+	}
+	repeatedStringForRecentRunResults += "}"
 	s := strings.Join([]string{`&InternalState{`,
 		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
@@ -2527,6 +3071,18 @@ func (this *InternalState) String() string {
 		`ConflictToken:` + fmt.Sprintf("%v", this.ConflictToken) + `,`,
 		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
 		`NeedRefresh:` + fmt.Sprintf("%v", this.NeedRefresh) + `,`,
+		`RecentRunResults:` + repeatedStringForRecentRunResults + `,`,
+		`ConsecutiveFailures:` + fmt.Sprintf("%v", this.ConsecutiveFailures) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InternalPolicies) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InternalPolicies{`,
+		`PauseAfterFailures:` + fmt.Sprintf("%v", this.PauseAfterFailures) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2540,6 +3096,7 @@ func (this *StartScheduleArgs) String() string {
 		`Info:` + strings.Replace(fmt.Sprintf("%v", this.Info), "ScheduleInfo", "v13.ScheduleInfo", 1) + `,`,
 		`InitialPatch:` + strings.Replace(fmt.Sprintf("%v", this.InitialPatch), "SchedulePatch", "v13.SchedulePatch", 1) + `,`,
 		`State:` + strings.Replace(this.State.String(), "InternalState", "InternalState", 1) + `,`,
+		`Policies:` + strings.Replace(this.Policies.String(), "InternalPolicies", "InternalPolicies", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2559,10 +3116,17 @@ func (this *DescribeResponse) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForRecentRunResults := "[]*RunResult{"
+	for _, f := range this.RecentRunResults {
+		repeatedStringForRecentRunResults += strings.Replace(f.String(), "RunResult", "RunResult", 1) + ","
+	}
+	repeatedStringForRecentRunResults += "}"
 	s := strings.Join([]string{`&DescribeResponse{`,
 		`Schedule:` + strings.Replace(fmt.Sprintf("%v", this.Schedule), "Schedule", "v13.Schedule", 1) + `,`,
 		`Info:` + strings.Replace(fmt.Sprintf("%v", this.Info), "ScheduleInfo", "v13.ScheduleInfo", 1) + `,`,
 		`ConflictToken:` + fmt.Sprintf("%v", this.ConflictToken) + `,`,
+		`RecentRunResults:` + repeatedStringForRecentRunResults + `,`,
+		`Policies:` + strings.Replace(this.Policies.String(), "InternalPolicies", "InternalPolicies", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2831,7 +3395,7 @@ func (m *BufferedStart) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InternalState) Unmarshal(dAtA []byte) error {
+func (m *RunResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2854,17 +3418,17 @@ func (m *InternalState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InternalState: wiretype end group for non-group")
+			return fmt.Errorf("proto: RunResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InternalState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RunResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -2874,29 +3438,33 @@ func (m *InternalState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.Execution == nil {
+				m.Execution = &v11.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -2906,27 +3474,31 @@ func (m *InternalState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			if m.ScheduleTime == nil {
+				m.ScheduleTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduleTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2953,18 +3525,18 @@ func (m *InternalState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastProcessedTime == nil {
-				m.LastProcessedTime = new(time.Time)
+			if m.CloseTime == nil {
+				m.CloseTime = new(time.Time)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastProcessedTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CloseTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BufferedStarts", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -2974,16 +3546,220 @@ func (m *InternalState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= v1.WorkflowExecutionStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureMessage", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InternalState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InternalState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InternalState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastProcessedTime == nil {
+				m.LastProcessedTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastProcessedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferedStarts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
@@ -3137,6 +3913,131 @@ func (m *InternalState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.NeedRefresh = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentRunResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentRunResults = append(m.RecentRunResults, &RunResult{})
+			if err := m.RecentRunResults[len(m.RecentRunResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InternalPolicies) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InternalPolicies: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InternalPolicies: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseAfterFailures", wireType)
+			}
+			m.PauseAfterFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PauseAfterFailures |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -3334,6 +4235,42 @@ func (m *StartScheduleArgs) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policies == nil {
+				m.Policies = &InternalPolicies{}
+			}
+			if err := m.Policies.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -3586,6 +4523,76 @@ func (m *DescribeResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentRunResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentRunResults = append(m.RecentRunResults, &RunResult{})
+			if err := m.RecentRunResults[len(m.RecentRunResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policies == nil {
+				m.Policies = &InternalPolicies{}
+			}
+			if err := m.Policies.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
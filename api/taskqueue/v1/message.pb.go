@@ -0,0 +1,1029 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: temporal/server/api/taskqueue/v1/message.proto
+
+package taskqueue
+
+import (
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BacklogStats describes the backlog of a single task queue partition.
+type BacklogStats struct {
+	// Approximate number of tasks waiting in the partition.
+	ApproximateBacklogCount int64 `protobuf:"varint,1,opt,name=approximate_backlog_count,json=approximateBacklogCount,proto3" json:"approximate_backlog_count,omitempty"`
+	// Age of the oldest task waiting to be dispatched, unset if there is no such task.
+	OldestTaskAge *time.Duration `protobuf:"bytes,2,opt,name=oldest_task_age,json=oldestTaskAge,proto3,stdduration" json:"oldest_task_age,omitempty"`
+	// Tasks added to the partition per second, averaged over the last minute.
+	AddRate float64 `protobuf:"fixed64,3,opt,name=add_rate,json=addRate,proto3" json:"add_rate,omitempty"`
+	// Tasks dispatched to pollers of the partition per second, averaged over the last minute.
+	DispatchRate float64 `protobuf:"fixed64,4,opt,name=dispatch_rate,json=dispatchRate,proto3" json:"dispatch_rate,omitempty"`
+	// Fraction of the tasks dispatched over the last minute that were matched synchronously
+	// instead of being read from the persisted backlog.
+	SyncMatchRatio float64 `protobuf:"fixed64,5,opt,name=sync_match_ratio,json=syncMatchRatio,proto3" json:"sync_match_ratio,omitempty"`
+}
+
+func (m *BacklogStats) Reset()      { *m = BacklogStats{} }
+func (*BacklogStats) ProtoMessage() {}
+func (*BacklogStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b64ab0f85f299, []int{0}
+}
+func (m *BacklogStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BacklogStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BacklogStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BacklogStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BacklogStats.Merge(m, src)
+}
+func (m *BacklogStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *BacklogStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_BacklogStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BacklogStats proto.InternalMessageInfo
+
+func (m *BacklogStats) GetApproximateBacklogCount() int64 {
+	if m != nil {
+		return m.ApproximateBacklogCount
+	}
+	return 0
+}
+
+func (m *BacklogStats) GetOldestTaskAge() *time.Duration {
+	if m != nil {
+		return m.OldestTaskAge
+	}
+	return nil
+}
+
+func (m *BacklogStats) GetAddRate() float64 {
+	if m != nil {
+		return m.AddRate
+	}
+	return 0
+}
+
+func (m *BacklogStats) GetDispatchRate() float64 {
+	if m != nil {
+		return m.DispatchRate
+	}
+	return 0
+}
+
+func (m *BacklogStats) GetSyncMatchRatio() float64 {
+	if m != nil {
+		return m.SyncMatchRatio
+	}
+	return 0
+}
+
+// TaskQueuePartitionStats describes a single task queue partition.
+type TaskQueuePartitionStats struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Address of the matching host that owns the partition.
+	OwnerHost string `protobuf:"bytes,2,opt,name=owner_host,json=ownerHost,proto3" json:"owner_host,omitempty"`
+	// Number of pollers seen by the partition in the last few minutes.
+	PollerCount  int32         `protobuf:"varint,3,opt,name=poller_count,json=pollerCount,proto3" json:"poller_count,omitempty"`
+	BacklogStats *BacklogStats `protobuf:"bytes,4,opt,name=backlog_stats,json=backlogStats,proto3" json:"backlog_stats,omitempty"`
+	// Set if the partition could not be described, in which case only name and owner_host are set.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *TaskQueuePartitionStats) Reset()      { *m = TaskQueuePartitionStats{} }
+func (*TaskQueuePartitionStats) ProtoMessage() {}
+func (*TaskQueuePartitionStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b64ab0f85f299, []int{1}
+}
+func (m *TaskQueuePartitionStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskQueuePartitionStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskQueuePartitionStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskQueuePartitionStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskQueuePartitionStats.Merge(m, src)
+}
+func (m *TaskQueuePartitionStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskQueuePartitionStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskQueuePartitionStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskQueuePartitionStats proto.InternalMessageInfo
+
+func (m *TaskQueuePartitionStats) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TaskQueuePartitionStats) GetOwnerHost() string {
+	if m != nil {
+		return m.OwnerHost
+	}
+	return ""
+}
+
+func (m *TaskQueuePartitionStats) GetPollerCount() int32 {
+	if m != nil {
+		return m.PollerCount
+	}
+	return 0
+}
+
+func (m *TaskQueuePartitionStats) GetBacklogStats() *BacklogStats {
+	if m != nil {
+		return m.BacklogStats
+	}
+	return nil
+}
+
+func (m *TaskQueuePartitionStats) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*BacklogStats)(nil), "temporal.server.api.taskqueue.v1.BacklogStats")
+	proto.RegisterType((*TaskQueuePartitionStats)(nil), "temporal.server.api.taskqueue.v1.TaskQueuePartitionStats")
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/taskqueue/v1/message.proto", fileDescriptor_4e9b64ab0f85f299)
+}
+
+var fileDescriptor_4e9b64ab0f85f299 = []byte{
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xb1, 0x8e, 0xd3, 0x4e,
+	0x10, 0xc6, 0xbd, 0xff, 0x4b, 0xfe, 0x90, 0x4d, 0x02, 0xc8, 0x42, 0xba, 0xe4, 0x24, 0x96, 0xdc,
+	0xd1, 0xa4, 0x5a, 0x73, 0x47, 0x07, 0x15, 0x01, 0x09, 0x1a, 0x24, 0xf0, 0x51, 0xd1, 0x58, 0x1b,
+	0x7b, 0xce, 0xb7, 0x8a, 0xed, 0x31, 0xbb, 0xeb, 0x00, 0x1d, 0x8f, 0x40, 0xc9, 0x23, 0xf0, 0x28,
+	0x94, 0x29, 0x4f, 0x34, 0x10, 0xa7, 0xa1, 0xbc, 0x17, 0x40, 0x42, 0xde, 0x8d, 0x8f, 0x34, 0x88,
+	0x6e, 0xf7, 0x9b, 0xdf, 0x7a, 0xbe, 0xf9, 0xc6, 0x94, 0x1b, 0xc8, 0x4b, 0x54, 0x22, 0x0b, 0x34,
+	0xa8, 0x25, 0xa8, 0x40, 0x94, 0x32, 0x30, 0x42, 0x2f, 0xde, 0x56, 0x50, 0x41, 0xb0, 0x3c, 0x0e,
+	0x72, 0xd0, 0x5a, 0xa4, 0xc0, 0x4b, 0x85, 0x06, 0xfd, 0x49, 0xcb, 0x73, 0xc7, 0x73, 0x51, 0x4a,
+	0x7e, 0xc5, 0xf3, 0xe5, 0xf1, 0x01, 0x4b, 0x11, 0xd3, 0x0c, 0x02, 0xcb, 0xcf, 0xab, 0xb3, 0x20,
+	0xa9, 0x94, 0x30, 0x12, 0x0b, 0xf7, 0x85, 0x83, 0xc3, 0x04, 0x4a, 0x28, 0x12, 0x28, 0x62, 0x09,
+	0x3a, 0x48, 0x31, 0x45, 0xab, 0xdb, 0x93, 0x43, 0x8e, 0x7e, 0x11, 0x3a, 0x98, 0x89, 0x78, 0x91,
+	0x61, 0x7a, 0x6a, 0x84, 0xd1, 0xfe, 0x43, 0x3a, 0x16, 0x65, 0xa9, 0xf0, 0xbd, 0xcc, 0x85, 0x81,
+	0x68, 0xee, 0x6a, 0x51, 0x8c, 0x55, 0x61, 0x46, 0x64, 0x42, 0xa6, 0x7b, 0xe1, 0xfe, 0x0e, 0xb0,
+	0x7d, 0xfb, 0xa4, 0x29, 0xfb, 0xcf, 0xe8, 0x4d, 0xcc, 0x12, 0xd0, 0x26, 0x6a, 0x6c, 0x46, 0x22,
+	0x85, 0xd1, 0x7f, 0x13, 0x32, 0xed, 0x9f, 0x8c, 0xb9, 0x73, 0xca, 0x5b, 0xa7, 0xfc, 0xe9, 0xd6,
+	0xe9, 0xac, 0xf3, 0xf9, 0xfb, 0x5d, 0x12, 0x0e, 0xdd, 0xbb, 0xd7, 0x42, 0x2f, 0x1e, 0xa7, 0xe0,
+	0x8f, 0xe9, 0x75, 0x91, 0x24, 0x91, 0x12, 0x06, 0x46, 0x7b, 0x13, 0x32, 0x25, 0xe1, 0x35, 0x91,
+	0x24, 0xa1, 0x30, 0xe0, 0xdf, 0xa3, 0xc3, 0x44, 0xea, 0x52, 0x98, 0xf8, 0xdc, 0xd5, 0x3b, 0xb6,
+	0x3e, 0x68, 0x45, 0x0b, 0x4d, 0xe9, 0x2d, 0xfd, 0xa1, 0x88, 0xa3, 0xbc, 0xc5, 0x24, 0x8e, 0xba,
+	0x96, 0xbb, 0xd1, 0xe8, 0x2f, 0xb6, 0xa0, 0xc4, 0xa3, 0x6f, 0x84, 0xee, 0x37, 0x5d, 0x5f, 0x35,
+	0x99, 0xbe, 0x14, 0xca, 0xc8, 0xc6, 0x95, 0x8b, 0xc2, 0xa7, 0x9d, 0x42, 0xe4, 0x60, 0xa7, 0xee,
+	0x85, 0xf6, 0xec, 0xdf, 0xa1, 0x14, 0xdf, 0x15, 0xa0, 0xa2, 0x73, 0xd4, 0xc6, 0x4e, 0xd7, 0x0b,
+	0x7b, 0x56, 0x79, 0x8e, 0xda, 0xf8, 0x87, 0x74, 0x50, 0x62, 0x96, 0x81, 0xda, 0x06, 0xd6, 0x98,
+	0xef, 0x86, 0x7d, 0xa7, 0xb9, 0x90, 0x4e, 0xe9, 0xb0, 0x0d, 0x55, 0x37, 0x6d, 0xec, 0x00, 0xfd,
+	0x13, 0xce, 0xff, 0xb5, 0x6e, 0xbe, 0xbb, 0xa7, 0x70, 0x30, 0xdf, 0xdd, 0xda, 0x6d, 0xda, 0x05,
+	0xa5, 0x50, 0xd9, 0x29, 0x7b, 0xa1, 0xbb, 0xcc, 0xce, 0x56, 0x6b, 0xe6, 0x5d, 0xac, 0x99, 0x77,
+	0xb9, 0x66, 0xe4, 0x63, 0xcd, 0xc8, 0x97, 0x9a, 0x91, 0xaf, 0x35, 0x23, 0xab, 0x9a, 0x91, 0x1f,
+	0x35, 0x23, 0x3f, 0x6b, 0xe6, 0x5d, 0xd6, 0x8c, 0x7c, 0xda, 0x30, 0x6f, 0xb5, 0x61, 0xde, 0xc5,
+	0x86, 0x79, 0x6f, 0xee, 0xa7, 0xf8, 0xc7, 0x8b, 0xc4, 0xbf, 0xfd, 0xad, 0x8f, 0xae, 0x2e, 0xf3,
+	0xff, 0xed, 0x5a, 0x1f, 0xfc, 0x1e, 0x00, 0x39, 0x20, 0x8f, 0xd8, 0xe2, 0x02, 0x00, 0x00,
+}
+
+func (this *BacklogStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BacklogStats)
+	if !ok {
+		that2, ok := that.(BacklogStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ApproximateBacklogCount != that1.ApproximateBacklogCount {
+		return false
+	}
+	if this.OldestTaskAge != nil && that1.OldestTaskAge != nil {
+		if *this.OldestTaskAge != *that1.OldestTaskAge {
+			return false
+		}
+	} else if this.OldestTaskAge != nil {
+		return false
+	} else if that1.OldestTaskAge != nil {
+		return false
+	}
+	if this.AddRate != that1.AddRate {
+		return false
+	}
+	if this.DispatchRate != that1.DispatchRate {
+		return false
+	}
+	if this.SyncMatchRatio != that1.SyncMatchRatio {
+		return false
+	}
+	return true
+}
+func (this *TaskQueuePartitionStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaskQueuePartitionStats)
+	if !ok {
+		that2, ok := that.(TaskQueuePartitionStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.OwnerHost != that1.OwnerHost {
+		return false
+	}
+	if this.PollerCount != that1.PollerCount {
+		return false
+	}
+	if !this.BacklogStats.Equal(that1.BacklogStats) {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *BacklogStats) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&taskqueue.BacklogStats{")
+	s = append(s, "ApproximateBacklogCount: "+fmt.Sprintf("%#v", this.ApproximateBacklogCount)+",\n")
+	s = append(s, "OldestTaskAge: "+fmt.Sprintf("%#v", this.OldestTaskAge)+",\n")
+	s = append(s, "AddRate: "+fmt.Sprintf("%#v", this.AddRate)+",\n")
+	s = append(s, "DispatchRate: "+fmt.Sprintf("%#v", this.DispatchRate)+",\n")
+	s = append(s, "SyncMatchRatio: "+fmt.Sprintf("%#v", this.SyncMatchRatio)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskQueuePartitionStats) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&taskqueue.TaskQueuePartitionStats{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "OwnerHost: "+fmt.Sprintf("%#v", this.OwnerHost)+",\n")
+	s = append(s, "PollerCount: "+fmt.Sprintf("%#v", this.PollerCount)+",\n")
+	if this.BacklogStats != nil {
+		s = append(s, "BacklogStats: "+fmt.Sprintf("%#v", this.BacklogStats)+",\n")
+	}
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringMessage(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *BacklogStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BacklogStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BacklogStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SyncMatchRatio != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SyncMatchRatio))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.DispatchRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DispatchRate))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.AddRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AddRate))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.OldestTaskAge != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.OldestTaskAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.OldestTaskAge):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintMessage(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ApproximateBacklogCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ApproximateBacklogCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskQueuePartitionStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskQueuePartitionStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskQueuePartitionStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BacklogStats != nil {
+		{
+			size, err := m.BacklogStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PollerCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.PollerCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OwnerHost) > 0 {
+		i -= len(m.OwnerHost)
+		copy(dAtA[i:], m.OwnerHost)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.OwnerHost)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BacklogStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ApproximateBacklogCount != 0 {
+		n += 1 + sovMessage(uint64(m.ApproximateBacklogCount))
+	}
+	if m.OldestTaskAge != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.OldestTaskAge)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.AddRate != 0 {
+		n += 9
+	}
+	if m.DispatchRate != 0 {
+		n += 9
+	}
+	if m.SyncMatchRatio != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *TaskQueuePartitionStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.OwnerHost)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.PollerCount != 0 {
+		n += 1 + sovMessage(uint64(m.PollerCount))
+	}
+	if m.BacklogStats != nil {
+		l = m.BacklogStats.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func sovMessage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessage(x uint64) (n int) {
+	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *BacklogStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BacklogStats{`,
+		`ApproximateBacklogCount:` + fmt.Sprintf("%v", this.ApproximateBacklogCount) + `,`,
+		`OldestTaskAge:` + strings.Replace(fmt.Sprintf("%v", this.OldestTaskAge), "Duration", "types.Duration", 1) + `,`,
+		`AddRate:` + fmt.Sprintf("%v", this.AddRate) + `,`,
+		`DispatchRate:` + fmt.Sprintf("%v", this.DispatchRate) + `,`,
+		`SyncMatchRatio:` + fmt.Sprintf("%v", this.SyncMatchRatio) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskQueuePartitionStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskQueuePartitionStats{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`OwnerHost:` + fmt.Sprintf("%v", this.OwnerHost) + `,`,
+		`PollerCount:` + fmt.Sprintf("%v", this.PollerCount) + `,`,
+		`BacklogStats:` + strings.Replace(this.BacklogStats.String(), "BacklogStats", "BacklogStats", 1) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringMessage(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *BacklogStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BacklogStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BacklogStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateBacklogCount", wireType)
+			}
+			m.ApproximateBacklogCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproximateBacklogCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestTaskAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldestTaskAge == nil {
+				m.OldestTaskAge = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.OldestTaskAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AddRate = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispatchRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DispatchRate = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncMatchRatio", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.SyncMatchRatio = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskQueuePartitionStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskQueuePartitionStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskQueuePartitionStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerHost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerHost = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollerCount", wireType)
+			}
+			m.PollerCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PollerCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BacklogStats == nil {
+				m.BacklogStats = &BacklogStats{}
+			}
+			if err := m.BacklogStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMessage(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMessage
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMessage
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMessage
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMessage        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMessage          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMessage = fmt.Errorf("proto: unexpected end of group")
+)
@@ -63,6 +63,16 @@ func (c *clientImpl) CloseShard(
 	return c.client.CloseShard(ctx, request, opts...)
 }
 
+func (c *clientImpl) CountWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.CountWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CountWorkflowExecutionsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CountWorkflowExecutions(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -103,6 +113,26 @@ func (c *clientImpl) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeSchedule(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeScheduleResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeTaskQueuePartitions(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueuePartitionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueuePartitionsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeTaskQueuePartitions(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -123,6 +153,16 @@ func (c *clientImpl) GetDLQReplicationMessages(
 	return c.client.GetDLQReplicationMessages(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetDynamicConfig(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetNamespaceReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetNamespaceReplicationMessagesRequest,
@@ -183,6 +223,16 @@ func (c *clientImpl) GetWorkflowExecutionRawHistoryV2(
 	return c.client.GetWorkflowExecutionRawHistoryV2(ctx, request, opts...)
 }
 
+func (c *clientImpl) ImportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ImportWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ImportWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -203,6 +253,26 @@ func (c *clientImpl) ListClusters(
 	return c.client.ListClusters(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListDynamicConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListDynamicConfigOverrides(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigOverridesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigOverridesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListDynamicConfigOverrides(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -213,6 +283,16 @@ func (c *clientImpl) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListShardOwnershipOverrides(
+	ctx context.Context,
+	request *adminservice.ListShardOwnershipOverridesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListShardOwnershipOverridesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListShardOwnershipOverrides(ctx, request, opts...)
+}
+
 func (c *clientImpl) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -223,6 +303,16 @@ func (c *clientImpl) MergeDLQMessages(
 	return c.client.MergeDLQMessages(ctx, request, opts...)
 }
 
+func (c *clientImpl) MoveShard(
+	ctx context.Context,
+	request *adminservice.MoveShardRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MoveShardResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.MoveShard(ctx, request, opts...)
+}
+
 func (c *clientImpl) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -263,6 +353,16 @@ func (c *clientImpl) RefreshWorkflowTasks(
 	return c.client.RefreshWorkflowTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) RemoveDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.RemoveDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RemoveDynamicConfigOverrideResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RemoveDynamicConfigOverride(ctx, request, opts...)
+}
+
 func (c *clientImpl) RemoveRemoteCluster(
 	ctx context.Context,
 	request *adminservice.RemoveRemoteClusterRequest,
@@ -302,3 +402,33 @@ func (c *clientImpl) ResendReplicationTasks(
 	defer cancel()
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
+
+func (c *clientImpl) SetDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigOverrideResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SetDynamicConfigOverride(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartBatchOperationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartBatchOperation(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateSchedulePolicies(
+	ctx context.Context,
+	request *adminservice.UpdateSchedulePoliciesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateSchedulePoliciesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateSchedulePolicies(ctx, request, opts...)
+}
@@ -77,6 +77,20 @@ func (c *metricClient) CloseShard(
 	return c.client.CloseShard(ctx, request, opts...)
 }
 
+func (c *metricClient) CountWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.CountWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.CountWorkflowExecutionsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientCountWorkflowExecutionsScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CountWorkflowExecutions(ctx, request, opts...)
+}
+
 func (c *metricClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -133,6 +147,34 @@ func (c *metricClient) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeSchedule(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeScheduleResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientDescribeScheduleScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeSchedule(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeTaskQueuePartitions(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueuePartitionsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeTaskQueuePartitionsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientDescribeTaskQueuePartitionsScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeTaskQueuePartitions(ctx, request, opts...)
+}
+
 func (c *metricClient) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -161,6 +203,20 @@ func (c *metricClient) GetDLQReplicationMessages(
 	return c.client.GetDLQReplicationMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetDynamicConfigResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientGetDynamicConfigScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetDynamicConfig(ctx, request, opts...)
+}
+
 func (c *metricClient) GetNamespaceReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetNamespaceReplicationMessagesRequest,
@@ -245,6 +301,20 @@ func (c *metricClient) GetWorkflowExecutionRawHistoryV2(
 	return c.client.GetWorkflowExecutionRawHistoryV2(ctx, request, opts...)
 }
 
+func (c *metricClient) ImportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ImportWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientImportWorkflowExecutionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ImportWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -273,6 +343,34 @@ func (c *metricClient) ListClusters(
 	return c.client.ListClusters(ctx, request, opts...)
 }
 
+func (c *metricClient) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListDynamicConfigResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientListDynamicConfigScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListDynamicConfig(ctx, request, opts...)
+}
+
+func (c *metricClient) ListDynamicConfigOverrides(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigOverridesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListDynamicConfigOverridesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientListDynamicConfigOverridesScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListDynamicConfigOverrides(ctx, request, opts...)
+}
+
 func (c *metricClient) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -287,6 +385,20 @@ func (c *metricClient) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) ListShardOwnershipOverrides(
+	ctx context.Context,
+	request *adminservice.ListShardOwnershipOverridesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListShardOwnershipOverridesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientListShardOwnershipOverridesScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListShardOwnershipOverrides(ctx, request, opts...)
+}
+
 func (c *metricClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -301,6 +413,20 @@ func (c *metricClient) MergeDLQMessages(
 	return c.client.MergeDLQMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) MoveShard(
+	ctx context.Context,
+	request *adminservice.MoveShardRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.MoveShardResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientMoveShardScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.MoveShard(ctx, request, opts...)
+}
+
 func (c *metricClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -357,6 +483,20 @@ func (c *metricClient) RefreshWorkflowTasks(
 	return c.client.RefreshWorkflowTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) RemoveDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.RemoveDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RemoveDynamicConfigOverrideResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientRemoveDynamicConfigOverrideScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RemoveDynamicConfigOverride(ctx, request, opts...)
+}
+
 func (c *metricClient) RemoveRemoteCluster(
 	ctx context.Context,
 	request *adminservice.RemoveRemoteClusterRequest,
@@ -412,3 +552,45 @@ func (c *metricClient) ResendReplicationTasks(
 
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
+
+func (c *metricClient) SetDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.SetDynamicConfigOverrideResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientSetDynamicConfigOverrideScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SetDynamicConfigOverride(ctx, request, opts...)
+}
+
+func (c *metricClient) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartBatchOperationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientStartBatchOperationScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartBatchOperation(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateSchedulePolicies(
+	ctx context.Context,
+	request *adminservice.UpdateSchedulePoliciesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateSchedulePoliciesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientUpdateSchedulePoliciesScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateSchedulePolicies(ctx, request, opts...)
+}
@@ -80,6 +80,21 @@ func (c *retryableClient) CloseShard(
 	return resp, err
 }
 
+func (c *retryableClient) CountWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.CountWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CountWorkflowExecutionsResponse, error) {
+	var resp *adminservice.CountWorkflowExecutionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CountWorkflowExecutions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -140,6 +155,36 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeSchedule(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeScheduleResponse, error) {
+	var resp *adminservice.DescribeScheduleResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeSchedule(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeTaskQueuePartitions(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueuePartitionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueuePartitionsResponse, error) {
+	var resp *adminservice.DescribeTaskQueuePartitionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeTaskQueuePartitions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -170,6 +215,21 @@ func (c *retryableClient) GetDLQReplicationMessages(
 	return resp, err
 }
 
+func (c *retryableClient) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigResponse, error) {
+	var resp *adminservice.GetDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetNamespaceReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetNamespaceReplicationMessagesRequest,
@@ -260,6 +320,21 @@ func (c *retryableClient) GetWorkflowExecutionRawHistoryV2(
 	return resp, err
 }
 
+func (c *retryableClient) ImportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ImportWorkflowExecutionResponse, error) {
+	var resp *adminservice.ImportWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ImportWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -290,6 +365,36 @@ func (c *retryableClient) ListClusters(
 	return resp, err
 }
 
+func (c *retryableClient) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigResponse, error) {
+	var resp *adminservice.ListDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListDynamicConfigOverrides(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigOverridesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigOverridesResponse, error) {
+	var resp *adminservice.ListDynamicConfigOverridesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListDynamicConfigOverrides(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -305,6 +410,21 @@ func (c *retryableClient) ListHistoryTasks(
 	return resp, err
 }
 
+func (c *retryableClient) ListShardOwnershipOverrides(
+	ctx context.Context,
+	request *adminservice.ListShardOwnershipOverridesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListShardOwnershipOverridesResponse, error) {
+	var resp *adminservice.ListShardOwnershipOverridesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListShardOwnershipOverrides(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -320,6 +440,21 @@ func (c *retryableClient) MergeDLQMessages(
 	return resp, err
 }
 
+func (c *retryableClient) MoveShard(
+	ctx context.Context,
+	request *adminservice.MoveShardRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MoveShardResponse, error) {
+	var resp *adminservice.MoveShardResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MoveShard(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -380,6 +515,21 @@ func (c *retryableClient) RefreshWorkflowTasks(
 	return resp, err
 }
 
+func (c *retryableClient) RemoveDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.RemoveDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RemoveDynamicConfigOverrideResponse, error) {
+	var resp *adminservice.RemoveDynamicConfigOverrideResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RemoveDynamicConfigOverride(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RemoveRemoteCluster(
 	ctx context.Context,
 	request *adminservice.RemoveRemoteClusterRequest,
@@ -439,3 +589,48 @@ func (c *retryableClient) ResendReplicationTasks(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) SetDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigOverrideResponse, error) {
+	var resp *adminservice.SetDynamicConfigOverrideResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SetDynamicConfigOverride(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartBatchOperationResponse, error) {
+	var resp *adminservice.StartBatchOperationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartBatchOperation(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateSchedulePolicies(
+	ctx context.Context,
+	request *adminservice.UpdateSchedulePoliciesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateSchedulePoliciesResponse, error) {
+	var resp *adminservice.UpdateSchedulePoliciesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateSchedulePolicies(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -217,6 +217,34 @@ func (c *metricClient) GetShard(
 	return c.client.GetShard(ctx, request, opts...)
 }
 
+func (c *metricClient) HandoffShard(
+	ctx context.Context,
+	request *historyservice.HandoffShardRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.HandoffShardResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.HistoryClientHandoffShardScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.HandoffShard(ctx, request, opts...)
+}
+
+func (c *metricClient) ImportWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ImportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.ImportWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.HistoryClientImportWorkflowExecutionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ImportWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) MergeDLQMessages(
 	ctx context.Context,
 	request *historyservice.MergeDLQMessagesRequest,
@@ -637,6 +665,20 @@ func (c *metricClient) UpdateWorkflow(
 	return c.client.UpdateWorkflow(ctx, request, opts...)
 }
 
+func (c *metricClient) UpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	request *historyservice.UpsertWorkflowSearchAttributesRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.UpsertWorkflowSearchAttributesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.HistoryClientUpsertWorkflowSearchAttributesScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpsertWorkflowSearchAttributes(ctx, request, opts...)
+}
+
 func (c *metricClient) VerifyChildExecutionCompletionRecorded(
 	ctx context.Context,
 	request *historyservice.VerifyChildExecutionCompletionRecordedRequest,
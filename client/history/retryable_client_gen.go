@@ -230,6 +230,36 @@ func (c *retryableClient) GetShard(
 	return resp, err
 }
 
+func (c *retryableClient) HandoffShard(
+	ctx context.Context,
+	request *historyservice.HandoffShardRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.HandoffShardResponse, error) {
+	var resp *historyservice.HandoffShardResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.HandoffShard(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ImportWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ImportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ImportWorkflowExecutionResponse, error) {
+	var resp *historyservice.ImportWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ImportWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) MergeDLQMessages(
 	ctx context.Context,
 	request *historyservice.MergeDLQMessagesRequest,
@@ -680,6 +710,21 @@ func (c *retryableClient) UpdateWorkflow(
 	return resp, err
 }
 
+func (c *retryableClient) UpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	request *historyservice.UpsertWorkflowSearchAttributesRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpsertWorkflowSearchAttributesResponse, error) {
+	var resp *historyservice.UpsertWorkflowSearchAttributesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpsertWorkflowSearchAttributes(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) VerifyChildExecutionCompletionRecorded(
 	ctx context.Context,
 	request *historyservice.VerifyChildExecutionCompletionRecordedRequest,
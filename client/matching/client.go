@@ -68,13 +68,37 @@ func NewClient(
 	lb LoadBalancer,
 	useOldRouting dynamicconfig.BoolPropertyFn,
 ) matchingservice.MatchingServiceClient {
-	return &clientImpl{
+	c := &clientImpl{
 		timeout:         timeout,
 		longPollTimeout: longPollTimeout,
 		clients:         clients,
 		loadBalancer:    lb,
 		useOldRouting:   useOldRouting,
 	}
+	if dlb, ok := lb.(*defaultLoadBalancer); ok {
+		dlb.setPartitionCountFetcher(c.fetchPartitionCounts)
+	}
+	return c
+}
+
+// fetchPartitionCounts asks the root partition of a task queue how many partitions are
+// currently active, so that the load balancer stops picking retired partitions.
+func (c *clientImpl) fetchPartitionCounts(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	taskQueue string,
+	taskQueueType enumspb.TaskQueueType,
+) (int, int, error) {
+	resp, err := c.GetTaskQueueMetadata(ctx, &matchingservice.GetTaskQueueMetadataRequest{
+		NamespaceId:         namespaceID.String(),
+		TaskQueue:           taskQueue,
+		TaskQueueType:       taskQueueType,
+		WantPartitionCounts: true,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return int(resp.GetNumWritePartitions()), int(resp.GetNumReadPartitions()), nil
 }
 
 func (c *clientImpl) AddActivityTask(
@@ -202,6 +226,15 @@ func (c *clientImpl) createLongPollContext(parent context.Context) (context.Cont
 	return context.WithTimeout(parent, c.longPollTimeout)
 }
 
+// metadataTaskQueueType returns the type of the root partition a GetTaskQueueMetadata request
+// is served by. Versioning data lives on the workflow queue, which is also the default.
+func metadataTaskQueueType(taskQueueType enumspb.TaskQueueType) enumspb.TaskQueueType {
+	if taskQueueType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return enumspb.TASK_QUEUE_TYPE_WORKFLOW
+	}
+	return taskQueueType
+}
+
 func (c *clientImpl) getClientForTaskqueue(
 	namespaceID string,
 	taskQueue *taskqueuepb.TaskQueue,
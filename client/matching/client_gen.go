@@ -71,7 +71,7 @@ func (c *clientImpl) GetTaskQueueMetadata(
 	opts ...grpc.CallOption,
 ) (*matchingservice.GetTaskQueueMetadataResponse, error) {
 
-	client, err := c.getClientForTaskqueue(request.GetNamespaceId(), &taskqueuepb.TaskQueue{Name: request.GetTaskQueue()}, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	client, err := c.getClientForTaskqueue(request.GetNamespaceId(), &taskqueuepb.TaskQueue{Name: request.GetTaskQueue()}, metadataTaskQueueType(request.GetTaskQueueType()))
 	if err != nil {
 		return nil, err
 	}
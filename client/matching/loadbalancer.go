@@ -25,13 +25,17 @@
 package matching
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 
+	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
 )
@@ -65,15 +69,47 @@ type (
 		) string
 	}
 
+	// partitionCountFetcher returns the number of partitions of a task queue that are currently
+	// active for writes and reads, as decided by its root partition
+	partitionCountFetcher func(
+		ctx context.Context,
+		namespaceID namespace.ID,
+		taskQueue string,
+		taskQueueType enumspb.TaskQueueType,
+	) (write int, read int, err error)
+
 	defaultLoadBalancer struct {
-		nReadPartitions   dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
-		nWritePartitions  dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
-		namespaceIDToName func(id namespace.ID) (namespace.Name, error)
+		nReadPartitions            dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		nWritePartitions           dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		enablePartitionAutoscaling dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
+		partitionCountsRefresh     dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		namespaceIDToName          func(id namespace.ID) (namespace.Name, error)
+
+		// Active partition counts of autoscaled task queues. Entries are refreshed in the
+		// background so that picking a partition never waits for the root partition.
+		activeCounts cache.Cache
+		fetchCounts  atomic.Value // partitionCountFetcher
+	}
+
+	partitionCountsKey struct {
+		namespaceID   namespace.ID
+		taskQueue     string
+		taskQueueType enumspb.TaskQueueType
+	}
+
+	partitionCountsEntry struct {
+		write      int // zero until the first fetch succeeds
+		read       int
+		expiry     time.Time
+		refreshing atomic.Bool
 	}
 )
 
 const (
 	taskQueuePartitionPrefix = "/_sys/"
+
+	activePartitionCountsCacheSize = 10000
+	fetchPartitionCountsTimeout    = 5 * time.Second
 )
 
 // NewLoadBalancer returns an instance of matching load balancer that
@@ -83,19 +119,28 @@ func NewLoadBalancer(
 	dc *dynamicconfig.Collection,
 ) LoadBalancer {
 	return &defaultLoadBalancer{
-		namespaceIDToName: namespaceIDToName,
-		nReadPartitions:   dc.GetTaskQueuePartitionsProperty(dynamicconfig.MatchingNumTaskqueueReadPartitions),
-		nWritePartitions:  dc.GetTaskQueuePartitionsProperty(dynamicconfig.MatchingNumTaskqueueWritePartitions),
+		namespaceIDToName:          namespaceIDToName,
+		nReadPartitions:            dc.GetTaskQueuePartitionsProperty(dynamicconfig.MatchingNumTaskqueueReadPartitions),
+		nWritePartitions:           dc.GetTaskQueuePartitionsProperty(dynamicconfig.MatchingNumTaskqueueWritePartitions),
+		enablePartitionAutoscaling: dc.GetBoolPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingEnablePartitionAutoscaling, false),
+		partitionCountsRefresh:     dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPartitionAutoscalingInterval, time.Minute),
+		activeCounts:               cache.NewLRU(activePartitionCountsCacheSize),
 	}
 }
 
+// setPartitionCountFetcher sets how the load balancer learns the active partition counts of
+// autoscaled task queues. Until it is set, the configured counts are used.
+func (lb *defaultLoadBalancer) setPartitionCountFetcher(fetch partitionCountFetcher) {
+	lb.fetchCounts.Store(fetch)
+}
+
 func (lb *defaultLoadBalancer) PickWritePartition(
 	namespaceID namespace.ID,
 	taskQueue taskqueuepb.TaskQueue,
 	taskQueueType enumspb.TaskQueueType,
 	forwardedFrom string,
 ) string {
-	return lb.pickPartition(namespaceID, taskQueue, taskQueueType, forwardedFrom, lb.nWritePartitions)
+	return lb.pickPartition(namespaceID, taskQueue, taskQueueType, forwardedFrom, lb.nWritePartitions, func(e *partitionCountsEntry) int { return e.write })
 }
 
 func (lb *defaultLoadBalancer) PickReadPartition(
@@ -104,7 +149,7 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 	taskQueueType enumspb.TaskQueueType,
 	forwardedFrom string,
 ) string {
-	return lb.pickPartition(namespaceID, taskQueue, taskQueueType, forwardedFrom, lb.nReadPartitions)
+	return lb.pickPartition(namespaceID, taskQueue, taskQueueType, forwardedFrom, lb.nReadPartitions, func(e *partitionCountsEntry) int { return e.read })
 }
 
 func (lb *defaultLoadBalancer) pickPartition(
@@ -113,6 +158,7 @@ func (lb *defaultLoadBalancer) pickPartition(
 	taskQueueType enumspb.TaskQueueType,
 	forwardedFrom string,
 	nPartitions dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters,
+	nActive func(*partitionCountsEntry) int,
 ) string {
 
 	if forwardedFrom != "" || taskQueue.GetKind() == enumspb.TASK_QUEUE_KIND_STICKY {
@@ -133,6 +179,14 @@ func (lb *defaultLoadBalancer) pickPartition(
 	if n <= 0 {
 		return taskQueue.GetName()
 	}
+	if lb.enablePartitionAutoscaling(namespace.String(), taskQueue.GetName(), taskQueueType) {
+		// the configured count is the upper bound, partitions above the active count are retired
+		if e := lb.getActiveCounts(namespaceID, namespace, taskQueue.GetName(), taskQueueType); e != nil {
+			if active := nActive(e); active > 0 && active < n {
+				n = active
+			}
+		}
+	}
 
 	p := rand.Intn(n)
 	if p == 0 {
@@ -141,3 +195,54 @@ func (lb *defaultLoadBalancer) pickPartition(
 
 	return fmt.Sprintf("%v%v/%v", taskQueuePartitionPrefix, taskQueue.GetName(), p)
 }
+
+// getActiveCounts returns the cached active partition counts of a task queue, starting a
+// background refresh if they are missing or expired. It returns nil if nothing is cached yet.
+func (lb *defaultLoadBalancer) getActiveCounts(
+	namespaceID namespace.ID,
+	namespaceName namespace.Name,
+	taskQueue string,
+	taskQueueType enumspb.TaskQueueType,
+) *partitionCountsEntry {
+	fetch, ok := lb.fetchCounts.Load().(partitionCountFetcher)
+	if !ok {
+		return nil
+	}
+	key := partitionCountsKey{namespaceID: namespaceID, taskQueue: taskQueue, taskQueueType: taskQueueType}
+	e, _ := lb.activeCounts.Get(key).(*partitionCountsEntry)
+	if e == nil {
+		v, err := lb.activeCounts.PutIfNotExist(key, &partitionCountsEntry{})
+		if err != nil {
+			return nil
+		}
+		e = v.(*partitionCountsEntry)
+	}
+	if time.Now().After(e.expiry) && e.refreshing.CompareAndSwap(false, true) {
+		ttl := lb.partitionCountsRefresh(namespaceName.String(), taskQueue, taskQueueType)
+		go lb.refreshActiveCounts(fetch, key, e, ttl)
+	}
+	if e.write <= 0 {
+		return nil
+	}
+	return e
+}
+
+func (lb *defaultLoadBalancer) refreshActiveCounts(
+	fetch partitionCountFetcher,
+	key partitionCountsKey,
+	prev *partitionCountsEntry,
+	ttl time.Duration,
+) {
+	ctx, cancel := context.WithTimeout(context.Background(), fetchPartitionCountsTimeout)
+	defer cancel()
+	write, read, err := fetch(ctx, key.namespaceID, key.taskQueue, key.taskQueueType)
+	if err != nil {
+		// keep the last known counts and try again after the refresh interval
+		write, read = prev.write, prev.read
+	}
+	lb.activeCounts.Put(key, &partitionCountsEntry{
+		write:  write,
+		read:   read,
+		expiry: time.Now().Add(ttl),
+	})
+}
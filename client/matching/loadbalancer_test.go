@@ -0,0 +1,111 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+)
+
+func TestPickPartition_ActivePartitionCounts(t *testing.T) {
+	dc := dynamicconfig.NewCollection(dynamicconfig.StaticClient{
+		dynamicconfig.MatchingNumTaskqueueWritePartitions: 8,
+		dynamicconfig.MatchingNumTaskqueueReadPartitions:  8,
+		dynamicconfig.MatchingEnablePartitionAutoscaling:  true,
+	}, log.NewNoopLogger())
+	lb := NewLoadBalancer(func(id namespace.ID) (namespace.Name, error) {
+		return namespace.Name("test-namespace"), nil
+	}, dc).(*defaultLoadBalancer)
+
+	fetched := make(chan struct{}, 1)
+	lb.setPartitionCountFetcher(func(
+		_ context.Context,
+		_ namespace.ID,
+		taskQueue string,
+		taskQueueType enumspb.TaskQueueType,
+	) (int, int, error) {
+		assert.Equal(t, "tq", taskQueue)
+		assert.Equal(t, enumspb.TASK_QUEUE_TYPE_ACTIVITY, taskQueueType)
+		fetched <- struct{}{}
+		return 1, 2, nil
+	})
+
+	tq := taskqueuepb.TaskQueue{Name: "tq", Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	pick := func(write bool) map[string]bool {
+		seen := make(map[string]bool)
+		for i := 0; i < 200; i++ {
+			if write {
+				seen[lb.PickWritePartition("nsid", tq, enumspb.TASK_QUEUE_TYPE_ACTIVITY, "")] = true
+			} else {
+				seen[lb.PickReadPartition("nsid", tq, enumspb.TASK_QUEUE_TYPE_ACTIVITY, "")] = true
+			}
+		}
+		return seen
+	}
+
+	// counts are fetched in the background, the first pick uses the configured counts
+	lb.PickWritePartition("nsid", tq, enumspb.TASK_QUEUE_TYPE_ACTIVITY, "")
+	select {
+	case <-fetched:
+	case <-time.After(time.Second):
+		require.FailNow(t, "partition counts were not fetched")
+	}
+	require.Eventually(t, func() bool {
+		return len(pick(true)) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, map[string]bool{"tq": true}, pick(true))
+	assert.Equal(t, map[string]bool{"tq": true, "/_sys/tq/1": true}, pick(false))
+}
+
+func TestPickPartition_AutoscalingDisabled(t *testing.T) {
+	dc := dynamicconfig.NewCollection(dynamicconfig.StaticClient{
+		dynamicconfig.MatchingNumTaskqueueWritePartitions: 2,
+	}, log.NewNoopLogger())
+	lb := NewLoadBalancer(func(id namespace.ID) (namespace.Name, error) {
+		return namespace.Name("test-namespace"), nil
+	}, dc).(*defaultLoadBalancer)
+	lb.setPartitionCountFetcher(func(context.Context, namespace.ID, string, enumspb.TaskQueueType) (int, int, error) {
+		assert.Fail(t, "partition counts should not be fetched when autoscaling is disabled")
+		return 1, 1, nil
+	})
+
+	tq := taskqueuepb.TaskQueue{Name: "tq", Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	seen := make(map[string]bool)
+	for i := 0; i < 200; i++ {
+		seen[lb.PickWritePartition("nsid", tq, enumspb.TASK_QUEUE_TYPE_WORKFLOW, "")] = true
+	}
+	assert.Equal(t, map[string]bool{"tq": true, "/_sys/tq/1": true}, seen)
+}
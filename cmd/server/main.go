@@ -158,6 +158,11 @@ func buildCLI() *cli.App {
 					if err != nil {
 						return cli.Exit(fmt.Sprintf("Unable to create dynamic config client. Error: %v", err), 1)
 					}
+				} else if cfg.DynamicConfigDirClient != nil {
+					dynamicConfigClient, err = dynamicconfig.NewDirBasedClient(cfg.DynamicConfigDirClient, logger, temporal.InterruptCh())
+					if err != nil {
+						return cli.Exit(fmt.Sprintf("Unable to create dynamic config client. Error: %v", err), 1)
+					}
 				} else {
 					dynamicConfigClient = dynamicconfig.NewNoopClient()
 					logger.Info("Dynamic config client is not configured. Using noop client.")
@@ -0,0 +1,111 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type key struct {
+	name        string
+	description string
+}
+
+func panicIfErr(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+// readKeys returns the string constants declared in the given file, in declaration order,
+// with their doc comments as descriptions.
+func readKeys(path string) []key {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.ParseComments)
+	panicIfErr(err)
+
+	var keys []key
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			if len(valueSpec.Values) != len(valueSpec.Names) {
+				continue
+			}
+			for i, name := range valueSpec.Names {
+				if lit, ok := valueSpec.Values[i].(*ast.BasicLit); !ok || lit.Kind != token.STRING {
+					continue
+				}
+				keys = append(keys, key{
+					name:        name.Name,
+					description: strings.Join(strings.Fields(valueSpec.Doc.Text()), " "),
+				})
+			}
+		}
+	}
+	return keys
+}
+
+func readLicenseFile(path string) string {
+	text, err := os.ReadFile(path)
+	panicIfErr(err)
+	var lines []string
+	for _, line := range strings.Split(string(text), "\n") {
+		lines = append(lines, strings.TrimRight("// "+line, " "))
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func main() {
+	inputFlag := flag.String("input", "constants.go", "file declaring the dynamic config keys")
+	outputFlag := flag.String("output", "keys_gen.go", "file to write the key registry to")
+	packageFlag := flag.String("package", "dynamicconfig", "package of the generated file")
+	licenseFlag := flag.String("licence_file", "../../LICENSE", "path to license to copy into header")
+	flag.Parse()
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%s\n// Code generated by cmd/tools/dynamicconfigkeys. DO NOT EDIT.\n\n", readLicenseFile(*licenseFlag))
+	fmt.Fprintf(&b, "package %s\n\n", *packageFlag)
+	fmt.Fprintf(&b, "var registeredKeys = []registeredKey{\n")
+	for _, k := range readKeys(*inputFlag) {
+		fmt.Fprintf(&b, "{key: %s, description: %s},\n", k.name, strconv.Quote(k.description))
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	code, err := format.Source(b.Bytes())
+	panicIfErr(err)
+	panicIfErr(os.WriteFile(*outputFlag, code, 0644))
+}
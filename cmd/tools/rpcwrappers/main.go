@@ -179,9 +179,10 @@ func makeGetMatchingClient(reqType reflect.Type) string {
 	case "GetWorkerBuildIdOrderingRequest",
 		"UpdateWorkerBuildIdOrderingRequest",
 		"RespondQueryTaskCompletedRequest",
-		"ListTaskQueuePartitionsRequest",
-		"GetTaskQueueMetadataRequest":
+		"ListTaskQueuePartitionsRequest":
 		tqtPath = "enumspb.TASK_QUEUE_TYPE_WORKFLOW"
+	case "GetTaskQueueMetadataRequest":
+		tqtPath = "metadataTaskQueueType(request.GetTaskQueueType())"
 	default:
 		tqtPath = pathToField(t, "TaskQueueType", "request", 2)
 	}
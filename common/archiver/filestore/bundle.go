@@ -0,0 +1,350 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/klauspost/compress/zstd"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// Bundle format
+
+// A bundle is a zstd compressed sequence of protobuf messages, each prefixed with its
+// size as an uvarint. Histories are written as one bundle per workflow run holding all
+// history batches. Visibility records are written as one bundle per record, and every
+// archived record is also appended to an index file in the namespace directory, so queries
+// can filter records without reading every file. The index file is only ever appended to,
+// which lets queries keep the parsed index in memory and only parse what was appended since.
+
+const (
+	// FormatJSON writes histories and visibility records as JSON, this is the default format
+	FormatJSON = "json"
+	// FormatBundle writes histories and visibility records as zstd compressed protobuf bundles
+	FormatBundle = "bundle"
+
+	historyBundleExtension    = "historybundle"
+	visibilityBundleExtension = "visibilitybundle"
+	visibilityIndexFilename   = "visibility.index"
+)
+
+var (
+	errInvalidFormat   = errors.New("invalid archival format")
+	errCorruptedBundle = errors.New("corrupted bundle")
+
+	// Encoder and decoder without options can't fail to be created and are safe for
+	// concurrent use with EncodeAll and DecodeAll.
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+type (
+	// visibilityIndexEntry is a line in the visibility index file. It holds all fields
+	// the query parser can filter on.
+	visibilityIndexEntry struct {
+		Filename         string
+		CloseTime        int64
+		WorkflowID       string
+		RunID            string
+		WorkflowTypeName string
+		Status           enumspb.WorkflowExecutionStatus
+	}
+
+	// visibilityIndexCache holds the parsed index file of every namespace directory that
+	// was queried.
+	visibilityIndexCache struct {
+		sync.Mutex
+		indexes map[string]*visibilityIndex
+	}
+
+	// visibilityIndex is the parsed content of an index file up to offset, keyed by filename.
+	visibilityIndex struct {
+		sync.RWMutex
+		offset  int64
+		entries map[string]*visibilityIndexEntry
+	}
+)
+
+func validateFormat(format string) error {
+	switch format {
+	case "", FormatJSON, FormatBundle:
+		return nil
+	default:
+		return errInvalidFormat
+	}
+}
+
+func encodeBundle(messages ...proto.Message) ([]byte, error) {
+	var buf []byte
+	for _, message := range messages {
+		data, err := proto.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+		buf = binary.AppendUvarint(buf, uint64(len(data)))
+		buf = append(buf, data...)
+	}
+	return zstdEncoder.EncodeAll(buf, nil), nil
+}
+
+func decodeBundle(data []byte, newMessage func() proto.Message) ([]proto.Message, error) {
+	buf, err := zstdDecoder.DecodeAll(data, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []proto.Message
+	for len(buf) > 0 {
+		size, n := binary.Uvarint(buf)
+		if n <= 0 || size > uint64(len(buf)-n) {
+			return nil, errCorruptedBundle
+		}
+		message := newMessage()
+		if err := proto.Unmarshal(buf[n:n+int(size)], message); err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+		buf = buf[n+int(size):]
+	}
+	return messages, nil
+}
+
+func encodeHistoryBundle(histories []*historypb.History) ([]byte, error) {
+	messages := make([]proto.Message, len(histories))
+	for i, history := range histories {
+		messages[i] = history
+	}
+	return encodeBundle(messages...)
+}
+
+func decodeHistoryBundle(data []byte) ([]*historypb.History, error) {
+	messages, err := decodeBundle(data, func() proto.Message { return &historypb.History{} })
+	if err != nil {
+		return nil, err
+	}
+	histories := make([]*historypb.History, len(messages))
+	for i, message := range messages {
+		histories[i] = message.(*historypb.History)
+	}
+	return histories, nil
+}
+
+func decodeVisibilityRecordBundle(data []byte) (*archiverspb.VisibilityRecord, error) {
+	messages, err := decodeBundle(data, func() proto.Message { return &archiverspb.VisibilityRecord{} })
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) != 1 {
+		return nil, errCorruptedBundle
+	}
+	return messages[0].(*archiverspb.VisibilityRecord), nil
+}
+
+func constructHistoryBundleFilename(namespaceID, workflowID, runID string, version int64) string {
+	combinedHash := constructHistoryFilenamePrefix(namespaceID, workflowID, runID)
+	return fmt.Sprintf("%s_%v.%s", combinedHash, version, historyBundleExtension)
+}
+
+func constructVisibilityBundleFilename(closeTimestamp int64, runID string) string {
+	return fmt.Sprintf("%v_%s.%s", closeTimestamp, hash(runID), visibilityBundleExtension)
+}
+
+func isVisibilityBundleFilename(filename string) bool {
+	return path.Ext(filename) == "."+visibilityBundleExtension
+}
+
+// Visibility index
+
+func newVisibilityIndexEntry(filename string, record *archiverspb.VisibilityRecord) *visibilityIndexEntry {
+	return &visibilityIndexEntry{
+		Filename:         filename,
+		CloseTime:        timestamp.TimeValue(record.CloseTime).UnixNano(),
+		WorkflowID:       record.GetWorkflowId(),
+		RunID:            record.GetRunId(),
+		WorkflowTypeName: record.GetWorkflowTypeName(),
+		Status:           record.GetStatus(),
+	}
+}
+
+// appendVisibilityIndexEntry appends entry to the index file in dirPath. Each entry is
+// written with a single append, so concurrent archivers don't interleave entries.
+func appendVisibilityIndexEntry(dirPath string, entry *visibilityIndexEntry, fileMode os.FileMode) (retErr error) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	f, err := os.OpenFile(path.Join(dirPath, visibilityIndexFilename), os.O_APPEND|os.O_CREATE|os.O_WRONLY, fileMode)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	_, err = f.Write(data)
+	return err
+}
+
+func newVisibilityIndexCache() *visibilityIndexCache {
+	return &visibilityIndexCache{
+		indexes: make(map[string]*visibilityIndex),
+	}
+}
+
+// get returns the index of dirPath, or nil if there is no index file. Only the entries
+// appended since the previous call are read from the file. Records without an index entry
+// are still found by reading their file.
+func (c *visibilityIndexCache) get(dirPath string) (*visibilityIndex, error) {
+	indexPath := path.Join(dirPath, visibilityIndexFilename)
+	info, err := os.Stat(indexPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			c.Lock()
+			delete(c.indexes, dirPath)
+			c.Unlock()
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	c.Lock()
+	index, ok := c.indexes[dirPath]
+	if !ok {
+		index = &visibilityIndex{entries: make(map[string]*visibilityIndexEntry)}
+		c.indexes[dirPath] = index
+	}
+	c.Unlock()
+
+	if err := index.update(indexPath, info.Size()); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+// update parses the entries between the end of the last complete line read so far and size.
+// Lines that can't be decoded, e.g. two partially written entries, are ignored. A partially
+// written last line is parsed once it is complete.
+func (i *visibilityIndex) update(indexPath string, size int64) (retErr error) {
+	i.Lock()
+	defer i.Unlock()
+
+	if size == i.offset {
+		return nil
+	}
+	if size < i.offset {
+		// the file was replaced, start over
+		i.offset = 0
+		i.entries = make(map[string]*visibilityIndexEntry)
+	}
+
+	// #nosec
+	f, err := os.Open(indexPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	data := make([]byte, size-i.offset)
+	n, err := f.ReadAt(data, i.offset)
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	end := bytes.LastIndexByte(data[:n], '\n')
+	if end < 0 {
+		return nil
+	}
+	for _, line := range bytes.Split(data[:end], []byte{'\n'}) {
+		if len(line) == 0 {
+			continue
+		}
+		entry := &visibilityIndexEntry{}
+		if err := json.Unmarshal(line, entry); err != nil {
+			continue
+		}
+		i.entries[entry.Filename] = entry
+	}
+	i.offset += int64(end + 1)
+	return nil
+}
+
+func (i *visibilityIndex) get(filename string) (*visibilityIndexEntry, bool) {
+	if i == nil {
+		return nil, false
+	}
+	i.RLock()
+	defer i.RUnlock()
+	entry, ok := i.entries[filename]
+	return entry, ok
+}
+
+func (i *visibilityIndex) len() int {
+	if i == nil {
+		return 0
+	}
+	i.RLock()
+	defer i.RUnlock()
+	return len(i.entries)
+}
+
+func matchIndexEntry(entry *visibilityIndexEntry, query *parsedQuery) bool {
+	closeTime := timestamp.UnixOrZeroTime(entry.CloseTime)
+	if closeTime.Before(query.earliestCloseTime) || closeTime.After(query.latestCloseTime) {
+		return false
+	}
+	if query.workflowID != nil && entry.WorkflowID != *query.workflowID {
+		return false
+	}
+	if query.runID != nil && entry.RunID != *query.runID {
+		return false
+	}
+	if query.workflowTypeName != nil && entry.WorkflowTypeName != *query.workflowTypeName {
+		return false
+	}
+	if query.status != nil && entry.Status != *query.status {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,173 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/tests/testhelper"
+)
+
+type BundleSuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestBundleSuite(t *testing.T) {
+	suite.Run(t, new(BundleSuite))
+}
+
+func (s *BundleSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *BundleSuite) TestHistoryBundleRoundTrip() {
+	now := time.Date(2020, 8, 22, 1, 2, 3, 4, time.UTC)
+	histories := []*historypb.History{
+		{
+			Events: []*historypb.HistoryEvent{
+				{EventId: 1, EventTime: &now, Version: 100},
+				{EventId: 2, EventTime: &now, Version: 100},
+			},
+		},
+		{
+			Events: []*historypb.HistoryEvent{
+				{EventId: 3, EventTime: &now, Version: 100},
+			},
+		},
+	}
+
+	data, err := encodeHistoryBundle(histories)
+	s.NoError(err)
+	decoded, err := decodeHistoryBundle(data)
+	s.NoError(err)
+	s.Equal(histories, decoded)
+}
+
+func (s *BundleSuite) TestDecodeBundle_Corrupted() {
+	data, err := encodeHistoryBundle([]*historypb.History{{}})
+	s.NoError(err)
+
+	_, err = decodeHistoryBundle(data[:len(data)-1])
+	s.Error(err)
+
+	// a valid zstd frame with a size prefix larger than the remaining data
+	_, err = decodeHistoryBundle(zstdEncoder.EncodeAll([]byte{10, 1}, nil))
+	s.Equal(errCorruptedBundle, err)
+}
+
+func (s *BundleSuite) TestVisibilityIndex_Incremental() {
+	dir := testhelper.MkdirTemp(s.T(), "", "TestVisibilityIndex_Incremental")
+	cache := newVisibilityIndexCache()
+
+	record := &archiverspb.VisibilityRecord{
+		WorkflowId: testWorkflowID,
+		RunId:      testRunID,
+		CloseTime:  timestamp.UnixOrZeroTimePtr(1000),
+	}
+	filename1 := constructVisibilityBundleFilename(1000, testRunID)
+	s.NoError(appendVisibilityIndexEntry(dir, newVisibilityIndexEntry(filename1, record), testFileMode))
+
+	index, err := cache.get(dir)
+	s.NoError(err)
+	s.Equal(1, index.len())
+	offset := index.offset
+
+	// only the appended entries are parsed
+	record.RunId = "other-run-id"
+	filename2 := constructVisibilityBundleFilename(2000, record.RunId)
+	s.NoError(appendVisibilityIndexEntry(dir, newVisibilityIndexEntry(filename2, record), testFileMode))
+	index, err = cache.get(dir)
+	s.NoError(err)
+	s.Equal(2, index.len())
+	s.Greater(index.offset, offset)
+	_, ok := index.get(filename2)
+	s.True(ok)
+
+	// a replaced file is parsed from the start
+	s.NoError(os.Remove(filepath.Join(dir, visibilityIndexFilename)))
+	s.NoError(appendVisibilityIndexEntry(dir, newVisibilityIndexEntry(filename2, record), testFileMode))
+	index, err = cache.get(dir)
+	s.NoError(err)
+	s.Equal(1, index.len())
+	_, ok = index.get(filename1)
+	s.False(ok)
+}
+
+func (s *BundleSuite) TestVisibilityIndex() {
+	dir := testhelper.MkdirTemp(s.T(), "", "TestVisibilityIndex")
+	cache := newVisibilityIndexCache()
+
+	index, err := cache.get(dir)
+	s.NoError(err)
+	s.Nil(index)
+
+	record := &archiverspb.VisibilityRecord{
+		WorkflowId:       testWorkflowID,
+		RunId:            testRunID,
+		WorkflowTypeName: testWorkflowTypeName,
+		CloseTime:        timestamp.UnixOrZeroTimePtr(1000),
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+	}
+	filename := constructVisibilityBundleFilename(1000, testRunID)
+	s.True(isVisibilityBundleFilename(filename))
+	s.NoError(appendVisibilityIndexEntry(dir, newVisibilityIndexEntry(filename, record), testFileMode))
+
+	// a partially written entry is skipped
+	f, err := os.OpenFile(filepath.Join(dir, visibilityIndexFilename), os.O_APPEND|os.O_WRONLY, testFileMode)
+	s.NoError(err)
+	_, err = f.Write([]byte(`{"Filename":`))
+	s.NoError(err)
+	s.NoError(f.Close())
+
+	index, err = cache.get(dir)
+	s.NoError(err)
+	s.Equal(1, index.len())
+	entry, ok := index.get(filename)
+	s.True(ok)
+
+	query := &parsedQuery{
+		earliestCloseTime: time.Unix(0, 0),
+		latestCloseTime:   time.Unix(0, 2000),
+		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
+	}
+	s.True(matchIndexEntry(entry, query))
+	s.Equal(matchQuery(record, query), matchIndexEntry(entry, query))
+
+	query.workflowTypeName = convert.StringPtr("some other workflow type")
+	s.False(matchIndexEntry(entry, query))
+	s.Equal(matchQuery(record, query), matchIndexEntry(entry, query))
+}
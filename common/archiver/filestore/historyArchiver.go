@@ -26,7 +26,10 @@
 
 // Each Archive() request results in a file named in the format of
 // hash(namespaceID, workflowID, runID)_version.history being created in the specified
-// directory. Workflow histories stored in that file are encoded in JSON format.
+// directory. Workflow histories stored in that file are encoded in JSON format. When the
+// archiver is configured with the bundle format, the file is named
+// hash(namespaceID, workflowID, runID)_version.historybundle instead and holds a zstd
+// compressed protobuf bundle.
 
 // The Get() method retrieves the archived histories from the directory specified in the
 // URI. It optionally takes in a NextPageToken which specifies the workflow close failover
@@ -77,6 +80,7 @@ type (
 		container *archiver.HistoryBootstrapContainer
 		fileMode  os.FileMode
 		dirMode   os.FileMode
+		format    string
 
 		// only set in test code
 		historyIterator archiver.HistoryIterator
@@ -109,10 +113,14 @@ func newHistoryArchiver(
 	if err != nil {
 		return nil, errInvalidDirMode
 	}
+	if err := validateFormat(config.Format); err != nil {
+		return nil, err
+	}
 	return &historyArchiver{
 		container:       container,
 		fileMode:        os.FileMode(fileMode),
 		dirMode:         os.FileMode(dirMode),
+		format:          config.Format,
 		historyIterator: historyIterator,
 	}, nil
 }
@@ -176,8 +184,16 @@ func (h *historyArchiver) Archive(
 		historyBatches = append(historyBatches, historyBlob.Body...)
 	}
 
-	encoder := codec.NewJSONPBEncoder()
-	encodedHistoryBatches, err := encoder.EncodeHistories(historyBatches)
+	var encodedHistoryBatches []byte
+	var filename string
+	if h.format == FormatBundle {
+		encodedHistoryBatches, err = encodeHistoryBundle(historyBatches)
+		filename = constructHistoryBundleFilename(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+	} else {
+		encoder := codec.NewJSONPBEncoder()
+		encodedHistoryBatches, err = encoder.EncodeHistories(historyBatches)
+		filename = constructHistoryFilename(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+	}
 	if err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
 		return err
@@ -189,7 +205,6 @@ func (h *historyArchiver) Archive(
 		return err
 	}
 
-	filename := constructHistoryFilename(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
 	if err := writeFile(path.Join(dirPath, filename), encodedHistoryBatches, h.fileMode); err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
 		return err
@@ -242,12 +257,21 @@ func (h *historyArchiver) Get(
 		}
 	}
 
-	filename := constructHistoryFilename(request.NamespaceID, request.WorkflowID, request.RunID, token.CloseFailoverVersion)
-	filepath := path.Join(dirPath, filename)
+	// Histories may have been archived in either format, regardless of the current one.
+	isBundle := false
+	filepath := path.Join(dirPath, constructHistoryFilename(request.NamespaceID, request.WorkflowID, request.RunID, token.CloseFailoverVersion))
 	exists, err = fileExists(filepath)
 	if err != nil {
 		return nil, serviceerror.NewInternal(err.Error())
 	}
+	if !exists {
+		isBundle = true
+		filepath = path.Join(dirPath, constructHistoryBundleFilename(request.NamespaceID, request.WorkflowID, request.RunID, token.CloseFailoverVersion))
+		exists, err = fileExists(filepath)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+	}
 	if !exists {
 		return nil, serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error())
 	}
@@ -257,8 +281,13 @@ func (h *historyArchiver) Get(
 		return nil, serviceerror.NewInternal(err.Error())
 	}
 
-	encoder := codec.NewJSONPBEncoder()
-	historyBatches, err := encoder.DecodeHistories(encodedHistoryBatches)
+	var historyBatches []*historypb.History
+	if isBundle {
+		historyBatches, err = decodeHistoryBundle(encodedHistoryBatches)
+	} else {
+		encoder := codec.NewJSONPBEncoder()
+		historyBatches, err = encoder.DecodeHistories(encodedHistoryBatches)
+	}
 	if err != nil {
 		return nil, serviceerror.NewInternal(err.Error())
 	}
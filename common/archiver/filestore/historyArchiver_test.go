@@ -571,6 +571,71 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(s.historyBatchesV100, response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestArchiveAndGet_BundleFormat() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyBlob := &archiverspb.HistoryBlob{
+		Header: &archiverspb.HistoryBlobHeader{
+			IsLast: true,
+		},
+		Body: s.historyBatchesV100,
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(historyBlob, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	dir := testhelper.MkdirTemp(s.T(), "", "TestArchiveAndGet_BundleFormat")
+
+	config := &config.FilestoreArchiver{
+		FileMode: testFileModeStr,
+		DirMode:  testDirModeStr,
+		Format:   FormatBundle,
+	}
+	historyArchiver, err := newHistoryArchiver(s.container, config, historyIterator)
+	s.NoError(err)
+	archiveRequest := &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, archiveRequest)
+	s.NoError(err)
+
+	expectedFilename := constructHistoryBundleFilename(testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion)
+	s.assertFileExists(path.Join(dir, expectedFilename))
+
+	getRequest := &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	}
+	response, err := historyArchiver.Get(context.Background(), URI, getRequest)
+	s.NoError(err)
+	s.NotNil(response)
+	s.Nil(response.NextPageToken)
+	s.Equal(s.historyBatchesV100, response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestNewHistoryArchiver_Fail_InvalidFormat() {
+	config := &config.FilestoreArchiver{
+		FileMode: testFileModeStr,
+		DirMode:  testDirModeStr,
+		Format:   "parquet",
+	}
+	_, err := newHistoryArchiver(s.container, config, nil)
+	s.Equal(errInvalidFormat, err)
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
@@ -41,6 +41,7 @@ import (
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
@@ -55,7 +56,9 @@ type (
 		container   *archiver.VisibilityBootstrapContainer
 		fileMode    os.FileMode
 		dirMode     os.FileMode
+		format      string
 		queryParser QueryParser
+		indexCache  *visibilityIndexCache
 	}
 
 	queryVisibilityToken struct {
@@ -84,11 +87,16 @@ func NewVisibilityArchiver(
 	if err != nil {
 		return nil, errInvalidDirMode
 	}
+	if err := validateFormat(config.Format); err != nil {
+		return nil, err
+	}
 	return &visibilityArchiver{
 		container:   container,
 		fileMode:    os.FileMode(fileMode),
 		dirMode:     os.FileMode(dirMode),
+		format:      config.Format,
 		queryParser: NewQueryParser(),
+		indexCache:  newVisibilityIndexCache(),
 	}, nil
 }
 
@@ -123,6 +131,10 @@ func (v *visibilityArchiver) Archive(
 		return err
 	}
 
+	if v.format == FormatBundle {
+		return v.archiveBundle(dirPath, request, logger)
+	}
+
 	encodedVisibilityRecord, err := encode(request)
 	if err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeVisibilityRecord), tag.Error(err))
@@ -140,6 +152,34 @@ func (v *visibilityArchiver) Archive(
 	return nil
 }
 
+func (v *visibilityArchiver) archiveBundle(
+	dirPath string,
+	request *archiverspb.VisibilityRecord,
+	logger log.Logger,
+) error {
+	encodedVisibilityRecord, err := encodeBundle(request)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeVisibilityRecord), tag.Error(err))
+		return err
+	}
+
+	// The filename has the format: closeTimestamp_hash(runID).visibilitybundle
+	filename := constructVisibilityBundleFilename(timestamp.TimeValue(request.CloseTime).UnixNano(), request.GetRunId())
+	if err := writeFile(path.Join(dirPath, filename), encodedVisibilityRecord, v.fileMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
+		return err
+	}
+
+	// The record is written before its index entry, so an entry never points to a missing file.
+	// Retried archivals may append duplicate entries, the last one wins when the index is read.
+	if err := appendVisibilityIndexEntry(dirPath, newVisibilityIndexEntry(filename, request), v.fileMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
+		return err
+	}
+
+	return nil
+}
+
 func (v *visibilityArchiver) Query(
 	ctx context.Context,
 	URI archiver.URI,
@@ -205,6 +245,7 @@ func (v *visibilityArchiver) query(
 		return nil, serviceerror.NewInternal(err.Error())
 	}
 
+	files = filterIndexFile(files)
 	files, err = sortAndFilterFiles(files, token)
 	if err != nil {
 		return nil, serviceerror.NewInternal(err.Error())
@@ -213,14 +254,33 @@ func (v *visibilityArchiver) query(
 		return &archiver.QueryVisibilityResponse{}, nil
 	}
 
+	index, err := v.indexCache.get(dirPath)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+
 	response := &archiver.QueryVisibilityResponse{}
 	for idx, file := range files {
+		if entry, ok := index.get(file); ok {
+			if timestamp.UnixOrZeroTime(entry.CloseTime).Before(request.parsedQuery.earliestCloseTime) {
+				break
+			}
+			if !matchIndexEntry(entry, request.parsedQuery) {
+				continue
+			}
+		}
+
 		encodedRecord, err := readFile(path.Join(dirPath, file))
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
 
-		record, err := decodeVisibilityRecord(encodedRecord)
+		var record *archiverspb.VisibilityRecord
+		if isVisibilityBundleFilename(file) {
+			record, err = decodeVisibilityRecordBundle(encodedRecord)
+		} else {
+			record, err = decodeVisibilityRecord(encodedRecord)
+		}
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
@@ -264,6 +324,16 @@ func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
 	return validateDirPath((URI.Path()))
 }
 
+func filterIndexFile(filenames []string) []string {
+	var filteredFilenames []string
+	for _, name := range filenames {
+		if name != visibilityIndexFilename {
+			filteredFilenames = append(filteredFilenames, name)
+		}
+	}
+	return filteredFilenames
+}
+
 type parsedVisFilename struct {
 	name        string
 	closeTime   time.Time
@@ -511,6 +511,57 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(ei, executions[1])
 }
 
+func (s *visibilityArchiverSuite) TestArchiveAndQuery_BundleFormat() {
+	dir := testhelper.MkdirTemp(s.T(), "", "TestArchiveAndQuery_BundleFormat")
+
+	config := &config.FilestoreArchiver{
+		FileMode: testFileModeStr,
+		DirMode:  testDirModeStr,
+		Format:   FormatBundle,
+	}
+	a, err := NewVisibilityArchiver(s.container, config)
+	s.NoError(err)
+	visibilityArchiver := a.(*visibilityArchiver)
+	mockParser := NewMockQueryParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		earliestCloseTime: time.Unix(0, 10),
+		latestCloseTime:   time.Unix(0, 10001),
+		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
+	}, nil).AnyTimes()
+	visibilityArchiver.queryParser = mockParser
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	for _, record := range s.visibilityRecords {
+		err := visibilityArchiver.Archive(context.Background(), URI, record)
+		s.NoError(err)
+	}
+
+	index, err := newVisibilityIndexCache().get(path.Join(dir, testNamespaceID))
+	s.NoError(err)
+	s.Equal(len(s.visibilityRecords)-1, index.len()) // the last record is in another namespace
+
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+		Query:       "parsed by mockParser",
+	}
+	executions := []*workflowpb.WorkflowExecutionInfo{}
+	for len(executions) == 0 || request.NextPageToken != nil {
+		response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+		s.NoError(err)
+		s.NotNil(response)
+		executions = append(executions, response.Executions...)
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Len(executions, 2)
+	ei, err := convertToExecutionInfo(s.visibilityRecords[0], searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Equal(ei, executions[0])
+	ei, err = convertToExecutionInfo(s.visibilityRecords[1], searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Equal(ei, executions[1])
+}
+
 func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
@@ -28,6 +28,7 @@ package archiver
 
 import (
 	"context"
+	"time"
 
 	historypb "go.temporal.io/api/history/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
@@ -76,6 +77,8 @@ type (
 		Logger           log.Logger
 		MetricsHandler   metrics.Handler
 		ClusterMetadata  cluster.Metadata
+		// NamespaceRetentionProvider is optional, archivers that expire archived data must handle it being nil
+		NamespaceRetentionProvider NamespaceRetentionProvider
 	}
 
 	// HistoryArchiver is used to archive history and read archived history
@@ -101,6 +104,13 @@ type (
 		Logger          log.Logger
 		MetricsHandler  metrics.Handler
 		ClusterMetadata cluster.Metadata
+		// NamespaceRetentionProvider is optional, archivers that expire archived data must handle it being nil
+		NamespaceRetentionProvider NamespaceRetentionProvider
+	}
+
+	// NamespaceRetentionProvider returns the retention period of a namespace
+	NamespaceRetentionProvider interface {
+		GetNamespaceRetention(namespaceID string) (time.Duration, error)
 	}
 
 	// QueryVisibilityRequest is the request to query archived visibility records
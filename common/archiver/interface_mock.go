@@ -31,6 +31,7 @@ package archiver
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	v1 "go.temporal.io/server/api/archiver/v1"
@@ -178,3 +179,41 @@ func (mr *MockVisibilityArchiverMockRecorder) ValidateURI(uri interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateURI", reflect.TypeOf((*MockVisibilityArchiver)(nil).ValidateURI), uri)
 }
+
+// MockNamespaceRetentionProvider is a mock of NamespaceRetentionProvider interface.
+type MockNamespaceRetentionProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockNamespaceRetentionProviderMockRecorder
+}
+
+// MockNamespaceRetentionProviderMockRecorder is the mock recorder for MockNamespaceRetentionProvider.
+type MockNamespaceRetentionProviderMockRecorder struct {
+	mock *MockNamespaceRetentionProvider
+}
+
+// NewMockNamespaceRetentionProvider creates a new mock instance.
+func NewMockNamespaceRetentionProvider(ctrl *gomock.Controller) *MockNamespaceRetentionProvider {
+	mock := &MockNamespaceRetentionProvider{ctrl: ctrl}
+	mock.recorder = &MockNamespaceRetentionProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNamespaceRetentionProvider) EXPECT() *MockNamespaceRetentionProviderMockRecorder {
+	return m.recorder
+}
+
+// GetNamespaceRetention mocks base method.
+func (m *MockNamespaceRetentionProvider) GetNamespaceRetention(namespaceID string) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespaceRetention", namespaceID)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaceRetention indicates an expected call of GetNamespaceRetention.
+func (mr *MockNamespaceRetentionProviderMockRecorder) GetNamespaceRetention(namespaceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceRetention", reflect.TypeOf((*MockNamespaceRetentionProvider)(nil).GetNamespaceRetention), namespaceID)
+}
@@ -0,0 +1,224 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"go.uber.org/multierr"
+
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	// expireTimeMetadataKey is the user metadata key holding the time an archived object
+	// can be deleted at. Object stores don't act on metadata by themselves, a lifecycle rule
+	// or cleanup job is expected to delete expired objects.
+	expireTimeMetadataKey = "Temporal-Expire-Time"
+
+	encryptionAlgorithmAES256 = s3.ServerSideEncryptionAes256
+	encryptionAlgorithmKMS    = s3.ServerSideEncryptionAwsKms
+
+	defaultBlobstoreTimeout = time.Minute
+)
+
+var (
+	// ErrBucketNotFound is returned when the bucket of an URI doesn't exist
+	ErrBucketNotFound = errors.New("bucket not found")
+	// ErrObjectNotFound is returned when an object doesn't exist
+	ErrObjectNotFound = errors.New("object not found")
+)
+
+type (
+	// Client is the subset of object store operations used by the archivers
+	Client interface {
+		// BucketExists returns ErrBucketNotFound if the bucket doesn't exist
+		BucketExists(ctx context.Context, bucket string) error
+		// Put writes an object, replacing any existing object with the same key
+		Put(ctx context.Context, bucket string, key string, data []byte, opts *PutOptions) error
+		// Get returns ErrObjectNotFound if the object doesn't exist
+		Get(ctx context.Context, bucket string, key string) ([]byte, error)
+		Exists(ctx context.Context, bucket string, key string) (bool, error)
+		// List returns up to maxKeys keys with the given prefix in ascending order, starting
+		// after the startAfter key
+		List(ctx context.Context, bucket string, prefix string, startAfter string, maxKeys int) ([]string, error)
+	}
+
+	// PutOptions are the options of an object written with Put
+	PutOptions struct {
+		// ExpireTime is the time after which the object can be deleted, nil if it never expires
+		ExpireTime *time.Time
+		// Encryption is the server side encryption of the object, nil if not encrypted
+		Encryption *config.S3Encryption
+	}
+
+	s3Client struct {
+		s3cli s3iface.S3API
+	}
+)
+
+// NewS3Client returns a Client for S3 compatible object stores. Credentials are resolved by
+// the default AWS credential chain, e.g. from the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY
+// environment variables.
+func NewS3Client(config *config.ObjectStoreArchiver) (Client, error) {
+	s3Config := &aws.Config{
+		Region:           aws.String(config.Region),
+		S3ForcePathStyle: aws.Bool(config.S3ForcePathStyle),
+	}
+	if config.Endpoint != "" {
+		s3Config.Endpoint = aws.String(config.Endpoint)
+	}
+	sess, err := session.NewSession(s3Config)
+	if err != nil {
+		return nil, err
+	}
+	return &s3Client{s3cli: s3.New(sess)}, nil
+}
+
+func (c *s3Client) BucketExists(ctx context.Context, bucket string) error {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	_, err := c.s3cli.HeadBucketWithContext(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(bucket),
+	})
+	if isNotFoundError(err) {
+		return ErrBucketNotFound
+	}
+	return convertS3Error(err)
+}
+
+func (c *s3Client) Put(ctx context.Context, bucket string, key string, data []byte, opts *PutOptions) error {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(data),
+	}
+	if opts != nil && opts.ExpireTime != nil {
+		input.Expires = opts.ExpireTime
+		input.Metadata = map[string]*string{
+			expireTimeMetadataKey: aws.String(opts.ExpireTime.UTC().Format(time.RFC3339)),
+		}
+	}
+	if opts != nil && opts.Encryption != nil {
+		input.ServerSideEncryption = aws.String(opts.Encryption.Algorithm)
+		if opts.Encryption.KMSKeyID != "" {
+			input.SSEKMSKeyId = aws.String(opts.Encryption.KMSKeyID)
+		}
+	}
+	_, err := c.s3cli.PutObjectWithContext(ctx, input)
+	return convertS3Error(err)
+}
+
+func (c *s3Client) Get(ctx context.Context, bucket string, key string) (data []byte, err error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	result, err := c.s3cli.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, convertS3Error(err)
+	}
+	defer func() {
+		err = multierr.Combine(err, result.Body.Close())
+	}()
+	return io.ReadAll(result.Body)
+}
+
+func (c *s3Client) Exists(ctx context.Context, bucket string, key string) (bool, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	_, err := c.s3cli.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		return false, convertS3Error(err)
+	}
+	return true, nil
+}
+
+func (c *s3Client) List(ctx context.Context, bucket string, prefix string, startAfter string, maxKeys int) ([]string, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	input := &s3.ListObjectsV2Input{
+		Bucket:  aws.String(bucket),
+		Prefix:  aws.String(prefix),
+		MaxKeys: aws.Int64(int64(maxKeys)),
+	}
+	if startAfter != "" {
+		input.StartAfter = aws.String(startAfter)
+	}
+	result, err := c.s3cli.ListObjectsV2WithContext(ctx, input)
+	if err != nil {
+		return nil, convertS3Error(err)
+	}
+	keys := make([]string, 0, len(result.Contents))
+	for _, object := range result.Contents {
+		keys = append(keys, aws.StringValue(object.Key))
+	}
+	return keys, nil
+}
+
+func convertS3Error(err error) error {
+	if aerr, ok := err.(awserr.Error); ok {
+		switch aerr.Code() {
+		case s3.ErrCodeNoSuchBucket:
+			return ErrBucketNotFound
+		case s3.ErrCodeNoSuchKey:
+			return ErrObjectNotFound
+		}
+	}
+	return err
+}
+
+// isNotFoundError returns true for errors of HEAD requests on missing buckets or objects,
+// they have no body and only report the status code.
+func isNotFoundError(err error) bool {
+	rerr, ok := err.(awserr.RequestFailure)
+	return ok && rerr.StatusCode() == http.StatusNotFound
+}
+
+func ensureContextTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, defaultBlobstoreTimeout)
+}
@@ -0,0 +1,101 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+)
+
+// newFakeServerClient returns the S3 client of this package connected to a fake server backed by store
+func newFakeServerClient(t *testing.T, store *InMemoryClient) Client {
+	server := NewFakeServer(store)
+	t.Cleanup(server.Close)
+	t.Setenv("AWS_ACCESS_KEY_ID", "test-access-key-id")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test-secret-access-key")
+	client, err := NewS3Client(&config.ObjectStoreArchiver{
+		Endpoint:         server.URL,
+		Region:           "us-east-1",
+		S3ForcePathStyle: true,
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func TestS3Client(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Date(2020, 8, 22, 1, 2, 3, 0, time.UTC))
+	store := NewInMemoryClient(timeSource, testBucket)
+	client := newFakeServerClient(t, store)
+	ctx := context.Background()
+
+	require.NoError(t, client.BucketExists(ctx, testBucket))
+	require.Equal(t, ErrBucketNotFound, client.BucketExists(ctx, "missing-bucket"))
+	require.Equal(t, ErrBucketNotFound, client.Put(ctx, "missing-bucket", "key", []byte("data"), nil))
+
+	expireTime := timeSource.Now().Add(time.Hour)
+	encryption := &config.S3Encryption{Algorithm: encryptionAlgorithmKMS, KMSKeyID: "test-key"}
+	require.NoError(t, client.Put(ctx, testBucket, "prefix/key 1", []byte("data"), &PutOptions{
+		ExpireTime: &expireTime,
+		Encryption: encryption,
+	}))
+	putOptions, err := store.GetPutOptions(testBucket, "prefix/key 1")
+	require.NoError(t, err)
+	require.Equal(t, expireTime, *putOptions.ExpireTime)
+	require.Equal(t, encryption, putOptions.Encryption)
+
+	data, err := client.Get(ctx, testBucket, "prefix/key 1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("data"), data)
+	_, err = client.Get(ctx, testBucket, "prefix/missing")
+	require.Equal(t, ErrObjectNotFound, err)
+
+	exists, err := client.Exists(ctx, testBucket, "prefix/key 1")
+	require.NoError(t, err)
+	require.True(t, exists)
+	exists, err = client.Exists(ctx, testBucket, "prefix/missing")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	for i := 2; i <= 4; i++ {
+		require.NoError(t, client.Put(ctx, testBucket, fmt.Sprintf("prefix/key %d", i), nil, nil))
+	}
+	require.NoError(t, client.Put(ctx, testBucket, "other/key", nil, nil))
+	keys, err := client.List(ctx, testBucket, "prefix/", "prefix/key 1", 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"prefix/key 2", "prefix/key 3"}, keys)
+
+	// the store deletes the object once it expires
+	timeSource.Update(expireTime)
+	exists, err = client.Exists(ctx, testBucket, "prefix/key 1")
+	require.NoError(t, err)
+	require.False(t, exists)
+}
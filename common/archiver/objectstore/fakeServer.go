@@ -0,0 +1,182 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package objectstore
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+
+	"go.temporal.io/server/common/config"
+)
+
+type (
+	// fakeServer serves the subset of the S3 API used by the S3 client of this package, with
+	// path style bucket addressing, from an InMemoryClient.
+	fakeServer struct {
+		client *InMemoryClient
+	}
+
+	fakeListBucketResult struct {
+		XMLName     xml.Name            `xml:"ListBucketResult"`
+		Name        string              `xml:"Name"`
+		Prefix      string              `xml:"Prefix"`
+		KeyCount    int                 `xml:"KeyCount"`
+		MaxKeys     int                 `xml:"MaxKeys"`
+		IsTruncated bool                `xml:"IsTruncated"`
+		Contents    []fakeObjectSummary `xml:"Contents"`
+	}
+
+	fakeObjectSummary struct {
+		Key string `xml:"Key"`
+	}
+
+	fakeError struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string   `xml:"Code"`
+		Message string   `xml:"Message"`
+	}
+)
+
+// NewFakeServer starts an in-process object store server backed by client. Point the endpoint
+// of an ObjectStoreArchiver config with S3ForcePathStyle at its URL to test the archivers over
+// HTTP without an object store. The caller must close the server.
+func NewFakeServer(client *InMemoryClient) *httptest.Server {
+	return httptest.NewServer(&fakeServer{client: client})
+}
+
+func (s *fakeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	switch {
+	case key == "" && r.Method == http.MethodHead:
+		s.writeError(w, r, s.client.BucketExists(r.Context(), bucket))
+	case key == "" && r.Method == http.MethodGet:
+		s.list(w, r, bucket)
+	case key != "" && r.Method == http.MethodPut:
+		s.put(w, r, bucket, key)
+	case key != "" && r.Method == http.MethodGet:
+		data, err := s.client.Get(r.Context(), bucket, key)
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+		_, _ = w.Write(data)
+	case key != "" && r.Method == http.MethodHead:
+		if _, err := s.client.Get(r.Context(), bucket, key); err != nil {
+			s.writeError(w, r, err)
+		}
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *fakeServer) put(w http.ResponseWriter, r *http.Request, bucket string, key string) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	opts := &PutOptions{}
+	if value := r.Header.Get("X-Amz-Meta-" + expireTimeMetadataKey); value != "" {
+		expireTime, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+		opts.ExpireTime = &expireTime
+	}
+	if algorithm := r.Header.Get("X-Amz-Server-Side-Encryption"); algorithm != "" {
+		opts.Encryption = &config.S3Encryption{
+			Algorithm: algorithm,
+			KMSKeyID:  r.Header.Get("X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id"),
+		}
+	}
+	s.writeError(w, r, s.client.Put(r.Context(), bucket, key, data, opts))
+}
+
+func (s *fakeServer) list(w http.ResponseWriter, r *http.Request, bucket string) {
+	query := r.URL.Query()
+	maxKeys := 1000
+	if value := query.Get("max-keys"); value != "" {
+		var err error
+		if maxKeys, err = strconv.Atoi(value); err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+	}
+	keys, err := s.client.List(r.Context(), bucket, query.Get("prefix"), query.Get("start-after"), maxKeys)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	result := &fakeListBucketResult{
+		Name:     bucket,
+		Prefix:   query.Get("prefix"),
+		KeyCount: len(keys),
+		MaxKeys:  maxKeys,
+	}
+	for _, key := range keys {
+		result.Contents = append(result.Contents, fakeObjectSummary{Key: key})
+	}
+	s.writeXML(w, http.StatusOK, result)
+}
+
+// writeError writes the S3 error response for err, or nothing if err is nil
+func (s *fakeServer) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	if err == nil {
+		return
+	}
+	status, code := http.StatusInternalServerError, "InternalError"
+	switch {
+	case errors.Is(err, ErrBucketNotFound):
+		status, code = http.StatusNotFound, s3.ErrCodeNoSuchBucket
+	case errors.Is(err, ErrObjectNotFound):
+		status, code = http.StatusNotFound, s3.ErrCodeNoSuchKey
+	}
+	if r.Method == http.MethodHead {
+		// responses to HEAD requests have no body
+		w.WriteHeader(status)
+		return
+	}
+	s.writeXML(w, status, &fakeError{Code: code, Message: err.Error()})
+}
+
+func (s *fakeServer) writeXML(w http.ResponseWriter, status int, v interface{}) {
+	data, err := xml.Marshal(v)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = w.Write(append([]byte(xml.Header), data...))
+}
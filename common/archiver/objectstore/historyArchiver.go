@@ -0,0 +1,415 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Object store History Archiver will archive workflow histories to S3 compatible object stores
+
+package objectstore
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	// URIScheme is the scheme for the object store implementation
+	URIScheme = "objectstore"
+
+	errEncodeHistory      = "failed to encode history batches"
+	errWriteKey           = "failed to write history to object store"
+	errGetRetention       = "failed to get namespace retention"
+	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
+	listPageSize          = 1000
+)
+
+type (
+	historyArchiver struct {
+		container  *archiver.HistoryBootstrapContainer
+		config     *config.ObjectStoreArchiver
+		client     Client
+		timeSource clock.TimeSource
+
+		// only set in test code
+		historyIterator archiver.HistoryIterator
+	}
+
+	getHistoryToken struct {
+		CloseFailoverVersion int64
+		BatchIdx             int
+	}
+
+	uploadProgress struct {
+		BatchIdx      int
+		IteratorState []byte
+		uploadedSize  int64
+		historySize   int64
+	}
+)
+
+// NewHistoryArchiver creates a new archiver.HistoryArchiver based on an S3 compatible object store
+func NewHistoryArchiver(
+	container *archiver.HistoryBootstrapContainer,
+	config *config.ObjectStoreArchiver,
+) (archiver.HistoryArchiver, error) {
+	client, err := NewS3Client(config)
+	if err != nil {
+		return nil, err
+	}
+	return NewHistoryArchiverWithClient(container, config, client)
+}
+
+// NewHistoryArchiverWithClient creates a new archiver.HistoryArchiver using the given object store client
+func NewHistoryArchiverWithClient(
+	container *archiver.HistoryBootstrapContainer,
+	config *config.ObjectStoreArchiver,
+	client Client,
+) (archiver.HistoryArchiver, error) {
+	return newHistoryArchiver(container, config, client, clock.NewRealTimeSource(), nil)
+}
+
+func newHistoryArchiver(
+	container *archiver.HistoryBootstrapContainer,
+	config *config.ObjectStoreArchiver,
+	client Client,
+	timeSource clock.TimeSource,
+	historyIterator archiver.HistoryIterator,
+) (*historyArchiver, error) {
+	if err := validateConfig(config); err != nil {
+		return nil, err
+	}
+	return &historyArchiver{
+		container:       container,
+		config:          config,
+		client:          client,
+		timeSource:      timeSource,
+		historyIterator: historyIterator,
+	}, nil
+}
+
+func (h *historyArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ArchiveHistoryRequest,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	handler := h.container.MetricsHandler.WithTags(metrics.OperationTag(metrics.HistoryArchiverScope), metrics.NamespaceTag(request.Namespace))
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	startTime := time.Now().UTC()
+	defer func() {
+		handler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+		if err != nil {
+			if isRetryableError(err) {
+				handler.Counter(metrics.HistoryArchiverArchiveTransientErrorCount.GetMetricName()).Record(1)
+			} else {
+				handler.Counter(metrics.HistoryArchiverArchiveNonRetryableErrorCount.GetMetricName()).Record(1)
+				if featureCatalog.NonRetryableError != nil {
+					err = featureCatalog.NonRetryableError()
+				}
+			}
+		}
+	}()
+
+	logger := archiver.TagLoggerWithArchiveHistoryRequestAndURI(h.container.Logger, request, URI.String())
+
+	if err := validateURI(URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return newNonRetryableError(err)
+	}
+
+	if err := archiver.ValidateHistoryArchiveRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return newNonRetryableError(err)
+	}
+
+	expireTime, err := getExpireTime(h.container.NamespaceRetentionProvider, request.NamespaceID, h.timeSource.Now())
+	if err != nil {
+		logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errGetRetention), tag.Error(err))
+		return err
+	}
+	putOptions := &PutOptions{
+		ExpireTime: expireTime,
+		Encryption: getEncryption(h.config, request.Namespace),
+	}
+
+	var progress uploadProgress
+	historyIterator := h.historyIterator
+	if historyIterator == nil { // will only be set by testing code
+		historyIterator = loadHistoryIterator(ctx, request, h.container.ExecutionManager, featureCatalog, &progress)
+	}
+	for historyIterator.HasNext() {
+		historyBlob, err := historyIterator.Next()
+		if err != nil {
+			if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
+				// workflow history no longer exists, may due to duplicated archival signal
+				// this may happen even in the middle of iterating history as two archival signals
+				// can be processed concurrently.
+				logger.Info(archiver.ArchiveSkippedInfoMsg)
+				handler.Counter(metrics.HistoryArchiverDuplicateArchivalsCount.GetMetricName()).Record(1)
+				return nil
+			}
+
+			logger := log.With(logger, tag.ArchivalArchiveFailReason(archiver.ErrReasonReadHistory), tag.Error(err))
+			if common.IsPersistenceTransientError(err) {
+				logger.Error(archiver.ArchiveTransientErrorMsg)
+				return err
+			}
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg)
+			return newNonRetryableError(err)
+		}
+
+		if historyMutated(request, historyBlob.Body, historyBlob.Header.IsLast) {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonHistoryMutated))
+			return newNonRetryableError(archiver.ErrHistoryMutated)
+		}
+
+		encodedHistoryBlob, err := encode(historyBlob)
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+			return newNonRetryableError(err)
+		}
+		key := constructHistoryKey(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion, progress.BatchIdx)
+
+		exists, err := h.client.Exists(ctx, URI.Hostname(), key)
+		if err != nil {
+			logArchiveError(logger, errWriteKey, err)
+			return err
+		}
+		blobSize := int64(binary.Size(encodedHistoryBlob))
+		if exists {
+			handler.Counter(metrics.HistoryArchiverBlobExistsCount.GetMetricName()).Record(1)
+		} else {
+			if err := h.client.Put(ctx, URI.Hostname(), key, encodedHistoryBlob, putOptions); err != nil {
+				logArchiveError(logger, errWriteKey, err)
+				return err
+			}
+			progress.uploadedSize += blobSize
+			handler.Histogram(metrics.HistoryArchiverBlobSize.GetMetricName(), metrics.HistoryArchiverBlobSize.GetMetricUnit()).Record(blobSize)
+		}
+
+		progress.historySize += blobSize
+		progress.BatchIdx = progress.BatchIdx + 1
+		saveHistoryIteratorState(ctx, featureCatalog, historyIterator, &progress)
+	}
+
+	handler.Histogram(metrics.HistoryArchiverTotalUploadSize.GetMetricName(), metrics.HistoryArchiverTotalUploadSize.GetMetricUnit()).Record(progress.uploadedSize)
+	handler.Histogram(metrics.HistoryArchiverHistorySize.GetMetricName(), metrics.HistoryArchiverHistorySize.GetMetricUnit()).Record(progress.historySize)
+	handler.Counter(metrics.HistoryArchiverArchiveSuccessCount.GetMetricName()).Record(1)
+	return nil
+}
+
+func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, executionManager persistence.ExecutionManager, featureCatalog *archiver.ArchiveFeatureCatalog, progress *uploadProgress) (historyIterator archiver.HistoryIterator) {
+	if featureCatalog.ProgressManager != nil {
+		if featureCatalog.ProgressManager.HasProgress(ctx) {
+			err := featureCatalog.ProgressManager.LoadProgress(ctx, progress)
+			if err == nil {
+				historyIterator, err := archiver.NewHistoryIteratorFromState(request, executionManager, targetHistoryBlobSize, progress.IteratorState)
+				if err == nil {
+					return historyIterator
+				}
+			}
+			progress.IteratorState = nil
+			progress.BatchIdx = 0
+			progress.historySize = 0
+			progress.uploadedSize = 0
+		}
+	}
+	return archiver.NewHistoryIterator(request, executionManager, targetHistoryBlobSize)
+}
+
+func saveHistoryIteratorState(ctx context.Context, featureCatalog *archiver.ArchiveFeatureCatalog, historyIterator archiver.HistoryIterator, progress *uploadProgress) {
+	// Saving history state is a best effort operation. Ignore errors and continue
+	if featureCatalog.ProgressManager != nil {
+		state, err := historyIterator.GetState()
+		if err != nil {
+			return
+		}
+		progress.IteratorState = state
+		_ = featureCatalog.ProgressManager.RecordProgress(ctx, progress)
+	}
+}
+
+func (h *historyArchiver) Get(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.GetHistoryRequest,
+) (*archiver.GetHistoryResponse, error) {
+	if err := validateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateGetRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidGetHistoryRequest.Error())
+	}
+
+	var err error
+	var token *getHistoryToken
+	if request.NextPageToken != nil {
+		token, err = deserializeGetHistoryToken(request.NextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+	} else if request.CloseFailoverVersion != nil {
+		token = &getHistoryToken{
+			CloseFailoverVersion: *request.CloseFailoverVersion,
+		}
+	} else {
+		highestVersion, err := h.getHighestVersion(ctx, URI, request)
+		if err != nil {
+			return nil, convertGetError(err)
+		}
+		if highestVersion == nil {
+			return nil, serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error())
+		}
+		token = &getHistoryToken{
+			CloseFailoverVersion: *highestVersion,
+		}
+	}
+
+	response := &archiver.GetHistoryResponse{}
+	numOfEvents := 0
+	isTruncated := false
+	for {
+		if numOfEvents >= request.PageSize {
+			isTruncated = true
+			break
+		}
+		key := constructHistoryKey(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID, token.CloseFailoverVersion, token.BatchIdx)
+
+		encodedHistoryBlob, err := h.client.Get(ctx, URI.Hostname(), key)
+		if err != nil {
+			return nil, convertGetError(err)
+		}
+
+		historyBlob, err := decodeHistoryBlob(encodedHistoryBlob)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+
+		for _, batch := range historyBlob.Body {
+			response.HistoryBatches = append(response.HistoryBatches, batch)
+			numOfEvents += len(batch.Events)
+		}
+
+		if historyBlob.Header.IsLast {
+			break
+		}
+		token.BatchIdx++
+	}
+
+	if isTruncated {
+		nextToken, err := serializeToken(token)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.NextPageToken = nextToken
+	}
+
+	return response, nil
+}
+
+func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
+	if err := validateURI(URI); err != nil {
+		return err
+	}
+	return h.client.BucketExists(context.TODO(), URI.Hostname())
+}
+
+func (h *historyArchiver) getHighestVersion(ctx context.Context, URI archiver.URI, request *archiver.GetHistoryRequest) (*int64, error) {
+	prefix := constructHistoryKeyPrefix(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID)
+	var highestVersion *int64
+	startAfter := ""
+	for {
+		keys, err := h.client.List(ctx, URI.Hostname(), prefix, startAfter, listPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			version, err := extractHistoryKeyVersion(prefix, key)
+			if err != nil {
+				continue
+			}
+			if highestVersion == nil || version > *highestVersion {
+				highestVersion = &version
+			}
+		}
+		if len(keys) < listPageSize {
+			return highestVersion, nil
+		}
+		startAfter = keys[len(keys)-1]
+	}
+}
+
+type nonRetryableError struct {
+	err error
+}
+
+func newNonRetryableError(err error) error {
+	return &nonRetryableError{err: err}
+}
+
+func (e *nonRetryableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *nonRetryableError) Unwrap() error {
+	return e.err
+}
+
+// isRetryableError returns false for errors that will fail again on retry, all other errors,
+// e.g. timeouts or object store errors, are assumed to be transient.
+func isRetryableError(err error) bool {
+	var nonRetryableErr *nonRetryableError
+	return err != nil && !errors.As(err, &nonRetryableErr) && !errors.Is(err, ErrBucketNotFound)
+}
+
+func logArchiveError(logger log.Logger, reason string, err error) {
+	if isRetryableError(err) {
+		logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(reason), tag.Error(err))
+	} else {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(reason), tag.Error(err))
+	}
+}
+
+func convertGetError(err error) error {
+	switch err {
+	case ErrBucketNotFound:
+		return serviceerror.NewInvalidArgument(err.Error())
+	case ErrObjectNotFound:
+		return serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error())
+	default:
+		return serviceerror.NewUnavailable(err.Error())
+	}
+}
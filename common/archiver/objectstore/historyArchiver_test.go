@@ -0,0 +1,312 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	testNamespaceID          = "test-namespace-id"
+	testNamespace            = "test-namespace"
+	testWorkflowID           = "test-workflow-id"
+	testRunID                = "test-run-id"
+	testNextEventID          = 1800
+	testCloseFailoverVersion = int64(100)
+	testPageSize             = 100
+	testBucket               = "test-bucket"
+	testBucketURI            = "objectstore://test-bucket/archival"
+	testRetention            = 72 * time.Hour
+)
+
+var testBranchToken = []byte{1, 2, 3}
+
+type historyArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	controller        *gomock.Controller
+	retentionProvider *archiver.MockNamespaceRetentionProvider
+	container         *archiver.HistoryBootstrapContainer
+	timeSource        *clock.EventTimeSource
+	store             *InMemoryClient
+	client            Client
+	testArchivalURI   archiver.URI
+}
+
+func TestHistoryArchiverSuite(t *testing.T) {
+	suite.Run(t, new(historyArchiverSuite))
+}
+
+func (s *historyArchiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.retentionProvider = archiver.NewMockNamespaceRetentionProvider(s.controller)
+	s.container = &archiver.HistoryBootstrapContainer{
+		Logger:                     log.NewNoopLogger(),
+		MetricsHandler:             metrics.NoopMetricsHandler,
+		NamespaceRetentionProvider: s.retentionProvider,
+	}
+	// expire times are sent to the object store in seconds
+	s.timeSource = clock.NewEventTimeSource().Update(time.Date(2020, 8, 22, 1, 2, 3, 0, time.UTC))
+	s.store = NewInMemoryClient(s.timeSource, testBucket)
+	s.client = newFakeServerClient(s.T(), s.store)
+
+	var err error
+	s.testArchivalURI, err = archiver.NewURI(testBucketURI)
+	s.NoError(err)
+}
+
+func (s *historyArchiverSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *historyArchiverSuite) TestValidateURI() {
+	historyArchiver := s.newTestHistoryArchiver(nil, &config.ObjectStoreArchiver{})
+
+	URI, err := archiver.NewURI("s3://test-bucket")
+	s.NoError(err)
+	s.Equal(archiver.ErrURISchemeMismatch, historyArchiver.ValidateURI(URI))
+
+	URI, err = archiver.NewURI("objectstore://other-bucket")
+	s.NoError(err)
+	s.Equal(ErrBucketNotFound, historyArchiver.ValidateURI(URI))
+
+	s.NoError(historyArchiver.ValidateURI(s.testArchivalURI))
+}
+
+func (s *historyArchiverSuite) TestNewHistoryArchiver_InvalidEncryption() {
+	_, err := newHistoryArchiver(s.container, &config.ObjectStoreArchiver{
+		NamespaceEncryption: map[string]*config.S3Encryption{
+			testNamespace: {Algorithm: "rot13"},
+		},
+	}, s.client, s.timeSource, nil)
+	s.Equal(errInvalidEncryption, err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_RetentionUnavailable() {
+	historyArchiver := s.newTestHistoryArchiver(nil, &config.ObjectStoreArchiver{})
+	s.retentionProvider.EXPECT().GetNamespaceRetention(testNamespaceID).Return(time.Duration(0), serviceerror.NewUnavailable("namespace registry unavailable"))
+
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, s.newArchiveRequest())
+	s.Error(err)
+	s.True(isRetryableError(err))
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_NonRetryableErrorOption() {
+	historyIterator := archiver.NewMockHistoryIterator(s.controller)
+	historyIterator.EXPECT().HasNext().Return(true)
+	historyIterator.EXPECT().Next().Return(nil, errors.New("some random error"))
+	historyArchiver := s.newTestHistoryArchiver(historyIterator, &config.ObjectStoreArchiver{})
+	s.retentionProvider.EXPECT().GetNamespaceRetention(testNamespaceID).Return(testRetention, nil)
+
+	nonRetryableErr := errors.New("some non-retryable error")
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, s.newArchiveRequest(), archiver.GetNonRetryableErrorOption(nonRetryableErr))
+	s.Equal(nonRetryableErr, err)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGet() {
+	historyBatches := s.newHistoryBatches()
+	historyIterator := archiver.NewMockHistoryIterator(s.controller)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(&archiverspb.HistoryBlob{
+			Header: &archiverspb.HistoryBlobHeader{IsLast: false},
+			Body:   historyBatches[:1],
+		}, nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(&archiverspb.HistoryBlob{
+			Header: &archiverspb.HistoryBlobHeader{IsLast: true},
+			Body:   historyBatches[1:],
+		}, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+	encryption := &config.S3Encryption{Algorithm: encryptionAlgorithmKMS, KMSKeyID: "test-key"}
+	historyArchiver := s.newTestHistoryArchiver(historyIterator, &config.ObjectStoreArchiver{
+		Encryption: &config.S3Encryption{Algorithm: encryptionAlgorithmAES256},
+		NamespaceEncryption: map[string]*config.S3Encryption{
+			testNamespace: encryption,
+		},
+	})
+	s.retentionProvider.EXPECT().GetNamespaceRetention(testNamespaceID).Return(testRetention, nil)
+
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, s.newArchiveRequest())
+	s.NoError(err)
+
+	for batchIdx := 0; batchIdx < 2; batchIdx++ {
+		key := constructHistoryKey(s.testArchivalURI.Path(), testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion, batchIdx)
+		putOptions, err := s.store.GetPutOptions(testBucket, key)
+		s.NoError(err)
+		s.Equal(s.timeSource.Now().Add(testRetention), *putOptions.ExpireTime)
+		s.Equal(encryption, putOptions.Encryption)
+	}
+
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	})
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(historyBatches, response.HistoryBatches)
+
+	// the archived history is gone after the retention
+	s.timeSource.Update(s.timeSource.Now().Add(testRetention))
+	_, err = historyArchiver.Get(context.Background(), s.testArchivalURI, &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	})
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_SmallPageSize() {
+	historyBatches := s.newHistoryBatches()
+	s.putHistoryBlob(testCloseFailoverVersion, 0, historyBatches[:1], false)
+	s.putHistoryBlob(testCloseFailoverVersion, 1, historyBatches[1:], true)
+	historyArchiver := s.newTestHistoryArchiver(nil, &config.ObjectStoreArchiver{})
+
+	request := &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    1,
+	}
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.NotNil(response.NextPageToken)
+	s.Equal(historyBatches[:1], response.HistoryBatches)
+
+	request.NextPageToken = response.NextPageToken
+	response, err = historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(historyBatches[1:], response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestGet_PickHighestVersion() {
+	historyBatches := s.newHistoryBatches()
+	s.putHistoryBlob(1, 0, historyBatches[:1], true)
+	s.putHistoryBlob(testCloseFailoverVersion, 0, historyBatches[1:], true)
+	historyArchiver := s.newTestHistoryArchiver(nil, &config.ObjectStoreArchiver{})
+
+	request := &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	}
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.Equal(historyBatches[1:], response.HistoryBatches)
+
+	request.CloseFailoverVersion = convert.Int64Ptr(1)
+	response, err = historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.Equal(historyBatches[:1], response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_HistoryNotExist() {
+	historyArchiver := s.newTestHistoryArchiver(nil, &config.ObjectStoreArchiver{})
+	_, err := historyArchiver.Get(context.Background(), s.testArchivalURI, &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	})
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
+func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator, config *config.ObjectStoreArchiver) *historyArchiver {
+	historyArchiver, err := newHistoryArchiver(s.container, config, s.client, s.timeSource, historyIterator)
+	s.NoError(err)
+	return historyArchiver
+}
+
+func (s *historyArchiverSuite) newArchiveRequest() *archiver.ArchiveHistoryRequest {
+	return &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+}
+
+func (s *historyArchiverSuite) newHistoryBatches() []*historypb.History {
+	return []*historypb.History{
+		{
+			Events: []*historypb.HistoryEvent{
+				{
+					EventId:   common.FirstEventID,
+					EventTime: timestamp.TimePtr(s.timeSource.Now()),
+					Version:   testCloseFailoverVersion,
+				},
+			},
+		},
+		{
+			Events: []*historypb.HistoryEvent{
+				{
+					EventId:   testNextEventID - 1,
+					EventTime: timestamp.TimePtr(s.timeSource.Now()),
+					Version:   testCloseFailoverVersion,
+				},
+			},
+		},
+	}
+}
+
+func (s *historyArchiverSuite) putHistoryBlob(version int64, batchIdx int, historyBatches []*historypb.History, isLast bool) {
+	data, err := encode(&archiverspb.HistoryBlob{
+		Header: &archiverspb.HistoryBlobHeader{IsLast: isLast},
+		Body:   historyBatches,
+	})
+	s.NoError(err)
+	key := constructHistoryKey(s.testArchivalURI.Path(), testNamespaceID, testWorkflowID, testRunID, version, batchIdx)
+	s.NoError(s.client.Put(context.Background(), testBucket, key, data, nil))
+}
@@ -0,0 +1,152 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package objectstore
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.temporal.io/server/common/clock"
+)
+
+type (
+	// InMemoryClient is an in-process Client for tests. Objects are treated as deleted once their
+	// expire time has passed, like in an object store with a lifecycle rule for expired objects.
+	InMemoryClient struct {
+		timeSource clock.TimeSource
+
+		sync.RWMutex
+		buckets map[string]map[string]*inMemoryObject
+	}
+
+	inMemoryObject struct {
+		data []byte
+		opts PutOptions
+	}
+)
+
+var _ Client = (*InMemoryClient)(nil)
+
+// NewInMemoryClient creates an InMemoryClient with the given buckets
+func NewInMemoryClient(timeSource clock.TimeSource, buckets ...string) *InMemoryClient {
+	c := &InMemoryClient{
+		timeSource: timeSource,
+		buckets:    make(map[string]map[string]*inMemoryObject),
+	}
+	for _, bucket := range buckets {
+		c.buckets[bucket] = make(map[string]*inMemoryObject)
+	}
+	return c
+}
+
+func (c *InMemoryClient) BucketExists(_ context.Context, bucket string) error {
+	c.RLock()
+	defer c.RUnlock()
+	if _, ok := c.buckets[bucket]; !ok {
+		return ErrBucketNotFound
+	}
+	return nil
+}
+
+func (c *InMemoryClient) Put(_ context.Context, bucket string, key string, data []byte, opts *PutOptions) error {
+	c.Lock()
+	defer c.Unlock()
+	objects, ok := c.buckets[bucket]
+	if !ok {
+		return ErrBucketNotFound
+	}
+	object := &inMemoryObject{data: append([]byte(nil), data...)}
+	if opts != nil {
+		object.opts = *opts
+	}
+	objects[key] = object
+	return nil
+}
+
+func (c *InMemoryClient) Get(_ context.Context, bucket string, key string) ([]byte, error) {
+	object, err := c.get(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(nil), object.data...), nil
+}
+
+func (c *InMemoryClient) Exists(_ context.Context, bucket string, key string) (bool, error) {
+	_, err := c.get(bucket, key)
+	if err == ErrObjectNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (c *InMemoryClient) List(_ context.Context, bucket string, prefix string, startAfter string, maxKeys int) ([]string, error) {
+	c.RLock()
+	defer c.RUnlock()
+	objects, ok := c.buckets[bucket]
+	if !ok {
+		return nil, ErrBucketNotFound
+	}
+	var keys []string
+	for key, object := range objects {
+		if strings.HasPrefix(key, prefix) && key > startAfter && !c.expired(object) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	if len(keys) > maxKeys {
+		keys = keys[:maxKeys]
+	}
+	return keys, nil
+}
+
+// GetPutOptions returns the options an object was written with
+func (c *InMemoryClient) GetPutOptions(bucket string, key string) (*PutOptions, error) {
+	object, err := c.get(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	opts := object.opts
+	return &opts, nil
+}
+
+func (c *InMemoryClient) get(bucket string, key string) (*inMemoryObject, error) {
+	c.RLock()
+	defer c.RUnlock()
+	objects, ok := c.buckets[bucket]
+	if !ok {
+		return nil, ErrBucketNotFound
+	}
+	object, ok := objects[key]
+	if !ok || c.expired(object) {
+		return nil, ErrObjectNotFound
+	}
+	return object, nil
+}
+
+func (c *InMemoryClient) expired(object *inMemoryObject) bool {
+	return object.opts.ExpireTime != nil && !c.timeSource.Now().Before(*object.opts.ExpireTime)
+}
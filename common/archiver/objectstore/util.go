@@ -0,0 +1,237 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package objectstore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+var (
+	errNoBucketSpecified = errors.New("no bucket specified")
+	errInvalidEncryption = errors.New("invalid server side encryption algorithm")
+)
+
+// encoding & decoding util
+
+func encode(message proto.Message) ([]byte, error) {
+	encoder := codec.NewJSONPBEncoder()
+	return encoder.Encode(message)
+}
+
+func decodeHistoryBlob(data []byte) (*archiverspb.HistoryBlob, error) {
+	historyBlob := &archiverspb.HistoryBlob{}
+	encoder := codec.NewJSONPBEncoder()
+	if err := encoder.Decode(data, historyBlob); err != nil {
+		return nil, err
+	}
+	return historyBlob, nil
+}
+
+func decodeVisibilityRecord(data []byte) (*archiverspb.VisibilityRecord, error) {
+	record := &archiverspb.VisibilityRecord{}
+	encoder := codec.NewJSONPBEncoder()
+	if err := encoder.Decode(data, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func serializeToken(token interface{}) ([]byte, error) {
+	if token == nil {
+		return nil, nil
+	}
+	return json.Marshal(token)
+}
+
+func deserializeGetHistoryToken(bytes []byte) (*getHistoryToken, error) {
+	token := &getHistoryToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+func deserializeQueryVisibilityToken(bytes []byte) (*queryVisibilityToken, error) {
+	token := &queryVisibilityToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+// Key construction
+
+func constructHistoryKey(path, namespaceID, workflowID, runID string, version int64, batchIdx int) string {
+	return fmt.Sprintf("%s%d", constructHistoryKeyPrefixWithVersion(path, namespaceID, workflowID, runID, version), batchIdx)
+}
+
+func constructHistoryKeyPrefixWithVersion(path, namespaceID, workflowID, runID string, version int64) string {
+	return fmt.Sprintf("%s%d/", constructHistoryKeyPrefix(path, namespaceID, workflowID, runID), version)
+}
+
+func constructHistoryKeyPrefix(path, namespaceID, workflowID, runID string) string {
+	return strings.TrimLeft(strings.Join([]string{path, namespaceID, "history", workflowID, runID}, "/"), "/") + "/"
+}
+
+func extractHistoryKeyVersion(prefix, key string) (int64, error) {
+	version, _, _ := strings.Cut(strings.TrimPrefix(key, prefix), "/")
+	return strconv.ParseInt(version, 10, 64)
+}
+
+// constructVisibilityKey returns a key that sorts records by close time in descending order,
+// so listing the keys returns the most recently closed records first.
+func constructVisibilityKey(path, namespaceID string, closeTime time.Time, runID string) string {
+	return fmt.Sprintf("%s%019d_%s", constructVisibilityKeyPrefix(path, namespaceID), math.MaxInt64-closeTime.UnixNano(), runID)
+}
+
+func constructVisibilityKeyPrefix(path, namespaceID string) string {
+	return strings.TrimLeft(strings.Join([]string{path, namespaceID, "visibility"}, "/"), "/") + "/"
+}
+
+func extractVisibilityKeyCloseTime(prefix, key string) (time.Time, error) {
+	invertedCloseTime, _, _ := strings.Cut(strings.TrimPrefix(key, prefix), "_")
+	nanos, err := strconv.ParseInt(invertedCloseTime, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, math.MaxInt64-nanos).UTC(), nil
+}
+
+// Validation
+
+func validateURI(URI archiver.URI) error {
+	if URI.Scheme() != URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+	if len(URI.Hostname()) == 0 {
+		return errNoBucketSpecified
+	}
+	return nil
+}
+
+func validateConfig(config *config.ObjectStoreArchiver) error {
+	if err := validateEncryption(config.Encryption); err != nil {
+		return err
+	}
+	for _, encryption := range config.NamespaceEncryption {
+		if err := validateEncryption(encryption); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateEncryption(encryption *config.S3Encryption) error {
+	if encryption == nil {
+		return nil
+	}
+	switch encryption.Algorithm {
+	case encryptionAlgorithmAES256, encryptionAlgorithmKMS:
+		return nil
+	default:
+		return errInvalidEncryption
+	}
+}
+
+// Put options
+
+// getEncryption returns the server side encryption for objects of the namespace
+func getEncryption(config *config.ObjectStoreArchiver, namespace string) *config.S3Encryption {
+	if encryption, ok := config.NamespaceEncryption[namespace]; ok {
+		return encryption
+	}
+	return config.Encryption
+}
+
+// getExpireTime returns the time archived objects of a namespace expire at, which is the
+// namespace retention after they are archived. Objects don't expire if the retention is unknown.
+func getExpireTime(retentionProvider archiver.NamespaceRetentionProvider, namespaceID string, now time.Time) (*time.Time, error) {
+	if retentionProvider == nil {
+		return nil, nil
+	}
+	retention, err := retentionProvider.GetNamespaceRetention(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if retention <= 0 {
+		return nil, nil
+	}
+	expireTime := now.Add(retention)
+	return &expireTime, nil
+}
+
+// Misc.
+
+func historyMutated(request *archiver.ArchiveHistoryRequest, historyBatches []*historypb.History, isLast bool) bool {
+	lastBatch := historyBatches[len(historyBatches)-1].Events
+	lastEvent := lastBatch[len(lastBatch)-1]
+	lastFailoverVersion := lastEvent.GetVersion()
+	if lastFailoverVersion > request.CloseFailoverVersion {
+		return true
+	}
+
+	if !isLast {
+		return false
+	}
+	lastEventID := lastEvent.GetEventId()
+	return lastFailoverVersion != request.CloseFailoverVersion || lastEventID+1 != request.NextEventID
+}
+
+func convertToExecutionInfo(record *archiverspb.VisibilityRecord, saTypeMap searchattribute.NameTypeMap) (*workflowpb.WorkflowExecutionInfo, error) {
+	searchAttributes, err := searchattribute.Parse(record.SearchAttributes, &saTypeMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: record.GetWorkflowId(),
+			RunId:      record.GetRunId(),
+		},
+		Type: &commonpb.WorkflowType{
+			Name: record.WorkflowTypeName,
+		},
+		StartTime:        record.StartTime,
+		ExecutionTime:    record.ExecutionTime,
+		CloseTime:        record.CloseTime,
+		Status:           record.Status,
+		HistoryLength:    record.HistoryLength,
+		Memo:             record.Memo,
+		SearchAttributes: searchAttributes,
+	}, nil
+}
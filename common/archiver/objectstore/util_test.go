@@ -0,0 +1,66 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package objectstore
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVisibilityKey_SortedByCloseTimeDesc(t *testing.T) {
+	prefix := constructVisibilityKeyPrefix("/archival", "test-namespace-id")
+	closeTimes := []time.Time{
+		time.Unix(0, 5).UTC(),
+		time.Date(2020, 8, 22, 1, 2, 3, 4, time.UTC),
+		time.Unix(1000, 0).UTC(),
+	}
+	var keys []string
+	for _, closeTime := range closeTimes {
+		keys = append(keys, constructVisibilityKey("/archival", "test-namespace-id", closeTime, "test-run-id"))
+	}
+	sort.Strings(keys)
+
+	var sortedCloseTimes []time.Time
+	for _, key := range keys {
+		require.Regexp(t, "^archival/test-namespace-id/visibility/", key)
+		closeTime, err := extractVisibilityKeyCloseTime(prefix, key)
+		require.NoError(t, err)
+		sortedCloseTimes = append(sortedCloseTimes, closeTime)
+	}
+	require.Equal(t, []time.Time{closeTimes[1], closeTimes[2], closeTimes[0]}, sortedCloseTimes)
+}
+
+func TestHistoryKeyVersion(t *testing.T) {
+	prefix := constructHistoryKeyPrefix("", "test-namespace-id", "test-workflow-id", "test-run-id")
+	key := constructHistoryKey("", "test-namespace-id", "test-workflow-id", "test-run-id", 12, 3)
+	require.Equal(t, "test-namespace-id/history/test-workflow-id/test-run-id/12/3", key)
+
+	version, err := extractHistoryKeyVersion(prefix, key)
+	require.NoError(t, err)
+	require.Equal(t, int64(12), version)
+}
@@ -0,0 +1,311 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package objectstore
+
+import (
+	"context"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	errEncodeVisibilityRecord = "failed to encode visibility record"
+)
+
+type (
+	visibilityArchiver struct {
+		container   *archiver.VisibilityBootstrapContainer
+		config      *config.ObjectStoreArchiver
+		client      Client
+		timeSource  clock.TimeSource
+		queryParser QueryParser
+	}
+
+	queryVisibilityToken struct {
+		LastKey string
+	}
+
+	queryVisibilityRequest struct {
+		namespaceID   string
+		pageSize      int
+		nextPageToken []byte
+		parsedQuery   *parsedQuery
+	}
+)
+
+// NewVisibilityArchiver creates a new archiver.VisibilityArchiver based on an S3 compatible object store
+func NewVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	config *config.ObjectStoreArchiver,
+) (archiver.VisibilityArchiver, error) {
+	client, err := NewS3Client(config)
+	if err != nil {
+		return nil, err
+	}
+	return NewVisibilityArchiverWithClient(container, config, client)
+}
+
+// NewVisibilityArchiverWithClient creates a new archiver.VisibilityArchiver using the given object store client
+func NewVisibilityArchiverWithClient(
+	container *archiver.VisibilityBootstrapContainer,
+	config *config.ObjectStoreArchiver,
+	client Client,
+) (archiver.VisibilityArchiver, error) {
+	return newVisibilityArchiver(container, config, client, clock.NewRealTimeSource())
+}
+
+func newVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	config *config.ObjectStoreArchiver,
+	client Client,
+	timeSource clock.TimeSource,
+) (*visibilityArchiver, error) {
+	if err := validateConfig(config); err != nil {
+		return nil, err
+	}
+	return &visibilityArchiver{
+		container:   container,
+		config:      config,
+		client:      client,
+		timeSource:  timeSource,
+		queryParser: NewQueryParser(),
+	}, nil
+}
+
+func (v *visibilityArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiverspb.VisibilityRecord,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	handler := v.container.MetricsHandler.WithTags(metrics.OperationTag(metrics.VisibilityArchiverScope), metrics.NamespaceTag(request.Namespace))
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	startTime := time.Now().UTC()
+	logger := archiver.TagLoggerWithArchiveVisibilityRequestAndURI(v.container.Logger, request, URI.String())
+	archiveFailReason := ""
+	defer func() {
+		handler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+		if err != nil {
+			logArchiveError(logger, archiveFailReason, err)
+			if isRetryableError(err) {
+				handler.Counter(metrics.VisibilityArchiverArchiveTransientErrorCount.GetMetricName()).Record(1)
+			} else {
+				handler.Counter(metrics.VisibilityArchiverArchiveNonRetryableErrorCount.GetMetricName()).Record(1)
+				if featureCatalog.NonRetryableError != nil {
+					err = featureCatalog.NonRetryableError()
+				}
+			}
+		}
+	}()
+
+	if err := validateURI(URI); err != nil {
+		archiveFailReason = archiver.ErrReasonInvalidURI
+		return newNonRetryableError(err)
+	}
+
+	if err := archiver.ValidateVisibilityArchivalRequest(request); err != nil {
+		archiveFailReason = archiver.ErrReasonInvalidArchiveRequest
+		return newNonRetryableError(err)
+	}
+
+	encodedVisibilityRecord, err := encode(request)
+	if err != nil {
+		archiveFailReason = errEncodeVisibilityRecord
+		return newNonRetryableError(err)
+	}
+
+	expireTime, err := getExpireTime(v.container.NamespaceRetentionProvider, request.GetNamespaceId(), v.timeSource.Now())
+	if err != nil {
+		archiveFailReason = errGetRetention
+		return err
+	}
+	putOptions := &PutOptions{
+		ExpireTime: expireTime,
+		Encryption: getEncryption(v.config, request.Namespace),
+	}
+
+	key := constructVisibilityKey(URI.Path(), request.GetNamespaceId(), timestamp.TimeValue(request.CloseTime), request.GetRunId())
+	if err := v.client.Put(ctx, URI.Hostname(), key, encodedVisibilityRecord, putOptions); err != nil {
+		archiveFailReason = errWriteKey
+		return err
+	}
+	handler.Counter(metrics.VisibilityArchiveSuccessCount.GetMetricName()).Record(1)
+	return nil
+}
+
+func (v *visibilityArchiver) Query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := validateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateQueryRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
+	}
+
+	parsedQuery, err := v.queryParser.Parse(request.Query)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+
+	if parsedQuery.emptyResult {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
+	return v.query(
+		ctx,
+		URI,
+		&queryVisibilityRequest{
+			namespaceID:   request.NamespaceID,
+			pageSize:      request.PageSize,
+			nextPageToken: request.NextPageToken,
+			parsedQuery:   parsedQuery,
+		},
+		saTypeMap,
+	)
+}
+
+func (v *visibilityArchiver) query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *queryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	startAfter := ""
+	if request.nextPageToken != nil {
+		token, err := deserializeQueryVisibilityToken(request.nextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+		startAfter = token.LastKey
+	}
+
+	// Keys are sorted by close time in descending order, so records are read in the order
+	// they are returned and the listing stops at the first record closed before the query range.
+	prefix := constructVisibilityKeyPrefix(URI.Path(), request.namespaceID)
+	response := &archiver.QueryVisibilityResponse{}
+	for {
+		keys, err := v.client.List(ctx, URI.Hostname(), prefix, startAfter, listPageSize)
+		if err != nil {
+			return nil, convertQueryError(err)
+		}
+
+		for idx, key := range keys {
+			closeTime, err := extractVisibilityKeyCloseTime(prefix, key)
+			if err != nil {
+				continue
+			}
+			if closeTime.Before(request.parsedQuery.earliestCloseTime) {
+				return response, nil
+			}
+			if closeTime.After(request.parsedQuery.latestCloseTime) {
+				continue
+			}
+
+			encodedRecord, err := v.client.Get(ctx, URI.Hostname(), key)
+			if err != nil {
+				if err == ErrObjectNotFound {
+					// expired since it was listed
+					continue
+				}
+				return nil, convertQueryError(err)
+			}
+			record, err := decodeVisibilityRecord(encodedRecord)
+			if err != nil {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+			if !matchQuery(record, request.parsedQuery) {
+				continue
+			}
+
+			executionInfo, err := convertToExecutionInfo(record, saTypeMap)
+			if err != nil {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+			response.Executions = append(response.Executions, executionInfo)
+			if len(response.Executions) == request.pageSize {
+				if idx != len(keys)-1 || len(keys) == listPageSize {
+					encodedToken, err := serializeToken(&queryVisibilityToken{LastKey: key})
+					if err != nil {
+						return nil, serviceerror.NewInternal(err.Error())
+					}
+					response.NextPageToken = encodedToken
+				}
+				return response, nil
+			}
+		}
+
+		if len(keys) < listPageSize {
+			return response, nil
+		}
+		startAfter = keys[len(keys)-1]
+	}
+}
+
+func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
+	if err := validateURI(URI); err != nil {
+		return err
+	}
+	return v.client.BucketExists(context.TODO(), URI.Hostname())
+}
+
+func matchQuery(record *archiverspb.VisibilityRecord, query *parsedQuery) bool {
+	if record.CloseTime.Before(query.earliestCloseTime) || record.CloseTime.After(query.latestCloseTime) {
+		return false
+	}
+	if query.workflowID != nil && record.GetWorkflowId() != *query.workflowID {
+		return false
+	}
+	if query.runID != nil && record.GetRunId() != *query.runID {
+		return false
+	}
+	if query.workflowTypeName != nil && record.WorkflowTypeName != *query.workflowTypeName {
+		return false
+	}
+	if query.status != nil && record.Status != *query.status {
+		return false
+	}
+	return true
+}
+
+func convertQueryError(err error) error {
+	if err == ErrBucketNotFound {
+		return serviceerror.NewInvalidArgument(err.Error())
+	}
+	return serviceerror.NewUnavailable(err.Error())
+}
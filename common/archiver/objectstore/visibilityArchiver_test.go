@@ -0,0 +1,216 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package objectstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	testWorkflowTypeName = "test-workflow-type"
+)
+
+type visibilityArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	controller        *gomock.Controller
+	retentionProvider *archiver.MockNamespaceRetentionProvider
+	container         *archiver.VisibilityBootstrapContainer
+	timeSource        *clock.EventTimeSource
+	store             *InMemoryClient
+	client            Client
+	testArchivalURI   archiver.URI
+	visibilityRecords []*archiverspb.VisibilityRecord
+}
+
+func TestVisibilityArchiverSuite(t *testing.T) {
+	suite.Run(t, new(visibilityArchiverSuite))
+}
+
+func (s *visibilityArchiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.retentionProvider = archiver.NewMockNamespaceRetentionProvider(s.controller)
+	s.container = &archiver.VisibilityBootstrapContainer{
+		Logger:                     log.NewNoopLogger(),
+		MetricsHandler:             metrics.NoopMetricsHandler,
+		NamespaceRetentionProvider: s.retentionProvider,
+	}
+	// expire times are sent to the object store in seconds
+	s.timeSource = clock.NewEventTimeSource().Update(time.Date(2020, 8, 22, 1, 2, 3, 0, time.UTC))
+	s.store = NewInMemoryClient(s.timeSource, testBucket)
+	s.client = newFakeServerClient(s.T(), s.store)
+
+	var err error
+	s.testArchivalURI, err = archiver.NewURI(testBucketURI)
+	s.NoError(err)
+	s.setupVisibilityRecords()
+}
+
+func (s *visibilityArchiverSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidURI() {
+	visibilityArchiver := s.newTestVisibilityArchiver(&config.ObjectStoreArchiver{})
+	URI, err := archiver.NewURI("objectstore://")
+	s.NoError(err)
+
+	err = visibilityArchiver.Archive(context.Background(), URI, s.visibilityRecords[0])
+	s.Error(err)
+	s.False(isRetryableError(err))
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_BucketNotExist() {
+	visibilityArchiver := s.newTestVisibilityArchiver(&config.ObjectStoreArchiver{})
+	s.retentionProvider.EXPECT().GetNamespaceRetention(testNamespaceID).Return(testRetention, nil)
+	URI, err := archiver.NewURI("objectstore://other-bucket")
+	s.NoError(err)
+
+	err = visibilityArchiver.Archive(context.Background(), URI, s.visibilityRecords[0])
+	s.Equal(ErrBucketNotFound, err)
+	s.False(isRetryableError(err))
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Success() {
+	encryption := &config.S3Encryption{Algorithm: encryptionAlgorithmAES256}
+	visibilityArchiver := s.newTestVisibilityArchiver(&config.ObjectStoreArchiver{
+		Encryption: encryption,
+	})
+	s.retentionProvider.EXPECT().GetNamespaceRetention(testNamespaceID).Return(testRetention, nil)
+
+	record := s.visibilityRecords[0]
+	err := visibilityArchiver.Archive(context.Background(), s.testArchivalURI, record)
+	s.NoError(err)
+
+	key := constructVisibilityKey(s.testArchivalURI.Path(), testNamespaceID, *record.CloseTime, record.RunId)
+	data, err := s.client.Get(context.Background(), testBucket, key)
+	s.NoError(err)
+	archivedRecord, err := decodeVisibilityRecord(data)
+	s.NoError(err)
+	s.Equal(record, archivedRecord)
+
+	putOptions, err := s.store.GetPutOptions(testBucket, key)
+	s.NoError(err)
+	s.Equal(s.timeSource.Now().Add(testRetention), *putOptions.ExpireTime)
+	s.Equal(encryption, putOptions.Encryption)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_NoRetentionProvider() {
+	s.container.NamespaceRetentionProvider = nil
+	visibilityArchiver := s.newTestVisibilityArchiver(&config.ObjectStoreArchiver{})
+
+	record := s.visibilityRecords[0]
+	err := visibilityArchiver.Archive(context.Background(), s.testArchivalURI, record)
+	s.NoError(err)
+
+	key := constructVisibilityKey(s.testArchivalURI.Path(), testNamespaceID, *record.CloseTime, record.RunId)
+	putOptions, err := s.store.GetPutOptions(testBucket, key)
+	s.NoError(err)
+	s.Nil(putOptions.ExpireTime)
+	s.Nil(putOptions.Encryption)
+}
+
+func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
+	visibilityArchiver := s.newTestVisibilityArchiver(&config.ObjectStoreArchiver{})
+	s.retentionProvider.EXPECT().GetNamespaceRetention(testNamespaceID).Return(testRetention, nil).Times(len(s.visibilityRecords))
+	for _, record := range s.visibilityRecords {
+		err := visibilityArchiver.Archive(context.Background(), s.testArchivalURI, record)
+		s.NoError(err)
+	}
+
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+		Query:       "ExecutionStatus = 'Failed' and CloseTime >= 1000",
+	}
+	var executions []*workflowpb.WorkflowExecutionInfo
+	for len(executions) == 0 || request.NextPageToken != nil {
+		response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request, searchattribute.TestNameTypeMap)
+		s.NoError(err)
+		executions = append(executions, response.Executions...)
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Len(executions, 2)
+	s.Equal(s.visibilityRecords[2].RunId, executions[0].Execution.RunId)
+	s.Equal(s.visibilityRecords[0].RunId, executions[1].Execution.RunId)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
+	visibilityArchiver := s.newTestVisibilityArchiver(&config.ObjectStoreArchiver{})
+	_, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+		Query:       "WorkflowId > 'some-workflow-id'",
+	}, searchattribute.TestNameTypeMap)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *visibilityArchiverSuite) newTestVisibilityArchiver(config *config.ObjectStoreArchiver) *visibilityArchiver {
+	visibilityArchiver, err := newVisibilityArchiver(s.container, config, s.client, s.timeSource)
+	s.NoError(err)
+	return visibilityArchiver
+}
+
+func (s *visibilityArchiverSuite) setupVisibilityRecords() {
+	newRecord := func(runID string, closeTime int64, status enumspb.WorkflowExecutionStatus) *archiverspb.VisibilityRecord {
+		return &archiverspb.VisibilityRecord{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       testWorkflowID,
+			RunId:            runID,
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamp.UnixOrZeroTimePtr(1),
+			CloseTime:        timestamp.UnixOrZeroTimePtr(closeTime),
+			Status:           status,
+			HistoryLength:    4,
+		}
+	}
+	s.visibilityRecords = []*archiverspb.VisibilityRecord{
+		newRecord("run-1", 1000, enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
+		newRecord("run-2", 2000, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED),
+		newRecord("run-3", 3000, enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
+		newRecord("run-4", 999, enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
+	}
+}
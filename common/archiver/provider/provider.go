@@ -34,6 +34,7 @@ import (
 
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/filestore"
+	"go.temporal.io/server/common/archiver/objectstore"
 	"go.temporal.io/server/common/archiver/s3store"
 	"go.temporal.io/server/common/config"
 )
@@ -157,6 +158,12 @@ func (p *archiverProvider) GetHistoryArchiver(scheme, serviceName string) (histo
 			return nil, ErrArchiverConfigNotFound
 		}
 		historyArchiver, err = s3store.NewHistoryArchiver(container, p.historyArchiverConfigs.S3store)
+
+	case objectstore.URIScheme:
+		if p.historyArchiverConfigs.ObjectStore == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		historyArchiver, err = objectstore.NewHistoryArchiver(container, p.historyArchiverConfigs.ObjectStore)
 	default:
 		return nil, ErrUnknownScheme
 	}
@@ -207,6 +214,11 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme, serviceName string) (ar
 			return nil, ErrArchiverConfigNotFound
 		}
 		visibilityArchiver, err = gcloud.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.Gstorage)
+	case objectstore.URIScheme:
+		if p.visibilityArchiverConfigs.ObjectStore == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		visibilityArchiver, err = objectstore.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.ObjectStore)
 
 	default:
 		return nil, ErrUnknownScheme
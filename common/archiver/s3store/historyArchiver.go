@@ -58,6 +58,7 @@ const (
 	URIScheme               = "s3"
 	errEncodeHistory        = "failed to encode history batches"
 	errWriteKey             = "failed to write history to s3"
+	errGetUploadOptions     = "failed to get upload options"
 	defaultBlobstoreTimeout = time.Minute
 	targetHistoryBlobSize   = 2 * 1024 * 1024 // 2MB
 )
@@ -70,8 +71,9 @@ var (
 
 type (
 	historyArchiver struct {
-		container *archiver.HistoryBootstrapContainer
-		s3cli     s3iface.S3API
+		container     *archiver.HistoryBootstrapContainer
+		s3cli         s3iface.S3API
+		uploadOptions *uploadOptionsProvider
 		// only set in test code
 		historyIterator archiver.HistoryIterator
 	}
@@ -114,10 +116,16 @@ func newHistoryArchiver(
 	if err != nil {
 		return nil, err
 	}
+	s3cli := s3.New(sess)
+	uploadOptions, err := newUploadOptionsProvider(s3cli, config, container.NamespaceRetentionProvider)
+	if err != nil {
+		return nil, err
+	}
 
 	return &historyArchiver{
 		container:       container,
-		s3cli:           s3.New(sess),
+		s3cli:           s3cli,
+		uploadOptions:   uploadOptions,
 		historyIterator: historyIterator,
 	}, nil
 }
@@ -156,6 +164,12 @@ func (h *historyArchiver) Archive(
 		return err
 	}
 
+	uploadOptions, err := h.uploadOptions.get(ctx, URI, request.NamespaceID, request.Namespace)
+	if err != nil {
+		logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errGetUploadOptions), tag.Error(err))
+		return err
+	}
+
 	var progress uploadProgress
 	historyIterator := h.historyIterator
 	if historyIterator == nil { // will only be set by testing code
@@ -208,7 +222,7 @@ func (h *historyArchiver) Archive(
 		if exists {
 			handler.Counter(metrics.HistoryArchiverBlobExistsCount.GetMetricName()).Record(1)
 		} else {
-			if err := Upload(ctx, h.s3cli, URI, key, encodedHistoryBlob); err != nil {
+			if err := Upload(ctx, h.s3cli, URI, key, encodedHistoryBlob, uploadOptions); err != nil {
 				if isRetryableError(err) {
 					logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
 				} else {
@@ -0,0 +1,237 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	// retentionTagKey is the object tag the bucket lifecycle rules of retentionLifecycle filter on
+	retentionTagKey = "temporal-retention-days"
+	// retentionRuleIDPrefix is the prefix of the ids of the bucket lifecycle rules of retentionLifecycle
+	retentionRuleIDPrefix = "temporal-retention-"
+
+	errCodeNoSuchLifecycleConfiguration = "NoSuchLifecycleConfiguration"
+)
+
+var (
+	errInvalidEncryption = errors.New("invalid server side encryption algorithm")
+)
+
+type (
+	// UploadOptions are the options of an uploaded object
+	UploadOptions struct {
+		// Encryption is the server side encryption of the object, nil if not encrypted
+		Encryption *config.S3Encryption
+		// RetentionDays tags the object to be expired by the bucket lifecycle rule of that many days, if positive
+		RetentionDays int64
+	}
+
+	// uploadOptionsProvider returns the options of objects archived for a namespace. A nil
+	// uploadOptionsProvider returns no options.
+	uploadOptionsProvider struct {
+		config            *config.S3Archiver
+		retentionProvider archiver.NamespaceRetentionProvider
+		lifecycle         *retentionLifecycle
+	}
+
+	// retentionLifecycle expires archived objects through bucket lifecycle rules. There is one
+	// rule per retention in days, which expires the objects tagged with that retention.
+	retentionLifecycle struct {
+		s3cli s3iface.S3API
+
+		sync.Mutex
+		// ensured contains the rules known to exist, keyed by bucket and retention days
+		ensured map[string]struct{}
+	}
+)
+
+func newUploadOptionsProvider(
+	s3cli s3iface.S3API,
+	config *config.S3Archiver,
+	retentionProvider archiver.NamespaceRetentionProvider,
+) (*uploadOptionsProvider, error) {
+	if err := validateEncryption(config.Encryption); err != nil {
+		return nil, err
+	}
+	for _, encryption := range config.NamespaceEncryption {
+		if err := validateEncryption(encryption); err != nil {
+			return nil, err
+		}
+	}
+	provider := &uploadOptionsProvider{
+		config:            config,
+		retentionProvider: retentionProvider,
+	}
+	if config.RetentionLifecycle && retentionProvider != nil {
+		provider.lifecycle = newRetentionLifecycle(s3cli)
+	}
+	return provider, nil
+}
+
+func validateEncryption(encryption *config.S3Encryption) error {
+	if encryption == nil {
+		return nil
+	}
+	switch encryption.Algorithm {
+	case s3.ServerSideEncryptionAes256, s3.ServerSideEncryptionAwsKms:
+		return nil
+	default:
+		return errInvalidEncryption
+	}
+}
+
+// get returns the options of objects archived for the namespace to the bucket of URI. It makes
+// sure the bucket has the lifecycle rule of the namespace retention if retention lifecycle is enabled.
+func (p *uploadOptionsProvider) get(
+	ctx context.Context,
+	URI archiver.URI,
+	namespaceID string,
+	namespace string,
+) (*UploadOptions, error) {
+	if p == nil {
+		return nil, nil
+	}
+	options := &UploadOptions{
+		Encryption: p.config.Encryption,
+	}
+	if encryption, ok := p.config.NamespaceEncryption[namespace]; ok {
+		options.Encryption = encryption
+	}
+	if p.lifecycle == nil {
+		return options, nil
+	}
+
+	retention, err := p.retentionProvider.GetNamespaceRetention(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if retention <= 0 {
+		return options, nil
+	}
+	options.RetentionDays = retentionDays(retention)
+	if err := p.lifecycle.ensureRule(ctx, URI.Hostname(), options.RetentionDays); err != nil {
+		return nil, err
+	}
+	return options, nil
+}
+
+// retentionDays rounds the retention up to whole days, the granularity of lifecycle rules
+func retentionDays(retention time.Duration) int64 {
+	day := 24 * time.Hour
+	return int64((retention + day - 1) / day)
+}
+
+func newRetentionLifecycle(s3cli s3iface.S3API) *retentionLifecycle {
+	return &retentionLifecycle{
+		s3cli:   s3cli,
+		ensured: make(map[string]struct{}),
+	}
+}
+
+// ensureRule adds the rule expiring objects tagged with the retention days to the bucket if it
+// doesn't have it yet. A rule is only cached once it was read back from the bucket, so a rule lost
+// to a concurrent update by this or another host is added again by the next archival. The lock is
+// not held during the requests to the object store, so that a slow object store doesn't serialize
+// archivals.
+func (l *retentionLifecycle) ensureRule(ctx context.Context, bucket string, days int64) error {
+	ruleKey := fmt.Sprintf("%s/%d", bucket, days)
+	l.Lock()
+	_, ok := l.ensured[ruleKey]
+	l.Unlock()
+	if ok {
+		return nil
+	}
+
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	var rules []*s3.LifecycleRule
+	output, err := l.s3cli.GetBucketLifecycleConfigurationWithContext(ctx, &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != errCodeNoSuchLifecycleConfiguration {
+			return err
+		}
+	} else {
+		rules = output.Rules
+	}
+
+	ruleID := retentionRuleIDPrefix + strconv.FormatInt(days, 10)
+	for _, rule := range rules {
+		if aws.StringValue(rule.ID) == ruleID {
+			l.Lock()
+			l.ensured[ruleKey] = struct{}{}
+			l.Unlock()
+			return nil
+		}
+	}
+
+	rules = append(rules, &s3.LifecycleRule{
+		ID:     aws.String(ruleID),
+		Status: aws.String(s3.ExpirationStatusEnabled),
+		Filter: &s3.LifecycleRuleFilter{
+			Tag: &s3.Tag{
+				Key:   aws.String(retentionTagKey),
+				Value: aws.String(strconv.FormatInt(days, 10)),
+			},
+		},
+		Expiration: &s3.LifecycleExpiration{
+			Days: aws.Int64(days),
+		},
+	})
+	_, err = l.s3cli.PutBucketLifecycleConfigurationWithContext(ctx, &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	})
+	return err
+}
+
+// tagging returns the tag set of an object with the options
+func (o *UploadOptions) tagging() *string {
+	if o == nil || o.RetentionDays <= 0 {
+		return nil
+	}
+	tags := url.Values{}
+	tags.Set(retentionTagKey, strconv.FormatInt(o.RetentionDays, 10))
+	return aws.String(tags.Encode())
+}
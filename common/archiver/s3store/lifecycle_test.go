@@ -0,0 +1,198 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/s3store/mocks"
+	"go.temporal.io/server/common/config"
+)
+
+type lifecycleSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	controller        *gomock.Controller
+	s3cli             *mocks.MockS3API
+	retentionProvider *archiver.MockNamespaceRetentionProvider
+	testURI           archiver.URI
+}
+
+func TestLifecycleSuite(t *testing.T) {
+	suite.Run(t, new(lifecycleSuite))
+}
+
+func (s *lifecycleSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.s3cli = mocks.NewMockS3API(s.controller)
+	s.retentionProvider = archiver.NewMockNamespaceRetentionProvider(s.controller)
+	var err error
+	s.testURI, err = archiver.NewURI("s3://test-bucket/test-path")
+	s.NoError(err)
+}
+
+func (s *lifecycleSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *lifecycleSuite) TestNewUploadOptionsProvider_InvalidEncryption() {
+	_, err := newUploadOptionsProvider(s.s3cli, &config.S3Archiver{
+		NamespaceEncryption: map[string]*config.S3Encryption{
+			testNamespace: {Algorithm: "rot13"},
+		},
+	}, nil)
+	s.Equal(errInvalidEncryption, err)
+}
+
+func (s *lifecycleSuite) TestGetUploadOptions_Encryption() {
+	defaultEncryption := &config.S3Encryption{Algorithm: s3.ServerSideEncryptionAes256}
+	namespaceEncryption := &config.S3Encryption{Algorithm: s3.ServerSideEncryptionAwsKms, KMSKeyID: "test-key"}
+	provider, err := newUploadOptionsProvider(s.s3cli, &config.S3Archiver{
+		Encryption: defaultEncryption,
+		NamespaceEncryption: map[string]*config.S3Encryption{
+			testNamespace: namespaceEncryption,
+		},
+	}, s.retentionProvider)
+	s.NoError(err)
+
+	options, err := provider.get(context.Background(), s.testURI, testNamespaceID, testNamespace)
+	s.NoError(err)
+	s.Equal(&UploadOptions{Encryption: namespaceEncryption}, options)
+
+	options, err = provider.get(context.Background(), s.testURI, "other-namespace-id", "other-namespace")
+	s.NoError(err)
+	s.Equal(&UploadOptions{Encryption: defaultEncryption}, options)
+
+	var nilProvider *uploadOptionsProvider
+	options, err = nilProvider.get(context.Background(), s.testURI, testNamespaceID, testNamespace)
+	s.NoError(err)
+	s.Nil(options)
+}
+
+func (s *lifecycleSuite) TestGetUploadOptions_RetentionLifecycle() {
+	provider, err := newUploadOptionsProvider(s.s3cli, &config.S3Archiver{
+		RetentionLifecycle: true,
+	}, s.retentionProvider)
+	s.NoError(err)
+
+	s.retentionProvider.EXPECT().GetNamespaceRetention(testNamespaceID).Return(36*time.Hour, nil).Times(3)
+	gomock.InOrder(
+		s.s3cli.EXPECT().GetBucketLifecycleConfigurationWithContext(gomock.Any(), gomock.Any()).
+			Return(nil, awserr.New(errCodeNoSuchLifecycleConfiguration, "", nil)),
+		s.s3cli.EXPECT().PutBucketLifecycleConfigurationWithContext(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, input *s3.PutBucketLifecycleConfigurationInput, _ ...interface{}) (*s3.PutBucketLifecycleConfigurationOutput, error) {
+				s.Equal("test-bucket", aws.StringValue(input.Bucket))
+				s.Len(input.LifecycleConfiguration.Rules, 1)
+				rule := input.LifecycleConfiguration.Rules[0]
+				s.Equal("temporal-retention-2", aws.StringValue(rule.ID))
+				s.Equal("2", aws.StringValue(rule.Filter.Tag.Value))
+				s.Equal(int64(2), aws.Int64Value(rule.Expiration.Days))
+				return &s3.PutBucketLifecycleConfigurationOutput{}, nil
+			}),
+		// the added rule is read back before it is cached
+		s.s3cli.EXPECT().GetBucketLifecycleConfigurationWithContext(gomock.Any(), gomock.Any()).
+			Return(&s3.GetBucketLifecycleConfigurationOutput{
+				Rules: []*s3.LifecycleRule{{ID: aws.String("temporal-retention-2")}},
+			}, nil),
+	)
+
+	for i := 0; i < 3; i++ {
+		options, err := provider.get(context.Background(), s.testURI, testNamespaceID, testNamespace)
+		s.NoError(err)
+		s.Equal(int64(2), options.RetentionDays)
+		s.Equal("temporal-retention-days=2", aws.StringValue(options.tagging()))
+	}
+}
+
+func (s *lifecycleSuite) TestEnsureRule_KeepsExistingRules() {
+	existingRule := &s3.LifecycleRule{ID: aws.String("other-rule")}
+	s.s3cli.EXPECT().GetBucketLifecycleConfigurationWithContext(gomock.Any(), gomock.Any()).
+		Return(&s3.GetBucketLifecycleConfigurationOutput{Rules: []*s3.LifecycleRule{existingRule}}, nil)
+	s.s3cli.EXPECT().PutBucketLifecycleConfigurationWithContext(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, input *s3.PutBucketLifecycleConfigurationInput, _ ...interface{}) (*s3.PutBucketLifecycleConfigurationOutput, error) {
+			s.Len(input.LifecycleConfiguration.Rules, 2)
+			s.Equal(existingRule, input.LifecycleConfiguration.Rules[0])
+			return &s3.PutBucketLifecycleConfigurationOutput{}, nil
+		})
+
+	s.NoError(newRetentionLifecycle(s.s3cli).ensureRule(context.Background(), "test-bucket", 7))
+}
+
+func (s *lifecycleSuite) TestEnsureRule_NotSerialized() {
+	lifecycle := newRetentionLifecycle(s.s3cli)
+	blocked := make(chan struct{})
+	s.s3cli.EXPECT().GetBucketLifecycleConfigurationWithContext(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, input *s3.GetBucketLifecycleConfigurationInput, _ ...interface{}) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+			if aws.StringValue(input.Bucket) == "slow-bucket" {
+				<-blocked
+			}
+			return &s3.GetBucketLifecycleConfigurationOutput{
+				Rules: []*s3.LifecycleRule{{ID: aws.String("temporal-retention-7")}},
+			}, nil
+		}).Times(2)
+
+	slowDone := make(chan error)
+	go func() {
+		slowDone <- lifecycle.ensureRule(context.Background(), "slow-bucket", 7)
+	}()
+	// a request to a slow bucket doesn't block the rules of other buckets
+	s.NoError(lifecycle.ensureRule(context.Background(), "test-bucket", 7))
+	close(blocked)
+	s.NoError(<-slowDone)
+}
+
+func (s *lifecycleSuite) TestUpload_Options() {
+	s.s3cli.EXPECT().PutObjectWithContext(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, input *s3.PutObjectInput, _ ...interface{}) (*s3.PutObjectOutput, error) {
+			s.Equal(s3.ServerSideEncryptionAwsKms, aws.StringValue(input.ServerSideEncryption))
+			s.Equal("test-key", aws.StringValue(input.SSEKMSKeyId))
+			s.Equal("temporal-retention-days=3", aws.StringValue(input.Tagging))
+			return &s3.PutObjectOutput{}, nil
+		})
+
+	err := Upload(context.Background(), s.s3cli, s.testURI, "test-key", []byte("data"), &UploadOptions{
+		Encryption:    &config.S3Encryption{Algorithm: s3.ServerSideEncryptionAwsKms, KMSKeyID: "test-key"},
+		RetentionDays: 3,
+	})
+	s.NoError(err)
+}
+
+func (s *lifecycleSuite) TestRetentionDays() {
+	s.Equal(int64(1), retentionDays(time.Hour))
+	s.Equal(int64(1), retentionDays(24*time.Hour))
+	s.Equal(int64(2), retentionDays(25*time.Hour))
+}
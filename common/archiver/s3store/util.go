@@ -183,15 +183,23 @@ func ensureContextTimeout(ctx context.Context) (context.Context, context.CancelF
 	}
 	return context.WithTimeout(ctx, defaultBlobstoreTimeout)
 }
-func Upload(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string, data []byte) error {
+func Upload(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string, data []byte, options *UploadOptions) error {
 	ctx, cancel := ensureContextTimeout(ctx)
 	defer cancel()
 
-	_, err := s3cli.PutObjectWithContext(ctx, &s3.PutObjectInput{
-		Bucket: aws.String(URI.Hostname()),
-		Key:    aws.String(key),
-		Body:   bytes.NewReader(data),
-	})
+	input := &s3.PutObjectInput{
+		Bucket:  aws.String(URI.Hostname()),
+		Key:     aws.String(key),
+		Body:    bytes.NewReader(data),
+		Tagging: options.tagging(),
+	}
+	if options != nil && options.Encryption != nil {
+		input.ServerSideEncryption = aws.String(options.Encryption.Algorithm)
+		if options.Encryption.KMSKeyID != "" {
+			input.SSEKMSKeyId = aws.String(options.Encryption.KMSKeyID)
+		}
+	}
+	_, err := s3cli.PutObjectWithContext(ctx, input)
 	if err != nil {
 		if aerr, ok := err.(awserr.Error); ok {
 			if aerr.Code() == s3.ErrCodeNoSuchBucket {
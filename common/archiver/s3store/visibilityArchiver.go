@@ -46,9 +46,10 @@ import (
 
 type (
 	visibilityArchiver struct {
-		container   *archiver.VisibilityBootstrapContainer
-		s3cli       s3iface.S3API
-		queryParser QueryParser
+		container     *archiver.VisibilityBootstrapContainer
+		s3cli         s3iface.S3API
+		uploadOptions *uploadOptionsProvider
+		queryParser   QueryParser
 	}
 
 	queryVisibilityRequest struct {
@@ -94,10 +95,16 @@ func newVisibilityArchiver(
 	if err != nil {
 		return nil, err
 	}
+	s3cli := s3.New(sess)
+	uploadOptions, err := newUploadOptionsProvider(s3cli, config, container.NamespaceRetentionProvider)
+	if err != nil {
+		return nil, err
+	}
 	return &visibilityArchiver{
-		container:   container,
-		s3cli:       s3.New(sess),
-		queryParser: NewQueryParser(),
+		container:     container,
+		s3cli:         s3cli,
+		uploadOptions: uploadOptions,
+		queryParser:   NewQueryParser(),
 	}, nil
 }
 
@@ -143,11 +150,16 @@ func (v *visibilityArchiver) Archive(
 		archiveFailReason = errEncodeVisibilityRecord
 		return err
 	}
+	uploadOptions, err := v.uploadOptions.get(ctx, URI, request.GetNamespaceId(), request.Namespace)
+	if err != nil {
+		archiveFailReason = errGetUploadOptions
+		return err
+	}
 	indexes := createIndexesToArchive(request)
 	// Upload archive to all indexes
 	for _, element := range indexes {
 		key := constructTimestampIndex(URI.Path(), request.GetNamespaceId(), element.primaryIndex, element.primaryIndexValue, element.secondaryIndex, element.secondaryIndexTimestamp, request.GetRunId())
-		if err := Upload(ctx, v.s3cli, URI, key, encodedVisibilityRecord); err != nil {
+		if err := Upload(ctx, v.s3cli, URI, key, encodedVisibilityRecord, uploadOptions); err != nil {
 			archiveFailReason = errWriteKey
 			return err
 		}
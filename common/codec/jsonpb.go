@@ -31,6 +31,7 @@ import (
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/sdk/converter"
 )
 
 type (
@@ -38,8 +39,9 @@ type (
 	// This is an wrapper on top of jsonpb.Marshaler which supports not only single object serialization
 	// but also slices of concrete objects.
 	JSONPBEncoder struct {
-		marshaler   jsonpb.Marshaler
-		ubmarshaler jsonpb.Unmarshaler
+		marshaler    jsonpb.Marshaler
+		ubmarshaler  jsonpb.Unmarshaler
+		payloadCodec converter.PayloadCodec
 	}
 )
 
@@ -59,10 +61,18 @@ func NewJSONPBIndentEncoder(indent string) *JSONPBEncoder {
 	}
 }
 
+// WithPayloadCodec returns a copy of the encoder that decodes every payload with payloadCodec before encoding.
+// The messages passed to the returned encoder are not modified.
+func (e *JSONPBEncoder) WithPayloadCodec(payloadCodec converter.PayloadCodec) *JSONPBEncoder {
+	encoder := *e
+	encoder.payloadCodec = payloadCodec
+	return &encoder
+}
+
 // Encode protobuf struct to bytes.
 func (e *JSONPBEncoder) Encode(pb proto.Message) ([]byte, error) {
 	var buf bytes.Buffer
-	err := e.marshaler.Marshal(&buf, pb)
+	err := e.marshal(&buf, pb)
 	return buf.Bytes(), err
 }
 
@@ -122,7 +132,7 @@ func (e *JSONPBEncoder) encodeSlice(
 	buf.WriteString("[")
 	for i := 0; i < len; i++ {
 		pb := item(i)
-		if err := e.marshaler.Marshal(&buf, pb); err != nil {
+		if err := e.marshal(&buf, pb); err != nil {
 			return nil, err
 		}
 
@@ -135,6 +145,16 @@ func (e *JSONPBEncoder) encodeSlice(
 	return buf.Bytes(), nil
 }
 
+func (e *JSONPBEncoder) marshal(buf *bytes.Buffer, pb proto.Message) error {
+	if e.payloadCodec != nil {
+		pb = proto.Clone(pb)
+		if err := DecodePayloads(pb, e.payloadCodec); err != nil {
+			return err
+		}
+	}
+	return e.marshaler.Marshal(buf, pb)
+}
+
 // constructor callback must create empty object, add it to result slice, and return it.
 func (e *JSONPBEncoder) decodeSlice(
 	data []byte,
@@ -0,0 +1,188 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package codec
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/converter"
+)
+
+const (
+	// RemotePayloadCodecNamespaceHeader tells the codec server which namespace the payloads belong to.
+	RemotePayloadCodecNamespaceHeader = "X-Namespace"
+
+	remotePayloadCodecEncodePath = "/encode"
+	remotePayloadCodecDecodePath = "/decode"
+
+	defaultRemotePayloadCodecTimeout = 10 * time.Second
+)
+
+type (
+	// RemotePayloadCodecOptions configures a RemotePayloadCodec.
+	RemotePayloadCodecOptions struct {
+		// Endpoint is the base URL of the codec server, e.g. http://localhost:8888. Requests are sent to
+		// Endpoint + "/encode" and Endpoint + "/decode".
+		Endpoint string
+		// Namespace is sent in the X-Namespace header, so that the codec server can pick namespace specific keys.
+		Namespace string
+		// Authorization, if set, is sent as the Authorization header.
+		Authorization string
+		// HTTPClient is used to call the codec server. Defaults to a client with a 10 second timeout.
+		HTTPClient *http.Client
+	}
+
+	// RemotePayloadCodec is a converter.PayloadCodec that calls a codec server speaking the standard Temporal
+	// codec server HTTP protocol.
+	RemotePayloadCodec struct {
+		options RemotePayloadCodecOptions
+	}
+)
+
+var _ converter.PayloadCodec = (*RemotePayloadCodec)(nil)
+
+var payloadType = reflect.TypeOf((*commonpb.Payload)(nil))
+
+// NewRemotePayloadCodec creates a new RemotePayloadCodec.
+func NewRemotePayloadCodec(options RemotePayloadCodecOptions) *RemotePayloadCodec {
+	options.Endpoint = strings.TrimSuffix(options.Endpoint, "/")
+	if options.HTTPClient == nil {
+		options.HTTPClient = &http.Client{Timeout: defaultRemotePayloadCodecTimeout}
+	}
+	return &RemotePayloadCodec{options: options}
+}
+
+// Encode encodes payloads using the codec server.
+func (c *RemotePayloadCodec) Encode(payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	return c.call(remotePayloadCodecEncodePath, payloads)
+}
+
+// Decode decodes payloads using the codec server.
+func (c *RemotePayloadCodec) Decode(payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	return c.call(remotePayloadCodecDecodePath, payloads)
+}
+
+func (c *RemotePayloadCodec) call(path string, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	var body bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&body, &commonpb.Payloads{Payloads: payloads}); err != nil {
+		return nil, fmt.Errorf("unable to marshal payloads: %w", err)
+	}
+
+	request, err := http.NewRequest(http.MethodPost, c.options.Endpoint+path, &body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build codec server request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if c.options.Namespace != "" {
+		request.Header.Set(RemotePayloadCodecNamespaceHeader, c.options.Namespace)
+	}
+	if c.options.Authorization != "" {
+		request.Header.Set("Authorization", c.options.Authorization)
+	}
+
+	response, err := c.options.HTTPClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("unable to call codec server: %w", err)
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	if response.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(response.Body)
+		return nil, fmt.Errorf("codec server returned %s: %s", response.Status, bytes.TrimSpace(message))
+	}
+
+	var result commonpb.Payloads
+	if err := jsonpb.Unmarshal(response.Body, &result); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal codec server response: %w", err)
+	}
+	if len(result.Payloads) != len(payloads) {
+		return nil, fmt.Errorf("codec server returned %d payloads, expected %d", len(result.Payloads), len(payloads))
+	}
+	return result.Payloads, nil
+}
+
+// DecodePayloads decodes, in place, every payload found anywhere in pb with a single call to payloadCodec.
+func DecodePayloads(pb proto.Message, payloadCodec converter.PayloadCodec) error {
+	var payloads []*commonpb.Payload
+	collectPayloads(reflect.ValueOf(pb), &payloads)
+	if len(payloads) == 0 {
+		return nil
+	}
+
+	decoded, err := payloadCodec.Decode(payloads)
+	if err != nil {
+		return err
+	}
+	if len(decoded) != len(payloads) {
+		return fmt.Errorf("payload codec returned %d payloads, expected %d", len(decoded), len(payloads))
+	}
+	for i, payload := range payloads {
+		*payload = *decoded[i]
+	}
+	return nil
+}
+
+func collectPayloads(v reflect.Value, payloads *[]*commonpb.Payload) {
+	if v.Type() == payloadType {
+		if !v.IsNil() {
+			*payloads = append(*payloads, v.Interface().(*commonpb.Payload))
+		}
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			collectPayloads(v.Elem(), payloads)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				collectPayloads(v.Field(i), payloads)
+			}
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			collectPayloads(v.Index(i), payloads)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			collectPayloads(iter.Value(), payloads)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package codec
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/testing/codecserver"
+)
+
+type (
+	payloadCodecSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		server *codecserver.Server
+		codec  *RemotePayloadCodec
+	}
+)
+
+func TestPayloadCodecSuite(t *testing.T) {
+	s := new(payloadCodecSuite)
+	suite.Run(t, s)
+}
+
+func (s *payloadCodecSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.server = codecserver.NewServer()
+	s.codec = NewRemotePayloadCodec(RemotePayloadCodecOptions{
+		Endpoint:      s.server.URL + "/",
+		Namespace:     "my-namespace",
+		Authorization: "Bearer token",
+	})
+}
+
+func (s *payloadCodecSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *payloadCodecSuite) TestEncodeDecodeRoundTrip() {
+	original := []*commonpb.Payload{payload.EncodeString("hello"), payload.EncodeString("world")}
+
+	encoded, err := s.codec.Encode(original)
+	s.NoError(err)
+	s.Len(encoded, 2)
+	s.Equal(codecserver.Encoding, string(encoded[0].GetMetadata()["encoding"]))
+
+	decoded, err := s.codec.Decode(encoded)
+	s.NoError(err)
+	s.Equal(original, decoded)
+
+	requests := s.server.Requests()
+	s.Len(requests, 2)
+	s.Equal("/encode", requests[0].URL.Path)
+	s.Equal("/decode", requests[1].URL.Path)
+	s.Equal("my-namespace", requests[1].Header.Get(RemotePayloadCodecNamespaceHeader))
+	s.Equal("Bearer token", requests[1].Header.Get("Authorization"))
+}
+
+func (s *payloadCodecSuite) TestServerError() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "no key for namespace", http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	_, err := NewRemotePayloadCodec(RemotePayloadCodecOptions{Endpoint: server.URL}).Decode([]*commonpb.Payload{payload.EncodeString("hello")})
+	s.Error(err)
+	s.True(strings.Contains(err.Error(), "no key for namespace"))
+}
+
+func (s *payloadCodecSuite) TestEncoderDecodesNestedPayloads() {
+	input := payloads.EncodeString("input")
+	encodedInput, err := codecserver.Codec{}.Encode(input.Payloads)
+	s.NoError(err)
+	memo, err := codecserver.Codec{}.Encode([]*commonpb.Payload{payload.EncodeString("memo")})
+	s.NoError(err)
+
+	event := &historypb.HistoryEvent{
+		EventId: 1,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+			Input: &commonpb.Payloads{Payloads: encodedInput},
+			Memo:  &commonpb.Memo{Fields: map[string]*commonpb.Payload{"key": memo[0]}},
+		}},
+	}
+
+	expected := &historypb.HistoryEvent{
+		EventId: 1,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+			Input: input,
+			Memo:  &commonpb.Memo{Fields: map[string]*commonpb.Payload{"key": payload.EncodeString("memo")}},
+		}},
+	}
+	expectedJSON, err := NewJSONPBEncoder().Encode(expected)
+	s.NoError(err)
+
+	encoder := NewJSONPBEncoder().WithPayloadCodec(s.codec)
+	json, err := encoder.Encode(event)
+	s.NoError(err)
+	s.Equal(string(expectedJSON), string(json))
+	s.Equal(codecserver.Encoding, string(event.GetWorkflowExecutionStartedEventAttributes().GetInput().Payloads[0].GetMetadata()["encoding"]), "original message is not modified")
+	s.Len(s.server.Requests(), 1, "payloads of a message are decoded in one batch")
+
+	json, err = encoder.EncodeHistoryEvents([]*historypb.HistoryEvent{event})
+	s.NoError(err)
+	s.Equal("["+string(expectedJSON)+"]", string(json))
+}
+
+func (s *payloadCodecSuite) TestEncoderWithoutPayloads() {
+	json, err := NewJSONPBEncoder().WithPayloadCodec(s.codec).Encode(historyEvent)
+	s.NoError(err)
+	s.Equal(encodedHistoryEvent, string(json))
+	s.Empty(s.server.Requests())
+}
@@ -26,6 +26,7 @@ package config
 
 import (
 	"bytes"
+	"fmt"
 	"strings"
 	"time"
 
@@ -63,6 +64,9 @@ type (
 		// DynamicConfigClient is the config for setting up the file based dynamic config client
 		// Filepath should be relative to the root directory
 		DynamicConfigClient *dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
+		// DynamicConfigDirClient is the config for setting up the directory based dynamic config client
+		// It is used only if DynamicConfigClient is not set
+		DynamicConfigDirClient *dynamicconfig.DirBasedClientConfig `yaml:"dynamicConfigDirClient"`
 		// NamespaceDefaults is the default config for every namespace
 		NamespaceDefaults NamespaceDefaults `yaml:"namespaceDefaults"`
 		// ExporterConfig allows the specification of process-wide OTEL exporters
@@ -217,6 +221,31 @@ type (
 		// This is generally used when BindOnIP would be the same across several nodes (ie: 0.0.0.0)
 		// and for nat traversal scenarios. Check net.ParseIP for supported syntax, only IPv4 is supported.
 		BroadcastAddress string `yaml:"broadcastAddress"`
+		// Provider selects the membership implementation, either "ringpop" (default) or "static".
+		Provider string `yaml:"provider"`
+		// Static contains the configuration of the static membership provider
+		Static StaticMembership `yaml:"static"`
+	}
+
+	// StaticMembership contains config items for the static membership provider. Hosts of a service are
+	// discovered from a static list or DNS SRV records and are only considered members while they keep
+	// heartbeating into the cluster_membership table.
+	StaticMembership struct {
+		// RefreshInterval is the interval at which hosts are rediscovered, defaults to 10s
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+		// Services maps service names to the way their hosts are discovered. Services without an entry
+		// are discovered from cluster_membership heartbeats alone.
+		Services map[string]StaticMembershipService `yaml:"services"`
+	}
+
+	// StaticMembershipService configures how the hosts of a service are discovered. Exactly one of
+	// Hosts and DNSSRVRecord should be set.
+	StaticMembershipService struct {
+		// Hosts is the static list of host:port gRPC addresses of the service
+		Hosts []string `yaml:"hosts"`
+		// DNSSRVRecord is the name of a DNS SRV record listing the gRPC addresses of the service,
+		// e.g. _grpc._tcp.temporal-history.temporal.svc.cluster.local
+		DNSSRVRecord string `yaml:"dnsSrvRecord"`
 	}
 
 	// Persistence contains the configuration for data store / persistence layer
@@ -226,6 +255,8 @@ type (
 		// VisibilityStore is the name of the datastore to be used for visibility records
 		VisibilityStore string `yaml:"visibilityStore"`
 		// AdvancedVisibilityStore is the name of the datastore to be used for visibility records
+		// with custom search attributes and list queries. It is an Elasticsearch datastore or
+		// an SQL datastore with the plugin which supports it (sqlite).
 		AdvancedVisibilityStore string `yaml:"advancedVisibilityStore"`
 		// NumHistoryShards is the desired number of history shards. This config doesn't
 		// belong here, needs refactoring
@@ -444,6 +475,8 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
 		S3store   *S3Archiver        `yaml:"s3store"`
+		// ObjectStore is the config for archiving to S3 compatible object stores
+		ObjectStore *ObjectStoreArchiver `yaml:"objectstore"`
 	}
 
 	// VisibilityArchival contains the config for visibility archival
@@ -461,12 +494,17 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		S3store   *S3Archiver        `yaml:"s3store"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
+		// ObjectStore is the config for archiving to S3 compatible object stores
+		ObjectStore *ObjectStoreArchiver `yaml:"objectstore"`
 	}
 
 	// FilestoreArchiver contain the config for filestore archiver
 	FilestoreArchiver struct {
 		FileMode string `yaml:"fileMode"`
 		DirMode  string `yaml:"dirMode"`
+		// Format is the format archived data is written in, either "json" (default) or "bundle"
+		// for zstd compressed protobuf bundles with an index of visibility records
+		Format string `yaml:"format"`
 	}
 
 	// GstorageArchiver contain the config for google storage archiver
@@ -479,6 +517,33 @@ type (
 		Region           string  `yaml:"region"`
 		Endpoint         *string `yaml:"endpoint"`
 		S3ForcePathStyle bool    `yaml:"s3ForcePathStyle"`
+		// Encryption is the server side encryption for archived objects, objects are not encrypted if unset
+		Encryption *S3Encryption `yaml:"encryption"`
+		// NamespaceEncryption overrides Encryption for the namespaces it contains, keyed by namespace name
+		NamespaceEncryption map[string]*S3Encryption `yaml:"namespaceEncryption"`
+		// RetentionLifecycle expires archived objects after the namespace retention through bucket lifecycle rules
+		RetentionLifecycle bool `yaml:"retentionLifecycle"`
+	}
+
+	// S3Encryption contains the server side encryption config for archived objects
+	S3Encryption struct {
+		// Algorithm is the server side encryption algorithm, either "AES256" or "aws:kms"
+		Algorithm string `yaml:"algorithm"`
+		// KMSKeyID is the id of the key used by the "aws:kms" algorithm, the default key is used if empty
+		KMSKeyID string `yaml:"kmsKeyId"`
+	}
+
+	// ObjectStoreArchiver contains the config for archivers backed by S3 compatible object stores, e.g. MinIO
+	ObjectStoreArchiver struct {
+		// Endpoint is the URL of the object store, the AWS endpoint for Region is used if empty
+		Endpoint string `yaml:"endpoint"`
+		Region   string `yaml:"region"`
+		// S3ForcePathStyle should be set for object stores that don't support virtual hosted buckets
+		S3ForcePathStyle bool `yaml:"s3ForcePathStyle"`
+		// Encryption is the server side encryption for archived objects, objects are not encrypted if unset
+		Encryption *S3Encryption `yaml:"encryption"`
+		// NamespaceEncryption overrides Encryption for the namespaces it contains, keyed by namespace name
+		NamespaceEncryption map[string]*S3Encryption `yaml:"namespaceEncryption"`
 	}
 
 	// PublicClient is config for internal nodes (history/matching/worker) connecting to
@@ -551,6 +616,13 @@ const (
 	ClusterMDStoreName DataStoreName = "ClusterMDStore"
 )
 
+const (
+	// MembershipProviderRingpop discovers cluster members by gossiping through ringpop
+	MembershipProviderRingpop = "ringpop"
+	// MembershipProviderStatic discovers cluster members from static lists or DNS SRV records
+	MembershipProviderStatic = "static"
+)
+
 // Validate validates this config
 func (c *Config) Validate() error {
 	if err := c.Persistence.Validate(); err != nil {
@@ -561,9 +633,30 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if err := c.Global.Membership.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// Validate validates the membership config
+func (m *Membership) Validate() error {
+	switch m.Provider {
+	case "", MembershipProviderRingpop:
+		return nil
+	case MembershipProviderStatic:
+		for service, serviceConfig := range m.Static.Services {
+			if len(serviceConfig.Hosts) > 0 && serviceConfig.DNSSRVRecord != "" {
+				return fmt.Errorf("static membership config of service %v sets both hosts and dnsSrvRecord", service)
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown membership provider %q", m.Provider)
+	}
+}
+
 // String converts the config object into a string
 func (c *Config) String() string {
 	var buf bytes.Buffer
@@ -36,3 +36,26 @@ func TestToString(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, cfg.String())
 }
+
+func TestMembershipValidate(t *testing.T) {
+	assert.NoError(t, (&Membership{}).Validate())
+	assert.NoError(t, (&Membership{Provider: MembershipProviderRingpop}).Validate())
+	assert.NoError(t, (&Membership{
+		Provider: MembershipProviderStatic,
+		Static: StaticMembership{
+			Services: map[string]StaticMembershipService{
+				"frontend": {Hosts: []string{"10.0.0.1:7233"}},
+				"history":  {DNSSRVRecord: "_grpc._tcp.history.example.com"},
+			},
+		},
+	}).Validate())
+	assert.Error(t, (&Membership{
+		Provider: MembershipProviderStatic,
+		Static: StaticMembership{
+			Services: map[string]StaticMembershipService{
+				"frontend": {Hosts: []string{"10.0.0.1:7233"}, DNSSRVRecord: "_grpc._tcp.frontend.example.com"},
+			},
+		},
+	}).Validate())
+	assert.Error(t, (&Membership{Provider: "consul"}).Validate())
+}
@@ -95,6 +95,14 @@ func (c *Persistence) validateAdvancedVisibility() error {
 		return fmt.Errorf("persistence config: advanced visibility datastore %q: missing config", c.AdvancedVisibilityStore)
 	}
 
+	// SQL advanced visibility is supported by plugins which implement it, e.g. sqlite.
+	if advancedVisibilityDataStore.SQL != nil {
+		if err := advancedVisibilityDataStore.Validate(); err != nil {
+			return fmt.Errorf("persistence config: advanced visibility datastore %q: %s", c.AdvancedVisibilityStore, err.Error())
+		}
+		return nil
+	}
+
 	if err := advancedVisibilityDataStore.Elasticsearch.Validate(c.AdvancedVisibilityStore); err != nil {
 		return err
 	}
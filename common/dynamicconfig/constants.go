@@ -102,6 +102,10 @@ const (
 	DeadlockInterval = "system.deadlock.Interval"
 	// How many extra goroutines can be created per root.
 	DeadlockMaxWorkersPerRoot = "system.deadlock.MaxWorkersPerRoot"
+	// PersistenceFaultInjectionRules is a map of named rules that inject errors and latency into persistence calls.
+	// Each rule may target a method, namespace (name or ID) and workflow ID. Meant for chaos testing only.
+	// Rules only take effect when fault injection is enabled in the static persistence config.
+	PersistenceFaultInjectionRules = "system.persistenceFaultInjectionRules"
 
 	// keys for size limit
 
@@ -113,6 +117,13 @@ const (
 	MemoSizeLimitError = "limit.memoSize.error"
 	// MemoSizeLimitWarn is the per event memo size limit for warning
 	MemoSizeLimitWarn = "limit.memoSize.warn"
+	// PayloadPolicyAllowedEncodings is a comma separated list of payload encodings (e.g. binary/encrypted) accepted by
+	// a namespace. Empty accepts any encoding. Search attributes and headers are not subject to it.
+	PayloadPolicyAllowedEncodings = "limit.payloadPolicy.allowedEncodings"
+	// PayloadPolicyMemoSizeLimit is the memo size limit enforced by the payload policy, 0 to disable
+	PayloadPolicyMemoSizeLimit = "limit.payloadPolicy.memoSize"
+	// PayloadPolicyHeaderSizeLimit is the header size limit enforced by the payload policy, 0 to disable
+	PayloadPolicyHeaderSizeLimit = "limit.payloadPolicy.headerSize"
 	// NumPendingChildExecutionsLimitError is the maximum number of pending child workflows a workflow can have before
 	// StartChildWorkflowExecution commands will fail.
 	NumPendingChildExecutionsLimitError = "limit.numPendingChildExecutions.error"
@@ -274,7 +285,9 @@ const (
 	MatchingEnablePersistencePriorityRateLimiting = "matching.enablePersistencePriorityRateLimiting"
 	// MatchingMinTaskThrottlingBurstSize is the minimum burst size for task queue throttling
 	MatchingMinTaskThrottlingBurstSize = "matching.minTaskThrottlingBurstSize"
-	// MatchingGetTasksBatchSize is the maximum batch size to fetch from the task buffer
+	// MatchingGetTasksBatchSize is the maximum batch size to fetch from the task buffer. It also bounds
+	// the backlog tasks of each task priority level held in memory per partition, which are the only
+	// backlog tasks that fairness can reorder
 	MatchingGetTasksBatchSize = "matching.getTasksBatchSize"
 	// MatchingLongPollExpirationInterval is the long poll expiration interval in the matching service
 	MatchingLongPollExpirationInterval = "matching.longPollExpirationInterval"
@@ -298,6 +311,27 @@ const (
 	MatchingNumTaskqueueWritePartitions = "matching.numTaskqueueWritePartitions"
 	// MatchingNumTaskqueueReadPartitions is the number of read partitions for a task queue
 	MatchingNumTaskqueueReadPartitions = "matching.numTaskqueueReadPartitions"
+	// MatchingEnablePartitionAutoscaling enables the root partition of a task queue to grow and shrink the number of
+	// active partitions based on observed load. The configured numbers of write and read partitions become the upper bound.
+	MatchingEnablePartitionAutoscaling = "matching.enablePartitionAutoscaling"
+	// MatchingPartitionAutoscalingTargetRate is the add rate (tasks per second) a single partition should handle before
+	// partition autoscaling adds another partition
+	MatchingPartitionAutoscalingTargetRate = "matching.partitionAutoscalingTargetRate"
+	// MatchingPartitionAutoscalingInterval is how often the root partition re-evaluates the number of active partitions,
+	// and how often non-root partitions refresh it from the root partition
+	MatchingPartitionAutoscalingInterval = "matching.partitionAutoscalingInterval"
+	// MatchingPartitionAutoscalingScaleDownDelay is how long the load must stay low before partition autoscaling
+	// retires partitions
+	MatchingPartitionAutoscalingScaleDownDelay = "matching.partitionAutoscalingScaleDownDelay"
+	// MatchingPriorityStarvationInterval is how often (every Nth dispatch) lower priority tasks are
+	// considered ahead of higher priority tasks, so that low priority work is not starved
+	MatchingPriorityStarvationInterval = "matching.priorityStarvationInterval"
+	// MatchingFairnessKeyWeights is a map from fairness key to the number of backlog tasks with that key
+	// dispatched per round-robin turn. Round-robin only covers the backlog tasks read ahead into memory,
+	// see MatchingGetTasksBatchSize, so it interleaves keys but does not keep a key with a larger backlog
+	// from delaying the others. Keys that are not in the map have a weight of 1. Only keys in the
+	// map get their own task_backlog_per_fairness_key series, the others are reported together
+	MatchingFairnessKeyWeights = "matching.fairnessKeyWeights"
 	// MatchingForwarderMaxOutstandingPolls is the max number of inflight polls from the forwarder
 	MatchingForwarderMaxOutstandingPolls = "matching.forwarderMaxOutstandingPolls"
 	// MatchingForwarderMaxOutstandingTasks is the max number of inflight addTask/queryTask from the forwarder
@@ -346,6 +380,37 @@ const (
 	AcquireShardInterval = "history.acquireShardInterval"
 	// AcquireShardConcurrency is number of goroutines that can be used to acquire shards in the shard controller.
 	AcquireShardConcurrency = "history.acquireShardConcurrency"
+	// ShardHandoffEnabled controls whether the shard controller hands off its shards to their new owners
+	// when the host is shutting down, instead of simply releasing them.
+	ShardHandoffEnabled = "history.shardHandoffEnabled"
+	// ShardHandoffConcurrency is number of goroutines that can be used to hand off shards in the shard controller.
+	ShardHandoffConcurrency = "history.shardHandoffConcurrency"
+	// ShardHandoffTimeout is the timeout for handing off a single shard, including the time the new owner
+	// spends prewarming its caches
+	ShardHandoffTimeout = "history.shardHandoffTimeout"
+	// ShardHandoffPrewarmWorkflowCount is the max number of recently used workflows of a shard the new owner
+	// prewarms its caches with during a shard handoff
+	ShardHandoffPrewarmWorkflowCount = "history.shardHandoffPrewarmWorkflowCount"
+	// ShardOwnershipOverrideRefreshInterval is the interval at which every host reloads the shard ownership
+	// overrides set by the MoveShard admin API. History hosts also reload them when they see a request
+	// for a shard they do not think they own.
+	ShardOwnershipOverrideRefreshInterval = "history.shardOwnershipOverrideRefreshInterval"
+	// ShardLoadWindow is the window over which the request rates and busiest workflows of a shard are reported
+	ShardLoadWindow = "history.shardLoadWindow"
+	// ShardLoadMaxTrackedWorkflows is the max number of workflows per shard whose requests are counted in a window
+	ShardLoadMaxTrackedWorkflows = "history.shardLoadMaxTrackedWorkflows"
+	// ShardLoadTopWorkflowCount is the number of busiest workflows reported per shard by DescribeHistoryHost
+	ShardLoadTopWorkflowCount = "history.shardLoadTopWorkflowCount"
+	// HotWorkflowReportInterval is the interval at which the busiest workflows of a history host are reported
+	HotWorkflowReportInterval = "history.hotWorkflowReportInterval"
+	// HotWorkflowRequestThreshold is the min number of requests within the shard load window for a workflow
+	// to be reported as hot. Zero disables the report
+	HotWorkflowRequestThreshold = "history.hotWorkflowRequestThreshold"
+	// WorkflowRPS is the max request rate per second for a single workflow on signal, query, update and other
+	// externally initiated APIs. Zero disables the limit
+	WorkflowRPS = "history.workflowRPS"
+	// WorkflowRateLimiterCacheSize is the max number of workflows a history host keeps rate limiters for
+	WorkflowRateLimiterCacheSize = "history.workflowRateLimiterCacheSize"
 	// StandbyClusterDelay is the artificial delay added to standby cluster's view of active cluster's time
 	StandbyClusterDelay = "history.standbyClusterDelay"
 	// StandbyTaskMissingEventsResendDelay is the amount of time standby cluster's will wait (if events are missing)
@@ -384,6 +449,9 @@ const (
 	// TaskSchedulerNamespaceMaxQPS is the max qps task schedulers on a host can schedule tasks for a certain namespace
 	// If value less or equal to 0, will fall back to HistoryPersistenceNamespaceMaxQPS
 	TaskSchedulerNamespaceMaxQPS = "history.taskSchedulerNamespaceMaxQPS"
+	// TaskSchedulerNamespaceWeight is the round robin weight of a namespace in host level task schedulers.
+	// Task channel weight is the priority weight multiplied by the namespace weight.
+	TaskSchedulerNamespaceWeight = "history.taskSchedulerNamespaceWeight"
 
 	// TimerTaskBatchSize is batch size for timer processor to process tasks
 	TimerTaskBatchSize = "history.timerTaskBatchSize"
@@ -726,4 +794,7 @@ const (
 	WorkerPerNamespaceWorkerCount = "worker.perNamespaceWorkerCount"
 	// WorkerEnableScheduler controls whether to start the worker for scheduled workflows
 	WorkerEnableScheduler = "worker.enableScheduler"
+	// WorkerSchedulerPauseAfterFailures is the default number of consecutive failed runs after which a
+	// schedule with pause-on-failure set is paused, for schedules that don't set their own
+	WorkerSchedulerPauseAfterFailures = "worker.schedulerPauseAfterFailures"
 )
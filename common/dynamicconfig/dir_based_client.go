@@ -0,0 +1,276 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/fsnotify/fsnotify"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+var _ Client = (*dirBasedClient)(nil)
+
+type (
+	// DirBasedClientConfig is the config for the directory based dynamic config client.
+	// Every yaml file in the directory uses the same format as the file based client and
+	// values are merged across files, so config can be split up, e.g. one file per namespace
+	// or task queue. A file which defines a key with the same constraints as another file is
+	// rejected like an invalid file. Files are watched for changes instead of being polled.
+	DirBasedClientConfig struct {
+		Dirpath string `yaml:"dirpath"`
+	}
+
+	dirBasedClient struct {
+		values  atomic.Value // configValueMap
+		logger  log.Logger
+		config  *DirBasedClientConfig
+		watcher *fsnotify.Watcher
+		doneCh  <-chan interface{}
+
+		// fileValues holds the last good values of each file, keyed by file name. It is only
+		// accessed during init and from the watch loop afterwards.
+		fileValues map[string]configValueMap
+	}
+)
+
+// NewDirBasedClient creates a directory based client.
+func NewDirBasedClient(config *DirBasedClientConfig, logger log.Logger, doneCh <-chan interface{}) (*dirBasedClient, error) {
+	client := &dirBasedClient{
+		logger:     logger,
+		config:     config,
+		doneCh:     doneCh,
+		fileValues: make(map[string]configValueMap),
+	}
+
+	err := client.init()
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+func (dc *dirBasedClient) GetValue(key Key) []ConstrainedValue {
+	values := dc.values.Load().(configValueMap)
+	return values[strings.ToLower(key.String())]
+}
+
+func (dc *dirBasedClient) init() error {
+	if err := dc.validateConfig(dc.config); err != nil {
+		return fmt.Errorf("unable to validate dynamic config: %w", err)
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("unable to watch dynamic config directory: %w", err)
+	}
+	// Start watching before the initial load so that no change is missed in between.
+	if err := watcher.Add(dc.config.Dirpath); err != nil {
+		_ = watcher.Close()
+		return fmt.Errorf("unable to watch dynamic config directory: %s: %w", dc.config.Dirpath, err)
+	}
+	dc.watcher = watcher
+
+	entries, err := os.ReadDir(dc.config.Dirpath)
+	if err != nil {
+		_ = watcher.Close()
+		return fmt.Errorf("unable to read dynamic config directory: %s: %w", dc.config.Dirpath, err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !isDirConfigFile(entry.Name()) {
+			continue
+		}
+		if err := dc.loadFile(entry.Name()); err != nil {
+			dc.logger.Error("Unable to load dynamic config file, skipping it.", tag.Error(err))
+		}
+	}
+	dc.publish(dc.config.Dirpath)
+
+	go dc.watch()
+
+	return nil
+}
+
+func (dc *dirBasedClient) validateConfig(config *DirBasedClientConfig) error {
+	if config == nil {
+		return errors.New("configuration for dynamic config client is nil")
+	}
+	info, err := os.Stat(config.Dirpath)
+	if err != nil {
+		return fmt.Errorf("dynamic config: %s: %w", config.Dirpath, err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("dynamic config: %s is not a directory", config.Dirpath)
+	}
+	return nil
+}
+
+func (dc *dirBasedClient) watch() {
+	defer func() { _ = dc.watcher.Close() }()
+
+	for {
+		select {
+		case event, ok := <-dc.watcher.Events:
+			if !ok {
+				return
+			}
+			dc.handleEvent(event)
+		case err, ok := <-dc.watcher.Errors:
+			if !ok {
+				return
+			}
+			dc.logger.Error("Error watching dynamic config directory.", tag.Error(err))
+		case <-dc.doneCh:
+			return
+		}
+	}
+}
+
+func (dc *dirBasedClient) handleEvent(event fsnotify.Event) {
+	name := filepath.Base(event.Name)
+	if !isDirConfigFile(name) {
+		return
+	}
+
+	switch {
+	case event.Op&(fsnotify.Remove|fsnotify.Rename) != 0:
+		// Rename is reported for the old name, the new name gets a separate Create event.
+		if _, ok := dc.fileValues[name]; !ok {
+			return
+		}
+		delete(dc.fileValues, name)
+	case event.Op&(fsnotify.Create|fsnotify.Write) != 0:
+		if err := dc.loadFile(name); err != nil {
+			// Keep serving the last good values of this file until it is fixed.
+			dc.logger.Error("Rejected invalid dynamic config file.", tag.Error(err))
+			return
+		}
+	default:
+		return
+	}
+
+	dc.publish(name)
+}
+
+func (dc *dirBasedClient) loadFile(name string) error {
+	path := filepath.Join(dc.config.Dirpath, name)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("dynamic config file: %s: %w", path, err)
+	}
+	values, err := decodeConfigValues(content)
+	if err != nil {
+		return fmt.Errorf("dynamic config file: %s: %w", path, err)
+	}
+	if err := dc.checkDuplicates(name, values); err != nil {
+		return fmt.Errorf("dynamic config file: %s: %w", path, err)
+	}
+	dc.fileValues[name] = values
+	return nil
+}
+
+// checkDuplicates returns an error if a value of the file named name has the same key and
+// constraints as a value of another file, since it would be unclear which of them applies.
+func (dc *dirBasedClient) checkDuplicates(name string, values configValueMap) error {
+	otherNames := make([]string, 0, len(dc.fileValues))
+	for otherName := range dc.fileValues {
+		if otherName != name {
+			otherNames = append(otherNames, otherName)
+		}
+	}
+	sort.Strings(otherNames)
+
+	for key, cvs := range values {
+		for _, cv := range cvs {
+			for _, otherName := range otherNames {
+				for _, other := range dc.fileValues[otherName][key] {
+					if other.Constraints == cv.Constraints {
+						return fmt.Errorf("key %s with constraints %+v is already defined in %s", key, cv.Constraints, otherName)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// publish merges the values of all files and makes them visible to GetValue.
+// Files are merged in name order so that the result does not depend on load order.
+func (dc *dirBasedClient) publish(source string) {
+	names := make([]string, 0, len(dc.fileValues))
+	for name := range dc.fileValues {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	newValues := make(configValueMap)
+	for _, name := range names {
+		for key, cvs := range dc.fileValues[name] {
+			newValues[key] = append(newValues[key], cvs...)
+		}
+	}
+
+	prev := dc.values.Swap(newValues)
+	oldValues, _ := prev.(configValueMap)
+	diffConfigValues(oldValues, newValues, func(key string, oldValue *ConstrainedValue, newValue *ConstrainedValue) {
+		dc.auditValueDiff(source, key, oldValue, newValue)
+	})
+	dc.logger.Info("Updated dynamic config", tag.NewStringTag("dynamic-config-source", source))
+}
+
+// auditValueDiff emits a structured audit event for a single changed value.
+func (dc *dirBasedClient) auditValueDiff(source string, key string, oldValue *ConstrainedValue, newValue *ConstrainedValue) {
+	tags := []tag.Tag{
+		tag.NewStringTag("audit-event", "dynamic-config-changed"),
+		tag.NewStringTag("dynamic-config-source", source),
+		tag.Key(key),
+	}
+	if oldValue != nil {
+		tags = append(tags, tag.NewAnyTag("constraints", oldValue.Constraints), tag.NewAnyTag("old-value", oldValue.Value))
+	} else {
+		tags = append(tags, tag.NewAnyTag("constraints", newValue.Constraints))
+	}
+	if newValue != nil {
+		tags = append(tags, tag.NewAnyTag("new-value", newValue.Value))
+	}
+	dc.logger.Info("Dynamic config changed", tags...)
+}
+
+func isDirConfigFile(name string) bool {
+	if strings.HasPrefix(name, ".") {
+		return false
+	}
+	ext := filepath.Ext(name)
+	return ext == ".yaml" || ext == ".yml"
+}
@@ -0,0 +1,238 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/log"
+)
+
+type dirBasedClientSuite struct {
+	suite.Suite
+	*require.Assertions
+	dir    string
+	doneCh chan interface{}
+}
+
+func TestDirBasedClientSuite(t *testing.T) {
+	s := new(dirBasedClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *dirBasedClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.dir = s.T().TempDir()
+	s.doneCh = make(chan interface{})
+}
+
+func (s *dirBasedClientSuite) TearDownTest() {
+	close(s.doneCh)
+}
+
+func (s *dirBasedClientSuite) writeFile(name string, content string) {
+	s.NoError(os.WriteFile(filepath.Join(s.dir, name), []byte(content), fileMode))
+}
+
+func (s *dirBasedClientSuite) newClient() *dirBasedClient {
+	client, err := NewDirBasedClient(&DirBasedClientConfig{Dirpath: s.dir}, log.NewNoopLogger(), s.doneCh)
+	s.NoError(err)
+	return client
+}
+
+func (s *dirBasedClientSuite) TestValidateConfig_DirNotExist() {
+	_, err := NewDirBasedClient(&DirBasedClientConfig{Dirpath: filepath.Join(s.dir, "missing")}, log.NewNoopLogger(), s.doneCh)
+	s.Error(err)
+}
+
+func (s *dirBasedClientSuite) TestValidateConfig_NotDir() {
+	s.writeFile("global.yaml", "")
+	_, err := NewDirBasedClient(&DirBasedClientConfig{Dirpath: filepath.Join(s.dir, "global.yaml")}, log.NewNoopLogger(), s.doneCh)
+	s.Error(err)
+}
+
+func (s *dirBasedClientSuite) TestGetValue_MergedAcrossFiles() {
+	s.writeFile("global.yaml", `
+testGetBoolPropertyKey:
+- value: false
+  constraints: {}
+`)
+	s.writeFile("samples-namespace.yaml", `
+testGetBoolPropertyKey:
+- value: true
+  constraints:
+    namespace: samples-namespace
+`)
+	s.writeFile("README.md", "not a config file")
+	client := s.newClient()
+
+	s.ElementsMatch([]ConstrainedValue{
+		{Constraints: Constraints{}, Value: false},
+		{Constraints: Constraints{Namespace: "samples-namespace"}, Value: true},
+	}, client.GetValue(testGetBoolPropertyKey))
+
+	collection := NewCollection(client, log.NewNoopLogger())
+	s.True(collection.GetBoolPropertyFnWithNamespaceFilter(testGetBoolPropertyKey, false)("samples-namespace"))
+	s.False(collection.GetBoolPropertyFnWithNamespaceFilter(testGetBoolPropertyKey, true)("other-namespace"))
+}
+
+func (s *dirBasedClientSuite) TestInit_InvalidFileSkipped() {
+	s.writeFile("global.yaml", `
+testGetIntPropertyKey:
+- value: 1000
+  constraints: {}
+`)
+	s.writeFile("invalid.yaml", `
+testGetBoolPropertyKey:
+- value: true
+  constraints:
+    unknownConstraint: value
+`)
+	client := s.newClient()
+
+	s.Equal([]ConstrainedValue{{Value: 1000}}, client.GetValue(testGetIntPropertyKey))
+	s.Nil(client.GetValue(testGetBoolPropertyKey))
+}
+
+func (s *dirBasedClientSuite) TestUpdate_FileChanged() {
+	s.writeFile("global.yaml", `
+testGetIntPropertyKey:
+- value: 1000
+  constraints: {}
+`)
+	client := s.newClient()
+	s.Equal([]ConstrainedValue{{Value: 1000}}, client.GetValue(testGetIntPropertyKey))
+
+	s.writeFile("global.yaml", `
+testGetIntPropertyKey:
+- value: 2000
+  constraints: {}
+`)
+	s.Eventually(func() bool {
+		cvs := client.GetValue(testGetIntPropertyKey)
+		return len(cvs) == 1 && cvs[0].Value == 2000
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func (s *dirBasedClientSuite) TestUpdate_InvalidFileKeepsLastGoodValues() {
+	s.writeFile("global.yaml", `
+testGetIntPropertyKey:
+- value: 1000
+  constraints: {}
+`)
+	client := s.newClient()
+
+	s.writeFile("global.yaml", `
+testGetIntPropertyKey:
+- value: 2000
+  constraints:
+    shardId: not-a-number
+`)
+	s.writeFile("other.yaml", `
+testGetBoolPropertyKey:
+- value: true
+  constraints: {}
+`)
+	s.Eventually(func() bool {
+		return len(client.GetValue(testGetBoolPropertyKey)) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	s.Equal([]ConstrainedValue{{Value: 1000}}, client.GetValue(testGetIntPropertyKey))
+}
+
+func (s *dirBasedClientSuite) TestUpdate_FileRemoved() {
+	s.writeFile("global.yaml", `
+testGetIntPropertyKey:
+- value: 1000
+  constraints: {}
+`)
+	client := s.newClient()
+
+	s.NoError(os.Remove(filepath.Join(s.dir, "global.yaml")))
+	s.Eventually(func() bool {
+		return client.GetValue(testGetIntPropertyKey) == nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func (s *dirBasedClientSuite) TestInit_DuplicateValueRejected() {
+	s.writeFile("a.yaml", `
+testGetIntPropertyKey:
+- value: 1000
+  constraints:
+    namespace: samples-namespace
+`)
+	s.writeFile("b.yaml", `
+testGetBoolPropertyKey:
+- value: true
+  constraints: {}
+testGetIntPropertyKey:
+- value: 2000
+  constraints:
+    namespace: samples-namespace
+`)
+	client := s.newClient()
+
+	s.Equal([]ConstrainedValue{{Constraints: Constraints{Namespace: "samples-namespace"}, Value: 1000}}, client.GetValue(testGetIntPropertyKey))
+	s.Nil(client.GetValue(testGetBoolPropertyKey))
+}
+
+func (s *dirBasedClientSuite) TestUpdate_DuplicateValueKeepsLastGoodValues() {
+	s.writeFile("a.yaml", `
+testGetIntPropertyKey:
+- value: 1000
+  constraints: {}
+`)
+	s.writeFile("b.yaml", `
+testGetIntPropertyKey:
+- value: 2000
+  constraints:
+    namespace: samples-namespace
+`)
+	client := s.newClient()
+
+	// b.yaml now redefines the global value of a.yaml
+	s.writeFile("b.yaml", `
+testGetIntPropertyKey:
+- value: 3000
+  constraints: {}
+`)
+	s.writeFile("c.yaml", `
+testGetBoolPropertyKey:
+- value: true
+  constraints: {}
+`)
+	s.Eventually(func() bool {
+		return len(client.GetValue(testGetBoolPropertyKey)) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	s.ElementsMatch([]ConstrainedValue{
+		{Constraints: Constraints{}, Value: 1000},
+		{Constraints: Constraints{Namespace: "samples-namespace"}, Value: 2000},
+	}, client.GetValue(testGetIntPropertyKey))
+}
@@ -163,30 +163,9 @@ func (fc *fileBasedClient) update() error {
 		return fmt.Errorf("dynamic config file: %s: %w", fc.config.Filepath, err)
 	}
 
-	var yamlValues map[string][]struct {
-		Constraints map[string]any
-		Value       any
-	}
-	if err = yaml.Unmarshal(confContent, &yamlValues); err != nil {
-		return fmt.Errorf("unable to decode dynamic config: %w", err)
-	}
-
-	newValues := make(configValueMap, len(yamlValues))
-	for key, yamlCV := range yamlValues {
-		cvs := make([]ConstrainedValue, len(yamlCV))
-		for i, cv := range yamlCV {
-			// yaml will unmarshal map into map[interface{}]interface{} instead of map[string]interface{}
-			// manually convert key type to string for all values here
-			cvs[i].Value, err = convertKeyTypeToString(cv.Value)
-			if err != nil {
-				return err
-			}
-			cvs[i].Constraints, err = convertYamlConstraints(cv.Constraints)
-			if err != nil {
-				return err
-			}
-		}
-		newValues[strings.ToLower(key)] = cvs
+	newValues, err := decodeConfigValues(confContent)
+	if err != nil {
+		return err
 	}
 
 	prev := fc.values.Swap(newValues)
@@ -211,16 +190,97 @@ func (fc *fileBasedClient) validateConfig(config *FileBasedClientConfig) error {
 }
 
 func (fc *fileBasedClient) logDiff(old configValueMap, new configValueMap) {
+	diffConfigValues(old, new, fc.logValueDiff)
+}
+
+func (fc *fileBasedClient) logValueDiff(key string, oldValue *ConstrainedValue, newValue *ConstrainedValue) {
+	logLine := &strings.Builder{}
+	logLine.Grow(128)
+	logLine.WriteString("dynamic config changed for the key: ")
+	logLine.WriteString(key)
+	logLine.WriteString(" oldValue: ")
+	fc.appendConstrainedValue(logLine, oldValue)
+	logLine.WriteString(" newValue: ")
+	fc.appendConstrainedValue(logLine, newValue)
+	fc.logger.Info(logLine.String())
+}
+
+func (fc *fileBasedClient) appendConstrainedValue(logLine *strings.Builder, value *ConstrainedValue) {
+	if value == nil {
+		logLine.WriteString("nil")
+	} else {
+		logLine.WriteString("{ constraints: {")
+		if value.Constraints.Namespace != "" {
+			logLine.WriteString(fmt.Sprintf("{Namespace:%s}", value.Constraints.Namespace))
+		}
+		if value.Constraints.NamespaceID != "" {
+			logLine.WriteString(fmt.Sprintf("{NamespaceID:%s}", value.Constraints.NamespaceID))
+		}
+		if value.Constraints.TaskQueueName != "" {
+			logLine.WriteString(fmt.Sprintf("{TaskQueueName:%s}", value.Constraints.TaskQueueName))
+		}
+		if value.Constraints.TaskQueueType != enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+			logLine.WriteString(fmt.Sprintf("{TaskQueueType:%s}", value.Constraints.TaskQueueType))
+		}
+		if value.Constraints.ShardID != 0 {
+			logLine.WriteString(fmt.Sprintf("{ShardID:%d}", value.Constraints.ShardID))
+		}
+		if value.Constraints.TaskType != enumsspb.TASK_TYPE_UNSPECIFIED {
+			logLine.WriteString(fmt.Sprintf("{HistoryTaskType:%s}", value.Constraints.TaskType))
+		}
+		logLine.WriteString(fmt.Sprint("} value: ", value.Value, " }"))
+	}
+}
+
+// decodeConfigValues decodes the yaml content of a dynamic config file.
+func decodeConfigValues(content []byte) (configValueMap, error) {
+	var yamlValues map[string][]struct {
+		Constraints map[string]any
+		Value       any
+	}
+	if err := yaml.Unmarshal(content, &yamlValues); err != nil {
+		return nil, fmt.Errorf("unable to decode dynamic config: %w", err)
+	}
+
+	values := make(configValueMap, len(yamlValues))
+	for key, yamlCV := range yamlValues {
+		cvs := make([]ConstrainedValue, len(yamlCV))
+		for i, cv := range yamlCV {
+			var err error
+			// yaml will unmarshal map into map[interface{}]interface{} instead of map[string]interface{}
+			// manually convert key type to string for all values here
+			cvs[i].Value, err = convertKeyTypeToString(cv.Value)
+			if err != nil {
+				return nil, err
+			}
+			cvs[i].Constraints, err = convertYamlConstraints(cv.Constraints)
+			if err != nil {
+				return nil, err
+			}
+		}
+		values[strings.ToLower(key)] = cvs
+	}
+	return values, nil
+}
+
+// diffConfigValues calls onDiff for every constrained value that was added, removed or changed
+// between old and new. oldValue is nil for added values and newValue is nil for removed ones.
+func diffConfigValues(
+	old configValueMap,
+	new configValueMap,
+	onDiff func(key string, oldValue *ConstrainedValue, newValue *ConstrainedValue),
+) {
 	for key, newValues := range new {
 		oldValues, ok := old[key]
 		if !ok {
 			for _, newValue := range newValues {
+				newValue := newValue
 				// new key added
-				fc.logValueDiff(key, nil, &newValue)
+				onDiff(key, nil, &newValue)
 			}
 		} else {
 			// compare existing keys
-			fc.logConstraintsDiff(key, oldValues, newValues)
+			diffConstraints(key, oldValues, newValues, onDiff)
 		}
 	}
 
@@ -228,29 +288,38 @@ func (fc *fileBasedClient) logDiff(old configValueMap, new configValueMap) {
 	for key, oldValues := range old {
 		if _, ok := new[key]; !ok {
 			for _, oldValue := range oldValues {
-				fc.logValueDiff(key, &oldValue, nil)
+				oldValue := oldValue
+				onDiff(key, &oldValue, nil)
 			}
 		}
 	}
 }
 
-func (fc *fileBasedClient) logConstraintsDiff(key string, oldValues []ConstrainedValue, newValues []ConstrainedValue) {
+func diffConstraints(
+	key string,
+	oldValues []ConstrainedValue,
+	newValues []ConstrainedValue,
+	onDiff func(key string, oldValue *ConstrainedValue, newValue *ConstrainedValue),
+) {
 	for _, oldValue := range oldValues {
+		oldValue := oldValue
 		matchFound := false
 		for _, newValue := range newValues {
+			newValue := newValue
 			if oldValue.Constraints == newValue.Constraints {
 				matchFound = true
 				if !reflect.DeepEqual(oldValue.Value, newValue.Value) {
-					fc.logValueDiff(key, &oldValue, &newValue)
+					onDiff(key, &oldValue, &newValue)
 				}
 			}
 		}
 		if !matchFound {
-			fc.logValueDiff(key, &oldValue, nil)
+			onDiff(key, &oldValue, nil)
 		}
 	}
 
 	for _, newValue := range newValues {
+		newValue := newValue
 		matchFound := false
 		for _, oldValue := range oldValues {
 			if oldValue.Constraints == newValue.Constraints {
@@ -258,47 +327,8 @@ func (fc *fileBasedClient) logConstraintsDiff(key string, oldValues []Constraine
 			}
 		}
 		if !matchFound {
-			fc.logValueDiff(key, nil, &newValue)
-		}
-	}
-}
-
-func (fc *fileBasedClient) logValueDiff(key string, oldValue *ConstrainedValue, newValue *ConstrainedValue) {
-	logLine := &strings.Builder{}
-	logLine.Grow(128)
-	logLine.WriteString("dynamic config changed for the key: ")
-	logLine.WriteString(key)
-	logLine.WriteString(" oldValue: ")
-	fc.appendConstrainedValue(logLine, oldValue)
-	logLine.WriteString(" newValue: ")
-	fc.appendConstrainedValue(logLine, newValue)
-	fc.logger.Info(logLine.String())
-}
-
-func (fc *fileBasedClient) appendConstrainedValue(logLine *strings.Builder, value *ConstrainedValue) {
-	if value == nil {
-		logLine.WriteString("nil")
-	} else {
-		logLine.WriteString("{ constraints: {")
-		if value.Constraints.Namespace != "" {
-			logLine.WriteString(fmt.Sprintf("{Namespace:%s}", value.Constraints.Namespace))
-		}
-		if value.Constraints.NamespaceID != "" {
-			logLine.WriteString(fmt.Sprintf("{NamespaceID:%s}", value.Constraints.NamespaceID))
-		}
-		if value.Constraints.TaskQueueName != "" {
-			logLine.WriteString(fmt.Sprintf("{TaskQueueName:%s}", value.Constraints.TaskQueueName))
-		}
-		if value.Constraints.TaskQueueType != enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
-			logLine.WriteString(fmt.Sprintf("{TaskQueueType:%s}", value.Constraints.TaskQueueType))
+			onDiff(key, nil, &newValue)
 		}
-		if value.Constraints.ShardID != 0 {
-			logLine.WriteString(fmt.Sprintf("{ShardID:%d}", value.Constraints.ShardID))
-		}
-		if value.Constraints.TaskType != enumsspb.TASK_TYPE_UNSPECIFIED {
-			logLine.WriteString(fmt.Sprintf("{HistoryTaskType:%s}", value.Constraints.TaskType))
-		}
-		logLine.WriteString(fmt.Sprint("} value: ", value.Value, " }"))
 	}
 }
 
@@ -0,0 +1,64 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate go run ../../cmd/tools/dynamicconfigkeys -input constants.go -output keys_gen.go
+
+package dynamicconfig
+
+import (
+	"sort"
+	"strings"
+)
+
+type registeredKey struct {
+	key         Key
+	description string
+}
+
+// keysByName indexes the keys declared in constants.go by their lower-cased name.
+var keysByName = func() map[string]registeredKey {
+	m := make(map[string]registeredKey, len(registeredKeys))
+	for _, k := range registeredKeys {
+		m[strings.ToLower(k.key.String())] = k
+	}
+	return m
+}()
+
+// RegisteredKeys returns all the keys the server reads, sorted by name.
+func RegisteredKeys() []Key {
+	keys := make([]Key, 0, len(registeredKeys))
+	for _, k := range registeredKeys {
+		keys = append(keys, k.key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
+// LookupKey returns the registered key matching name, ignoring case, and its description.
+func LookupKey(name string) (key Key, description string, ok bool) {
+	k, ok := keysByName[strings.ToLower(name)]
+	return k.key, k.description, ok
+}
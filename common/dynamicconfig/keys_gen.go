@@ -0,0 +1,358 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by cmd/tools/dynamicconfigkeys. DO NOT EDIT.
+
+package dynamicconfig
+
+var registeredKeys = []registeredKey{
+	{key: AdminMatchingNamespaceToPartitionDispatchRate, description: "AdminMatchingNamespaceToPartitionDispatchRate is the max qps of any task queue partition for a given namespace"},
+	{key: AdminMatchingNamespaceTaskqueueToPartitionDispatchRate, description: "AdminMatchingNamespaceTaskqueueToPartitionDispatchRate is the max qps of a task queue partition for a given namespace & task queue"},
+	{key: StandardVisibilityPersistenceMaxReadQPS, description: "StandardVisibilityPersistenceMaxReadQPS is the max QPC system host can query standard visibility DB (SQL or Cassandra) for read."},
+	{key: StandardVisibilityPersistenceMaxWriteQPS, description: "StandardVisibilityPersistenceMaxWriteQPS is the max QPC system host can query standard visibility DB (SQL or Cassandra) for write."},
+	{key: AdvancedVisibilityPersistenceMaxReadQPS, description: "AdvancedVisibilityPersistenceMaxReadQPS is the max QPC system host can query advanced visibility DB (Elasticsearch) for read."},
+	{key: AdvancedVisibilityPersistenceMaxWriteQPS, description: "AdvancedVisibilityPersistenceMaxWriteQPS is the max QPC system host can query advanced visibility DB (Elasticsearch) for write."},
+	{key: AdvancedVisibilityWritingMode, description: "AdvancedVisibilityWritingMode is key for how to write to advanced visibility"},
+	{key: EnableWriteToSecondaryAdvancedVisibility, description: "EnableWriteToSecondaryAdvancedVisibility is the config to enable write to secondary visibility for Elasticsearch"},
+	{key: EnableReadVisibilityFromES, description: "EnableReadVisibilityFromES is key for enable read from Elasticsearch"},
+	{key: EnableReadFromSecondaryAdvancedVisibility, description: "EnableReadFromSecondaryAdvancedVisibility is the config to enable read from secondary Elasticsearch"},
+	{key: VisibilityDisableOrderByClause, description: "VisibilityDisableOrderByClause is the config to disable ORDERY BY clause for Elasticsearch"},
+	{key: HistoryArchivalState, description: "HistoryArchivalState is key for the state of history archival"},
+	{key: EnableReadFromHistoryArchival, description: "EnableReadFromHistoryArchival is key for enabling reading history from archival store"},
+	{key: VisibilityArchivalState, description: "VisibilityArchivalState is key for the state of visibility archival"},
+	{key: EnableReadFromVisibilityArchival, description: "EnableReadFromVisibilityArchival is key for enabling reading visibility from archival store"},
+	{key: EnableNamespaceNotActiveAutoForwarding, description: "EnableNamespaceNotActiveAutoForwarding whether enabling DC auto forwarding to active cluster for signal / start / signal with start API if namespace is not active"},
+	{key: TransactionSizeLimit, description: "TransactionSizeLimit is the largest allowed transaction size to persistence"},
+	{key: DisallowQuery, description: "DisallowQuery is the key to disallow query for a namespace"},
+	{key: EnableAuthorization, description: "EnableAuthorization is the key to enable authorization for a namespace"},
+	{key: EnableCrossNamespaceCommands, description: "EnableCrossNamespaceCommands is the key to enable commands for external namespaces"},
+	{key: ClusterMetadataRefreshInterval, description: "ClusterMetadataRefreshInterval is config to manage cluster metadata table refresh interval"},
+	{key: ForceSearchAttributesCacheRefreshOnRead, description: "ForceSearchAttributesCacheRefreshOnRead forces refreshing search attributes cache on a read operation, so we always get the latest data from DB. This effectively bypasses cache value and is used to facilitate testing of changes in search attributes. This should not be turned on in production."},
+	{key: EnableRingpopTLS, description: ""},
+	{key: EnableParentClosePolicyWorker, description: "EnableParentClosePolicyWorker decides whether or not enable system workers for processing parent close policy task"},
+	{key: EnableStickyQuery, description: "EnableStickyQuery indicates if sticky query should be enabled per namespace"},
+	{key: EnableActivityEagerExecution, description: "EnableActivityEagerExecution indicates if acitivty eager execution is enabled per namespace"},
+	{key: NamespaceCacheRefreshInterval, description: "NamespaceCacheRefreshInterval is the key for namespace cache refresh interval dynamic config"},
+	{key: DeadlockDumpGoroutines, description: "Whether the deadlock detector should dump goroutines"},
+	{key: DeadlockFailHealthCheck, description: "Whether the deadlock detector should cause the grpc server to fail health checks"},
+	{key: DeadlockAbortProcess, description: "Whether the deadlock detector should abort the process"},
+	{key: DeadlockInterval, description: "How often the detector checks each root."},
+	{key: DeadlockMaxWorkersPerRoot, description: "How many extra goroutines can be created per root."},
+	{key: PersistenceFaultInjectionRules, description: "PersistenceFaultInjectionRules is a map of named rules that inject errors and latency into persistence calls. Each rule may target a method, namespace (name or ID) and workflow ID. Meant for chaos testing only. Rules only take effect when fault injection is enabled in the static persistence config."},
+	{key: BlobSizeLimitError, description: "BlobSizeLimitError is the per event blob size limit"},
+	{key: BlobSizeLimitWarn, description: "BlobSizeLimitWarn is the per event blob size limit for warning"},
+	{key: MemoSizeLimitError, description: "MemoSizeLimitError is the per event memo size limit"},
+	{key: MemoSizeLimitWarn, description: "MemoSizeLimitWarn is the per event memo size limit for warning"},
+	{key: PayloadPolicyAllowedEncodings, description: "PayloadPolicyAllowedEncodings is a comma separated list of payload encodings (e.g. binary/encrypted) accepted by a namespace. Empty accepts any encoding. Search attributes and headers are not subject to it."},
+	{key: PayloadPolicyMemoSizeLimit, description: "PayloadPolicyMemoSizeLimit is the memo size limit enforced by the payload policy, 0 to disable"},
+	{key: PayloadPolicyHeaderSizeLimit, description: "PayloadPolicyHeaderSizeLimit is the header size limit enforced by the payload policy, 0 to disable"},
+	{key: NumPendingChildExecutionsLimitError, description: "NumPendingChildExecutionsLimitError is the maximum number of pending child workflows a workflow can have before StartChildWorkflowExecution commands will fail."},
+	{key: NumPendingActivitiesLimitError, description: "NumPendingActivitiesLimitError is the maximum number of pending activities a workflow can have before ScheduleActivityTask will fail."},
+	{key: NumPendingSignalsLimitError, description: "NumPendingSignalsLimitError is the maximum number of pending signals a workflow can have before SignalExternalWorkflowExecution commands from this workflow will fail."},
+	{key: NumPendingCancelRequestsLimitError, description: "NumPendingCancelRequestsLimitError is the maximum number of pending requests to cancel other workflows a workflow can have before RequestCancelExternalWorkflowExecution commands will fail."},
+	{key: HistorySizeLimitError, description: "HistorySizeLimitError is the per workflow execution history size limit"},
+	{key: HistorySizeLimitWarn, description: "HistorySizeLimitWarn is the per workflow execution history size limit for warning"},
+	{key: HistoryCountLimitError, description: "HistoryCountLimitError is the per workflow execution history event count limit"},
+	{key: HistoryCountLimitWarn, description: "HistoryCountLimitWarn is the per workflow execution history event count limit for warning"},
+	{key: MaxIDLengthLimit, description: "MaxIDLengthLimit is the length limit for various IDs, including: Namespace, TaskQueue, WorkflowID, ActivityID, TimerID, WorkflowType, ActivityType, SignalName, MarkerName, ErrorReason/FailureReason/CancelCause, Identity, RequestID"},
+	{key: WorkerBuildIdSizeLimit, description: "WorkerBuildIdSizeLimit is the byte length limit for a worker build id as used in the rpc methods for updating the version graph for a task queue"},
+	{key: VersionGraphNodeLimit, description: "VersionGraphNodeLimit is the max number of nodes allowed in the version graph for a task queue. Update requests which would cause the graph size to exceed this number will result in the oldest versions being dropped."},
+	{key: FrontendPersistenceMaxQPS, description: "FrontendPersistenceMaxQPS is the max qps frontend host can query DB"},
+	{key: FrontendPersistenceGlobalMaxQPS, description: "FrontendPersistenceGlobalMaxQPS is the max qps frontend cluster can query DB"},
+	{key: FrontendPersistenceNamespaceMaxQPS, description: "FrontendPersistenceNamespaceMaxQPS is the max qps each namespace on frontend host can query DB"},
+	{key: FrontendEnablePersistencePriorityRateLimiting, description: "FrontendEnablePersistencePriorityRateLimiting indicates if priority rate limiting is enabled in frontend persistence client"},
+	{key: FrontendVisibilityMaxPageSize, description: "FrontendVisibilityMaxPageSize is default max size for ListWorkflowExecutions in one page"},
+	{key: FrontendESIndexMaxResultWindow, description: "FrontendESIndexMaxResultWindow is ElasticSearch index setting max_result_window"},
+	{key: FrontendHistoryMaxPageSize, description: "FrontendHistoryMaxPageSize is default max size for GetWorkflowExecutionHistory in one page"},
+	{key: FrontendRPS, description: "FrontendRPS is workflow rate limit per second"},
+	{key: FrontendMaxNamespaceRPSPerInstance, description: "FrontendMaxNamespaceRPSPerInstance is workflow namespace rate limit per second"},
+	{key: FrontendMaxNamespaceBurstPerInstance, description: "FrontendMaxNamespaceBurstPerInstance is workflow namespace burst limit"},
+	{key: FrontendMaxNamespaceCountPerInstance, description: "FrontendMaxNamespaceCountPerInstance is workflow namespace count limit per second"},
+	{key: FrontendMaxNamespaceVisibilityRPSPerInstance, description: "FrontendMaxNamespaceVisibilityRPSPerInstance is namespace rate limit per second for visibility APIs. This config is EXPERIMENTAL and may be changed or removed in a later release."},
+	{key: FrontendMaxNamespaceVisibilityBurstPerInstance, description: "FrontendMaxNamespaceVisibilityBurstPerInstance is namespace burst limit for visibility APIs. This config is EXPERIMENTAL and may be changed or removed in a later release."},
+	{key: FrontendGlobalNamespaceRPS, description: "FrontendGlobalNamespaceRPS is workflow namespace rate limit per second for the whole cluster. The limit is evenly distributed among available frontend service instances. If this is set, it overwrites per instance limit \"frontend.namespaceRPS\"."},
+	{key: FrontendGlobalNamespaceVisibilityRPS, description: "FrontendGlobalNamespaceVisibilityRPS is workflow namespace rate limit per second for the whole cluster for visibility API. The limit is evenly distributed among available frontend service instances. If this is set, it overwrites per instance limit \"frontend.namespaceRPS.visibility\". This config is EXPERIMENTAL and may be changed or removed in a later release."},
+	{key: FrontendThrottledLogRPS, description: "FrontendThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger"},
+	{key: FrontendShutdownDrainDuration, description: "FrontendShutdownDrainDuration is the duration of traffic drain during shutdown"},
+	{key: FrontendMaxBadBinaries, description: "FrontendMaxBadBinaries is the max number of bad binaries in namespace config"},
+	{key: SendRawWorkflowHistory, description: "SendRawWorkflowHistory is whether to enable raw history retrieving"},
+	{key: SearchAttributesNumberOfKeysLimit, description: "SearchAttributesNumberOfKeysLimit is the limit of number of keys"},
+	{key: SearchAttributesSizeOfValueLimit, description: "SearchAttributesSizeOfValueLimit is the size limit of each value"},
+	{key: SearchAttributesTotalSizeLimit, description: "SearchAttributesTotalSizeLimit is the size limit of the whole map"},
+	{key: VisibilityArchivalQueryMaxPageSize, description: "VisibilityArchivalQueryMaxPageSize is the maximum page size for a visibility archival query"},
+	{key: VisibilityArchivalQueryMaxRangeInDays, description: "VisibilityArchivalQueryMaxRangeInDays is the maximum number of days for a visibility archival query"},
+	{key: VisibilityArchivalQueryMaxQPS, description: "VisibilityArchivalQueryMaxQPS is the timeout for a visibility archival query"},
+	{key: EnableServerVersionCheck, description: "EnableServerVersionCheck is a flag that controls whether or not periodic version checking is enabled"},
+	{key: EnableTokenNamespaceEnforcement, description: "EnableTokenNamespaceEnforcement enables enforcement that namespace in completion token matches namespace of the request"},
+	{key: DisableListVisibilityByFilter, description: "DisableListVisibilityByFilter is config to disable list open/close workflow using filter"},
+	{key: KeepAliveMinTime, description: "KeepAliveMinTime is the minimum amount of time a client should wait before sending a keepalive ping."},
+	{key: KeepAlivePermitWithoutStream, description: "KeepAlivePermitWithoutStream If true, server allows keepalive pings even when there are no active streams(RPCs). If false, and client sends ping when there are no active streams, server will send GOAWAY and close the connection."},
+	{key: KeepAliveMaxConnectionIdle, description: "KeepAliveMaxConnectionIdle is a duration for the amount of time after which an idle connection would be closed by sending a GoAway. Idleness duration is defined since the most recent time the number of outstanding RPCs became zero or the connection establishment."},
+	{key: KeepAliveMaxConnectionAge, description: "KeepAliveMaxConnectionAge is a duration for the maximum amount of time a connection may exist before it will be closed by sending a GoAway. A random jitter of +/-10% will be added to MaxConnectionAge to spread out connection storms."},
+	{key: KeepAliveMaxConnectionAgeGrace, description: "KeepAliveMaxConnectionAgeGrace is an additive period after MaxConnectionAge after which the connection will be forcibly closed."},
+	{key: KeepAliveTime, description: "KeepAliveTime After a duration of this time if the server doesn't see any activity it pings the client to see if the transport is still alive. If set below 1s, a minimum value of 1s will be used instead."},
+	{key: KeepAliveTimeout, description: "KeepAliveTimeout After having pinged for keepalive check, the server waits for a duration of Timeout and if no activity is seen even after that the connection is closed."},
+	{key: FrontendEnableSchedules, description: "FrontendEnableSchedules enables schedule-related RPCs in the frontend"},
+	{key: FrontendMaxConcurrentBatchOperationPerNamespace, description: "FrontendMaxConcurrentBatchOperationPerNamespace is the max concurrent batch operation job count per namespace"},
+	{key: FrontendEnableBatcher, description: "FrontendEnableBatcher enables batcher-related RPCs in the frontend"},
+	{key: DeleteNamespaceDeleteActivityRPS, description: "DeleteNamespaceDeleteActivityRPS is an RPS per every parallel delete executions activity. Total RPS is equal to DeleteNamespaceDeleteActivityRPS * DeleteNamespaceConcurrentDeleteExecutionsActivities. Default value is 100."},
+	{key: DeleteNamespacePageSize, description: "DeleteNamespacePageSize is a page size to read executions from visibility for delete executions activity. Default value is 1000."},
+	{key: DeleteNamespacePagesPerExecution, description: "DeleteNamespacePagesPerExecution is a number of pages before returning ContinueAsNew from delete executions activity. Default value is 256."},
+	{key: DeleteNamespaceConcurrentDeleteExecutionsActivities, description: "DeleteNamespaceConcurrentDeleteExecutionsActivities is a number of concurrent delete executions activities. Must be not greater than 256 and number of worker cores in the cluster. Default is 4."},
+	{key: DeleteNamespaceNamespaceDeleteDelay, description: "DeleteNamespaceNamespaceDeleteDelay is a duration for how long namespace stays in database after all namespace resources (i.e. workflow executions) are deleted. Default is 0, means, namespace will be deleted immediately."},
+	{key: MatchingRPS, description: "MatchingRPS is request rate per second for each matching host"},
+	{key: MatchingPersistenceMaxQPS, description: "MatchingPersistenceMaxQPS is the max qps matching host can query DB"},
+	{key: MatchingPersistenceGlobalMaxQPS, description: "MatchingPersistenceGlobalMaxQPS is the max qps matching cluster can query DB"},
+	{key: MatchingPersistenceNamespaceMaxQPS, description: "MatchingPersistenceNamespaceMaxQPS is the max qps each namespace on matching host can query DB"},
+	{key: MatchingEnablePersistencePriorityRateLimiting, description: "MatchingEnablePersistencePriorityRateLimiting indicates if priority rate limiting is enabled in matching persistence client"},
+	{key: MatchingMinTaskThrottlingBurstSize, description: "MatchingMinTaskThrottlingBurstSize is the minimum burst size for task queue throttling"},
+	{key: MatchingGetTasksBatchSize, description: "MatchingGetTasksBatchSize is the maximum batch size to fetch from the task buffer. It also bounds the backlog tasks of each task priority level held in memory per partition, which are the only backlog tasks that fairness can reorder"},
+	{key: MatchingLongPollExpirationInterval, description: "MatchingLongPollExpirationInterval is the long poll expiration interval in the matching service"},
+	{key: MatchingSyncMatchWaitDuration, description: "MatchingSyncMatchWaitDuration is to wait time for sync match"},
+	{key: MatchingUpdateAckInterval, description: "MatchingUpdateAckInterval is the interval for update ack"},
+	{key: MatchingIdleTaskqueueCheckInterval, description: "MatchingIdleTaskqueueCheckInterval is the IdleTaskqueueCheckInterval"},
+	{key: MaxTaskqueueIdleTime, description: "MaxTaskqueueIdleTime is the max time taskqueue being idle"},
+	{key: MatchingOutstandingTaskAppendsThreshold, description: "MatchingOutstandingTaskAppendsThreshold is the threshold for outstanding task appends"},
+	{key: MatchingMaxTaskBatchSize, description: "MatchingMaxTaskBatchSize is max batch size for task writer"},
+	{key: MatchingMaxTaskDeleteBatchSize, description: "MatchingMaxTaskDeleteBatchSize is the max batch size for range deletion of tasks"},
+	{key: MatchingThrottledLogRPS, description: "MatchingThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger"},
+	{key: MatchingNumTaskqueueWritePartitions, description: "MatchingNumTaskqueueWritePartitions is the number of write partitions for a task queue"},
+	{key: MatchingNumTaskqueueReadPartitions, description: "MatchingNumTaskqueueReadPartitions is the number of read partitions for a task queue"},
+	{key: MatchingEnablePartitionAutoscaling, description: "MatchingEnablePartitionAutoscaling enables the root partition of a task queue to grow and shrink the number of active partitions based on observed load. The configured numbers of write and read partitions become the upper bound."},
+	{key: MatchingPartitionAutoscalingTargetRate, description: "MatchingPartitionAutoscalingTargetRate is the add rate (tasks per second) a single partition should handle before partition autoscaling adds another partition"},
+	{key: MatchingPartitionAutoscalingInterval, description: "MatchingPartitionAutoscalingInterval is how often the root partition re-evaluates the number of active partitions, and how often non-root partitions refresh it from the root partition"},
+	{key: MatchingPartitionAutoscalingScaleDownDelay, description: "MatchingPartitionAutoscalingScaleDownDelay is how long the load must stay low before partition autoscaling retires partitions"},
+	{key: MatchingPriorityStarvationInterval, description: "MatchingPriorityStarvationInterval is how often (every Nth dispatch) lower priority tasks are considered ahead of higher priority tasks, so that low priority work is not starved"},
+	{key: MatchingFairnessKeyWeights, description: "MatchingFairnessKeyWeights is a map from fairness key to the number of backlog tasks with that key dispatched per round-robin turn. Round-robin only covers the backlog tasks read ahead into memory, see MatchingGetTasksBatchSize, so it interleaves keys but does not keep a key with a larger backlog from delaying the others. Keys that are not in the map have a weight of 1. Only keys in the map get their own task_backlog_per_fairness_key series, the others are reported together"},
+	{key: MatchingForwarderMaxOutstandingPolls, description: "MatchingForwarderMaxOutstandingPolls is the max number of inflight polls from the forwarder"},
+	{key: MatchingForwarderMaxOutstandingTasks, description: "MatchingForwarderMaxOutstandingTasks is the max number of inflight addTask/queryTask from the forwarder"},
+	{key: MatchingForwarderMaxRatePerSecond, description: "MatchingForwarderMaxRatePerSecond is the max rate at which add/query can be forwarded"},
+	{key: MatchingForwarderMaxChildrenPerNode, description: "MatchingForwarderMaxChildrenPerNode is the max number of children per node in the task queue partition tree"},
+	{key: MatchingShutdownDrainDuration, description: "MatchingShutdownDrainDuration is the duration of traffic drain during shutdown"},
+	{key: MatchingMetadataPollFrequency, description: "MatchingMetadataPollFrequency is how often non-root partitions will poll the root partition for fresh metadata"},
+	{key: MatchingUseOldRouting, description: "MatchingUseOldRouting is whether to use old task queue routing (name only) instead of namespace+name+type."},
+	{key: HistoryRPS, description: "HistoryRPS is request rate per second for each history host"},
+	{key: HistoryPersistenceMaxQPS, description: "HistoryPersistenceMaxQPS is the max qps history host can query DB"},
+	{key: HistoryPersistenceGlobalMaxQPS, description: "HistoryPersistenceGlobalMaxQPS is the max qps history cluster can query DB"},
+	{key: HistoryPersistenceNamespaceMaxQPS, description: "HistoryPersistenceNamespaceMaxQPS is the max qps each namespace on history host can query DB If value less or equal to 0, will fall back to HistoryPersistenceMaxQPS"},
+	{key: HistoryEnablePersistencePriorityRateLimiting, description: "HistoryEnablePersistencePriorityRateLimiting indicates if priority rate limiting is enabled in history persistence client"},
+	{key: HistoryLongPollExpirationInterval, description: "HistoryLongPollExpirationInterval is the long poll expiration interval in the history service"},
+	{key: HistoryCacheInitialSize, description: "HistoryCacheInitialSize is initial size of history cache"},
+	{key: HistoryCacheMaxSize, description: "HistoryCacheMaxSize is max size of history cache"},
+	{key: HistoryCacheTTL, description: "HistoryCacheTTL is TTL of history cache"},
+	{key: HistoryShutdownDrainDuration, description: "HistoryShutdownDrainDuration is the duration of traffic drain during shutdown"},
+	{key: EventsCacheInitialSize, description: "EventsCacheInitialSize is initial size of events cache"},
+	{key: EventsCacheMaxSize, description: "EventsCacheMaxSize is max size of events cache"},
+	{key: EventsCacheTTL, description: "EventsCacheTTL is TTL of events cache"},
+	{key: AcquireShardInterval, description: "AcquireShardInterval is interval that timer used to acquire shard"},
+	{key: AcquireShardConcurrency, description: "AcquireShardConcurrency is number of goroutines that can be used to acquire shards in the shard controller."},
+	{key: ShardHandoffEnabled, description: "ShardHandoffEnabled controls whether the shard controller hands off its shards to their new owners when the host is shutting down, instead of simply releasing them."},
+	{key: ShardHandoffConcurrency, description: "ShardHandoffConcurrency is number of goroutines that can be used to hand off shards in the shard controller."},
+	{key: ShardHandoffTimeout, description: "ShardHandoffTimeout is the timeout for handing off a single shard, including the time the new owner spends prewarming its caches"},
+	{key: ShardHandoffPrewarmWorkflowCount, description: "ShardHandoffPrewarmWorkflowCount is the max number of recently used workflows of a shard the new owner prewarms its caches with during a shard handoff"},
+	{key: ShardOwnershipOverrideRefreshInterval, description: "ShardOwnershipOverrideRefreshInterval is the interval at which every host reloads the shard ownership overrides set by the MoveShard admin API. History hosts also reload them when they see a request for a shard they do not think they own."},
+	{key: ShardLoadWindow, description: "ShardLoadWindow is the window over which the request rates and busiest workflows of a shard are reported"},
+	{key: ShardLoadMaxTrackedWorkflows, description: "ShardLoadMaxTrackedWorkflows is the max number of workflows per shard whose requests are counted in a window"},
+	{key: ShardLoadTopWorkflowCount, description: "ShardLoadTopWorkflowCount is the number of busiest workflows reported per shard by DescribeHistoryHost"},
+	{key: HotWorkflowReportInterval, description: "HotWorkflowReportInterval is the interval at which the busiest workflows of a history host are reported"},
+	{key: HotWorkflowRequestThreshold, description: "HotWorkflowRequestThreshold is the min number of requests within the shard load window for a workflow to be reported as hot. Zero disables the report"},
+	{key: WorkflowRPS, description: "WorkflowRPS is the max request rate per second for a single workflow on signal, query, update and other externally initiated APIs. Zero disables the limit"},
+	{key: WorkflowRateLimiterCacheSize, description: "WorkflowRateLimiterCacheSize is the max number of workflows a history host keeps rate limiters for"},
+	{key: StandbyClusterDelay, description: "StandbyClusterDelay is the artificial delay added to standby cluster's view of active cluster's time"},
+	{key: StandbyTaskMissingEventsResendDelay, description: "StandbyTaskMissingEventsResendDelay is the amount of time standby cluster's will wait (if events are missing) before calling remote for missing events"},
+	{key: StandbyTaskMissingEventsDiscardDelay, description: "StandbyTaskMissingEventsDiscardDelay is the amount of time standby cluster's will wait (if events are missing) before discarding the task"},
+	{key: QueuePendingTaskCriticalCount, description: "QueuePendingTaskCriticalCount is the max number of pending task in one queue before triggering queue slice splitting and unloading"},
+	{key: QueueReaderStuckCriticalAttempts, description: "QueueReaderStuckCriticalAttempts is the max number of task loading attempts for a certain task range before that task range is split into a separate slice to unblock loading for later range. currently only work for scheduled queues and the task range is 1s."},
+	{key: QueueCriticalSlicesCount, description: "QueueCriticalSlicesCount is the max number of slices in one queue before force compacting slices"},
+	{key: QueuePendingTaskMaxCount, description: "QueuePendingTaskMaxCount is the max number of task pending tasks in one queue before stop loading new tasks into memory. While QueuePendingTaskCriticalCount won't stop task loading for the entire queue but only trigger a queue action to unload tasks. Ideally this max count limit should not be hit and task unloading should happen once critical count is exceeded. But since queue action is async, we need this hard limit."},
+	{key: QueueMaxReaderCount, description: "QueueMaxReaderCount is the max number of readers in one multi-cursor queue"},
+	{key: ContinueAsNewMinInterval, description: "ContinueAsNewMinInterval is the minimal interval between continue_as_new executions. This is needed to prevent tight loop continue_as_new spin. Default is 1s."},
+	{key: TaskSchedulerEnableRateLimiter, description: "TaskSchedulerEnableRateLimiter indicates if rate limiter should be enabled in task scheduler"},
+	{key: TaskSchedulerMaxQPS, description: "TaskSchedulerMaxQPS is the max qps task schedulers on a host can schedule tasks If value less or equal to 0, will fall back to HistoryPersistenceMaxQPS"},
+	{key: TaskSchedulerNamespaceMaxQPS, description: "TaskSchedulerNamespaceMaxQPS is the max qps task schedulers on a host can schedule tasks for a certain namespace If value less or equal to 0, will fall back to HistoryPersistenceNamespaceMaxQPS"},
+	{key: TaskSchedulerNamespaceWeight, description: "TaskSchedulerNamespaceWeight is the round robin weight of a namespace in host level task schedulers. Task channel weight is the priority weight multiplied by the namespace weight."},
+	{key: TimerTaskBatchSize, description: "TimerTaskBatchSize is batch size for timer processor to process tasks"},
+	{key: TimerTaskMaxRetryCount, description: "TimerTaskMaxRetryCount is max retry count for timer processor"},
+	{key: TimerProcessorSchedulerWorkerCount, description: "TimerProcessorSchedulerWorkerCount is the number of workers in the host level task scheduler for timer processor"},
+	{key: TimerProcessorSchedulerActiveRoundRobinWeights, description: "TimerProcessorSchedulerActiveRoundRobinWeights is the priority round robin weights used by timer task scheduler for active namespaces"},
+	{key: TimerProcessorSchedulerStandbyRoundRobinWeights, description: "TimerProcessorSchedulerStandbyRoundRobinWeights is the priority round robin weights used by timer task scheduler for standby namespaces"},
+	{key: TimerProcessorUpdateAckInterval, description: "TimerProcessorUpdateAckInterval is update interval for timer processor"},
+	{key: TimerProcessorUpdateAckIntervalJitterCoefficient, description: "TimerProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient"},
+	{key: TimerProcessorCompleteTimerInterval, description: "TimerProcessorCompleteTimerInterval is complete timer interval for timer processor"},
+	{key: TimerProcessorFailoverMaxPollRPS, description: "TimerProcessorFailoverMaxPollRPS is max poll rate per second for timer processor"},
+	{key: TimerProcessorMaxPollRPS, description: "TimerProcessorMaxPollRPS is max poll rate per second for timer processor"},
+	{key: TimerProcessorMaxPollHostRPS, description: "TimerProcessorMaxPollHostRPS is max poll rate per second for all timer processor on a host"},
+	{key: TimerProcessorMaxPollInterval, description: "TimerProcessorMaxPollInterval is max poll interval for timer processor"},
+	{key: TimerProcessorMaxPollIntervalJitterCoefficient, description: "TimerProcessorMaxPollIntervalJitterCoefficient is the max poll interval jitter coefficient"},
+	{key: TimerProcessorMaxReschedulerSize, description: "TimerProcessorMaxReschedulerSize is the threshold of the number of tasks in the redispatch queue for timer processor"},
+	{key: TimerProcessorPollBackoffInterval, description: "TimerProcessorPollBackoffInterval is the poll backoff interval if task redispatcher's size exceeds limit for timer processor"},
+	{key: TimerProcessorMaxTimeShift, description: "TimerProcessorMaxTimeShift is the max shift timer processor can have"},
+	{key: TimerProcessorHistoryArchivalSizeLimit, description: "TimerProcessorHistoryArchivalSizeLimit is the max history size for inline archival"},
+	{key: TimerProcessorArchivalTimeLimit, description: "TimerProcessorArchivalTimeLimit is the upper time limit for inline history archival"},
+	{key: RetentionTimerJitterDuration, description: "RetentionTimerJitterDuration is a time duration jitter to distribute timer from T0 to T0 + jitter duration"},
+	{key: TransferTaskBatchSize, description: "TransferTaskBatchSize is batch size for transferQueueProcessor"},
+	{key: TransferProcessorFailoverMaxPollRPS, description: "TransferProcessorFailoverMaxPollRPS is max poll rate per second for transferQueueProcessor"},
+	{key: TransferProcessorMaxPollRPS, description: "TransferProcessorMaxPollRPS is max poll rate per second for transferQueueProcessor"},
+	{key: TransferProcessorMaxPollHostRPS, description: "TransferProcessorMaxPollHostRPS is max poll rate per second for all transferQueueProcessor on a host"},
+	{key: TransferTaskMaxRetryCount, description: "TransferTaskMaxRetryCount is max times of retry for transferQueueProcessor"},
+	{key: TransferProcessorSchedulerWorkerCount, description: "TransferProcessorSchedulerWorkerCount is the number of workers in the host level task scheduler for transferQueueProcessor"},
+	{key: TransferProcessorSchedulerActiveRoundRobinWeights, description: "TransferProcessorSchedulerActiveRoundRobinWeights is the priority round robin weights used by transfer task scheduler for active namespaces"},
+	{key: TransferProcessorSchedulerStandbyRoundRobinWeights, description: "TransferProcessorSchedulerStandbyRoundRobinWeights is the priority round robin weights used by transfer task scheduler for standby namespaces"},
+	{key: TransferProcessorUpdateShardTaskCount, description: "TransferProcessorUpdateShardTaskCount is update shard count for transferQueueProcessor"},
+	{key: TransferProcessorMaxPollInterval, description: "TransferProcessorMaxPollInterval max poll interval for transferQueueProcessor"},
+	{key: TransferProcessorMaxPollIntervalJitterCoefficient, description: "TransferProcessorMaxPollIntervalJitterCoefficient is the max poll interval jitter coefficient"},
+	{key: TransferProcessorUpdateAckInterval, description: "TransferProcessorUpdateAckInterval is update interval for transferQueueProcessor"},
+	{key: TransferProcessorUpdateAckIntervalJitterCoefficient, description: "TransferProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient"},
+	{key: TransferProcessorCompleteTransferInterval, description: "TransferProcessorCompleteTransferInterval is complete timer interval for transferQueueProcessor"},
+	{key: TransferProcessorMaxReschedulerSize, description: "TransferProcessorMaxReschedulerSize is the threshold of the number of tasks in the redispatch queue for transferQueueProcessor"},
+	{key: TransferProcessorPollBackoffInterval, description: "TransferProcessorPollBackoffInterval is the poll backoff interval if task redispatcher's size exceeds limit for transferQueueProcessor"},
+	{key: TransferProcessorVisibilityArchivalTimeLimit, description: "TransferProcessorVisibilityArchivalTimeLimit is the upper time limit for archiving visibility records"},
+	{key: TransferProcessorEnsureCloseBeforeDelete, description: "TransferProcessorEnsureCloseBeforeDelete means we ensure the execution is closed before we delete it"},
+	{key: VisibilityTaskBatchSize, description: "VisibilityTaskBatchSize is batch size for visibilityQueueProcessor"},
+	{key: VisibilityProcessorMaxPollRPS, description: "VisibilityProcessorMaxPollRPS is max poll rate per second for visibilityQueueProcessor"},
+	{key: VisibilityProcessorMaxPollHostRPS, description: "VisibilityProcessorMaxPollHostRPS is max poll rate per second for all visibilityQueueProcessor on a host"},
+	{key: VisibilityTaskMaxRetryCount, description: "VisibilityTaskMaxRetryCount is max times of retry for visibilityQueueProcessor"},
+	{key: VisibilityProcessorSchedulerWorkerCount, description: "VisibilityProcessorSchedulerWorkerCount is the number of workers in the host level task scheduler for visibilityQueueProcessor"},
+	{key: VisibilityProcessorSchedulerActiveRoundRobinWeights, description: "VisibilityProcessorSchedulerActiveRoundRobinWeights is the priority round robin weights by visibility task scheduler for active namespaces"},
+	{key: VisibilityProcessorSchedulerStandbyRoundRobinWeights, description: "VisibilityProcessorSchedulerStandbyRoundRobinWeights is the priority round robin weights by visibility task scheduler for standby namespaces"},
+	{key: VisibilityProcessorMaxPollInterval, description: "VisibilityProcessorMaxPollInterval max poll interval for visibilityQueueProcessor"},
+	{key: VisibilityProcessorMaxPollIntervalJitterCoefficient, description: "VisibilityProcessorMaxPollIntervalJitterCoefficient is the max poll interval jitter coefficient"},
+	{key: VisibilityProcessorUpdateAckInterval, description: "VisibilityProcessorUpdateAckInterval is update interval for visibilityQueueProcessor"},
+	{key: VisibilityProcessorUpdateAckIntervalJitterCoefficient, description: "VisibilityProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient"},
+	{key: VisibilityProcessorCompleteTaskInterval, description: "VisibilityProcessorCompleteTaskInterval is complete timer interval for visibilityQueueProcessor"},
+	{key: VisibilityProcessorMaxReschedulerSize, description: "VisibilityProcessorMaxReschedulerSize is the threshold of the number of tasks in the redispatch queue for visibilityQueueProcessor"},
+	{key: VisibilityProcessorPollBackoffInterval, description: "VisibilityProcessorPollBackoffInterval is the poll backoff interval if task redispatcher's size exceeds limit for visibilityQueueProcessor"},
+	{key: VisibilityProcessorVisibilityArchivalTimeLimit, description: "VisibilityProcessorVisibilityArchivalTimeLimit is the upper time limit for archiving visibility records"},
+	{key: VisibilityProcessorEnsureCloseBeforeDelete, description: "VisibilityProcessorEnsureCloseBeforeDelete means we ensure the visibility of an execution is closed before we delete its visibility records"},
+	{key: VisibilityProcessorEnableCloseWorkflowCleanup, description: "VisibilityProcessorEnableCloseWorkflowCleanup to clean up the mutable state after visibility close task has been processed. Must use Elasticsearch as visibility store, otherwise workflow data (eg: search attributes) will be lost after workflow is closed."},
+	{key: ArchivalTaskBatchSize, description: "ArchivalTaskBatchSize is batch size for archivalQueueProcessor"},
+	{key: ArchivalProcessorMaxPollRPS, description: "ArchivalProcessorMaxPollRPS is max poll rate per second for archivalQueueProcessor"},
+	{key: ArchivalProcessorMaxPollHostRPS, description: "ArchivalProcessorMaxPollHostRPS is max poll rate per second for all archivalQueueProcessor on a host"},
+	{key: ArchivalProcessorSchedulerWorkerCount, description: "ArchivalProcessorSchedulerWorkerCount is the number of workers in the host level task scheduler for archivalQueueProcessor"},
+	{key: ArchivalProcessorMaxPollInterval, description: "ArchivalProcessorMaxPollInterval max poll interval for archivalQueueProcessor"},
+	{key: ArchivalProcessorMaxPollIntervalJitterCoefficient, description: "ArchivalProcessorMaxPollIntervalJitterCoefficient is the max poll interval jitter coefficient"},
+	{key: ArchivalProcessorUpdateAckInterval, description: "ArchivalProcessorUpdateAckInterval is update interval for archivalQueueProcessor"},
+	{key: ArchivalProcessorUpdateAckIntervalJitterCoefficient, description: "ArchivalProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient"},
+	{key: ArchivalProcessorPollBackoffInterval, description: "ArchivalProcessorPollBackoffInterval is the poll backoff interval if task redispatcher's size exceeds limit for archivalQueueProcessor"},
+	{key: ArchivalProcessorArchiveDelay, description: "ArchivalProcessorArchiveDelay is the delay before archivalQueueProcessor starts to process archival tasks"},
+	{key: ArchivalProcessorRetryWarningLimit, description: "ArchivalProcessorRetryWarningLimit is the number of times an archival task may be retried before we log a warning"},
+	{key: ArchivalBackendMaxRPS, description: "ArchivalBackendMaxRPS is the maximum rate of requests per second to the archival backend"},
+	{key: DurableArchivalEnabled, description: "DurableArchivalEnabled is the flag to enable durable archival"},
+	{key: ReplicatorTaskBatchSize, description: "ReplicatorTaskBatchSize is batch size for ReplicatorProcessor"},
+	{key: ReplicatorMaxSkipTaskCount, description: "ReplicatorMaxSkipTaskCount is maximum number of tasks that can be skipped during tasks pagination due to not meeting filtering conditions (e.g. missed namespace)."},
+	{key: ReplicatorTaskWorkerCount, description: "ReplicatorTaskWorkerCount is number of worker for ReplicatorProcessor"},
+	{key: ReplicatorTaskMaxRetryCount, description: "ReplicatorTaskMaxRetryCount is max times of retry for ReplicatorProcessor"},
+	{key: ReplicatorProcessorMaxPollRPS, description: "ReplicatorProcessorMaxPollRPS is max poll rate per second for ReplicatorProcessor"},
+	{key: ReplicatorProcessorMaxPollInterval, description: "ReplicatorProcessorMaxPollInterval is max poll interval for ReplicatorProcessor"},
+	{key: ReplicatorProcessorMaxPollIntervalJitterCoefficient, description: "ReplicatorProcessorMaxPollIntervalJitterCoefficient is the max poll interval jitter coefficient"},
+	{key: ReplicatorProcessorUpdateAckInterval, description: "ReplicatorProcessorUpdateAckInterval is update interval for ReplicatorProcessor"},
+	{key: ReplicatorProcessorUpdateAckIntervalJitterCoefficient, description: "ReplicatorProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient"},
+	{key: ReplicatorProcessorMaxReschedulerSize, description: "ReplicatorProcessorMaxReschedulerSize is the threshold of the number of tasks in the redispatch queue for ReplicatorProcessor"},
+	{key: ReplicatorProcessorEnablePriorityTaskProcessor, description: "ReplicatorProcessorEnablePriorityTaskProcessor indicates whether priority task processor should be used for ReplicatorProcessor"},
+	{key: MaximumBufferedEventsBatch, description: "MaximumBufferedEventsBatch is max number of buffer event in mutable state"},
+	{key: MaximumSignalsPerExecution, description: "MaximumSignalsPerExecution is max number of signals supported by single execution"},
+	{key: ShardUpdateMinInterval, description: "ShardUpdateMinInterval is the minimal time interval which the shard info can be updated"},
+	{key: ShardSyncMinInterval, description: "ShardSyncMinInterval is the minimal time interval which the shard info should be sync to remote"},
+	{key: EmitShardDiffLog, description: "EmitShardDiffLog whether emit the shard diff log"},
+	{key: DefaultEventEncoding, description: "DefaultEventEncoding is the encoding type for history events"},
+	{key: NumArchiveSystemWorkflows, description: "NumArchiveSystemWorkflows is key for number of archive system workflows running in total"},
+	{key: ArchiveRequestRPS, description: "ArchiveRequestRPS is the rate limit on the number of archive request per second"},
+	{key: ArchiveSignalTimeout, description: "ArchiveSignalTimeout is the signal timeout used when starting an archive system workflow"},
+	{key: DefaultActivityRetryPolicy, description: "DefaultActivityRetryPolicy represents the out-of-box retry policy for activities where the user has not specified an explicit RetryPolicy"},
+	{key: DefaultWorkflowRetryPolicy, description: "DefaultWorkflowRetryPolicy represents the out-of-box retry policy for unset fields where the user has set an explicit RetryPolicy, but not specified all the fields"},
+	{key: HistoryMaxAutoResetPoints, description: "HistoryMaxAutoResetPoints is the key for max number of auto reset points stored in mutableState"},
+	{key: EnableParentClosePolicy, description: "EnableParentClosePolicy whether to ParentClosePolicy"},
+	{key: ParentClosePolicyThreshold, description: "ParentClosePolicyThreshold decides that parent close policy will be processed by sys workers(if enabled) if the number of children greater than or equal to this threshold"},
+	{key: NumParentClosePolicySystemWorkflows, description: "NumParentClosePolicySystemWorkflows is key for number of parentClosePolicy system workflows running in total"},
+	{key: HistoryThrottledLogRPS, description: "HistoryThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger"},
+	{key: StickyTTL, description: "StickyTTL is to expire a sticky taskqueue if no update more than this duration"},
+	{key: WorkflowTaskHeartbeatTimeout, description: "WorkflowTaskHeartbeatTimeout for workflow task heartbeat"},
+	{key: WorkflowTaskCriticalAttempts, description: "WorkflowTaskCriticalAttempts is the number of attempts for a workflow task that's regarded as critical"},
+	{key: WorkflowTaskRetryMaxInterval, description: "WorkflowTaskRetryMaxInterval is the maximum interval added to a workflow task's startToClose timeout for slowing down retry"},
+	{key: DefaultWorkflowTaskTimeout, description: "DefaultWorkflowTaskTimeout for a workflow task"},
+	{key: SkipReapplicationByNamespaceID, description: "SkipReapplicationByNamespaceID is whether skipping a event re-application for a namespace"},
+	{key: StandbyTaskReReplicationContextTimeout, description: "StandbyTaskReReplicationContextTimeout is the context timeout for standby task re-replication"},
+	{key: MaxBufferedQueryCount, description: "MaxBufferedQueryCount indicates max buffer query count"},
+	{key: MutableStateChecksumGenProbability, description: "MutableStateChecksumGenProbability is the probability [0-100] that checksum will be generated for mutable state"},
+	{key: MutableStateChecksumVerifyProbability, description: "MutableStateChecksumVerifyProbability is the probability [0-100] that checksum will be verified for mutable state"},
+	{key: MutableStateChecksumInvalidateBefore, description: "MutableStateChecksumInvalidateBefore is the epoch timestamp before which all checksums are to be discarded"},
+	{key: ReplicationTaskFetcherParallelism, description: "ReplicationTaskFetcherParallelism determines how many go routines we spin up for fetching tasks"},
+	{key: ReplicationTaskFetcherAggregationInterval, description: "ReplicationTaskFetcherAggregationInterval determines how frequently the fetch requests are sent"},
+	{key: ReplicationTaskFetcherTimerJitterCoefficient, description: "ReplicationTaskFetcherTimerJitterCoefficient is the jitter for fetcher timer"},
+	{key: ReplicationTaskFetcherErrorRetryWait, description: "ReplicationTaskFetcherErrorRetryWait is the wait time when fetcher encounters error"},
+	{key: ReplicationTaskProcessorErrorRetryWait, description: "ReplicationTaskProcessorErrorRetryWait is the initial retry wait when we see errors in applying replication tasks"},
+	{key: ReplicationTaskProcessorErrorRetryBackoffCoefficient, description: "ReplicationTaskProcessorErrorRetryBackoffCoefficient is the retry wait backoff time coefficient"},
+	{key: ReplicationTaskProcessorErrorRetryMaxInterval, description: "ReplicationTaskProcessorErrorRetryMaxInterval is the retry wait backoff max duration"},
+	{key: ReplicationTaskProcessorErrorRetryMaxAttempts, description: "ReplicationTaskProcessorErrorRetryMaxAttempts is the max retry attempts for applying replication tasks"},
+	{key: ReplicationTaskProcessorErrorRetryExpiration, description: "ReplicationTaskProcessorErrorRetryExpiration is the max retry duration for applying replication tasks"},
+	{key: ReplicationTaskProcessorNoTaskInitialWait, description: "ReplicationTaskProcessorNoTaskInitialWait is the wait time when not ask is returned"},
+	{key: ReplicationTaskProcessorCleanupInterval, description: "ReplicationTaskProcessorCleanupInterval determines how frequently the cleanup replication queue"},
+	{key: ReplicationTaskProcessorCleanupJitterCoefficient, description: "ReplicationTaskProcessorCleanupJitterCoefficient is the jitter for cleanup timer"},
+	{key: ReplicationTaskProcessorStartWait, description: "ReplicationTaskProcessorStartWait is the wait time before each task processing batch"},
+	{key: ReplicationTaskProcessorHostQPS, description: "ReplicationTaskProcessorHostQPS is the qps of task processing rate limiter on host level"},
+	{key: ReplicationTaskProcessorShardQPS, description: "ReplicationTaskProcessorShardQPS is the qps of task processing rate limiter on shard level"},
+	{key: ReplicationBypassCorruptedData, description: "ReplicationBypassCorruptedData is the flag to bypass corrupted workflow data in source cluster"},
+	{key: WorkerPersistenceMaxQPS, description: "WorkerPersistenceMaxQPS is the max qps worker host can query DB"},
+	{key: WorkerPersistenceGlobalMaxQPS, description: "WorkerPersistenceGlobalMaxQPS is the max qps worker cluster can query DB"},
+	{key: WorkerPersistenceNamespaceMaxQPS, description: "WorkerPersistenceNamespaceMaxQPS is the max qps each namespace on worker host can query DB"},
+	{key: WorkerEnablePersistencePriorityRateLimiting, description: "WorkerEnablePersistencePriorityRateLimiting indicates if priority rate limiting is enabled in worker persistence client"},
+	{key: WorkerIndexerConcurrency, description: "WorkerIndexerConcurrency is the max concurrent messages to be processed at any given time"},
+	{key: WorkerESProcessorNumOfWorkers, description: "WorkerESProcessorNumOfWorkers is num of workers for esProcessor"},
+	{key: WorkerESProcessorBulkActions, description: "WorkerESProcessorBulkActions is max number of requests in bulk for esProcessor"},
+	{key: WorkerESProcessorBulkSize, description: "WorkerESProcessorBulkSize is max total size of bulk in bytes for esProcessor"},
+	{key: WorkerESProcessorFlushInterval, description: "WorkerESProcessorFlushInterval is flush interval for esProcessor"},
+	{key: WorkerESProcessorAckTimeout, description: "WorkerESProcessorAckTimeout is the timeout that store will wait to get ack signal from ES processor. Should be at least WorkerESProcessorFlushInterval+<time to process request>."},
+	{key: WorkerArchiverMaxConcurrentActivityExecutionSize, description: "WorkerArchiverMaxConcurrentActivityExecutionSize indicates worker archiver max concurrent activity execution size"},
+	{key: WorkerArchiverMaxConcurrentWorkflowTaskExecutionSize, description: "WorkerArchiverMaxConcurrentWorkflowTaskExecutionSize indicates worker archiver max concurrent workflow execution size"},
+	{key: WorkerArchiverMaxConcurrentActivityTaskPollers, description: "WorkerArchiverMaxConcurrentActivityTaskPollers indicates worker archiver max concurrent activity pollers"},
+	{key: WorkerArchiverMaxConcurrentWorkflowTaskPollers, description: "WorkerArchiverMaxConcurrentWorkflowTaskPollers indicates worker archiver max concurrent workflow pollers"},
+	{key: WorkerArchiverConcurrency, description: "WorkerArchiverConcurrency controls the number of coroutines handling archival work per archival workflow"},
+	{key: WorkerArchivalsPerIteration, description: "WorkerArchivalsPerIteration controls the number of archivals handled in each iteration of archival workflow"},
+	{key: WorkerTimeLimitPerArchivalIteration, description: "WorkerTimeLimitPerArchivalIteration controls the time limit of each iteration of archival workflow"},
+	{key: WorkerThrottledLogRPS, description: "WorkerThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger"},
+	{key: WorkerScannerMaxConcurrentActivityExecutionSize, description: "WorkerScannerMaxConcurrentActivityExecutionSize indicates worker scanner max concurrent activity execution size"},
+	{key: WorkerScannerMaxConcurrentWorkflowTaskExecutionSize, description: "WorkerScannerMaxConcurrentWorkflowTaskExecutionSize indicates worker scanner max concurrent workflow execution size"},
+	{key: WorkerScannerMaxConcurrentActivityTaskPollers, description: "WorkerScannerMaxConcurrentActivityTaskPollers indicates worker scanner max concurrent activity pollers"},
+	{key: WorkerScannerMaxConcurrentWorkflowTaskPollers, description: "WorkerScannerMaxConcurrentWorkflowTaskPollers indicates worker scanner max concurrent workflow pollers"},
+	{key: ScannerPersistenceMaxQPS, description: "ScannerPersistenceMaxQPS is the maximum rate of persistence calls from worker.Scanner"},
+	{key: ExecutionScannerPerHostQPS, description: "ExecutionScannerPerHostQPS is the maximum rate of calls per host from executions.Scanner"},
+	{key: ExecutionScannerPerShardQPS, description: "ExecutionScannerPerShardQPS is the maximum rate of calls per shard from executions.Scanner"},
+	{key: ExecutionDataDurationBuffer, description: "ExecutionDataDurationBuffer is the data TTL duration buffer of execution data"},
+	{key: ExecutionScannerWorkerCount, description: "ExecutionScannerWorkerCount is the execution scavenger worker count"},
+	{key: TaskQueueScannerEnabled, description: "TaskQueueScannerEnabled indicates if task queue scanner should be started as part of worker.Scanner"},
+	{key: HistoryScannerEnabled, description: "HistoryScannerEnabled indicates if history scanner should be started as part of worker.Scanner"},
+	{key: ExecutionsScannerEnabled, description: "ExecutionsScannerEnabled indicates if executions scanner should be started as part of worker.Scanner"},
+	{key: HistoryScannerDataMinAge, description: "HistoryScannerDataMinAge indicates the history scanner cleanup minimum age."},
+	{key: HistoryScannerVerifyRetention, description: "HistoryScannerVerifyRetention indicates the history scanner verify data retention. If the service configures with archival feature enabled, update worker.historyScannerVerifyRetention to be double of the data retention."},
+	{key: EnableBatcher, description: "EnableBatcher decides whether start batcher in our worker"},
+	{key: BatcherRPS, description: "BatcherRPS controls number the rps of batch operations"},
+	{key: BatcherConcurrency, description: "BatcherConcurrency controls the concurrency of one batch operation"},
+	{key: WorkerParentCloseMaxConcurrentActivityExecutionSize, description: "WorkerParentCloseMaxConcurrentActivityExecutionSize indicates worker parent close worker max concurrent activity execution size"},
+	{key: WorkerParentCloseMaxConcurrentWorkflowTaskExecutionSize, description: "WorkerParentCloseMaxConcurrentWorkflowTaskExecutionSize indicates worker parent close worker max concurrent workflow execution size"},
+	{key: WorkerParentCloseMaxConcurrentActivityTaskPollers, description: "WorkerParentCloseMaxConcurrentActivityTaskPollers indicates worker parent close worker max concurrent activity pollers"},
+	{key: WorkerParentCloseMaxConcurrentWorkflowTaskPollers, description: "WorkerParentCloseMaxConcurrentWorkflowTaskPollers indicates worker parent close worker max concurrent workflow pollers"},
+	{key: WorkerPerNamespaceWorkerCount, description: "WorkerPerNamespaceWorkerCount controls number of per-ns (scheduler, batcher, etc.) workers to run per namespace"},
+	{key: WorkerEnableScheduler, description: "WorkerEnableScheduler controls whether to start the worker for scheduled workflows"},
+	{key: WorkerSchedulerPauseAfterFailures, description: "WorkerSchedulerPauseAfterFailures is the default number of consecutive failed runs after which a schedule with pause-on-failure set is paused, for schedules that don't set their own"},
+}
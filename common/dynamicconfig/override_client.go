@@ -0,0 +1,278 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+var _ Client = (*OverrideClient)(nil)
+
+const (
+	overrideClientInitialized int32 = iota
+	overrideClientStarted
+	overrideClientStopped
+)
+
+const (
+	overrideRefreshInterval = 10 * time.Second
+	overrideLoadTimeout     = 10 * time.Second
+)
+
+type (
+	// OverrideClient layers temporary overrides on top of another Client. An override
+	// replaces the value with the same constraints from the underlying client until it
+	// expires. The overrides are stored in persistence and reloaded periodically once the
+	// client is started, so that an override set on one host applies to all of them.
+	OverrideClient struct {
+		status     int32
+		client     Client
+		timeSource clock.TimeSource
+		loader     OverrideLoader
+		logger     log.Logger
+		shutdownCh chan struct{}
+
+		lock sync.RWMutex
+		// keyed by the lower-cased key, see Key
+		overrides map[string][]Override
+	}
+
+	// OverrideLoader returns the overrides stored in persistence.
+	OverrideLoader func(ctx context.Context) ([]*persistencespb.DynamicConfigOverride, error)
+
+	// Override is a ConstrainedValue set on an OverrideClient, with its expiration time.
+	Override struct {
+		Key Key
+		ConstrainedValue
+		ExpireTime time.Time
+	}
+)
+
+// NewOverrideClient creates a client that serves overrides on top of the given client.
+func NewOverrideClient(client Client, timeSource clock.TimeSource) *OverrideClient {
+	return &OverrideClient{
+		status:     overrideClientInitialized,
+		client:     client,
+		timeSource: timeSource,
+		shutdownCh: make(chan struct{}),
+		overrides:  make(map[string][]Override),
+	}
+}
+
+// Start loads the overrides with loader and keeps reloading them until Stop is called.
+func (c *OverrideClient) Start(loader OverrideLoader, logger log.Logger) error {
+	if !atomic.CompareAndSwapInt32(&c.status, overrideClientInitialized, overrideClientStarted) {
+		return nil
+	}
+	c.loader = loader
+	c.logger = logger
+	if err := c.refresh(); err != nil {
+		return fmt.Errorf("unable to load dynamic config overrides: %w", err)
+	}
+	go c.refreshLoop()
+	return nil
+}
+
+// Stop stops reloading the overrides.
+func (c *OverrideClient) Stop() {
+	if !atomic.CompareAndSwapInt32(&c.status, overrideClientStarted, overrideClientStopped) {
+		return
+	}
+	close(c.shutdownCh)
+}
+
+func (c *OverrideClient) GetValue(key Key) []ConstrainedValue {
+	values := c.client.GetValue(key)
+
+	c.lock.RLock()
+	overrides := c.overrides[strings.ToLower(key.String())]
+	c.lock.RUnlock()
+	if len(overrides) == 0 {
+		return values
+	}
+
+	now := c.timeSource.Now()
+	result := make([]ConstrainedValue, 0, len(values)+len(overrides))
+	overridden := make(map[Constraints]struct{}, len(overrides))
+	for _, override := range overrides {
+		if !now.Before(override.ExpireTime) {
+			continue
+		}
+		result = append(result, override.ConstrainedValue)
+		overridden[override.Constraints] = struct{}{}
+	}
+	for _, value := range values {
+		if _, ok := overridden[value.Constraints]; !ok {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// Update replaces all overrides with the given ones.
+func (c *OverrideClient) Update(overrides []Override) {
+	newOverrides := make(map[string][]Override, len(overrides))
+	for _, override := range overrides {
+		name := strings.ToLower(override.Key.String())
+		newOverrides[name] = append(newOverrides[name], override)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	// GetValue may still hold the previous slices, so they are never modified in place
+	c.overrides = newOverrides
+}
+
+// ListOverrides returns all overrides that have not expired yet.
+func (c *OverrideClient) ListOverrides() []Override {
+	now := c.timeSource.Now()
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var result []Override
+	for _, overrides := range c.overrides {
+		for _, override := range overrides {
+			if now.Before(override.ExpireTime) {
+				result = append(result, override)
+			}
+		}
+	}
+	return result
+}
+
+func (c *OverrideClient) refreshLoop() {
+	ticker := time.NewTicker(overrideRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.shutdownCh:
+			return
+		case <-ticker.C:
+			if err := c.refresh(); err != nil {
+				c.logger.Warn("Failed to load dynamic config overrides", tag.Error(err))
+			}
+		}
+	}
+}
+
+func (c *OverrideClient) refresh() error {
+	ctx, cancel := context.WithTimeout(context.Background(), overrideLoadTimeout)
+	defer cancel()
+
+	persisted, err := c.loader(ctx)
+	if err != nil {
+		return err
+	}
+	overrides := make([]Override, 0, len(persisted))
+	for _, p := range persisted {
+		override, err := OverrideFromProto(p)
+		if err != nil {
+			c.logger.Warn("Ignoring invalid dynamic config override", tag.Key(p.GetKey()), tag.Error(err))
+			continue
+		}
+		overrides = append(overrides, override)
+	}
+	c.Update(overrides)
+	return nil
+}
+
+// OverrideFromProto converts a persisted override into an Override.
+func OverrideFromProto(override *persistencespb.DynamicConfigOverride) (Override, error) {
+	value, err := ParseValue(override.GetValue())
+	if err != nil {
+		return Override{}, err
+	}
+	return Override{
+		Key: Key(override.GetKey()),
+		ConstrainedValue: ConstrainedValue{
+			Constraints: ConstraintsFromProto(override.GetConstraints()),
+			Value:       value,
+		},
+		ExpireTime: timestamp.TimeValue(override.GetExpireTime()),
+	}, nil
+}
+
+// ConstraintsFromProto converts persisted constraints into Constraints.
+func ConstraintsFromProto(constraints *persistencespb.DynamicConfigConstraints) Constraints {
+	return Constraints{
+		Namespace:     constraints.GetNamespace(),
+		NamespaceID:   constraints.GetNamespaceId(),
+		TaskQueueName: constraints.GetTaskQueueName(),
+		TaskQueueType: constraints.GetTaskQueueType(),
+		ShardID:       constraints.GetShardId(),
+		TaskType:      constraints.GetTaskType(),
+	}
+}
+
+// ConstraintsToProto converts Constraints into their persisted form.
+func ConstraintsToProto(constraints Constraints) *persistencespb.DynamicConfigConstraints {
+	return &persistencespb.DynamicConfigConstraints{
+		Namespace:     constraints.Namespace,
+		NamespaceId:   constraints.NamespaceID,
+		TaskQueueName: constraints.TaskQueueName,
+		TaskQueueType: constraints.TaskQueueType,
+		ShardId:       constraints.ShardID,
+		TaskType:      constraints.TaskType,
+	}
+}
+
+// ParseValue parses a value written in YAML the same way as the values of the dynamic config file.
+func ParseValue(value string) (any, error) {
+	var parsed any
+	if err := yaml.Unmarshal([]byte(value), &parsed); err != nil {
+		return nil, fmt.Errorf("unable to decode dynamic config value: %w", err)
+	}
+	if parsed == nil {
+		return nil, fmt.Errorf("dynamic config value is empty")
+	}
+	return convertKeyTypeToString(parsed)
+}
+
+// FormatValue formats a value in YAML, the reverse of ParseValue.
+func FormatValue(value any) (string, error) {
+	if d, ok := value.(time.Duration); ok {
+		value = d.String()
+	}
+	formatted, err := yaml.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(string(formatted), "\n"), nil
+}
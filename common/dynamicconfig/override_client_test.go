@@ -0,0 +1,218 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+)
+
+type overrideClientSuite struct {
+	suite.Suite
+	*require.Assertions
+	timeSource *clock.EventTimeSource
+	client     *OverrideClient
+	collection *Collection
+}
+
+func TestOverrideClientSuite(t *testing.T) {
+	s := new(overrideClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *overrideClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+	s.client = NewOverrideClient(StaticClient(map[Key]any{
+		testGetIntPropertyKey: []ConstrainedValue{
+			{Value: 1000},
+			{Constraints: Constraints{Namespace: "samples-namespace"}, Value: 2000},
+		},
+	}), s.timeSource)
+	s.collection = NewCollection(s.client, log.NewNoopLogger())
+}
+
+func (s *overrideClientSuite) TearDownTest() {
+	s.client.Stop()
+}
+
+func (s *overrideClientSuite) override(key Key, constraints Constraints, value any) Override {
+	return Override{
+		Key:              key,
+		ConstrainedValue: ConstrainedValue{Constraints: constraints, Value: value},
+		ExpireTime:       s.timeSource.Now().Add(time.Minute),
+	}
+}
+
+func (s *overrideClientSuite) TestGetValue_NoOverride() {
+	s.Equal(1000, s.collection.GetIntPropertyFilteredByNamespace(testGetIntPropertyKey, 0)("other-namespace"))
+	s.Equal(2000, s.collection.GetIntPropertyFilteredByNamespace(testGetIntPropertyKey, 0)("samples-namespace"))
+}
+
+func (s *overrideClientSuite) TestUpdate() {
+	s.client.Update([]Override{
+		s.override(testGetIntPropertyKey, Constraints{Namespace: "samples-namespace"}, 3000),
+	})
+
+	s.Equal(1000, s.collection.GetIntPropertyFilteredByNamespace(testGetIntPropertyKey, 0)("other-namespace"))
+	s.Equal(3000, s.collection.GetIntPropertyFilteredByNamespace(testGetIntPropertyKey, 0)("samples-namespace"))
+	s.Len(s.client.GetValue(testGetIntPropertyKey), 2)
+}
+
+func (s *overrideClientSuite) TestUpdate_NewKey() {
+	s.client.Update([]Override{s.override(testGetBoolPropertyKey, Constraints{}, true)})
+	s.True(s.collection.GetBoolProperty(testGetBoolPropertyKey, false)())
+}
+
+func (s *overrideClientSuite) TestUpdate_Replace() {
+	s.client.Update([]Override{s.override(testGetIntPropertyKey, Constraints{}, 3000)})
+	s.Equal(3000, s.collection.GetIntProperty(testGetIntPropertyKey, 0)())
+
+	s.client.Update(nil)
+	s.Equal(1000, s.collection.GetIntProperty(testGetIntPropertyKey, 0)())
+	s.Empty(s.client.ListOverrides())
+}
+
+func (s *overrideClientSuite) TestUpdate_Expired() {
+	s.client.Update([]Override{s.override(testGetIntPropertyKey, Constraints{}, 3000)})
+	s.Equal(3000, s.collection.GetIntProperty(testGetIntPropertyKey, 0)())
+
+	s.timeSource.Update(s.timeSource.Now().Add(time.Minute))
+	s.Equal(1000, s.collection.GetIntProperty(testGetIntPropertyKey, 0)())
+	s.Empty(s.client.ListOverrides())
+}
+
+func (s *overrideClientSuite) TestListOverrides_KeepsKey() {
+	override := s.override(Key("TestGetIntPropertyKey"), Constraints{}, 3000)
+	s.client.Update([]Override{override})
+
+	s.Equal(3000, s.collection.GetIntProperty(testGetIntPropertyKey, 0)())
+	s.Equal([]Override{override}, s.client.ListOverrides())
+}
+
+func (s *overrideClientSuite) TestStart() {
+	loaded := make(chan struct{}, 1)
+	err := s.client.Start(func(ctx context.Context) ([]*persistencespb.DynamicConfigOverride, error) {
+		loaded <- struct{}{}
+		expireTime := s.timeSource.Now().Add(time.Minute)
+		return []*persistencespb.DynamicConfigOverride{
+			{
+				Key:         testGetIntPropertyKey,
+				Constraints: &persistencespb.DynamicConfigConstraints{Namespace: "samples-namespace"},
+				Value:       "3000",
+				ExpireTime:  &expireTime,
+			},
+			{
+				Key:        testGetBoolPropertyKey,
+				Value:      "[invalid",
+				ExpireTime: &expireTime,
+			},
+		}, nil
+	}, log.NewNoopLogger())
+	s.NoError(err)
+	<-loaded
+
+	s.Equal(3000, s.collection.GetIntPropertyFilteredByNamespace(testGetIntPropertyKey, 0)("samples-namespace"))
+	s.False(s.collection.GetBoolProperty(testGetBoolPropertyKey, false)())
+	s.Len(s.client.ListOverrides(), 1)
+}
+
+func (s *overrideClientSuite) TestStart_LoadError() {
+	err := s.client.Start(func(ctx context.Context) ([]*persistencespb.DynamicConfigOverride, error) {
+		return nil, errors.New("unavailable")
+	}, log.NewNoopLogger())
+	s.Error(err)
+}
+
+func (s *overrideClientSuite) TestOverrideFromProto() {
+	expireTime := s.timeSource.Now().Add(time.Minute)
+	override, err := OverrideFromProto(&persistencespb.DynamicConfigOverride{
+		Key: "matching.numTaskqueueReadPartitions",
+		Constraints: &persistencespb.DynamicConfigConstraints{
+			Namespace:     "samples-namespace",
+			TaskQueueName: "samples-task-queue",
+			TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		},
+		Value:      "8",
+		ExpireTime: &expireTime,
+	})
+	s.NoError(err)
+	s.Equal(Override{
+		Key: "matching.numTaskqueueReadPartitions",
+		ConstrainedValue: ConstrainedValue{
+			Constraints: Constraints{
+				Namespace:     "samples-namespace",
+				TaskQueueName: "samples-task-queue",
+				TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+			},
+			Value: 8,
+		},
+		ExpireTime: expireTime,
+	}, override)
+	s.Equal(override.Constraints, ConstraintsFromProto(ConstraintsToProto(override.Constraints)))
+}
+
+func (s *overrideClientSuite) TestParseValue() {
+	testCases := []struct {
+		value    string
+		expected any
+	}{
+		{value: "10", expected: 10},
+		{value: "0.5", expected: 0.5},
+		{value: "true", expected: true},
+		{value: "1m", expected: "1m"},
+		{value: "{key: value}", expected: map[string]any{"key": "value"}},
+	}
+	for _, tc := range testCases {
+		parsed, err := ParseValue(tc.value)
+		s.NoError(err)
+		s.Equal(tc.expected, parsed)
+
+		formatted, err := FormatValue(parsed)
+		s.NoError(err)
+		reparsed, err := ParseValue(formatted)
+		s.NoError(err)
+		s.Equal(parsed, reparsed)
+	}
+
+	_, err := ParseValue("")
+	s.Error(err)
+	_, err = ParseValue("[invalid")
+	s.Error(err)
+
+	formatted, err := FormatValue(time.Minute)
+	s.NoError(err)
+	s.Equal("1m0s", formatted)
+}
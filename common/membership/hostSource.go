@@ -0,0 +1,116 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type (
+	// HostSource discovers the candidate gRPC addresses (ip:port) of the hosts of a service.
+	HostSource interface {
+		Hosts(ctx context.Context) ([]string, error)
+	}
+
+	staticHostSource struct {
+		hostPorts []string
+		resolver  *net.Resolver
+	}
+
+	dnsSRVHostSource struct {
+		name     string
+		resolver *net.Resolver
+	}
+)
+
+// NewStaticHostSource returns a HostSource serving a fixed list of host:port addresses.
+// Host names are resolved to IP addresses every time hosts are requested.
+func NewStaticHostSource(hostPorts []string) HostSource {
+	return &staticHostSource{
+		hostPorts: hostPorts,
+		resolver:  net.DefaultResolver,
+	}
+}
+
+// NewDNSSRVHostSource returns a HostSource serving the targets of the given DNS SRV record.
+func NewDNSSRVHostSource(name string) HostSource {
+	return &dnsSRVHostSource{
+		name:     name,
+		resolver: net.DefaultResolver,
+	}
+}
+
+func (s *staticHostSource) Hosts(ctx context.Context) ([]string, error) {
+	return resolveHostPorts(ctx, s.resolver, s.hostPorts)
+}
+
+func (s *dnsSRVHostSource) Hosts(ctx context.Context) ([]string, error) {
+	_, records, err := s.resolver.LookupSRV(ctx, "", "", s.name)
+	if err != nil {
+		return nil, err
+	}
+
+	hostPorts := make([]string, 0, len(records))
+	for _, record := range records {
+		hostPorts = append(hostPorts, net.JoinHostPort(strings.TrimSuffix(record.Target, "."), strconv.Itoa(int(record.Port))))
+	}
+	return resolveHostPorts(ctx, s.resolver, hostPorts)
+}
+
+// resolveHostPorts resolves the host names of the given host:port addresses to IP addresses,
+// so they can be matched against the addresses hosts heartbeat with.
+func resolveHostPorts(ctx context.Context, resolver *net.Resolver, hostPorts []string) ([]string, error) {
+	set := make(map[string]struct{}, len(hostPorts))
+	for _, hostPort := range hostPorts {
+		host, port, err := net.SplitHostPort(hostPort)
+		if err != nil {
+			return nil, ErrIncorrectAddressFormat
+		}
+
+		if ip := net.ParseIP(host); ip != nil {
+			set[net.JoinHostPort(ip.String(), port)] = struct{}{}
+			continue
+		}
+
+		addrs, err := resolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		for _, addr := range addrs {
+			set[net.JoinHostPort(addr.IP.String(), port)] = struct{}{}
+		}
+	}
+
+	resolved := make([]string, 0, len(set))
+	for hostPort := range set {
+		resolved = append(resolved, hostPort)
+	}
+	sort.Strings(resolved)
+	return resolved, nil
+}
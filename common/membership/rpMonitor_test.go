@@ -108,7 +108,7 @@ func (s *RpoSuite) TestCompareMembers() {
 }
 
 func (s *RpoSuite) testCompareMembers(curr []string, new []string, expectedDiff []string) {
-	resolver := &ringpopServiceResolver{}
+	resolver := &serviceResolver{}
 	currMembers := make(map[string]struct{}, len(curr))
 	for _, m := range curr {
 		currMembers[m] = struct{}{}
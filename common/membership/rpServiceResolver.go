@@ -28,19 +28,14 @@ import (
 	"errors"
 	"net"
 	"strconv"
-	"sync"
-	"sync/atomic"
 	"time"
 
 	"github.com/temporalio/ringpop-go"
 	"github.com/temporalio/tchannel-go"
 
-	"github.com/dgryski/go-farm"
 	"github.com/temporalio/ringpop-go/events"
-	"github.com/temporalio/ringpop-go/hashring"
 	"github.com/temporalio/ringpop-go/swim"
 
-	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/primitives"
@@ -61,24 +56,12 @@ const (
 	replicaPoints          = 100
 )
 
+// ringpopServiceResolver builds the hash ring of a service from the members in the ringpop gossip state,
+// and refreshes it on every ringpop ring change.
 type ringpopServiceResolver struct {
-	status      int32
-	service     primitives.ServiceName
-	port        int
-	rp          *RingPop
-	refreshChan chan struct{}
-	shutdownCh  chan struct{}
-	shutdownWG  sync.WaitGroup
-	logger      log.Logger
-
-	ringValue atomic.Value // this stores the current hashring
-
-	refreshLock     sync.Mutex
-	lastRefreshTime time.Time
-	membersMap      map[string]struct{} // for de-duping change notifications
-
-	listenerLock sync.RWMutex
-	listeners    map[string]chan<- *ChangedEvent
+	*serviceResolver
+	port int
+	rp   *RingPop
 }
 
 var _ ServiceResolver = (*ringpopServiceResolver)(nil)
@@ -91,126 +74,23 @@ func newRingpopServiceResolver(
 ) *ringpopServiceResolver {
 
 	resolver := &ringpopServiceResolver{
-		status:      common.DaemonStatusInitialized,
-		service:     service,
-		port:        port,
-		rp:          rp,
-		refreshChan: make(chan struct{}),
-		shutdownCh:  make(chan struct{}),
-		logger:      log.With(logger, tag.ComponentServiceResolver, tag.Service(service)),
-		membersMap:  make(map[string]struct{}),
-		listeners:   make(map[string]chan<- *ChangedEvent),
+		port: port,
+		rp:   rp,
 	}
-	resolver.ringValue.Store(newHashRing())
+	resolver.serviceResolver = newServiceResolver(service, resolver.getReachableMembers, defaultRefreshInterval, logger)
 	return resolver
 }
 
-func newHashRing() *hashring.HashRing {
-	return hashring.New(farm.Fingerprint32, replicaPoints)
-}
-
 // Start starts the oracle
 func (r *ringpopServiceResolver) Start() {
-	if !atomic.CompareAndSwapInt32(
-		&r.status,
-		common.DaemonStatusInitialized,
-		common.DaemonStatusStarted,
-	) {
-		return
-	}
-
-	r.rp.AddListener(r)
-	if err := r.refresh(); err != nil {
+	if err := r.start(func() { r.rp.AddListener(r) }); err != nil {
 		r.logger.Fatal("unable to start ring pop service resolver", tag.Error(err))
 	}
-
-	r.shutdownWG.Add(1)
-	go r.refreshRingWorker()
 }
 
 // Stop stops the resolver
 func (r *ringpopServiceResolver) Stop() {
-	if !atomic.CompareAndSwapInt32(
-		&r.status,
-		common.DaemonStatusStarted,
-		common.DaemonStatusStopped,
-	) {
-		return
-	}
-
-	r.listenerLock.Lock()
-	defer r.listenerLock.Unlock()
-	r.rp.RemoveListener(r)
-	r.ringValue.Store(newHashRing())
-	r.listeners = make(map[string]chan<- *ChangedEvent)
-	close(r.shutdownCh)
-
-	if success := common.AwaitWaitGroup(&r.shutdownWG, time.Minute); !success {
-		r.logger.Warn("service resolver timed out on shutdown.")
-	}
-}
-
-func (r *ringpopServiceResolver) RequestRefresh() {
-	select {
-	case r.refreshChan <- struct{}{}:
-	default:
-	}
-}
-
-// Lookup finds the host in the ring responsible for serving the given key
-func (r *ringpopServiceResolver) Lookup(
-	key string,
-) (*HostInfo, error) {
-
-	addr, found := r.ring().Lookup(key)
-	if !found {
-		r.RequestRefresh()
-		return nil, ErrInsufficientHosts
-	}
-
-	return NewHostInfo(addr, r.getLabelsMap()), nil
-}
-
-func (r *ringpopServiceResolver) AddListener(
-	name string,
-	notifyChannel chan<- *ChangedEvent,
-) error {
-
-	r.listenerLock.Lock()
-	defer r.listenerLock.Unlock()
-	_, ok := r.listeners[name]
-	if ok {
-		return ErrListenerAlreadyExist
-	}
-	r.listeners[name] = notifyChannel
-	return nil
-}
-
-func (r *ringpopServiceResolver) RemoveListener(
-	name string,
-) error {
-
-	r.listenerLock.Lock()
-	defer r.listenerLock.Unlock()
-	_, ok := r.listeners[name]
-	if !ok {
-		return nil
-	}
-	delete(r.listeners, name)
-	return nil
-}
-
-func (r *ringpopServiceResolver) MemberCount() int {
-	return r.ring().ServerCount()
-}
-
-func (r *ringpopServiceResolver) Members() []*HostInfo {
-	var servers []*HostInfo
-	for _, s := range r.ring().Servers() {
-		servers = append(servers, NewHostInfo(s, r.getLabelsMap()))
-	}
-
-	return servers
+	r.stop(func() { r.rp.RemoveListener(r) })
 }
 
 // HandleEvent handles updates from ringpop
@@ -229,63 +109,6 @@ func (r *ringpopServiceResolver) HandleEvent(
 	}
 }
 
-func (r *ringpopServiceResolver) refresh() error {
-	var event *ChangedEvent
-	var err error
-	defer func() {
-		if event != nil {
-			r.emitEvent(event)
-		}
-	}()
-	r.refreshLock.Lock()
-	defer r.refreshLock.Unlock()
-	event, err = r.refreshNoLock()
-	return err
-}
-
-func (r *ringpopServiceResolver) refreshWithBackoff() error {
-	var event *ChangedEvent
-	var err error
-	defer func() {
-		if event != nil {
-			r.emitEvent(event)
-		}
-	}()
-	r.refreshLock.Lock()
-	defer r.refreshLock.Unlock()
-	if r.lastRefreshTime.After(time.Now().UTC().Add(-minRefreshInternal)) {
-		// refresh too frequently
-		return nil
-	}
-	event, err = r.refreshNoLock()
-	return err
-}
-
-func (r *ringpopServiceResolver) refreshNoLock() (*ChangedEvent, error) {
-	addrs, err := r.getReachableMembers()
-	if err != nil {
-		return nil, err
-	}
-
-	newMembersMap, changedEvent := r.compareMembers(addrs)
-	if changedEvent == nil {
-		return nil, nil
-	}
-
-	ring := newHashRing()
-	for _, addr := range addrs {
-		host := NewHostInfo(addr, r.getLabelsMap())
-		ring.AddMembers(host)
-	}
-
-	r.membersMap = newMembersMap
-	r.lastRefreshTime = time.Now().UTC()
-	r.ringValue.Store(ring)
-	r.logger.Info("Current reachable members", tag.Addresses(addrs))
-
-	return changedEvent, nil
-}
-
 func (r *ringpopServiceResolver) getReachableMembers() ([]string, error) {
 	members, err := r.rp.GetReachableMemberObjects(swim.MemberWithLabelAndValue(RoleKey, string(r.service)))
 	if err != nil {
@@ -320,75 +143,6 @@ func (r *ringpopServiceResolver) getReachableMembers() ([]string, error) {
 	return hostPorts, nil
 }
 
-func (r *ringpopServiceResolver) emitEvent(event *ChangedEvent) {
-	// Notify listeners
-	r.listenerLock.RLock()
-	defer r.listenerLock.RUnlock()
-
-	for name, ch := range r.listeners {
-		select {
-		case ch <- event:
-		default:
-			r.logger.Error("Failed to send listener notification, channel full", tag.ListenerName(name))
-		}
-	}
-}
-
-func (r *ringpopServiceResolver) refreshRingWorker() {
-	defer r.shutdownWG.Done()
-
-	refreshTicker := time.NewTicker(defaultRefreshInterval)
-	defer refreshTicker.Stop()
-
-	for {
-		select {
-		case <-r.shutdownCh:
-			return
-		case <-r.refreshChan:
-			if err := r.refreshWithBackoff(); err != nil {
-				r.logger.Error("error refreshing ring by request", tag.Error(err))
-			}
-		case <-refreshTicker.C:
-			if err := r.refreshWithBackoff(); err != nil {
-				r.logger.Error("error periodically refreshing ring", tag.Error(err))
-			}
-		}
-	}
-}
-
-func (r *ringpopServiceResolver) ring() *hashring.HashRing {
-	return r.ringValue.Load().(*hashring.HashRing)
-}
-
-func (r *ringpopServiceResolver) getLabelsMap() map[string]string {
-	labels := make(map[string]string)
-	labels[RoleKey] = string(r.service)
-	return labels
-}
-
-func (r *ringpopServiceResolver) compareMembers(addrs []string) (map[string]struct{}, *ChangedEvent) {
-	event := &ChangedEvent{}
-	changed := false
-	newMembersMap := make(map[string]struct{}, len(addrs))
-	for _, addr := range addrs {
-		newMembersMap[addr] = struct{}{}
-		if _, ok := r.membersMap[addr]; !ok {
-			event.HostsAdded = append(event.HostsAdded, NewHostInfo(addr, r.getLabelsMap()))
-			changed = true
-		}
-	}
-	for addr := range r.membersMap {
-		if _, ok := newMembersMap[addr]; !ok {
-			event.HostsRemoved = append(event.HostsRemoved, NewHostInfo(addr, r.getLabelsMap()))
-			changed = true
-		}
-	}
-	if changed {
-		return newMembersMap, event
-	}
-	return newMembersMap, nil
-}
-
 // BuildBroadcastHostPort return the listener hostport from an existing tchannel
 // and overrides the address with broadcastAddress if specified
 func BuildBroadcastHostPort(listenerPeerInfo tchannel.LocalPeerInfo, broadcastAddress string) (string, error) {
@@ -0,0 +1,338 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dgryski/go-farm"
+	"github.com/temporalio/ringpop-go/hashring"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	// membersSource returns the addresses of the reachable members of a service.
+	membersSource func() ([]string, error)
+
+	// serviceResolver maintains the consistent hash ring of a service, built from the members returned by
+	// its membersSource. The ring is rebuilt periodically and on request, and listeners are notified
+	// whenever the set of members changes.
+	serviceResolver struct {
+		status          int32
+		service         primitives.ServiceName
+		members         membersSource
+		refreshInterval time.Duration
+		refreshChan     chan struct{}
+		shutdownCh      chan struct{}
+		shutdownWG      sync.WaitGroup
+		logger          log.Logger
+
+		ringValue atomic.Value // this stores the current hashring
+
+		refreshLock     sync.Mutex
+		lastRefreshTime time.Time
+		membersMap      map[string]struct{} // for de-duping change notifications
+
+		listenerLock sync.RWMutex
+		listeners    map[string]chan<- *ChangedEvent
+	}
+)
+
+var _ ServiceResolver = (*serviceResolver)(nil)
+
+func newServiceResolver(
+	service primitives.ServiceName,
+	members membersSource,
+	refreshInterval time.Duration,
+	logger log.Logger,
+) *serviceResolver {
+
+	resolver := &serviceResolver{
+		status:          common.DaemonStatusInitialized,
+		service:         service,
+		members:         members,
+		refreshInterval: refreshInterval,
+		refreshChan:     make(chan struct{}),
+		shutdownCh:      make(chan struct{}),
+		logger:          log.With(logger, tag.ComponentServiceResolver, tag.Service(service)),
+		membersMap:      make(map[string]struct{}),
+		listeners:       make(map[string]chan<- *ChangedEvent),
+	}
+	resolver.ringValue.Store(newHashRing())
+	return resolver
+}
+
+func newHashRing() *hashring.HashRing {
+	return hashring.New(farm.Fingerprint32, replicaPoints)
+}
+
+// Start starts the resolver
+func (r *serviceResolver) Start() {
+	if err := r.start(nil); err != nil {
+		r.logger.Error("unable to refresh ring when starting service resolver", tag.Error(err))
+	}
+}
+
+// start builds the initial ring and starts the refresh worker. onStart, if not nil, is called before the
+// initial refresh. A failed initial refresh is returned and then retried by the refresh worker.
+func (r *serviceResolver) start(onStart func()) error {
+	if !atomic.CompareAndSwapInt32(
+		&r.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return nil
+	}
+
+	if onStart != nil {
+		onStart()
+	}
+	err := r.refresh()
+
+	r.shutdownWG.Add(1)
+	go r.refreshRingWorker()
+	return err
+}
+
+// Stop stops the resolver
+func (r *serviceResolver) Stop() {
+	r.stop(nil)
+}
+
+// stop stops the refresh worker and drops the ring and listeners. onStop, if not nil, is called before
+// the ring is dropped.
+func (r *serviceResolver) stop(onStop func()) {
+	if !atomic.CompareAndSwapInt32(
+		&r.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	if onStop != nil {
+		onStop()
+	}
+	r.ringValue.Store(newHashRing())
+	r.listeners = make(map[string]chan<- *ChangedEvent)
+	close(r.shutdownCh)
+
+	if success := common.AwaitWaitGroup(&r.shutdownWG, time.Minute); !success {
+		r.logger.Warn("service resolver timed out on shutdown.")
+	}
+}
+
+func (r *serviceResolver) RequestRefresh() {
+	select {
+	case r.refreshChan <- struct{}{}:
+	default:
+	}
+}
+
+// Lookup finds the host in the ring responsible for serving the given key
+func (r *serviceResolver) Lookup(
+	key string,
+) (*HostInfo, error) {
+
+	addr, found := r.ring().Lookup(key)
+	if !found {
+		r.RequestRefresh()
+		return nil, ErrInsufficientHosts
+	}
+
+	return NewHostInfo(addr, r.getLabelsMap()), nil
+}
+
+func (r *serviceResolver) AddListener(
+	name string,
+	notifyChannel chan<- *ChangedEvent,
+) error {
+
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	_, ok := r.listeners[name]
+	if ok {
+		return ErrListenerAlreadyExist
+	}
+	r.listeners[name] = notifyChannel
+	return nil
+}
+
+func (r *serviceResolver) RemoveListener(
+	name string,
+) error {
+
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	delete(r.listeners, name)
+	return nil
+}
+
+func (r *serviceResolver) MemberCount() int {
+	return r.ring().ServerCount()
+}
+
+func (r *serviceResolver) Members() []*HostInfo {
+	var servers []*HostInfo
+	for _, s := range r.ring().Servers() {
+		servers = append(servers, NewHostInfo(s, r.getLabelsMap()))
+	}
+
+	return servers
+}
+
+func (r *serviceResolver) refresh() error {
+	var event *ChangedEvent
+	var err error
+	defer func() {
+		if event != nil {
+			r.emitEvent(event)
+		}
+	}()
+	r.refreshLock.Lock()
+	defer r.refreshLock.Unlock()
+	event, err = r.refreshNoLock()
+	return err
+}
+
+func (r *serviceResolver) refreshWithBackoff() error {
+	var event *ChangedEvent
+	var err error
+	defer func() {
+		if event != nil {
+			r.emitEvent(event)
+		}
+	}()
+	r.refreshLock.Lock()
+	defer r.refreshLock.Unlock()
+	if r.lastRefreshTime.After(time.Now().UTC().Add(-minRefreshInternal)) {
+		// refresh too frequently
+		return nil
+	}
+	event, err = r.refreshNoLock()
+	return err
+}
+
+func (r *serviceResolver) refreshNoLock() (*ChangedEvent, error) {
+	addrs, err := r.members()
+	if err != nil {
+		return nil, err
+	}
+	r.lastRefreshTime = time.Now().UTC()
+
+	newMembersMap, changedEvent := r.compareMembers(addrs)
+	if changedEvent == nil {
+		return nil, nil
+	}
+
+	ring := newHashRing()
+	for _, addr := range addrs {
+		host := NewHostInfo(addr, r.getLabelsMap())
+		ring.AddMembers(host)
+	}
+
+	r.membersMap = newMembersMap
+	r.ringValue.Store(ring)
+	r.logger.Info("Current reachable members", tag.Addresses(addrs))
+
+	return changedEvent, nil
+}
+
+func (r *serviceResolver) emitEvent(event *ChangedEvent) {
+	// Notify listeners
+	r.listenerLock.RLock()
+	defer r.listenerLock.RUnlock()
+
+	for name, ch := range r.listeners {
+		select {
+		case ch <- event:
+		default:
+			r.logger.Error("Failed to send listener notification, channel full", tag.ListenerName(name))
+		}
+	}
+}
+
+func (r *serviceResolver) refreshRingWorker() {
+	defer r.shutdownWG.Done()
+
+	refreshTicker := time.NewTicker(r.refreshInterval)
+	defer refreshTicker.Stop()
+
+	for {
+		select {
+		case <-r.shutdownCh:
+			return
+		case <-r.refreshChan:
+			if err := r.refreshWithBackoff(); err != nil {
+				r.logger.Error("error refreshing ring by request", tag.Error(err))
+			}
+		case <-refreshTicker.C:
+			if err := r.refresh(); err != nil {
+				r.logger.Error("error periodically refreshing ring", tag.Error(err))
+			}
+		}
+	}
+}
+
+func (r *serviceResolver) ring() *hashring.HashRing {
+	return r.ringValue.Load().(*hashring.HashRing)
+}
+
+func (r *serviceResolver) getLabelsMap() map[string]string {
+	labels := make(map[string]string)
+	labels[RoleKey] = string(r.service)
+	return labels
+}
+
+func (r *serviceResolver) compareMembers(addrs []string) (map[string]struct{}, *ChangedEvent) {
+	event := &ChangedEvent{}
+	changed := false
+	newMembersMap := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		newMembersMap[addr] = struct{}{}
+		if _, ok := r.membersMap[addr]; !ok {
+			event.HostsAdded = append(event.HostsAdded, NewHostInfo(addr, r.getLabelsMap()))
+			changed = true
+		}
+	}
+	for addr := range r.membersMap {
+		if _, ok := newMembersMap[addr]; !ok {
+			event.HostsRemoved = append(event.HostsRemoved, NewHostInfo(addr, r.getLabelsMap()))
+			changed = true
+		}
+	}
+	if changed {
+		return newMembersMap, event
+	}
+	return newMembersMap, nil
+}
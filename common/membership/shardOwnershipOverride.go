@@ -0,0 +1,413 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	shardOwnershipOverrideOperationTimeout = 10 * time.Second
+)
+
+type (
+	// shardOwnershipOverrideMonitor wraps a Monitor so that lookups of history shards honor the
+	// shard ownership overrides stored in cluster metadata. All other services are served by the
+	// wrapped Monitor unchanged.
+	shardOwnershipOverrideMonitor struct {
+		Monitor
+
+		resolver *shardOwnershipOverrideResolver
+	}
+
+	// shardOwnershipOverrideResolver resolves a history shard to the host it was moved to, as
+	// long as the override has not expired and the host is still a member of the ring, and falls
+	// back to the consistent hash ring of the wrapped ServiceResolver otherwise.
+	//
+	// Overrides are reloaded periodically, when the earliest active override expires, and on
+	// RequestRefresh, which hosts call when they find out that their view of shard ownership is
+	// stale, e.g. on ShardOwnershipLost.
+	shardOwnershipOverrideResolver struct {
+		ServiceResolver
+
+		status                 int32
+		clusterMetadataManager persistence.ClusterMetadataManager
+		refreshInterval        dynamicconfig.DurationPropertyFn
+		timeSource             clock.TimeSource
+		logger                 log.Logger
+		lifecycleCtx           context.Context
+		lifecycleCancel        context.CancelFunc
+		shutdownWG             sync.WaitGroup
+		refreshChan            chan struct{}
+
+		refreshLock     sync.Mutex
+		lastRefreshTime time.Time
+		overrides       atomic.Value // map[int32]*persistencespb.ShardOwnershipOverride
+
+		listenerLock sync.RWMutex
+		listeners    map[string]chan<- *ChangedEvent
+	}
+)
+
+var _ Monitor = (*shardOwnershipOverrideMonitor)(nil)
+var _ ServiceResolver = (*shardOwnershipOverrideResolver)(nil)
+
+// NewShardOwnershipOverrideMonitor returns a Monitor which resolves history shards according to
+// the shard ownership overrides in cluster metadata, refreshing them every refreshInterval.
+func NewShardOwnershipOverrideMonitor(
+	monitor Monitor,
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	refreshInterval dynamicconfig.DurationPropertyFn,
+	logger log.Logger,
+) (Monitor, error) {
+	historyResolver, err := monitor.GetResolver(primitives.HistoryService)
+	if err == ErrUnknownService {
+		// history hosts are not tracked by this monitor, so there is nothing to override
+		return monitor, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &shardOwnershipOverrideMonitor{
+		Monitor: monitor,
+		resolver: newShardOwnershipOverrideResolver(
+			historyResolver,
+			clusterMetadataManager,
+			refreshInterval,
+			clock.NewRealTimeSource(),
+			logger,
+		),
+	}, nil
+}
+
+func (m *shardOwnershipOverrideMonitor) Start() {
+	m.Monitor.Start()
+	m.resolver.Start()
+}
+
+func (m *shardOwnershipOverrideMonitor) Stop() {
+	m.resolver.Stop()
+	m.Monitor.Stop()
+}
+
+func (m *shardOwnershipOverrideMonitor) Lookup(service primitives.ServiceName, key string) (*HostInfo, error) {
+	if service == primitives.HistoryService {
+		return m.resolver.Lookup(key)
+	}
+	return m.Monitor.Lookup(service, key)
+}
+
+func (m *shardOwnershipOverrideMonitor) GetResolver(service primitives.ServiceName) (ServiceResolver, error) {
+	if service == primitives.HistoryService {
+		return m.resolver, nil
+	}
+	return m.Monitor.GetResolver(service)
+}
+
+func (m *shardOwnershipOverrideMonitor) AddListener(service primitives.ServiceName, name string, notifyChannel chan<- *ChangedEvent) error {
+	if service == primitives.HistoryService {
+		return m.resolver.AddListener(name, notifyChannel)
+	}
+	return m.Monitor.AddListener(service, name, notifyChannel)
+}
+
+func (m *shardOwnershipOverrideMonitor) RemoveListener(service primitives.ServiceName, name string) error {
+	if service == primitives.HistoryService {
+		return m.resolver.RemoveListener(name)
+	}
+	return m.Monitor.RemoveListener(service, name)
+}
+
+func newShardOwnershipOverrideResolver(
+	resolver ServiceResolver,
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	refreshInterval dynamicconfig.DurationPropertyFn,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *shardOwnershipOverrideResolver {
+
+	lifecycleCtx, lifecycleCancel := context.WithCancel(context.Background())
+	lifecycleCtx = headers.SetCallerInfo(
+		lifecycleCtx,
+		headers.SystemBackgroundCallerInfo,
+	)
+
+	r := &shardOwnershipOverrideResolver{
+		ServiceResolver:        resolver,
+		status:                 common.DaemonStatusInitialized,
+		clusterMetadataManager: clusterMetadataManager,
+		refreshInterval:        refreshInterval,
+		timeSource:             timeSource,
+		logger:                 log.With(logger, tag.ComponentServiceResolver, tag.Service(primitives.HistoryService)),
+		lifecycleCtx:           lifecycleCtx,
+		lifecycleCancel:        lifecycleCancel,
+		refreshChan:            make(chan struct{}, 1),
+		listeners:              make(map[string]chan<- *ChangedEvent),
+	}
+	r.overrides.Store(map[int32]*persistencespb.ShardOwnershipOverride{})
+	return r
+}
+
+// Start starts the refresh of shard ownership overrides
+func (r *shardOwnershipOverrideResolver) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&r.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+
+	if err := r.refresh(); err != nil {
+		r.logger.Error("unable to load shard ownership overrides", tag.Error(err))
+	}
+
+	r.shutdownWG.Add(1)
+	go r.refreshLoop()
+}
+
+// Stop stops the refresh of shard ownership overrides
+func (r *shardOwnershipOverrideResolver) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&r.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+
+	r.lifecycleCancel()
+	if success := common.AwaitWaitGroup(&r.shutdownWG, time.Minute); !success {
+		r.logger.Warn("shard ownership override resolver timed out on shutdown.")
+	}
+}
+
+// Lookup returns the host a shard was moved to if there is an active override for the shard,
+// and the owner in the hash ring otherwise. Keys which are not shard IDs are never overridden.
+func (r *shardOwnershipOverrideResolver) Lookup(key string) (*HostInfo, error) {
+	if shardID, err := strconv.ParseInt(key, 10, 32); err == nil {
+		if host := r.overrideOwner(int32(shardID)); host != nil {
+			return host, nil
+		}
+	}
+	return r.ServiceResolver.Lookup(key)
+}
+
+// RequestRefresh requests a refresh of the wrapped resolver and an asynchronous reload of the
+// shard ownership overrides. Reloads are rate limited.
+func (r *shardOwnershipOverrideResolver) RequestRefresh() {
+	r.ServiceResolver.RequestRefresh()
+	select {
+	case r.refreshChan <- struct{}{}:
+	default:
+	}
+}
+
+// AddListener adds a listener which is notified on membership changes of the wrapped resolver
+// as well as on changes to the active shard ownership overrides.
+func (r *shardOwnershipOverrideResolver) AddListener(name string, notifyChannel chan<- *ChangedEvent) error {
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+
+	if _, ok := r.listeners[name]; ok {
+		return ErrListenerAlreadyExist
+	}
+	if err := r.ServiceResolver.AddListener(name, notifyChannel); err != nil {
+		return err
+	}
+	r.listeners[name] = notifyChannel
+	return nil
+}
+
+// RemoveListener removes a listener
+func (r *shardOwnershipOverrideResolver) RemoveListener(name string) error {
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+
+	if _, ok := r.listeners[name]; !ok {
+		return nil
+	}
+	delete(r.listeners, name)
+	return r.ServiceResolver.RemoveListener(name)
+}
+
+func (r *shardOwnershipOverrideResolver) overrideOwner(shardID int32) *HostInfo {
+	override, ok := r.currentOverrides()[shardID]
+	if !ok || !r.timeSource.Now().Before(timestamp.TimeValue(override.GetExpireTime())) {
+		return nil
+	}
+	for _, member := range r.ServiceResolver.Members() {
+		if member.GetAddress() == override.GetHostAddress() {
+			return member
+		}
+	}
+	return nil
+}
+
+func (r *shardOwnershipOverrideResolver) currentOverrides() map[int32]*persistencespb.ShardOwnershipOverride {
+	return r.overrides.Load().(map[int32]*persistencespb.ShardOwnershipOverride)
+}
+
+func (r *shardOwnershipOverrideResolver) refreshLoop() {
+	defer r.shutdownWG.Done()
+
+	timer := time.NewTimer(r.nextRefreshDelay())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-r.lifecycleCtx.Done():
+			return
+		case <-r.refreshChan:
+			if err := r.refreshWithBackoff(); err != nil {
+				r.logger.Error("error refreshing shard ownership overrides by request", tag.Error(err))
+			}
+		case <-timer.C:
+			if err := r.refresh(); err != nil {
+				r.logger.Error("error refreshing shard ownership overrides", tag.Error(err))
+			}
+			timer.Reset(r.nextRefreshDelay())
+		}
+	}
+}
+
+// nextRefreshDelay returns the time until the next periodic refresh, which is brought forward to the
+// expiry of the earliest active override so that listeners learn about the expiry right away.
+func (r *shardOwnershipOverrideResolver) nextRefreshDelay() time.Duration {
+	delay := r.refreshInterval()
+	now := r.timeSource.Now()
+	for _, override := range r.currentOverrides() {
+		// expired overrides are already ignored by Lookup, and are dropped by the next successful refresh
+		if untilExpiry := timestamp.TimeValue(override.GetExpireTime()).Sub(now); untilExpiry > 0 && untilExpiry < delay {
+			delay = untilExpiry
+		}
+	}
+	return delay
+}
+
+func (r *shardOwnershipOverrideResolver) refreshWithBackoff() error {
+	r.refreshLock.Lock()
+	defer r.refreshLock.Unlock()
+	if r.lastRefreshTime.After(r.timeSource.Now().Add(-minRefreshInternal)) {
+		// refresh too frequently
+		return nil
+	}
+	return r.refreshLocked()
+}
+
+func (r *shardOwnershipOverrideResolver) refresh() error {
+	r.refreshLock.Lock()
+	defer r.refreshLock.Unlock()
+	return r.refreshLocked()
+}
+
+func (r *shardOwnershipOverrideResolver) refreshLocked() error {
+	ctx, cancel := context.WithTimeout(r.lifecycleCtx, shardOwnershipOverrideOperationTimeout)
+	defer cancel()
+
+	var overrides []*persistencespb.ShardOwnershipOverride
+	resp, err := r.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	switch err.(type) {
+	case nil:
+		overrides = resp.ShardOwnershipOverrides
+	case *serviceerror.NotFound:
+	default:
+		return err
+	}
+	r.lastRefreshTime = r.timeSource.Now()
+
+	newOverrides := make(map[int32]*persistencespb.ShardOwnershipOverride)
+	for _, override := range ActiveShardOwnershipOverrides(overrides, r.timeSource.Now()) {
+		newOverrides[override.GetShardId()] = override
+	}
+	if shardOwnershipOverridesEqual(r.currentOverrides(), newOverrides) {
+		return nil
+	}
+
+	r.overrides.Store(newOverrides)
+	r.logger.Info("Shard ownership overrides changed", tag.Counter(len(newOverrides)))
+	r.emitEvent(&ChangedEvent{})
+	return nil
+}
+
+func (r *shardOwnershipOverrideResolver) emitEvent(event *ChangedEvent) {
+	r.listenerLock.RLock()
+	defer r.listenerLock.RUnlock()
+
+	for name, ch := range r.listeners {
+		select {
+		case ch <- event:
+		default:
+			r.logger.Error("Failed to send listener notification, channel full", tag.ListenerName(name))
+		}
+	}
+}
+
+// ActiveShardOwnershipOverrides returns the overrides which have not expired at the given time.
+func ActiveShardOwnershipOverrides(
+	overrides []*persistencespb.ShardOwnershipOverride,
+	now time.Time,
+) []*persistencespb.ShardOwnershipOverride {
+	var active []*persistencespb.ShardOwnershipOverride
+	for _, override := range overrides {
+		if now.Before(timestamp.TimeValue(override.GetExpireTime())) {
+			active = append(active, override)
+		}
+	}
+	return active
+}
+
+func shardOwnershipOverridesEqual(
+	a map[int32]*persistencespb.ShardOwnershipOverride,
+	b map[int32]*persistencespb.ShardOwnershipOverride,
+) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for shardID, override := range a {
+		other, ok := b[shardID]
+		if !ok || !override.Equal(other) {
+			return false
+		}
+	}
+	return true
+}
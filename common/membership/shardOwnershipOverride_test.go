@@ -0,0 +1,201 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	shardOwnershipOverrideSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		controller      *gomock.Controller
+		metadataManager *persistence.MockClusterMetadataManager
+		mockResolver    *MockServiceResolver
+		timeSource      *clock.EventTimeSource
+
+		resolver *shardOwnershipOverrideResolver
+	}
+)
+
+func TestShardOwnershipOverrideSuite(t *testing.T) {
+	suite.Run(t, new(shardOwnershipOverrideSuite))
+}
+
+func (s *shardOwnershipOverrideSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.metadataManager = persistence.NewMockClusterMetadataManager(s.controller)
+	s.mockResolver = NewMockServiceResolver(s.controller)
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+
+	s.mockResolver.EXPECT().Members().Return([]*HostInfo{
+		NewHostInfo("10.0.0.1:7234", nil),
+		NewHostInfo("10.0.0.2:7234", nil),
+	}).AnyTimes()
+
+	s.resolver = newShardOwnershipOverrideResolver(
+		s.mockResolver,
+		s.metadataManager,
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		s.timeSource,
+		log.NewNoopLogger(),
+	)
+}
+
+func (s *shardOwnershipOverrideSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *shardOwnershipOverrideSuite) expectOverrides(overrides ...*persistencespb.ShardOwnershipOverride) {
+	s.metadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{ShardOwnershipOverrides: overrides},
+	}, nil)
+}
+
+func (s *shardOwnershipOverrideSuite) override(shardID int32, address string, ttl time.Duration) *persistencespb.ShardOwnershipOverride {
+	return &persistencespb.ShardOwnershipOverride{
+		ShardId:     shardID,
+		HostAddress: address,
+		ExpireTime:  timestamp.TimePtr(s.timeSource.Now().Add(ttl)),
+	}
+}
+
+func (s *shardOwnershipOverrideSuite) TestLookup_Override() {
+	s.expectOverrides(s.override(1, "10.0.0.2:7234", time.Hour))
+	s.NoError(s.resolver.refresh())
+
+	host, err := s.resolver.Lookup("1")
+	s.NoError(err)
+	s.Equal("10.0.0.2:7234", host.GetAddress())
+
+	s.mockResolver.EXPECT().Lookup("2").Return(NewHostInfo("10.0.0.1:7234", nil), nil)
+	host, err = s.resolver.Lookup("2")
+	s.NoError(err)
+	s.Equal("10.0.0.1:7234", host.GetAddress())
+}
+
+func (s *shardOwnershipOverrideSuite) TestLookup_ExpiredOverride() {
+	s.expectOverrides(s.override(1, "10.0.0.2:7234", time.Minute))
+	s.NoError(s.resolver.refresh())
+
+	s.timeSource.Update(s.timeSource.Now().Add(2 * time.Minute))
+	s.mockResolver.EXPECT().Lookup("1").Return(NewHostInfo("10.0.0.1:7234", nil), nil)
+	host, err := s.resolver.Lookup("1")
+	s.NoError(err)
+	s.Equal("10.0.0.1:7234", host.GetAddress())
+}
+
+func (s *shardOwnershipOverrideSuite) TestLookup_HostNotMember() {
+	s.expectOverrides(s.override(1, "10.0.0.3:7234", time.Hour))
+	s.NoError(s.resolver.refresh())
+
+	s.mockResolver.EXPECT().Lookup("1").Return(NewHostInfo("10.0.0.1:7234", nil), nil)
+	host, err := s.resolver.Lookup("1")
+	s.NoError(err)
+	s.Equal("10.0.0.1:7234", host.GetAddress())
+}
+
+func (s *shardOwnershipOverrideSuite) TestRefresh_NotifiesOnChange() {
+	notifyCh := make(chan *ChangedEvent, 1)
+	s.mockResolver.EXPECT().AddListener("test", gomock.Any()).Return(nil)
+	s.NoError(s.resolver.AddListener("test", notifyCh))
+
+	override := s.override(1, "10.0.0.2:7234", time.Hour)
+	s.expectOverrides(override)
+	s.NoError(s.resolver.refresh())
+	s.Len(notifyCh, 1)
+	<-notifyCh
+
+	// unchanged overrides do not notify
+	s.expectOverrides(override)
+	s.NoError(s.resolver.refresh())
+	s.Len(notifyCh, 0)
+
+	s.metadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(nil, serviceerror.NewNotFound("not found"))
+	s.NoError(s.resolver.refresh())
+	s.Len(notifyCh, 1)
+
+	s.mockResolver.EXPECT().Lookup("1").Return(NewHostInfo("10.0.0.1:7234", nil), nil)
+	host, err := s.resolver.Lookup("1")
+	s.NoError(err)
+	s.Equal("10.0.0.1:7234", host.GetAddress())
+}
+
+func (s *shardOwnershipOverrideSuite) TestRefreshWithBackoff() {
+	s.expectOverrides(s.override(1, "10.0.0.2:7234", time.Hour))
+	s.NoError(s.resolver.refreshWithBackoff())
+
+	// requested refreshes right after a refresh are skipped
+	s.NoError(s.resolver.refreshWithBackoff())
+
+	s.timeSource.Update(s.timeSource.Now().Add(minRefreshInternal + time.Second))
+	s.expectOverrides()
+	s.NoError(s.resolver.refreshWithBackoff())
+
+	s.mockResolver.EXPECT().Lookup("1").Return(NewHostInfo("10.0.0.1:7234", nil), nil)
+	host, err := s.resolver.Lookup("1")
+	s.NoError(err)
+	s.Equal("10.0.0.1:7234", host.GetAddress())
+}
+
+func (s *shardOwnershipOverrideSuite) TestNextRefreshDelay() {
+	s.Equal(time.Minute, s.resolver.nextRefreshDelay())
+
+	s.expectOverrides(
+		s.override(1, "10.0.0.2:7234", time.Hour),
+		s.override(2, "10.0.0.2:7234", 10*time.Second),
+	)
+	s.NoError(s.resolver.refresh())
+	s.Equal(10*time.Second, s.resolver.nextRefreshDelay())
+
+	// an override that expired without a successful refresh does not cause a refresh loop
+	s.timeSource.Update(s.timeSource.Now().Add(20 * time.Second))
+	s.Equal(time.Minute, s.resolver.nextRefreshDelay())
+}
+
+func (s *shardOwnershipOverrideSuite) TestRequestRefresh() {
+	s.mockResolver.EXPECT().RequestRefresh().Times(2)
+
+	// requests are coalesced and never block the caller
+	s.resolver.RequestRefresh()
+	s.resolver.RequestRefresh()
+	s.Len(s.resolver.refreshChan, 1)
+}
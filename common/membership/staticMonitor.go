@@ -0,0 +1,445 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"context"
+	"math/rand"
+	"net"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pborman/uuid"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+)
+
+const (
+	// DefaultStaticRefreshInterval is the default interval at which the static monitor rediscovers hosts
+	DefaultStaticRefreshInterval = defaultRefreshInterval
+
+	staticHeartbeatInterval         = 10 * time.Second
+	staticHeartbeatJitter           = 5 * time.Second
+	staticOperationTimeout          = 10 * time.Second
+	staticGetClusterMembersPageSize = 1000
+	// evictedRecordExpiry is used to expire the membership record of an evicted host right away
+	evictedRecordExpiry = time.Second
+)
+
+// staticMonitor is a membership monitor which discovers the hosts of every service from a HostSource
+// (a static list or DNS SRV records) and only considers those that keep heartbeating into the
+// cluster_membership table. Services without a HostSource are discovered from heartbeats alone.
+// Unlike ringpopMonitor, hosts heartbeat with their gRPC address rather than a ringpop address,
+// so both monitors must not be mixed within a cluster.
+type staticMonitor struct {
+	status int32
+
+	lifecycleCtx    context.Context
+	lifecycleCancel context.CancelFunc
+	shutdownWG      sync.WaitGroup
+
+	serviceName               primitives.ServiceName
+	services                  map[primitives.ServiceName]int
+	sources                   map[primitives.ServiceName]HostSource
+	rings                     map[primitives.ServiceName]*staticServiceResolver
+	logger                    log.Logger
+	metadataManager           persistence.ClusterMetadataManager
+	broadcastHostPortResolver func() (string, error)
+	hostID                    uuid.UUID
+
+	heartbeatLock    sync.Mutex
+	heartbeatRequest *persistence.UpsertClusterMembershipRequest
+	evicted          bool
+}
+
+var _ Monitor = (*staticMonitor)(nil)
+
+// NewStaticMonitor returns a membership monitor discovering hosts from the given sources and
+// cluster_membership heartbeats. broadcastHostPortResolver must return the IP address other hosts
+// reach this host on, the port is ignored in favor of the gRPC port of the service.
+func NewStaticMonitor(
+	serviceName primitives.ServiceName,
+	services map[primitives.ServiceName]int,
+	sources map[primitives.ServiceName]HostSource,
+	refreshInterval time.Duration,
+	logger log.Logger,
+	metadataManager persistence.ClusterMetadataManager,
+	broadcastHostPortResolver func() (string, error),
+) Monitor {
+
+	lifecycleCtx, lifecycleCancel := context.WithCancel(context.Background())
+	lifecycleCtx = headers.SetCallerInfo(
+		lifecycleCtx,
+		headers.SystemBackgroundCallerInfo,
+	)
+
+	if refreshInterval <= 0 {
+		refreshInterval = DefaultStaticRefreshInterval
+	}
+
+	m := &staticMonitor{
+		status: common.DaemonStatusInitialized,
+
+		lifecycleCtx:    lifecycleCtx,
+		lifecycleCancel: lifecycleCancel,
+
+		serviceName:               serviceName,
+		services:                  services,
+		sources:                   sources,
+		rings:                     make(map[primitives.ServiceName]*staticServiceResolver),
+		logger:                    logger,
+		metadataManager:           metadataManager,
+		broadcastHostPortResolver: broadcastHostPortResolver,
+		hostID:                    uuid.NewUUID(),
+	}
+	for service := range services {
+		service := service
+		m.rings[service] = newStaticServiceResolver(
+			service,
+			func() ([]string, error) { return m.members(service) },
+			refreshInterval,
+			logger,
+		)
+	}
+	return m
+}
+
+func (m *staticMonitor) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&m.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+
+	request, err := m.newHeartbeatRequest()
+	if err != nil {
+		m.logger.Fatal("unable to initialize membership heartbeats", tag.Error(err))
+	}
+	m.heartbeatLock.Lock()
+	m.heartbeatRequest = request
+	m.heartbeatLock.Unlock()
+
+	// Start by cleaning up expired records to avoid growth
+	if err := m.metadataManager.PruneClusterMembership(
+		m.lifecycleCtx,
+		&persistence.PruneClusterMembershipRequest{MaxRecordsPruned: 10},
+	); err != nil {
+		m.logger.Error("Membership prune failed.", tag.Error(err))
+	}
+
+	// Upsert before building the rings, so this host discovers itself.
+	if err := m.heartbeat(); err != nil {
+		m.logger.Fatal("unable to initialize membership heartbeats", tag.Error(err))
+	}
+	m.logger.Info("Membership heartbeat upserted successfully",
+		tag.Address(request.RPCAddress.String()),
+		tag.Port(int(request.RPCPort)),
+		tag.HostID(m.hostID.String()))
+
+	m.shutdownWG.Add(1)
+	go m.heartbeatLoop()
+
+	for _, ring := range m.rings {
+		ring.Start()
+	}
+}
+
+func (m *staticMonitor) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&m.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+
+	m.lifecycleCancel()
+
+	for _, ring := range m.rings {
+		ring.Stop()
+	}
+
+	if success := common.AwaitWaitGroup(&m.shutdownWG, time.Minute); !success {
+		m.logger.Warn("static membership monitor timed out on shutdown.")
+	}
+}
+
+func (m *staticMonitor) WhoAmI() (*HostInfo, error) {
+	servicePort, ok := m.services[m.serviceName]
+	if !ok {
+		return nil, ErrUnknownService
+	}
+
+	address, err := m.broadcastHostPortResolver()
+	if err != nil {
+		return nil, err
+	}
+	serviceAddress, err := replaceServicePort(address, servicePort)
+	if err != nil {
+		return nil, err
+	}
+	return NewHostInfo(serviceAddress, map[string]string{
+		RoleKey:  string(m.serviceName),
+		RolePort: convert.IntToString(servicePort),
+	}), nil
+}
+
+// EvictSelf stops heartbeating and expires the membership record of this host,
+// so other hosts drop it from their rings on their next refresh.
+func (m *staticMonitor) EvictSelf() error {
+	if err := m.expireHeartbeat(); err != nil {
+		return err
+	}
+
+	if ring, ok := m.rings[m.serviceName]; ok {
+		if err := ring.refresh(); err != nil {
+			m.logger.Error("error refreshing ring after self eviction", tag.Error(err))
+		}
+	}
+	return nil
+}
+
+func (m *staticMonitor) GetResolver(service primitives.ServiceName) (ServiceResolver, error) {
+	ring, found := m.rings[service]
+	if !found {
+		return nil, ErrUnknownService
+	}
+	return ring, nil
+}
+
+func (m *staticMonitor) Lookup(service primitives.ServiceName, key string) (*HostInfo, error) {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return nil, err
+	}
+	return ring.Lookup(key)
+}
+
+func (m *staticMonitor) AddListener(service primitives.ServiceName, name string, notifyChannel chan<- *ChangedEvent) error {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return ring.AddListener(name, notifyChannel)
+}
+
+func (m *staticMonitor) RemoveListener(service primitives.ServiceName, name string) error {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return ring.RemoveListener(name)
+}
+
+// GetReachableMembers returns the addresses of the members of all service rings
+func (m *staticMonitor) GetReachableMembers() ([]string, error) {
+	var members []string
+	for _, ring := range m.rings {
+		for _, host := range ring.Members() {
+			members = append(members, host.GetAddress())
+		}
+	}
+	sort.Strings(members)
+	return members, nil
+}
+
+func (m *staticMonitor) GetMemberCount(service primitives.ServiceName) (int, error) {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return 0, err
+	}
+	return ring.MemberCount(), nil
+}
+
+func (m *staticMonitor) newHeartbeatRequest() (*persistence.UpsertClusterMembershipRequest, error) {
+	hostInfo, err := m.WhoAmI()
+	if err != nil {
+		return nil, err
+	}
+
+	address, port, err := SplitHostPortTyped(hostInfo.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	if address == nil {
+		return nil, ErrIncorrectAddressFormat
+	}
+
+	role, err := ServiceNameToServiceTypeEnum(m.serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &persistence.UpsertClusterMembershipRequest{
+		Role:         role,
+		RPCAddress:   address,
+		RPCPort:      port,
+		SessionStart: time.Now().UTC(),
+		RecordExpiry: upsertMembershipRecordExpiryDefault,
+		HostID:       m.hostID,
+	}, nil
+}
+
+func (m *staticMonitor) heartbeat() error {
+	m.heartbeatLock.Lock()
+	defer m.heartbeatLock.Unlock()
+
+	if m.evicted {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(m.lifecycleCtx, staticOperationTimeout)
+	defer cancel()
+	return m.metadataManager.UpsertClusterMembership(ctx, m.heartbeatRequest)
+}
+
+func (m *staticMonitor) expireHeartbeat() error {
+	m.heartbeatLock.Lock()
+	defer m.heartbeatLock.Unlock()
+
+	wasEvicted := m.evicted
+	m.evicted = true
+	if wasEvicted || m.heartbeatRequest == nil {
+		return nil
+	}
+
+	request := *m.heartbeatRequest
+	request.RecordExpiry = evictedRecordExpiry
+
+	ctx, cancel := context.WithTimeout(m.lifecycleCtx, staticOperationTimeout)
+	defer cancel()
+	return m.metadataManager.UpsertClusterMembership(ctx, &request)
+}
+
+func (m *staticMonitor) heartbeatLoop() {
+	defer m.shutdownWG.Done()
+
+	for {
+		jitter := time.Duration(rand.Int63n(int64(staticHeartbeatJitter)))
+		timer := time.NewTimer(staticHeartbeatInterval + jitter)
+		select {
+		case <-m.lifecycleCtx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if err := m.heartbeat(); err != nil {
+			m.logger.Error("Membership upsert failed.", tag.Error(err))
+		}
+	}
+}
+
+// members returns the addresses of the hosts of the given service which are listed by its
+// HostSource, if any, and have heartbeated recently.
+func (m *staticMonitor) members(service primitives.ServiceName) ([]string, error) {
+	ctx, cancel := context.WithTimeout(m.lifecycleCtx, staticOperationTimeout)
+	defer cancel()
+
+	heartbeating, err := m.heartbeatingMembers(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+
+	if service == m.serviceName {
+		if address, evicted := m.evictedAddress(); evicted {
+			delete(heartbeating, address)
+		}
+	}
+
+	var members []string
+	if source, ok := m.sources[service]; ok {
+		candidates, err := source.Hosts(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, candidate := range candidates {
+			if _, ok := heartbeating[candidate]; ok {
+				members = append(members, candidate)
+			}
+		}
+	} else {
+		for member := range heartbeating {
+			members = append(members, member)
+		}
+	}
+
+	sort.Strings(members)
+	return members, nil
+}
+
+func (m *staticMonitor) heartbeatingMembers(
+	ctx context.Context,
+	service primitives.ServiceName,
+) (map[string]struct{}, error) {
+	role, err := ServiceNameToServiceTypeEnum(service)
+	if err != nil {
+		return nil, err
+	}
+
+	set := make(map[string]struct{})
+	var nextPageToken []byte
+	for {
+		resp, err := m.metadataManager.GetClusterMembers(ctx, &persistence.GetClusterMembersRequest{
+			LastHeartbeatWithin: healthyHostLastHeartbeatCutoff,
+			RoleEquals:          role,
+			PageSize:            staticGetClusterMembersPageSize,
+			NextPageToken:       nextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, member := range resp.ActiveMembers {
+			set[net.JoinHostPort(member.RPCAddress.String(), convert.Uint16ToString(member.RPCPort))] = struct{}{}
+		}
+
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			return set, nil
+		}
+	}
+}
+
+// evictedAddress returns the address this host heartbeated with, if it has been evicted
+func (m *staticMonitor) evictedAddress() (string, bool) {
+	m.heartbeatLock.Lock()
+	defer m.heartbeatLock.Unlock()
+
+	if !m.evicted || m.heartbeatRequest == nil {
+		return "", false
+	}
+	return net.JoinHostPort(m.heartbeatRequest.RPCAddress.String(), convert.Uint16ToString(m.heartbeatRequest.RPCPort)), true
+}
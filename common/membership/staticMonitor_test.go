@@ -0,0 +1,231 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"context"
+	"net"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	staticMonitorSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		controller      *gomock.Controller
+		metadataManager *persistence.MockClusterMetadataManager
+
+		lock    sync.Mutex
+		members map[persistence.ServiceType][]*persistence.ClusterMember
+	}
+
+	fakeHostSource []string
+)
+
+func TestStaticMonitorSuite(t *testing.T) {
+	suite.Run(t, new(staticMonitorSuite))
+}
+
+func (s *staticMonitorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.metadataManager = persistence.NewMockClusterMetadataManager(s.controller)
+	s.members = make(map[persistence.ServiceType][]*persistence.ClusterMember)
+
+	s.metadataManager.EXPECT().PruneClusterMembership(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.metadataManager.EXPECT().GetClusterMembers(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetClusterMembersRequest) (*persistence.GetClusterMembersResponse, error) {
+			s.lock.Lock()
+			defer s.lock.Unlock()
+			return &persistence.GetClusterMembersResponse{ActiveMembers: s.members[request.RoleEquals]}, nil
+		},
+	).AnyTimes()
+}
+
+func (s *staticMonitorSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *staticMonitorSuite) heartbeat(role persistence.ServiceType, address string, port uint16) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.members[role] = append(s.members[role], &persistence.ClusterMember{
+		Role:       role,
+		RPCAddress: net.ParseIP(address),
+		RPCPort:    port,
+	})
+}
+
+func (s *staticMonitorSuite) newMonitor(sources map[primitives.ServiceName]HostSource) *staticMonitor {
+	return NewStaticMonitor(
+		primitives.HistoryService,
+		map[primitives.ServiceName]int{
+			primitives.FrontendService: 7233,
+			primitives.HistoryService:  7234,
+		},
+		sources,
+		time.Minute,
+		log.NewNoopLogger(),
+		s.metadataManager,
+		func() (string, error) { return "10.0.0.1:0", nil },
+	).(*staticMonitor)
+}
+
+func (s *staticMonitorSuite) TestMembersFromHostSourceAndHeartbeats() {
+	s.metadataManager.EXPECT().UpsertClusterMembership(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpsertClusterMembershipRequest) error {
+			s.Equal(persistence.History, request.Role)
+			s.Equal("10.0.0.1", request.RPCAddress.String())
+			s.Equal(uint16(7234), request.RPCPort)
+			s.heartbeat(request.Role, request.RPCAddress.String(), request.RPCPort)
+			return nil
+		},
+	)
+	s.heartbeat(persistence.History, "10.0.0.2", 7234)
+	s.heartbeat(persistence.History, "10.0.0.9", 7234)
+	s.heartbeat(persistence.Frontend, "10.0.0.3", 7233)
+
+	monitor := s.newMonitor(map[primitives.ServiceName]HostSource{
+		primitives.HistoryService: fakeHostSource{"10.0.0.1:7234", "10.0.0.2:7234", "10.0.0.4:7234"},
+	})
+	monitor.Start()
+	defer monitor.Stop()
+
+	// 10.0.0.4 is listed but does not heartbeat, 10.0.0.9 heartbeats but is not listed
+	s.Equal([]string{"10.0.0.1:7234", "10.0.0.2:7234"}, s.memberAddresses(monitor, primitives.HistoryService))
+	// services without a host source are discovered from heartbeats alone
+	s.Equal([]string{"10.0.0.3:7233"}, s.memberAddresses(monitor, primitives.FrontendService))
+
+	members, err := monitor.GetReachableMembers()
+	s.NoError(err)
+	s.Equal([]string{"10.0.0.1:7234", "10.0.0.2:7234", "10.0.0.3:7233"}, members)
+
+	self, err := monitor.WhoAmI()
+	s.NoError(err)
+	s.Equal("10.0.0.1:7234", self.GetAddress())
+	role, _ := self.Label(RoleKey)
+	s.Equal(string(primitives.HistoryService), role)
+
+	// lookups are consistent with the ringpop hash ring
+	ring := newHashRing()
+	ring.AddMembers(NewHostInfo("10.0.0.1:7234", nil), NewHostInfo("10.0.0.2:7234", nil))
+	for _, key := range []string{"1", "2", "3", "4", "5"} {
+		expected, ok := ring.Lookup(key)
+		s.True(ok)
+		host, err := monitor.Lookup(primitives.HistoryService, key)
+		s.NoError(err)
+		s.Equal(expected, host.GetAddress())
+	}
+
+	_, err = monitor.GetResolver(primitives.MatchingService)
+	s.ErrorIs(err, ErrUnknownService)
+}
+
+func (s *staticMonitorSuite) TestMembershipChangeNotifiesListeners() {
+	s.metadataManager.EXPECT().UpsertClusterMembership(gomock.Any(), gomock.Any()).Return(nil)
+
+	monitor := s.newMonitor(nil)
+	monitor.Start()
+	defer monitor.Stop()
+
+	listenCh := make(chan *ChangedEvent, 1)
+	s.NoError(monitor.AddListener(primitives.FrontendService, "test-listener", listenCh))
+
+	s.heartbeat(persistence.Frontend, "10.0.0.3", 7233)
+	s.NoError(monitor.rings[primitives.FrontendService].refresh())
+
+	event := <-listenCh
+	s.Len(event.HostsAdded, 1)
+	s.Equal("10.0.0.3:7233", event.HostsAdded[0].GetAddress())
+	count, err := monitor.GetMemberCount(primitives.FrontendService)
+	s.NoError(err)
+	s.Equal(1, count)
+}
+
+func (s *staticMonitorSuite) TestEvictSelf() {
+	s.metadataManager.EXPECT().UpsertClusterMembership(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpsertClusterMembershipRequest) error {
+			s.Equal(upsertMembershipRecordExpiryDefault, request.RecordExpiry)
+			s.heartbeat(request.Role, request.RPCAddress.String(), request.RPCPort)
+			return nil
+		},
+	)
+	s.heartbeat(persistence.History, "10.0.0.2", 7234)
+
+	monitor := s.newMonitor(nil)
+	monitor.Start()
+	defer monitor.Stop()
+	s.Equal([]string{"10.0.0.1:7234", "10.0.0.2:7234"}, s.memberAddresses(monitor, primitives.HistoryService))
+
+	s.metadataManager.EXPECT().UpsertClusterMembership(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpsertClusterMembershipRequest) error {
+			s.Equal(evictedRecordExpiry, request.RecordExpiry)
+			return nil
+		},
+	)
+	s.NoError(monitor.EvictSelf())
+	s.Equal([]string{"10.0.0.2:7234"}, s.memberAddresses(monitor, primitives.HistoryService))
+
+	// heartbeats stop once evicted
+	s.NoError(monitor.heartbeat())
+	s.NoError(monitor.EvictSelf())
+}
+
+func (s *staticMonitorSuite) TestStaticHostSource() {
+	hosts, err := NewStaticHostSource([]string{"10.0.0.2:7234", "10.0.0.1:7234", "10.0.0.1:7234"}).Hosts(context.Background())
+	s.NoError(err)
+	s.Equal([]string{"10.0.0.1:7234", "10.0.0.2:7234"}, hosts)
+
+	_, err = NewStaticHostSource([]string{"10.0.0.1"}).Hosts(context.Background())
+	s.ErrorIs(err, ErrIncorrectAddressFormat)
+}
+
+func (s *staticMonitorSuite) memberAddresses(monitor *staticMonitor, service primitives.ServiceName) []string {
+	resolver, err := monitor.GetResolver(service)
+	s.NoError(err)
+
+	var addresses []string
+	for _, host := range resolver.Members() {
+		addresses = append(addresses, host.GetAddress())
+	}
+	sort.Strings(addresses)
+	return addresses
+}
+
+func (f fakeHostSource) Hosts(context.Context) ([]string, error) {
+	return f, nil
+}
@@ -0,0 +1,54 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"time"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/primitives"
+)
+
+// staticServiceResolver maintains the same consistent hash ring as ringpopServiceResolver, but builds
+// it from the members returned by membersFn instead of the ringpop gossip state.
+//
+// Unlike ringpop, discovery depends on DNS and persistence being reachable, so a failed initial refresh
+// is retried by the refresh worker instead of being fatal.
+type staticServiceResolver struct {
+	*serviceResolver
+}
+
+var _ ServiceResolver = (*staticServiceResolver)(nil)
+
+func newStaticServiceResolver(
+	service primitives.ServiceName,
+	membersFn membersSource,
+	refreshInterval time.Duration,
+	logger log.Logger,
+) *staticServiceResolver {
+	return &staticServiceResolver{
+		serviceResolver: newServiceResolver(service, membersFn, refreshInterval, logger),
+	}
+}
@@ -45,6 +45,7 @@ const (
 	TaskCategoryTagName        = "task_category"
 	TaskTypeTagName            = "task_type"
 	TaskPriorityTagName        = "task_priority"
+	FairnessKeyTagName         = "fairness_key"
 	QueueReaderIDTagName       = "queue_reader_id"
 	QueueAlertTypeTagName      = "queue_alert_type"
 	QueueTypeTagName           = "queue_type"
@@ -93,6 +94,20 @@ const (
 	AdminClientGetSearchAttributesScope = "AdminClientGetSearchAttributes"
 	// AdminClientCloseShardScope tracks RPC calls to admin service
 	AdminClientCloseShardScope = "AdminClientCloseShard"
+	// AdminClientMoveShardScope tracks RPC calls to admin service
+	AdminClientMoveShardScope = "AdminClientMoveShard"
+	// AdminClientListShardOwnershipOverridesScope tracks RPC calls to admin service
+	AdminClientListShardOwnershipOverridesScope = "AdminClientListShardOwnershipOverrides"
+	// AdminClientGetDynamicConfigScope tracks RPC calls to admin service
+	AdminClientGetDynamicConfigScope = "AdminClientGetDynamicConfig"
+	// AdminClientSetDynamicConfigOverrideScope tracks RPC calls to admin service
+	AdminClientSetDynamicConfigOverrideScope = "AdminClientSetDynamicConfigOverride"
+	// AdminClientRemoveDynamicConfigOverrideScope tracks RPC calls to admin service
+	AdminClientRemoveDynamicConfigOverrideScope = "AdminClientRemoveDynamicConfigOverride"
+	// AdminClientListDynamicConfigOverridesScope tracks RPC calls to admin service
+	AdminClientListDynamicConfigOverridesScope = "AdminClientListDynamicConfigOverrides"
+	// AdminClientListDynamicConfigScope tracks RPC calls to admin service
+	AdminClientListDynamicConfigScope = "AdminClientListDynamicConfig"
 	// AdminClientGetShardScope tracks RPC calls to admin service
 	AdminClientGetShardScope = "AdminClientGetShard"
 	// AdminClientListHistoryTasksScope tracks RPC calls to admin service
@@ -125,6 +140,8 @@ const (
 	AdminClientGetDLQReplicationMessagesScope = "AdminClientGetDLQReplicationMessages"
 	// AdminClientReapplyEventsScope tracks RPC calls to admin service
 	AdminClientReapplyEventsScope = "AdminClientReapplyEvents"
+	// AdminClientImportWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientImportWorkflowExecutionScope = "AdminClientImportWorkflowExecution"
 	// AdminClientGetDLQMessagesScope tracks RPC calls to admin service
 	AdminClientGetDLQMessagesScope = "AdminClientGetDLQMessages"
 	// AdminClientPurgeDLQMessagesScope tracks RPC calls to admin service
@@ -137,8 +154,18 @@ const (
 	AdminClientResendReplicationTasksScope = "AdminClientResendReplicationTasks"
 	// AdminClientGetTaskQueueTasksScope tracks RPC calls to admin service
 	AdminClientGetTaskQueueTasksScope = "AdminClientGetTaskQueueTasks"
+	// AdminClientDescribeTaskQueuePartitionsScope tracks RPC calls to admin service
+	AdminClientDescribeTaskQueuePartitionsScope = "AdminClientDescribeTaskQueuePartitions"
 	// AdminClientDeleteWorkflowExecutionScope tracks RPC calls to admin service
 	AdminClientDeleteWorkflowExecutionScope = "AdminClientDeleteWorkflowExecution"
+	// AdminClientStartBatchOperationScope tracks RPC calls to admin service
+	AdminClientStartBatchOperationScope = "AdminClientStartBatchOperation"
+	// AdminClientCountWorkflowExecutionsScope tracks RPC calls to admin service
+	AdminClientCountWorkflowExecutionsScope = "AdminClientCountWorkflowExecutions"
+	// AdminClientDescribeScheduleScope tracks RPC calls to admin service
+	AdminClientDescribeScheduleScope = "AdminClientDescribeSchedule"
+	// AdminClientUpdateSchedulePoliciesScope tracks RPC calls to admin service
+	AdminClientUpdateSchedulePoliciesScope = "AdminClientUpdateSchedulePolicies"
 
 	// AdminDescribeHistoryHostScope is the metric scope for admin.AdminDescribeHistoryHost
 	AdminDescribeHistoryHostScope = "AdminDescribeHistoryHost"
@@ -162,16 +189,34 @@ const (
 	AdminGetDLQReplicationMessagesScope = "AdminGetDLQReplicationMessages"
 	// AdminReapplyEventsScope is the metric scope for admin.ReapplyEvents
 	AdminReapplyEventsScope = "AdminReapplyEvents"
+	// AdminImportWorkflowExecutionScope is the metric scope for admin.ImportWorkflowExecution
+	AdminImportWorkflowExecutionScope = "AdminImportWorkflowExecution"
 	// AdminRefreshWorkflowTasksScope is the metric scope for admin.RefreshWorkflowTasks
 	AdminRefreshWorkflowTasksScope = "AdminRefreshWorkflowTasks"
 	// AdminResendReplicationTasksScope is the metric scope for admin.ResendReplicationTasks
 	AdminResendReplicationTasksScope = "AdminResendReplicationTasks"
 	// AdminGetTaskQueueTasksScope is the metric scope for admin.GetTaskQueueTasks
 	AdminGetTaskQueueTasksScope = "AdminGetTaskQueueTasks"
+	// AdminDescribeTaskQueuePartitionsScope is the metric scope for admin.DescribeTaskQueuePartitions
+	AdminDescribeTaskQueuePartitionsScope = "AdminDescribeTaskQueuePartitions"
 	// AdminRemoveTaskScope is the metric scope for admin.AdminRemoveTask
 	AdminRemoveTaskScope = "AdminRemoveTask"
 	// AdminCloseShardScope is the metric scope for admin.AdminCloseShard
 	AdminCloseShardScope = "AdminCloseShard"
+	// AdminMoveShardScope is the metric scope for admin.AdminMoveShard
+	AdminMoveShardScope = "AdminMoveShard"
+	// AdminListShardOwnershipOverridesScope is the metric scope for admin.AdminListShardOwnershipOverrides
+	AdminListShardOwnershipOverridesScope = "AdminListShardOwnershipOverrides"
+	// AdminGetDynamicConfigScope is the metric scope for admin.AdminGetDynamicConfig
+	AdminGetDynamicConfigScope = "AdminGetDynamicConfig"
+	// AdminSetDynamicConfigOverrideScope is the metric scope for admin.AdminSetDynamicConfigOverride
+	AdminSetDynamicConfigOverrideScope = "AdminSetDynamicConfigOverride"
+	// AdminRemoveDynamicConfigOverrideScope is the metric scope for admin.AdminRemoveDynamicConfigOverride
+	AdminRemoveDynamicConfigOverrideScope = "AdminRemoveDynamicConfigOverride"
+	// AdminListDynamicConfigOverridesScope is the metric scope for admin.AdminListDynamicConfigOverrides
+	AdminListDynamicConfigOverridesScope = "AdminListDynamicConfigOverrides"
+	// AdminListDynamicConfigScope is the metric scope for admin.AdminListDynamicConfig
+	AdminListDynamicConfigScope = "AdminListDynamicConfig"
 	// AdminGetShardScope is the metric scope for admin.AdminGetShard
 	AdminGetShardScope = "AdminGetShard"
 	// AdminListHistoryTasksScope is the metric scope for admin.ListHistoryTasks
@@ -194,6 +239,14 @@ const (
 	AdminRemoveRemoteClusterScope = "AdminRemoveRemoteCluster"
 	// AdminDeleteWorkflowExecutionScope is the metric scope for admin.AdminDeleteWorkflowExecution
 	AdminDeleteWorkflowExecutionScope = "AdminDeleteWorkflowExecution"
+	// AdminStartBatchOperationScope is the metric scope for admin.AdminStartBatchOperation
+	AdminStartBatchOperationScope = "AdminStartBatchOperation"
+	// AdminCountWorkflowExecutionsScope is the metric scope for admin.AdminCountWorkflowExecutions
+	AdminCountWorkflowExecutionsScope = "AdminCountWorkflowExecutions"
+	// AdminDescribeScheduleScope is the metric scope for admin.AdminDescribeSchedule
+	AdminDescribeScheduleScope = "AdminDescribeSchedule"
+	// AdminUpdateSchedulePoliciesScope is the metric scope for admin.AdminUpdateSchedulePolicies
+	AdminUpdateSchedulePoliciesScope = "AdminUpdateSchedulePolicies"
 
 	// DCRedirectionDeleteWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionDeleteWorkflowExecutionScope = "DCRedirectionDeleteWorkflowExecution"
@@ -477,6 +530,8 @@ const (
 	HistoryClientSignalWithStartWorkflowExecutionScope = "HistoryClientSignalWithStartWorkflowExecution"
 	// HistoryClientRemoveSignalMutableStateScope tracks RPC calls to history service
 	HistoryClientRemoveSignalMutableStateScope = "HistoryClientRemoveSignalMutableState"
+	// HistoryClientUpsertWorkflowSearchAttributesScope tracks RPC calls to history service
+	HistoryClientUpsertWorkflowSearchAttributesScope = "HistoryClientUpsertWorkflowSearchAttributes"
 	// HistoryClientTerminateWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientTerminateWorkflowExecutionScope = "HistoryClientTerminateWorkflowExecution"
 	// HistoryClientUpdateWorkflowScope tracks RPC calls to history service
@@ -495,6 +550,8 @@ const (
 	HistoryClientVerifyChildExecutionCompletionRecordedScope = "HistoryClientVerifyChildExecutionCompletionRecorded"
 	// HistoryClientReplicateEventsV2Scope tracks RPC calls to history service
 	HistoryClientReplicateEventsV2Scope = "HistoryClientReplicateEventsV2"
+	// HistoryClientImportWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientImportWorkflowExecutionScope = "HistoryClientImportWorkflowExecution"
 	// HistoryClientSyncShardStatusScope tracks RPC calls to history service
 	HistoryClientSyncShardStatusScope = "HistoryClientSyncShardStatus"
 	// HistoryClientSyncActivityScope tracks RPC calls to history service
@@ -529,6 +586,8 @@ const (
 	HistoryClientGetDLQReplicationMessagesScope = "HistoryClientGetDLQReplicationMessages"
 	// HistoryClientGetShardScope tracks RPC calls to history service
 	HistoryClientGetShardScope = "HistoryClientGetShard"
+	// HistoryClientHandoffShardScope tracks RPC calls to history service
+	HistoryClientHandoffShardScope = "HistoryClientHandoffShard"
 	// HistoryClientRebuildMutableStateScope tracks RPC calls to history service
 	HistoryClientRebuildMutableStateScope = "HistoryClientRebuildMutableState"
 	// HistoryClientRemoveTaskScope tracks RPC calls to history service
@@ -964,6 +1023,8 @@ const (
 	HistorySignalWithStartWorkflowExecutionScope = "SignalWithStartWorkflowExecution"
 	// HistoryRemoveSignalMutableStateScope tracks RemoveSignalMutableState API calls received by service
 	HistoryRemoveSignalMutableStateScope = "RemoveSignalMutableState"
+	// HistoryUpsertWorkflowSearchAttributesScope tracks UpsertWorkflowSearchAttributes API calls received by service
+	HistoryUpsertWorkflowSearchAttributesScope = "UpsertWorkflowSearchAttributes"
 	// HistoryTerminateWorkflowExecutionScope tracks TerminateWorkflowExecution API calls received by service
 	HistoryTerminateWorkflowExecutionScope = "TerminateWorkflowExecution"
 	// HistoryScheduleWorkflowTaskScope tracks ScheduleWorkflowTask API calls received by service
@@ -1010,8 +1071,12 @@ const (
 	HistoryCloseShardScope = "CloseShard"
 	// HistoryGetShard is the scope used by get shard API
 	HistoryGetShardScope = "GetShard"
+	// HistoryHandoffShardScope is the scope used by handoff shard API
+	HistoryHandoffShardScope = "HandoffShard"
 	// HistoryReplicateEventsV2 is the scope used by replicate events API
 	HistoryReplicateEventsV2Scope = "ReplicateEventsV2"
+	// HistoryImportWorkflowExecutionScope is the scope used by import workflow execution API
+	HistoryImportWorkflowExecutionScope = "ImportWorkflowExecution"
 	// HistoryDescribeHistoryHost is the scope used by describe history host API
 	HistoryDescribeHistoryHostScope = "DescribeHistoryHost"
 	// HistoryDeleteWorkflowVisibilityRecordScope is the scope used by delete workflow visibility record API
@@ -1395,6 +1460,12 @@ var (
 	ShardContextCreatedCounter                        = NewCounterDef("sharditem_created_count")
 	ShardContextRemovedCounter                        = NewCounterDef("sharditem_removed_count")
 	ShardContextAcquisitionLatency                    = NewTimerDef("sharditem_acquisition_latency")
+	ShardHandoffLatency                               = NewTimerDef("shard_handoff_latency")
+	ShardHandoffFailedCounter                         = NewCounterDef("shard_handoff_failed")
+	ShardHandoffPrewarmLatency                        = NewTimerDef("shard_handoff_prewarm_latency")
+	ShardHandoffPrewarmedWorkflows                    = NewCounterDef("shard_handoff_prewarmed_workflows")
+	HotWorkflowRequestCount                           = NewDimensionlessHistogramDef("hot_workflow_request_count")
+	WorkflowRateLimitedCounter                        = NewCounterDef("workflow_rate_limited")
 	ShardInfoReplicationPendingTasksTimer             = NewDimensionlessHistogramDef("shardinfo_replication_pending_task")
 	ShardInfoTransferActivePendingTasksTimer          = NewDimensionlessHistogramDef("shardinfo_transfer_active_pending_task")
 	ShardInfoTransferStandbyPendingTasksTimer         = NewDimensionlessHistogramDef("shardinfo_transfer_standby_pending_task")
@@ -1437,7 +1508,9 @@ var (
 	TaskReschedulerPendingTasks                       = NewDimensionlessHistogramDef("task_rescheduler_pending_tasks")
 	TaskThrottledCounter                              = NewCounterDef("task_throttled_counter")
 	PendingTasksCounter                               = NewDimensionlessHistogramDef("pending_tasks")
-	QueueScheduleLatency                              = NewTimerDef("queue_latency_schedule") // latency for scheduling 100 tasks in one task channel
+	QueueScheduleLatency                              = NewTimerDef("queue_latency_schedule")       // latency for scheduling 100 tasks in one task channel
+	QueueScheduleWaitLatency                          = NewTimerDef("queue_latency_schedule_wait")  // average latency from submission to start for tasks in one task channel
+	QueueSchedulePendingTasks                         = NewGaugeDef("queue_schedule_pending_tasks") // number of submitted but not yet started tasks in one task channel
 	QueueReaderCountHistogram                         = NewDimensionlessHistogramDef("queue_reader_count")
 	QueueSliceCountHistogram                          = NewDimensionlessHistogramDef("queue_slice_count")
 	QueueActionCounter                                = NewCounterDef("queue_actions")
@@ -1600,6 +1673,7 @@ var (
 	TaskWriteThrottlePerTaskQueueCounter      = NewCounterDef("task_write_throttle_count")
 	TaskWriteLatencyPerTaskQueue              = NewTimerDef("task_write_latency")
 	TaskLagPerTaskQueueGauge                  = NewGaugeDef("task_lag_per_tl")
+	TaskBacklogPerFairnessKeyGauge            = NewGaugeDef("task_backlog_per_fairness_key")
 	NoRecentPollerTasksPerTaskQueueCounter    = NewCounterDef("no_poller_tasks")
 
 	// Worker
@@ -1680,6 +1754,7 @@ var (
 	RateLimiterFailuresCount                                  = NewCounterDef("rate_limiter_failures")
 	BatcherProcessorSuccess                                   = NewCounterDef("batcher_processor_requests")
 	BatcherProcessorFailures                                  = NewCounterDef("batcher_processor_errors")
+	BatcherProcessorSkipped                                   = NewCounterDef("batcher_processor_skipped")
 	BatcherOperationFailures                                  = NewCounterDef("batcher_operation_errors")
 	ElasticsearchBulkProcessorRequests                        = NewCounterDef("elasticsearch_bulk_processor_requests")
 	ElasticsearchBulkProcessorQueuedRequests                  = NewDimensionlessHistogramDef("elasticsearch_bulk_processor_queued_requests")
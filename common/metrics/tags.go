@@ -196,6 +196,13 @@ func TaskPriorityTag(value string) Tag {
 	return &tagImpl{key: TaskPriorityTagName, value: value}
 }
 
+func FairnessKeyTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return &tagImpl{key: FairnessKeyTagName, value: sanitizer.Value(value)}
+}
+
 func QueueReaderIDTag(readerID int32) Tag {
 	return &tagImpl{key: QueueReaderIDTagName, value: strconv.Itoa(int(readerID))}
 }
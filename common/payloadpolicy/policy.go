@@ -0,0 +1,222 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadpolicy
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+)
+
+const (
+	metadataEncoding = "encoding"
+)
+
+type (
+	// Policy decides which payloads a namespace accepts. It is consulted by frontend for workflow service
+	// requests, except workflow and query task results, and by history for every workflow task command and
+	// activity task result, so that payloads violating it never reach history.
+	Policy interface {
+		// Check returns an InvalidArgument error naming the offending field if message carries a payload, memo or
+		// header that namespaceName does not accept.
+		Check(namespaceName namespace.Name, message proto.Message) error
+	}
+
+	dynamicConfigPolicy struct {
+		allowedEncodings dynamicconfig.StringPropertyFnWithNamespaceFilter
+		memoSizeLimit    dynamicconfig.IntPropertyFnWithNamespaceFilter
+		headerSizeLimit  dynamicconfig.IntPropertyFnWithNamespaceFilter
+	}
+
+	noopPolicy struct{}
+
+	// rules is the policy of a single namespace.
+	rules struct {
+		namespaceName    namespace.Name
+		allowedEncodings []string
+		memoSizeLimit    int
+		headerSizeLimit  int
+	}
+)
+
+var (
+	payloadType          = reflect.TypeOf((*commonpb.Payload)(nil))
+	memoType             = reflect.TypeOf((*commonpb.Memo)(nil))
+	headerType           = reflect.TypeOf((*commonpb.Header)(nil))
+	searchAttributesType = reflect.TypeOf((*commonpb.SearchAttributes)(nil))
+)
+
+// NewDynamicConfigPolicy creates a Policy configured per namespace by the limit.payloadPolicy dynamic config keys.
+func NewDynamicConfigPolicy(dc *dynamicconfig.Collection) Policy {
+	return &dynamicConfigPolicy{
+		allowedEncodings: dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.PayloadPolicyAllowedEncodings, ""),
+		memoSizeLimit:    dc.GetIntPropertyFilteredByNamespace(dynamicconfig.PayloadPolicyMemoSizeLimit, 0),
+		headerSizeLimit:  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.PayloadPolicyHeaderSizeLimit, 0),
+	}
+}
+
+// NewNoopPolicy creates a Policy that accepts everything.
+func NewNoopPolicy() Policy {
+	return noopPolicy{}
+}
+
+func (noopPolicy) Check(namespace.Name, proto.Message) error {
+	return nil
+}
+
+func (p *dynamicConfigPolicy) Check(namespaceName namespace.Name, message proto.Message) error {
+	r := &rules{
+		namespaceName:    namespaceName,
+		allowedEncodings: splitEncodings(p.allowedEncodings(namespaceName.String())),
+		memoSizeLimit:    p.memoSizeLimit(namespaceName.String()),
+		headerSizeLimit:  p.headerSizeLimit(namespaceName.String()),
+	}
+	if len(r.allowedEncodings) == 0 && r.memoSizeLimit <= 0 && r.headerSizeLimit <= 0 {
+		return nil
+	}
+	return r.check(reflect.ValueOf(message), "", true)
+}
+
+func splitEncodings(value string) []string {
+	var encodings []string
+	for _, encoding := range strings.Split(value, ",") {
+		if encoding = strings.TrimSpace(encoding); encoding != "" {
+			encodings = append(encodings, encoding)
+		}
+	}
+	return encodings
+}
+
+// check walks v, where path is the field path of v and checkEncoding is false below headers.
+func (r *rules) check(v reflect.Value, path string, checkEncoding bool) error {
+	switch v.Type() {
+	case payloadType:
+		if checkEncoding && !v.IsNil() {
+			return r.checkEncoding(v.Interface().(*commonpb.Payload), path)
+		}
+		return nil
+	case searchAttributesType:
+		// Search attributes must stay readable by the server.
+		return nil
+	case memoType:
+		if memo := v.Interface().(*commonpb.Memo); memo != nil && r.memoSizeLimit > 0 && memo.Size() > r.memoSizeLimit {
+			return r.violation(path, fmt.Sprintf("memo size %d exceeds limit %d", memo.Size(), r.memoSizeLimit))
+		}
+	case headerType:
+		// SDKs do not pass headers through payload codecs, so only their size is checked.
+		if header := v.Interface().(*commonpb.Header); header != nil && r.headerSizeLimit > 0 && header.Size() > r.headerSizeLimit {
+			return r.violation(path, fmt.Sprintf("header size %d exceeds limit %d", header.Size(), r.headerSizeLimit))
+		}
+		checkEncoding = false
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			return r.check(v.Elem(), path, checkEncoding)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if err := r.check(v.Field(i), joinPath(path, fieldName(field)), checkEncoding); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := r.check(v.Index(i), fmt.Sprintf("%s[%d]", path, i), checkEncoding); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if err := r.check(iter.Value(), fmt.Sprintf("%s[%v]", path, iter.Key()), checkEncoding); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *rules) checkEncoding(payload *commonpb.Payload, path string) error {
+	if len(r.allowedEncodings) == 0 {
+		return nil
+	}
+	encoding := string(payload.GetMetadata()[metadataEncoding])
+	for _, allowed := range r.allowedEncodings {
+		if encoding == allowed {
+			return nil
+		}
+	}
+	return r.violation(path, fmt.Sprintf("payload encoding %q is not allowed, allowed encodings: %s", encoding, strings.Join(r.allowedEncodings, ", ")))
+}
+
+func (r *rules) violation(path string, reason string) error {
+	return serviceerror.NewInvalidArgument(fmt.Sprintf("%s violates payload policy of namespace %s: %s.", path, r.namespaceName, reason))
+}
+
+// fieldName returns the JSON name of a generated proto field, or the Go name for oneof wrappers and non-proto fields.
+func fieldName(field reflect.StructField) string {
+	for _, part := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if name := strings.TrimPrefix(part, "json="); name != part {
+			return name
+		}
+	}
+	for _, part := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if name := strings.TrimPrefix(part, "name="); name != part {
+			return name
+		}
+	}
+	if field.Tag.Get("protobuf_oneof") != "" {
+		// The oneof wrapper struct adds the name of the field that is set.
+		return ""
+	}
+	return field.Name
+}
+
+func joinPath(path string, name string) string {
+	switch {
+	case name == "":
+		return path
+	case path == "":
+		return name
+	default:
+		return path + "." + name
+	}
+}
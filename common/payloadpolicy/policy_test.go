@@ -0,0 +1,166 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commandpb "go.temporal.io/api/command/v1"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+)
+
+type (
+	policySuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+const (
+	testNamespace = namespace.Name("test-namespace")
+)
+
+func TestPolicySuite(t *testing.T) {
+	s := new(policySuite)
+	suite.Run(t, s)
+}
+
+func (s *policySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *policySuite) newPolicy(values map[dynamicconfig.Key]any) Policy {
+	client := dynamicconfig.StaticClient{}
+	for key, value := range values {
+		client[key] = []dynamicconfig.ConstrainedValue{{
+			Constraints: dynamicconfig.Constraints{Namespace: testNamespace.String()},
+			Value:       value,
+		}}
+	}
+	return NewDynamicConfigPolicy(dynamicconfig.NewCollection(client, log.NewNoopLogger()))
+}
+
+func encodedPayload(encoding string) *commonpb.Payload {
+	return &commonpb.Payload{Metadata: map[string][]byte{"encoding": []byte(encoding)}, Data: []byte("data")}
+}
+
+func (s *policySuite) TestDefaultAcceptsEverything() {
+	policy := s.newPolicy(nil)
+	request := &workflowservice.StartWorkflowExecutionRequest{
+		Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{encodedPayload("json/plain")}},
+	}
+	s.NoError(policy.Check(testNamespace, request))
+	s.NoError(NewNoopPolicy().Check(testNamespace, request))
+}
+
+func (s *policySuite) TestAllowedEncodings() {
+	policy := s.newPolicy(map[dynamicconfig.Key]any{
+		dynamicconfig.PayloadPolicyAllowedEncodings: "binary/encrypted, binary/null",
+	})
+
+	request := &workflowservice.SignalWorkflowExecutionRequest{
+		Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{encodedPayload("binary/encrypted"), encodedPayload("json/plain")}},
+	}
+	err := policy.Check(testNamespace, request)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Contains(err.Error(), "input.payloads[1]")
+	s.Contains(err.Error(), `"json/plain"`)
+	s.Contains(err.Error(), testNamespace.String())
+
+	request.Input.Payloads[1] = encodedPayload("binary/null")
+	s.NoError(policy.Check(testNamespace, request))
+
+	s.NoError(policy.Check("other-namespace", &workflowservice.SignalWorkflowExecutionRequest{
+		Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{encodedPayload("json/plain")}},
+	}))
+}
+
+func (s *policySuite) TestAllowedEncodingsSkipSearchAttributesAndHeaders() {
+	policy := s.newPolicy(map[dynamicconfig.Key]any{
+		dynamicconfig.PayloadPolicyAllowedEncodings: "binary/encrypted",
+	})
+
+	request := &workflowservice.StartWorkflowExecutionRequest{
+		SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{"CustomKeywordField": encodedPayload("json/plain")}},
+		Header:           &commonpb.Header{Fields: map[string]*commonpb.Payload{"tracing": encodedPayload("json/plain")}},
+		Memo:             &commonpb.Memo{Fields: map[string]*commonpb.Payload{"key": encodedPayload("binary/encrypted")}},
+	}
+	s.NoError(policy.Check(testNamespace, request))
+
+	request.Memo.Fields["key"] = encodedPayload("json/plain")
+	err := policy.Check(testNamespace, request)
+	s.Error(err)
+	s.Contains(err.Error(), "memo.fields[key]")
+}
+
+func (s *policySuite) TestCommandFieldPath() {
+	policy := s.newPolicy(map[dynamicconfig.Key]any{
+		dynamicconfig.PayloadPolicyAllowedEncodings: "binary/encrypted",
+	})
+
+	command := &commandpb.Command{
+		CommandType: enumspb.COMMAND_TYPE_SCHEDULE_ACTIVITY_TASK,
+		Attributes: &commandpb.Command_ScheduleActivityTaskCommandAttributes{ScheduleActivityTaskCommandAttributes: &commandpb.ScheduleActivityTaskCommandAttributes{
+			Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{encodedPayload("json/plain")}},
+		}},
+	}
+	err := policy.Check(testNamespace, command)
+	s.Error(err)
+	s.Contains(err.Error(), "scheduleActivityTaskCommandAttributes.input.payloads[0]")
+}
+
+func (s *policySuite) TestMemoAndHeaderSizeLimits() {
+	policy := s.newPolicy(map[dynamicconfig.Key]any{
+		dynamicconfig.PayloadPolicyMemoSizeLimit:   20,
+		dynamicconfig.PayloadPolicyHeaderSizeLimit: 50,
+	})
+
+	largeFields := map[string]*commonpb.Payload{"key": {Data: make([]byte, 30)}}
+	err := policy.Check(testNamespace, &workflowservice.StartWorkflowExecutionRequest{
+		Memo:   &commonpb.Memo{Fields: largeFields},
+		Header: &commonpb.Header{Fields: largeFields},
+	})
+	s.Error(err)
+	s.Contains(err.Error(), "memo violates")
+
+	err = policy.Check(testNamespace, &workflowservice.StartWorkflowExecutionRequest{
+		Header: &commonpb.Header{Fields: map[string]*commonpb.Payload{"key": {Data: make([]byte, 60)}}},
+	})
+	s.Error(err)
+	s.Contains(err.Error(), "header violates")
+
+	s.NoError(policy.Check(testNamespace, &workflowservice.StartWorkflowExecutionRequest{
+		Header: &commonpb.Header{Fields: largeFields},
+	}))
+}
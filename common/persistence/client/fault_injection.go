@@ -40,6 +40,7 @@ type (
 	FaultInjectionDataStoreFactory struct {
 		baseFactory    DataStoreFactory
 		config         *config.FaultInjection
+		rules          *FaultInjectionRules
 		ErrorGenerator ErrorGenerator
 
 		TaskStore      *FaultInjectionTaskStore
@@ -53,31 +54,37 @@ type (
 	FaultInjectionShardStore struct {
 		baseShardStore persistence.ShardStore
 		ErrorGenerator ErrorGenerator
+		rules          *FaultInjectionRules
 	}
 
 	FaultInjectionTaskStore struct {
 		baseTaskStore  persistence.TaskStore
 		ErrorGenerator ErrorGenerator
+		rules          *FaultInjectionRules
 	}
 
 	FaultInjectionMetadataStore struct {
 		baseMetadataStore persistence.MetadataStore
 		ErrorGenerator    ErrorGenerator
+		rules             *FaultInjectionRules
 	}
 
 	FaultInjectionClusterMetadataStore struct {
 		baseCMStore    persistence.ClusterMetadataStore
 		ErrorGenerator ErrorGenerator
+		rules          *FaultInjectionRules
 	}
 
 	FaultInjectionExecutionStore struct {
 		baseExecutionStore persistence.ExecutionStore
 		ErrorGenerator     ErrorGenerator
+		rules              *FaultInjectionRules
 	}
 
 	FaultInjectionQueue struct {
 		baseQueue      persistence.Queue
 		ErrorGenerator ErrorGenerator
+		rules          *FaultInjectionRules
 	}
 )
 
@@ -111,6 +118,7 @@ func newErrorGenerator(rate float64, errorWeights []FaultWeight) ErrorGenerator
 func NewFaultInjectionDatastoreFactory(
 	config *config.FaultInjection,
 	baseFactory DataStoreFactory,
+	rules *FaultInjectionRules,
 ) *FaultInjectionDataStoreFactory {
 	errorGenerator := newErrorGenerator(
 		config.Rate,
@@ -124,6 +132,7 @@ func NewFaultInjectionDatastoreFactory(
 	return &FaultInjectionDataStoreFactory{
 		baseFactory:    baseFactory,
 		config:         config,
+		rules:          rules,
 		ErrorGenerator: errorGenerator,
 	}
 }
@@ -132,15 +141,29 @@ func (d *FaultInjectionDataStoreFactory) Close() {
 	d.baseFactory.Close()
 }
 
+// UpdateRate updates the error rate of the factory and of every store created so far.
 func (d *FaultInjectionDataStoreFactory) UpdateRate(rate float64) {
 	d.ErrorGenerator.UpdateRate(rate)
-	d.TaskStore.UpdateRate(rate)
-	d.ShardStore.UpdateRate(rate)
-	d.MetadataStore.UpdateRate(rate)
-	d.ExecutionStore.UpdateRate(rate)
-	d.Queue.UpdateRate(rate)
-	d.ClusterMDStore.UpdateRate(rate)
+	if d.TaskStore != nil {
+		d.TaskStore.UpdateRate(rate)
+	}
+	if d.ShardStore != nil {
+		d.ShardStore.UpdateRate(rate)
+	}
+	if d.MetadataStore != nil {
+		d.MetadataStore.UpdateRate(rate)
+	}
+	if d.ExecutionStore != nil {
+		d.ExecutionStore.UpdateRate(rate)
+	}
+	if d.Queue != nil {
+		d.Queue.UpdateRate(rate)
+	}
+	if d.ClusterMDStore != nil {
+		d.ClusterMDStore.UpdateRate(rate)
+	}
 }
+
 func (d *FaultInjectionDataStoreFactory) NewTaskStore() (persistence.TaskStore, error) {
 	if d.TaskStore == nil {
 		baseFactory, err := d.baseFactory.NewTaskStore()
@@ -158,6 +181,7 @@ func (d *FaultInjectionDataStoreFactory) NewTaskStore() (persistence.TaskStore,
 				return nil, err
 			}
 		}
+		d.TaskStore.rules = d.rules
 	}
 	return d.TaskStore, nil
 }
@@ -179,6 +203,7 @@ func (d *FaultInjectionDataStoreFactory) NewShardStore() (persistence.ShardStore
 				return nil, err
 			}
 		}
+		d.ShardStore.rules = d.rules
 	}
 	return d.ShardStore, nil
 }
@@ -200,6 +225,7 @@ func (d *FaultInjectionDataStoreFactory) NewMetadataStore() (persistence.Metadat
 				return nil, err
 			}
 		}
+		d.MetadataStore.rules = d.rules
 	}
 	return d.MetadataStore, nil
 }
@@ -221,7 +247,7 @@ func (d *FaultInjectionDataStoreFactory) NewExecutionStore() (persistence.Execut
 				return nil, err
 			}
 		}
-
+		d.ExecutionStore.rules = d.rules
 	}
 	return d.ExecutionStore, nil
 }
@@ -243,6 +269,7 @@ func (d *FaultInjectionDataStoreFactory) NewQueue(queueType persistence.QueueTyp
 				return nil, err
 			}
 		}
+		d.Queue.rules = d.rules
 	}
 	return d.Queue, nil
 }
@@ -264,7 +291,7 @@ func (d *FaultInjectionDataStoreFactory) NewClusterMetadataStore() (persistence.
 				return nil, err
 			}
 		}
-
+		d.ClusterMDStore.rules = d.rules
 	}
 	return d.ClusterMDStore, nil
 }
@@ -302,6 +329,9 @@ func (q *FaultInjectionQueue) Init(
 	if err := q.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := q.rules.Inject(ctx, "Init", nil); err != nil {
+		return err
+	}
 	return q.baseQueue.Init(ctx, blob)
 }
 
@@ -312,6 +342,9 @@ func (q *FaultInjectionQueue) EnqueueMessage(
 	if err := q.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := q.rules.Inject(ctx, "EnqueueMessage", nil); err != nil {
+		return err
+	}
 	return q.baseQueue.EnqueueMessage(ctx, blob)
 }
 
@@ -323,6 +356,9 @@ func (q *FaultInjectionQueue) ReadMessages(
 	if err := q.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := q.rules.Inject(ctx, "ReadMessages", nil); err != nil {
+		return nil, err
+	}
 	return q.baseQueue.ReadMessages(ctx, lastMessageID, maxCount)
 }
 
@@ -333,6 +369,9 @@ func (q *FaultInjectionQueue) DeleteMessagesBefore(
 	if err := q.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := q.rules.Inject(ctx, "DeleteMessagesBefore", nil); err != nil {
+		return err
+	}
 	return q.baseQueue.DeleteMessagesBefore(ctx, messageID)
 }
 
@@ -343,6 +382,9 @@ func (q *FaultInjectionQueue) UpdateAckLevel(
 	if err := q.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := q.rules.Inject(ctx, "UpdateAckLevel", nil); err != nil {
+		return err
+	}
 	return q.baseQueue.UpdateAckLevel(ctx, metadata)
 }
 
@@ -352,6 +394,9 @@ func (q *FaultInjectionQueue) GetAckLevels(
 	if err := q.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := q.rules.Inject(ctx, "GetAckLevels", nil); err != nil {
+		return nil, err
+	}
 	return q.baseQueue.GetAckLevels(ctx)
 }
 
@@ -362,6 +407,9 @@ func (q *FaultInjectionQueue) EnqueueMessageToDLQ(
 	if err := q.ErrorGenerator.Generate(); err != nil {
 		return 0, err
 	}
+	if err := q.rules.Inject(ctx, "EnqueueMessageToDLQ", nil); err != nil {
+		return 0, err
+	}
 	return q.baseQueue.EnqueueMessageToDLQ(ctx, blob)
 }
 
@@ -375,6 +423,9 @@ func (q *FaultInjectionQueue) ReadMessagesFromDLQ(
 	if err := q.ErrorGenerator.Generate(); err != nil {
 		return nil, nil, err
 	}
+	if err := q.rules.Inject(ctx, "ReadMessagesFromDLQ", nil); err != nil {
+		return nil, nil, err
+	}
 	return q.baseQueue.ReadMessagesFromDLQ(ctx, firstMessageID, lastMessageID, pageSize, pageToken)
 }
 
@@ -385,6 +436,9 @@ func (q *FaultInjectionQueue) DeleteMessageFromDLQ(
 	if err := q.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := q.rules.Inject(ctx, "DeleteMessageFromDLQ", nil); err != nil {
+		return err
+	}
 	return q.baseQueue.DeleteMessageFromDLQ(ctx, messageID)
 }
 
@@ -396,6 +450,9 @@ func (q *FaultInjectionQueue) RangeDeleteMessagesFromDLQ(
 	if err := q.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := q.rules.Inject(ctx, "RangeDeleteMessagesFromDLQ", nil); err != nil {
+		return err
+	}
 	return q.baseQueue.RangeDeleteMessagesFromDLQ(ctx, firstMessageID, lastMessageID)
 }
 
@@ -406,6 +463,9 @@ func (q *FaultInjectionQueue) UpdateDLQAckLevel(
 	if err := q.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := q.rules.Inject(ctx, "UpdateDLQAckLevel", nil); err != nil {
+		return err
+	}
 	return q.baseQueue.UpdateDLQAckLevel(ctx, metadata)
 }
 
@@ -415,6 +475,9 @@ func (q *FaultInjectionQueue) GetDLQAckLevels(
 	if err := q.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := q.rules.Inject(ctx, "GetDLQAckLevels", nil); err != nil {
+		return nil, err
+	}
 	return q.baseQueue.GetDLQAckLevels(ctx)
 }
 
@@ -454,6 +517,9 @@ func (e *FaultInjectionExecutionStore) GetWorkflowExecution(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := e.rules.Inject(ctx, "GetWorkflowExecution", request); err != nil {
+		return nil, err
+	}
 	return e.baseExecutionStore.GetWorkflowExecution(ctx, request)
 }
 
@@ -464,6 +530,9 @@ func (e *FaultInjectionExecutionStore) SetWorkflowExecution(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := e.rules.Inject(ctx, "SetWorkflowExecution", request); err != nil {
+		return err
+	}
 	return e.baseExecutionStore.SetWorkflowExecution(ctx, request)
 }
 
@@ -474,6 +543,9 @@ func (e *FaultInjectionExecutionStore) UpdateWorkflowExecution(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := e.rules.Inject(ctx, "UpdateWorkflowExecution", request); err != nil {
+		return err
+	}
 	return e.baseExecutionStore.UpdateWorkflowExecution(ctx, request)
 }
 
@@ -484,6 +556,9 @@ func (e *FaultInjectionExecutionStore) ConflictResolveWorkflowExecution(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := e.rules.Inject(ctx, "ConflictResolveWorkflowExecution", request); err != nil {
+		return err
+	}
 	return e.baseExecutionStore.ConflictResolveWorkflowExecution(ctx, request)
 }
 
@@ -494,6 +569,9 @@ func (e *FaultInjectionExecutionStore) CreateWorkflowExecution(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := e.rules.Inject(ctx, "CreateWorkflowExecution", request); err != nil {
+		return nil, err
+	}
 	return e.baseExecutionStore.CreateWorkflowExecution(ctx, request)
 }
 
@@ -504,6 +582,9 @@ func (e *FaultInjectionExecutionStore) DeleteWorkflowExecution(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := e.rules.Inject(ctx, "DeleteWorkflowExecution", request); err != nil {
+		return err
+	}
 	return e.baseExecutionStore.DeleteWorkflowExecution(ctx, request)
 }
 
@@ -514,6 +595,9 @@ func (e *FaultInjectionExecutionStore) DeleteCurrentWorkflowExecution(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := e.rules.Inject(ctx, "DeleteCurrentWorkflowExecution", request); err != nil {
+		return err
+	}
 	return e.baseExecutionStore.DeleteCurrentWorkflowExecution(ctx, request)
 }
 
@@ -524,6 +608,9 @@ func (e *FaultInjectionExecutionStore) GetCurrentExecution(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := e.rules.Inject(ctx, "GetCurrentExecution", request); err != nil {
+		return nil, err
+	}
 	return e.baseExecutionStore.GetCurrentExecution(ctx, request)
 }
 
@@ -534,6 +621,9 @@ func (e *FaultInjectionExecutionStore) ListConcreteExecutions(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := e.rules.Inject(ctx, "ListConcreteExecutions", request); err != nil {
+		return nil, err
+	}
 	return e.baseExecutionStore.ListConcreteExecutions(ctx, request)
 }
 
@@ -544,6 +634,9 @@ func (e *FaultInjectionExecutionStore) AddHistoryTasks(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := e.rules.Inject(ctx, "AddHistoryTasks", request); err != nil {
+		return err
+	}
 	return e.baseExecutionStore.AddHistoryTasks(ctx, request)
 }
 
@@ -554,6 +647,9 @@ func (e *FaultInjectionExecutionStore) GetHistoryTask(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := e.rules.Inject(ctx, "GetHistoryTask", request); err != nil {
+		return nil, err
+	}
 	return e.baseExecutionStore.GetHistoryTask(ctx, request)
 }
 
@@ -564,6 +660,9 @@ func (e *FaultInjectionExecutionStore) GetHistoryTasks(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := e.rules.Inject(ctx, "GetHistoryTasks", request); err != nil {
+		return nil, err
+	}
 	return e.baseExecutionStore.GetHistoryTasks(ctx, request)
 }
 
@@ -574,6 +673,9 @@ func (e *FaultInjectionExecutionStore) CompleteHistoryTask(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := e.rules.Inject(ctx, "CompleteHistoryTask", request); err != nil {
+		return err
+	}
 	return e.baseExecutionStore.CompleteHistoryTask(ctx, request)
 }
 
@@ -584,6 +686,9 @@ func (e *FaultInjectionExecutionStore) RangeCompleteHistoryTasks(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := e.rules.Inject(ctx, "RangeCompleteHistoryTasks", request); err != nil {
+		return err
+	}
 	return e.baseExecutionStore.RangeCompleteHistoryTasks(ctx, request)
 }
 
@@ -594,6 +699,9 @@ func (e *FaultInjectionExecutionStore) PutReplicationTaskToDLQ(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := e.rules.Inject(ctx, "PutReplicationTaskToDLQ", request); err != nil {
+		return err
+	}
 	return e.baseExecutionStore.PutReplicationTaskToDLQ(ctx, request)
 }
 
@@ -607,6 +715,9 @@ func (e *FaultInjectionExecutionStore) GetReplicationTasksFromDLQ(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := e.rules.Inject(ctx, "GetReplicationTasksFromDLQ", request); err != nil {
+		return nil, err
+	}
 	return e.baseExecutionStore.GetReplicationTasksFromDLQ(ctx, request)
 }
 
@@ -617,6 +728,9 @@ func (e *FaultInjectionExecutionStore) DeleteReplicationTaskFromDLQ(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := e.rules.Inject(ctx, "DeleteReplicationTaskFromDLQ", request); err != nil {
+		return err
+	}
 	return e.baseExecutionStore.DeleteReplicationTaskFromDLQ(ctx, request)
 }
 
@@ -627,6 +741,9 @@ func (e *FaultInjectionExecutionStore) RangeDeleteReplicationTaskFromDLQ(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := e.rules.Inject(ctx, "RangeDeleteReplicationTaskFromDLQ", request); err != nil {
+		return err
+	}
 	return e.baseExecutionStore.RangeDeleteReplicationTaskFromDLQ(ctx, request)
 }
 
@@ -637,6 +754,9 @@ func (e *FaultInjectionExecutionStore) AppendHistoryNodes(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := e.rules.Inject(ctx, "AppendHistoryNodes", request); err != nil {
+		return err
+	}
 	return e.baseExecutionStore.AppendHistoryNodes(ctx, request)
 }
 
@@ -647,6 +767,9 @@ func (e *FaultInjectionExecutionStore) DeleteHistoryNodes(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := e.rules.Inject(ctx, "DeleteHistoryNodes", request); err != nil {
+		return err
+	}
 	return e.baseExecutionStore.DeleteHistoryNodes(ctx, request)
 }
 
@@ -657,6 +780,9 @@ func (e *FaultInjectionExecutionStore) ParseHistoryBranchInfo(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := e.rules.Inject(ctx, "ParseHistoryBranchInfo", request); err != nil {
+		return nil, err
+	}
 	return e.baseExecutionStore.ParseHistoryBranchInfo(ctx, request)
 }
 
@@ -667,6 +793,9 @@ func (e *FaultInjectionExecutionStore) UpdateHistoryBranchInfo(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := e.rules.Inject(ctx, "UpdateHistoryBranchInfo", request); err != nil {
+		return nil, err
+	}
 	return e.baseExecutionStore.UpdateHistoryBranchInfo(ctx, request)
 }
 
@@ -677,6 +806,9 @@ func (e *FaultInjectionExecutionStore) NewHistoryBranch(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := e.rules.Inject(ctx, "NewHistoryBranch", request); err != nil {
+		return nil, err
+	}
 	return e.baseExecutionStore.NewHistoryBranch(ctx, request)
 }
 
@@ -687,6 +819,9 @@ func (e *FaultInjectionExecutionStore) ReadHistoryBranch(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := e.rules.Inject(ctx, "ReadHistoryBranch", request); err != nil {
+		return nil, err
+	}
 	return e.baseExecutionStore.ReadHistoryBranch(ctx, request)
 }
 
@@ -697,6 +832,9 @@ func (e *FaultInjectionExecutionStore) ForkHistoryBranch(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := e.rules.Inject(ctx, "ForkHistoryBranch", request); err != nil {
+		return err
+	}
 	return e.baseExecutionStore.ForkHistoryBranch(ctx, request)
 }
 
@@ -707,6 +845,9 @@ func (e *FaultInjectionExecutionStore) DeleteHistoryBranch(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := e.rules.Inject(ctx, "DeleteHistoryBranch", request); err != nil {
+		return err
+	}
 	return e.baseExecutionStore.DeleteHistoryBranch(ctx, request)
 }
 
@@ -717,6 +858,9 @@ func (e *FaultInjectionExecutionStore) GetHistoryTree(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := e.rules.Inject(ctx, "GetHistoryTree", request); err != nil {
+		return nil, err
+	}
 	return e.baseExecutionStore.GetHistoryTree(ctx, request)
 }
 
@@ -727,6 +871,9 @@ func (e *FaultInjectionExecutionStore) GetAllHistoryTreeBranches(
 	if err := e.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := e.rules.Inject(ctx, "GetAllHistoryTreeBranches", request); err != nil {
+		return nil, err
+	}
 	return e.baseExecutionStore.GetAllHistoryTreeBranches(ctx, request)
 }
 
@@ -760,6 +907,9 @@ func (c *FaultInjectionClusterMetadataStore) ListClusterMetadata(
 	if err := c.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := c.rules.Inject(ctx, "ListClusterMetadata", request); err != nil {
+		return nil, err
+	}
 	return c.baseCMStore.ListClusterMetadata(ctx, request)
 }
 
@@ -770,6 +920,9 @@ func (c *FaultInjectionClusterMetadataStore) GetClusterMetadata(
 	if err := c.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := c.rules.Inject(ctx, "GetClusterMetadata", request); err != nil {
+		return nil, err
+	}
 	return c.baseCMStore.GetClusterMetadata(ctx, request)
 }
 
@@ -780,6 +933,9 @@ func (c *FaultInjectionClusterMetadataStore) SaveClusterMetadata(
 	if err := c.ErrorGenerator.Generate(); err != nil {
 		return false, err
 	}
+	if err := c.rules.Inject(ctx, "SaveClusterMetadata", request); err != nil {
+		return false, err
+	}
 	return c.baseCMStore.SaveClusterMetadata(ctx, request)
 }
 
@@ -790,6 +946,9 @@ func (c *FaultInjectionClusterMetadataStore) DeleteClusterMetadata(
 	if err := c.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := c.rules.Inject(ctx, "DeleteClusterMetadata", request); err != nil {
+		return err
+	}
 	return c.baseCMStore.DeleteClusterMetadata(ctx, request)
 }
 
@@ -800,6 +959,9 @@ func (c *FaultInjectionClusterMetadataStore) GetClusterMembers(
 	if err := c.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := c.rules.Inject(ctx, "GetClusterMembers", request); err != nil {
+		return nil, err
+	}
 	return c.baseCMStore.GetClusterMembers(ctx, request)
 }
 
@@ -810,6 +972,9 @@ func (c *FaultInjectionClusterMetadataStore) UpsertClusterMembership(
 	if err := c.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := c.rules.Inject(ctx, "UpsertClusterMembership", request); err != nil {
+		return err
+	}
 	return c.baseCMStore.UpsertClusterMembership(ctx, request)
 }
 
@@ -820,6 +985,9 @@ func (c *FaultInjectionClusterMetadataStore) PruneClusterMembership(
 	if err := c.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := c.rules.Inject(ctx, "PruneClusterMembership", request); err != nil {
+		return err
+	}
 	return c.baseCMStore.PruneClusterMembership(ctx, request)
 }
 
@@ -853,6 +1021,9 @@ func (m *FaultInjectionMetadataStore) CreateNamespace(
 	if err := m.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := m.rules.Inject(ctx, "CreateNamespace", request); err != nil {
+		return nil, err
+	}
 	return m.baseMetadataStore.CreateNamespace(ctx, request)
 }
 
@@ -863,6 +1034,9 @@ func (m *FaultInjectionMetadataStore) GetNamespace(
 	if err := m.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := m.rules.Inject(ctx, "GetNamespace", request); err != nil {
+		return nil, err
+	}
 	return m.baseMetadataStore.GetNamespace(ctx, request)
 }
 
@@ -873,6 +1047,9 @@ func (m *FaultInjectionMetadataStore) UpdateNamespace(
 	if err := m.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := m.rules.Inject(ctx, "UpdateNamespace", request); err != nil {
+		return err
+	}
 	return m.baseMetadataStore.UpdateNamespace(ctx, request)
 }
 
@@ -883,6 +1060,9 @@ func (m *FaultInjectionMetadataStore) RenameNamespace(
 	if err := m.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := m.rules.Inject(ctx, "RenameNamespace", request); err != nil {
+		return err
+	}
 	return m.baseMetadataStore.RenameNamespace(ctx, request)
 }
 
@@ -893,6 +1073,9 @@ func (m *FaultInjectionMetadataStore) DeleteNamespace(
 	if err := m.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := m.rules.Inject(ctx, "DeleteNamespace", request); err != nil {
+		return err
+	}
 	return m.baseMetadataStore.DeleteNamespace(ctx, request)
 }
 
@@ -903,6 +1086,9 @@ func (m *FaultInjectionMetadataStore) DeleteNamespaceByName(
 	if err := m.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := m.rules.Inject(ctx, "DeleteNamespaceByName", request); err != nil {
+		return err
+	}
 	return m.baseMetadataStore.DeleteNamespaceByName(ctx, request)
 }
 
@@ -913,6 +1099,9 @@ func (m *FaultInjectionMetadataStore) ListNamespaces(
 	if err := m.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := m.rules.Inject(ctx, "ListNamespaces", request); err != nil {
+		return nil, err
+	}
 	return m.baseMetadataStore.ListNamespaces(ctx, request)
 }
 
@@ -922,6 +1111,9 @@ func (m *FaultInjectionMetadataStore) GetMetadata(
 	if err := m.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := m.rules.Inject(ctx, "GetMetadata", nil); err != nil {
+		return nil, err
+	}
 	return m.baseMetadataStore.GetMetadata(ctx)
 }
 
@@ -956,6 +1148,9 @@ func (t *FaultInjectionTaskStore) CreateTaskQueue(
 	if err := t.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := t.rules.Inject(ctx, "CreateTaskQueue", request); err != nil {
+		return err
+	}
 	return t.baseTaskStore.CreateTaskQueue(ctx, request)
 }
 
@@ -966,6 +1161,9 @@ func (t *FaultInjectionTaskStore) GetTaskQueue(
 	if err := t.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := t.rules.Inject(ctx, "GetTaskQueue", request); err != nil {
+		return nil, err
+	}
 	return t.baseTaskStore.GetTaskQueue(ctx, request)
 }
 
@@ -976,6 +1174,9 @@ func (t *FaultInjectionTaskStore) UpdateTaskQueue(
 	if err := t.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := t.rules.Inject(ctx, "UpdateTaskQueue", request); err != nil {
+		return nil, err
+	}
 	return t.baseTaskStore.UpdateTaskQueue(ctx, request)
 }
 
@@ -986,6 +1187,9 @@ func (t *FaultInjectionTaskStore) ListTaskQueue(
 	if err := t.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := t.rules.Inject(ctx, "ListTaskQueue", request); err != nil {
+		return nil, err
+	}
 	return t.baseTaskStore.ListTaskQueue(ctx, request)
 }
 
@@ -996,6 +1200,9 @@ func (t *FaultInjectionTaskStore) DeleteTaskQueue(
 	if err := t.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := t.rules.Inject(ctx, "DeleteTaskQueue", request); err != nil {
+		return err
+	}
 	return t.baseTaskStore.DeleteTaskQueue(ctx, request)
 }
 
@@ -1006,6 +1213,9 @@ func (t *FaultInjectionTaskStore) CreateTasks(
 	if err := t.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := t.rules.Inject(ctx, "CreateTasks", request); err != nil {
+		return nil, err
+	}
 	return t.baseTaskStore.CreateTasks(ctx, request)
 }
 
@@ -1016,6 +1226,9 @@ func (t *FaultInjectionTaskStore) GetTasks(
 	if err := t.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := t.rules.Inject(ctx, "GetTasks", request); err != nil {
+		return nil, err
+	}
 	return t.baseTaskStore.GetTasks(ctx, request)
 }
 
@@ -1026,6 +1239,9 @@ func (t *FaultInjectionTaskStore) CompleteTask(
 	if err := t.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := t.rules.Inject(ctx, "CompleteTask", request); err != nil {
+		return err
+	}
 	return t.baseTaskStore.CompleteTask(ctx, request)
 }
 
@@ -1036,6 +1252,9 @@ func (t *FaultInjectionTaskStore) CompleteTasksLessThan(
 	if err := t.ErrorGenerator.Generate(); err != nil {
 		return 0, err
 	}
+	if err := t.rules.Inject(ctx, "CompleteTasksLessThan", request); err != nil {
+		return 0, err
+	}
 	return t.baseTaskStore.CompleteTasksLessThan(ctx, request)
 }
 
@@ -1085,6 +1304,9 @@ func (s *FaultInjectionShardStore) GetOrCreateShard(
 	if err := s.ErrorGenerator.Generate(); err != nil {
 		return nil, err
 	}
+	if err := s.rules.Inject(ctx, "GetOrCreateShard", request); err != nil {
+		return nil, err
+	}
 	return s.baseShardStore.GetOrCreateShard(ctx, request)
 }
 
@@ -1095,6 +1317,9 @@ func (s *FaultInjectionShardStore) UpdateShard(
 	if err := s.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := s.rules.Inject(ctx, "UpdateShard", request); err != nil {
+		return err
+	}
 	return s.baseShardStore.UpdateShard(ctx, request)
 }
 
@@ -1105,6 +1330,9 @@ func (s *FaultInjectionShardStore) AssertShardOwnership(
 	if err := s.ErrorGenerator.Generate(); err != nil {
 		return err
 	}
+	if err := s.rules.Inject(ctx, "AssertShardOwnership", request); err != nil {
+		return err
+	}
 	return s.baseShardStore.AssertShardOwnership(ctx, request)
 }
 
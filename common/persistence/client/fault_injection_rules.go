@@ -0,0 +1,354 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	// faultInjectionRulesRefreshInterval bounds how often the dynamic config value is re-read.
+	faultInjectionRulesRefreshInterval = time.Second
+
+	faultInjectionRuleMethodKey      = "method"
+	faultInjectionRuleNamespaceKey   = "namespace"
+	faultInjectionRuleWorkflowIDKey  = "workflowId"
+	faultInjectionRuleErrorRateKey   = "errorRate"
+	faultInjectionRuleErrorKey       = "error"
+	faultInjectionRuleLatencyKey     = "latency"
+	faultInjectionRuleLatencyRateKey = "latencyRate"
+
+	defaultFaultInjectionRuleError = "UnavailableError"
+)
+
+type (
+	// FaultInjectionRulesFn returns the fault injection rules keyed by rule name.
+	FaultInjectionRulesFn dynamicconfig.MapPropertyFn
+
+	// FaultInjectionRules injects errors and latency into persistence calls that match the rules currently
+	// configured in dynamic config. Every rule is optional on each dimension, e.g.
+	//
+	//	system.persistenceFaultInjectionRules:
+	//	  - value:
+	//	      slowUpdates:
+	//	        method: UpdateWorkflowExecution
+	//	        namespace: my-namespace
+	//	        workflowId: my-workflow
+	//	        latency: 500ms
+	//	        latencyRate: 0.5
+	//	        errorRate: 0.1
+	//	        error: ShardOwnershipLostError
+	//
+	// A namespace matches either the caller's namespace name or the namespace ID on the request. A nil
+	// *FaultInjectionRules never injects anything.
+	FaultInjectionRules struct {
+		rulesFn FaultInjectionRulesFn
+		logger  log.Logger
+		ruleSet atomic.Value // *faultInjectionRuleSet
+	}
+
+	faultInjectionRuleSet struct {
+		raw    map[string]any
+		rules  []faultInjectionRule
+		expiry time.Time
+	}
+
+	faultInjectionRule struct {
+		name        string
+		method      string
+		namespace   string
+		workflowID  string
+		errorRate   float64
+		err         error
+		latency     time.Duration
+		latencyRate float64
+	}
+
+	// faultInjectionTarget describes a single persistence call. Request identifiers are only extracted
+	// when a rule needs them.
+	faultInjectionTarget struct {
+		ctx         context.Context
+		method      string
+		request     any
+		extracted   bool
+		namespaceID string
+		workflowID  string
+	}
+)
+
+func FaultInjectionRulesProvider(dc *dynamicconfig.Collection) FaultInjectionRulesFn {
+	return FaultInjectionRulesFn(dc.GetMapProperty(dynamicconfig.PersistenceFaultInjectionRules, map[string]any{}))
+}
+
+func NewFaultInjectionRules(
+	rulesFn FaultInjectionRulesFn,
+	logger log.Logger,
+) *FaultInjectionRules {
+	return &FaultInjectionRules{
+		rulesFn: rulesFn,
+		logger:  logger,
+	}
+}
+
+// Inject delays and/or fails the persistence call to method if it matches any configured rule. Latency is
+// injected before errors and is cut short if ctx is done.
+func (r *FaultInjectionRules) Inject(
+	ctx context.Context,
+	method string,
+	request any,
+) error {
+	if r == nil {
+		return nil
+	}
+	rules := r.rules()
+	if len(rules) == 0 {
+		return nil
+	}
+
+	target := &faultInjectionTarget{ctx: ctx, method: method, request: request}
+	for _, rule := range rules {
+		if !rule.matches(target) {
+			continue
+		}
+		if rule.latency > 0 && rand.Float64() < rule.latencyRate {
+			if err := sleepWithContext(ctx, rule.latency); err != nil {
+				return err
+			}
+		}
+		if rule.errorRate > 0 && rand.Float64() < rule.errorRate {
+			return rule.err
+		}
+	}
+	return nil
+}
+
+func (r *FaultInjectionRules) rules() []faultInjectionRule {
+	now := time.Now()
+	cached, _ := r.ruleSet.Load().(*faultInjectionRuleSet)
+	if cached != nil && now.Before(cached.expiry) {
+		return cached.rules
+	}
+
+	raw := r.rulesFn()
+	if cached != nil && reflect.DeepEqual(cached.raw, raw) {
+		r.ruleSet.Store(&faultInjectionRuleSet{raw: raw, rules: cached.rules, expiry: now.Add(faultInjectionRulesRefreshInterval)})
+		return cached.rules
+	}
+
+	rules := make([]faultInjectionRule, 0, len(raw))
+	for name, value := range raw {
+		rule, err := newFaultInjectionRule(name, value)
+		if err != nil {
+			r.logger.Warn("Ignoring invalid persistence fault injection rule.", tag.Key(name), tag.Error(err))
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].name < rules[j].name })
+	r.ruleSet.Store(&faultInjectionRuleSet{raw: raw, rules: rules, expiry: now.Add(faultInjectionRulesRefreshInterval)})
+	return rules
+}
+
+func newFaultInjectionRule(name string, value any) (faultInjectionRule, error) {
+	rule := faultInjectionRule{name: name}
+	fields, ok := value.(map[string]any)
+	if !ok {
+		return rule, fmt.Errorf("rule must be a map, got %T", value)
+	}
+
+	var err error
+	errorName := defaultFaultInjectionRuleError
+	latencyRateSet := false
+	for key, field := range fields {
+		switch key {
+		case faultInjectionRuleMethodKey:
+			rule.method, err = faultInjectionRuleString(key, field)
+		case faultInjectionRuleNamespaceKey:
+			rule.namespace, err = faultInjectionRuleString(key, field)
+		case faultInjectionRuleWorkflowIDKey:
+			rule.workflowID, err = faultInjectionRuleString(key, field)
+		case faultInjectionRuleErrorKey:
+			errorName, err = faultInjectionRuleString(key, field)
+		case faultInjectionRuleErrorRateKey:
+			rule.errorRate, err = faultInjectionRuleRate(key, field)
+		case faultInjectionRuleLatencyRateKey:
+			rule.latencyRate, err = faultInjectionRuleRate(key, field)
+			latencyRateSet = true
+		case faultInjectionRuleLatencyKey:
+			rule.latency, err = faultInjectionRuleDuration(key, field)
+		default:
+			err = fmt.Errorf("unknown key %q", key)
+		}
+		if err != nil {
+			return rule, err
+		}
+	}
+
+	if rule.errorRate > 0 {
+		if rule.err, ok = faultInjectionErrorFromName(errorName); !ok {
+			return rule, fmt.Errorf("unknown error type %q", errorName)
+		}
+	}
+	if rule.latency > 0 && !latencyRateSet {
+		rule.latencyRate = 1
+	}
+	return rule, nil
+}
+
+func faultInjectionRuleString(key string, value any) (string, error) {
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("%s must be a string, got %T", key, value)
+	}
+	return s, nil
+}
+
+func faultInjectionRuleRate(key string, value any) (float64, error) {
+	var rate float64
+	switch v := value.(type) {
+	case float64:
+		rate = v
+	case int:
+		rate = float64(v)
+	default:
+		return 0, fmt.Errorf("%s must be a number, got %T", key, value)
+	}
+	if rate < 0 || rate > 1 {
+		return 0, fmt.Errorf("%s must be between 0 and 1, got %v", key, rate)
+	}
+	return rate, nil
+}
+
+func faultInjectionRuleDuration(key string, value any) (time.Duration, error) {
+	switch v := value.(type) {
+	case time.Duration:
+		return v, nil
+	case string:
+		d, err := timestamp.ParseDurationDefaultDays(v)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", key, err)
+		}
+		return d, nil
+	default:
+		return 0, fmt.Errorf("%s must be a duration string, got %T", key, value)
+	}
+}
+
+func (rule *faultInjectionRule) matches(target *faultInjectionTarget) bool {
+	if rule.method != "" && rule.method != target.method {
+		return false
+	}
+	if rule.namespace != "" &&
+		rule.namespace != headers.GetCallerInfo(target.ctx).CallerName &&
+		rule.namespace != target.requestNamespaceID() {
+		return false
+	}
+	if rule.workflowID != "" && rule.workflowID != target.requestWorkflowID() {
+		return false
+	}
+	return true
+}
+
+func (t *faultInjectionTarget) requestNamespaceID() string {
+	t.extract()
+	return t.namespaceID
+}
+
+func (t *faultInjectionTarget) requestWorkflowID() string {
+	t.extract()
+	return t.workflowID
+}
+
+// extract looks for NamespaceID and WorkflowID fields on the request, or on a struct nested one level
+// down (e.g. the mutation of an update or the snapshot of a create).
+func (t *faultInjectionTarget) extract() {
+	if t.extracted {
+		return
+	}
+	t.extracted = true
+
+	v := reflect.ValueOf(t.request)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+	if t.namespaceID, t.workflowID = requestIDFields(v); t.namespaceID != "" || t.workflowID != "" {
+		return
+	}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if field.Kind() == reflect.Ptr {
+			if field.IsNil() {
+				continue
+			}
+			field = field.Elem()
+		}
+		if field.Kind() != reflect.Struct {
+			continue
+		}
+		if t.namespaceID, t.workflowID = requestIDFields(field); t.namespaceID != "" || t.workflowID != "" {
+			return
+		}
+	}
+}
+
+func requestIDFields(v reflect.Value) (namespaceID string, workflowID string) {
+	if field := v.FieldByName("NamespaceID"); field.Kind() == reflect.String {
+		namespaceID = field.String()
+	}
+	if field := v.FieldByName("WorkflowID"); field.Kind() == reflect.String {
+		workflowID = field.String()
+	}
+	return namespaceID, workflowID
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
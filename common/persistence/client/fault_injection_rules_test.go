@@ -0,0 +1,175 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	faultInjectionRulesSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		raw   map[string]any
+		rules *FaultInjectionRules
+	}
+)
+
+func TestFaultInjectionRulesSuite(t *testing.T) {
+	s := new(faultInjectionRulesSuite)
+	suite.Run(t, s)
+}
+
+func (s *faultInjectionRulesSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.raw = map[string]any{}
+	s.rules = NewFaultInjectionRules(func() map[string]any { return s.raw }, log.NewNoopLogger())
+}
+
+func (s *faultInjectionRulesSuite) setRules(raw map[string]any) {
+	s.raw = raw
+	s.rules.ruleSet.Store((*faultInjectionRuleSet)(nil))
+}
+
+func (s *faultInjectionRulesSuite) TestNilRules() {
+	var rules *FaultInjectionRules
+	s.NoError(rules.Inject(context.Background(), "UpdateWorkflowExecution", nil))
+}
+
+func (s *faultInjectionRulesSuite) TestNoRules() {
+	s.NoError(s.rules.Inject(context.Background(), "UpdateWorkflowExecution", nil))
+}
+
+func (s *faultInjectionRulesSuite) TestMethod() {
+	s.setRules(map[string]any{
+		"rule": map[string]any{
+			"method":    "UpdateWorkflowExecution",
+			"errorRate": 1,
+			"error":     "ShardOwnershipLostError",
+		},
+	})
+
+	err := s.rules.Inject(context.Background(), "UpdateWorkflowExecution", nil)
+	s.IsType(&persistence.ShardOwnershipLostError{}, err)
+	s.NoError(s.rules.Inject(context.Background(), "GetWorkflowExecution", nil))
+}
+
+func (s *faultInjectionRulesSuite) TestNamespaceNameAndID() {
+	s.setRules(map[string]any{
+		"byName": map[string]any{
+			"namespace": "my-namespace",
+			"errorRate": 1.0,
+		},
+		"byID": map[string]any{
+			"namespace": "my-namespace-id",
+			"errorRate": 1.0,
+		},
+	})
+
+	ctx := headers.SetCallerName(context.Background(), "my-namespace")
+	s.Error(s.rules.Inject(ctx, "GetWorkflowExecution", nil))
+
+	request := &persistence.GetWorkflowExecutionRequest{NamespaceID: "my-namespace-id"}
+	s.Error(s.rules.Inject(context.Background(), "GetWorkflowExecution", request))
+
+	request = &persistence.GetWorkflowExecutionRequest{NamespaceID: "other-namespace-id"}
+	s.NoError(s.rules.Inject(headers.SetCallerName(context.Background(), "other-namespace"), "GetWorkflowExecution", request))
+}
+
+func (s *faultInjectionRulesSuite) TestWorkflowIDOnNestedMutation() {
+	s.setRules(map[string]any{
+		"rule": map[string]any{
+			"workflowId": "my-workflow",
+			"errorRate":  1.0,
+		},
+	})
+
+	request := &persistence.InternalUpdateWorkflowExecutionRequest{
+		UpdateWorkflowMutation: persistence.InternalWorkflowMutation{WorkflowID: "my-workflow"},
+	}
+	s.Error(s.rules.Inject(context.Background(), "UpdateWorkflowExecution", request))
+
+	request.UpdateWorkflowMutation.WorkflowID = "other-workflow"
+	s.NoError(s.rules.Inject(context.Background(), "UpdateWorkflowExecution", request))
+	s.NoError(s.rules.Inject(context.Background(), "UpdateWorkflowExecution", nil))
+}
+
+func (s *faultInjectionRulesSuite) TestLatency() {
+	s.setRules(map[string]any{
+		"rule": map[string]any{
+			"latency": "50ms",
+		},
+	})
+
+	start := time.Now()
+	s.NoError(s.rules.Inject(context.Background(), "GetWorkflowExecution", nil))
+	s.GreaterOrEqual(time.Since(start), 50*time.Millisecond)
+}
+
+func (s *faultInjectionRulesSuite) TestLatencyRespectsContext() {
+	s.setRules(map[string]any{
+		"rule": map[string]any{
+			"latency": "1m",
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	s.ErrorIs(s.rules.Inject(ctx, "GetWorkflowExecution", nil), context.DeadlineExceeded)
+}
+
+func (s *faultInjectionRulesSuite) TestInvalidRulesAreIgnored() {
+	s.setRules(map[string]any{
+		"badRate":  map[string]any{"errorRate": 2.0},
+		"badError": map[string]any{"errorRate": 1.0, "error": "NoSuchError"},
+		"badKey":   map[string]any{"errorRate": 1.0, "methods": "GetWorkflowExecution"},
+		"notAMap":  "GetWorkflowExecution",
+	})
+
+	s.NoError(s.rules.Inject(context.Background(), "GetWorkflowExecution", nil))
+	s.Empty(s.rules.rules())
+}
+
+func (s *faultInjectionRulesSuite) TestRulesAreRefreshed() {
+	s.NoError(s.rules.Inject(context.Background(), "GetWorkflowExecution", nil))
+
+	s.raw = map[string]any{"rule": map[string]any{"errorRate": 1.0}}
+	s.NoError(s.rules.Inject(context.Background(), "GetWorkflowExecution", nil), "rules are cached until the refresh interval")
+
+	cached := s.rules.ruleSet.Load().(*faultInjectionRuleSet)
+	cached.expiry = time.Now()
+	s.Error(s.rules.Inject(context.Background(), "GetWorkflowExecution", nil))
+}
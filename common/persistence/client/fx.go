@@ -34,6 +34,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/resolver"
 )
 
 type (
@@ -56,18 +57,44 @@ type (
 	}
 
 	FactoryProviderFn func(NewFactoryParams) Factory
+
+	DataStoreFactoryParams struct {
+		fx.In
+
+		ClusterName              ClusterName
+		ServiceResolver          resolver.ServiceResolver
+		Cfg                      *config.Persistence
+		AbstractDataStoreFactory AbstractDataStoreFactory
+		Logger                   log.Logger
+		MetricsHandler           metrics.Handler
+		FaultInjectionRules      FaultInjectionRulesFn `optional:"true"`
+	}
 )
 
 var Module = fx.Options(
 	BeanModule,
 	fx.Provide(ClusterNameProvider),
-	fx.Provide(DataStoreFactoryProvider),
+	fx.Provide(DataStoreFactoryFxProvider),
 )
 
 func ClusterNameProvider(config *cluster.Config) ClusterName {
 	return ClusterName(config.CurrentClusterName)
 }
 
+// DataStoreFactoryFxProvider is DataStoreFactoryProvider for fx. FaultInjectionRulesFn is optional, provide it
+// with FaultInjectionRulesProvider to turn on targeted fault injection.
+func DataStoreFactoryFxProvider(params DataStoreFactoryParams) (DataStoreFactory, *FaultInjectionDataStoreFactory) {
+	return DataStoreFactoryProvider(
+		params.ClusterName,
+		params.ServiceResolver,
+		params.Cfg,
+		params.AbstractDataStoreFactory,
+		params.Logger,
+		params.MetricsHandler,
+		params.FaultInjectionRules,
+	)
+}
+
 func FactoryProvider(
 	params NewFactoryParams,
 ) Factory {
@@ -69,14 +69,15 @@ type (
 func DataStoreFactoryProvider(
 	clusterName ClusterName,
 	r resolver.ServiceResolver,
-	config *config.Persistence,
+	cfg *config.Persistence,
 	abstractDataStoreFactory AbstractDataStoreFactory,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
+	faultInjectionRules FaultInjectionRulesFn,
 ) (DataStoreFactory, *FaultInjectionDataStoreFactory) {
 
 	var dataStoreFactory DataStoreFactory
-	defaultCfg := config.DataStores[config.DefaultStore]
+	defaultCfg := cfg.DataStores[cfg.DefaultStore]
 	switch {
 	case defaultCfg.Cassandra != nil:
 		dataStoreFactory = cassandra.NewFactory(*defaultCfg.Cassandra, r, string(clusterName), logger)
@@ -88,9 +89,16 @@ func DataStoreFactoryProvider(
 		logger.Fatal("invalid config: one of cassandra or sql params must be specified for default data store")
 	}
 
+	// Fault injection is only enabled by the static configuration. Once enabled, rules from dynamic config, if
+	// provided, target injected faults at specific calls on top of the configured rate.
 	var faultInjection *FaultInjectionDataStoreFactory
 	if defaultCfg.FaultInjection != nil {
-		dataStoreFactory = NewFaultInjectionDatastoreFactory(defaultCfg.FaultInjection, dataStoreFactory)
+		var rules *FaultInjectionRules
+		if faultInjectionRules != nil {
+			rules = NewFaultInjectionRules(faultInjectionRules, logger)
+		}
+		faultInjection = NewFaultInjectionDatastoreFactory(defaultCfg.FaultInjection, dataStoreFactory, rules)
+		dataStoreFactory = faultInjection
 	}
 
 	return dataStoreFactory, faultInjection
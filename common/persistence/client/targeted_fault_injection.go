@@ -32,6 +32,9 @@ import (
 	"strings"
 	"time"
 
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/persistence"
 )
@@ -99,13 +102,28 @@ func (d *dataStoreErrorGenerator) Generate() error {
 // getErrorFromName returns an error based on the provided name. If the name is not recognized, then this method will
 // panic.
 func getErrorFromName(name string) error {
+	err, ok := faultInjectionErrorFromName(name)
+	if !ok {
+		panic(fmt.Sprintf("unknown error type: %v", name))
+	}
+	return err
+}
+
+// faultInjectionErrorFromName returns the error for the provided name and whether the name is recognized.
+func faultInjectionErrorFromName(name string) (error, bool) {
 	switch name {
 	case "ShardOwnershipLostError":
-		return &persistence.ShardOwnershipLostError{}
+		return &persistence.ShardOwnershipLostError{}, true
 	case "DeadlineExceededError":
-		return context.DeadlineExceeded
+		return context.DeadlineExceeded, true
+	case "UnavailableError":
+		return serviceerror.NewUnavailable("persistence fault injection"), true
+	case "ResourceExhaustedError":
+		return serviceerror.NewResourceExhausted(enumspb.RESOURCE_EXHAUSTED_CAUSE_SYSTEM_OVERLOADED, "persistence fault injection"), true
+	case "TimeoutError":
+		return &persistence.TimeoutError{Msg: "persistence fault injection"}, true
 	default:
-		panic(fmt.Sprintf("unknown error type: %v", name))
+		return nil, false
 	}
 }
 
@@ -201,6 +201,7 @@ func (s *TestBase) Setup(clusterMetadataConfig *cluster.Config) {
 		s.AbstractDataStoreFactory,
 		s.Logger,
 		metrics.NoopMetricsHandler,
+		nil,
 	)
 	factory := client.NewFactory(dataStoreFactory, &cfg, nil, serialization.NewSerializer(), clusterName, metrics.NoopMetricsHandler, s.Logger)
 
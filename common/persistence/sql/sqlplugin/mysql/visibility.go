@@ -79,6 +79,22 @@ const (
 
 	templateGetClosedWorkflowExecutionsByID = templateClosedSelect + `AND workflow_id = ?` + templateConditionsClosedWorkflows
 
+	// Prefixes are matched case sensitively, like in the other visibility stores. The collation of
+	// the columns is case insensitive: the first LIKE uses it so that it can use the index on the
+	// column, the second LIKE rechecks the rows found by the first one byte by byte. Both take the
+	// same pattern.
+	templateWorkflowTypeNamePrefixCondition = `workflow_type_name LIKE ? ESCAPE '!' AND CAST(workflow_type_name AS BINARY) LIKE CAST(? AS BINARY) ESCAPE '!'`
+
+	templateWorkflowIDPrefixCondition = `workflow_id LIKE ? ESCAPE '!' AND CAST(workflow_id AS BINARY) LIKE CAST(? AS BINARY) ESCAPE '!'`
+
+	templateGetOpenWorkflowExecutionsByTypePrefix = templateOpenSelect + `AND ` + templateWorkflowTypeNamePrefixCondition + templateConditions
+
+	templateGetClosedWorkflowExecutionsByTypePrefix = templateClosedSelect + `AND ` + templateWorkflowTypeNamePrefixCondition + templateConditionsClosedWorkflows
+
+	templateGetOpenWorkflowExecutionsByIDPrefix = templateOpenSelect + `AND ` + templateWorkflowIDPrefixCondition + templateConditions
+
+	templateGetClosedWorkflowExecutionsByIDPrefix = templateClosedSelect + `AND ` + templateWorkflowIDPrefixCondition + templateConditionsClosedWorkflows
+
 	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND status = ?` + templateConditionsClosedWorkflows
 
 	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length, task_queue 
@@ -102,6 +118,10 @@ const (
 		FROM executions_visibility
 		WHERE namespace_id = ? AND run_id = ?`
 
+	// Same time conditions as list of open and closed workflows.
+	templateCountConditions = ` FROM executions_visibility WHERE namespace_id = ?
+		 AND ((status = 1 AND start_time >= ? AND start_time <= ?) OR (status != 1 AND close_time >= ? AND close_time <= ?))`
+
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE namespace_id = ? AND run_id = ?"
 )
 
@@ -204,10 +224,16 @@ func (mdb *db) SelectFromVisibility(
 		if filter.Status != 1 {
 			qry = templateGetClosedWorkflowExecutionsByID
 		}
-		err = mdb.conn.SelectContext(ctx,
-			&rows,
-			qry,
-			*filter.WorkflowID,
+		args := []interface{}{*filter.WorkflowID}
+		if filter.PrefixMatch {
+			qry = templateGetOpenWorkflowExecutionsByIDPrefix
+			if filter.Status != 1 {
+				qry = templateGetClosedWorkflowExecutionsByIDPrefix
+			}
+			pattern := sqlplugin.LikePrefixPattern(*filter.WorkflowID)
+			args = []interface{}{pattern, pattern}
+		}
+		args = append(args,
 			filter.NamespaceID,
 			*filter.MinTime,
 			*filter.MaxTime,
@@ -216,16 +242,23 @@ func (mdb *db) SelectFromVisibility(
 			*filter.MaxTime,
 			*filter.PageSize,
 		)
+		err = mdb.conn.SelectContext(ctx, &rows, qry, args...)
 	case filter.MinTime != nil && filter.MaxTime != nil &&
 		filter.WorkflowTypeName != nil && filter.RunID != nil && filter.PageSize != nil:
 		qry := templateGetOpenWorkflowExecutionsByType
 		if filter.Status != 1 {
 			qry = templateGetClosedWorkflowExecutionsByType
 		}
-		err = mdb.conn.SelectContext(ctx,
-			&rows,
-			qry,
-			*filter.WorkflowTypeName,
+		args := []interface{}{*filter.WorkflowTypeName}
+		if filter.PrefixMatch {
+			qry = templateGetOpenWorkflowExecutionsByTypePrefix
+			if filter.Status != 1 {
+				qry = templateGetClosedWorkflowExecutionsByTypePrefix
+			}
+			pattern := sqlplugin.LikePrefixPattern(*filter.WorkflowTypeName)
+			args = []interface{}{pattern, pattern}
+		}
+		args = append(args,
 			filter.NamespaceID,
 			*filter.MinTime,
 			*filter.MaxTime,
@@ -234,6 +267,7 @@ func (mdb *db) SelectFromVisibility(
 			*filter.MaxTime,
 			*filter.PageSize,
 		)
+		err = mdb.conn.SelectContext(ctx, &rows, qry, args...)
 	case filter.MinTime != nil && filter.MaxTime != nil &&
 		filter.RunID != nil && filter.PageSize != nil &&
 		filter.Status != 0 && filter.Status != 1: // 0 is UNSPECIFIED, 1 is RUNNING
@@ -300,3 +334,53 @@ func (mdb *db) GetFromVisibility(
 	}
 	return &row, nil
 }
+
+// CountFromVisibility returns number of rows matching filter in visibility table
+func (mdb *db) CountFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilityCountFilter,
+) ([]sqlplugin.VisibilityCountRow, error) {
+	minTime := mdb.converter.ToMySQLDateTime(filter.MinTime)
+	maxTime := mdb.converter.ToMySQLDateTime(filter.MaxTime)
+	qry := templateCountConditions
+	args := []interface{}{filter.NamespaceID, minTime, maxTime, minTime, maxTime}
+	if filter.WorkflowID != nil {
+		if filter.PrefixMatch {
+			pattern := sqlplugin.LikePrefixPattern(*filter.WorkflowID)
+			qry += ` AND ` + templateWorkflowIDPrefixCondition
+			args = append(args, pattern, pattern)
+		} else {
+			qry += ` AND workflow_id = ?`
+			args = append(args, *filter.WorkflowID)
+		}
+	}
+	if filter.WorkflowTypeName != nil {
+		if filter.PrefixMatch {
+			pattern := sqlplugin.LikePrefixPattern(*filter.WorkflowTypeName)
+			qry += ` AND ` + templateWorkflowTypeNamePrefixCondition
+			args = append(args, pattern, pattern)
+		} else {
+			qry += ` AND workflow_type_name = ?`
+			args = append(args, *filter.WorkflowTypeName)
+		}
+	}
+	if filter.Status != 0 {
+		qry += ` AND status = ?`
+		args = append(args, filter.Status)
+	}
+
+	switch filter.GroupBy {
+	case "":
+		qry = `SELECT COUNT(*) AS count` + qry
+	case sqlplugin.VisibilityGroupByStatus, sqlplugin.VisibilityGroupByWorkflowTypeName:
+		qry = `SELECT ` + filter.GroupBy + ` AS group_value, COUNT(*) AS count` + qry + ` GROUP BY ` + filter.GroupBy
+	default:
+		return nil, fmt.Errorf("invalid group by column: %v", filter.GroupBy)
+	}
+
+	var rows []sqlplugin.VisibilityCountRow
+	if err := mdb.conn.SelectContext(ctx, &rows, qry, args...); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
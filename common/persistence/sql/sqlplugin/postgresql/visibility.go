@@ -102,6 +102,14 @@ const (
 
 	templateGetClosedWorkflowExecutionsByID = templateClosedSelect + `AND workflow_id = $1` + templateConditionsClosedWorkflow2
 
+	templateGetOpenWorkflowExecutionsByTypePrefix = templateOpenSelect + `AND workflow_type_name LIKE $1 ESCAPE '!'` + templateConditions2
+
+	templateGetClosedWorkflowExecutionsByTypePrefix = templateClosedSelect + `AND workflow_type_name LIKE $1 ESCAPE '!'` + templateConditionsClosedWorkflow2
+
+	templateGetOpenWorkflowExecutionsByIDPrefix = templateOpenSelect + `AND workflow_id LIKE $1 ESCAPE '!'` + templateConditions2
+
+	templateGetClosedWorkflowExecutionsByIDPrefix = templateClosedSelect + `AND workflow_id LIKE $1 ESCAPE '!'` + templateConditionsClosedWorkflow2
+
 	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND status = $1` + templateConditionsClosedWorkflow2
 
 	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length, task_queue
@@ -125,6 +133,10 @@ const (
 		FROM executions_visibility
 		WHERE namespace_id = $1 AND run_id = $2`
 
+	// Same time conditions as list of open and closed workflows.
+	templateCountConditions = ` FROM executions_visibility WHERE namespace_id = ?
+		 AND ((status = 1 AND start_time >= ? AND start_time <= ?) OR (status != 1 AND close_time >= ? AND close_time <= ?))`
+
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE namespace_id = $1 AND run_id = $2"
 )
 
@@ -225,10 +237,18 @@ func (pdb *db) SelectFromVisibility(
 		if filter.Status != 1 {
 			qry = templateGetClosedWorkflowExecutionsByID
 		}
+		workflowID := *filter.WorkflowID
+		if filter.PrefixMatch {
+			qry = templateGetOpenWorkflowExecutionsByIDPrefix
+			if filter.Status != 1 {
+				qry = templateGetClosedWorkflowExecutionsByIDPrefix
+			}
+			workflowID = sqlplugin.LikePrefixPattern(workflowID)
+		}
 		err = pdb.conn.SelectContext(ctx,
 			&rows,
 			qry,
-			*filter.WorkflowID,
+			workflowID,
 			filter.NamespaceID,
 			*filter.MinTime,
 			*filter.MaxTime,
@@ -242,10 +262,18 @@ func (pdb *db) SelectFromVisibility(
 		if filter.Status != 1 {
 			qry = templateGetClosedWorkflowExecutionsByType
 		}
+		workflowTypeName := *filter.WorkflowTypeName
+		if filter.PrefixMatch {
+			qry = templateGetOpenWorkflowExecutionsByTypePrefix
+			if filter.Status != 1 {
+				qry = templateGetClosedWorkflowExecutionsByTypePrefix
+			}
+			workflowTypeName = sqlplugin.LikePrefixPattern(workflowTypeName)
+		}
 		err = pdb.conn.SelectContext(ctx,
 			&rows,
 			qry,
-			*filter.WorkflowTypeName,
+			workflowTypeName,
 			filter.NamespaceID,
 			*filter.MinTime,
 			*filter.MaxTime,
@@ -320,3 +348,51 @@ func (mdb *db) GetFromVisibility(
 	}
 	return &row, nil
 }
+
+// CountFromVisibility returns number of rows matching filter in visibility table
+func (pdb *db) CountFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilityCountFilter,
+) ([]sqlplugin.VisibilityCountRow, error) {
+	minTime := pdb.converter.ToPostgreSQLDateTime(filter.MinTime)
+	maxTime := pdb.converter.ToPostgreSQLDateTime(filter.MaxTime)
+	qry := templateCountConditions
+	args := []interface{}{filter.NamespaceID, minTime, maxTime, minTime, maxTime}
+	if filter.WorkflowID != nil {
+		if filter.PrefixMatch {
+			qry += ` AND workflow_id LIKE ? ESCAPE '!'`
+			args = append(args, sqlplugin.LikePrefixPattern(*filter.WorkflowID))
+		} else {
+			qry += ` AND workflow_id = ?`
+			args = append(args, *filter.WorkflowID)
+		}
+	}
+	if filter.WorkflowTypeName != nil {
+		if filter.PrefixMatch {
+			qry += ` AND workflow_type_name LIKE ? ESCAPE '!'`
+			args = append(args, sqlplugin.LikePrefixPattern(*filter.WorkflowTypeName))
+		} else {
+			qry += ` AND workflow_type_name = ?`
+			args = append(args, *filter.WorkflowTypeName)
+		}
+	}
+	if filter.Status != 0 {
+		qry += ` AND status = ?`
+		args = append(args, filter.Status)
+	}
+
+	switch filter.GroupBy {
+	case "":
+		qry = `SELECT COUNT(*) AS count` + qry
+	case sqlplugin.VisibilityGroupByStatus, sqlplugin.VisibilityGroupByWorkflowTypeName:
+		qry = `SELECT ` + filter.GroupBy + ` AS group_value, COUNT(*) AS count` + qry + ` GROUP BY ` + filter.GroupBy
+	default:
+		return nil, fmt.Errorf("invalid group by column: %v", filter.GroupBy)
+	}
+
+	var rows []sqlplugin.VisibilityCountRow
+	if err := pdb.conn.SelectContext(ctx, &rows, pdb.conn.Rebind(qry), args...); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
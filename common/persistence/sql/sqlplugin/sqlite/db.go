@@ -54,6 +54,7 @@ type db struct {
 var _ sqlplugin.AdminDB = (*db)(nil)
 var _ sqlplugin.DB = (*db)(nil)
 var _ sqlplugin.Tx = (*db)(nil)
+var _ sqlplugin.AdvancedVisibility = (*db)(nil)
 
 // newDB returns an instance of DB, which is a logical
 // connection to the underlying sqlite database
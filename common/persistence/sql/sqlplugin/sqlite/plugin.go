@@ -144,7 +144,11 @@ func (p *plugin) createDBConnection(
 			return nil, err
 		}
 	case cfg.ConnectAttributes["setup"] == "true": // file mode, optional setting to setup the schema
-		if err := p.setupSQLiteDatabase(cfg, db); err != nil && !isTableExistsError(err) { // benign error indicating tables already exist
+		err := p.setupSQLiteDatabase(cfg, db)
+		if err != nil && isTableExistsError(err) { // tables already exist, the schema might be of an earlier release
+			err = p.updateSQLiteDatabase(cfg, db)
+		}
+		if err != nil {
 			_ = db.Close()
 			return nil, err
 		}
@@ -167,6 +171,13 @@ func (p *plugin) setupSQLiteDatabase(cfg *config.SQL, conn *sqlx.DB) error {
 	return sqliteschema.SetupSchemaOnDB(db)
 }
 
+func (p *plugin) updateSQLiteDatabase(cfg *config.SQL, conn *sqlx.DB) error {
+	db := newDB(sqlplugin.DbKindUnknown, cfg.DatabaseName, conn, nil)
+	defer func() { _ = db.Close() }()
+
+	return sqliteschema.UpdateSchemaOnDB(db)
+}
+
 func buildDSN(cfg *config.SQL) (string, error) {
 	if cfg.ConnectAttributes == nil {
 		cfg.ConnectAttributes = make(map[string]string)
@@ -31,19 +31,31 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, task_queue) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, task_queue, state_transition_count, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ` +
 		`ON CONFLICT (namespace_id, run_id) DO NOTHING`
 
 	templateCreateWorkflowExecutionClosed = `REPLACE INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding, task_queue) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) `
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding, task_queue, ` +
+		`state_transition_count, history_size_bytes, execution_duration, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) `
+
+	// Closed workflows are not updated, so a delayed upsert can't overwrite the final state.
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, task_queue, state_transition_count, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ` +
+		`ON CONFLICT (namespace_id, run_id) DO UPDATE SET ` +
+		`execution_time = excluded.execution_time, memo = excluded.memo, encoding = excluded.encoding, task_queue = excluded.task_queue, ` +
+		`state_transition_count = excluded.state_transition_count, search_attributes = excluded.search_attributes ` +
+		`WHERE executions_visibility.status = 1`
 
 	// RunID condition is needed for correct pagination
 	templateConditions = ` AND namespace_id = ?
@@ -78,8 +90,26 @@ const (
 
 	templateGetClosedWorkflowExecutionsByID = templateClosedSelect + `AND workflow_id = ?` + templateConditionsClosedWorkflows
 
+	templateGetOpenWorkflowExecutionsByTypePrefix = templateOpenSelect + `AND workflow_type_name GLOB ?` + templateConditions
+
+	templateGetClosedWorkflowExecutionsByTypePrefix = templateClosedSelect + `AND workflow_type_name GLOB ?` + templateConditionsClosedWorkflows
+
+	templateGetOpenWorkflowExecutionsByIDPrefix = templateOpenSelect + `AND workflow_id GLOB ?` + templateConditions
+
+	templateGetClosedWorkflowExecutionsByIDPrefix = templateClosedSelect + `AND workflow_id GLOB ?` + templateConditionsClosedWorkflows
+
 	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND status = ?` + templateConditionsClosedWorkflows
 
+	templateAdvancedFieldNames = templateOpenFieldNames + `, close_time, history_length, state_transition_count, history_size_bytes, execution_duration, search_attributes`
+
+	templateSelectByQuery = `SELECT ` + templateAdvancedFieldNames + ` FROM executions_visibility WHERE namespace_id = ?`
+
+	templateCountByQuery = ` FROM executions_visibility WHERE namespace_id = ?`
+
+	// Same time conditions as list of open and closed workflows.
+	templateCountConditions = ` FROM executions_visibility WHERE namespace_id = ?
+		 AND ((status = 1 AND start_time >= ? AND start_time <= ?) OR (status != 1 AND close_time >= ? AND close_time <= ?))`
+
 	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length, task_queue 
 		 FROM executions_visibility
 		 WHERE namespace_id = ? AND status != 1
@@ -97,7 +127,11 @@ const (
 			workflow_type_name,
 			status,
 			history_length,
-			task_queue 
+			task_queue,
+			state_transition_count,
+			history_size_bytes,
+			execution_duration,
+			search_attributes
 		FROM executions_visibility
 		WHERE namespace_id = ? AND run_id = ?`
 
@@ -106,6 +140,23 @@ const (
 
 var errCloseParams = errors.New("missing one of {closeTime, historyLength} params")
 
+// globPrefixPattern returns a GLOB pattern matching all values starting with prefix.
+// GLOB is used instead of LIKE because LIKE is case insensitive in SQLite.
+func globPrefixPattern(prefix string) string {
+	var pattern strings.Builder
+	for _, r := range prefix {
+		if r == '*' || r == '?' || r == '[' {
+			pattern.WriteByte('[')
+			pattern.WriteRune(r)
+			pattern.WriteByte(']')
+			continue
+		}
+		pattern.WriteRune(r)
+	}
+	pattern.WriteByte('*')
+	return pattern.String()
+}
+
 // InsertIntoVisibility inserts a row into visibility table. If an row already exist,
 // its left as such and no update will be made
 func (mdb *db) InsertIntoVisibility(
@@ -113,6 +164,7 @@ func (mdb *db) InsertIntoVisibility(
 	row *sqlplugin.VisibilityRow,
 ) (sql.Result, error) {
 	row.StartTime = mdb.converter.ToSQLiteDateTime(row.StartTime)
+	row.ExecutionTime = mdb.converter.ToSQLiteDateTime(row.ExecutionTime)
 	return mdb.conn.ExecContext(ctx,
 		templateCreateWorkflowExecutionStarted,
 		row.NamespaceID,
@@ -125,6 +177,32 @@ func (mdb *db) InsertIntoVisibility(
 		row.Memo,
 		row.Encoding,
 		row.TaskQueue,
+		row.StateTransitionCount,
+		row.SearchAttributes,
+	)
+}
+
+// UpsertIntoVisibility inserts a row into visibility table or updates the row of a running workflow
+func (mdb *db) UpsertIntoVisibility(
+	ctx context.Context,
+	row *sqlplugin.VisibilityRow,
+) (sql.Result, error) {
+	row.StartTime = mdb.converter.ToSQLiteDateTime(row.StartTime)
+	row.ExecutionTime = mdb.converter.ToSQLiteDateTime(row.ExecutionTime)
+	return mdb.conn.ExecContext(ctx,
+		templateUpsertWorkflowExecution,
+		row.NamespaceID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Status,
+		row.Memo,
+		row.Encoding,
+		row.TaskQueue,
+		row.StateTransitionCount,
+		row.SearchAttributes,
 	)
 }
 
@@ -136,6 +214,7 @@ func (mdb *db) ReplaceIntoVisibility(
 	switch {
 	case row.CloseTime != nil && row.HistoryLength != nil:
 		row.StartTime = mdb.converter.ToSQLiteDateTime(row.StartTime)
+		row.ExecutionTime = mdb.converter.ToSQLiteDateTime(row.ExecutionTime)
 		closeTime := mdb.converter.ToSQLiteDateTime(*row.CloseTime)
 		return mdb.conn.ExecContext(ctx,
 			templateCreateWorkflowExecutionClosed,
@@ -151,6 +230,10 @@ func (mdb *db) ReplaceIntoVisibility(
 			row.Memo,
 			row.Encoding,
 			row.TaskQueue,
+			row.StateTransitionCount,
+			row.HistorySizeBytes,
+			row.ExecutionDuration,
+			row.SearchAttributes,
 		)
 	default:
 		return nil, errCloseParams
@@ -201,10 +284,18 @@ func (mdb *db) SelectFromVisibility(
 		if filter.Status != 1 {
 			qry = templateGetClosedWorkflowExecutionsByID
 		}
+		workflowID := *filter.WorkflowID
+		if filter.PrefixMatch {
+			qry = templateGetOpenWorkflowExecutionsByIDPrefix
+			if filter.Status != 1 {
+				qry = templateGetClosedWorkflowExecutionsByIDPrefix
+			}
+			workflowID = globPrefixPattern(workflowID)
+		}
 		err = mdb.conn.SelectContext(ctx,
 			&rows,
 			qry,
-			*filter.WorkflowID,
+			workflowID,
 			filter.NamespaceID,
 			*filter.MinTime,
 			*filter.MaxTime,
@@ -219,10 +310,18 @@ func (mdb *db) SelectFromVisibility(
 		if filter.Status != 1 {
 			qry = templateGetClosedWorkflowExecutionsByType
 		}
+		workflowTypeName := *filter.WorkflowTypeName
+		if filter.PrefixMatch {
+			qry = templateGetOpenWorkflowExecutionsByTypePrefix
+			if filter.Status != 1 {
+				qry = templateGetClosedWorkflowExecutionsByTypePrefix
+			}
+			workflowTypeName = globPrefixPattern(workflowTypeName)
+		}
 		err = mdb.conn.SelectContext(ctx,
 			&rows,
 			qry,
-			*filter.WorkflowTypeName,
+			workflowTypeName,
 			filter.NamespaceID,
 			*filter.MinTime,
 			*filter.MaxTime,
@@ -269,6 +368,123 @@ func (mdb *db) SelectFromVisibility(
 	if err != nil {
 		return nil, err
 	}
+	mdb.fromSQLiteVisibilityRows(rows)
+	return rows, nil
+}
+
+// SelectFromVisibilityByQuery reads one page of rows matching the query conditions from visibility table
+func (mdb *db) SelectFromVisibilityByQuery(
+	ctx context.Context,
+	filter sqlplugin.VisibilityQueryFilter,
+) ([]sqlplugin.VisibilityRow, error) {
+	qry := templateSelectByQuery
+	args := []interface{}{filter.NamespaceID}
+	if filter.Condition != "" {
+		qry += ` AND (` + filter.Condition + `)`
+		args = append(args, mdb.toSQLiteQueryArgs(filter.ConditionArgs)...)
+	}
+	if filter.OrderBy != "" {
+		qry += ` ORDER BY ` + filter.OrderBy
+		args = append(args, mdb.toSQLiteQueryArgs(filter.OrderByArgs)...)
+	}
+	qry += ` LIMIT ?`
+	args = append(args, filter.PageSize)
+
+	var rows []sqlplugin.VisibilityRow
+	if err := mdb.conn.SelectContext(ctx, &rows, qry, args...); err != nil {
+		return nil, err
+	}
+	mdb.fromSQLiteVisibilityRows(rows)
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery returns number of rows matching the query conditions in visibility table
+func (mdb *db) CountFromVisibilityByQuery(
+	ctx context.Context,
+	filter sqlplugin.VisibilityQueryFilter,
+) ([]sqlplugin.VisibilityCountRow, error) {
+	qry := templateCountByQuery
+	args := []interface{}{filter.NamespaceID}
+	if filter.Condition != "" {
+		qry += ` AND (` + filter.Condition + `)`
+		args = append(args, mdb.toSQLiteQueryArgs(filter.ConditionArgs)...)
+	}
+	if filter.GroupBy != "" {
+		qry = `SELECT ` + filter.GroupBy + ` AS group_value, COUNT(*) AS count` + qry + ` GROUP BY group_value`
+		args = append(mdb.toSQLiteQueryArgs(filter.GroupByArgs), args...)
+	} else {
+		qry = `SELECT COUNT(*) AS count` + qry
+	}
+
+	var rows []sqlplugin.VisibilityCountRow
+	if err := mdb.conn.SelectContext(ctx, &rows, qry, args...); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// CountFromVisibility returns number of rows matching filter in visibility table
+func (mdb *db) CountFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilityCountFilter,
+) ([]sqlplugin.VisibilityCountRow, error) {
+	minTime := mdb.converter.ToSQLiteDateTime(filter.MinTime)
+	maxTime := mdb.converter.ToSQLiteDateTime(filter.MaxTime)
+	qry := templateCountConditions
+	args := []interface{}{filter.NamespaceID, minTime, maxTime, minTime, maxTime}
+	if filter.WorkflowID != nil {
+		if filter.PrefixMatch {
+			qry += ` AND workflow_id GLOB ?`
+			args = append(args, globPrefixPattern(*filter.WorkflowID))
+		} else {
+			qry += ` AND workflow_id = ?`
+			args = append(args, *filter.WorkflowID)
+		}
+	}
+	if filter.WorkflowTypeName != nil {
+		if filter.PrefixMatch {
+			qry += ` AND workflow_type_name GLOB ?`
+			args = append(args, globPrefixPattern(*filter.WorkflowTypeName))
+		} else {
+			qry += ` AND workflow_type_name = ?`
+			args = append(args, *filter.WorkflowTypeName)
+		}
+	}
+	if filter.Status != 0 {
+		qry += ` AND status = ?`
+		args = append(args, filter.Status)
+	}
+
+	switch filter.GroupBy {
+	case "":
+		qry = `SELECT COUNT(*) AS count` + qry
+	case sqlplugin.VisibilityGroupByStatus, sqlplugin.VisibilityGroupByWorkflowTypeName:
+		qry = `SELECT ` + filter.GroupBy + ` AS group_value, COUNT(*) AS count` + qry + ` GROUP BY ` + filter.GroupBy
+	default:
+		return nil, fmt.Errorf("invalid group by column: %v", filter.GroupBy)
+	}
+
+	var rows []sqlplugin.VisibilityCountRow
+	if err := mdb.conn.SelectContext(ctx, &rows, qry, args...); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// toSQLiteQueryArgs converts time arguments of query conditions the same way as stored times,
+// so they are compared correctly.
+func (mdb *db) toSQLiteQueryArgs(args []interface{}) []interface{} {
+	result := make([]interface{}, len(args))
+	for i, arg := range args {
+		if t, ok := arg.(time.Time); ok {
+			arg = mdb.converter.ToSQLiteDateTime(t)
+		}
+		result[i] = arg
+	}
+	return result
+}
+
+func (mdb *db) fromSQLiteVisibilityRows(rows []sqlplugin.VisibilityRow) {
 	for i := range rows {
 		rows[i].StartTime = mdb.converter.FromSQLiteDateTime(rows[i].StartTime)
 		rows[i].ExecutionTime = mdb.converter.FromSQLiteDateTime(rows[i].ExecutionTime)
@@ -277,7 +493,6 @@ func (mdb *db) SelectFromVisibility(
 			rows[i].CloseTime = &closeTime
 		}
 	}
-	return rows, nil
 }
 
 // GetFromVisibility reads one row from visibility table
@@ -295,5 +510,7 @@ func (mdb *db) GetFromVisibility(
 	if err != nil {
 		return nil, err
 	}
-	return &row, nil
+	rows := []sqlplugin.VisibilityRow{row}
+	mdb.fromSQLiteVisibilityRows(rows)
+	return &rows[0], nil
 }
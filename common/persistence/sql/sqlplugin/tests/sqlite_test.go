@@ -30,6 +30,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"go.temporal.io/server/common/config"
@@ -38,6 +39,7 @@ import (
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/shuffle"
+	sqliteschema "go.temporal.io/server/schema/sqlite"
 )
 
 // TODO merge the initialization with existing persistence setup
@@ -713,6 +715,44 @@ func TestSQLiteFileVisibilitySuite(t *testing.T) {
 	suite.Run(t, s)
 }
 
+func TestSQLiteFileSchemaUpdate(t *testing.T) {
+	cfg := newSQLiteFileConfig()
+	setupSQLiteDatabase(cfg, t)
+	db, err := sql.NewSQLAdminDB(sqlplugin.DbKindUnknown, cfg, resolver.NewNoopResolver())
+	require.NoError(t, err)
+	version, err := db.ReadSchemaVersion("temporal_visibility")
+	require.NoError(t, err)
+	require.Equal(t, sqliteschema.VisibilityVersion, version)
+
+	// Turn the database into one set up by version 0.1 of the visibility schema, which didn't record versions.
+	for _, stmt := range []string{
+		"DROP TABLE schema_version",
+		"DROP TABLE schema_update_history",
+		"ALTER TABLE executions_visibility DROP COLUMN state_transition_count",
+		"ALTER TABLE executions_visibility DROP COLUMN history_size_bytes",
+		"ALTER TABLE executions_visibility DROP COLUMN execution_duration",
+		"ALTER TABLE executions_visibility DROP COLUMN search_attributes",
+	} {
+		require.NoError(t, db.Exec(stmt))
+	}
+	require.NoError(t, db.Close())
+
+	// Connecting with the setup attribute updates the schema.
+	store, err := sql.NewSQLDB(sqlplugin.DbKindVisibility, cfg, resolver.NewNoopResolver())
+	require.NoError(t, err)
+	defer testCleanUp(store, cfg, t)
+
+	db, err = sql.NewSQLAdminDB(sqlplugin.DbKindUnknown, cfg, resolver.NewNoopResolver())
+	require.NoError(t, err)
+	version, err = db.ReadSchemaVersion("temporal_visibility")
+	require.NoError(t, err)
+	require.Equal(t, sqliteschema.VisibilityVersion, version)
+	require.NoError(t, db.Close())
+
+	s := newVisibilitySuite(t, store)
+	suite.Run(t, s)
+}
+
 func testCleanUp(d sqlplugin.DB, c *config.SQL, t *testing.T) {
 	err := d.Close()
 	if err != nil {
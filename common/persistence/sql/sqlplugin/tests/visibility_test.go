@@ -901,6 +901,205 @@ func (s *visibilitySuite) TestSelect_MinStartTime_MaxStartTime_WorkflowTypeName_
 	s.Equal(visibilities, rows)
 }
 
+func (s *visibilitySuite) TestSelect_MinStartTime_MaxStartTime_WorkflowIDPrefix_StatusOpen() {
+	pageSize := 10
+
+	var visibilities []sqlplugin.VisibilityRow
+
+	namespaceID := primitives.NewUUID()
+	workflowTypeName := shuffle.String(testVisibilityWorkflowTypeName)
+	startTime := s.now()
+	minStartTime := startTime
+	executionTime := startTime.Add(time.Second)
+	status := int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	closeTime := (*time.Time)(nil)
+	historyLength := (*int64)(nil)
+	// prefixes are matched case sensitively by all databases
+	for _, workflowID := range []string{"order-1", "order-2", "Order-3", "orders-4", "order", "ord-5"} {
+		visibility := s.newRandomVisibilityRow(
+			namespaceID,
+			primitives.NewUUID(),
+			workflowTypeName,
+			workflowID,
+			startTime,
+			executionTime,
+			status,
+			closeTime,
+			historyLength,
+		)
+		result, err := s.store.InsertIntoVisibility(newVisibilityContext(), &visibility)
+		s.NoError(err)
+		rowsAffected, err := result.RowsAffected()
+		s.NoError(err)
+		s.Equal(1, int(rowsAffected))
+
+		visibilities = append(visibilities, visibility)
+		startTime = startTime.Add(time.Second)
+	}
+	maxStartTime := startTime
+
+	testCases := []struct {
+		prefix   string
+		expected []sqlplugin.VisibilityRow
+	}{
+		{prefix: "order-", expected: []sqlplugin.VisibilityRow{visibilities[0], visibilities[1]}},
+		{prefix: "order", expected: []sqlplugin.VisibilityRow{visibilities[0], visibilities[1], visibilities[3], visibilities[4]}},
+		{prefix: "Order", expected: []sqlplugin.VisibilityRow{visibilities[2]}},
+		{prefix: "ORDER", expected: nil},
+		{prefix: "ord", expected: []sqlplugin.VisibilityRow{visibilities[0], visibilities[1], visibilities[3], visibilities[4], visibilities[5]}},
+		{prefix: "order_", expected: nil},
+		{prefix: "order%", expected: nil},
+		{prefix: "order*", expected: nil},
+	}
+	for _, testCase := range testCases {
+		selectFilter := sqlplugin.VisibilitySelectFilter{
+			NamespaceID:      namespaceID.String(),
+			WorkflowID:       convert.StringPtr(testCase.prefix),
+			PrefixMatch:      true,
+			RunID:            convert.StringPtr(""),
+			WorkflowTypeName: nil,
+			MinTime:          timestamp.TimePtr(minStartTime),
+			MaxTime:          timestamp.TimePtr(maxStartTime),
+			Status:           int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
+			PageSize:         convert.IntPtr(pageSize),
+		}
+		rows, err := s.store.SelectFromVisibility(newVisibilityContext(), selectFilter)
+		s.NoError(err)
+		for index := range rows {
+			rows[index].NamespaceID = namespaceID.String()
+		}
+		s.sortByStartTimeDescRunIDAsc(testCase.expected)
+		s.Equal(len(testCase.expected), len(rows), testCase.prefix)
+		if len(testCase.expected) > 0 {
+			s.Equal(testCase.expected, rows, testCase.prefix)
+		}
+	}
+}
+
+func (s *visibilitySuite) TestSelect_MinStartTime_MaxStartTime_WorkflowTypeNamePrefix_StatusClose() {
+	pageSize := 10
+
+	var visibilities []sqlplugin.VisibilityRow
+
+	namespaceID := primitives.NewUUID()
+	startTime := s.now()
+	executionTime := startTime.Add(time.Second)
+	status := int32(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	closeTime := executionTime.Add(time.Second)
+	historyLength := rand.Int63()
+	minStartTime := closeTime
+	for _, workflowTypeName := range []string{"payment-workflow", "payment-activity", "Payment-workflow", "pay-workflow"} {
+		workflowID := shuffle.String(testVisibilityWorkflowID)
+		visibility := s.newRandomVisibilityRow(
+			namespaceID,
+			primitives.NewUUID(),
+			workflowTypeName,
+			workflowID,
+			startTime,
+			executionTime,
+			status,
+			timestamp.TimePtr(closeTime),
+			convert.Int64Ptr(historyLength),
+		)
+		result, err := s.store.ReplaceIntoVisibility(newVisibilityContext(), &visibility)
+		s.NoError(err)
+		rowsAffected, err := result.RowsAffected()
+		s.NoError(err)
+		s.Equal(1, int(rowsAffected))
+
+		visibilities = append(visibilities, visibility)
+		closeTime = closeTime.Add(time.Second)
+	}
+	maxStartTime := closeTime
+
+	selectFilter := sqlplugin.VisibilitySelectFilter{
+		NamespaceID:      namespaceID.String(),
+		WorkflowID:       nil,
+		RunID:            convert.StringPtr(""),
+		WorkflowTypeName: convert.StringPtr("payment-"),
+		PrefixMatch:      true,
+		MinTime:          timestamp.TimePtr(minStartTime),
+		MaxTime:          timestamp.TimePtr(maxStartTime),
+		Status:           int32(enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED),
+		PageSize:         convert.IntPtr(pageSize),
+	}
+	rows, err := s.store.SelectFromVisibility(newVisibilityContext(), selectFilter)
+	s.NoError(err)
+	for index := range rows {
+		rows[index].NamespaceID = namespaceID.String()
+	}
+	expected := visibilities[:2]
+	s.sortByCloseTimeDescRunIDAsc(expected)
+	s.Equal(expected, rows)
+}
+
+func (s *visibilitySuite) TestCount_GroupByStatus() {
+	namespaceID := primitives.NewUUID()
+	startTime := s.now()
+	executionTime := startTime.Add(time.Second)
+	closeTime := executionTime.Add(time.Second)
+	for i, status := range []enumspb.WorkflowExecutionStatus{
+		enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+		enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+	} {
+		workflowTypeName := "payment-workflow"
+		if i == 4 {
+			// not counted, prefixes are matched case sensitively
+			workflowTypeName = "Payment-workflow"
+		}
+		visibility := s.newRandomVisibilityRow(
+			namespaceID,
+			primitives.NewUUID(),
+			workflowTypeName,
+			shuffle.String(testVisibilityWorkflowID),
+			startTime,
+			executionTime,
+			int32(status),
+			nil,
+			nil,
+		)
+		if status == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			_, err := s.store.InsertIntoVisibility(newVisibilityContext(), &visibility)
+			s.NoError(err)
+			continue
+		}
+		visibility.CloseTime = timestamp.TimePtr(closeTime)
+		visibility.HistoryLength = convert.Int64Ptr(rand.Int63())
+		_, err := s.store.ReplaceIntoVisibility(newVisibilityContext(), &visibility)
+		s.NoError(err)
+	}
+
+	countFilter := sqlplugin.VisibilityCountFilter{
+		NamespaceID:      namespaceID.String(),
+		WorkflowTypeName: convert.StringPtr("payment-"),
+		PrefixMatch:      true,
+		MinTime:          startTime,
+		MaxTime:          closeTime,
+	}
+	rows, err := s.store.CountFromVisibility(newVisibilityContext(), countFilter)
+	s.NoError(err)
+	s.Equal([]sqlplugin.VisibilityCountRow{{Count: 4}}, rows)
+
+	countFilter.GroupBy = sqlplugin.VisibilityGroupByStatus
+	rows, err = s.store.CountFromVisibility(newVisibilityContext(), countFilter)
+	s.NoError(err)
+	counts := make(map[string]int64)
+	for _, row := range rows {
+		s.NotNil(row.GroupValue)
+		counts[*row.GroupValue] = row.Count
+	}
+	s.Equal(map[string]int64{"1": 1, "2": 2, "3": 1}, counts)
+
+	countFilter.Status = int32(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	countFilter.GroupBy = sqlplugin.VisibilityGroupByWorkflowTypeName
+	rows, err = s.store.CountFromVisibility(newVisibilityContext(), countFilter)
+	s.NoError(err)
+	s.Equal([]sqlplugin.VisibilityCountRow{{GroupValue: convert.StringPtr("payment-workflow"), Count: 2}}, rows)
+}
+
 func (s *visibilitySuite) TestSelect_MinStartTime_MaxStartTime_StatusOpen_Single() {
 	pageSize := 1
 
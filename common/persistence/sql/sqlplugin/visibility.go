@@ -25,8 +25,13 @@
 package sqlplugin
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -45,8 +50,18 @@ type (
 		Memo             []byte
 		Encoding         string
 		TaskQueue        string
+
+		// Following fields are only used by plugins which implement AdvancedVisibility.
+		StateTransitionCount *int64
+		HistorySizeBytes     *int64
+		ExecutionDuration    *int64
+		SearchAttributes     VisibilitySearchAttributes
 	}
 
+	// VisibilitySearchAttributes are custom and predefined search attributes of a visibility row.
+	// They are stored as JSON object. Numbers are decoded as json.Number to not lose precision.
+	VisibilitySearchAttributes map[string]interface{}
+
 	// VisibilitySelectFilter contains the column names within executions_visibility table that
 	// can be used to filter results through a WHERE clause
 	VisibilitySelectFilter struct {
@@ -54,10 +69,50 @@ type (
 		RunID            *string
 		WorkflowID       *string
 		WorkflowTypeName *string
-		Status           int32
-		MinTime          *time.Time
-		MaxTime          *time.Time
-		PageSize         *int
+		// PrefixMatch matches WorkflowID or WorkflowTypeName as a prefix instead of the full value.
+		PrefixMatch bool
+		Status      int32
+		MinTime     *time.Time
+		MaxTime     *time.Time
+		PageSize    *int
+	}
+
+	// VisibilityCountFilter contains the conditions of rows counted by CountFromVisibility.
+	// Running workflows are filtered by start time and closed workflows by close time.
+	VisibilityCountFilter struct {
+		NamespaceID      string
+		WorkflowID       *string
+		WorkflowTypeName *string
+		// PrefixMatch matches WorkflowID or WorkflowTypeName as a prefix instead of the full value.
+		PrefixMatch bool
+		Status      int32
+		MinTime     time.Time
+		MaxTime     time.Time
+		// GroupBy is empty or one of VisibilityGroupByStatus and VisibilityGroupByWorkflowTypeName.
+		GroupBy string
+	}
+
+	// VisibilityQueryFilter selects rows of one namespace using conditions built from a list query
+	VisibilityQueryFilter struct {
+		NamespaceID string
+		// Condition is a boolean SQL expression on executions_visibility columns with "?" placeholders
+		// for ConditionArgs. Empty condition matches all rows of the namespace.
+		Condition     string
+		ConditionArgs []interface{}
+		// OrderBy is a list of SQL ORDER BY terms with "?" placeholders for OrderByArgs.
+		OrderBy     string
+		OrderByArgs []interface{}
+		// GroupBy is an SQL expression with "?" placeholders for GroupByArgs. It is used by count only.
+		GroupBy     string
+		GroupByArgs []interface{}
+		PageSize    int
+	}
+
+	// VisibilityCountRow is number of rows in one group. GroupValue is nil if rows aren't
+	// grouped or if the group by value is NULL. Numbers are returned as strings.
+	VisibilityCountRow struct {
+		GroupValue *string
+		Count      int64
 	}
 
 	VisibilityGetFilter struct {
@@ -87,5 +142,73 @@ type (
 		SelectFromVisibility(ctx context.Context, filter VisibilitySelectFilter) ([]VisibilityRow, error)
 		GetFromVisibility(ctx context.Context, filter VisibilityGetFilter) (*VisibilityRow, error)
 		DeleteFromVisibility(ctx context.Context, filter VisibilityDeleteFilter) (sql.Result, error)
+		// CountFromVisibility returns number of rows matching filter, one row per group if filter
+		// has GroupBy column
+		CountFromVisibility(ctx context.Context, filter VisibilityCountFilter) ([]VisibilityCountRow, error)
 	}
+
+	// AdvancedVisibility is implemented by plugins which support custom search attributes and list
+	// queries. Conditions and ORDER BY terms are written in the SQL dialect of the plugin.
+	AdvancedVisibility interface {
+		// UpsertIntoVisibility inserts a row into visibility table or updates the existing one
+		// if the workflow is still running. Rows of closed workflows are not changed.
+		UpsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
+		// SelectFromVisibilityByQuery returns one page of rows matching filter
+		SelectFromVisibilityByQuery(ctx context.Context, filter VisibilityQueryFilter) ([]VisibilityRow, error)
+		// CountFromVisibilityByQuery returns number of rows matching filter, one row per group if
+		// filter has GroupBy expression. OrderBy and PageSize are ignored.
+		CountFromVisibilityByQuery(ctx context.Context, filter VisibilityQueryFilter) ([]VisibilityCountRow, error)
+	}
+)
+
+// Columns of executions_visibility table which can be used as VisibilityCountFilter.GroupBy.
+const (
+	VisibilityGroupByStatus           = "status"
+	VisibilityGroupByWorkflowTypeName = "workflow_type_name"
 )
+
+// Value implements driver.Valuer.
+func (sa VisibilitySearchAttributes) Value() (driver.Value, error) {
+	if sa == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(sa)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner.
+func (sa *VisibilitySearchAttributes) Scan(src interface{}) error {
+	var data []byte
+	switch v := src.(type) {
+	case nil:
+		*sa = nil
+		return nil
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fmt.Errorf("unable to scan search attributes of type %T", src)
+	}
+
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	return d.Decode(sa)
+}
+
+// LikePrefixPattern returns a LIKE pattern matching all values starting with prefix.
+// Wildcards in prefix are escaped with '!', so the pattern must be used with ESCAPE '!'.
+func LikePrefixPattern(prefix string) string {
+	var pattern strings.Builder
+	for _, r := range prefix {
+		if r == '%' || r == '_' || r == '!' {
+			pattern.WriteByte('!')
+		}
+		pattern.WriteRune(r)
+	}
+	pattern.WriteByte('%')
+	return pattern.String()
+}
@@ -33,6 +33,7 @@ import (
 	"go.temporal.io/server/common/persistence/visibility/store"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	sqladvanced "go.temporal.io/server/common/persistence/visibility/store/sql"
 	"go.temporal.io/server/common/persistence/visibility/store/standard"
 	"go.temporal.io/server/common/persistence/visibility/store/standard/cassandra"
 	"go.temporal.io/server/common/persistence/visibility/store/standard/sql"
@@ -76,10 +77,10 @@ func NewManager(
 		return nil, err
 	}
 
-	advVisibilityManager, err := NewAdvancedManager(
+	advVisibilityManager, err := NewSQLAdvancedManager(
+		persistenceCfg,
+		persistenceResolver,
 		defaultIndexName,
-		esClient,
-		esProcessorConfig,
 		searchAttributesProvider,
 		searchAttributesMapper,
 		advancedVisibilityPersistenceMaxReadQPS,
@@ -92,6 +93,24 @@ func NewManager(
 		return nil, err
 	}
 
+	if advVisibilityManager == nil {
+		advVisibilityManager, err = NewAdvancedManager(
+			defaultIndexName,
+			esClient,
+			esProcessorConfig,
+			searchAttributesProvider,
+			searchAttributesMapper,
+			advancedVisibilityPersistenceMaxReadQPS,
+			advancedVisibilityPersistenceMaxWriteQPS,
+			visibilityDisableOrderByClause,
+			metricsHandler,
+			logger,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	secondaryVisibilityManager, err := NewAdvancedManager(
 		secondaryVisibilityIndexName,
 		esClient,
@@ -220,6 +239,52 @@ func NewAdvancedManager(
 	), nil
 }
 
+// NewSQLAdvancedManager returns advanced visibility manager on SQL database if advanced visibility
+// datastore is configured with SQL, otherwise it returns nil.
+func NewSQLAdvancedManager(
+	persistenceCfg config.Persistence,
+	persistenceResolver resolver.ServiceResolver,
+	defaultIndexName string,
+	searchAttributesProvider searchattribute.Provider,
+	searchAttributesMapper searchattribute.Mapper,
+
+	advancedVisibilityPersistenceMaxReadQPS dynamicconfig.IntPropertyFn,
+	advancedVisibilityPersistenceMaxWriteQPS dynamicconfig.IntPropertyFn,
+	visibilityDisableOrderByClause dynamicconfig.BoolPropertyFn,
+
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) (manager.VisibilityManager, error) {
+	if !persistenceCfg.AdvancedVisibilityConfigExist() {
+		return nil, nil
+	}
+	advancedVisibilityStoreCfg := persistenceCfg.DataStores[persistenceCfg.AdvancedVisibilityStore]
+	if advancedVisibilityStoreCfg.SQL == nil {
+		return nil, nil
+	}
+
+	advVisibilityStore, err := sqladvanced.NewVisibilityStore(
+		*advancedVisibilityStoreCfg.SQL,
+		persistenceResolver,
+		defaultIndexName,
+		searchAttributesProvider,
+		searchAttributesMapper,
+		visibilityDisableOrderByClause,
+		logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return newVisibilityManager(
+		advVisibilityStore,
+		advancedVisibilityPersistenceMaxReadQPS,
+		advancedVisibilityPersistenceMaxWriteQPS,
+		metricsHandler,
+		metrics.AdvancedVisibilityTypeTag(),
+		logger,
+	), nil
+}
+
 func newVisibilityManager(
 	store store.VisibilityStore,
 	maxReadQPS dynamicconfig.IntPropertyFn,
@@ -142,6 +142,15 @@ type (
 	// CountWorkflowExecutionsResponse is response to CountWorkflowExecutions
 	CountWorkflowExecutionsResponse struct {
 		Count int64
+		// Groups are set if query has GROUP BY clause. Executions which don't have
+		// a value of GROUP BY field are counted in Count but don't belong to any group.
+		Groups []CountWorkflowExecutionsGroup
+	}
+
+	// CountWorkflowExecutionsGroup is number of executions with the same values of GROUP BY fields
+	CountWorkflowExecutionsGroup struct {
+		GroupValues []*commonpb.Payload
+		Count       int64
 	}
 
 	// ListWorkflowExecutionsByTypeRequest is used to list executions of
@@ -149,6 +158,8 @@ type (
 	ListWorkflowExecutionsByTypeRequest struct {
 		*ListWorkflowExecutionsRequest
 		WorkflowTypeName string
+		// PrefixMatch lists executions whose type starts with WorkflowTypeName.
+		PrefixMatch bool
 	}
 
 	// ListWorkflowExecutionsByWorkflowIDRequest is used to list executions that
@@ -156,6 +167,8 @@ type (
 	ListWorkflowExecutionsByWorkflowIDRequest struct {
 		*ListWorkflowExecutionsRequest
 		WorkflowID string
+		// PrefixMatch lists executions whose WorkflowID starts with WorkflowID.
+		PrefixMatch bool
 	}
 
 	// ListClosedWorkflowExecutionsByStatusRequest is used to list executions that
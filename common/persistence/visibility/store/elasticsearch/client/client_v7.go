@@ -218,6 +218,22 @@ func (c *clientImpl) Count(ctx context.Context, index string, query elastic.Quer
 	return c.esClient.Count(index).Query(query).Do(ctx)
 }
 
+// CountGroupBy returns search result without hits but with total number of hits and agg aggregation results.
+func (c *clientImpl) CountGroupBy(
+	ctx context.Context,
+	index string,
+	query elastic.Query,
+	aggName string,
+	agg elastic.Aggregation,
+) (*elastic.SearchResult, error) {
+	searchSource := elastic.NewSearchSource().
+		Query(query).
+		Size(0).
+		TrackTotalHits(true).
+		Aggregation(aggName, agg)
+	return c.esClient.Search(index).SearchSource(searchSource).Do(ctx)
+}
+
 func (c *clientImpl) RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error) {
 	esBulkProcessor, err := c.esClient.BulkProcessor().
 		Name(p.Name).
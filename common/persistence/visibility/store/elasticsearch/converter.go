@@ -43,6 +43,7 @@ var allowedComparisonOperators = map[string]struct{}{
 	sqlparser.NotLikeStr:      {},
 	sqlparser.InStr:           {},
 	sqlparser.NotInStr:        {},
+	query.StartsWithStr:       {},
 }
 
 func newQueryConverter(
@@ -37,18 +37,20 @@ import (
 )
 
 var errorCases = map[string]string{
-	"delete":                          query.MalformedSqlQueryErrMessage,
-	"update x":                        query.MalformedSqlQueryErrMessage,
-	"insert ":                         query.MalformedSqlQueryErrMessage,
-	"insert into a values(1,2)":       query.NotSupportedErrMessage,
-	"update a set id = 1":             query.NotSupportedErrMessage,
-	"delete from a where id=1":        query.NotSupportedErrMessage,
-	"select * from a where NOT(id=1)": query.NotSupportedErrMessage,
-	"select * from a where 1 = 1":     query.InvalidExpressionErrMessage,
-	"select * from a where 1=a":       query.InvalidExpressionErrMessage,
-	"select * from a where zz(k=2)":   query.NotSupportedErrMessage,
-	"select * from a group by k":      query.NotSupportedErrMessage,
-	"invalid query":                   query.MalformedSqlQueryErrMessage,
+	"delete":                                query.MalformedSqlQueryErrMessage,
+	"update x":                              query.MalformedSqlQueryErrMessage,
+	"insert ":                               query.MalformedSqlQueryErrMessage,
+	"insert into a values(1,2)":             query.NotSupportedErrMessage,
+	"update a set id = 1":                   query.NotSupportedErrMessage,
+	"delete from a where id=1":              query.NotSupportedErrMessage,
+	"select * from a where NOT(id=1)":       query.NotSupportedErrMessage,
+	"select * from a where 1 = 1":           query.InvalidExpressionErrMessage,
+	"select * from a where 1=a":             query.InvalidExpressionErrMessage,
+	"select * from a where zz(k=2)":         query.NotSupportedErrMessage,
+	"select * from a group by k":            query.NotSupportedErrMessage,
+	"invalid query":                         query.MalformedSqlQueryErrMessage,
+	"select * from a where a <=> 1":         "not allowed",
+	"select * from a where a starts_with 1": query.InvalidExpressionErrMessage,
 	"select * from a where  a= 1 and multi_match(zz=1, query='this is a test', fields=(title,title.origin), type=phrase)": query.NotSupportedErrMessage,
 }
 
@@ -63,29 +65,35 @@ var supportedWhereCases = map[string]string{
 	"process_id <= 1":               `{"bool":{"filter":{"range":{"process_id":{"from":null,"include_lower":true,"include_upper":true,"to":1}}}}}`,
 	"process_id >= 1":               `{"bool":{"filter":{"range":{"process_id":{"from":1,"include_lower":true,"include_upper":true,"to":null}}}}}`,
 	"process_id != 1":               `{"bool":{"must_not":{"match":{"process_id":{"query":1}}}}}`,
-	"process_id = 0 and status= 1 and channel = 4": `{"bool":{"filter":[{"match":{"process_id":{"query":0}}},{"match":{"status":{"query":1}}},{"match":{"channel":{"query":4}}}]}}`,
-	"process_id > 1 and status = 1":                `{"bool":{"filter":[{"range":{"process_id":{"from":1,"include_lower":false,"include_upper":true,"to":null}}},{"match":{"status":{"query":1}}}]}}`,
-	"id > 1 or process_id = 0":                     `{"bool":{"should":[{"range":{"id":{"from":1,"include_lower":false,"include_upper":true,"to":null}}},{"match":{"process_id":{"query":0}}}]}}`,
-	"id > 1 and d = 1 or process_id = 0 and x = 2": `{"bool":{"should":[{"bool":{"filter":[{"range":{"id":{"from":1,"include_lower":false,"include_upper":true,"to":null}}},{"match":{"d":{"query":1}}}]}},{"bool":{"filter":[{"match":{"process_id":{"query":0}}},{"match":{"x":{"query":2}}}]}}]}}`,
-	"(id > 1 and d = 1)":                           `{"bool":{"filter":[{"range":{"id":{"from":1,"include_lower":false,"include_upper":true,"to":null}}},{"match":{"d":{"query":1}}}]}}`,
-	"(id > 1 and d = 1) or (c=1)":                  `{"bool":{"should":[{"bool":{"filter":[{"range":{"id":{"from":1,"include_lower":false,"include_upper":true,"to":null}}},{"match":{"d":{"query":1}}}]}},{"match":{"c":{"query":1}}}]}}`,
-	"nid=1 and (cif = 1 or cif = 2)":               `{"bool":{"filter":[{"match":{"nid":{"query":1}}},{"bool":{"should":[{"match":{"cif":{"query":1}}},{"match":{"cif":{"query":2}}}]}}]}}`,
-	"id > 1 or (process_id = 0)":                   `{"bool":{"should":[{"range":{"id":{"from":1,"include_lower":false,"include_upper":true,"to":null}}},{"match":{"process_id":{"query":0}}}]}}`,
-	"id in (1,2,3,4)":                              `{"bool":{"filter":{"terms":{"id":[1,2,3,4]}}}}`,
-	"a = 'text'":                                   `{"bool":{"filter":{"match":{"a":{"query":"text"}}}}}`,
-	"a LiKE '%a%'":                                 `{"bool":{"filter":{"match":{"a":{"query":"a"}}}}}`,
-	"`by` = 1":                                     `{"bool":{"filter":{"match":{"by":{"query":1}}}}}`,
-	"id not like '%aaa%'":                          `{"bool":{"must_not":{"match":{"id":{"query":"aaa"}}}}}`,
-	"id not IN (1, 2,3)":                           `{"bool":{"must_not":{"terms":{"id":[1,2,3]}}}}`,
-	"id iS not null":                               `{"bool":{"filter":{"exists":{"field":"id"}}}}`,
-	"id is NULL":                                   `{"bool":{"must_not":{"exists":{"field":"id"}}}}`,
-	"value = '1'":                                  `{"bool":{"filter":{"match":{"value":{"query":"1"}}}}}`,
-	"value = 'true'":                               `{"bool":{"filter":{"match":{"value":{"query":"true"}}}}}`,
-	"value = 'True'":                               `{"bool":{"filter":{"match":{"value":{"query":"True"}}}}}`,
-	"value = true":                                 `{"bool":{"filter":{"match":{"value":{"query":true}}}}}`,
-	"value = True":                                 `{"bool":{"filter":{"match":{"value":{"query":true}}}}}`,
-	"value = 1528358645123456789":                  `{"bool":{"filter":{"match":{"value":{"query":1528358645123456789}}}}}`,
-	"value = 1528358645.1234567":                   `{"bool":{"filter":{"match":{"value":{"query":1528358645.1234567}}}}}`,
+	"process_id = 0 and status= 1 and channel = 4":   `{"bool":{"filter":[{"match":{"process_id":{"query":0}}},{"match":{"status":{"query":1}}},{"match":{"channel":{"query":4}}}]}}`,
+	"process_id > 1 and status = 1":                  `{"bool":{"filter":[{"range":{"process_id":{"from":1,"include_lower":false,"include_upper":true,"to":null}}},{"match":{"status":{"query":1}}}]}}`,
+	"id > 1 or process_id = 0":                       `{"bool":{"should":[{"range":{"id":{"from":1,"include_lower":false,"include_upper":true,"to":null}}},{"match":{"process_id":{"query":0}}}]}}`,
+	"id > 1 and d = 1 or process_id = 0 and x = 2":   `{"bool":{"should":[{"bool":{"filter":[{"range":{"id":{"from":1,"include_lower":false,"include_upper":true,"to":null}}},{"match":{"d":{"query":1}}}]}},{"bool":{"filter":[{"match":{"process_id":{"query":0}}},{"match":{"x":{"query":2}}}]}}]}}`,
+	"(id > 1 and d = 1)":                             `{"bool":{"filter":[{"range":{"id":{"from":1,"include_lower":false,"include_upper":true,"to":null}}},{"match":{"d":{"query":1}}}]}}`,
+	"(id > 1 and d = 1) or (c=1)":                    `{"bool":{"should":[{"bool":{"filter":[{"range":{"id":{"from":1,"include_lower":false,"include_upper":true,"to":null}}},{"match":{"d":{"query":1}}}]}},{"match":{"c":{"query":1}}}]}}`,
+	"nid=1 and (cif = 1 or cif = 2)":                 `{"bool":{"filter":[{"match":{"nid":{"query":1}}},{"bool":{"should":[{"match":{"cif":{"query":1}}},{"match":{"cif":{"query":2}}}]}}]}}`,
+	"id > 1 or (process_id = 0)":                     `{"bool":{"should":[{"range":{"id":{"from":1,"include_lower":false,"include_upper":true,"to":null}}},{"match":{"process_id":{"query":0}}}]}}`,
+	"id in (1,2,3,4)":                                `{"bool":{"filter":{"terms":{"id":[1,2,3,4]}}}}`,
+	"a = 'text'":                                     `{"bool":{"filter":{"match":{"a":{"query":"text"}}}}}`,
+	"a LiKE '%a%'":                                   `{"bool":{"filter":{"match":{"a":{"query":"a"}}}}}`,
+	"`by` = 1":                                       `{"bool":{"filter":{"match":{"by":{"query":1}}}}}`,
+	"id not like '%aaa%'":                            `{"bool":{"must_not":{"match":{"id":{"query":"aaa"}}}}}`,
+	"id starts_with 'order-'":                        `{"bool":{"filter":{"prefix":{"id":"order-"}}}}`,
+	"id starts_with 'Order-'":                        `{"bool":{"filter":{"prefix":{"id":"Order-"}}}}`,
+	"id STARTS_WITH 'a%_' and value = 'starts_with'": `{"bool":{"filter":[{"prefix":{"id":"a%_"}},{"match":{"value":{"query":"starts_with"}}}]}}`,
+	"`starts_with` = 1":                              `{"bool":{"filter":{"match":{"starts_with":{"query":1}}}}}`,
+	"starts_with = 1 and id starts_with \"x\"":       `{"bool":{"filter":[{"match":{"starts_with":{"query":1}}},{"prefix":{"id":"x"}}]}}`,
+	"value = 'id starts_with x'":                     `{"bool":{"filter":{"match":{"value":{"query":"id starts_with x"}}}}}`,
+	"id not IN (1, 2,3)":                             `{"bool":{"must_not":{"terms":{"id":[1,2,3]}}}}`,
+	"id iS not null":                                 `{"bool":{"filter":{"exists":{"field":"id"}}}}`,
+	"id is NULL":                                     `{"bool":{"must_not":{"exists":{"field":"id"}}}}`,
+	"value = '1'":                                    `{"bool":{"filter":{"match":{"value":{"query":"1"}}}}}`,
+	"value = 'true'":                                 `{"bool":{"filter":{"match":{"value":{"query":"true"}}}}}`,
+	"value = 'True'":                                 `{"bool":{"filter":{"match":{"value":{"query":"True"}}}}}`,
+	"value = true":                                   `{"bool":{"filter":{"match":{"value":{"query":true}}}}}`,
+	"value = True":                                   `{"bool":{"filter":{"match":{"value":{"query":true}}}}}`,
+	"value = 1528358645123456789":                    `{"bool":{"filter":{"match":{"value":{"query":1528358645123456789}}}}}`,
+	"value = 1528358645.1234567":                     `{"bool":{"filter":{"match":{"value":{"query":1528358645.1234567}}}}}`,
 	// Long float is truncated.
 	"value = 1528358645.123456790":                                            `{"bool":{"filter":{"match":{"value":{"query":1528358645.1234567}}}}}`,
 	"id in (\"text1\",'text2') and content = 'aaaa'":                          `{"bool":{"filter":[{"terms":{"id":["text1","text2"]}},{"match":{"content":{"query":"aaaa"}}}]}}`,
@@ -164,6 +172,33 @@ func TestSupportedSelectWhereOrder(t *testing.T) {
 	}
 }
 
+func TestSupportedSelectWhereGroupBy(t *testing.T) {
+	c := newQueryConverter(nil, nil)
+
+	boolQuery, groupBy, err := c.ConvertWhereGroupBy("id = 1 group by status")
+	assert.NoError(t, err)
+	actualQueryMap, _ := boolQuery.Source()
+	actualQueryJson, _ := json.Marshal(actualQueryMap)
+	assert.Equal(t, `{"bool":{"filter":{"match":{"id":{"query":1}}}}}`, string(actualQueryJson))
+	assert.Equal(t, []string{"status"}, groupBy)
+
+	boolQuery, groupBy, err = c.ConvertWhereGroupBy("GROUP BY status")
+	assert.NoError(t, err)
+	assert.Nil(t, boolQuery)
+	assert.Equal(t, []string{"status"}, groupBy)
+
+	boolQuery, groupBy, err = c.ConvertWhereGroupBy("id = 1")
+	assert.NoError(t, err)
+	assert.NotNil(t, boolQuery)
+	assert.Nil(t, groupBy)
+
+	_, _, err = c.ConvertWhereGroupBy("id = 1 group by status, type")
+	assert.Contains(t, err.Error(), query.NotSupportedErrMessage)
+
+	_, _, err = c.ConvertWhereGroupBy("id = 1 group by status limit 10")
+	assert.Contains(t, err.Error(), query.NotSupportedErrMessage)
+}
+
 func TestErrors(t *testing.T) {
 	c := newQueryConverter(nil, nil)
 	for sql, expectedErrMessage := range errorCases {
@@ -87,7 +87,19 @@ func (ni *nameInterceptor) Name(name string, usage query.FieldNameUsage) (string
 		}
 	}
 
-	if fieldName == searchattribute.TemporalNamespaceDivision && usage == query.FieldNameFilter {
+	if usage == query.FieldNamePrefixFilter {
+		if fieldType != enumspb.INDEXED_VALUE_TYPE_KEYWORD && fieldType != enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST {
+			return "", query.NewConverterError("unable to filter by prefix on field of %s type, use field of type %s or %s", fieldType.String(), enumspb.INDEXED_VALUE_TYPE_KEYWORD.String(), enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST.String())
+		}
+	}
+
+	if usage == query.FieldNameGroupBy {
+		if fieldType != enumspb.INDEXED_VALUE_TYPE_KEYWORD {
+			return "", query.NewConverterError("unable to group by field of %s type, use field of type %s", fieldType.String(), enumspb.INDEXED_VALUE_TYPE_KEYWORD.String())
+		}
+	}
+
+	if fieldName == searchattribute.TemporalNamespaceDivision && (usage == query.FieldNameFilter || usage == query.FieldNamePrefixFilter) {
 		ni.seenNamespaceDivision = true
 	}
 
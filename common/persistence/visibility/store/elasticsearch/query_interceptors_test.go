@@ -31,6 +31,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
 	"go.temporal.io/server/common/searchattribute"
 )
 
@@ -55,6 +57,43 @@ func (s *QueryInterceptorSuite) TearDownTest() {
 	s.controller.Finish()
 }
 
+func (s *QueryInterceptorSuite) TestNameInterceptor_PrefixFilter() {
+	ni := newNameInterceptor(namespace.Name("test-namespace"), "test-index", searchattribute.TestNameTypeMap, nil)
+
+	fieldName, err := ni.Name(searchattribute.WorkflowID, query.FieldNamePrefixFilter)
+	s.NoError(err)
+	s.Equal(searchattribute.WorkflowID, fieldName)
+
+	fieldName, err = ni.Name("CustomKeywordField", query.FieldNamePrefixFilter)
+	s.NoError(err)
+	s.Equal("CustomKeywordField", fieldName)
+
+	_, err = ni.Name("CustomTextField", query.FieldNamePrefixFilter)
+	s.Error(err)
+
+	_, err = ni.Name("CustomIntField", query.FieldNamePrefixFilter)
+	s.Error(err)
+}
+
+func (s *QueryInterceptorSuite) TestNameInterceptor_GroupBy() {
+	ni := newNameInterceptor(namespace.Name("test-namespace"), "test-index", searchattribute.TestNameTypeMap, nil)
+
+	fieldName, err := ni.Name(searchattribute.ExecutionStatus, query.FieldNameGroupBy)
+	s.NoError(err)
+	s.Equal(searchattribute.ExecutionStatus, fieldName)
+
+	fieldName, err = ni.Name("CustomKeywordField", query.FieldNameGroupBy)
+	s.NoError(err)
+	s.Equal("CustomKeywordField", fieldName)
+
+	_, err = ni.Name("CustomIntField", query.FieldNameGroupBy)
+	s.Error(err)
+
+	_, err = ni.Name(searchattribute.TemporalNamespaceDivision, query.FieldNameGroupBy)
+	s.NoError(err)
+	s.False(ni.seenNamespaceDivision)
+}
+
 func (s *QueryInterceptorSuite) TestTimeProcessFunc() {
 	vi := NewValuesInterceptor()
 
@@ -37,6 +37,7 @@ import (
 	"time"
 
 	"github.com/olivere/elastic/v7"
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 
@@ -57,6 +58,10 @@ const (
 	delimiter                    = "~"
 	pointInTimeKeepAliveInterval = "1m"
 	scrollKeepAliveInterval      = "1m"
+
+	// Maximum number of groups returned by count query with GROUP BY clause.
+	// If there are more groups, the largest ones are returned.
+	countGroupByMaxGroups = 1000
 )
 
 // Default sort by uses the sorting order defined in the index template, so no
@@ -335,7 +340,7 @@ func (s *visibilityStore) ListOpenWorkflowExecutionsByType(
 
 	boolQuery := elastic.NewBoolQuery().
 		Filter(
-			elastic.NewTermQuery(searchattribute.WorkflowType, request.WorkflowTypeName),
+			keywordQuery(searchattribute.WorkflowType, request.WorkflowTypeName, request.PrefixMatch),
 			elastic.NewTermQuery(searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String()))
 
 	p, err := s.buildSearchParameters(request.ListWorkflowExecutionsRequest, boolQuery, true)
@@ -361,7 +366,7 @@ func (s *visibilityStore) ListClosedWorkflowExecutionsByType(
 ) (*store.InternalListWorkflowExecutionsResponse, error) {
 
 	boolQuery := elastic.NewBoolQuery().
-		Filter(elastic.NewTermQuery(searchattribute.WorkflowType, request.WorkflowTypeName)).
+		Filter(keywordQuery(searchattribute.WorkflowType, request.WorkflowTypeName, request.PrefixMatch)).
 		MustNot(elastic.NewTermQuery(searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String()))
 
 	p, err := s.buildSearchParameters(request.ListWorkflowExecutionsRequest, boolQuery, false)
@@ -388,7 +393,7 @@ func (s *visibilityStore) ListOpenWorkflowExecutionsByWorkflowID(
 
 	boolQuery := elastic.NewBoolQuery().
 		Filter(
-			elastic.NewTermQuery(searchattribute.WorkflowID, request.WorkflowID),
+			keywordQuery(searchattribute.WorkflowID, request.WorkflowID, request.PrefixMatch),
 			elastic.NewTermQuery(searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String()))
 
 	p, err := s.buildSearchParameters(request.ListWorkflowExecutionsRequest, boolQuery, true)
@@ -414,7 +419,7 @@ func (s *visibilityStore) ListClosedWorkflowExecutionsByWorkflowID(
 ) (*store.InternalListWorkflowExecutionsResponse, error) {
 
 	boolQuery := elastic.NewBoolQuery().
-		Filter(elastic.NewTermQuery(searchattribute.WorkflowID, request.WorkflowID)).
+		Filter(keywordQuery(searchattribute.WorkflowID, request.WorkflowID, request.PrefixMatch)).
 		MustNot(elastic.NewTermQuery(searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String()))
 
 	p, err := s.buildSearchParameters(request.ListWorkflowExecutionsRequest, boolQuery, false)
@@ -587,11 +592,15 @@ func (s *visibilityStore) CountWorkflowExecutions(
 	ctx context.Context,
 	request *manager.CountWorkflowExecutionsRequest,
 ) (*manager.CountWorkflowExecutionsResponse, error) {
-	boolQuery, _, err := s.convertQuery(request.Namespace, request.NamespaceID, request.Query)
+	boolQuery, groupBy, err := s.convertCountQuery(request.Namespace, request.NamespaceID, request.Query)
 	if err != nil {
 		return nil, err
 	}
 
+	if len(groupBy) > 0 {
+		return s.countGroupByWorkflowExecutions(ctx, boolQuery, groupBy[0])
+	}
+
 	count, err := s.esClient.Count(ctx, s.index, boolQuery)
 	if err != nil {
 		return nil, convertElasticsearchClientError("CountWorkflowExecutions failed", err)
@@ -601,6 +610,39 @@ func (s *visibilityStore) CountWorkflowExecutions(
 	return response, nil
 }
 
+func (s *visibilityStore) countGroupByWorkflowExecutions(
+	ctx context.Context,
+	boolQuery *elastic.BoolQuery,
+	groupBy string,
+) (*manager.CountWorkflowExecutionsResponse, error) {
+	termsAgg := elastic.NewTermsAggregation().Field(groupBy).Size(countGroupByMaxGroups)
+	searchResult, err := s.esClient.CountGroupBy(ctx, s.index, boolQuery, groupBy, termsAgg)
+	if err != nil {
+		return nil, convertElasticsearchClientError("CountWorkflowExecutions failed", err)
+	}
+
+	buckets, ok := searchResult.Aggregations.Terms(groupBy)
+	if !ok {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to find %s aggregation in Elasticsearch response.", groupBy))
+	}
+
+	response := &manager.CountWorkflowExecutionsResponse{
+		Count:  searchResult.TotalHits(),
+		Groups: make([]manager.CountWorkflowExecutionsGroup, 0, len(buckets.Buckets)),
+	}
+	for _, bucket := range buckets.Buckets {
+		groupValue, err := searchattribute.EncodeValue(bucket.Key, enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to encode %s group value: %v", groupBy, err))
+		}
+		response.Groups = append(response.Groups, manager.CountWorkflowExecutionsGroup{
+			GroupValues: []*commonpb.Payload{groupValue},
+			Count:       bucket.DocCount,
+		})
+	}
+	return response, nil
+}
+
 func (s *visibilityStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -722,22 +764,61 @@ func (s *visibilityStore) convertQuery(
 	namespaceID namespace.ID,
 	requestQueryStr string,
 ) (*elastic.BoolQuery, []*elastic.FieldSort, error) {
-	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
+	nameInterceptor, queryConverter, err := s.newQueryConverter(namespace)
 	if err != nil {
-		return nil, nil, serviceerror.NewUnavailable(fmt.Sprintf("Unable to read search attribute types: %v", err))
+		return nil, nil, err
 	}
-	nameInterceptor := newNameInterceptor(namespace, s.index, saTypeMap, s.searchAttributesMapper)
-	queryConverter := newQueryConverter(nameInterceptor, NewValuesInterceptor())
 	requestQuery, fieldSorts, err := queryConverter.ConvertWhereOrderBy(requestQueryStr)
 	if err != nil {
-		// Convert ConverterError to InvalidArgument and pass through all other errors (which should be only mapper errors).
-		var converterErr *query.ConverterError
-		if errors.As(err, &converterErr) {
-			return nil, nil, converterErr.ToInvalidArgument()
-		}
+		return nil, nil, convertQueryError(err)
+	}
+
+	return s.namespaceFilterQuery(namespaceID, nameInterceptor, requestQuery), fieldSorts, nil
+}
+
+// convertCountQuery is the same as convertQuery, but it supports GROUP BY clause
+// and returns names of GROUP BY fields instead of sorts.
+func (s *visibilityStore) convertCountQuery(
+	namespace namespace.Name,
+	namespaceID namespace.ID,
+	requestQueryStr string,
+) (*elastic.BoolQuery, []string, error) {
+	nameInterceptor, queryConverter, err := s.newQueryConverter(namespace)
+	if err != nil {
 		return nil, nil, err
 	}
+	requestQuery, groupBy, err := queryConverter.ConvertWhereGroupBy(requestQueryStr)
+	if err != nil {
+		return nil, nil, convertQueryError(err)
+	}
+
+	return s.namespaceFilterQuery(namespaceID, nameInterceptor, requestQuery), groupBy, nil
+}
 
+func (s *visibilityStore) newQueryConverter(namespace namespace.Name) (*nameInterceptor, *query.Converter, error) {
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
+	if err != nil {
+		return nil, nil, serviceerror.NewUnavailable(fmt.Sprintf("Unable to read search attribute types: %v", err))
+	}
+	nameInterceptor := newNameInterceptor(namespace, s.index, saTypeMap, s.searchAttributesMapper)
+	return nameInterceptor, newQueryConverter(nameInterceptor, NewValuesInterceptor()), nil
+}
+
+// convertQueryError converts ConverterError to InvalidArgument and passes through all other errors
+// (which should be only mapper errors).
+func convertQueryError(err error) error {
+	var converterErr *query.ConverterError
+	if errors.As(err, &converterErr) {
+		return converterErr.ToInvalidArgument()
+	}
+	return err
+}
+
+func (s *visibilityStore) namespaceFilterQuery(
+	namespaceID namespace.ID,
+	nameInterceptor *nameInterceptor,
+	requestQuery *elastic.BoolQuery,
+) *elastic.BoolQuery {
 	// Create new bool query because request query might have only "should" (="or") queries.
 	namespaceFilterQuery := elastic.NewBoolQuery().Filter(elastic.NewTermQuery(searchattribute.NamespaceID, namespaceID.String()))
 
@@ -751,7 +832,7 @@ func (s *visibilityStore) convertQuery(
 		namespaceFilterQuery.Filter(requestQuery)
 	}
 
-	return namespaceFilterQuery, fieldSorts, nil
+	return namespaceFilterQuery
 }
 
 func (s *visibilityStore) setDefaultFieldSort(fieldSorts []*elastic.FieldSort) []elastic.Sorter {
@@ -1057,6 +1138,13 @@ func finishParseJSONValue(val interface{}, t enumspb.IndexedValueType) (interfac
 	panic(fmt.Sprintf("Unknown field type: %v", t))
 }
 
+func keywordQuery(name string, value string, prefixMatch bool) elastic.Query {
+	if prefixMatch {
+		return elastic.NewPrefixQuery(name, value)
+	}
+	return elastic.NewTermQuery(name, value)
+}
+
 func convertElasticsearchClientError(message string, err error) error {
 	errMessage := fmt.Sprintf("%s: %s", message, detailedErrorMessage(err))
 	switch e := err.(type) {
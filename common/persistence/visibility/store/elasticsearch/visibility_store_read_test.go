@@ -45,6 +45,7 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -1271,6 +1272,71 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions() {
 	s.True(strings.HasPrefix(err.Error(), "invalid query"), err.Error())
 }
 
+func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
+	s.mockESClient.EXPECT().CountGroupBy(gomock.Any(), testIndex, gomock.Any(), searchattribute.ExecutionStatus, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, index string, query elastic.Query, aggName string, agg elastic.Aggregation) (*elastic.SearchResult, error) {
+			s.Equal(
+				elastic.NewBoolQuery().Filter(
+					elastic.NewTermQuery(searchattribute.NamespaceID, testNamespaceID.String()),
+					elastic.NewBoolQuery().Filter(elastic.NewMatchQuery("WorkflowType", "wt")),
+				).MustNot(namespaceDivisionExists),
+				query,
+			)
+			s.Equal(elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).Size(countGroupByMaxGroups), agg)
+			return &elastic.SearchResult{
+				Hits: &elastic.SearchHits{TotalHits: &elastic.TotalHits{Value: 5}},
+				Aggregations: elastic.Aggregations{
+					searchattribute.ExecutionStatus: json.RawMessage(`{"buckets":[{"key":"Running","doc_count":3},{"key":"Completed","doc_count":2}]}`),
+				},
+			}, nil
+		})
+
+	request := &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       `WorkflowType = 'wt' GROUP BY ExecutionStatus`,
+	}
+	resp, err := s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Equal(int64(5), resp.Count)
+	s.Len(resp.Groups, 2)
+	expectedValues := []string{"Running", "Completed"}
+	expectedCounts := []int64{3, 2}
+	for i, group := range resp.Groups {
+		s.Len(group.GroupValues, 1)
+		var value string
+		s.NoError(payload.Decode(group.GroupValues[0], &value))
+		s.Equal(expectedValues[i], value)
+		s.Equal(expectedCounts[i], group.Count)
+	}
+
+	// test bad request
+	request.Query = `GROUP BY CustomIntField`
+	_, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	s.Error(err)
+	_, ok := err.(*serviceerror.InvalidArgument)
+	s.True(ok)
+
+	request.Query = `ExecutionStatus = 'Running' GROUP BY ExecutionStatus, WorkflowType`
+	_, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	s.Error(err)
+	_, ok = err.(*serviceerror.InvalidArgument)
+	s.True(ok)
+}
+
+func (s *ESVisibilitySuite) TestListWorkflowExecutions_GroupBy() {
+	request := &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		PageSize:    10,
+		Query:       `GROUP BY ExecutionStatus`,
+	}
+	_, err := s.visibilityStore.ListWorkflowExecutions(context.Background(), request)
+	s.Error(err)
+	_, ok := err.(*serviceerror.InvalidArgument)
+	s.True(ok)
+}
+
 func (s *ESVisibilitySuite) TestGetWorkflowExecution() {
 	now := timestamp.TimePtr(time.Now())
 	s.mockESClient.EXPECT().Get(gomock.Any(), testIndex, gomock.Any()).DoAndReturn(
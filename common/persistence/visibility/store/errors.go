@@ -31,4 +31,6 @@ import (
 var (
 	// OperationNotSupportedErr is returned when visibility operation in not supported.
 	OperationNotSupportedErr = serviceerror.NewInvalidArgument("Operation not supported. Please use on Elasticsearch")
+	// PrefixMatchNotSupportedErr is returned when visibility store doesn't support prefix match.
+	PrefixMatchNotSupportedErr = serviceerror.NewInvalidArgument("Prefix match not supported. Please use on SQL or Elasticsearch")
 )
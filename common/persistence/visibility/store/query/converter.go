@@ -82,6 +82,13 @@ type (
 	notSupportedExprConverter struct{}
 )
 
+// StartsWithStr is the prefix match operator, e.g. "WorkflowId STARTS_WITH 'order-'".
+// It is not part of the SQL dialect of sqlparser, so queries are rewritten before parsing.
+const StartsWithStr = "starts_with"
+
+// startsWithFuncName is the function STARTS_WITH comparisons are parsed as, see replaceStartsWith.
+const startsWithFuncName = "starts_with"
+
 func NewConverter(fnInterceptor FieldNameInterceptor, whereConverter ExprConverter) *Converter {
 	if fnInterceptor == nil {
 		fnInterceptor = &NopFieldNameInterceptor{}
@@ -188,29 +195,93 @@ func NewNotSupportedExprConverter() ExprConverter {
 // ConvertWhereOrderBy transforms WHERE SQL statement to Elasticsearch query.
 // It also supports ORDER BY clause.
 func (c *Converter) ConvertWhereOrderBy(whereOrderBy string) (*elastic.BoolQuery, []*elastic.FieldSort, error) {
+	return c.ConvertSql(whereOrderByToSql(whereOrderBy))
+}
+
+// ConvertSql transforms SQL to Elasticsearch query.
+func (c *Converter) ConvertSql(sql string) (*elastic.BoolQuery, []*elastic.FieldSort, error) {
+	selectStmt, err := parseSelect(sql)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return c.convertSelect(selectStmt)
+}
+
+// ConvertWhereGroupBy transforms WHERE SQL statement with optional GROUP BY clause to Elasticsearch
+// query and returns names of GROUP BY fields. It is used by count queries, so ORDER BY clause
+// is validated but ignored.
+func (c *Converter) ConvertWhereGroupBy(whereGroupBy string) (*elastic.BoolQuery, []string, error) {
+	selectStmt, err := ParseWhereOrderBy(whereGroupBy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	groupBy, err := ConvertGroupBy(c.fnInterceptor, selectStmt.GroupBy)
+	if err != nil {
+		return nil, nil, err
+	}
+	selectStmt.GroupBy = nil
+
+	query, _, err := c.convertSelect(selectStmt)
+	if err != nil {
+		return nil, nil, err
+	}
+	return query, groupBy, nil
+}
+
+// ConvertGroupBy converts GROUP BY clause to field names. Only a single field is supported.
+func ConvertGroupBy(fnInterceptor FieldNameInterceptor, groupBy sqlparser.GroupBy) ([]string, error) {
+	if len(groupBy) > 1 {
+		return nil, NewConverterError("%s: 'group by' clause with more than one field", NotSupportedErrMessage)
+	}
+
+	var fieldNames []string
+	for _, groupByExpr := range groupBy {
+		colName, err := ConvertColName(fnInterceptor, groupByExpr, FieldNameGroupBy)
+		if err != nil {
+			return nil, wrapConverterError("unable to convert 'group by' column name", err)
+		}
+		fieldNames = append(fieldNames, colName)
+	}
+	return fieldNames, nil
+}
+
+// ParseWhereOrderBy parses WHERE SQL statement with optional GROUP BY and ORDER BY clauses into select statement.
+// It is used by stores which don't use Elasticsearch queries and convert the statement themselves.
+// STARTS_WITH comparison expressions have StartsWithStr operator.
+func ParseWhereOrderBy(whereOrderBy string) (*sqlparser.Select, error) {
+	return parseSelect(whereOrderByToSql(whereOrderBy))
+}
+
+func whereOrderByToSql(whereOrderBy string) string {
 	whereOrderBy = strings.TrimSpace(whereOrderBy)
 
-	if whereOrderBy != "" && !strings.HasPrefix(strings.ToLower(whereOrderBy), "order by ") {
+	lowerWhereOrderBy := strings.ToLower(whereOrderBy)
+	if whereOrderBy != "" && !strings.HasPrefix(lowerWhereOrderBy, "order by ") && !strings.HasPrefix(lowerWhereOrderBy, "group by ") {
 		whereOrderBy = "where " + whereOrderBy
 	}
 	// sqlparser can't parse just WHERE clause but instead accepts only valid SQL statement.
-	sql := fmt.Sprintf("select * from table1 %s", whereOrderBy)
-	return c.ConvertSql(sql)
+	return fmt.Sprintf("select * from table1 %s", whereOrderBy)
 }
 
-// ConvertSql transforms SQL to Elasticsearch query.
-func (c *Converter) ConvertSql(sql string) (*elastic.BoolQuery, []*elastic.FieldSort, error) {
+func parseSelect(sql string) (*sqlparser.Select, error) {
+	sql, err := replaceStartsWith(sql)
+	if err != nil {
+		return nil, err
+	}
+
 	stmt, err := sqlparser.Parse(sql)
 	if err != nil {
-		return nil, nil, NewConverterError("%s: %v", MalformedSqlQueryErrMessage, err)
+		return nil, NewConverterError("%s: %v", MalformedSqlQueryErrMessage, err)
 	}
+	restoreStartsWith(stmt)
 
 	selectStmt, isSelect := stmt.(*sqlparser.Select)
 	if !isSelect {
-		return nil, nil, NewConverterError("%s: statement must be 'select' not %T", NotSupportedErrMessage, stmt)
+		return nil, NewConverterError("%s: statement must be 'select' not %T", NotSupportedErrMessage, stmt)
 	}
-
-	return c.convertSelect(selectStmt)
+	return selectStmt, nil
 }
 
 func (c *Converter) convertSelect(sel *sqlparser.Select) (*elastic.BoolQuery, []*elastic.FieldSort, error) {
@@ -237,7 +308,7 @@ func (c *Converter) convertSelect(sel *sqlparser.Select) (*elastic.BoolQuery, []
 
 	var fieldSorts []*elastic.FieldSort
 	for _, orderByExpr := range sel.OrderBy {
-		colName, err := convertColName(c.fnInterceptor, orderByExpr.Expr, FieldNameSorter)
+		colName, err := ConvertColName(c.fnInterceptor, orderByExpr.Expr, FieldNameSorter)
 		if err != nil {
 			return nil, nil, wrapConverterError("unable to convert 'order by' column name", err)
 		}
@@ -352,16 +423,16 @@ func (r *rangeCondConverter) Convert(expr sqlparser.Expr) (elastic.Query, error)
 		return nil, NewConverterError("%v is not a range condition", sqlparser.String(expr))
 	}
 
-	colName, err := convertColName(r.fnInterceptor, rangeCond.Left, FieldNameFilter)
+	colName, err := ConvertColName(r.fnInterceptor, rangeCond.Left, FieldNameFilter)
 	if err != nil {
 		return nil, wrapConverterError("unable to convert left part of 'between' expression", err)
 	}
 
-	fromValue, err := parseSqlValue(sqlparser.String(rangeCond.From))
+	fromValue, err := ParseSqlValue(sqlparser.String(rangeCond.From))
 	if err != nil {
 		return nil, err
 	}
-	toValue, err := parseSqlValue(sqlparser.String(rangeCond.To))
+	toValue, err := ParseSqlValue(sqlparser.String(rangeCond.To))
 	if err != nil {
 		return nil, err
 	}
@@ -394,7 +465,7 @@ func (i *isConverter) Convert(expr sqlparser.Expr) (elastic.Query, error) {
 		return nil, NewConverterError("%v is not an 'is' expression", sqlparser.String(expr))
 	}
 
-	colName, err := convertColName(i.fnInterceptor, isExpr.Expr, FieldNameFilter)
+	colName, err := ConvertColName(i.fnInterceptor, isExpr.Expr, FieldNameFilter)
 	if err != nil {
 		return nil, wrapConverterError("unable to convert left part of 'is' expression", err)
 	}
@@ -418,23 +489,33 @@ func (c *comparisonExprConverter) Convert(expr sqlparser.Expr) (elastic.Query, e
 		return nil, NewConverterError("%v is not a comparison expression", sqlparser.String(expr))
 	}
 
-	colName, err := convertColName(c.fnInterceptor, comparisonExpr.Left, FieldNameFilter)
+	usage := FieldNameFilter
+	if comparisonExpr.Operator == StartsWithStr {
+		usage = FieldNamePrefixFilter
+	}
+	colName, err := ConvertColName(c.fnInterceptor, comparisonExpr.Left, usage)
 	if err != nil {
 		return nil, wrapConverterError("unable to convert left part of comparison expression", err)
 	}
 
-	colValue, err := convertComparisonExprValue(comparisonExpr.Right)
+	colValue, err := ConvertComparisonExprValue(comparisonExpr.Right)
 	if err != nil {
 		return nil, wrapConverterError("unable to convert right part of comparison expression", err)
 	}
 
 	if comparisonExpr.Operator == "like" || comparisonExpr.Operator == "not like" {
-		colValue, err = cleanLikeValue(colValue)
+		colValue, err = CleanLikeValue(colValue)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	if comparisonExpr.Operator == StartsWithStr {
+		if _, isString := colValue.(string); !isString {
+			return nil, NewConverterError("%s: 'starts_with' operator value must be a string but was %T", InvalidExpressionErrMessage, colValue)
+		}
+	}
+
 	colValues, isArray := colValue.([]interface{})
 	// colValue should be an array only for "in (1,2,3)" queries.
 	if !isArray {
@@ -470,15 +551,18 @@ func (c *comparisonExprConverter) Convert(expr sqlparser.Expr) (elastic.Query, e
 		query = elastic.NewTermsQuery(colName, colValues...)
 	case "not in":
 		query = elastic.NewBoolQuery().MustNot(elastic.NewTermsQuery(colName, colValues...))
+	case StartsWithStr:
+		query = elastic.NewPrefixQuery(colName, fmt.Sprint(colValues[0]))
 	}
 
 	return query, nil
 }
 
-func convertComparisonExprValue(expr sqlparser.Expr) (interface{}, error) {
+// ConvertComparisonExprValue converts right part of comparison expression to a value or a list of values.
+func ConvertComparisonExprValue(expr sqlparser.Expr) (interface{}, error) {
 	switch e := expr.(type) {
 	case *sqlparser.SQLVal:
-		v, err := parseSqlValue(sqlparser.String(e))
+		v, err := ParseSqlValue(sqlparser.String(e))
 		if err != nil {
 			return nil, err
 		}
@@ -490,7 +574,7 @@ func convertComparisonExprValue(expr sqlparser.Expr) (interface{}, error) {
 		exprs := []sqlparser.Expr(e)
 		var result []interface{}
 		for _, expr := range exprs {
-			v, err := convertComparisonExprValue(expr)
+			v, err := ConvertComparisonExprValue(expr)
 			if err != nil {
 				return nil, err
 			}
@@ -508,7 +592,8 @@ func convertComparisonExprValue(expr sqlparser.Expr) (interface{}, error) {
 	}
 }
 
-func cleanLikeValue(colValue interface{}) (string, error) {
+// CleanLikeValue removes "%" from the value of like expression.
+func CleanLikeValue(colValue interface{}) (string, error) {
 	colValueStr, isString := colValue.(string)
 	if !isString {
 		return "", NewConverterError("%s: 'like' operator value must be a string but was %T", InvalidExpressionErrMessage, colValue)
@@ -516,11 +601,72 @@ func cleanLikeValue(colValue interface{}) (string, error) {
 	return strings.ReplaceAll(colValueStr, "%", ""), nil
 }
 
+// replaceStartsWith rewrites every STARTS_WITH comparison in sql to an equality with a
+// startsWithFuncName function call, which sqlparser can parse: "a STARTS_WITH 'b'" becomes
+// "a = starts_with('b')". STARTS_WITH is only an operator directly after a column name, so
+// columns named starts_with and string literals are left as is. Function calls are not
+// valid values otherwise, so restoreStartsWith doesn't change the meaning of any other query.
+func replaceStartsWith(sql string) (string, error) {
+	var result strings.Builder
+	tokenizer := sqlparser.NewStringTokenizer(sql)
+	last := 0
+	prevToken := 0
+	for {
+		token, value := tokenizer.Scan()
+		switch token {
+		case 0, sqlparser.LEX_ERROR:
+			// Syntax errors are reported by the parser.
+			result.WriteString(sql[last:])
+			return result.String(), nil
+		case sqlparser.ID:
+			// Tokenizer position is one past the character following the token.
+			end := tokenizer.Position - 1
+			start := end - len(value)
+			// Quoted identifiers don't match because of the quotes.
+			if prevToken != sqlparser.ID || start < last || !strings.EqualFold(sql[start:end], StartsWithStr) {
+				break
+			}
+			if valueToken, _ := tokenizer.Scan(); valueToken != sqlparser.STRING {
+				return "", NewConverterError("%s: 'starts_with' operator value must be a string", InvalidExpressionErrMessage)
+			}
+			valueEnd := tokenizer.Position - 1
+			result.WriteString(sql[last:start])
+			result.WriteString(sqlparser.EqualStr + " " + startsWithFuncName + "(")
+			result.WriteString(sql[end:valueEnd])
+			result.WriteString(")")
+			last = valueEnd
+			token = sqlparser.STRING
+		}
+		prevToken = token
+	}
+}
+
+// restoreStartsWith turns the comparisons rewritten by replaceStartsWith back into
+// STARTS_WITH comparisons.
+func restoreStartsWith(stmt sqlparser.Statement) {
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		comparisonExpr, ok := node.(*sqlparser.ComparisonExpr)
+		if !ok || comparisonExpr.Operator != sqlparser.EqualStr {
+			return true, nil
+		}
+		funcExpr, ok := comparisonExpr.Right.(*sqlparser.FuncExpr)
+		if !ok || !funcExpr.Qualifier.IsEmpty() || !funcExpr.Name.EqualString(startsWithFuncName) || len(funcExpr.Exprs) != 1 {
+			return true, nil
+		}
+		if arg, ok := funcExpr.Exprs[0].(*sqlparser.AliasedExpr); ok {
+			comparisonExpr.Operator = StartsWithStr
+			comparisonExpr.Right = arg.Expr
+		}
+		return true, nil
+	}, stmt)
+}
+
 func (n *notSupportedExprConverter) Convert(expr sqlparser.Expr) (elastic.Query, error) {
 	return nil, NewConverterError("%s: expression of type %T", NotSupportedErrMessage, expr)
 }
 
-func parseSqlValue(sqlValue string) (interface{}, error) {
+// ParseSqlValue parses quoted string or number.
+func ParseSqlValue(sqlValue string) (interface{}, error) {
 	if sqlValue == "" {
 		return "", nil
 	}
@@ -543,7 +689,8 @@ func parseSqlValue(sqlValue string) (interface{}, error) {
 	return nil, NewConverterError("%s: unable to parse %s", InvalidExpressionErrMessage, sqlValue)
 }
 
-func convertColName(fnInterceptor FieldNameInterceptor, colNameExpr sqlparser.Expr, usage FieldNameUsage) (string, error) {
+// ConvertColName converts column name expression to field name using fnInterceptor.
+func ConvertColName(fnInterceptor FieldNameInterceptor, colNameExpr sqlparser.Expr, usage FieldNameUsage) (string, error) {
 	colName, isColName := colNameExpr.(*sqlparser.ColName)
 	if !isColName {
 		return "", NewConverterError("%s: must be a column name but was %T", InvalidExpressionErrMessage, colNameExpr)
@@ -42,6 +42,10 @@ type (
 const (
 	FieldNameFilter FieldNameUsage = iota
 	FieldNameSorter
+	// FieldNamePrefixFilter is used for fields filtered by prefix with STARTS_WITH.
+	FieldNamePrefixFilter
+	// FieldNameGroupBy is used for fields in GROUP BY clause of count queries.
+	FieldNameGroupBy
 )
 
 func (n *NopFieldNameInterceptor) Name(name string, _ FieldNameUsage) (string, error) {
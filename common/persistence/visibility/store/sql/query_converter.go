@@ -0,0 +1,594 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// queryConverter converts list queries to conditions on executions_visibility table.
+	// System search attributes are mapped to columns. Custom and predefined search attributes
+	// are stored as JSON object in search_attributes column and are queried with JSON functions.
+	queryConverter struct {
+		namespaceName          namespace.Name
+		searchAttributesTypes  searchattribute.NameTypeMap
+		searchAttributesMapper searchattribute.Mapper
+		seenNamespaceDivision  bool
+	}
+
+	// sqlExpr is an SQL expression with "?" placeholders for args.
+	sqlExpr struct {
+		sql  string
+		args []interface{}
+	}
+
+	sortField struct {
+		name       string
+		expr       sqlExpr
+		desc       bool
+		nullsFirst bool
+	}
+
+	sqlQuery struct {
+		condition  sqlExpr
+		sortFields []*sortField
+		groupBy    []string
+	}
+)
+
+const (
+	searchAttributesColumn = "search_attributes"
+
+	// Datetime search attributes are stored with fixed number of fraction digits in UTC,
+	// so they are ordered correctly when compared as strings.
+	searchAttributeTimeFormat = "2006-01-02T15:04:05.000000000Z07:00"
+)
+
+var (
+	searchAttributeColumns = map[string]string{
+		searchattribute.WorkflowID:           "workflow_id",
+		searchattribute.RunID:                "run_id",
+		searchattribute.WorkflowType:         "workflow_type_name",
+		searchattribute.StartTime:            "start_time",
+		searchattribute.ExecutionTime:        "execution_time",
+		searchattribute.CloseTime:            "close_time",
+		searchattribute.ExecutionStatus:      "status",
+		searchattribute.TaskQueue:            "task_queue",
+		searchattribute.HistoryLength:        "history_length",
+		searchattribute.ExecutionDuration:    "execution_duration",
+		searchattribute.StateTransitionCount: "state_transition_count",
+		searchattribute.HistorySizeBytes:     "history_size_bytes",
+	}
+
+	allowedComparisonOperators = map[string]struct{}{
+		sqlparser.EqualStr:        {},
+		sqlparser.NotEqualStr:     {},
+		sqlparser.GreaterThanStr:  {},
+		sqlparser.GreaterEqualStr: {},
+		sqlparser.LessThanStr:     {},
+		sqlparser.LessEqualStr:    {},
+		sqlparser.LikeStr:         {},
+		sqlparser.NotLikeStr:      {},
+		sqlparser.InStr:           {},
+		sqlparser.NotInStr:        {},
+		query.StartsWithStr:       {},
+	}
+
+	// Same as Elasticsearch: running workflows first, then closed workflows by close time.
+	defaultSortFields = []*sortField{
+		newSortField(searchattribute.CloseTime, true),
+		newSortField(searchattribute.StartTime, true),
+		newSortField(searchattribute.RunID, true),
+	}
+
+	falseExpr = sqlExpr{sql: "0"}
+)
+
+func newQueryConverter(
+	namespaceName namespace.Name,
+	searchAttributesTypes searchattribute.NameTypeMap,
+	searchAttributesMapper searchattribute.Mapper,
+) *queryConverter {
+	return &queryConverter{
+		namespaceName:          namespaceName,
+		searchAttributesTypes:  searchAttributesTypes,
+		searchAttributesMapper: searchAttributesMapper,
+	}
+}
+
+func newSortField(name string, desc bool) *sortField {
+	return &sortField{
+		name: name,
+		expr: fieldExpr(name),
+		desc: desc,
+		// Missing values are last by default and first for descending default sort, same as Elasticsearch.
+		nullsFirst: desc && (name == searchattribute.CloseTime || name == searchattribute.StartTime),
+	}
+}
+
+// convertWhereOrderBy converts WHERE clause with optional ORDER BY clause of a list query.
+// Returned query also filters out workflows of other namespace divisions unless query
+// filters by TemporalNamespaceDivision explicitly.
+func (c *queryConverter) convertWhereOrderBy(whereOrderBy string) (*sqlQuery, error) {
+	sel, err := query.ParseWhereOrderBy(whereOrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	if sel.GroupBy != nil {
+		return nil, query.NewConverterError("%s: 'group by' clause", query.NotSupportedErrMessage)
+	}
+	return c.convertSelect(sel)
+}
+
+// convertWhereGroupBy converts WHERE clause with optional GROUP BY clause of a count query.
+// ORDER BY clause is validated but ignored.
+func (c *queryConverter) convertWhereGroupBy(whereGroupBy string) (*sqlQuery, error) {
+	sel, err := query.ParseWhereOrderBy(whereGroupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	groupBy, err := query.ConvertGroupBy(c, sel.GroupBy)
+	if err != nil {
+		return nil, err
+	}
+	sel.GroupBy = nil
+
+	result, err := c.convertSelect(sel)
+	if err != nil {
+		return nil, err
+	}
+	result.groupBy = groupBy
+	return result, nil
+}
+
+func (c *queryConverter) convertSelect(sel *sqlparser.Select) (*sqlQuery, error) {
+	if sel.Limit != nil {
+		return nil, query.NewConverterError("%s: 'limit' clause", query.NotSupportedErrMessage)
+	}
+
+	result := &sqlQuery{}
+	var conditions []sqlExpr
+	if sel.Where != nil {
+		condition, err := c.convertExpr(sel.Where.Expr)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert filter expression: %w", err)
+		}
+		conditions = append(conditions, condition)
+	}
+	if !c.seenNamespaceDivision {
+		conditions = append(conditions, isNullExpr(searchattribute.TemporalNamespaceDivision))
+	}
+	result.condition = andExpr(conditions...)
+
+	for _, orderByExpr := range sel.OrderBy {
+		name, err := query.ConvertColName(c, orderByExpr.Expr, query.FieldNameSorter)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert 'order by' column name: %w", err)
+		}
+		result.sortFields = append(result.sortFields, newSortField(name, orderByExpr.Direction == sqlparser.DescScr))
+	}
+	if len(result.sortFields) > 0 {
+		// RunID is explicit tiebreaker.
+		result.sortFields = append(result.sortFields, newSortField(searchattribute.RunID, true))
+	}
+
+	return result, nil
+}
+
+// Name implements query.FieldNameInterceptor. It resolves aliases of custom search attributes
+// and validates usage of the field.
+func (c *queryConverter) Name(name string, usage query.FieldNameUsage) (string, error) {
+	fieldName := name
+	if searchattribute.IsMappable(name) && c.searchAttributesMapper != nil {
+		var err error
+		fieldName, err = c.searchAttributesMapper.GetFieldName(name, c.namespaceName.String())
+		if err != nil {
+			return "", err
+		}
+	}
+
+	fieldType, err := c.searchAttributesTypes.GetType(fieldName)
+	if err != nil {
+		return "", query.NewConverterError("invalid search attribute: %s", name)
+	}
+
+	switch usage {
+	case query.FieldNameSorter:
+		if fieldType == enumspb.INDEXED_VALUE_TYPE_TEXT || fieldType == enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST {
+			return "", query.NewConverterError("unable to sort by field of %s type, use field of type %s", fieldType.String(), enumspb.INDEXED_VALUE_TYPE_KEYWORD.String())
+		}
+	case query.FieldNamePrefixFilter:
+		if fieldType != enumspb.INDEXED_VALUE_TYPE_KEYWORD && fieldType != enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST {
+			return "", query.NewConverterError("unable to filter by prefix on field of %s type, use field of type %s or %s", fieldType.String(), enumspb.INDEXED_VALUE_TYPE_KEYWORD.String(), enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST.String())
+		}
+	case query.FieldNameGroupBy:
+		if fieldType != enumspb.INDEXED_VALUE_TYPE_KEYWORD {
+			return "", query.NewConverterError("unable to group by field of %s type, use field of type %s", fieldType.String(), enumspb.INDEXED_VALUE_TYPE_KEYWORD.String())
+		}
+	}
+
+	if fieldName == searchattribute.TemporalNamespaceDivision && (usage == query.FieldNameFilter || usage == query.FieldNamePrefixFilter) {
+		c.seenNamespaceDivision = true
+	}
+
+	return fieldName, nil
+}
+
+func (c *queryConverter) convertExpr(expr sqlparser.Expr) (sqlExpr, error) {
+	switch e := expr.(type) {
+	case *sqlparser.AndExpr:
+		left, err := c.convertExpr(e.Left)
+		if err != nil {
+			return sqlExpr{}, err
+		}
+		right, err := c.convertExpr(e.Right)
+		if err != nil {
+			return sqlExpr{}, err
+		}
+		return andExpr(left, right), nil
+	case *sqlparser.OrExpr:
+		left, err := c.convertExpr(e.Left)
+		if err != nil {
+			return sqlExpr{}, err
+		}
+		right, err := c.convertExpr(e.Right)
+		if err != nil {
+			return sqlExpr{}, err
+		}
+		return orExpr(left, right), nil
+	case *sqlparser.ParenExpr:
+		return c.convertExpr(e.Expr)
+	case *sqlparser.ComparisonExpr:
+		return c.convertComparisonExpr(e)
+	case *sqlparser.RangeCond:
+		return c.convertRangeCond(e)
+	case *sqlparser.IsExpr:
+		return c.convertIsExpr(e)
+	case *sqlparser.NotExpr:
+		return sqlExpr{}, query.NewConverterError("%s: 'not' expression", query.NotSupportedErrMessage)
+	case *sqlparser.FuncExpr:
+		return sqlExpr{}, query.NewConverterError("%s: function expression", query.NotSupportedErrMessage)
+	case *sqlparser.ColName:
+		return sqlExpr{}, query.NewConverterError("incomplete expression")
+	default:
+		return sqlExpr{}, query.NewConverterError("%s: expression of type %T", query.NotSupportedErrMessage, expr)
+	}
+}
+
+func (c *queryConverter) convertComparisonExpr(expr *sqlparser.ComparisonExpr) (sqlExpr, error) {
+	operator := expr.Operator
+	if _, ok := allowedComparisonOperators[operator]; !ok {
+		return sqlExpr{}, query.NewConverterError("operator '%v' not allowed in comparison expression", operator)
+	}
+
+	usage := query.FieldNameFilter
+	if operator == query.StartsWithStr {
+		usage = query.FieldNamePrefixFilter
+	}
+	name, err := query.ConvertColName(c, expr.Left, usage)
+	if err != nil {
+		return sqlExpr{}, fmt.Errorf("unable to convert left part of comparison expression: %w", err)
+	}
+
+	value, err := query.ConvertComparisonExprValue(expr.Right)
+	if err != nil {
+		return sqlExpr{}, fmt.Errorf("unable to convert right part of comparison expression: %w", err)
+	}
+	if operator == sqlparser.LikeStr || operator == sqlparser.NotLikeStr {
+		if value, err = query.CleanLikeValue(value); err != nil {
+			return sqlExpr{}, err
+		}
+	}
+	if operator == query.StartsWithStr {
+		if _, isString := value.(string); !isString {
+			return sqlExpr{}, query.NewConverterError("%s: 'starts_with' operator value must be a string but was %T", query.InvalidExpressionErrMessage, value)
+		}
+	}
+
+	values, isArray := value.([]interface{})
+	// value should be an array only for "in (1,2,3)" queries.
+	if !isArray {
+		values = []interface{}{value}
+	}
+	values, err = c.convertValues(name, values)
+	if err != nil {
+		return sqlExpr{}, fmt.Errorf("unable to convert values of comparison expression: %w", err)
+	}
+
+	fieldType, _ := c.searchAttributesTypes.GetType(name)
+	if fieldType == enumspb.INDEXED_VALUE_TYPE_TEXT {
+		// Text search attributes are matched by substring, similar to full text match of Elasticsearch.
+		switch operator {
+		case sqlparser.EqualStr, sqlparser.LikeStr:
+			return fieldCondition(name, false, "instr(lower(%s), lower(?)) > 0", values[0]), nil
+		case sqlparser.NotEqualStr, sqlparser.NotLikeStr:
+			return fieldCondition(name, true, "instr(lower(%s), lower(?)) > 0", values[0]), nil
+		default:
+			return sqlExpr{}, query.NewConverterError("operator '%v' not allowed for field of %s type", operator, fieldType.String())
+		}
+	}
+
+	switch operator {
+	case sqlparser.EqualStr, sqlparser.LikeStr:
+		return fieldCondition(name, false, "%s = ?", values[0]), nil
+	case sqlparser.NotEqualStr, sqlparser.NotLikeStr:
+		return fieldCondition(name, true, "%s = ?", values[0]), nil
+	case sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr, sqlparser.LessThanStr, sqlparser.LessEqualStr:
+		return fieldCondition(name, false, "%s "+operator+" ?", values[0]), nil
+	case sqlparser.InStr:
+		return fieldCondition(name, false, "%s IN ("+placeholders(len(values))+")", values...), nil
+	case sqlparser.NotInStr:
+		return fieldCondition(name, true, "%s IN ("+placeholders(len(values))+")", values...), nil
+	default: // query.StartsWithStr
+		// instr is case sensitive unlike LIKE.
+		return fieldCondition(name, false, "instr(%s, ?) = 1", values[0]), nil
+	}
+}
+
+func (c *queryConverter) convertRangeCond(expr *sqlparser.RangeCond) (sqlExpr, error) {
+	name, err := query.ConvertColName(c, expr.Left, query.FieldNameFilter)
+	if err != nil {
+		return sqlExpr{}, fmt.Errorf("unable to convert left part of 'between' expression: %w", err)
+	}
+
+	fromValue, err := query.ParseSqlValue(sqlparser.String(expr.From))
+	if err != nil {
+		return sqlExpr{}, err
+	}
+	toValue, err := query.ParseSqlValue(sqlparser.String(expr.To))
+	if err != nil {
+		return sqlExpr{}, err
+	}
+	values, err := c.convertValues(name, []interface{}{fromValue, toValue})
+	if err != nil {
+		return sqlExpr{}, fmt.Errorf("unable to convert values of 'between' expression: %w", err)
+	}
+
+	switch expr.Operator {
+	case sqlparser.BetweenStr:
+		return fieldCondition(name, false, "%s BETWEEN ? AND ?", values...), nil
+	case sqlparser.NotBetweenStr:
+		return fieldCondition(name, true, "%s BETWEEN ? AND ?", values...), nil
+	default:
+		return sqlExpr{}, query.NewConverterError("%s: range condition operator must be 'between' or 'not between'", query.InvalidExpressionErrMessage)
+	}
+}
+
+func (c *queryConverter) convertIsExpr(expr *sqlparser.IsExpr) (sqlExpr, error) {
+	name, err := query.ConvertColName(c, expr.Expr, query.FieldNameFilter)
+	if err != nil {
+		return sqlExpr{}, fmt.Errorf("unable to convert left part of 'is' expression: %w", err)
+	}
+
+	switch expr.Operator {
+	case sqlparser.IsNullStr:
+		return isNullExpr(name), nil
+	case sqlparser.IsNotNullStr:
+		return sqlExpr{sql: "NOT (" + isNullExpr(name).sql + ")", args: isNullExpr(name).args}, nil
+	default:
+		return sqlExpr{}, query.NewConverterError("%s: 'is' operator can be used with 'null' and 'not null' only", query.InvalidExpressionErrMessage)
+	}
+}
+
+// convertValues converts query values to values stored in executions_visibility table.
+func (c *queryConverter) convertValues(name string, values []interface{}) ([]interface{}, error) {
+	// Elasticsearch values interceptor converts datetimes, execution statuses and durations to their canonical form.
+	values, err := elasticsearch.NewValuesInterceptor().Values(name, values...)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldType, err := c.searchAttributesTypes.GetType(name)
+	if err != nil {
+		return nil, query.NewConverterError("invalid search attribute: %s", name)
+	}
+	_, isColumn := searchAttributeColumns[name]
+
+	result := make([]interface{}, len(values))
+	for i, value := range values {
+		var valid bool
+		switch fieldType {
+		case enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, enumspb.INDEXED_VALUE_TYPE_TEXT:
+			var str string
+			if str, valid = value.(string); valid && name == searchattribute.ExecutionStatus {
+				var status int32
+				status, valid = enumspb.WorkflowExecutionStatus_value[str]
+				value = status
+			}
+		case enumspb.INDEXED_VALUE_TYPE_INT:
+			_, valid = value.(int64)
+		case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+			switch v := value.(type) {
+			case int64:
+				value, valid = float64(v), true
+			case float64:
+				valid = true
+			}
+		case enumspb.INDEXED_VALUE_TYPE_BOOL:
+			_, valid = value.(bool)
+		case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+			if str, isString := value.(string); isString {
+				if t, err := time.Parse(time.RFC3339Nano, str); err == nil {
+					valid = true
+					if isColumn {
+						value = t
+					} else {
+						value = formatSearchAttributeTime(t)
+					}
+				}
+			}
+		}
+		if !valid {
+			return nil, query.NewConverterError("%s: invalid value '%v' for search attribute %s of type %s", query.InvalidExpressionErrMessage, value, name, fieldType.String())
+		}
+		result[i] = value
+	}
+	return result, nil
+}
+
+// fieldExpr returns SQL expression which reads the value of a search attribute.
+func fieldExpr(name string) sqlExpr {
+	if column, isColumn := searchAttributeColumns[name]; isColumn {
+		return sqlExpr{sql: column}
+	}
+	return sqlExpr{sql: "json_extract(" + searchAttributesColumn + ", ?)", args: []interface{}{searchAttributePath(name)}}
+}
+
+// fieldCondition builds condition on a search attribute from format with "%s" placeholder for the field.
+// Custom search attributes might have a list of values and the condition matches if any of the values
+// matches. Negated condition matches if none of the values match, including missing values.
+func fieldCondition(name string, negate bool, format string, args ...interface{}) sqlExpr {
+	if column, isColumn := searchAttributeColumns[name]; isColumn {
+		condition := fmt.Sprintf(format, column)
+		if negate {
+			condition = fmt.Sprintf("(%s IS NULL OR NOT (%s))", column, condition)
+		}
+		return sqlExpr{sql: condition, args: args}
+	}
+
+	condition := fmt.Sprintf("EXISTS (SELECT 1 FROM json_each(%s, ?) WHERE %s)", searchAttributesColumn, fmt.Sprintf(format, "value"))
+	if negate {
+		condition = "NOT " + condition
+	}
+	return sqlExpr{sql: condition, args: append([]interface{}{searchAttributePath(name)}, args...)}
+}
+
+func isNullExpr(name string) sqlExpr {
+	if column, isColumn := searchAttributeColumns[name]; isColumn {
+		return sqlExpr{sql: column + " IS NULL"}
+	}
+	return sqlExpr{sql: "json_type(" + searchAttributesColumn + ", ?) IS NULL", args: []interface{}{searchAttributePath(name)}}
+}
+
+func andExpr(exprs ...sqlExpr) sqlExpr {
+	return joinExprs(" AND ", exprs)
+}
+
+func orExpr(exprs ...sqlExpr) sqlExpr {
+	return joinExprs(" OR ", exprs)
+}
+
+func joinExprs(sep string, exprs []sqlExpr) sqlExpr {
+	if len(exprs) == 1 {
+		return exprs[0]
+	}
+	var result sqlExpr
+	sqls := make([]string, len(exprs))
+	for i, expr := range exprs {
+		sqls[i] = "(" + expr.sql + ")"
+		result.args = append(result.args, expr.args...)
+	}
+	result.sql = strings.Join(sqls, sep)
+	return result
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+func searchAttributePath(name string) string {
+	return `$."` + name + `"`
+}
+
+func formatSearchAttributeTime(t time.Time) string {
+	return t.UTC().Format(searchAttributeTimeFormat)
+}
+
+// orderBy returns ORDER BY terms for sort fields.
+func orderBy(sortFields []*sortField) sqlExpr {
+	var result sqlExpr
+	terms := make([]string, len(sortFields))
+	for i, field := range sortFields {
+		direction := "ASC"
+		if field.desc {
+			direction = "DESC"
+		}
+		nulls := "NULLS LAST"
+		if field.nullsFirst {
+			nulls = "NULLS FIRST"
+		}
+		terms[i] = fmt.Sprintf("%s %s %s", field.expr.sql, direction, nulls)
+		result.args = append(result.args, field.expr.args...)
+	}
+	result.sql = strings.Join(terms, ", ")
+	return result
+}
+
+// afterCondition returns condition which matches rows sorted after the row with values of sort fields.
+// NULL is the smallest value in SQLite and sorts according to nullsFirst of the field.
+func afterCondition(sortFields []*sortField, values []interface{}) (sqlExpr, error) {
+	if len(sortFields) != len(values) {
+		return sqlExpr{}, errors.New("number of sort values doesn't match number of sort fields")
+	}
+
+	var alternatives []sqlExpr
+	for i, field := range sortFields {
+		var after sqlExpr
+		value := values[i]
+		switch {
+		case value == nil && !field.nullsFirst:
+			// Nothing is after missing values, try next field.
+		case value == nil:
+			after = sqlExpr{sql: field.expr.sql + " IS NOT NULL", args: field.expr.args}
+		default:
+			comparison := " > ?"
+			if field.desc {
+				comparison = " < ?"
+			}
+			after = sqlExpr{sql: field.expr.sql + comparison, args: append(append([]interface{}{}, field.expr.args...), value)}
+			if !field.nullsFirst {
+				after = orExpr(after, sqlExpr{sql: field.expr.sql + " IS NULL", args: field.expr.args})
+			}
+		}
+
+		if after.sql != "" {
+			var equal []sqlExpr
+			for j := 0; j < i; j++ {
+				expr := sortFields[j].expr
+				equal = append(equal, sqlExpr{sql: expr.sql + " IS ?", args: append(append([]interface{}{}, expr.args...), values[j])})
+			}
+			alternatives = append(alternatives, andExpr(append(equal, after)...))
+		}
+	}
+
+	if len(alternatives) == 0 {
+		return falseExpr, nil
+	}
+	return orExpr(alternatives...), nil
+}
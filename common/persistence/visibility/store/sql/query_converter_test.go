@@ -0,0 +1,204 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const namespaceDivisionCondition = `json_type(search_attributes, ?) IS NULL`
+
+var namespaceDivisionPath = `$."TemporalNamespaceDivision"`
+
+func TestQueryConverter_Condition(t *testing.T) {
+	startTime, err := time.Parse(time.RFC3339Nano, "2022-11-10T20:04:59.123Z")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		query        string
+		expectedSQL  string
+		expectedArgs []interface{}
+	}{
+		{
+			query:        ``,
+			expectedSQL:  namespaceDivisionCondition,
+			expectedArgs: []interface{}{namespaceDivisionPath},
+		},
+		{
+			query:        `WorkflowId = 'wid'`,
+			expectedSQL:  `(workflow_id = ?) AND (` + namespaceDivisionCondition + `)`,
+			expectedArgs: []interface{}{"wid", namespaceDivisionPath},
+		},
+		{
+			query:        `ExecutionStatus != 'Running' and StartTime >= '2022-11-10T20:04:59.123Z'`,
+			expectedSQL:  `(((status IS NULL OR NOT (status = ?))) AND (start_time >= ?)) AND (` + namespaceDivisionCondition + `)`,
+			expectedArgs: []interface{}{int32(1), startTime, namespaceDivisionPath},
+		},
+		{
+			query:        `WorkflowType starts_with 'order' or TaskQueue in ('a', 'b')`,
+			expectedSQL:  `((instr(workflow_type_name, ?) = 1) OR (task_queue IN (?, ?))) AND (` + namespaceDivisionCondition + `)`,
+			expectedArgs: []interface{}{"order", "a", "b", namespaceDivisionPath},
+		},
+		{
+			query:        `CustomKeywordField = 'foo'`,
+			expectedSQL:  `(EXISTS (SELECT 1 FROM json_each(search_attributes, ?) WHERE value = ?)) AND (` + namespaceDivisionCondition + `)`,
+			expectedArgs: []interface{}{`$."CustomKeywordField"`, "foo", namespaceDivisionPath},
+		},
+		{
+			query:        `CustomKeywordField not in ('foo', 'bar')`,
+			expectedSQL:  `(NOT EXISTS (SELECT 1 FROM json_each(search_attributes, ?) WHERE value IN (?, ?))) AND (` + namespaceDivisionCondition + `)`,
+			expectedArgs: []interface{}{`$."CustomKeywordField"`, "foo", "bar", namespaceDivisionPath},
+		},
+		{
+			query:        `CustomTextField like '%Foo%'`,
+			expectedSQL:  `(EXISTS (SELECT 1 FROM json_each(search_attributes, ?) WHERE instr(lower(value), lower(?)) > 0)) AND (` + namespaceDivisionCondition + `)`,
+			expectedArgs: []interface{}{`$."CustomTextField"`, "Foo", namespaceDivisionPath},
+		},
+		{
+			query:        `CustomIntField between 1 and 10`,
+			expectedSQL:  `(EXISTS (SELECT 1 FROM json_each(search_attributes, ?) WHERE value BETWEEN ? AND ?)) AND (` + namespaceDivisionCondition + `)`,
+			expectedArgs: []interface{}{`$."CustomIntField"`, int64(1), int64(10), namespaceDivisionPath},
+		},
+		{
+			query:        `CustomDatetimeField < '2022-11-10T20:04:59.123Z'`,
+			expectedSQL:  `(EXISTS (SELECT 1 FROM json_each(search_attributes, ?) WHERE value < ?)) AND (` + namespaceDivisionCondition + `)`,
+			expectedArgs: []interface{}{`$."CustomDatetimeField"`, "2022-11-10T20:04:59.123000000Z", namespaceDivisionPath},
+		},
+		{
+			query:        `CustomDoubleField > 1 and CustomBoolField = true`,
+			expectedSQL:  `((EXISTS (SELECT 1 FROM json_each(search_attributes, ?) WHERE value > ?)) AND (EXISTS (SELECT 1 FROM json_each(search_attributes, ?) WHERE value = ?))) AND (` + namespaceDivisionCondition + `)`,
+			expectedArgs: []interface{}{`$."CustomDoubleField"`, float64(1), `$."CustomBoolField"`, true, namespaceDivisionPath},
+		},
+		{
+			query:        `CloseTime is null or CustomIntField is not null`,
+			expectedSQL:  `((close_time IS NULL) OR (NOT (json_type(search_attributes, ?) IS NULL))) AND (` + namespaceDivisionCondition + `)`,
+			expectedArgs: []interface{}{`$."CustomIntField"`, namespaceDivisionPath},
+		},
+		{
+			query:        `TemporalNamespaceDivision = 'division'`,
+			expectedSQL:  `EXISTS (SELECT 1 FROM json_each(search_attributes, ?) WHERE value = ?)`,
+			expectedArgs: []interface{}{namespaceDivisionPath, "division"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			c := newQueryConverter(namespace.Name("test-namespace"), searchattribute.TestNameTypeMap, nil)
+			sqlQuery, err := c.convertWhereOrderBy(tc.query)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSQL, sqlQuery.condition.sql)
+			assert.Equal(t, tc.expectedArgs, sqlQuery.condition.args)
+			assert.Empty(t, sqlQuery.sortFields)
+		})
+	}
+}
+
+func TestQueryConverter_OrderBy(t *testing.T) {
+	c := newQueryConverter(namespace.Name("test-namespace"), searchattribute.TestNameTypeMap, nil)
+	sqlQuery, err := c.convertWhereOrderBy(`order by CustomIntField asc, StartTime desc`)
+	require.NoError(t, err)
+
+	order := orderBy(sqlQuery.sortFields)
+	assert.Equal(t, `json_extract(search_attributes, ?) ASC NULLS LAST, start_time DESC NULLS FIRST, run_id DESC NULLS LAST`, order.sql)
+	assert.Equal(t, []interface{}{`$."CustomIntField"`}, order.args)
+
+	_, err = c.convertWhereOrderBy(`order by CustomTextField`)
+	var converterErr *query.ConverterError
+	assert.True(t, errors.As(err, &converterErr))
+}
+
+func TestQueryConverter_GroupBy(t *testing.T) {
+	c := newQueryConverter(namespace.Name("test-namespace"), searchattribute.TestNameTypeMap, nil)
+	sqlQuery, err := c.convertWhereGroupBy(`WorkflowType = 'wt' group by CustomKeywordField`)
+	require.NoError(t, err)
+	assert.Equal(t, `(workflow_type_name = ?) AND (`+namespaceDivisionCondition+`)`, sqlQuery.condition.sql)
+	assert.Equal(t, []string{"CustomKeywordField"}, sqlQuery.groupBy)
+
+	sqlQuery, err = c.convertWhereGroupBy(`GROUP BY ExecutionStatus`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{searchattribute.ExecutionStatus}, sqlQuery.groupBy)
+
+	for _, queryStr := range []string{
+		`group by CustomIntField`,
+		`group by CustomTextField`,
+		`group by ExecutionStatus, WorkflowType`,
+	} {
+		_, err = c.convertWhereGroupBy(queryStr)
+		var converterErr *query.ConverterError
+		assert.True(t, errors.As(err, &converterErr), "unexpected error for %s: %v", queryStr, err)
+	}
+}
+
+func TestQueryConverter_Errors(t *testing.T) {
+	testCases := []string{
+		`UnknownField = 'foo'`,
+		`CustomIntField = 'foo'`,
+		`CustomTextField > 'foo'`,
+		`CustomIntField starts_with 'foo'`,
+		`ExecutionStatus = 'Unknown'`,
+		`StartTime > 'yesterday'`,
+		`WorkflowId <=> 'foo'`,
+		`not WorkflowId = 'foo'`,
+		`WorkflowId = 'foo' limit 10`,
+		`WorkflowId = 'foo' group by WorkflowType`,
+	}
+
+	for _, queryStr := range testCases {
+		t.Run(queryStr, func(t *testing.T) {
+			c := newQueryConverter(namespace.Name("test-namespace"), searchattribute.TestNameTypeMap, nil)
+			_, err := c.convertWhereOrderBy(queryStr)
+			var converterErr *query.ConverterError
+			assert.True(t, errors.As(err, &converterErr), "unexpected error: %v", err)
+		})
+	}
+}
+
+func TestAfterCondition(t *testing.T) {
+	closeTime := time.Date(2022, 11, 10, 20, 4, 59, 0, time.UTC)
+	startTime := closeTime.Add(-time.Hour)
+
+	condition, err := afterCondition(defaultSortFields, []interface{}{closeTime, startTime, "run-id"})
+	require.NoError(t, err)
+	assert.Equal(t, `(close_time < ?) OR ((close_time IS ?) AND (start_time < ?)) OR ((close_time IS ?) AND (start_time IS ?) AND ((run_id < ?) OR (run_id IS NULL)))`, condition.sql)
+	assert.Equal(t, []interface{}{closeTime, closeTime, startTime, closeTime, startTime, "run-id"}, condition.args)
+
+	// Running workflows are first, closed workflows follow.
+	condition, err = afterCondition(defaultSortFields, []interface{}{nil, startTime, "run-id"})
+	require.NoError(t, err)
+	assert.Equal(t, `(close_time IS NOT NULL) OR ((close_time IS ?) AND (start_time < ?)) OR ((close_time IS ?) AND (start_time IS ?) AND ((run_id < ?) OR (run_id IS NULL)))`, condition.sql)
+	assert.Equal(t, []interface{}{nil, startTime, nil, startTime, "run-id"}, condition.args)
+
+	_, err = afterCondition(defaultSortFields, []interface{}{nil})
+	assert.Error(t, err)
+}
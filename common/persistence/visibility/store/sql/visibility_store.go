@@ -0,0 +1,779 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	persistencesql "go.temporal.io/server/common/persistence/sql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// visibilityStore is advanced visibility store on SQL database. It requires SQL plugin
+	// which implements sqlplugin.AdvancedVisibility, currently only SQLite.
+	visibilityStore struct {
+		sqlStore                 persistencesql.SqlStore
+		db                       sqlplugin.AdvancedVisibility
+		index                    string
+		searchAttributesProvider searchattribute.Provider
+		searchAttributesMapper   searchattribute.Mapper
+		disableOrderByClause     dynamicconfig.BoolPropertyFn
+	}
+
+	// visibilityPageToken holds values of sort fields of the last row of the page.
+	visibilityPageToken struct {
+		SortValues []interface{}
+	}
+)
+
+var _ store.VisibilityStore = (*visibilityStore)(nil)
+
+// NewVisibilityStore creates an instance of advanced VisibilityStore on SQL database
+func NewVisibilityStore(
+	cfg config.SQL,
+	r resolver.ServiceResolver,
+	index string,
+	searchAttributesProvider searchattribute.Provider,
+	searchAttributesMapper searchattribute.Mapper,
+	disableOrderByClause dynamicconfig.BoolPropertyFn,
+	logger log.Logger,
+) (*visibilityStore, error) {
+	refDbConn := persistencesql.NewRefCountedDBConn(sqlplugin.DbKindVisibility, &cfg, r)
+	db, err := refDbConn.Get()
+	if err != nil {
+		return nil, err
+	}
+	// Reference counted connection exposes only sqlplugin.DB methods, plugin DB is checked instead.
+	advancedDB, ok := refDbConn.DB.(sqlplugin.AdvancedVisibility)
+	if !ok {
+		_ = db.Close()
+		return nil, fmt.Errorf("advanced visibility is not supported by SQL plugin %q", cfg.PluginName)
+	}
+	return &visibilityStore{
+		sqlStore:                 persistencesql.NewSqlStore(db, logger),
+		db:                       advancedDB,
+		index:                    index,
+		searchAttributesProvider: searchAttributesProvider,
+		searchAttributesMapper:   searchAttributesMapper,
+		disableOrderByClause:     disableOrderByClause,
+	}, nil
+}
+
+func (s *visibilityStore) Close() {
+	s.sqlStore.Close()
+}
+
+func (s *visibilityStore) GetName() string {
+	return s.sqlStore.GetName()
+}
+
+func (s *visibilityStore) RecordWorkflowExecutionStarted(
+	ctx context.Context,
+	request *store.InternalRecordWorkflowExecutionStartedRequest,
+) error {
+	row, err := s.generateRow(request.InternalVisibilityRequestBase)
+	if err != nil {
+		return err
+	}
+	row.Status = int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	_, err = s.sqlStore.Db.InsertIntoVisibility(ctx, row)
+	return err
+}
+
+func (s *visibilityStore) RecordWorkflowExecutionClosed(
+	ctx context.Context,
+	request *store.InternalRecordWorkflowExecutionClosedRequest,
+) error {
+	row, err := s.generateRow(request.InternalVisibilityRequestBase)
+	if err != nil {
+		return err
+	}
+	executionDuration := request.CloseTime.Sub(request.ExecutionTime).Nanoseconds()
+	row.CloseTime = &request.CloseTime
+	row.HistoryLength = &request.HistoryLength
+	row.HistorySizeBytes = &request.HistorySizeBytes
+	row.ExecutionDuration = &executionDuration
+
+	result, err := s.sqlStore.Db.ReplaceIntoVisibility(ctx, row)
+	if err != nil {
+		return err
+	}
+	noRowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("RecordWorkflowExecutionClosed rowsAffected error: %v", err)
+	}
+	if noRowsAffected > 2 { // either adds a new row or deletes old row and adds new row
+		return fmt.Errorf("RecordWorkflowExecutionClosed unexpected numRows (%v) updated", noRowsAffected)
+	}
+	return nil
+}
+
+func (s *visibilityStore) UpsertWorkflowExecution(
+	ctx context.Context,
+	request *store.InternalUpsertWorkflowExecutionRequest,
+) error {
+	row, err := s.generateRow(request.InternalVisibilityRequestBase)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.UpsertIntoVisibility(ctx, row)
+	return err
+}
+
+func (s *visibilityStore) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *manager.VisibilityDeleteWorkflowExecutionRequest,
+) error {
+	_, err := s.sqlStore.Db.DeleteFromVisibility(ctx, sqlplugin.VisibilityDeleteFilter{
+		NamespaceID: request.NamespaceID.String(),
+		RunID:       request.RunID,
+	})
+	if err != nil {
+		return serviceerror.NewUnavailable(err.Error())
+	}
+	return nil
+}
+
+func (s *visibilityStore) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsRequest,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutionsByRequest(ctx, "ListOpenWorkflowExecutions", request, true,
+		fieldCondition(searchattribute.ExecutionStatus, false, "%s = ?", int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)))
+}
+
+func (s *visibilityStore) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsRequest,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutionsByRequest(ctx, "ListClosedWorkflowExecutions", request, false,
+		fieldCondition(searchattribute.ExecutionStatus, true, "%s = ?", int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)))
+}
+
+func (s *visibilityStore) ListOpenWorkflowExecutionsByType(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsByTypeRequest,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutionsByRequest(ctx, "ListOpenWorkflowExecutionsByType", request.ListWorkflowExecutionsRequest, true,
+		fieldCondition(searchattribute.ExecutionStatus, false, "%s = ?", int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)),
+		keywordCondition(searchattribute.WorkflowType, request.WorkflowTypeName, request.PrefixMatch))
+}
+
+func (s *visibilityStore) ListClosedWorkflowExecutionsByType(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsByTypeRequest,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutionsByRequest(ctx, "ListClosedWorkflowExecutionsByType", request.ListWorkflowExecutionsRequest, false,
+		fieldCondition(searchattribute.ExecutionStatus, true, "%s = ?", int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)),
+		keywordCondition(searchattribute.WorkflowType, request.WorkflowTypeName, request.PrefixMatch))
+}
+
+func (s *visibilityStore) ListOpenWorkflowExecutionsByWorkflowID(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsByWorkflowIDRequest,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutionsByRequest(ctx, "ListOpenWorkflowExecutionsByWorkflowID", request.ListWorkflowExecutionsRequest, true,
+		fieldCondition(searchattribute.ExecutionStatus, false, "%s = ?", int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)),
+		keywordCondition(searchattribute.WorkflowID, request.WorkflowID, request.PrefixMatch))
+}
+
+func (s *visibilityStore) ListClosedWorkflowExecutionsByWorkflowID(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsByWorkflowIDRequest,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutionsByRequest(ctx, "ListClosedWorkflowExecutionsByWorkflowID", request.ListWorkflowExecutionsRequest, false,
+		fieldCondition(searchattribute.ExecutionStatus, true, "%s = ?", int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)),
+		keywordCondition(searchattribute.WorkflowID, request.WorkflowID, request.PrefixMatch))
+}
+
+func (s *visibilityStore) ListClosedWorkflowExecutionsByStatus(
+	ctx context.Context,
+	request *manager.ListClosedWorkflowExecutionsByStatusRequest,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutionsByRequest(ctx, "ListClosedWorkflowExecutionsByStatus", request.ListWorkflowExecutionsRequest, false,
+		fieldCondition(searchattribute.ExecutionStatus, false, "%s = ?", int32(request.Status)))
+}
+
+func (s *visibilityStore) ListWorkflowExecutions(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsRequestV2,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	sqlQuery, err := s.convertQuery(request.Namespace, request.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	// Same as Elasticsearch store: ORDER BY clause might be slow for large number of workflows.
+	if s.disableOrderByClause() && len(sqlQuery.sortFields) > 0 {
+		return nil, serviceerror.NewInvalidArgument("ORDER BY clause is not supported")
+	}
+	sortFields := sqlQuery.sortFields
+	if len(sortFields) == 0 {
+		sortFields = defaultSortFields
+	}
+
+	return s.listWorkflowExecutions(ctx, "ListWorkflowExecutions", request.NamespaceID, request.Namespace, sqlQuery.condition, sortFields, request.PageSize, request.NextPageToken)
+}
+
+func (s *visibilityStore) ScanWorkflowExecutions(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsRequestV2,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	sqlQuery, err := s.convertQuery(request.Namespace, request.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	// Scan doesn't guarantee any order, default order is used to iterate over all workflows.
+	return s.listWorkflowExecutions(ctx, "ScanWorkflowExecutions", request.NamespaceID, request.Namespace, sqlQuery.condition, defaultSortFields, request.PageSize, request.NextPageToken)
+}
+
+func (s *visibilityStore) CountWorkflowExecutions(
+	ctx context.Context,
+	request *manager.CountWorkflowExecutionsRequest,
+) (*manager.CountWorkflowExecutionsResponse, error) {
+	sqlQuery, err := s.convertCountQuery(request.Namespace, request.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := sqlplugin.VisibilityQueryFilter{
+		NamespaceID:   request.NamespaceID.String(),
+		Condition:     sqlQuery.condition.sql,
+		ConditionArgs: sqlQuery.condition.args,
+	}
+	if len(sqlQuery.groupBy) > 0 {
+		groupBy := fieldExpr(sqlQuery.groupBy[0])
+		filter.GroupBy = groupBy.sql
+		filter.GroupByArgs = groupBy.args
+	}
+	rows, err := s.db.CountFromVisibilityByQuery(ctx, filter)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("CountWorkflowExecutions operation failed. Select failed: %v", err))
+	}
+
+	response := &manager.CountWorkflowExecutionsResponse{}
+	for _, row := range rows {
+		response.Count += row.Count
+		// Workflows without value of GROUP BY field are not part of any group.
+		if len(sqlQuery.groupBy) == 0 || row.GroupValue == nil {
+			continue
+		}
+		groupValue, err := store.EncodeCountGroupValue(sqlQuery.groupBy[0], *row.GroupValue)
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to encode %s group value: %v", sqlQuery.groupBy[0], err))
+		}
+		response.Groups = append(response.Groups, manager.CountWorkflowExecutionsGroup{
+			GroupValues: []*commonpb.Payload{groupValue},
+			Count:       row.Count,
+		})
+	}
+	return response, nil
+}
+
+func (s *visibilityStore) GetWorkflowExecution(
+	ctx context.Context,
+	request *manager.GetWorkflowExecutionRequest,
+) (*store.InternalGetWorkflowExecutionResponse, error) {
+	row, err := s.sqlStore.Db.GetFromVisibility(ctx, sqlplugin.VisibilityGetFilter{
+		NamespaceID: request.NamespaceID.String(),
+		RunID:       request.RunID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, serviceerror.NewNotFound(fmt.Sprintf("Workflow execution with RunId %s not found", request.RunID))
+		}
+		return nil, serviceerror.NewUnavailable(
+			fmt.Sprintf("GetWorkflowExecution operation failed. Select failed: %v", err))
+	}
+
+	typeMap, err := s.getSearchAttributesTypes()
+	if err != nil {
+		return nil, err
+	}
+	info, err := s.rowToInfo(row, typeMap, request.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return &store.InternalGetWorkflowExecutionResponse{
+		Execution: info,
+	}, nil
+}
+
+func (s *visibilityStore) convertQuery(namespaceName namespace.Name, queryStr string) (*sqlQuery, error) {
+	typeMap, err := s.getSearchAttributesTypes()
+	if err != nil {
+		return nil, err
+	}
+	sqlQuery, err := newQueryConverter(namespaceName, typeMap, s.searchAttributesMapper).convertWhereOrderBy(queryStr)
+	if err != nil {
+		return nil, convertQueryError(err)
+	}
+	return sqlQuery, nil
+}
+
+func (s *visibilityStore) convertCountQuery(namespaceName namespace.Name, queryStr string) (*sqlQuery, error) {
+	typeMap, err := s.getSearchAttributesTypes()
+	if err != nil {
+		return nil, err
+	}
+	sqlQuery, err := newQueryConverter(namespaceName, typeMap, s.searchAttributesMapper).convertWhereGroupBy(queryStr)
+	if err != nil {
+		return nil, convertQueryError(err)
+	}
+	return sqlQuery, nil
+}
+
+// convertQueryError converts ConverterError to InvalidArgument and passes through all other errors
+// (which should be only mapper errors).
+func convertQueryError(err error) error {
+	var converterErr *query.ConverterError
+	if errors.As(err, &converterErr) {
+		return converterErr.ToInvalidArgument()
+	}
+	return err
+}
+
+// listWorkflowExecutionsByRequest lists workflows for APIs which filter by start time of open
+// workflows or close time of closed workflows.
+func (s *visibilityStore) listWorkflowExecutionsByRequest(
+	ctx context.Context,
+	opName string,
+	request *manager.ListWorkflowExecutionsRequest,
+	overStartTime bool,
+	conditions ...sqlExpr,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	if request.NamespaceDivision == "" {
+		conditions = append(conditions, isNullExpr(searchattribute.TemporalNamespaceDivision))
+	} else {
+		conditions = append(conditions, fieldCondition(searchattribute.TemporalNamespaceDivision, false, "%s = ?", request.NamespaceDivision))
+	}
+
+	timeField := searchattribute.CloseTime
+	if overStartTime {
+		timeField = searchattribute.StartTime
+	}
+	if !request.EarliestStartTime.IsZero() {
+		conditions = append(conditions, fieldCondition(timeField, false, "%s >= ?", request.EarliestStartTime))
+	}
+	if !request.LatestStartTime.IsZero() {
+		conditions = append(conditions, fieldCondition(timeField, false, "%s <= ?", request.LatestStartTime))
+	}
+
+	return s.listWorkflowExecutions(ctx, opName, request.NamespaceID, request.Namespace, andExpr(conditions...), defaultSortFields, request.PageSize, request.NextPageToken)
+}
+
+func (s *visibilityStore) listWorkflowExecutions(
+	ctx context.Context,
+	opName string,
+	namespaceID namespace.ID,
+	namespaceName namespace.Name,
+	condition sqlExpr,
+	sortFields []*sortField,
+	pageSize int,
+	pageToken []byte,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	typeMap, err := s.getSearchAttributesTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pageToken) > 0 {
+		token, err := s.deserializePageToken(pageToken, sortFields)
+		if err != nil {
+			return nil, err
+		}
+		after, err := afterCondition(sortFields, token.SortValues)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid page token: %v", err))
+		}
+		if condition.sql == "" {
+			condition = after
+		} else {
+			condition = andExpr(condition, after)
+		}
+	}
+
+	order := orderBy(sortFields)
+	rows, err := s.db.SelectFromVisibilityByQuery(ctx, sqlplugin.VisibilityQueryFilter{
+		NamespaceID:   namespaceID.String(),
+		Condition:     condition.sql,
+		ConditionArgs: condition.args,
+		OrderBy:       order.sql,
+		OrderByArgs:   order.args,
+		PageSize:      pageSize,
+	})
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("%v operation failed. Select failed: %v", opName, err))
+	}
+	if len(rows) == 0 {
+		return &store.InternalListWorkflowExecutionsResponse{}, nil
+	}
+
+	infos := make([]*store.InternalWorkflowExecutionInfo, len(rows))
+	for i := range rows {
+		infos[i], err = s.rowToInfo(&rows[i], typeMap, namespaceName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var nextPageToken []byte
+	if len(rows) == pageSize {
+		nextPageToken, err = s.serializePageToken(&visibilityPageToken{
+			SortValues: sortValues(&rows[len(rows)-1], sortFields),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &store.InternalListWorkflowExecutionsResponse{
+		Executions:    infos,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *visibilityStore) getSearchAttributesTypes() (searchattribute.NameTypeMap, error) {
+	typeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
+	if err != nil {
+		return searchattribute.NameTypeMap{}, serviceerror.NewUnavailable(fmt.Sprintf("Unable to read search attribute types: %v", err))
+	}
+	return typeMap, nil
+}
+
+func (s *visibilityStore) generateRow(request *store.InternalVisibilityRequestBase) (*sqlplugin.VisibilityRow, error) {
+	typeMap, err := s.getSearchAttributesTypes()
+	if err != nil {
+		return nil, err
+	}
+	searchAttributes, err := searchattribute.Decode(request.SearchAttributes, &typeMap)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to decode search attributes: %v", err))
+	}
+
+	var rowSearchAttributes sqlplugin.VisibilitySearchAttributes
+	for name, value := range searchAttributes {
+		// If search attribute value is nil, it means that it shouldn't be stored.
+		// Empty slices are converted to nil while decoding.
+		switch v := value.(type) {
+		case nil:
+			continue
+		case time.Time:
+			value = formatSearchAttributeTime(v)
+		case []time.Time:
+			times := make([]string, len(v))
+			for i, t := range v {
+				times[i] = formatSearchAttributeTime(t)
+			}
+			value = times
+		}
+		if rowSearchAttributes == nil {
+			rowSearchAttributes = make(sqlplugin.VisibilitySearchAttributes)
+		}
+		rowSearchAttributes[name] = value
+	}
+
+	stateTransitionCount := request.StateTransitionCount
+	return &sqlplugin.VisibilityRow{
+		NamespaceID:          request.NamespaceID,
+		WorkflowID:           request.WorkflowID,
+		RunID:                request.RunID,
+		StartTime:            request.StartTime,
+		ExecutionTime:        request.ExecutionTime,
+		WorkflowTypeName:     request.WorkflowTypeName,
+		Status:               int32(request.Status),
+		Memo:                 request.Memo.GetData(),
+		Encoding:             request.Memo.GetEncodingType().String(),
+		TaskQueue:            request.TaskQueue,
+		StateTransitionCount: &stateTransitionCount,
+		SearchAttributes:     rowSearchAttributes,
+	}, nil
+}
+
+func (s *visibilityStore) rowToInfo(
+	row *sqlplugin.VisibilityRow,
+	typeMap searchattribute.NameTypeMap,
+	namespaceName namespace.Name,
+) (*store.InternalWorkflowExecutionInfo, error) {
+	executionTime := row.ExecutionTime
+	if executionTime.UnixNano() == 0 {
+		executionTime = row.StartTime
+	}
+	info := &store.InternalWorkflowExecutionInfo{
+		WorkflowID:    row.WorkflowID,
+		RunID:         row.RunID,
+		TypeName:      row.WorkflowTypeName,
+		StartTime:     row.StartTime,
+		ExecutionTime: executionTime,
+		Memo:          persistence.NewDataBlob(row.Memo, row.Encoding),
+		Status:        enumspb.WorkflowExecutionStatus(row.Status),
+		TaskQueue:     row.TaskQueue,
+	}
+	if row.CloseTime != nil {
+		info.CloseTime = *row.CloseTime
+	}
+	if row.HistoryLength != nil {
+		info.HistoryLength = *row.HistoryLength
+	}
+	if row.StateTransitionCount != nil {
+		info.StateTransitionCount = *row.StateTransitionCount
+	}
+	if row.HistorySizeBytes != nil {
+		info.HistorySizeBytes = *row.HistorySizeBytes
+	}
+
+	searchAttributes, err := s.parseSearchAttributes(row, typeMap, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+	info.SearchAttributes = searchAttributes
+	return info, nil
+}
+
+func (s *visibilityStore) parseSearchAttributes(
+	row *sqlplugin.VisibilityRow,
+	typeMap searchattribute.NameTypeMap,
+	namespaceName namespace.Name,
+) (*commonpb.SearchAttributes, error) {
+	if len(row.SearchAttributes) == 0 {
+		return nil, nil
+	}
+
+	searchAttributes := make(map[string]interface{}, len(row.SearchAttributes))
+	for name, value := range row.SearchAttributes {
+		fieldType, err := typeMap.GetType(name)
+		if err != nil {
+			// Silently ignore ErrInvalidName because it indicates search attribute which was removed.
+			if errors.Is(err, searchattribute.ErrInvalidName) {
+				continue
+			}
+			return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to get type for search attribute %q: %v", name, err))
+		}
+		parsedValue, err := parseSearchAttributeValue(value, fieldType)
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to parse search attribute %q of workflow execution %s: %v", name, row.RunID, err))
+		}
+		searchAttributes[name] = parsedValue
+	}
+	if len(searchAttributes) == 0 {
+		return nil, nil
+	}
+
+	encoded, err := searchattribute.Encode(searchAttributes, &typeMap)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to encode search attributes of workflow execution %s: %v", row.RunID, err))
+	}
+	aliased, err := searchattribute.AliasFields(s.searchAttributesMapper, encoded, namespaceName.String())
+	if err != nil {
+		return nil, err
+	}
+	if aliased != nil {
+		encoded = aliased
+	}
+	return encoded, nil
+}
+
+func (s *visibilityStore) deserializePageToken(data []byte, sortFields []*sortField) (*visibilityPageToken, error) {
+	var token visibilityPageToken
+	d := json.NewDecoder(bytes.NewReader(data))
+	// Numbers must not be decoded as float64 to not lose precision of int64 values.
+	d.UseNumber()
+	if err := d.Decode(&token); err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Unable to deserialize page token: %v", err))
+	}
+	if len(token.SortValues) != len(sortFields) {
+		return nil, serviceerror.NewInvalidArgument("Invalid page token: query was changed")
+	}
+
+	for i, value := range token.SortValues {
+		switch v := value.(type) {
+		case json.Number:
+			if intValue, err := v.Int64(); err == nil {
+				token.SortValues[i] = intValue
+			} else if floatValue, err := v.Float64(); err == nil {
+				token.SortValues[i] = floatValue
+			}
+		case string:
+			switch sortFields[i].name {
+			case searchattribute.StartTime, searchattribute.ExecutionTime, searchattribute.CloseTime:
+				t, err := time.Parse(time.RFC3339Nano, v)
+				if err != nil {
+					return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid page token: %v", err))
+				}
+				token.SortValues[i] = t
+			}
+		}
+	}
+	return &token, nil
+}
+
+func (s *visibilityStore) serializePageToken(token *visibilityPageToken) ([]byte, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to serialize page token: %v", err))
+	}
+	return data, nil
+}
+
+// sortValues returns values of sort fields of the row, in the same form as they are compared in SQL.
+func sortValues(row *sqlplugin.VisibilityRow, sortFields []*sortField) []interface{} {
+	values := make([]interface{}, len(sortFields))
+	for i, field := range sortFields {
+		var value interface{}
+		switch field.name {
+		case searchattribute.WorkflowID:
+			value = row.WorkflowID
+		case searchattribute.RunID:
+			value = row.RunID
+		case searchattribute.WorkflowType:
+			value = row.WorkflowTypeName
+		case searchattribute.StartTime:
+			value = row.StartTime
+		case searchattribute.ExecutionTime:
+			value = row.ExecutionTime
+		case searchattribute.CloseTime:
+			if row.CloseTime != nil {
+				value = *row.CloseTime
+			}
+		case searchattribute.ExecutionStatus:
+			value = row.Status
+		case searchattribute.TaskQueue:
+			value = row.TaskQueue
+		case searchattribute.HistoryLength:
+			value = int64PtrValue(row.HistoryLength)
+		case searchattribute.ExecutionDuration:
+			value = int64PtrValue(row.ExecutionDuration)
+		case searchattribute.StateTransitionCount:
+			value = int64PtrValue(row.StateTransitionCount)
+		case searchattribute.HistorySizeBytes:
+			value = int64PtrValue(row.HistorySizeBytes)
+		default:
+			value = jsonSortValue(row.SearchAttributes[field.name])
+		}
+		values[i] = value
+	}
+	return values
+}
+
+func int64PtrValue(v *int64) interface{} {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
+
+// jsonSortValue converts JSON value to the value returned by json_extract.
+func jsonSortValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if intValue, err := v.Int64(); err == nil {
+			return intValue
+		}
+		floatValue, _ := v.Float64()
+		return floatValue
+	case []interface{}:
+		// json_extract returns arrays as JSON text.
+		var buf bytes.Buffer
+		encoder := json.NewEncoder(&buf)
+		encoder.SetEscapeHTML(false)
+		_ = encoder.Encode(v)
+		return string(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+	default:
+		return v
+	}
+}
+
+// parseSearchAttributeValue converts JSON value of a search attribute to the type expected by searchattribute.Encode.
+func parseSearchAttributeValue(value interface{}, t enumspb.IndexedValueType) (interface{}, error) {
+	// Custom search attributes support array of particular type.
+	if arrayValue, isArray := value.([]interface{}); isArray {
+		result := make([]interface{}, len(arrayValue))
+		for i, v := range arrayValue {
+			var err error
+			if result[i], err = parseSearchAttributeValue(v, t); err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	}
+
+	switch t {
+	case enumspb.INDEXED_VALUE_TYPE_TEXT, enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		stringValue, isString := value.(string)
+		if !isString {
+			return nil, fmt.Errorf("expected string got %T", value)
+		}
+		if t == enumspb.INDEXED_VALUE_TYPE_DATETIME {
+			return time.Parse(time.RFC3339Nano, stringValue)
+		}
+		return stringValue, nil
+	case enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		numberValue, isNumber := value.(json.Number)
+		if !isNumber {
+			return nil, fmt.Errorf("expected number got %T", value)
+		}
+		if t == enumspb.INDEXED_VALUE_TYPE_INT {
+			return numberValue.Int64()
+		}
+		return numberValue.Float64()
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		boolValue, isBool := value.(bool)
+		if !isBool {
+			return nil, fmt.Errorf("expected bool got %T", value)
+		}
+		return boolValue, nil
+	default:
+		return nil, fmt.Errorf("unknown search attribute type %v", t)
+	}
+}
+
+// keywordCondition matches keyword search attribute by value or by prefix.
+func keywordCondition(name string, value string, prefixMatch bool) sqlExpr {
+	if prefixMatch {
+		return fieldCondition(name, false, "instr(%s, ?) = 1", value)
+	}
+	return fieldCondition(name, false, "%s = ?", value)
+}
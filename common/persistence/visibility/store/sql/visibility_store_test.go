@@ -0,0 +1,357 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	testNamespaceID = namespace.ID("test-namespace-id")
+	testNamespace   = namespace.Name("test-namespace")
+)
+
+type (
+	visibilityStoreSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		disableOrderByClause bool
+		store                *visibilityStore
+		startTime            time.Time
+	}
+)
+
+func TestVisibilityStoreSuite(t *testing.T) {
+	suite.Run(t, new(visibilityStoreSuite))
+}
+
+func (s *visibilityStoreSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.disableOrderByClause = false
+	cfg := config.SQL{
+		PluginName:        sqlite.PluginName,
+		DatabaseName:      primitives.NewUUID().String(),
+		ConnectAttributes: map[string]string{"mode": "memory", "cache": "private"},
+	}
+	var err error
+	s.store, err = NewVisibilityStore(
+		cfg,
+		resolver.NewNoopResolver(),
+		"test-index",
+		searchattribute.NewTestProvider(),
+		nil,
+		func() bool { return s.disableOrderByClause },
+		log.NewNoopLogger(),
+	)
+	s.NoError(err)
+	s.startTime = time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+}
+
+func (s *visibilityStoreSuite) TearDownTest() {
+	s.store.Close()
+}
+
+func (s *visibilityStoreSuite) TestGetWorkflowExecution() {
+	runID := s.recordStarted("wid", "wtype", map[string]interface{}{
+		"CustomKeywordField": "foo",
+		"CustomIntField":     int64(42),
+	})
+
+	response, err := s.store.GetWorkflowExecution(context.Background(), &manager.GetWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		RunID:       runID,
+	})
+	s.NoError(err)
+	info := response.Execution
+	s.Equal("wid", info.WorkflowID)
+	s.Equal("wtype", info.TypeName)
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, info.Status)
+	s.True(s.startTime.Equal(info.StartTime))
+	searchAttributes, err := searchattribute.Decode(info.SearchAttributes, &searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Equal("foo", searchAttributes["CustomKeywordField"])
+	s.Equal(int64(42), searchAttributes["CustomIntField"])
+
+	_, err = s.store.GetWorkflowExecution(context.Background(), &manager.GetWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		RunID:       primitives.NewUUID().String(),
+	})
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
+func (s *visibilityStoreSuite) TestListWorkflowExecutions_Query() {
+	s.recordStarted("order-1", "wtype", map[string]interface{}{"CustomKeywordField": "foo"})
+	s.recordStarted("order-2", "wtype", map[string]interface{}{"CustomKeywordField": "bar"})
+	s.recordStarted("payment-1", "wtype", map[string]interface{}{"CustomKeywordField": "foo"})
+
+	testCases := []struct {
+		query       string
+		workflowIDs []string
+	}{
+		{query: ``, workflowIDs: []string{"order-1", "order-2", "payment-1"}},
+		{query: `CustomKeywordField = 'foo'`, workflowIDs: []string{"order-1", "payment-1"}},
+		{query: `WorkflowId STARTS_WITH 'order-'`, workflowIDs: []string{"order-1", "order-2"}},
+		{query: `WorkflowId STARTS_WITH 'order-' AND CustomKeywordField = 'foo'`, workflowIDs: []string{"order-1"}},
+		{query: `CustomKeywordField IN ('bar', 'baz')`, workflowIDs: []string{"order-2"}},
+	}
+	for _, tc := range testCases {
+		response, err := s.store.ListWorkflowExecutions(context.Background(), &manager.ListWorkflowExecutionsRequestV2{
+			NamespaceID: testNamespaceID,
+			Namespace:   testNamespace,
+			PageSize:    10,
+			Query:       tc.query,
+		})
+		s.NoError(err, tc.query)
+		s.ElementsMatch(tc.workflowIDs, workflowIDs(response.Executions), tc.query)
+		s.Nil(response.NextPageToken, tc.query)
+	}
+
+	_, err := s.store.ListWorkflowExecutions(context.Background(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		PageSize:    10,
+		Query:       `UnknownField = 'foo'`,
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *visibilityStoreSuite) TestListWorkflowExecutions_Pagination() {
+	var expected []string
+	for i := 0; i < 5; i++ {
+		workflowID := primitives.NewUUID().String()
+		s.recordStarted(workflowID, "wtype", map[string]interface{}{"CustomIntField": int64(i)})
+		expected = append(expected, workflowID)
+	}
+
+	for _, query := range []string{``, `ORDER BY CustomIntField DESC`} {
+		var listed []string
+		var pageToken []byte
+		for {
+			response, err := s.store.ListWorkflowExecutions(context.Background(), &manager.ListWorkflowExecutionsRequestV2{
+				NamespaceID:   testNamespaceID,
+				Namespace:     testNamespace,
+				PageSize:      2,
+				NextPageToken: pageToken,
+				Query:         query,
+			})
+			s.NoError(err)
+			listed = append(listed, workflowIDs(response.Executions)...)
+			if len(response.NextPageToken) == 0 {
+				break
+			}
+			pageToken = response.NextPageToken
+		}
+		s.ElementsMatch(expected, listed, query)
+	}
+
+	// Workflows are ordered by the ORDER BY field.
+	response, err := s.store.ListWorkflowExecutions(context.Background(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		PageSize:    5,
+		Query:       `ORDER BY CustomIntField DESC`,
+	})
+	s.NoError(err)
+	s.Equal([]string{expected[4], expected[3], expected[2], expected[1], expected[0]}, workflowIDs(response.Executions))
+
+	s.disableOrderByClause = true
+	_, err = s.store.ListWorkflowExecutions(context.Background(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		PageSize:    5,
+		Query:       `ORDER BY CustomIntField DESC`,
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *visibilityStoreSuite) TestRecordClosedAndListByStatus() {
+	s.recordStarted("open", "wtype", nil)
+	closedRunID := s.recordStarted("closed", "wtype", nil)
+	s.recordClosed(closedRunID, "closed", "wtype", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+
+	request := &manager.ListWorkflowExecutionsRequest{
+		NamespaceID:       testNamespaceID,
+		Namespace:         testNamespace,
+		EarliestStartTime: s.startTime.Add(-time.Hour),
+		LatestStartTime:   s.startTime.Add(time.Hour),
+		PageSize:          10,
+	}
+	open, err := s.store.ListOpenWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Equal([]string{"open"}, workflowIDs(open.Executions))
+
+	closed, err := s.store.ListClosedWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Equal([]string{"closed"}, workflowIDs(closed.Executions))
+	s.Equal(int64(10), closed.Executions[0].HistoryLength)
+
+	byStatus, err := s.store.ListClosedWorkflowExecutionsByStatus(context.Background(), &manager.ListClosedWorkflowExecutionsByStatusRequest{
+		ListWorkflowExecutionsRequest: request,
+		Status:                        enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+	})
+	s.NoError(err)
+	s.Empty(byStatus.Executions)
+
+	byType, err := s.store.ListOpenWorkflowExecutionsByType(context.Background(), &manager.ListWorkflowExecutionsByTypeRequest{
+		ListWorkflowExecutionsRequest: request,
+		WorkflowTypeName:              "wty",
+		PrefixMatch:                   true,
+	})
+	s.NoError(err)
+	s.Equal([]string{"open"}, workflowIDs(byType.Executions))
+}
+
+func (s *visibilityStoreSuite) TestCountWorkflowExecutions_GroupBy() {
+	s.recordStarted("open-1", "wtype", nil)
+	s.recordStarted("open-2", "wtype", nil)
+	closedRunID := s.recordStarted("closed", "wtype", nil)
+	s.recordClosed(closedRunID, "closed", "wtype", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+
+	response, err := s.store.CountWorkflowExecutions(context.Background(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       `GROUP BY ExecutionStatus`,
+	})
+	s.NoError(err)
+	s.Equal(int64(3), response.Count)
+	counts := make(map[string]int64)
+	for _, group := range response.Groups {
+		var status string
+		s.NoError(payload.Decode(group.GroupValues[0], &status))
+		counts[status] = group.Count
+	}
+	s.Equal(map[string]int64{"Running": 2, "Completed": 1}, counts)
+
+	response, err = s.store.CountWorkflowExecutions(context.Background(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       `ExecutionStatus = 'Running'`,
+	})
+	s.NoError(err)
+	s.Equal(int64(2), response.Count)
+	s.Empty(response.Groups)
+}
+
+func (s *visibilityStoreSuite) TestUpsertAndDelete() {
+	runID := s.recordStarted("wid", "wtype", map[string]interface{}{"CustomKeywordField": "foo"})
+
+	searchAttributes, err := searchattribute.Encode(map[string]interface{}{"CustomKeywordField": "bar"}, &searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	err = s.store.UpsertWorkflowExecution(context.Background(), &store.InternalUpsertWorkflowExecutionRequest{
+		InternalVisibilityRequestBase: s.requestBase(runID, "wid", "wtype", searchAttributes),
+	})
+	s.NoError(err)
+	response, err := s.store.ListWorkflowExecutions(context.Background(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		PageSize:    10,
+		Query:       `CustomKeywordField = 'bar'`,
+	})
+	s.NoError(err)
+	s.Equal([]string{"wid"}, workflowIDs(response.Executions))
+
+	err = s.store.DeleteWorkflowExecution(context.Background(), &manager.VisibilityDeleteWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		RunID:       runID,
+	})
+	s.NoError(err)
+	_, err = s.store.GetWorkflowExecution(context.Background(), &manager.GetWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		RunID:       runID,
+	})
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
+func (s *visibilityStoreSuite) recordStarted(workflowID string, workflowType string, searchAttributes map[string]interface{}) string {
+	runID := primitives.NewUUID().String()
+	encoded, err := searchattribute.Encode(searchAttributes, &searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	err = s.store.RecordWorkflowExecutionStarted(context.Background(), &store.InternalRecordWorkflowExecutionStartedRequest{
+		InternalVisibilityRequestBase: s.requestBase(runID, workflowID, workflowType, encoded),
+	})
+	s.NoError(err)
+	return runID
+}
+
+func (s *visibilityStoreSuite) recordClosed(runID string, workflowID string, workflowType string, status enumspb.WorkflowExecutionStatus) {
+	base := s.requestBase(runID, workflowID, workflowType, nil)
+	base.Status = status
+	err := s.store.RecordWorkflowExecutionClosed(context.Background(), &store.InternalRecordWorkflowExecutionClosedRequest{
+		InternalVisibilityRequestBase: base,
+		CloseTime:                     s.startTime.Add(time.Minute),
+		HistoryLength:                 10,
+	})
+	s.NoError(err)
+}
+
+func (s *visibilityStoreSuite) requestBase(
+	runID string,
+	workflowID string,
+	workflowType string,
+	searchAttributes *commonpb.SearchAttributes,
+) *store.InternalVisibilityRequestBase {
+	return &store.InternalVisibilityRequestBase{
+		NamespaceID:      testNamespaceID.String(),
+		WorkflowID:       workflowID,
+		RunID:            runID,
+		WorkflowTypeName: workflowType,
+		StartTime:        s.startTime,
+		ExecutionTime:    s.startTime,
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		TaskQueue:        "test-task-queue",
+		SearchAttributes: searchAttributes,
+	}
+}
+
+func workflowIDs(executions []*store.InternalWorkflowExecutionInfo) []string {
+	var ids []string
+	for _, execution := range executions {
+		ids = append(ids, execution.WorkflowID)
+	}
+	return ids
+}
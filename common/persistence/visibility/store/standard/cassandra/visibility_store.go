@@ -287,6 +287,9 @@ func (v *visibilityStore) ListOpenWorkflowExecutionsByType(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsByTypeRequest,
 ) (*store.InternalListWorkflowExecutionsResponse, error) {
+	if request.PrefixMatch {
+		return nil, store.PrefixMatchNotSupportedErr
+	}
 	query := v.session.Query(
 		templateGetOpenWorkflowExecutionsByType,
 		request.NamespaceID.String(),
@@ -318,6 +321,9 @@ func (v *visibilityStore) ListOpenWorkflowExecutionsByWorkflowID(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsByWorkflowIDRequest,
 ) (*store.InternalListWorkflowExecutionsResponse, error) {
+	if request.PrefixMatch {
+		return nil, store.PrefixMatchNotSupportedErr
+	}
 	query := v.session.Query(
 		templateGetOpenWorkflowExecutionsByID,
 		request.NamespaceID.String(),
@@ -378,6 +384,9 @@ func (v *visibilityStore) ListClosedWorkflowExecutionsByType(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsByTypeRequest,
 ) (*store.InternalListWorkflowExecutionsResponse, error) {
+	if request.PrefixMatch {
+		return nil, store.PrefixMatchNotSupportedErr
+	}
 	query := v.session.Query(
 		templateGetClosedWorkflowExecutionsByType,
 		request.NamespaceID.String(),
@@ -409,6 +418,9 @@ func (v *visibilityStore) ListClosedWorkflowExecutionsByWorkflowID(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsByWorkflowIDRequest,
 ) (*store.InternalListWorkflowExecutionsResponse, error) {
+	if request.PrefixMatch {
+		return nil, store.PrefixMatchNotSupportedErr
+	}
 	query := v.session.Query(templateGetClosedWorkflowExecutionsByID,
 		request.NamespaceID.String(),
 		namespacePartition,
@@ -36,7 +36,8 @@ import (
 )
 
 var allowedComparisonOperators = map[string]struct{}{
-	sqlparser.EqualStr: {},
+	sqlparser.EqualStr:  {},
+	query.StartsWithStr: {},
 }
 
 type (
@@ -47,8 +48,9 @@ type (
 )
 
 func newQueryConverter() *converter {
-	fnInterceptor := newNameInterceptor()
-	fvInterceptor := newValuesInterceptor()
+	filter := &sqlplugin.VisibilitySelectFilter{}
+	fnInterceptor := newNameInterceptor(filter)
+	fvInterceptor := newValuesInterceptor(filter)
 
 	rangeCond := query.NewRangeCondConverter(fnInterceptor, fvInterceptor, false)
 	comparisonExpr := query.NewComparisonExprConverter(fnInterceptor, fvInterceptor, allowedComparisonOperators)
@@ -67,17 +69,36 @@ func newQueryConverter() *converter {
 	}
 }
 
+// GetCountFilter parses count query of standard visibility. It returns the same filter as
+// list query does and names of GROUP BY fields.
+func GetCountFilter(whereGroupBy string) (*sqlplugin.VisibilitySelectFilter, []string, error) {
+	return newQueryConverter().GetCountFilter(whereGroupBy)
+}
+
 func (c *converter) GetFilter(whereOrderBy string) (*sqlplugin.VisibilitySelectFilter, error) {
 	_, _, err := c.ConvertWhereOrderBy(whereOrderBy)
 	if err != nil {
-		// Convert ConverterError to InvalidArgument and pass through all other errors.
-		var converterErr *query.ConverterError
-		if errors.As(err, &converterErr) {
-			return nil, converterErr.ToInvalidArgument()
-		}
-		return nil, err
+		return nil, convertQueryError(err)
+	}
+
+	return c.getFilter()
+}
+
+// GetCountFilter returns filter of count query and names of GROUP BY fields.
+func (c *converter) GetCountFilter(whereGroupBy string) (*sqlplugin.VisibilitySelectFilter, []string, error) {
+	_, groupBy, err := c.ConvertWhereGroupBy(whereGroupBy)
+	if err != nil {
+		return nil, nil, convertQueryError(err)
 	}
 
+	filter, err := c.getFilter()
+	if err != nil {
+		return nil, nil, err
+	}
+	return filter, groupBy, nil
+}
+
+func (c *converter) getFilter() (*sqlplugin.VisibilitySelectFilter, error) {
 	filter := c.fvInterceptor.filter
 	numPredicates := 0
 
@@ -109,3 +130,12 @@ func (c *converter) GetFilter(whereOrderBy string) (*sqlplugin.VisibilitySelectF
 
 	return filter, nil
 }
+
+// convertQueryError converts ConverterError to InvalidArgument and passes through all other errors.
+func convertQueryError(err error) error {
+	var converterErr *query.ConverterError
+	if errors.As(err, &converterErr) {
+		return converterErr.ToInvalidArgument()
+	}
+	return err
+}
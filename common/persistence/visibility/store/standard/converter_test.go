@@ -44,14 +44,16 @@ var supportedQuery = map[string]*sqlplugin.VisibilitySelectFilter{
 	"":                   {},
 	startTimeRangeFilter: {MinTime: &startTimeFrom, MaxTime: &startTimeTo},
 	`WorkflowId = "abc"`: {WorkflowID: convert.StringPtr("abc")},
-	`WorkflowId = "abc" AND ` + startTimeRangeFilter:          {WorkflowID: convert.StringPtr("abc"), MinTime: &startTimeFrom, MaxTime: &startTimeTo},
-	startTimeRangeFilter + ` AND WorkflowId = "abc"`:          {WorkflowID: convert.StringPtr("abc"), MinTime: &startTimeFrom, MaxTime: &startTimeTo},
-	`WorkflowType = "abc"`:                                    {WorkflowTypeName: convert.StringPtr("abc")},
-	`WorkflowType = "abc" AND ` + startTimeRangeFilter:        {WorkflowTypeName: convert.StringPtr("abc"), MinTime: &startTimeFrom, MaxTime: &startTimeTo},
-	startTimeRangeFilter + ` AND WorkflowType = "abc"`:        {WorkflowTypeName: convert.StringPtr("abc"), MinTime: &startTimeFrom, MaxTime: &startTimeTo},
-	`ExecutionStatus = "Running"`:                             {Status: int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)},
-	`ExecutionStatus = "Running" AND ` + startTimeRangeFilter: {Status: int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING), MinTime: &startTimeFrom, MaxTime: &startTimeTo},
-	startTimeRangeFilter + ` AND ExecutionStatus = "Running"`: {Status: int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING), MinTime: &startTimeFrom, MaxTime: &startTimeTo},
+	`WorkflowId = "abc" AND ` + startTimeRangeFilter:             {WorkflowID: convert.StringPtr("abc"), MinTime: &startTimeFrom, MaxTime: &startTimeTo},
+	startTimeRangeFilter + ` AND WorkflowId = "abc"`:             {WorkflowID: convert.StringPtr("abc"), MinTime: &startTimeFrom, MaxTime: &startTimeTo},
+	`WorkflowType = "abc"`:                                       {WorkflowTypeName: convert.StringPtr("abc")},
+	`WorkflowType = "abc" AND ` + startTimeRangeFilter:           {WorkflowTypeName: convert.StringPtr("abc"), MinTime: &startTimeFrom, MaxTime: &startTimeTo},
+	startTimeRangeFilter + ` AND WorkflowType = "abc"`:           {WorkflowTypeName: convert.StringPtr("abc"), MinTime: &startTimeFrom, MaxTime: &startTimeTo},
+	`ExecutionStatus = "Running"`:                                {Status: int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)},
+	`ExecutionStatus = "Running" AND ` + startTimeRangeFilter:    {Status: int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING), MinTime: &startTimeFrom, MaxTime: &startTimeTo},
+	startTimeRangeFilter + ` AND ExecutionStatus = "Running"`:    {Status: int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING), MinTime: &startTimeFrom, MaxTime: &startTimeTo},
+	`WorkflowId STARTS_WITH "order-"`:                            {WorkflowID: convert.StringPtr("order-"), PrefixMatch: true},
+	`WorkflowType starts_with "abc" AND ` + startTimeRangeFilter: {WorkflowTypeName: convert.StringPtr("abc"), PrefixMatch: true, MinTime: &startTimeFrom, MaxTime: &startTimeTo},
 }
 
 // This is not an exhaustive list.
@@ -63,6 +65,8 @@ var unsupportedQuery = []string{
 	`WorkflowID = "abc" OR WorkflowType = "xyz"`,
 	`WorkflowID != "abc"`,
 	`StartTime < "2022-01-15T05:43:12.74127Z"`,
+	`ExecutionStatus STARTS_WITH "Run"`,
+	`WorkflowId STARTS_WITH "abc" AND WorkflowType = "xyz"`,
 }
 
 func TestSupportedQueryFilters(t *testing.T) {
@@ -74,6 +78,7 @@ func TestSupportedQueryFilters(t *testing.T) {
 		assert.EqualValues(t, expectedFilter.WorkflowID, filter.WorkflowID)
 		assert.EqualValues(t, expectedFilter.WorkflowTypeName, filter.WorkflowTypeName)
 		assert.EqualValues(t, expectedFilter.Status, filter.Status)
+		assert.Equal(t, expectedFilter.PrefixMatch, filter.PrefixMatch)
 
 		if expectedFilter.MinTime == nil {
 			assert.True(t, time.Unix(0, 0).Equal(*filter.MinTime))
@@ -98,3 +103,27 @@ func TestUnsupportedQueryFilters(t *testing.T) {
 		assert.Error(t, err)
 	}
 }
+
+func TestCountQueryFilters(t *testing.T) {
+	filter, groupBy, err := GetCountFilter(`WorkflowType = "abc" GROUP BY ExecutionStatus`)
+	assert.NoError(t, err)
+	assert.EqualValues(t, convert.StringPtr("abc"), filter.WorkflowTypeName)
+	assert.Equal(t, []string{"ExecutionStatus"}, groupBy)
+
+	filter, groupBy, err = GetCountFilter(`ExecutionStatus = "Running"`)
+	assert.NoError(t, err)
+	assert.EqualValues(t, int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING), filter.Status)
+	assert.Empty(t, groupBy)
+
+	_, _, err = GetCountFilter(`group by WorkflowType`)
+	assert.NoError(t, err)
+
+	for _, query := range []string{
+		`GROUP BY WorkflowId`,
+		`GROUP BY ExecutionStatus, WorkflowType`,
+		`WorkflowID = "abc" AND WorkflowType = "xyz" GROUP BY ExecutionStatus`,
+	} {
+		_, _, err = GetCountFilter(query)
+		assert.Error(t, err, query)
+	}
+}
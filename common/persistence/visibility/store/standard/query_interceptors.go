@@ -44,21 +44,35 @@ var allowedFilters = []string{
 	searchattribute.StartTime,
 }
 
+var allowedPrefixFilters = []string{
+	searchattribute.WorkflowID,
+	searchattribute.WorkflowType,
+}
+
+var allowedGroupBy = []string{
+	searchattribute.ExecutionStatus,
+	searchattribute.WorkflowType,
+}
+
 type (
-	nameInterceptor   struct{}
+	nameInterceptor struct {
+		filter *sqlplugin.VisibilitySelectFilter
+	}
 	valuesInterceptor struct {
 		filter          *sqlplugin.VisibilitySelectFilter
 		nextInterceptor query.FieldValuesInterceptor
 	}
 )
 
-func newNameInterceptor() *nameInterceptor {
-	return &nameInterceptor{}
+func newNameInterceptor(filter *sqlplugin.VisibilitySelectFilter) *nameInterceptor {
+	return &nameInterceptor{
+		filter: filter,
+	}
 }
 
-func newValuesInterceptor() *valuesInterceptor {
+func newValuesInterceptor(filter *sqlplugin.VisibilitySelectFilter) *valuesInterceptor {
 	return &valuesInterceptor{
-		filter:          &sqlplugin.VisibilitySelectFilter{},
+		filter:          filter,
 		nextInterceptor: elasticsearch.NewValuesInterceptor(),
 	}
 }
@@ -68,6 +82,25 @@ func (ni *nameInterceptor) Name(name string, usage query.FieldNameUsage) (string
 		return "", query.NewConverterError("order by not allowed for standard visibility")
 	}
 
+	if usage == query.FieldNamePrefixFilter {
+		for _, filter := range allowedPrefixFilters {
+			if filter == name {
+				ni.filter.PrefixMatch = true
+				return name, nil
+			}
+		}
+		return "", query.NewConverterError("filter by '%v' prefix not supported for standard visibility", name)
+	}
+
+	if usage == query.FieldNameGroupBy {
+		for _, groupBy := range allowedGroupBy {
+			if groupBy == name {
+				return name, nil
+			}
+		}
+		return "", query.NewConverterError("group by '%v' not supported for standard visibility", name)
+	}
+
 	for _, filter := range allowedFilters {
 		if filter == name {
 			return name, nil
@@ -30,6 +30,7 @@ import (
 	"fmt"
 	"time"
 
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 
@@ -40,7 +41,9 @@ import (
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store"
+	"go.temporal.io/server/common/persistence/visibility/store/standard"
 	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 type (
@@ -56,6 +59,12 @@ type (
 
 var _ store.VisibilityStore = (*visibilityStore)(nil)
 
+// groupByColumns maps fields which count query can be grouped by to visibility table columns.
+var groupByColumns = map[string]string{
+	searchattribute.ExecutionStatus: sqlplugin.VisibilityGroupByStatus,
+	searchattribute.WorkflowType:    sqlplugin.VisibilityGroupByWorkflowTypeName,
+}
+
 // NewSQLVisibilityStore creates an instance of VisibilityStore
 func NewSQLVisibilityStore(
 	cfg config.SQL,
@@ -197,6 +206,7 @@ func (s *visibilityStore) ListOpenWorkflowExecutionsByType(
 				MaxTime:          &readLevel.Time,
 				RunID:            &readLevel.RunID,
 				WorkflowTypeName: &request.WorkflowTypeName,
+				PrefixMatch:      request.PrefixMatch,
 				PageSize:         &request.PageSize,
 				Status:           int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
 			})
@@ -219,6 +229,7 @@ func (s *visibilityStore) ListClosedWorkflowExecutionsByType(
 				MaxTime:          &readLevel.Time,
 				RunID:            &readLevel.RunID,
 				WorkflowTypeName: &request.WorkflowTypeName,
+				PrefixMatch:      request.PrefixMatch,
 				PageSize:         &request.PageSize,
 			})
 		})
@@ -240,6 +251,7 @@ func (s *visibilityStore) ListOpenWorkflowExecutionsByWorkflowID(
 				MaxTime:     &readLevel.Time,
 				RunID:       &readLevel.RunID,
 				WorkflowID:  &request.WorkflowID,
+				PrefixMatch: request.PrefixMatch,
 				PageSize:    &request.PageSize,
 				Status:      int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
 			})
@@ -262,6 +274,7 @@ func (s *visibilityStore) ListClosedWorkflowExecutionsByWorkflowID(
 				MaxTime:     &readLevel.Time,
 				RunID:       &readLevel.RunID,
 				WorkflowID:  &request.WorkflowID,
+				PrefixMatch: request.PrefixMatch,
 				PageSize:    &request.PageSize,
 			})
 		})
@@ -317,10 +330,49 @@ func (s *visibilityStore) ScanWorkflowExecutions(
 }
 
 func (s *visibilityStore) CountWorkflowExecutions(
-	_ context.Context,
-	_ *manager.CountWorkflowExecutionsRequest,
+	ctx context.Context,
+	request *manager.CountWorkflowExecutionsRequest,
 ) (*manager.CountWorkflowExecutionsResponse, error) {
-	return nil, store.OperationNotSupportedErr
+	filter, groupBy, err := standard.GetCountFilter(request.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	countFilter := sqlplugin.VisibilityCountFilter{
+		NamespaceID:      request.NamespaceID.String(),
+		WorkflowID:       filter.WorkflowID,
+		WorkflowTypeName: filter.WorkflowTypeName,
+		PrefixMatch:      filter.PrefixMatch,
+		Status:           filter.Status,
+		MinTime:          *filter.MinTime,
+		MaxTime:          *filter.MaxTime,
+	}
+	if len(groupBy) > 0 {
+		countFilter.GroupBy = groupByColumns[groupBy[0]]
+	}
+
+	rows, err := s.sqlStore.Db.CountFromVisibility(ctx, countFilter)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(
+			fmt.Sprintf("CountWorkflowExecutions operation failed. Select failed: %v", err))
+	}
+
+	response := &manager.CountWorkflowExecutionsResponse{}
+	for _, row := range rows {
+		response.Count += row.Count
+		if len(groupBy) == 0 || row.GroupValue == nil {
+			continue
+		}
+		groupValue, err := store.EncodeCountGroupValue(groupBy[0], *row.GroupValue)
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to encode %s group value: %v", groupBy[0], err))
+		}
+		response.Groups = append(response.Groups, manager.CountWorkflowExecutionsGroup{
+			GroupValues: []*commonpb.Payload{groupValue},
+			Count:       row.Count,
+		})
+	}
+	return response, nil
 }
 
 func (s *visibilityStore) GetWorkflowExecution(
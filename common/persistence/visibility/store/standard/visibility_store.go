@@ -197,6 +197,7 @@ func (s *standardStore) ListWorkflowExecutions(
 		request := &manager.ListWorkflowExecutionsByWorkflowIDRequest{
 			ListWorkflowExecutionsRequest: baseReq,
 			WorkflowID:                    *filter.WorkflowID,
+			PrefixMatch:                   filter.PrefixMatch,
 		}
 		return s.listWorkflowExecutionsHelper(
 			ctx,
@@ -207,6 +208,7 @@ func (s *standardStore) ListWorkflowExecutions(
 		request := &manager.ListWorkflowExecutionsByTypeRequest{
 			ListWorkflowExecutionsRequest: baseReq,
 			WorkflowTypeName:              *filter.WorkflowTypeName,
+			PrefixMatch:                   filter.PrefixMatch,
 		}
 		return s.listWorkflowExecutionsHelper(
 			ctx,
@@ -49,6 +49,7 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/deadlock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -93,6 +94,7 @@ type (
 // See LifetimeHooksModule for detail
 var Module = fx.Options(
 	persistenceClient.Module,
+	fx.Provide(persistenceClient.FaultInjectionRulesProvider),
 	fx.Provide(SnTaggedLoggerProvider),
 	fx.Provide(HostNameProvider),
 	fx.Provide(TimeSourceProvider),
@@ -248,6 +250,31 @@ func MembershipMonitorProvider(
 
 	rpcConfig := cfg.Services[string(svcName)].RPC
 
+	if cfg.Global.Membership.Provider == config.MembershipProviderStatic {
+		monitor, err := membership.NewShardOwnershipOverrideMonitor(
+			staticMembershipMonitor(&cfg.Global.Membership, svcName, servicePortMap, logger, clusterMetadataManager, &rpcConfig),
+			clusterMetadataManager,
+			dc.GetDurationProperty(dynamicconfig.ShardOwnershipOverrideRefreshInterval, 10*time.Second),
+			logger,
+		)
+		if err != nil {
+			return nil, err
+		}
+		lc.Append(
+			fx.Hook{
+				OnStart: func(context.Context) error {
+					monitor.Start()
+					return nil
+				},
+				OnStop: func(context.Context) error {
+					monitor.Stop()
+					return nil
+				},
+			},
+		)
+		return monitor, nil
+	}
+
 	factory, err := ringpop.NewRingpopFactory(
 		&cfg.Global.Membership,
 		svcName,
@@ -266,6 +293,15 @@ func MembershipMonitorProvider(
 	if err != nil {
 		return nil, err
 	}
+	monitor, err = membership.NewShardOwnershipOverrideMonitor(
+		monitor,
+		clusterMetadataManager,
+		dc.GetDurationProperty(dynamicconfig.ShardOwnershipOverrideRefreshInterval, 10*time.Second),
+		logger,
+	)
+	if err != nil {
+		return nil, err
+	}
 
 	lc.Append(
 		fx.Hook{
@@ -284,6 +320,61 @@ func MembershipMonitorProvider(
 	return monitor, nil
 }
 
+func staticMembershipMonitor(
+	membershipConfig *config.Membership,
+	svcName primitives.ServiceName,
+	servicePortMap map[primitives.ServiceName]int,
+	logger log.Logger,
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	rpcConfig *config.RPC,
+) membership.Monitor {
+	sources := make(map[primitives.ServiceName]membership.HostSource)
+	for sn, sc := range membershipConfig.Static.Services {
+		switch {
+		case len(sc.Hosts) > 0:
+			sources[primitives.ServiceName(sn)] = membership.NewStaticHostSource(sc.Hosts)
+		case sc.DNSSRVRecord != "":
+			sources[primitives.ServiceName(sn)] = membership.NewDNSSRVHostSource(sc.DNSSRVRecord)
+		}
+	}
+
+	broadcastHostPortResolver := func() (string, error) {
+		ip, err := membershipBroadcastIP(membershipConfig, rpcConfig)
+		if err != nil {
+			return "", err
+		}
+		return net.JoinHostPort(ip.String(), convert.IntToString(rpcConfig.GRPCPort)), nil
+	}
+
+	return membership.NewStaticMonitor(
+		svcName,
+		servicePortMap,
+		sources,
+		membershipConfig.Static.RefreshInterval,
+		logger,
+		clusterMetadataManager,
+		broadcastHostPortResolver,
+	)
+}
+
+// membershipBroadcastIP returns the IP address other hosts reach this host on
+func membershipBroadcastIP(membershipConfig *config.Membership, rpcConfig *config.RPC) (net.IP, error) {
+	if membershipConfig.BroadcastAddress != "" {
+		ip := net.ParseIP(membershipConfig.BroadcastAddress)
+		if ip == nil {
+			return nil, fmt.Errorf("membership config malformed `broadcastAddress` param")
+		}
+		return ip, nil
+	}
+	if rpcConfig.BindOnLocalHost {
+		return net.IPv4(127, 0, 0, 1), nil
+	}
+	if ip := net.ParseIP(rpcConfig.BindOnIP); ip != nil && !ip.IsUnspecified() {
+		return ip, nil
+	}
+	return config.ListenIP()
+}
+
 func FrontendClientProvider(clientBean client.Bean) workflowservice.WorkflowServiceClient {
 	frontendRawClient := clientBean.GetFrontendClient()
 	return frontend.NewRetryableClient(
@@ -308,11 +399,13 @@ func VisibilityBootstrapContainerProvider(
 	logger log.SnTaggedLogger,
 	metricsHandler metrics.Handler,
 	clusterMetadata cluster.Metadata,
+	namespaceRegistry namespace.Registry,
 ) *archiver.VisibilityBootstrapContainer {
 	return &archiver.VisibilityBootstrapContainer{
-		Logger:          logger,
-		MetricsHandler:  metricsHandler,
-		ClusterMetadata: clusterMetadata,
+		Logger:                     logger,
+		MetricsHandler:             metricsHandler,
+		ClusterMetadata:            clusterMetadata,
+		NamespaceRetentionProvider: &namespaceRetentionProvider{namespaceRegistry: namespaceRegistry},
 	}
 }
 
@@ -321,13 +414,27 @@ func HistoryBootstrapContainerProvider(
 	metricsHandler metrics.Handler,
 	clusterMetadata cluster.Metadata,
 	executionManager persistence.ExecutionManager,
+	namespaceRegistry namespace.Registry,
 ) *archiver.HistoryBootstrapContainer {
 	return &archiver.HistoryBootstrapContainer{
-		ExecutionManager: executionManager,
-		Logger:           logger,
-		MetricsHandler:   metricsHandler,
-		ClusterMetadata:  clusterMetadata,
+		ExecutionManager:           executionManager,
+		Logger:                     logger,
+		MetricsHandler:             metricsHandler,
+		ClusterMetadata:            clusterMetadata,
+		NamespaceRetentionProvider: &namespaceRetentionProvider{namespaceRegistry: namespaceRegistry},
+	}
+}
+
+type namespaceRetentionProvider struct {
+	namespaceRegistry namespace.Registry
+}
+
+func (p *namespaceRetentionProvider) GetNamespaceRetention(namespaceID string) (time.Duration, error) {
+	ns, err := p.namespaceRegistry.GetNamespaceByID(namespace.ID(namespaceID))
+	if err != nil {
+		return 0, err
 	}
+	return ns.Retention(), nil
 }
 
 func RegisterBootstrapContainer(
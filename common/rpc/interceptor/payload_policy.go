@@ -0,0 +1,93 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadpolicy"
+)
+
+type (
+	// PayloadPolicyInterceptor rejects workflow service requests carrying payloads that the policy of the request
+	// namespace does not accept.
+	PayloadPolicyInterceptor struct {
+		namespaceRegistry namespace.Registry
+		policy            payloadpolicy.Policy
+	}
+)
+
+var (
+	// payloadPolicyExcludedAPIs are the APIs workers use to report workflow and query task results. Rejecting a
+	// result would leave the task to time out and be retried with the same payloads, so workflow commands are
+	// checked by history when the workflow task completes instead.
+	payloadPolicyExcludedAPIs = map[string]struct{}{
+		"RespondWorkflowTaskCompleted": {},
+		"RespondWorkflowTaskFailed":    {},
+		"RespondQueryTaskCompleted":    {},
+	}
+)
+
+var _ grpc.UnaryServerInterceptor = (*PayloadPolicyInterceptor)(nil).Intercept
+
+func NewPayloadPolicyInterceptor(
+	namespaceRegistry namespace.Registry,
+	policy payloadpolicy.Policy,
+) *PayloadPolicyInterceptor {
+	return &PayloadPolicyInterceptor{
+		namespaceRegistry: namespaceRegistry,
+		policy:            policy,
+	}
+}
+
+func (i *PayloadPolicyInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if message, ok := req.(proto.Message); ok && i.shouldCheck(info.FullMethod) {
+		if namespaceName := GetNamespace(i.namespaceRegistry, req); namespaceName != namespace.EmptyName {
+			if err := i.policy.Check(namespaceName, message); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return handler(ctx, req)
+}
+
+func (i *PayloadPolicyInterceptor) shouldCheck(fullMethod string) bool {
+	if !strings.HasPrefix(fullMethod, frontendPackagePrefix) {
+		return false
+	}
+	_, methodName := splitMethodName(fullMethod)
+	_, excluded := payloadPolicyExcludedAPIs[methodName]
+	return !excluded
+}
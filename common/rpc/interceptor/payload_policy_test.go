@@ -0,0 +1,175 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/namespace"
+)
+
+type (
+	payloadPolicyInterceptorSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller   *gomock.Controller
+		mockRegistry *namespace.MockRegistry
+		policy       *testPayloadPolicy
+		interceptor  *PayloadPolicyInterceptor
+	}
+
+	testPayloadPolicy struct {
+		checked []namespace.Name
+		err     error
+	}
+)
+
+func TestPayloadPolicyInterceptorSuite(t *testing.T) {
+	suite.Run(t, &payloadPolicyInterceptorSuite{})
+}
+
+func (s *payloadPolicyInterceptorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockRegistry = namespace.NewMockRegistry(s.controller)
+	s.policy = &testPayloadPolicy{}
+	s.interceptor = NewPayloadPolicyInterceptor(s.mockRegistry, s.policy)
+}
+
+func (s *payloadPolicyInterceptorSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (p *testPayloadPolicy) Check(namespaceName namespace.Name, _ proto.Message) error {
+	p.checked = append(p.checked, namespaceName)
+	return p.err
+}
+
+func (s *payloadPolicyInterceptorSuite) intercept(fullMethod string, req interface{}) (bool, error) {
+	handlerCalled := false
+	_, err := s.interceptor.Intercept(
+		context.Background(),
+		req,
+		&grpc.UnaryServerInfo{FullMethod: fullMethod},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			handlerCalled = true
+			return nil, nil
+		},
+	)
+	return handlerCalled, err
+}
+
+func (s *payloadPolicyInterceptorSuite) TestAccepted() {
+	s.mockRegistry.EXPECT().GetNamespace(namespace.Name("test-namespace")).Return(nil, nil)
+
+	handlerCalled, err := s.intercept(
+		frontendPackagePrefix+"StartWorkflowExecution",
+		&workflowservice.StartWorkflowExecutionRequest{Namespace: "test-namespace"},
+	)
+	s.NoError(err)
+	s.True(handlerCalled)
+	s.Equal([]namespace.Name{"test-namespace"}, s.policy.checked)
+}
+
+func (s *payloadPolicyInterceptorSuite) TestRejected() {
+	s.mockRegistry.EXPECT().GetNamespace(namespace.Name("test-namespace")).Return(nil, nil)
+	s.policy.err = serviceerror.NewInvalidArgument("input.payloads[0] violates payload policy")
+
+	handlerCalled, err := s.intercept(
+		frontendPackagePrefix+"SignalWorkflowExecution",
+		&workflowservice.SignalWorkflowExecutionRequest{Namespace: "test-namespace"},
+	)
+	s.Equal(s.policy.err, err)
+	s.False(handlerCalled)
+}
+
+func (s *payloadPolicyInterceptorSuite) TestUnknownNamespaceSkipped() {
+	s.mockRegistry.EXPECT().GetNamespace(namespace.Name("unknown")).Return(nil, serviceerror.NewNamespaceNotFound("unknown"))
+
+	handlerCalled, err := s.intercept(
+		frontendPackagePrefix+"StartWorkflowExecution",
+		&workflowservice.StartWorkflowExecutionRequest{Namespace: "unknown"},
+	)
+	s.NoError(err)
+	s.True(handlerCalled)
+	s.Empty(s.policy.checked)
+}
+
+func (s *payloadPolicyInterceptorSuite) TestNonWorkflowServiceSkipped() {
+	handlerCalled, err := s.intercept(
+		"/temporal.server.api.adminservice.v1.AdminService/DescribeMutableState",
+		&adminservice.DescribeMutableStateRequest{Namespace: "test-namespace"},
+	)
+	s.NoError(err)
+	s.True(handlerCalled)
+	s.Empty(s.policy.checked)
+}
+
+func (s *payloadPolicyInterceptorSuite) TestWorkerTaskResultsSkipped() {
+	for _, tc := range []struct {
+		method string
+		req    interface{}
+	}{
+		{"RespondWorkflowTaskCompleted", &workflowservice.RespondWorkflowTaskCompletedRequest{Namespace: "test-namespace"}},
+		{"RespondWorkflowTaskFailed", &workflowservice.RespondWorkflowTaskFailedRequest{Namespace: "test-namespace"}},
+		{"RespondQueryTaskCompleted", &workflowservice.RespondQueryTaskCompletedRequest{Namespace: "test-namespace"}},
+	} {
+		handlerCalled, err := s.intercept(frontendPackagePrefix+tc.method, tc.req)
+		s.NoError(err, tc.method)
+		s.True(handlerCalled, tc.method)
+	}
+	s.Empty(s.policy.checked)
+}
+
+func (s *payloadPolicyInterceptorSuite) TestActivityTaskResultsRejected() {
+	s.mockRegistry.EXPECT().GetNamespace(namespace.Name("test-namespace")).Return(nil, nil).AnyTimes()
+	s.policy.err = serviceerror.NewInvalidArgument("result.payloads[0] violates payload policy")
+
+	for _, tc := range []struct {
+		method string
+		req    interface{}
+	}{
+		{"RespondActivityTaskCompleted", &workflowservice.RespondActivityTaskCompletedRequest{Namespace: "test-namespace"}},
+		{"RespondActivityTaskFailedById", &workflowservice.RespondActivityTaskFailedByIdRequest{Namespace: "test-namespace"}},
+		{"RespondActivityTaskCanceled", &workflowservice.RespondActivityTaskCanceledRequest{Namespace: "test-namespace"}},
+		{"RecordActivityTaskHeartbeat", &workflowservice.RecordActivityTaskHeartbeatRequest{Namespace: "test-namespace"}},
+	} {
+		handlerCalled, err := s.intercept(frontendPackagePrefix+tc.method, tc.req)
+		s.Equal(s.policy.err, err, tc.method)
+		s.False(handlerCalled, tc.method)
+	}
+}
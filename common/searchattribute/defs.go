@@ -61,7 +61,8 @@ const (
 	TemporalScheduledById      = "TemporalScheduledById"
 
 	// Used by scheduler workflow.
-	TemporalSchedulePaused = "TemporalSchedulePaused"
+	TemporalSchedulePaused            = "TemporalSchedulePaused"
+	TemporalScheduleBlackoutCalendars = "TemporalScheduleBlackoutCalendars"
 
 	ReservedPrefix = "Temporal"
 )
@@ -85,14 +86,15 @@ var (
 
 	// predefined are internal search attributes which are passed and stored in SearchAttributes object together with custom search attributes.
 	predefined = map[string]enumspb.IndexedValueType{
-		TemporalChangeVersion:      enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-		BinaryChecksums:            enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-		BatcherNamespace:           enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-		BatcherUser:                enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-		TemporalScheduledStartTime: enumspb.INDEXED_VALUE_TYPE_DATETIME,
-		TemporalScheduledById:      enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-		TemporalSchedulePaused:     enumspb.INDEXED_VALUE_TYPE_BOOL,
-		TemporalNamespaceDivision:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TemporalChangeVersion:             enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		BinaryChecksums:                   enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		BatcherNamespace:                  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		BatcherUser:                       enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TemporalScheduledStartTime:        enumspb.INDEXED_VALUE_TYPE_DATETIME,
+		TemporalScheduledById:             enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TemporalSchedulePaused:            enumspb.INDEXED_VALUE_TYPE_BOOL,
+		TemporalScheduleBlackoutCalendars: enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TemporalNamespaceDivision:         enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 	}
 
 	// reserved are internal field names that can't be used as search attribute names.
@@ -0,0 +1,69 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package taskfairness defines the fairness key of workflow and activity tasks and how it
+// is read from the header of a workflow start request or a schedule activity command.
+//
+// Fairness keys only interleave dispatch, they do not prevent starvation. Matching dispatches
+// the backlog tasks of a task queue partition that it has read into memory round-robin across
+// fairness keys, but it reads the backlog of each priority level in the order tasks were added
+// and holds at most matching.getTasksBatchSize tasks of a level in memory. A key with a larger
+// backlog than that still delays the tasks of the other keys that were added after it.
+package taskfairness
+
+import (
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/payload"
+)
+
+const (
+	// HeaderKey is the header field that carries the requested fairness key as a string.
+	HeaderKey = "temporal-fairness-key"
+
+	// MaxKeyLength is the maximum length of a fairness key, longer keys are truncated
+	MaxKeyLength = 64
+)
+
+// Normalize truncates key to MaxKeyLength
+func Normalize(key string) string {
+	if len(key) > MaxKeyLength {
+		return key[:MaxKeyLength]
+	}
+	return key
+}
+
+// FromHeader returns the fairness key requested in header, or defaultKey if the header does
+// not request one or the value cannot be decoded.
+func FromHeader(header *commonpb.Header, defaultKey string) string {
+	p, ok := header.GetFields()[HeaderKey]
+	if !ok {
+		return defaultKey
+	}
+	var key string
+	if err := payload.Decode(p, &key); err != nil {
+		return defaultKey
+	}
+	return Normalize(key)
+}
@@ -0,0 +1,54 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package taskfairness
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/payload"
+)
+
+func TestNormalize(t *testing.T) {
+	require.Equal(t, "", Normalize(""))
+	require.Equal(t, "tenant-a", Normalize("tenant-a"))
+	require.Equal(t, strings.Repeat("a", MaxKeyLength), Normalize(strings.Repeat("a", MaxKeyLength+10)))
+}
+
+func TestFromHeader(t *testing.T) {
+	require.Equal(t, "default", FromHeader(nil, "default"))
+	require.Equal(t, "", FromHeader(&commonpb.Header{}, ""))
+
+	header := &commonpb.Header{Fields: map[string]*commonpb.Payload{HeaderKey: payload.EncodeString("tenant-a")}}
+	require.Equal(t, "tenant-a", FromHeader(header, "default"))
+
+	p, err := payload.Encode(5)
+	require.NoError(t, err)
+	header.Fields[HeaderKey] = p
+	require.Equal(t, "default", FromHeader(header, "default"))
+}
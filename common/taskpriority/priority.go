@@ -0,0 +1,86 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package taskpriority defines the priority levels of workflow and activity tasks and how a
+// priority is read from the header of a workflow start request or a schedule activity command.
+//
+// Matching prefers higher priority tasks among the tasks waiting to be matched with a poller.
+// Each task queue partition reads the backlog of each priority level separately, holding up to
+// matching.getTasksBatchSize tasks of each level in memory, so a higher priority task is
+// dispatched before older lower priority tasks however long their backlog is. The backlog is
+// persisted in the order tasks were added, so every level scans the tasks of the other levels
+// while reading its own.
+package taskpriority
+
+import (
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/payload"
+)
+
+const (
+	// HeaderKey is the header field that carries the requested task priority as an integer.
+	// Positive values request high priority and negative values request low priority.
+	HeaderKey = "temporal-task-priority"
+
+	// Low priority tasks are dispatched after the other tasks held in memory
+	Low int32 = -1
+	// Normal is the priority of tasks that did not request one
+	Normal int32 = 0
+	// High priority tasks are dispatched before the other tasks held in memory
+	High int32 = 1
+
+	// NumLevels is the number of distinct priority levels
+	NumLevels = int(High-Low) + 1
+)
+
+// Normalize clamps priority to the supported range
+func Normalize(priority int32) int32 {
+	if priority < Low {
+		return Low
+	}
+	if priority > High {
+		return High
+	}
+	return priority
+}
+
+// Level maps priority to a zero based level, with Low at level 0 and High at NumLevels-1
+func Level(priority int32) int {
+	return int(Normalize(priority) - Low)
+}
+
+// FromHeader returns the priority requested in header, or defaultPriority if the header does
+// not request one or the value cannot be decoded.
+func FromHeader(header *commonpb.Header, defaultPriority int32) int32 {
+	p, ok := header.GetFields()[HeaderKey]
+	if !ok {
+		return defaultPriority
+	}
+	var priority int32
+	if err := payload.Decode(p, &priority); err != nil {
+		return defaultPriority
+	}
+	return Normalize(priority)
+}
@@ -0,0 +1,112 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package codecserver provides a local stand-in for a Temporal codec server, for use in tests.
+package codecserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/converter"
+)
+
+const (
+	// Encoding is the encoding metadata of payloads encoded by Codec.
+	Encoding = "binary/encrypted"
+
+	metadataEncoding = "encoding"
+)
+
+type (
+	// Codec stands in for an SDK encryption codec: it wraps the original payload, serialized, in a payload with
+	// Encoding metadata. It provides no confidentiality at all.
+	Codec struct{}
+
+	// Server is a local codec server backed by Codec.
+	Server struct {
+		*httptest.Server
+
+		sync.Mutex
+		requests []*http.Request
+	}
+)
+
+var _ converter.PayloadCodec = Codec{}
+
+// NewServer starts a new codec server. Callers must Close it.
+func NewServer() *Server {
+	s := &Server{}
+	handler := converter.NewPayloadCodecHTTPHandler(Codec{})
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Lock()
+		s.requests = append(s.requests, r.Clone(r.Context()))
+		s.Unlock()
+		handler.ServeHTTP(w, r)
+	}))
+	return s
+}
+
+// Requests returns the requests received so far. Their bodies have been consumed.
+func (s *Server) Requests() []*http.Request {
+	s.Lock()
+	defer s.Unlock()
+	return append([]*http.Request(nil), s.requests...)
+}
+
+// Encode wraps every payload in a payload with Encoding metadata.
+func (Codec) Encode(payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	result := make([]*commonpb.Payload, len(payloads))
+	for i, payload := range payloads {
+		data, err := proto.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = &commonpb.Payload{
+			Metadata: map[string][]byte{metadataEncoding: []byte(Encoding)},
+			Data:     data,
+		}
+	}
+	return result, nil
+}
+
+// Decode unwraps payloads with Encoding metadata and passes any other payload through unchanged.
+func (Codec) Decode(payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	result := make([]*commonpb.Payload, len(payloads))
+	for i, payload := range payloads {
+		if string(payload.GetMetadata()[metadataEncoding]) != Encoding {
+			result[i] = payload
+			continue
+		}
+		decoded := &commonpb.Payload{}
+		if err := proto.Unmarshal(payload.GetData(), decoded); err != nil {
+			return nil, err
+		}
+		result[i] = decoded
+	}
+	return result, nil
+}
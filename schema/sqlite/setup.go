@@ -27,10 +27,19 @@ package sqlite
 import (
 	"bytes"
 	"context"
-	_ "embed"
+	"crypto/md5"
+	"database/sql"
+	"embed"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
+	"path"
+	"sort"
 
+	"github.com/blang/semver/v4"
 	enumspb "go.temporal.io/api/enums/v1"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -38,7 +47,7 @@ import (
 	"go.temporal.io/server/common/config"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
-	"go.temporal.io/server/common/persistence/sql"
+	persistencesql "go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -50,13 +59,36 @@ var (
 	executionSchema []byte
 	//go:embed v3/visibility/schema.sql
 	visibilitySchema []byte
+	//go:embed v3/visibility/versioned
+	visibilityVersionedSchema embed.FS
+)
+
+const (
+	// Both schemas are set up in the same database, their versions are recorded under these names.
+	executionSchemaName  = "temporal"
+	visibilitySchemaName = "temporal_visibility"
+
+	visibilityVersionedSchemaDir = "v3/visibility/versioned"
+	// unversionedSchemaVersion is the version of databases set up before schema versions were recorded.
+	unversionedSchemaVersion = "0.1"
+	schemaVersionTable       = "schema_version"
+)
+
+type (
+	// schemaUpdateManifest is the manifest.json of a versioned schema update
+	schemaUpdateManifest struct {
+		CurrVersion          string
+		MinCompatibleVersion string
+		Description          string
+		SchemaUpdateCqlFiles []string
+	}
 )
 
 // SetupSchema initializes the SQLite schema in an empty database.
 //
 // Note: this function may receive breaking changes or be removed in the future.
 func SetupSchema(cfg *config.SQL) error {
-	db, err := sql.NewSQLAdminDB(sqlplugin.DbKindUnknown, cfg, resolver.NewNoopResolver())
+	db, err := persistencesql.NewSQLAdminDB(sqlplugin.DbKindUnknown, cfg, resolver.NewNoopResolver())
 	if err != nil {
 		return fmt.Errorf("unable to create SQLite admin DB: %w", err)
 	}
@@ -91,6 +123,136 @@ func SetupSchemaOnDB(db sqlplugin.AdminDB) error {
 		}
 	}
 
+	if err := db.CreateSchemaVersionTables(); err != nil {
+		return fmt.Errorf("error creating schema version tables: %w", err)
+	}
+	if err := db.UpdateSchemaVersion(executionSchemaName, Version, Version); err != nil {
+		return fmt.Errorf("error recording execution schema version: %w", err)
+	}
+	if err := db.UpdateSchemaVersion(visibilitySchemaName, VisibilityVersion, VisibilityVersion); err != nil {
+		return fmt.Errorf("error recording visibility schema version: %w", err)
+	}
+	return nil
+}
+
+// UpdateSchema applies the versioned schema updates missing in a database set up by an earlier release.
+//
+// Note: this function may receive breaking changes or be removed in the future.
+func UpdateSchema(cfg *config.SQL) error {
+	db, err := persistencesql.NewSQLAdminDB(sqlplugin.DbKindUnknown, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		return fmt.Errorf("unable to create SQLite admin DB: %w", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	return UpdateSchemaOnDB(db)
+}
+
+// UpdateSchemaOnDB applies the versioned schema updates missing in a database set up by an earlier
+// release using existing DB connection. Databases set up before schema versions were recorded
+// are at version 0.1 of both schemas.
+//
+// Note: this function may receive breaking changes or be removed in the future.
+func UpdateSchemaOnDB(db sqlplugin.AdminDB) error {
+	tables, err := db.ListTables("")
+	if err != nil {
+		return fmt.Errorf("error listing tables: %w", err)
+	}
+	hasVersionTables := false
+	for _, table := range tables {
+		if table == schemaVersionTable {
+			hasVersionTables = true
+			break
+		}
+	}
+	if !hasVersionTables {
+		if err := db.CreateSchemaVersionTables(); err != nil {
+			return fmt.Errorf("error creating schema version tables: %w", err)
+		}
+		if err := db.UpdateSchemaVersion(executionSchemaName, unversionedSchemaVersion, unversionedSchemaVersion); err != nil {
+			return fmt.Errorf("error recording execution schema version: %w", err)
+		}
+	}
+
+	visibilityVersion, err := db.ReadSchemaVersion(visibilitySchemaName)
+	if errors.Is(err, sql.ErrNoRows) {
+		visibilityVersion = unversionedSchemaVersion
+	} else if err != nil {
+		return fmt.Errorf("error reading visibility schema version: %w", err)
+	}
+
+	versionedSchema, err := fs.Sub(visibilityVersionedSchema, visibilityVersionedSchemaDir)
+	if err != nil {
+		return err
+	}
+	return updateSchema(db, visibilitySchemaName, visibilityVersion, versionedSchema)
+}
+
+// updateSchema applies the updates of versionedSchema newer than currVersion in version order.
+// Every update is a directory named after its version with a manifest.json.
+func updateSchema(db sqlplugin.AdminDB, dbName string, currVersion string, versionedSchema fs.FS) error {
+	curr, err := semver.ParseTolerant(currVersion)
+	if err != nil {
+		return fmt.Errorf("invalid %s schema version %q: %w", dbName, currVersion, err)
+	}
+	dirs, err := fs.ReadDir(versionedSchema, ".")
+	if err != nil {
+		return err
+	}
+
+	versions := make(map[string]semver.Version, len(dirs))
+	var updates []string
+	for _, dir := range dirs {
+		version, err := semver.ParseTolerant(dir.Name()[1:])
+		if err != nil {
+			return fmt.Errorf("invalid schema update directory %q: %w", dir.Name(), err)
+		}
+		if version.GT(curr) {
+			versions[dir.Name()] = version
+			updates = append(updates, dir.Name())
+		}
+	}
+	sort.Slice(updates, func(i, j int) bool {
+		return versions[updates[i]].LT(versions[updates[j]])
+	})
+
+	for _, dir := range updates {
+		manifestData, err := fs.ReadFile(versionedSchema, path.Join(dir, "manifest.json"))
+		if err != nil {
+			return fmt.Errorf("error reading %s manifest: %w", dir, err)
+		}
+		var manifest schemaUpdateManifest
+		if err := json.Unmarshal(manifestData, &manifest); err != nil {
+			return fmt.Errorf("error parsing %s manifest: %w", dir, err)
+		}
+
+		readers := make([]io.Reader, 0, len(manifest.SchemaUpdateCqlFiles))
+		for _, file := range manifest.SchemaUpdateCqlFiles {
+			data, err := fs.ReadFile(versionedSchema, path.Join(dir, file))
+			if err != nil {
+				return fmt.Errorf("error reading %s schema update: %w", dir, err)
+			}
+			readers = append(readers, bytes.NewBuffer(data))
+		}
+		statements, err := p.LoadAndSplitQueryFromReaders(readers)
+		if err != nil {
+			return fmt.Errorf("error loading %s schema update: %w", dir, err)
+		}
+		for _, stmt := range statements {
+			if err := db.Exec(stmt); err != nil {
+				return fmt.Errorf("error executing statement %q: %w", stmt, err)
+			}
+		}
+
+		if err := db.UpdateSchemaVersion(dbName, manifest.CurrVersion, manifest.MinCompatibleVersion); err != nil {
+			return fmt.Errorf("error recording %s schema version: %w", dbName, err)
+		}
+		md5Sum := md5.Sum(manifestData)
+		if err := db.WriteSchemaUpdateLog(currVersion, manifest.CurrVersion, hex.EncodeToString(md5Sum[:]), manifest.Description); err != nil {
+			return fmt.Errorf("error writing %s schema update log: %w", dbName, err)
+		}
+		currVersion = manifest.CurrVersion
+	}
 	return nil
 }
 
@@ -113,7 +275,7 @@ type NamespaceConfig struct {
 //
 // Note: this function may receive breaking changes or be removed in the future.
 func CreateNamespaces(cfg *config.SQL, namespaces ...*NamespaceConfig) error {
-	db, err := sql.NewSQLDB(sqlplugin.DbKindUnknown, cfg, resolver.NewNoopResolver())
+	db, err := persistencesql.NewSQLDB(sqlplugin.DbKindUnknown, cfg, resolver.NewNoopResolver())
 	if err != nil {
 		return fmt.Errorf("unable to create SQLite admin DB: %w", err)
 	}
@@ -11,6 +11,11 @@ CREATE TABLE executions_visibility (
 	memo BLOB,
 	encoding VARCHAR(64) NOT NULL,
 	task_queue VARCHAR(255) DEFAULT '' NOT NULL,
+	state_transition_count BIGINT,
+	history_size_bytes BIGINT,
+	execution_duration BIGINT,
+	-- Custom and predefined search attributes as JSON object, used by advanced visibility only.
+	search_attributes TEXT,
 
 	PRIMARY KEY (namespace_id, run_id)
 );
@@ -0,0 +1,5 @@
+ALTER TABLE executions_visibility ADD COLUMN state_transition_count BIGINT;
+ALTER TABLE executions_visibility ADD COLUMN history_size_bytes BIGINT;
+ALTER TABLE executions_visibility ADD COLUMN execution_duration BIGINT;
+-- Custom and predefined search attributes as JSON object, used by advanced visibility only.
+ALTER TABLE executions_visibility ADD COLUMN search_attributes TEXT;
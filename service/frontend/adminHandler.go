@@ -40,8 +40,11 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	querypb "go.temporal.io/api/query/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
 	sdkclient "go.temporal.io/sdk/client"
 	"golang.org/x/exp/maps"
 	"google.golang.org/grpc/health"
@@ -52,8 +55,11 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	schedspb "go.temporal.io/server/api/schedule/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	serverClient "go.temporal.io/server/client"
 	"go.temporal.io/server/client/admin"
@@ -63,12 +69,14 @@ import (
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/versionhistory"
@@ -82,12 +90,16 @@ import (
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker"
 	"go.temporal.io/server/service/worker/addsearchattributes"
+	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/scheduler"
 )
 
 const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
 	listClustersPageSize                    = 100
+	maxShardOwnershipOverrideTTL            = 24 * time.Hour
+	maxDynamicConfigOverrideTTL             = 7 * 24 * time.Hour
 )
 
 type (
@@ -112,14 +124,18 @@ type (
 		clientFactory               serverClient.Factory
 		clientBean                  serverClient.Bean
 		historyClient               historyservice.HistoryServiceClient
+		matchingClient              matchingservice.MatchingServiceClient
 		sdkClientFactory            sdk.ClientFactory
 		membershipMonitor           membership.Monitor
 		metricsHandler              metrics.Handler
 		namespaceRegistry           namespace.Registry
 		saProvider                  searchattribute.Provider
 		saManager                   searchattribute.Manager
+		saValidator                 *searchattribute.Validator
 		clusterMetadata             cluster.Metadata
 		healthServer                *health.Server
+		timeSource                  clock.TimeSource
+		dynamicConfigOverrides      *dynamicconfig.OverrideClient
 	}
 
 	NewAdminHandlerArgs struct {
@@ -138,6 +154,7 @@ type (
 		ClientFactory                       serverClient.Factory
 		ClientBean                          serverClient.Bean
 		HistoryClient                       historyservice.HistoryServiceClient
+		MatchingClient                      matchingservice.MatchingServiceClient
 		sdkClientFactory                    sdk.ClientFactory
 		MembershipMonitor                   membership.Monitor
 		ArchiverProvider                    provider.ArchiverProvider
@@ -150,6 +167,7 @@ type (
 		HealthServer                        *health.Server
 		EventSerializer                     serialization.Serializer
 		TimeSource                          clock.TimeSource
+		DynamicConfigOverrides              *dynamicconfig.OverrideClient
 	}
 )
 
@@ -202,14 +220,25 @@ func NewAdminHandler(
 		clientFactory:               args.ClientFactory,
 		clientBean:                  args.ClientBean,
 		historyClient:               args.HistoryClient,
+		matchingClient:              args.MatchingClient,
 		sdkClientFactory:            args.sdkClientFactory,
 		membershipMonitor:           args.MembershipMonitor,
 		metricsHandler:              args.MetricsHandler,
 		namespaceRegistry:           args.NamespaceRegistry,
 		saProvider:                  args.SaProvider,
 		saManager:                   args.SaManager,
-		clusterMetadata:             args.ClusterMetadata,
-		healthServer:                args.HealthServer,
+		// admin APIs take search attribute field names, so there are no aliases to resolve
+		saValidator: searchattribute.NewValidator(
+			args.SaProvider,
+			nil,
+			args.Config.SearchAttributesNumberOfKeysLimit,
+			args.Config.SearchAttributesSizeOfValueLimit,
+			args.Config.SearchAttributesTotalSizeLimit,
+		),
+		clusterMetadata:        args.ClusterMetadata,
+		healthServer:           args.HealthServer,
+		timeSource:             args.TimeSource,
+		dynamicConfigOverrides: args.DynamicConfigOverrides,
 	}
 }
 
@@ -516,6 +545,339 @@ func (adh *AdminHandler) CloseShard(ctx context.Context, request *adminservice.C
 	return &adminservice.CloseShardResponse{}, err
 }
 
+// MoveShard overrides the owner of a history shard with the given host until the TTL expires,
+// or removes the override for the shard when no host is given.
+func (adh *AdminHandler) MoveShard(ctx context.Context, request *adminservice.MoveShardRequest) (_ *adminservice.MoveShardResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	shardID := request.GetShardId()
+	if shardID < 1 || shardID > adh.numberOfHistoryShards {
+		return nil, errInvalidShardID
+	}
+
+	now := adh.timeSource.Now().UTC()
+	var override *persistencespb.ShardOwnershipOverride
+	if hostAddress := request.GetHostAddress(); hostAddress != "" {
+		ttl := timestamp.DurationValue(request.GetTtl())
+		if ttl <= 0 || ttl > maxShardOwnershipOverrideTTL {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errInvalidShardOwnershipOverrideTTLMessage, maxShardOwnershipOverrideTTL))
+		}
+		if err := adh.validateHistoryHost(hostAddress); err != nil {
+			return nil, err
+		}
+		override = &persistencespb.ShardOwnershipOverride{
+			ShardId:     shardID,
+			HostAddress: hostAddress,
+			ExpireTime:  timestamp.TimePtr(now.Add(ttl)),
+		}
+	}
+
+	resp, err := adh.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	clusterMetadata := resp.ClusterMetadata
+	var overrides []*persistencespb.ShardOwnershipOverride
+	for _, existing := range membership.ActiveShardOwnershipOverrides(clusterMetadata.ShardOwnershipOverrides, now) {
+		if existing.GetShardId() != shardID {
+			overrides = append(overrides, existing)
+		}
+	}
+	if override != nil {
+		overrides = append(overrides, override)
+	}
+	clusterMetadata.ShardOwnershipOverrides = overrides
+
+	applied, err := adh.clusterMetadataManager.SaveClusterMetadata(ctx, &persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: clusterMetadata,
+		Version:         resp.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !applied {
+		return nil, errClusterMetadataConcurrentlyUpdated
+	}
+
+	// Reload the overrides on this host right away, history hosts reload them on their next refresh or
+	// as soon as they see a request for a shard they don't think they own.
+	if resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService); err == nil {
+		resolver.RequestRefresh()
+	}
+
+	adh.logger.Info("Shard ownership override updated",
+		tag.ShardID(shardID),
+		tag.Address(request.GetHostAddress()),
+		tag.TimestampPtr(override.GetExpireTime()),
+	)
+	return &adminservice.MoveShardResponse{ExpireTime: override.GetExpireTime()}, nil
+}
+
+// ListShardOwnershipOverrides returns the history shard ownership overrides which have not expired yet.
+func (adh *AdminHandler) ListShardOwnershipOverrides(
+	ctx context.Context,
+	request *adminservice.ListShardOwnershipOverridesRequest,
+) (_ *adminservice.ListShardOwnershipOverridesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	resp, err := adh.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListShardOwnershipOverridesResponse{
+		Overrides: membership.ActiveShardOwnershipOverrides(resp.ShardOwnershipOverrides, adh.timeSource.Now().UTC()),
+	}, nil
+}
+
+// GetDynamicConfig returns the values of a dynamic config key on this host, including overrides.
+func (adh *AdminHandler) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+) (_ *adminservice.GetDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetKey() == "" {
+		return nil, errDynamicConfigKeyNotSet
+	}
+
+	values, err := adh.dynamicConfigValues(dynamicconfig.Key(request.GetKey()), adh.dynamicConfigOverrideExpireTimes())
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.GetDynamicConfigResponse{Values: values}, nil
+}
+
+// ListDynamicConfig returns the registered dynamic config keys and their values on this host, including overrides.
+func (adh *AdminHandler) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+) (_ *adminservice.ListDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	prefix := strings.ToLower(request.GetKeyPrefix())
+	overrideExpireTimes := adh.dynamicConfigOverrideExpireTimes()
+	var keys []*adminservice.DynamicConfigKey
+	for _, key := range dynamicconfig.RegisteredKeys() {
+		if !strings.HasPrefix(strings.ToLower(key.String()), prefix) {
+			continue
+		}
+		values, err := adh.dynamicConfigValues(key, overrideExpireTimes)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) == 0 && !request.GetIncludeUnset() {
+			continue
+		}
+		_, description, _ := dynamicconfig.LookupKey(key.String())
+		keys = append(keys, &adminservice.DynamicConfigKey{
+			Key:         key.String(),
+			Description: description,
+			Values:      values,
+		})
+	}
+	return &adminservice.ListDynamicConfigResponse{Keys: keys}, nil
+}
+
+// dynamicConfigOverrideExpireTimes returns the expire times of the overrides applied to this host,
+// by lower-cased key and constraints.
+func (adh *AdminHandler) dynamicConfigOverrideExpireTimes() map[string]map[dynamicconfig.Constraints]time.Time {
+	expireTimes := make(map[string]map[dynamicconfig.Constraints]time.Time)
+	for _, override := range adh.dynamicConfigOverrides.ListOverrides() {
+		key := strings.ToLower(override.Key.String())
+		if expireTimes[key] == nil {
+			expireTimes[key] = make(map[dynamicconfig.Constraints]time.Time)
+		}
+		expireTimes[key][override.Constraints] = override.ExpireTime
+	}
+	return expireTimes
+}
+
+func (adh *AdminHandler) dynamicConfigValues(
+	key dynamicconfig.Key,
+	overrideExpireTimes map[string]map[dynamicconfig.Constraints]time.Time,
+) ([]*adminservice.DynamicConfigValue, error) {
+	var values []*adminservice.DynamicConfigValue
+	for _, cv := range adh.dynamicConfigOverrides.GetValue(key) {
+		value, err := dynamicconfig.FormatValue(cv.Value)
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf(errInvalidDynamicConfigValueMessage, err))
+		}
+		var expireTime *time.Time
+		if t, ok := overrideExpireTimes[strings.ToLower(key.String())][cv.Constraints]; ok {
+			expireTime = timestamp.TimePtr(t)
+		}
+		values = append(values, &adminservice.DynamicConfigValue{
+			Constraints:        dynamicconfig.ConstraintsToProto(cv.Constraints),
+			Value:              value,
+			OverrideExpireTime: expireTime,
+		})
+	}
+	return values, nil
+}
+
+// SetDynamicConfigOverride overrides the value of a dynamic config key on all hosts until the override expires.
+func (adh *AdminHandler) SetDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigOverrideRequest,
+) (_ *adminservice.SetDynamicConfigOverrideResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetKey() == "" {
+		return nil, errDynamicConfigKeyNotSet
+	}
+	if _, _, ok := dynamicconfig.LookupKey(request.GetKey()); !ok {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errUnknownDynamicConfigKeyMessage, request.GetKey()))
+	}
+	ttl := timestamp.DurationValue(request.GetTtl())
+	if ttl <= 0 || ttl > maxDynamicConfigOverrideTTL {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errInvalidDynamicConfigOverrideTTLMessage, maxDynamicConfigOverrideTTL))
+	}
+	if _, err := dynamicconfig.ParseValue(request.GetValue()); err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errInvalidDynamicConfigValueMessage, err))
+	}
+
+	override := &persistencespb.DynamicConfigOverride{
+		Key:         request.GetKey(),
+		Constraints: dynamicconfig.ConstraintsToProto(dynamicconfig.ConstraintsFromProto(request.GetConstraints())),
+		Value:       request.GetValue(),
+		ExpireTime:  timestamp.TimePtr(adh.timeSource.Now().UTC().Add(ttl)),
+	}
+	if err := adh.updateDynamicConfigOverrides(ctx, request.GetKey(), request.GetConstraints(), override); err != nil {
+		return nil, err
+	}
+
+	adh.logger.Info("Dynamic config override set",
+		tag.Key(request.GetKey()),
+		tag.Value(request.GetValue()),
+		tag.TimestampPtr(override.GetExpireTime()),
+	)
+	return &adminservice.SetDynamicConfigOverrideResponse{ExpireTime: override.GetExpireTime()}, nil
+}
+
+// RemoveDynamicConfigOverride removes the override of a dynamic config key for the given constraints.
+func (adh *AdminHandler) RemoveDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.RemoveDynamicConfigOverrideRequest,
+) (_ *adminservice.RemoveDynamicConfigOverrideResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetKey() == "" {
+		return nil, errDynamicConfigKeyNotSet
+	}
+
+	if err := adh.updateDynamicConfigOverrides(ctx, request.GetKey(), request.GetConstraints(), nil); err != nil {
+		return nil, err
+	}
+
+	adh.logger.Info("Dynamic config override removed", tag.Key(request.GetKey()))
+	return &adminservice.RemoveDynamicConfigOverrideResponse{}, nil
+}
+
+// ListDynamicConfigOverrides returns the dynamic config overrides which have not expired yet.
+func (adh *AdminHandler) ListDynamicConfigOverrides(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigOverridesRequest,
+) (_ *adminservice.ListDynamicConfigOverridesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	resp, err := adh.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListDynamicConfigOverridesResponse{
+		Overrides: activeDynamicConfigOverrides(resp.DynamicConfigOverrides, adh.timeSource.Now().UTC()),
+	}, nil
+}
+
+// updateDynamicConfigOverrides replaces the persisted override of key and constraints with override,
+// or removes it if override is nil, and applies the result to this host right away. The other hosts
+// pick it up when they reload the overrides.
+func (adh *AdminHandler) updateDynamicConfigOverrides(
+	ctx context.Context,
+	key string,
+	constraints *persistencespb.DynamicConfigConstraints,
+	override *persistencespb.DynamicConfigOverride,
+) error {
+	resp, err := adh.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	clusterMetadata := resp.ClusterMetadata
+	var overrides []*persistencespb.DynamicConfigOverride
+	for _, existing := range activeDynamicConfigOverrides(clusterMetadata.DynamicConfigOverrides, adh.timeSource.Now().UTC()) {
+		if strings.EqualFold(existing.GetKey(), key) &&
+			dynamicconfig.ConstraintsFromProto(existing.GetConstraints()) == dynamicconfig.ConstraintsFromProto(constraints) {
+			continue
+		}
+		overrides = append(overrides, existing)
+	}
+	if override != nil {
+		overrides = append(overrides, override)
+	}
+	clusterMetadata.DynamicConfigOverrides = overrides
+
+	applied, err := adh.clusterMetadataManager.SaveClusterMetadata(ctx, &persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: clusterMetadata,
+		Version:         resp.Version,
+	})
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return errClusterMetadataConcurrentlyUpdated
+	}
+
+	converted := make([]dynamicconfig.Override, 0, len(overrides))
+	for _, o := range overrides {
+		c, err := dynamicconfig.OverrideFromProto(o)
+		if err != nil {
+			// the value was validated when the override was set
+			adh.logger.Warn("Ignoring invalid dynamic config override", tag.Key(o.GetKey()), tag.Error(err))
+			continue
+		}
+		converted = append(converted, c)
+	}
+	adh.dynamicConfigOverrides.Update(converted)
+	return nil
+}
+
+func activeDynamicConfigOverrides(
+	overrides []*persistencespb.DynamicConfigOverride,
+	now time.Time,
+) []*persistencespb.DynamicConfigOverride {
+	var active []*persistencespb.DynamicConfigOverride
+	for _, override := range overrides {
+		if now.Before(timestamp.TimeValue(override.GetExpireTime())) {
+			active = append(active, override)
+		}
+	}
+	return active
+}
+
 func (adh *AdminHandler) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -634,6 +996,7 @@ func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *admin
 		ShardId:           request.GetShardId(),
 		NamespaceId:       namespaceID.String(),
 		WorkflowExecution: request.GetWorkflowExecution(),
+		IncludeShardLoads: request.GetIncludeShardLoads(),
 	})
 
 	if resp == nil {
@@ -645,6 +1008,7 @@ func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *admin
 		ShardIds:       resp.GetShardIds(),
 		NamespaceCache: resp.GetNamespaceCache(),
 		Address:        resp.GetAddress(),
+		ShardLoads:     resp.GetShardLoads(),
 	}, err
 }
 
@@ -1147,6 +1511,45 @@ func (adh *AdminHandler) ReapplyEvents(ctx context.Context, request *adminservic
 	return &adminservice.ReapplyEventsResponse{}, nil
 }
 
+// ImportWorkflowExecution writes the history batches of a workflow execution exported from another cluster
+func (adh *AdminHandler) ImportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+) (_ *adminservice.ImportWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if request.GetExecution().GetRunId() == "" {
+		return nil, errRunIDNotSet
+	}
+	if len(request.GetHistoryBatches()) == 0 {
+		return nil, errHistoryBatchesNotSet
+	}
+	if len(request.GetVersionHistory().GetItems()) == 0 {
+		return nil, errInvalidVersionHistories
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.historyClient.ImportWorkflowExecution(ctx, &historyservice.ImportWorkflowExecutionRequest{
+		NamespaceId:    namespaceID.String(),
+		Execution:      request.Execution,
+		HistoryBatches: request.HistoryBatches,
+		VersionHistory: request.VersionHistory,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ImportWorkflowExecutionResponse{}, nil
+}
+
 // GetDLQMessages reads messages from DLQ
 func (adh *AdminHandler) GetDLQMessages(
 	ctx context.Context,
@@ -1394,6 +1797,84 @@ func (adh *AdminHandler) GetTaskQueueTasks(
 	}, nil
 }
 
+// DescribeTaskQueuePartitions returns backlog and rate statistics for every partition of a task queue.
+// A partition that can't be described is returned with its error instead of failing the whole call.
+func (adh *AdminHandler) DescribeTaskQueuePartitions(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueuePartitionsRequest,
+) (_ *adminservice.DescribeTaskQueuePartitionsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetTaskQueue() == "" {
+		return nil, errTaskQueueNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	taskQueueType := request.GetTaskQueueType()
+	if taskQueueType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		taskQueueType = enumspb.TASK_QUEUE_TYPE_WORKFLOW
+	}
+
+	listResp, err := adh.matchingClient.ListTaskQueuePartitions(ctx, &matchingservice.ListTaskQueuePartitionsRequest{
+		NamespaceId: namespaceID.String(),
+		Namespace:   request.GetNamespace(),
+		TaskQueue: &taskqueuepb.TaskQueue{
+			Name: request.GetTaskQueue(),
+			Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	partitions := listResp.GetWorkflowTaskQueuePartitions()
+	if taskQueueType == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+		partitions = listResp.GetActivityTaskQueuePartitions()
+	}
+
+	result := make([]*taskqueuespb.TaskQueuePartitionStats, 0, len(partitions))
+	for _, partition := range partitions {
+		descResp, err := adh.matchingClient.DescribeTaskQueue(ctx, &matchingservice.DescribeTaskQueueRequest{
+			NamespaceId: namespaceID.String(),
+			DescRequest: &workflowservice.DescribeTaskQueueRequest{
+				Namespace: request.GetNamespace(),
+				TaskQueue: &taskqueuepb.TaskQueue{
+					Name: partition.GetKey(),
+					Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+				},
+				TaskQueueType: taskQueueType,
+			},
+			IncludeBacklogStats: true,
+		})
+		if err != nil {
+			// report the failure for this partition and keep describing the others
+			adh.logger.Warn("Failed to describe task queue partition", tag.WorkflowTaskQueueName(partition.GetKey()), tag.Error(err))
+			result = append(result, &taskqueuespb.TaskQueuePartitionStats{
+				Name:      partition.GetKey(),
+				OwnerHost: partition.GetOwnerHostName(),
+				Error:     err.Error(),
+			})
+			continue
+		}
+		result = append(result, &taskqueuespb.TaskQueuePartitionStats{
+			Name:         partition.GetKey(),
+			OwnerHost:    partition.GetOwnerHostName(),
+			PollerCount:  int32(len(descResp.GetPollers())),
+			BacklogStats: descResp.GetBacklogStats(),
+		})
+	}
+
+	return &adminservice.DescribeTaskQueuePartitionsResponse{
+		Partitions: result,
+	}, nil
+}
+
 func (adh *AdminHandler) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -1534,6 +2015,241 @@ func (adh *AdminHandler) DeleteWorkflowExecution(
 	}, nil
 }
 
+// StartBatchOperation starts a batch operation the public StartBatchOperation API does not support yet
+func (adh *AdminHandler) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+) (_ *adminservice.StartBatchOperationResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetJobId()) == 0 {
+		return nil, errBatchJobIDNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetVisibilityQuery()) == 0 {
+		return nil, errQueryNotSet
+	}
+	if len(request.GetReason()) == 0 {
+		return nil, errReasonNotSet
+	}
+	if (request.GetResetOperation() == nil) == (request.GetUpsertSearchAttributesOperation() == nil) {
+		return nil, errBatchOperationNotSet
+	}
+	if !adh.config.EnableBatcher(request.GetNamespace()) {
+		return nil, errBatchAPINotAllowed
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate concurrent batch operation
+	countResp, err := adh.visibilityMgr.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: namespaceID,
+		Namespace:   namespace.Name(request.GetNamespace()),
+		Query:       batcher.OpenBatchOperationQuery,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if countResp.Count >= int64(adh.config.MaxConcurrentBatchOperation(request.GetNamespace())) {
+		return nil, serviceerror.NewUnavailable("Max concurrent batch operations is reached")
+	}
+
+	input := &batcher.BatchParams{
+		Namespace: request.GetNamespace(),
+		Query:     request.GetVisibilityQuery(),
+		Reason:    request.GetReason(),
+	}
+	if op := request.GetResetOperation(); op != nil {
+		input.BatchType = batcher.BatchTypeReset
+		input.ResetParams = batcher.ResetParams{
+			BadBinaryChecksum: op.GetBadBinaryChecksum(),
+			BuildID:           op.GetBuildId(),
+			ResetReapplyType:  op.GetResetReapplyType(),
+		}
+		switch op.GetResetType() {
+		case enumsspb.RESET_TYPE_FIRST_WORKFLOW_TASK:
+			input.ResetParams.ResetType = batcher.ResetTypeFirstWorkflowTask
+		case enumsspb.RESET_TYPE_LAST_WORKFLOW_TASK:
+			input.ResetParams.ResetType = batcher.ResetTypeLastWorkflowTask
+		case enumsspb.RESET_TYPE_BAD_BINARY:
+			if op.GetBadBinaryChecksum() == "" {
+				return nil, errBadBinaryChecksumNotSet
+			}
+			input.ResetParams.ResetType = batcher.ResetTypeBadBinary
+		case enumsspb.RESET_TYPE_BUILD_ID:
+			if op.GetBuildId() == "" {
+				return nil, errBuildIDNotSet
+			}
+			input.ResetParams.ResetType = batcher.ResetTypeBuildID
+		default:
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("The reset type %v is not supported", op.GetResetType()))
+		}
+	} else {
+		op := request.GetUpsertSearchAttributesOperation()
+		if len(op.GetSearchAttributes().GetIndexedFields()) == 0 {
+			return nil, errSearchAttributesNotSet
+		}
+		if err := adh.saValidator.Validate(op.GetSearchAttributes(), request.GetNamespace(), adh.config.ESIndexName); err != nil {
+			return nil, err
+		}
+		if err := adh.saValidator.ValidateSize(op.GetSearchAttributes(), request.GetNamespace()); err != nil {
+			return nil, err
+		}
+		input.BatchType = batcher.BatchTypeUpsertSearchAttributes
+		input.UpsertSearchAttributesParams = batcher.UpsertSearchAttributesParams{
+			SearchAttributes: op.GetSearchAttributes(),
+		}
+	}
+
+	if err := startBatchOperationWorkflow(ctx, adh.historyClient, namespaceID, request.GetJobId(), request.GetIdentity(), input); err != nil {
+		return nil, err
+	}
+	return &adminservice.StartBatchOperationResponse{}, nil
+}
+
+// CountWorkflowExecutions counts the workflow executions matching a visibility query, by group if the
+// query has a GROUP BY clause.
+func (adh *AdminHandler) CountWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.CountWorkflowExecutionsRequest,
+) (_ *adminservice.CountWorkflowExecutionsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	persistenceResp, err := adh.visibilityMgr.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: namespaceID,
+		Namespace:   namespaceName,
+		Query:       request.GetQuery(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &adminservice.CountWorkflowExecutionsResponse{
+		Count: persistenceResp.Count,
+	}
+	for _, group := range persistenceResp.Groups {
+		resp.Groups = append(resp.Groups, &adminservice.CountWorkflowExecutionsGroup{
+			GroupValues: group.GroupValues,
+			Count:       group.Count,
+		})
+	}
+	return resp, nil
+}
+
+// DescribeSchedule returns the internal policies of a schedule and the outcomes of its most recent runs
+func (adh *AdminHandler) DescribeSchedule(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRequest,
+) (_ *adminservice.DescribeScheduleResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetScheduleId()) == 0 {
+		return nil, errScheduleIDNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := adh.historyClient.QueryWorkflow(ctx, &historyservice.QueryWorkflowRequest{
+		NamespaceId: namespaceID.String(),
+		Request: &workflowservice.QueryWorkflowRequest{
+			Namespace: request.GetNamespace(),
+			Execution: &commonpb.WorkflowExecution{WorkflowId: scheduler.WorkflowIDPrefix + request.GetScheduleId()},
+			Query:     &querypb.WorkflowQuery{QueryType: scheduler.QueryNameDescribe},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var queryResponse schedspb.DescribeResponse
+	if err := payloads.Decode(res.GetResponse().GetQueryResult(), &queryResponse); err != nil {
+		return nil, err
+	}
+
+	return &adminservice.DescribeScheduleResponse{
+		Policies:         queryResponse.GetPolicies(),
+		RecentRunResults: queryResponse.GetRecentRunResults(),
+	}, nil
+}
+
+// UpdateSchedulePolicies replaces the internal policies of a schedule
+func (adh *AdminHandler) UpdateSchedulePolicies(
+	ctx context.Context,
+	request *adminservice.UpdateSchedulePoliciesRequest,
+) (_ *adminservice.UpdateSchedulePoliciesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetScheduleId()) == 0 {
+		return nil, errScheduleIDNotSet
+	}
+	if request.GetPolicies() == nil {
+		return nil, errSchedulePoliciesNotSet
+	}
+	if request.GetPolicies().GetPauseAfterFailures() < 0 {
+		return nil, errInvalidPauseAfterFailures
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	inputPayloads, err := sdk.PreferProtoDataConverter.ToPayloads(request.GetPolicies())
+	if err != nil {
+		return nil, err
+	}
+	_, err = adh.historyClient.SignalWorkflowExecution(ctx, &historyservice.SignalWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+			Namespace:         request.GetNamespace(),
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: scheduler.WorkflowIDPrefix + request.GetScheduleId()},
+			SignalName:        scheduler.SignalNameUpdatePolicies,
+			Input:             inputPayloads,
+			Identity:          request.GetIdentity(),
+			RequestId:         uuid.New(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateSchedulePoliciesResponse{}, nil
+}
+
 func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
 	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
 ) error {
@@ -1564,6 +2280,19 @@ func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
 	return nil
 }
 
+func (adh *AdminHandler) validateHistoryHost(hostAddress string) error {
+	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return err
+	}
+	for _, member := range resolver.Members() {
+		if member.GetAddress() == hostAddress {
+			return nil
+		}
+	}
+	return serviceerror.NewInvalidArgument(fmt.Sprintf(errHistoryHostNotFoundMessage, hostAddress))
+}
+
 func (adh *AdminHandler) validateRemoteClusterMetadata(metadata *adminservice.DescribeClusterResponse) error {
 	// Verify remote cluster config
 	currentClusterInfo := adh.clusterMetadata
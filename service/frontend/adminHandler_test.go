@@ -28,6 +28,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -52,23 +53,32 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
 
 	"go.temporal.io/server/api/adminservice/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	schedspb "go.temporal.io/server/api/schedule/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	clientmocks "go.temporal.io/server/client"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/worker/scheduler"
 )
 
 type (
@@ -88,6 +98,8 @@ type (
 		mockAdminClient            *adminservicemock.MockAdminServiceClient
 		mockMetadata               *cluster.MockMetadata
 		mockProducer               *persistence.MockNamespaceReplicationQueue
+		dynamicConfigOverrides     *dynamicconfig.OverrideClient
+		timeSource                 *clock.EventTimeSource
 
 		namespace      namespace.Name
 		namespaceID    namespace.ID
@@ -129,6 +141,11 @@ func (s *adminHandlerSuite) SetupTest() {
 	s.mockMetadata = s.mockResource.ClusterMetadata
 	s.mockVisibilityMgr = manager.NewMockVisibilityManager(s.controller)
 	s.mockProducer = persistence.NewMockNamespaceReplicationQueue(s.controller)
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+	s.dynamicConfigOverrides = dynamicconfig.NewOverrideClient(dynamicconfig.StaticClient(map[dynamicconfig.Key]any{
+		dynamicconfig.FrontendRPS:                        []dynamicconfig.ConstrainedValue{{Value: 1200}},
+		dynamicconfig.FrontendMaxNamespaceRPSPerInstance: []dynamicconfig.ConstrainedValue{{Value: 100}},
+	}), s.timeSource)
 
 	persistenceConfig := &config.Persistence{
 		NumHistoryShards: 1,
@@ -151,6 +168,7 @@ func (s *adminHandlerSuite) SetupTest() {
 		s.mockResource.GetClientFactory(),
 		s.mockResource.GetClientBean(),
 		s.mockResource.GetHistoryClient(),
+		s.mockResource.GetMatchingClient(),
 		s.mockResource.GetSDKClientFactory(),
 		s.mockResource.GetMembershipMonitor(),
 		s.mockResource.GetArchiverProvider(),
@@ -162,7 +180,8 @@ func (s *adminHandlerSuite) SetupTest() {
 		s.mockResource.GetArchivalMetadata(),
 		health.NewServer(),
 		serialization.NewSerializer(),
-		clock.NewRealTimeSource(),
+		s.timeSource,
+		s.dynamicConfigOverrides,
 	}
 	s.mockMetadata.EXPECT().GetCurrentClusterName().Return(uuid.New()).AnyTimes()
 	s.handler = NewAdminHandler(args)
@@ -1191,6 +1210,303 @@ func (s *adminHandlerSuite) Test_ListClusters_Success() {
 	s.Equal(0, len(resp.GetNextPageToken()))
 }
 
+func (s *adminHandlerSuite) TestMoveShard() {
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{
+		membership.NewHostInfo("10.0.0.1:7234", nil),
+	}).AnyTimes()
+	expired := &persistencespb.ShardOwnershipOverride{
+		ShardId:     2,
+		HostAddress: "10.0.0.2:7234",
+		ExpireTime:  timestamp.TimePtr(time.Now().Add(-time.Minute)),
+	}
+	s.mockClusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{ShardOwnershipOverrides: []*persistencespb.ShardOwnershipOverride{expired}},
+		Version:         5,
+	}, nil)
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.SaveClusterMetadataRequest) (bool, error) {
+			s.Equal(int64(5), request.Version)
+			s.Len(request.ShardOwnershipOverrides, 1)
+			s.Equal(int32(1), request.ShardOwnershipOverrides[0].GetShardId())
+			s.Equal("10.0.0.1:7234", request.ShardOwnershipOverrides[0].GetHostAddress())
+			return true, nil
+		},
+	)
+
+	// the overrides are reloaded on this host right away
+	s.mockResource.HistoryServiceResolver.EXPECT().RequestRefresh()
+
+	resp, err := s.handler.MoveShard(context.Background(), &adminservice.MoveShardRequest{
+		ShardId:     1,
+		HostAddress: "10.0.0.1:7234",
+		Ttl:         timestamp.DurationPtr(time.Hour),
+	})
+	s.NoError(err)
+	s.Equal(s.timeSource.Now().UTC().Add(time.Hour), timestamp.TimeValue(resp.GetExpireTime()))
+}
+
+func (s *adminHandlerSuite) TestMoveShard_InvalidRequest() {
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{
+		membership.NewHostInfo("10.0.0.1:7234", nil),
+	}).AnyTimes()
+
+	_, err := s.handler.MoveShard(context.Background(), &adminservice.MoveShardRequest{
+		ShardId:     2,
+		HostAddress: "10.0.0.1:7234",
+		Ttl:         timestamp.DurationPtr(time.Hour),
+	})
+	s.Equal(errInvalidShardID, err)
+
+	_, err = s.handler.MoveShard(context.Background(), &adminservice.MoveShardRequest{
+		ShardId:     1,
+		HostAddress: "10.0.0.1:7234",
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = s.handler.MoveShard(context.Background(), &adminservice.MoveShardRequest{
+		ShardId:     1,
+		HostAddress: "10.0.0.3:7234",
+		Ttl:         timestamp.DurationPtr(time.Hour),
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *adminHandlerSuite) TestMoveShard_RemoveOverride() {
+	override := &persistencespb.ShardOwnershipOverride{
+		ShardId:     1,
+		HostAddress: "10.0.0.1:7234",
+		ExpireTime:  timestamp.TimePtr(time.Now().Add(time.Hour)),
+	}
+	s.mockClusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{ShardOwnershipOverrides: []*persistencespb.ShardOwnershipOverride{override}},
+		Version:         5,
+	}, nil)
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.SaveClusterMetadataRequest) (bool, error) {
+			s.Empty(request.ShardOwnershipOverrides)
+			return false, nil
+		},
+	)
+
+	_, err := s.handler.MoveShard(context.Background(), &adminservice.MoveShardRequest{ShardId: 1})
+	s.Equal(errClusterMetadataConcurrentlyUpdated, err)
+}
+
+func (s *adminHandlerSuite) TestSetDynamicConfigOverride() {
+	constraints := &persistencespb.DynamicConfigConstraints{Namespace: "samples-namespace"}
+	replaced := &persistencespb.DynamicConfigOverride{
+		Key:         "frontend.RPS",
+		Constraints: constraints,
+		Value:       "100",
+		ExpireTime:  timestamp.TimePtr(time.Now().Add(time.Hour)),
+	}
+	other := &persistencespb.DynamicConfigOverride{
+		Key:        "frontend.namespaceRPS",
+		Value:      "100",
+		ExpireTime: timestamp.TimePtr(time.Now().Add(time.Hour)),
+	}
+	expired := &persistencespb.DynamicConfigOverride{
+		Key:        "frontend.namespaceCount",
+		Value:      "100",
+		ExpireTime: timestamp.TimePtr(time.Now().Add(-time.Minute)),
+	}
+	s.mockClusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{
+			DynamicConfigOverrides: []*persistencespb.DynamicConfigOverride{replaced, other, expired},
+		},
+		Version: 5,
+	}, nil)
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.SaveClusterMetadataRequest) (bool, error) {
+			s.Equal(int64(5), request.Version)
+			s.Len(request.DynamicConfigOverrides, 2)
+			s.Equal(other, request.DynamicConfigOverrides[0])
+			// the key is stored as given
+			s.Equal("Frontend.Rps", request.DynamicConfigOverrides[1].GetKey())
+			s.Equal("samples-namespace", request.DynamicConfigOverrides[1].GetConstraints().GetNamespace())
+			s.Equal("2400", request.DynamicConfigOverrides[1].GetValue())
+			return true, nil
+		},
+	)
+
+	resp, err := s.handler.SetDynamicConfigOverride(context.Background(), &adminservice.SetDynamicConfigOverrideRequest{
+		Key:         "Frontend.Rps",
+		Constraints: constraints,
+		Value:       "2400",
+		Ttl:         timestamp.DurationPtr(time.Hour),
+	})
+	s.NoError(err)
+	s.WithinDuration(time.Now().Add(time.Hour), timestamp.TimeValue(resp.GetExpireTime()), time.Minute)
+
+	// the override applies to this host right away
+	getResp, err := s.handler.GetDynamicConfig(context.Background(), &adminservice.GetDynamicConfigRequest{Key: "frontend.rps"})
+	s.NoError(err)
+	s.Len(getResp.GetValues(), 2)
+	for _, value := range getResp.GetValues() {
+		if value.GetConstraints().GetNamespace() == "samples-namespace" {
+			s.Equal("2400", value.GetValue())
+			s.Equal(resp.GetExpireTime(), value.GetOverrideExpireTime())
+		} else {
+			s.Equal("1200", value.GetValue())
+			s.Nil(value.GetOverrideExpireTime())
+		}
+	}
+	overrides := s.dynamicConfigOverrides.ListOverrides()
+	s.Len(overrides, 2)
+}
+
+func (s *adminHandlerSuite) TestSetDynamicConfigOverride_InvalidRequest() {
+	_, err := s.handler.SetDynamicConfigOverride(context.Background(), &adminservice.SetDynamicConfigOverrideRequest{
+		Value: "10",
+		Ttl:   timestamp.DurationPtr(time.Hour),
+	})
+	s.Equal(errDynamicConfigKeyNotSet, err)
+
+	_, err = s.handler.SetDynamicConfigOverride(context.Background(), &adminservice.SetDynamicConfigOverrideRequest{
+		Key:   "frontend.rps",
+		Value: "10",
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = s.handler.SetDynamicConfigOverride(context.Background(), &adminservice.SetDynamicConfigOverrideRequest{
+		Key:   "frontend.rps",
+		Value: "[10",
+		Ttl:   timestamp.DurationPtr(time.Hour),
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	// a misspelled key would create an override nothing reads
+	_, err = s.handler.SetDynamicConfigOverride(context.Background(), &adminservice.SetDynamicConfigOverrideRequest{
+		Key:   "frontend.rsp",
+		Value: "10",
+		Ttl:   timestamp.DurationPtr(time.Hour),
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Contains(err.Error(), "frontend.rsp")
+}
+
+func (s *adminHandlerSuite) TestListDynamicConfig() {
+	expireTime := s.timeSource.Now().Add(time.Hour)
+	s.dynamicConfigOverrides.Update([]dynamicconfig.Override{{
+		Key: dynamicconfig.FrontendMaxNamespaceRPSPerInstance,
+		ConstrainedValue: dynamicconfig.ConstrainedValue{
+			Constraints: dynamicconfig.Constraints{Namespace: "samples-namespace"},
+			Value:       200,
+		},
+		ExpireTime: expireTime,
+	}})
+
+	resp, err := s.handler.ListDynamicConfig(context.Background(), &adminservice.ListDynamicConfigRequest{
+		KeyPrefix: "Frontend.",
+	})
+	s.NoError(err)
+	s.Len(resp.GetKeys(), 2)
+	s.Equal(dynamicconfig.FrontendMaxNamespaceRPSPerInstance, resp.GetKeys()[0].GetKey())
+	s.Contains(resp.GetKeys()[0].GetDescription(), "FrontendMaxNamespaceRPSPerInstance")
+	s.Len(resp.GetKeys()[0].GetValues(), 2)
+	for _, value := range resp.GetKeys()[0].GetValues() {
+		if value.GetConstraints().GetNamespace() == "samples-namespace" {
+			s.Equal("200", value.GetValue())
+			s.Equal(expireTime, timestamp.TimeValue(value.GetOverrideExpireTime()))
+		} else {
+			s.Equal("100", value.GetValue())
+			s.Nil(value.GetOverrideExpireTime())
+		}
+	}
+	s.Equal(dynamicconfig.FrontendRPS, resp.GetKeys()[1].GetKey())
+	s.Equal("1200", resp.GetKeys()[1].GetValues()[0].GetValue())
+
+	resp, err = s.handler.ListDynamicConfig(context.Background(), &adminservice.ListDynamicConfigRequest{
+		KeyPrefix:    "frontend.",
+		IncludeUnset: true,
+	})
+	s.NoError(err)
+	s.Greater(len(resp.GetKeys()), 2)
+	for _, key := range resp.GetKeys() {
+		s.True(strings.HasPrefix(key.GetKey(), "frontend."))
+		if key.GetKey() != dynamicconfig.FrontendRPS && key.GetKey() != dynamicconfig.FrontendMaxNamespaceRPSPerInstance {
+			s.Empty(key.GetValues())
+		}
+	}
+}
+
+func (s *adminHandlerSuite) TestRemoveDynamicConfigOverride() {
+	override := &persistencespb.DynamicConfigOverride{
+		Key:        "frontend.RPS",
+		Value:      "100",
+		ExpireTime: timestamp.TimePtr(time.Now().Add(time.Hour)),
+	}
+	s.mockClusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{
+			DynamicConfigOverrides: []*persistencespb.DynamicConfigOverride{override},
+		},
+		Version: 5,
+	}, nil).Times(2)
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.SaveClusterMetadataRequest) (bool, error) {
+			s.Empty(request.DynamicConfigOverrides)
+			return false, nil
+		},
+	)
+
+	_, err := s.handler.RemoveDynamicConfigOverride(context.Background(), &adminservice.RemoveDynamicConfigOverrideRequest{
+		Key: "frontend.rps",
+	})
+	s.Equal(errClusterMetadataConcurrentlyUpdated, err)
+
+	listResp, err := s.handler.ListDynamicConfigOverrides(context.Background(), &adminservice.ListDynamicConfigOverridesRequest{})
+	s.NoError(err)
+	s.Equal([]*persistencespb.DynamicConfigOverride{override}, listResp.GetOverrides())
+}
+
+func (s *adminHandlerSuite) TestImportWorkflowExecution() {
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "workflowID",
+		RunId:      uuid.New(),
+	}
+	batches := []*commonpb.DataBlob{{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("batch")}}
+	versionHistory := versionhistory.NewVersionHistory(nil, []*historyspb.VersionHistoryItem{
+		versionhistory.NewVersionHistoryItem(int64(10), int64(100)),
+	})
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().ImportWorkflowExecution(gomock.Any(), &historyservice.ImportWorkflowExecutionRequest{
+		NamespaceId:    s.namespaceID.String(),
+		Execution:      execution,
+		HistoryBatches: batches,
+		VersionHistory: versionHistory,
+	}).Return(&historyservice.ImportWorkflowExecutionResponse{}, nil)
+
+	_, err := s.handler.ImportWorkflowExecution(context.Background(), &adminservice.ImportWorkflowExecutionRequest{
+		Namespace:      s.namespace.String(),
+		Execution:      execution,
+		HistoryBatches: batches,
+		VersionHistory: versionHistory,
+	})
+	s.NoError(err)
+
+	_, err = s.handler.ImportWorkflowExecution(context.Background(), &adminservice.ImportWorkflowExecutionRequest{
+		Namespace:      s.namespace.String(),
+		Execution:      &commonpb.WorkflowExecution{WorkflowId: "workflowID"},
+		HistoryBatches: batches,
+		VersionHistory: versionHistory,
+	})
+	s.Equal(errRunIDNotSet, err)
+
+	_, err = s.handler.ImportWorkflowExecution(context.Background(), &adminservice.ImportWorkflowExecutionRequest{
+		Namespace:      s.namespace.String(),
+		Execution:      execution,
+		VersionHistory: versionHistory,
+	})
+	s.Equal(errHistoryBatchesNotSet, err)
+
+	_, err = s.handler.ImportWorkflowExecution(context.Background(), &adminservice.ImportWorkflowExecutionRequest{
+		Namespace:      s.namespace.String(),
+		Execution:      execution,
+		HistoryBatches: batches,
+	})
+	s.Equal(errInvalidVersionHistories, err)
+}
+
 func (s *adminHandlerSuite) TestDeleteWorkflowExecution_DeleteCurrentExecution() {
 	execution := commonpb.WorkflowExecution{
 		WorkflowId: "workflowID",
@@ -1388,3 +1704,137 @@ func (s *adminHandlerSuite) TestDeleteWorkflowExecution_CassandraVisibilityBacke
 	_, err = s.handler.DeleteWorkflowExecution(context.Background(), request)
 	s.NoError(err)
 }
+
+func (s *adminHandlerSuite) TestDescribeTaskQueuePartitions_PartitionError() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.MatchingClient.EXPECT().ListTaskQueuePartitions(gomock.Any(), gomock.Any()).Return(&matchingservice.ListTaskQueuePartitionsResponse{
+		WorkflowTaskQueuePartitions: []*taskqueuepb.TaskQueuePartitionMetadata{
+			{Key: "tq", OwnerHostName: "host1"},
+			{Key: "/_sys/tq/1", OwnerHostName: "host2"},
+		},
+	}, nil)
+	s.mockResource.MatchingClient.EXPECT().DescribeTaskQueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.DescribeTaskQueueRequest, _ ...grpc.CallOption) (*matchingservice.DescribeTaskQueueResponse, error) {
+			if request.DescRequest.TaskQueue.Name == "tq" {
+				return nil, serviceerror.NewUnavailable("host1 unavailable")
+			}
+			return &matchingservice.DescribeTaskQueueResponse{
+				Pollers:      []*taskqueuepb.PollerInfo{{Identity: "poller"}},
+				BacklogStats: &taskqueuespb.BacklogStats{ApproximateBacklogCount: 5},
+			}, nil
+		},
+	).Times(2)
+
+	resp, err := s.handler.DescribeTaskQueuePartitions(context.Background(), &adminservice.DescribeTaskQueuePartitionsRequest{
+		Namespace: s.namespace.String(),
+		TaskQueue: "tq",
+	})
+	s.NoError(err)
+	s.Equal([]*taskqueuespb.TaskQueuePartitionStats{
+		{Name: "tq", OwnerHost: "host1", Error: "host1 unavailable"},
+		{Name: "/_sys/tq/1", OwnerHost: "host2", PollerCount: 1, BacklogStats: &taskqueuespb.BacklogStats{ApproximateBacklogCount: 5}},
+	}, resp.Partitions)
+}
+
+func (s *adminHandlerSuite) TestCountWorkflowExecutions() {
+	query := "WorkflowType = 'payment' GROUP BY ExecutionStatus"
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: s.namespaceID,
+		Namespace:   s.namespace,
+		Query:       query,
+	}).Return(&manager.CountWorkflowExecutionsResponse{
+		Count: 5,
+		Groups: []manager.CountWorkflowExecutionsGroup{
+			{GroupValues: []*commonpb.Payload{payload.EncodeString("Running")}, Count: 3},
+			{GroupValues: []*commonpb.Payload{payload.EncodeString("Completed")}, Count: 2},
+		},
+	}, nil)
+
+	resp, err := s.handler.CountWorkflowExecutions(context.Background(), &adminservice.CountWorkflowExecutionsRequest{
+		Namespace: s.namespace.String(),
+		Query:     query,
+	})
+	s.NoError(err)
+	s.Equal(int64(5), resp.GetCount())
+	s.Len(resp.GetGroups(), 2)
+	s.Equal([]*commonpb.Payload{payload.EncodeString("Running")}, resp.GetGroups()[0].GetGroupValues())
+	s.Equal(int64(3), resp.GetGroups()[0].GetCount())
+	s.Equal(int64(2), resp.GetGroups()[1].GetCount())
+
+	_, err = s.handler.CountWorkflowExecutions(context.Background(), &adminservice.CountWorkflowExecutionsRequest{Query: query})
+	s.Equal(errNamespaceNotSet, err)
+}
+
+func (s *adminHandlerSuite) TestDescribeSchedule() {
+	describeResponse := &schedspb.DescribeResponse{
+		Policies: &schedspb.InternalPolicies{PauseAfterFailures: 3},
+		RecentRunResults: []*schedspb.RunResult{{
+			Execution:      &commonpb.WorkflowExecution{WorkflowId: "myid-2022-06-01T00:05:00Z", RunId: "run-id"},
+			Status:         enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+			FailureMessage: "oops",
+		}},
+	}
+	queryResult, err := payloads.Encode(describeResponse)
+	s.NoError(err)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.QueryWorkflowRequest, _ ...grpc.CallOption) (*historyservice.QueryWorkflowResponse, error) {
+			s.Equal(s.namespaceID.String(), request.GetNamespaceId())
+			s.Equal(scheduler.WorkflowIDPrefix+"myschedule", request.GetRequest().GetExecution().GetWorkflowId())
+			s.Equal(scheduler.QueryNameDescribe, request.GetRequest().GetQuery().GetQueryType())
+			return &historyservice.QueryWorkflowResponse{
+				Response: &workflowservice.QueryWorkflowResponse{QueryResult: queryResult},
+			}, nil
+		},
+	)
+
+	resp, err := s.handler.DescribeSchedule(context.Background(), &adminservice.DescribeScheduleRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "myschedule",
+	})
+	s.NoError(err)
+	s.Equal(describeResponse.Policies, resp.GetPolicies())
+	s.Equal(describeResponse.RecentRunResults, resp.GetRecentRunResults())
+
+	_, err = s.handler.DescribeSchedule(context.Background(), &adminservice.DescribeScheduleRequest{Namespace: s.namespace.String()})
+	s.Equal(errScheduleIDNotSet, err)
+}
+
+func (s *adminHandlerSuite) TestUpdateSchedulePolicies() {
+	policies := &schedspb.InternalPolicies{PauseAfterFailures: 3}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.SignalWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.SignalWorkflowExecutionResponse, error) {
+			s.Equal(s.namespaceID.String(), request.GetNamespaceId())
+			s.Equal(scheduler.WorkflowIDPrefix+"myschedule", request.GetSignalRequest().GetWorkflowExecution().GetWorkflowId())
+			s.Equal(scheduler.SignalNameUpdatePolicies, request.GetSignalRequest().GetSignalName())
+			s.Equal("tdbg", request.GetSignalRequest().GetIdentity())
+			var input schedspb.InternalPolicies
+			s.NoError(payloads.Decode(request.GetSignalRequest().GetInput(), &input))
+			s.Equal(policies, &input)
+			return &historyservice.SignalWorkflowExecutionResponse{}, nil
+		},
+	)
+
+	_, err := s.handler.UpdateSchedulePolicies(context.Background(), &adminservice.UpdateSchedulePoliciesRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "myschedule",
+		Policies:   policies,
+		Identity:   "tdbg",
+	})
+	s.NoError(err)
+
+	_, err = s.handler.UpdateSchedulePolicies(context.Background(), &adminservice.UpdateSchedulePoliciesRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "myschedule",
+	})
+	s.Equal(errSchedulePoliciesNotSet, err)
+
+	_, err = s.handler.UpdateSchedulePolicies(context.Background(), &adminservice.UpdateSchedulePoliciesRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "myschedule",
+		Policies:   &schedspb.InternalPolicies{PauseAfterFailures: -1},
+	})
+	s.Equal(errInvalidPauseAfterFailures, err)
+}
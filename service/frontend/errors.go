@@ -33,6 +33,7 @@ var (
 	errWorkflowIDNotSet                                   = serviceerror.NewInvalidArgument("WorkflowId is not set on request.")
 	errActivityIDNotSet                                   = serviceerror.NewInvalidArgument("ActivityId is not set on request.")
 	errSignalNameNotSet                                   = serviceerror.NewInvalidArgument("SignalName is not set on request.")
+	errRunIDNotSet                                        = serviceerror.NewInvalidArgument("RunId is not set on request.")
 	errInvalidRunID                                       = serviceerror.NewInvalidArgument("Invalid RunId.")
 	errInvalidNextPageToken                               = serviceerror.NewInvalidArgument("Invalid NextPageToken.")
 	errNextPageTokenRunIDMismatch                         = serviceerror.NewInvalidArgument("RunId in the request does not match the NextPageToken.")
@@ -66,6 +67,7 @@ var (
 	errInvalidPaginationToken                             = serviceerror.NewInvalidArgument("Invalid pagination token.")
 	errInvalidFirstNextEventCombination                   = serviceerror.NewInvalidArgument("Invalid FirstEventId and NextEventId combination.")
 	errInvalidVersionHistories                            = serviceerror.NewInvalidArgument("Invalid version histories.")
+	errHistoryBatchesNotSet                               = serviceerror.NewInvalidArgument("HistoryBatches are not set on request.")
 	errInvalidEventQueryRange                             = serviceerror.NewInvalidArgument("Invalid event query range.")
 	errDLQTypeIsNotSupported                              = serviceerror.NewInvalidArgument("The DLQ type is not supported.")
 	errFailureMustHaveApplicationFailureInfo              = serviceerror.NewInvalidArgument("Failure must have ApplicationFailureInfo.")
@@ -78,6 +80,15 @@ var (
 	errNamespaceNotSet                                    = serviceerror.NewInvalidArgument("Namespace is not set on request.")
 	errReasonNotSet                                       = serviceerror.NewInvalidArgument("Reason is not set on request.")
 	errBatchOperationNotSet                               = serviceerror.NewInvalidArgument("Batch operation is not set on request.")
+	errBadBinaryChecksumNotSet                            = serviceerror.NewInvalidArgument("BadBinaryChecksum is not set on request.")
+	errBuildIDNotSet                                      = serviceerror.NewInvalidArgument("BuildId is not set on request.")
+	errInvalidShardID                                     = serviceerror.NewInvalidArgument("Invalid ShardId.")
+	errClusterMetadataConcurrentlyUpdated                 = serviceerror.NewUnavailable("Cluster metadata was updated concurrently, please retry.")
+	errDynamicConfigKeyNotSet                             = serviceerror.NewInvalidArgument("Key is not set on request.")
+	errScheduleIDNotSet                                   = serviceerror.NewInvalidArgument("ScheduleId is not set on request.")
+	errSchedulePoliciesNotSet                             = serviceerror.NewInvalidArgument("Policies are not set on request.")
+	errInvalidPauseAfterFailures                          = serviceerror.NewInvalidArgument("PauseAfterFailures must not be negative.")
+	errCountGroupByNotSupported                           = serviceerror.NewInvalidArgument("GROUP BY is not supported by CountWorkflowExecutions, use the CountWorkflowExecutions admin API instead.")
 
 	errPageSizeTooBigMessage = "PageSize is larger than allowed %d."
 
@@ -94,9 +105,16 @@ var (
 	errInvalidRemoteClusterInfo                       = "Unable connect to remote cluster with invalid config: %v."
 	errUnableToStoreClusterInfo                       = "Unable to persist cluster info with error: %v."
 	errUnableToDeleteClusterInfo                      = "Unable to delete cluster info with error: %v."
+	errInvalidShardOwnershipOverrideTTLMessage        = "Ttl must be set and at most %v when moving a shard to a host."
+	errInvalidDynamicConfigOverrideTTLMessage         = "Ttl must be set and at most %v when overriding a dynamic config value."
+	errInvalidDynamicConfigValueMessage               = "Invalid dynamic config value: %v."
+	errUnknownDynamicConfigKeyMessage                 = "Unknown dynamic config key %q. Use ListDynamicConfig with include_unset to see the keys the server reads."
+	errHistoryHostNotFoundMessage                     = "History host %s is not a member of the ring."
 
 	errListNotAllowed      = serviceerror.NewPermissionDenied("List is disabled on this namespace.", "")
 	errSchedulesNotAllowed = serviceerror.NewPermissionDenied("Schedules are disabled on this namespace.", "")
 
 	errBatchAPINotAllowed = serviceerror.NewPermissionDenied("Batch operation feature are disabled on this namespace.", "")
+
+	errBlackoutCalendarRefreshNotAllowed = serviceerror.NewFailedPrecondition("Blackout calendars can't be updated while batch operations are disabled on this namespace, the schedules that reference them would not be refreshed.")
 )
@@ -48,6 +48,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadpolicy"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/serialization"
@@ -82,6 +83,7 @@ var Module = fx.Options(
 	fx.Provide(NamespaceRateLimitInterceptorProvider),
 	fx.Provide(SDKVersionInterceptorProvider),
 	fx.Provide(CallerInfoInterceptorProvider),
+	fx.Provide(PayloadPolicyInterceptorProvider),
 	fx.Provide(GrpcServerOptionsProvider),
 	fx.Provide(VisibilityManagerProvider),
 	fx.Provide(ThrottledLoggerRpsFnProvider),
@@ -141,6 +143,7 @@ func GrpcServerOptionsProvider(
 	traceInterceptor telemetry.ServerTraceInterceptor,
 	sdkVersionInterceptor *interceptor.SDKVersionInterceptor,
 	callerInfoInterceptor *interceptor.CallerInfoInterceptor,
+	payloadPolicyInterceptor *interceptor.PayloadPolicyInterceptor,
 	authorizer authorization.Authorizer,
 	claimMapper authorization.ClaimMapper,
 	audienceGetter authorization.JWTAudienceMapper,
@@ -183,6 +186,7 @@ func GrpcServerOptionsProvider(
 		rateLimitInterceptor.Intercept,
 		sdkVersionInterceptor.Intercept,
 		callerInfoInterceptor.Intercept,
+		payloadPolicyInterceptor.Intercept,
 	}
 	if len(customInterceptors) > 0 {
 		// TODO: Deprecate WithChainedFrontendGrpcInterceptors and provide a inner custom interceptor
@@ -326,6 +330,13 @@ func CallerInfoInterceptorProvider(
 	return interceptor.NewCallerInfoInterceptor(namespaceRegistry)
 }
 
+func PayloadPolicyInterceptorProvider(
+	namespaceRegistry namespace.Registry,
+	policy payloadpolicy.Policy,
+) *interceptor.PayloadPolicyInterceptor {
+	return interceptor.NewPayloadPolicyInterceptor(namespaceRegistry, policy)
+}
+
 func PersistenceRateLimitingParamsProvider(
 	serviceConfig *Config,
 ) service.PersistenceRateLimitingParams {
@@ -402,6 +413,7 @@ func AdminHandlerProvider(
 	clientFactory client.Factory,
 	clientBean client.Bean,
 	historyClient historyservice.HistoryServiceClient,
+	matchingClient resource.MatchingClient,
 	sdkClientFactory sdk.ClientFactory,
 	membershipMonitor membership.Monitor,
 	archiverProvider provider.ArchiverProvider,
@@ -414,6 +426,7 @@ func AdminHandlerProvider(
 	healthServer *health.Server,
 	eventSerializer serialization.Serializer,
 	timeSource clock.TimeSource,
+	dynamicConfigOverrides *dynamicconfig.OverrideClient,
 ) *AdminHandler {
 	args := NewAdminHandlerArgs{
 		persistenceConfig,
@@ -431,6 +444,7 @@ func AdminHandlerProvider(
 		clientFactory,
 		clientBean,
 		historyClient,
+		matchingClient,
 		sdkClientFactory,
 		membershipMonitor,
 		archiverProvider,
@@ -443,6 +457,7 @@ func AdminHandlerProvider(
 		healthServer,
 		eventSerializer,
 		timeSource,
+		dynamicConfigOverrides,
 	}
 	return NewAdminHandler(args)
 }
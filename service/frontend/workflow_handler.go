@@ -29,6 +29,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -73,6 +74,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/rpc/interceptor"
@@ -85,10 +87,25 @@ import (
 
 var _ Handler = (*WorkflowHandler)(nil)
 
+const (
+	blackoutRefreshJobIDPrefix = "temporal-sys-blackout-refresh-"
+	blackoutRefreshIdentity    = "internal refresh from blackout calendar update"
+)
+
 var (
 	minTime = time.Unix(0, 0).UTC()
 	maxTime = time.Date(2100, 1, 1, 1, 0, 0, 0, time.UTC)
 
+	// runningSchedulesQuery selects the workflows of all running schedules in a namespace
+	runningSchedulesQuery = fmt.Sprintf("%s = '%s' AND %s = '%s' AND %s = %d",
+		searchattribute.TemporalNamespaceDivision,
+		scheduler.NamespaceDivision,
+		searchattribute.WorkflowType,
+		scheduler.WorkflowType,
+		searchattribute.ExecutionStatus,
+		int(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
+	)
+
 	// Tail room for context deadline to bail out from retry for long poll.
 	longPollTailRoom = time.Second
 
@@ -298,10 +315,19 @@ func (wh *WorkflowHandler) UpdateNamespace(ctx context.Context, request *workflo
 		return nil, errRequestNotSet
 	}
 
+	changedCalendars := wh.changedBlackoutCalendars(request)
+	if len(changedCalendars) > 0 && !wh.config.EnableBatcher(request.GetNamespace()) {
+		return nil, errBlackoutCalendarRefreshNotAllowed
+	}
+
 	resp, err := wh.namespaceHandler.UpdateNamespace(ctx, request)
 	if err != nil {
 		return resp, err
 	}
+
+	// The namespace is updated at this point, failing to start the schedule refresh isn't an error.
+	wh.refreshSchedulesForBlackoutCalendars(ctx, namespace.Name(request.GetNamespace()), changedCalendars)
+
 	return resp, err
 }
 
@@ -2521,6 +2547,12 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(ctx context.Context, request
 		return nil, errRequestNotSet
 	}
 
+	// The response has no groups, a grouped count would be answered with the total.
+	// Queries which can't be parsed are left to the visibility store to reject.
+	if sel, err := query.ParseWhereOrderBy(request.GetQuery()); err == nil && len(sel.GroupBy) > 0 {
+		return nil, errCountGroupByNotSupported
+	}
+
 	namespaceName := namespace.Name(request.GetNamespace())
 	namespaceID, err := wh.namespaceRegistry.GetNamespaceID(namespaceName)
 	if err != nil {
@@ -3774,15 +3806,31 @@ func (wh *WorkflowHandler) StartBatchOperation(
 		SignalParams:    signalParams,
 		DeleteParams:    batcher.DeleteParams{},
 	}
+	if err := startBatchOperationWorkflow(ctx, wh.historyClient, namespaceID, request.GetJobId(), identity, input); err != nil {
+		return nil, err
+	}
+	return &workflowservice.StartBatchOperationResponse{}, nil
+}
+
+// startBatchOperationWorkflow starts the batcher workflow running the given batch operation
+// on the per namespace worker of the target namespace.
+func startBatchOperationWorkflow(
+	ctx context.Context,
+	historyClient historyservice.HistoryServiceClient,
+	namespaceID namespace.ID,
+	jobID string,
+	identity string,
+	input *batcher.BatchParams,
+) error {
 	inputPayload, err := sdk.PreferProtoDataConverter.ToPayloads(input)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	memo := &commonpb.Memo{
 		Fields: map[string]*commonpb.Payload{
-			batcher.BatchOperationTypeMemo: payload.EncodeString(operationType),
-			batcher.BatchReasonMemo:        payload.EncodeString(request.GetReason()),
+			batcher.BatchOperationTypeMemo: payload.EncodeString(input.BatchType),
+			batcher.BatchReasonMemo:        payload.EncodeString(input.Reason),
 		},
 	}
 
@@ -3792,8 +3840,8 @@ func (wh *WorkflowHandler) StartBatchOperation(
 	searchattribute.AddSearchAttribute(&searchAttributes, searchattribute.TemporalNamespaceDivision, payload.EncodeString(batcher.NamespaceDivision))
 
 	startReq := &workflowservice.StartWorkflowExecutionRequest{
-		Namespace:             request.Namespace,
-		WorkflowId:            request.GetJobId(),
+		Namespace:             input.Namespace,
+		WorkflowId:            jobID,
 		WorkflowType:          &commonpb.WorkflowType{Name: batcher.BatchWFTypeName},
 		TaskQueue:             &taskqueuepb.TaskQueue{Name: primitives.PerNSWorkerTaskQueue},
 		Input:                 inputPayload,
@@ -3804,11 +3852,8 @@ func (wh *WorkflowHandler) StartBatchOperation(
 		SearchAttributes:      searchAttributes,
 	}
 
-	_, err = wh.historyClient.StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(namespaceID.String(), startReq, nil, time.Now().UTC()))
-	if err != nil {
-		return nil, err
-	}
-	return &workflowservice.StartBatchOperationResponse{}, nil
+	_, err = historyClient.StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(namespaceID.String(), startReq, nil, time.Now().UTC()))
+	return err
 }
 
 func (wh *WorkflowHandler) StopBatchOperation(
@@ -3931,6 +3976,10 @@ func (wh *WorkflowHandler) DescribeBatchOperation(
 		operationType = enumspb.BATCH_OPERATION_TYPE_TERMINATE
 	case batcher.BatchTypeDelete:
 		operationType = enumspb.BATCH_OPERATION_TYPE_DELETE
+	case batcher.BatchTypeReset, batcher.BatchTypeUpsertSearchAttributes:
+		// There is no public operation type for reset and upserting search attributes yet,
+		// these batch operations are started through the admin API.
+		operationType = enumspb.BATCH_OPERATION_TYPE_UNSPECIFIED
 	default:
 		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("The operation type %s is not supported", operationTypeString))
 	}
@@ -3949,7 +3998,7 @@ func (wh *WorkflowHandler) DescribeBatchOperation(
 		if err != nil {
 			return nil, err
 		}
-		batchOperationResp.TotalOperationCount = int64(stats.NumSuccess + stats.NumFailure)
+		batchOperationResp.TotalOperationCount = int64(stats.NumSuccess + stats.NumFailure + stats.NumSkipped)
 		batchOperationResp.FailureOperationCount = int64(stats.NumFailure)
 		batchOperationResp.CompleteOperationCount = int64(stats.NumSuccess)
 	} else {
@@ -4782,6 +4831,65 @@ func (wh *WorkflowHandler) canonicalizeScheduleSpec(schedule *schedpb.Schedule)
 	return nil
 }
 
+// changedBlackoutCalendars returns the sorted names of the blackout calendars that request
+// changes, or nil if it doesn't change any or schedules are disabled on the namespace.
+func (wh *WorkflowHandler) changedBlackoutCalendars(request *workflowservice.UpdateNamespaceRequest) []string {
+	changed := scheduler.BlackoutCalendarsFromNamespaceData(request.GetUpdateInfo().GetData())
+	if len(changed) == 0 || !wh.config.EnableSchedules(request.GetNamespace()) {
+		return nil
+	}
+	names := make([]string, 0, len(changed))
+	for name := range changed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// refreshSchedulesForBlackoutCalendars starts a batch operation that sends a refresh signal to the
+// running schedules in the namespace which reference one of the changed blackout calendars, so
+// they reload them. It returns once the batch operation is started.
+func (wh *WorkflowHandler) refreshSchedulesForBlackoutCalendars(ctx context.Context, namespaceName namespace.Name, names []string) {
+	if len(names) == 0 {
+		return
+	}
+
+	logger := log.With(wh.logger, tag.WorkflowNamespace(namespaceName.String()))
+	namespaceID, err := wh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		logger.Error("unable to refresh schedules for blackout calendars", tag.Error(err))
+		return
+	}
+
+	input := &batcher.BatchParams{
+		Namespace: namespaceName.String(),
+		Query:     blackoutCalendarSchedulesQuery(names),
+		Reason:    fmt.Sprintf("blackout calendars updated: %s", strings.Join(names, ", ")),
+		BatchType: batcher.BatchTypeSignal,
+		SignalParams: batcher.SignalParams{
+			SignalName: scheduler.SignalNameRefresh,
+		},
+	}
+	jobID := blackoutRefreshJobIDPrefix + uuid.New()
+	if err := startBatchOperationWorkflow(ctx, wh.historyClient, namespaceID, jobID, blackoutRefreshIdentity, input); err != nil {
+		logger.Error("unable to refresh schedules for blackout calendars", tag.WorkflowID(jobID), tag.Error(err))
+	}
+}
+
+// blackoutCalendarSchedulesQuery returns the query that selects the running schedules which
+// reference one of the named blackout calendars.
+func blackoutCalendarSchedulesQuery(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = "'" + strings.ReplaceAll(name, "'", "''") + "'"
+	}
+	return fmt.Sprintf("%s AND %s IN (%s)",
+		runningSchedulesQuery,
+		searchattribute.TemporalScheduleBlackoutCalendars,
+		strings.Join(quoted, ", "),
+	)
+}
+
 func (wh *WorkflowHandler) decodeScheduleListInfo(memo *commonpb.Memo) *schedpb.ScheduleListInfo {
 	var listInfo schedpb.ScheduleListInfo
 	var listInfoBytes []byte
@@ -4805,6 +4913,7 @@ func (wh *WorkflowHandler) cleanScheduleSearchAttributes(searchAttributes *commo
 	}
 
 	delete(fields, searchattribute.TemporalSchedulePaused)
+	delete(fields, searchattribute.TemporalScheduleBlackoutCalendars)
 	delete(fields, "TemporalScheduleInfoJSON") // used by older version, clean this up if present
 	// this isn't schedule-related but isn't relevant to the user for
 	// scheduler workflows since it's the server worker
@@ -72,11 +72,13 @@ import (
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resourcetest"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/scheduler"
 )
 
 const (
@@ -1863,6 +1865,11 @@ func (s *workflowHandlerSuite) TestCountWorkflowExecutions() {
 	resp, err := wh.CountWorkflowExecutions(ctx, countRequest)
 	s.NoError(err)
 	s.Equal(int64(5), resp.Count)
+
+	// the response can't carry the groups
+	countRequest.Query = "WorkflowType = 'wtype' GROUP BY ExecutionStatus"
+	_, err = wh.CountWorkflowExecutions(ctx, countRequest)
+	s.Equal(errCountGroupByNotSupported, err)
 }
 
 func (s *workflowHandlerSuite) TestVerifyHistoryIsComplete() {
@@ -2097,6 +2104,76 @@ func (s *workflowHandlerSuite) TestStartBatchOperation_Signal() {
 	s.NoError(err)
 }
 
+func (s *workflowHandlerSuite) TestRefreshSchedulesForBlackoutCalendars() {
+	testNamespace := namespace.Name("test-namespace")
+	namespaceID := namespace.ID(uuid.New())
+	wh := s.getWorkflowHandler(s.newConfig())
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(testNamespace).Return(namespaceID, nil)
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(
+			_ context.Context,
+			request *historyservice.StartWorkflowExecutionRequest,
+			_ ...grpc.CallOption,
+		) (*historyservice.StartWorkflowExecutionResponse, error) {
+			s.Equal(namespaceID.String(), request.NamespaceId)
+			s.Equal(batcher.BatchWFTypeName, request.StartRequest.WorkflowType.Name)
+			s.True(strings.HasPrefix(request.StartRequest.WorkflowId, blackoutRefreshJobIDPrefix))
+			var params batcher.BatchParams
+			s.NoError(payloads.Decode(request.StartRequest.Input, &params))
+			s.Equal(batcher.BatchTypeSignal, params.BatchType)
+			s.Equal(scheduler.SignalNameRefresh, params.SignalParams.SignalName)
+			s.Equal(runningSchedulesQuery+" AND TemporalScheduleBlackoutCalendars IN ('holidays', 'maintenance')", params.Query)
+			s.Equal("blackout calendars updated: holidays, maintenance", params.Reason)
+			return &historyservice.StartWorkflowExecutionResponse{}, nil
+		},
+	)
+
+	names := wh.changedBlackoutCalendars(&workflowservice.UpdateNamespaceRequest{
+		Namespace: testNamespace.String(),
+		UpdateInfo: &namespacepb.UpdateNamespaceInfo{
+			Data: map[string]string{
+				scheduler.BlackoutCalendarDataKeyPrefix + "maintenance": "{}",
+				scheduler.BlackoutCalendarDataKeyPrefix + "holidays":    "{}",
+				"other": "value",
+			},
+		},
+	})
+	s.Equal([]string{"holidays", "maintenance"}, names)
+	wh.refreshSchedulesForBlackoutCalendars(context.Background(), testNamespace, names)
+
+	// no blackout calendar changed
+	names = wh.changedBlackoutCalendars(&workflowservice.UpdateNamespaceRequest{
+		Namespace: testNamespace.String(),
+		UpdateInfo: &namespacepb.UpdateNamespaceInfo{
+			Data: map[string]string{"other": "value"},
+		},
+	})
+	s.Empty(names)
+	wh.refreshSchedulesForBlackoutCalendars(context.Background(), testNamespace, names)
+}
+
+func (s *workflowHandlerSuite) TestBlackoutCalendarSchedulesQuery() {
+	schedulesQuery := blackoutCalendarSchedulesQuery([]string{"it's", "maintenance"})
+	s.Equal(runningSchedulesQuery+" AND TemporalScheduleBlackoutCalendars IN ('it''s', 'maintenance')", schedulesQuery)
+	_, err := query.ParseWhereOrderBy(schedulesQuery)
+	s.NoError(err)
+}
+
+func (s *workflowHandlerSuite) TestUpdateNamespace_BlackoutCalendarsWithBatcherDisabled() {
+	config := s.newConfig()
+	config.EnableBatcher = dc.GetBoolPropertyFnFilteredByNamespace(false)
+	wh := s.getWorkflowHandler(config)
+
+	// the namespace isn't updated, since the schedules that reference the calendar wouldn't be refreshed
+	_, err := wh.UpdateNamespace(context.Background(), &workflowservice.UpdateNamespaceRequest{
+		Namespace: "test-namespace",
+		UpdateInfo: &namespacepb.UpdateNamespaceInfo{
+			Data: map[string]string{scheduler.BlackoutCalendarDataKeyPrefix + "maintenance": "{}"},
+		},
+	})
+	s.Equal(errBlackoutCalendarRefreshNotAllowed, err)
+}
+
 func (s *workflowHandlerSuite) TestStartBatchOperation_InvalidRequest() {
 	request := &workflowservice.StartBatchOperationRequest{
 		Namespace: "",
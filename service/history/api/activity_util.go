@@ -28,9 +28,14 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/gogo/protobuf/proto"
+	failurepb "go.temporal.io/api/failure/v1"
 	"go.temporal.io/api/serviceerror"
 
 	tokenspb "go.temporal.io/server/api/token/v1"
+	"go.temporal.io/server/common/failure"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadpolicy"
 	"go.temporal.io/server/service/history/workflow"
 )
 
@@ -76,3 +81,21 @@ func GetActivityScheduledEventID(
 	}
 	return activityInfo.ScheduledEventId, nil
 }
+
+// ActivityPayloadPolicyFailure returns a non retryable failure naming the field of an activity task
+// response that the payload policy of namespaceName does not accept, or nil if the policy accepts
+// the response. Like a response that exceeds the blob size limit, such a response fails the
+// activity instead of being recorded.
+func ActivityPayloadPolicyFailure(
+	payloadPolicy payloadpolicy.Policy,
+	namespaceName namespace.Name,
+	response proto.Message,
+) *failurepb.Failure {
+	if payloadPolicy == nil {
+		return nil
+	}
+	if err := payloadPolicy.Check(namespaceName, response); err != nil {
+		return failure.NewServerFailure(err.Error(), true)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package prewarmcache
+
+import (
+	"context"
+
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/shard"
+)
+
+// Invoke loads the mutable state and start event of the given workflows into the
+// workflow and events caches, and returns the number of workflows that were loaded.
+// Workflows that fail to load are skipped, as they will be loaded on demand anyway.
+func Invoke(
+	ctx context.Context,
+	workflowKeys []definition.WorkflowKey,
+	shard shard.Context,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) int {
+	prewarmed := 0
+	for _, workflowKey := range workflowKeys {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := prewarmWorkflow(ctx, workflowKey, workflowConsistencyChecker); err != nil {
+			shard.GetLogger().Debug("Unable to prewarm workflow caches",
+				tag.WorkflowNamespaceID(workflowKey.NamespaceID),
+				tag.WorkflowID(workflowKey.WorkflowID),
+				tag.WorkflowRunID(workflowKey.RunID),
+				tag.Error(err),
+			)
+			continue
+		}
+		prewarmed++
+	}
+	return prewarmed
+}
+
+func prewarmWorkflow(
+	ctx context.Context,
+	workflowKey definition.WorkflowKey,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (retError error) {
+	wfContext, err := workflowConsistencyChecker.GetWorkflowContext(
+		ctx,
+		nil,
+		api.BypassMutableStateConsistencyPredicate,
+		workflowKey,
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { wfContext.GetReleaseFn()(retError) }()
+
+	_, err = wfContext.GetMutableState().GetStartEvent(ctx)
+	return err
+}
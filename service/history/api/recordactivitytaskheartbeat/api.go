@@ -27,12 +27,16 @@ package recordactivitytaskheartbeat
 import (
 	"context"
 
+	"go.temporal.io/api/workflowservice/v1"
+
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadpolicy"
 	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/api/respondactivitytaskfailed"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/shard"
 )
@@ -42,13 +46,30 @@ func Invoke(
 	req *historyservice.RecordActivityTaskHeartbeatRequest,
 	shard shard.Context,
 	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	payloadPolicy payloadpolicy.Policy,
 ) (resp *historyservice.RecordActivityTaskHeartbeatResponse, retError error) {
-	_, err := api.GetActiveNamespace(shard, namespace.ID(req.GetNamespaceId()))
+	namespaceEntry, err := api.GetActiveNamespace(shard, namespace.ID(req.GetNamespaceId()))
 	if err != nil {
 		return nil, err
 	}
 
 	request := req.HeartbeatRequest
+	if policyFailure := api.ActivityPayloadPolicyFailure(payloadPolicy, namespaceEntry.Name(), request); policyFailure != nil {
+		// heartbeat details are not accepted by the namespace, we would fail the activity immediately with explicit error reason
+		_, err := respondactivitytaskfailed.Invoke(ctx, &historyservice.RespondActivityTaskFailedRequest{
+			NamespaceId: req.GetNamespaceId(),
+			FailedRequest: &workflowservice.RespondActivityTaskFailedRequest{
+				TaskToken: request.TaskToken,
+				Failure:   policyFailure,
+				Identity:  request.Identity,
+				Namespace: request.Namespace,
+			},
+		}, shard, workflowConsistencyChecker, payloadPolicy)
+		if err != nil {
+			return nil, err
+		}
+		return &historyservice.RecordActivityTaskHeartbeatResponse{CancelRequested: true}, nil
+	}
 	tokenSerializer := common.NewProtoTaskTokenSerializer()
 	token, err0 := tokenSerializer.Deserialize(request.TaskToken)
 	if err0 != nil {
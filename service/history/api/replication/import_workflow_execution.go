@@ -0,0 +1,99 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/ndc"
+	"go.temporal.io/server/service/history/shard"
+)
+
+// ImportWorkflowExecution applies history batches exported from another cluster through the
+// replication path, one batch at a time. Batches which are already written are skipped by the
+// replicator, so an interrupted import can be retried with the same batches.
+func ImportWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ImportWorkflowExecutionRequest,
+	shard shard.Context,
+	historyReplicator ndc.HistoryReplicator,
+) (*historyservice.ImportWorkflowExecutionResponse, error) {
+	namespaceEntry, err := api.GetActiveNamespace(shard, namespace.ID(request.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+	versionHistory := request.GetVersionHistory()
+	if versionhistory.IsEmptyVersionHistory(versionHistory) {
+		return nil, serviceerror.NewInvalidArgument("Version history is not set on request.")
+	}
+
+	serializer := shard.GetPayloadSerializer()
+	for _, blob := range request.GetHistoryBatches() {
+		events, err := serializer.DeserializeEvents(blob)
+		if err != nil {
+			return nil, err
+		}
+		if len(events) == 0 {
+			return nil, serviceerror.NewInvalidArgument("History batch is empty.")
+		}
+		lastEvent := events[len(events)-1]
+		// local namespaces only accept events with empty version, see cluster.Metadata.ClusterNameForFailoverVersion
+		if !namespaceEntry.IsGlobalNamespace() && lastEvent.GetVersion() != common.EmptyVersion {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(
+				"History events have failover version %v and can only be imported into a global namespace.",
+				lastEvent.GetVersion(),
+			))
+		}
+		batchVersionHistory, err := versionhistory.CopyVersionHistoryUntilLCAVersionHistoryItem(
+			versionHistory,
+			&historyspb.VersionHistoryItem{EventId: lastEvent.GetEventId(), Version: lastEvent.GetVersion()},
+		)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(
+				"History batch ending with event %v does not belong to the version history.",
+				lastEvent.GetEventId(),
+			))
+		}
+
+		if err := historyReplicator.ApplyEvents(ctx, &historyservice.ReplicateEventsV2Request{
+			NamespaceId:         namespaceEntry.ID().String(),
+			WorkflowExecution:   request.GetExecution(),
+			VersionHistoryItems: batchVersionHistory.GetItems(),
+			Events:              blob,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return &historyservice.ImportWorkflowExecutionResponse{}, nil
+}
@@ -0,0 +1,183 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tests"
+)
+
+type (
+	importWorkflowExecutionSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller        *gomock.Controller
+		shardContext      *shard.MockContext
+		namespaceRegistry *namespace.MockRegistry
+		serializer        serialization.Serializer
+		replicator        *fakeHistoryReplicator
+	}
+
+	fakeHistoryReplicator struct {
+		requests []*historyservice.ReplicateEventsV2Request
+		err      error
+	}
+)
+
+func TestImportWorkflowExecutionSuite(t *testing.T) {
+	suite.Run(t, new(importWorkflowExecutionSuite))
+}
+
+func (s *importWorkflowExecutionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.shardContext = shard.NewMockContext(s.controller)
+	s.namespaceRegistry = namespace.NewMockRegistry(s.controller)
+	s.serializer = serialization.NewSerializer()
+	s.replicator = &fakeHistoryReplicator{}
+
+	clusterMetadata := cluster.NewMockMetadata(s.controller)
+	clusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.shardContext.EXPECT().GetClusterMetadata().Return(clusterMetadata).AnyTimes()
+	s.shardContext.EXPECT().GetNamespaceRegistry().Return(s.namespaceRegistry).AnyTimes()
+	s.shardContext.EXPECT().GetPayloadSerializer().Return(s.serializer).AnyTimes()
+}
+
+func (s *importWorkflowExecutionSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *importWorkflowExecutionSuite) TestImportWorkflowExecution() {
+	s.namespaceRegistry.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.GlobalNamespaceEntry, nil)
+	request := s.newRequest(tests.Version, []int64{1, 3}, []int64{2, 5})
+
+	_, err := ImportWorkflowExecution(context.Background(), request, s.shardContext, s.replicator)
+	s.NoError(err)
+	s.Len(s.replicator.requests, 2)
+	for i, lastEventID := range []int64{2, 5} {
+		replicateRequest := s.replicator.requests[i]
+		s.Equal(tests.NamespaceID.String(), replicateRequest.NamespaceId)
+		s.Equal(request.Execution, replicateRequest.WorkflowExecution)
+		s.Equal(request.HistoryBatches[i], replicateRequest.Events)
+		s.Equal([]*historyspb.VersionHistoryItem{{EventId: lastEventID, Version: tests.Version}}, replicateRequest.VersionHistoryItems)
+	}
+}
+
+func (s *importWorkflowExecutionSuite) TestImportWorkflowExecution_LocalNamespace() {
+	s.namespaceRegistry.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.LocalNamespaceEntry, nil).Times(2)
+
+	_, err := ImportWorkflowExecution(context.Background(), s.newRequest(common.EmptyVersion, []int64{1}, []int64{2}), s.shardContext, s.replicator)
+	s.NoError(err)
+	s.Len(s.replicator.requests, 1)
+
+	_, err = ImportWorkflowExecution(context.Background(), s.newRequest(tests.Version, []int64{1}, []int64{2}), s.shardContext, s.replicator)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Len(s.replicator.requests, 1)
+}
+
+func (s *importWorkflowExecutionSuite) TestImportWorkflowExecution_BatchNotInVersionHistory() {
+	s.namespaceRegistry.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.GlobalNamespaceEntry, nil)
+	request := s.newRequest(tests.Version, []int64{1}, []int64{2})
+	request.VersionHistory.Items[0].EventId = 1
+
+	_, err := ImportWorkflowExecution(context.Background(), request, s.shardContext, s.replicator)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Empty(s.replicator.requests)
+}
+
+func (s *importWorkflowExecutionSuite) TestImportWorkflowExecution_ReplicatorError() {
+	s.namespaceRegistry.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.GlobalNamespaceEntry, nil)
+	s.replicator.err = serviceerror.NewUnavailable("unavailable")
+
+	_, err := ImportWorkflowExecution(context.Background(), s.newRequest(tests.Version, []int64{1, 3}, []int64{2, 5}), s.shardContext, s.replicator)
+	s.Equal(s.replicator.err, err)
+	s.Len(s.replicator.requests, 1)
+}
+
+// newRequest returns a request with one history batch per firstEventIDs and lastEventIDs pair.
+func (s *importWorkflowExecutionSuite) newRequest(
+	version int64,
+	firstEventIDs []int64,
+	lastEventIDs []int64,
+) *historyservice.ImportWorkflowExecutionRequest {
+	request := &historyservice.ImportWorkflowExecutionRequest{
+		NamespaceId: tests.NamespaceID.String(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: tests.WorkflowID,
+			RunId:      tests.RunID,
+		},
+		VersionHistory: &historyspb.VersionHistory{
+			Items: []*historyspb.VersionHistoryItem{{EventId: lastEventIDs[len(lastEventIDs)-1], Version: version}},
+		},
+	}
+	for i, firstEventID := range firstEventIDs {
+		var events []*historypb.HistoryEvent
+		for eventID := firstEventID; eventID <= lastEventIDs[i]; eventID++ {
+			events = append(events, &historypb.HistoryEvent{
+				EventId:   eventID,
+				Version:   version,
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			})
+		}
+		blob, err := s.serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+		s.NoError(err)
+		request.HistoryBatches = append(request.HistoryBatches, blob)
+	}
+	return request
+}
+
+func (r *fakeHistoryReplicator) ApplyEvents(
+	_ context.Context,
+	request *historyservice.ReplicateEventsV2Request,
+) error {
+	r.requests = append(r.requests, request)
+	return r.err
+}
+
+func (r *fakeHistoryReplicator) ApplyWorkflowState(
+	_ context.Context,
+	_ *historyservice.ReplicateWorkflowStateRequest,
+) error {
+	return nil
+}
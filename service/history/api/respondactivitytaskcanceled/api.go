@@ -28,12 +28,16 @@ import (
 	"context"
 	"time"
 
+	"go.temporal.io/api/workflowservice/v1"
+
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadpolicy"
 	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/api/respondactivitytaskfailed"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/shard"
 )
@@ -43,6 +47,7 @@ func Invoke(
 	req *historyservice.RespondActivityTaskCanceledRequest,
 	shard shard.Context,
 	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	payloadPolicy payloadpolicy.Policy,
 ) (resp *historyservice.RespondActivityTaskCanceledResponse, retError error) {
 	namespaceEntry, err := api.GetActiveNamespace(shard, namespace.ID(req.GetNamespaceId()))
 	if err != nil {
@@ -51,6 +56,22 @@ func Invoke(
 	namespace := namespaceEntry.Name()
 
 	request := req.CancelRequest
+	if policyFailure := api.ActivityPayloadPolicyFailure(payloadPolicy, namespace, request); policyFailure != nil {
+		// the details are not accepted by the namespace, we would fail the activity immediately with explicit error reason
+		_, err := respondactivitytaskfailed.Invoke(ctx, &historyservice.RespondActivityTaskFailedRequest{
+			NamespaceId: req.GetNamespaceId(),
+			FailedRequest: &workflowservice.RespondActivityTaskFailedRequest{
+				TaskToken: request.TaskToken,
+				Failure:   policyFailure,
+				Identity:  request.Identity,
+				Namespace: request.Namespace,
+			},
+		}, shard, workflowConsistencyChecker, payloadPolicy)
+		if err != nil {
+			return nil, err
+		}
+		return &historyservice.RespondActivityTaskCanceledResponse{}, nil
+	}
 	tokenSerializer := common.NewProtoTaskTokenSerializer()
 	token, err0 := tokenSerializer.Deserialize(request.TaskToken)
 	if err0 != nil {
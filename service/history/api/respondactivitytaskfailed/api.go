@@ -36,6 +36,7 @@ import (
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadpolicy"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/shard"
@@ -46,6 +47,7 @@ func Invoke(
 	req *historyservice.RespondActivityTaskFailedRequest,
 	shard shard.Context,
 	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	payloadPolicy payloadpolicy.Policy,
 ) (resp *historyservice.RespondActivityTaskFailedResponse, retError error) {
 	namespaceEntry, err := api.GetActiveNamespace(shard, namespace.ID(req.GetNamespaceId()))
 	if err != nil {
@@ -54,6 +56,12 @@ func Invoke(
 	namespace := namespaceEntry.Name()
 
 	request := req.FailedRequest
+	if policyFailure := api.ActivityPayloadPolicyFailure(payloadPolicy, namespace, request); policyFailure != nil {
+		// the failure or heartbeat details are not accepted by the namespace, we would fail the activity
+		// with explicit error reason instead
+		request.Failure = policyFailure
+		request.LastHeartbeatDetails = nil
+	}
 	tokenSerializer := common.NewProtoTaskTokenSerializer()
 	token, err0 := tokenSerializer.Deserialize(request.TaskToken)
 	if err0 != nil {
@@ -44,6 +44,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadpolicy"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/configs"
@@ -77,6 +78,7 @@ type (
 
 		mutableState              workflow.MutableState
 		searchAttributesValidator *searchattribute.Validator
+		payloadPolicy             payloadpolicy.Policy
 		executionStats            *persistencespb.ExecutionStats
 		metricsHandler            metrics.Handler
 		logger                    log.Logger
@@ -87,6 +89,24 @@ const (
 	reservedTaskQueuePrefix = "/_sys/"
 )
 
+// badCommandAttributesCauses maps command types to the cause reported when their attributes are rejected.
+var badCommandAttributesCauses = map[enumspb.CommandType]enumspb.WorkflowTaskFailedCause{
+	enumspb.COMMAND_TYPE_SCHEDULE_ACTIVITY_TASK:                     enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SCHEDULE_ACTIVITY_ATTRIBUTES,
+	enumspb.COMMAND_TYPE_REQUEST_CANCEL_ACTIVITY_TASK:               enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES,
+	enumspb.COMMAND_TYPE_START_TIMER:                                enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_START_TIMER_ATTRIBUTES,
+	enumspb.COMMAND_TYPE_CANCEL_TIMER:                               enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_CANCEL_TIMER_ATTRIBUTES,
+	enumspb.COMMAND_TYPE_RECORD_MARKER:                              enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_RECORD_MARKER_ATTRIBUTES,
+	enumspb.COMMAND_TYPE_COMPLETE_WORKFLOW_EXECUTION:                enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES,
+	enumspb.COMMAND_TYPE_FAIL_WORKFLOW_EXECUTION:                    enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES,
+	enumspb.COMMAND_TYPE_CANCEL_WORKFLOW_EXECUTION:                  enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES,
+	enumspb.COMMAND_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION: enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES,
+	enumspb.COMMAND_TYPE_CONTINUE_AS_NEW_WORKFLOW_EXECUTION:         enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_CONTINUE_AS_NEW_ATTRIBUTES,
+	enumspb.COMMAND_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION:         enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES,
+	enumspb.COMMAND_TYPE_START_CHILD_WORKFLOW_EXECUTION:             enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_START_CHILD_EXECUTION_ATTRIBUTES,
+	enumspb.COMMAND_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES:          enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SEARCH_ATTRIBUTES,
+	enumspb.COMMAND_TYPE_MODIFY_WORKFLOW_PROPERTIES:                 enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_MODIFY_WORKFLOW_PROPERTIES_ATTRIBUTES,
+}
+
 func newCommandAttrValidator(
 	namespaceRegistry namespace.Registry,
 	config *configs.Config,
@@ -107,6 +127,7 @@ func newWorkflowSizeChecker(
 	limits workflowSizeLimits,
 	mutableState workflow.MutableState,
 	searchAttributesValidator *searchattribute.Validator,
+	payloadPolicy payloadpolicy.Policy,
 	executionStats *persistencespb.ExecutionStats,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
@@ -115,6 +136,7 @@ func newWorkflowSizeChecker(
 		workflowSizeLimits:        limits,
 		mutableState:              mutableState,
 		searchAttributesValidator: searchAttributesValidator,
+		payloadPolicy:             payloadPolicy,
 		executionStats:            executionStats,
 		metricsHandler:            metricsHandler,
 		logger:                    logger,
@@ -174,6 +196,35 @@ func (c *workflowSizeChecker) checkIfMemoSizeExceedsLimit(
 	return nil
 }
 
+// checkPayloadPolicy checks the payloads of command against the payload policy of the workflow namespace and, for
+// commands targeting another namespace, of that namespace too.
+func (c *workflowSizeChecker) checkPayloadPolicy(
+	command *commandpb.Command,
+) (enumspb.WorkflowTaskFailedCause, error) {
+	if c.payloadPolicy == nil {
+		return enumspb.WORKFLOW_TASK_FAILED_CAUSE_UNSPECIFIED, nil
+	}
+
+	namespaceNames := []namespace.Name{c.mutableState.GetNamespaceEntry().Name()}
+	var targetNamespace string
+	switch command.GetCommandType() {
+	case enumspb.COMMAND_TYPE_START_CHILD_WORKFLOW_EXECUTION:
+		targetNamespace = command.GetStartChildWorkflowExecutionCommandAttributes().GetNamespace()
+	case enumspb.COMMAND_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION:
+		targetNamespace = command.GetSignalExternalWorkflowExecutionCommandAttributes().GetNamespace()
+	}
+	if targetNamespace != "" && targetNamespace != namespaceNames[0].String() {
+		namespaceNames = append(namespaceNames, namespace.Name(targetNamespace))
+	}
+
+	for _, namespaceName := range namespaceNames {
+		if err := c.payloadPolicy.Check(namespaceName, command); err != nil {
+			return badCommandAttributesCauses[command.GetCommandType()], err
+		}
+	}
+	return enumspb.WORKFLOW_TASK_FAILED_CAUSE_UNSPECIFIED, nil
+}
+
 func withinLimit(value int, limit int) bool {
 	if limit <= 0 {
 		// limit not defined
@@ -29,6 +29,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -858,7 +859,7 @@ func TestWorkflowSizeChecker_NumChildWorkflows(t *testing.T) {
 				numPendingActivitiesLimit:      c.PendingActivitiesLimit,
 				numPendingCancelsRequestLimit:  c.PendingCancelRequestsLimit,
 				numPendingSignalsLimit:         c.PendingSignalsLimit,
-			}, mutableState, nil, nil, metricsHandler, logger)
+			}, mutableState, nil, nil, nil, metricsHandler, logger)
 
 			err := checker.checkIfNumChildWorkflowsExceedsLimit()
 			if len(c.ExpectedChildExecutionsErrorMsg) > 0 {
@@ -894,3 +895,57 @@ func TestWorkflowSizeChecker_NumChildWorkflows(t *testing.T) {
 		})
 	}
 }
+
+type recordingPayloadPolicy struct {
+	rejected   namespace.Name
+	namespaces []namespace.Name
+}
+
+func (p *recordingPayloadPolicy) Check(namespaceName namespace.Name, _ proto.Message) error {
+	p.namespaces = append(p.namespaces, namespaceName)
+	if namespaceName == p.rejected {
+		return serviceerror.NewInvalidArgument("payload policy violated")
+	}
+	return nil
+}
+
+func TestWorkflowSizeChecker_PayloadPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mutableState := workflow.NewMockMutableState(ctrl)
+	mutableState.EXPECT().GetNamespaceEntry().Return(tests.LocalNamespaceEntry).AnyTimes()
+
+	signalCommand := &commandpb.Command{
+		CommandType: enumspb.COMMAND_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION,
+		Attributes: &commandpb.Command_SignalExternalWorkflowExecutionCommandAttributes{
+			SignalExternalWorkflowExecutionCommandAttributes: &commandpb.SignalExternalWorkflowExecutionCommandAttributes{
+				Namespace: tests.TargetNamespace.String(),
+			},
+		},
+	}
+
+	checker := newWorkflowSizeChecker(workflowSizeLimits{}, mutableState, nil, nil, nil, metrics.NoopMetricsHandler, log.NewNoopLogger())
+	cause, err := checker.checkPayloadPolicy(signalCommand)
+	assert.NoError(t, err)
+	assert.Equal(t, enumspb.WORKFLOW_TASK_FAILED_CAUSE_UNSPECIFIED, cause)
+
+	policy := &recordingPayloadPolicy{}
+	checker = newWorkflowSizeChecker(workflowSizeLimits{}, mutableState, nil, policy, nil, metrics.NoopMetricsHandler, log.NewNoopLogger())
+	_, err = checker.checkPayloadPolicy(signalCommand)
+	assert.NoError(t, err)
+	assert.Equal(t, []namespace.Name{tests.Namespace, tests.TargetNamespace}, policy.namespaces)
+
+	policy = &recordingPayloadPolicy{rejected: tests.TargetNamespace}
+	checker = newWorkflowSizeChecker(workflowSizeLimits{}, mutableState, nil, policy, nil, metrics.NoopMetricsHandler, log.NewNoopLogger())
+	cause, err = checker.checkPayloadPolicy(signalCommand)
+	assert.IsType(t, &serviceerror.InvalidArgument{}, err)
+	assert.Equal(t, enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES, cause)
+
+	policy = &recordingPayloadPolicy{rejected: tests.Namespace}
+	checker = newWorkflowSizeChecker(workflowSizeLimits{}, mutableState, nil, policy, nil, metrics.NoopMetricsHandler, log.NewNoopLogger())
+	cause, err = checker.checkPayloadPolicy(&commandpb.Command{CommandType: enumspb.COMMAND_TYPE_START_TIMER})
+	assert.IsType(t, &serviceerror.InvalidArgument{}, err)
+	assert.Equal(t, enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_START_TIMER_ATTRIBUTES, cause)
+	assert.Equal(t, []namespace.Name{tests.Namespace}, policy.namespaces)
+}
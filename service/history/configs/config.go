@@ -76,9 +76,20 @@ type Config struct {
 	EventsCacheTTL         dynamicconfig.DurationPropertyFn
 
 	// ShardController settings
-	RangeSizeBits           uint
-	AcquireShardInterval    dynamicconfig.DurationPropertyFn
-	AcquireShardConcurrency dynamicconfig.IntPropertyFn
+	RangeSizeBits                    uint
+	AcquireShardInterval             dynamicconfig.DurationPropertyFn
+	AcquireShardConcurrency          dynamicconfig.IntPropertyFn
+	ShardHandoffEnabled              dynamicconfig.BoolPropertyFn
+	ShardHandoffConcurrency          dynamicconfig.IntPropertyFn
+	ShardHandoffTimeout              dynamicconfig.DurationPropertyFn
+	ShardHandoffPrewarmWorkflowCount dynamicconfig.IntPropertyFn
+	ShardLoadWindow                  dynamicconfig.DurationPropertyFn
+	ShardLoadMaxTrackedWorkflows     dynamicconfig.IntPropertyFn
+	ShardLoadTopWorkflowCount        dynamicconfig.IntPropertyFn
+	HotWorkflowReportInterval        dynamicconfig.DurationPropertyFn
+	HotWorkflowRequestThreshold      dynamicconfig.IntPropertyFn
+	WorkflowRPS                      dynamicconfig.IntPropertyFnWithNamespaceFilter
+	WorkflowRateLimiterCacheSize     dynamicconfig.IntPropertyFn
 
 	// the artificial delay added to standby cluster's view of active cluster's time
 	StandbyClusterDelay                  dynamicconfig.DurationPropertyFn
@@ -94,6 +105,7 @@ type Config struct {
 	TaskSchedulerEnableRateLimiter dynamicconfig.BoolPropertyFn
 	TaskSchedulerMaxQPS            dynamicconfig.IntPropertyFn
 	TaskSchedulerNamespaceMaxQPS   dynamicconfig.IntPropertyFnWithNamespaceFilter
+	TaskSchedulerNamespaceWeight   dynamicconfig.IntPropertyFnWithNamespaceFilter
 
 	// TimerQueueProcessor settings
 	TimerTaskHighPriorityRPS                         dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -339,6 +351,17 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		RangeSizeBits:                        20, // 20 bits for sequencer, 2^20 sequence number for any range
 		AcquireShardInterval:                 dc.GetDurationProperty(dynamicconfig.AcquireShardInterval, time.Minute),
 		AcquireShardConcurrency:              dc.GetIntProperty(dynamicconfig.AcquireShardConcurrency, 10),
+		ShardHandoffEnabled:                  dc.GetBoolProperty(dynamicconfig.ShardHandoffEnabled, false),
+		ShardHandoffConcurrency:              dc.GetIntProperty(dynamicconfig.ShardHandoffConcurrency, 10),
+		ShardHandoffTimeout:                  dc.GetDurationProperty(dynamicconfig.ShardHandoffTimeout, 5*time.Second),
+		ShardHandoffPrewarmWorkflowCount:     dc.GetIntProperty(dynamicconfig.ShardHandoffPrewarmWorkflowCount, 100),
+		ShardLoadWindow:                      dc.GetDurationProperty(dynamicconfig.ShardLoadWindow, time.Minute),
+		ShardLoadMaxTrackedWorkflows:         dc.GetIntProperty(dynamicconfig.ShardLoadMaxTrackedWorkflows, 1000),
+		ShardLoadTopWorkflowCount:            dc.GetIntProperty(dynamicconfig.ShardLoadTopWorkflowCount, 10),
+		HotWorkflowReportInterval:            dc.GetDurationProperty(dynamicconfig.HotWorkflowReportInterval, time.Minute),
+		HotWorkflowRequestThreshold:          dc.GetIntProperty(dynamicconfig.HotWorkflowRequestThreshold, 6000),
+		WorkflowRPS:                          dc.GetIntPropertyFilteredByNamespace(dynamicconfig.WorkflowRPS, 0),
+		WorkflowRateLimiterCacheSize:         dc.GetIntProperty(dynamicconfig.WorkflowRateLimiterCacheSize, 10000),
 		StandbyClusterDelay:                  dc.GetDurationProperty(dynamicconfig.StandbyClusterDelay, 5*time.Minute),
 		StandbyTaskMissingEventsResendDelay:  dc.GetDurationPropertyFilteredByTaskType(dynamicconfig.StandbyTaskMissingEventsResendDelay, 10*time.Minute),
 		StandbyTaskMissingEventsDiscardDelay: dc.GetDurationPropertyFilteredByTaskType(dynamicconfig.StandbyTaskMissingEventsDiscardDelay, 15*time.Minute),
@@ -352,6 +375,7 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		TaskSchedulerEnableRateLimiter: dc.GetBoolProperty(dynamicconfig.TaskSchedulerEnableRateLimiter, false),
 		TaskSchedulerMaxQPS:            dc.GetIntProperty(dynamicconfig.TaskSchedulerMaxQPS, 0),
 		TaskSchedulerNamespaceMaxQPS:   dc.GetIntPropertyFilteredByNamespace(dynamicconfig.TaskSchedulerNamespaceMaxQPS, 0),
+		TaskSchedulerNamespaceWeight:   dc.GetIntPropertyFilteredByNamespace(dynamicconfig.TaskSchedulerNamespaceWeight, 1),
 
 		TimerTaskBatchSize:                               dc.GetIntProperty(dynamicconfig.TimerTaskBatchSize, 100),
 		TimerTaskMaxRetryCount:                           dc.GetIntProperty(dynamicconfig.TimerTaskMaxRetryCount, 20),
@@ -32,6 +32,8 @@ var (
 	APIToPriority = map[string]int{
 		"CloseShard":                             0,
 		"GetShard":                               0,
+		"HandoffShard":                           0,
+		"ImportWorkflowExecution":                0,
 		"DeleteWorkflowExecution":                0,
 		"DescribeHistoryHost":                    0,
 		"DescribeMutableState":                   0,
@@ -75,9 +77,21 @@ var (
 		"GetReplicationStatus":                   0,
 		"DeleteWorkflowVisibilityRecord":         0,
 		"UpdateWorkflow":                         0,
+		"UpsertWorkflowSearchAttributes":         0,
 	}
 
 	APIPrioritiesOrdered = []int{0}
+
+	// WorkflowRateLimitedAPIs are the externally initiated APIs subject to the per workflow rate limit.
+	// APIs driving the progress of a workflow or stopping it, e.g. task completions, replication and
+	// termination, are never limited.
+	WorkflowRateLimitedAPIs = map[string]struct{}{
+		"DescribeWorkflowExecution":        {},
+		"QueryWorkflow":                    {},
+		"SignalWithStartWorkflowExecution": {},
+		"SignalWorkflowExecution":          {},
+		"UpdateWorkflow":                   {},
+	}
 )
 
 func NewPriorityRateLimiter(
@@ -143,6 +143,19 @@ func HandlerProvider(args NewHandlerArgs) *Handler {
 		eventNotifier:                 args.EventNotifier,
 		replicationTaskFetcherFactory: args.ReplicationTaskFetcherFactory,
 		tracer:                        args.TracerProvider.Tracer(consts.LibraryName),
+		workflowRateLimiter: newWorkflowRateLimiter(
+			args.Config.WorkflowRPS,
+			args.Config.WorkflowRateLimiterCacheSize(),
+			args.NamespaceRegistry,
+			args.MetricsHandler,
+		),
+		hotWorkflowReporter: newHotWorkflowReporter(
+			args.Config,
+			args.ShardController,
+			args.NamespaceRegistry,
+			args.MetricsHandler,
+			args.Logger,
+		),
 	}
 
 	// prevent us from trying to serve requests before shard controller is started and ready
@@ -31,6 +31,7 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/pborman/uuid"
 	"go.opentelemetry.io/otel/trace"
@@ -38,6 +39,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.uber.org/fx"
+	"google.golang.org/grpc"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
@@ -49,6 +51,7 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
@@ -97,6 +100,8 @@ type (
 		hostInfoProvider              membership.HostInfoProvider
 		controller                    shard.Controller
 		tracer                        trace.Tracer
+		workflowRateLimiter           *workflowRateLimiter
+		hotWorkflowReporter           *hotWorkflowReporter
 	}
 
 	NewHandlerArgs struct {
@@ -161,6 +166,7 @@ func (h *Handler) Start() {
 	// events notifier must starts before controller
 	h.eventNotifier.Start()
 	h.controller.Start()
+	h.hotWorkflowReporter.Start()
 
 	h.startWG.Done()
 }
@@ -176,6 +182,7 @@ func (h *Handler) Stop() {
 	}
 
 	h.replicationTaskFetcherFactory.Stop()
+	h.hotWorkflowReporter.Stop()
 	h.controller.Stop()
 	h.eventNotifier.Stop()
 }
@@ -206,7 +213,7 @@ func (h *Handler) RecordActivityTaskHeartbeat(ctx context.Context, request *hist
 	}
 	workflowID := taskToken.GetWorkflowId()
 
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -235,7 +242,7 @@ func (h *Handler) RecordActivityTaskStarted(ctx context.Context, request *histor
 		return nil, h.convertError(errNamespaceNotSet)
 	}
 
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -271,7 +278,7 @@ func (h *Handler) RecordWorkflowTaskStarted(ctx context.Context, request *histor
 		return nil, h.convertError(errTaskQueueNotSet)
 	}
 
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -319,7 +326,7 @@ func (h *Handler) RespondActivityTaskCompleted(ctx context.Context, request *his
 	}
 	workflowID := taskToken.GetWorkflowId()
 
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -358,7 +365,7 @@ func (h *Handler) RespondActivityTaskFailed(ctx context.Context, request *histor
 	}
 	workflowID := taskToken.GetWorkflowId()
 
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -397,7 +404,7 @@ func (h *Handler) RespondActivityTaskCanceled(ctx context.Context, request *hist
 	}
 	workflowID := taskToken.GetWorkflowId()
 
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -442,7 +449,7 @@ func (h *Handler) RespondWorkflowTaskCompleted(ctx context.Context, request *his
 	}
 	workflowID := token.GetWorkflowId()
 
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -487,7 +494,7 @@ func (h *Handler) RespondWorkflowTaskFailed(ctx context.Context, request *histor
 	}
 	workflowID := token.GetWorkflowId()
 
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -516,7 +523,7 @@ func (h *Handler) StartWorkflowExecution(ctx context.Context, request *historyse
 
 	startRequest := request.StartRequest
 	workflowID := startRequest.GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -538,7 +545,7 @@ func (h *Handler) StartWorkflowExecution(ctx context.Context, request *historyse
 }
 
 // DescribeHistoryHost returns information about the internal states of a history host
-func (h *Handler) DescribeHistoryHost(_ context.Context, _ *historyservice.DescribeHistoryHostRequest) (_ *historyservice.DescribeHistoryHostResponse, retError error) {
+func (h *Handler) DescribeHistoryHost(ctx context.Context, request *historyservice.DescribeHistoryHostRequest) (_ *historyservice.DescribeHistoryHostResponse, retError error) {
 	defer log.CapturePanic(h.logger, &retError)
 	h.startWG.Wait()
 
@@ -554,6 +561,20 @@ func (h *Handler) DescribeHistoryHost(_ context.Context, _ *historyservice.Descr
 		},
 		Address: h.hostInfoProvider.HostInfo().GetAddress(),
 	}
+	if request.GetIncludeShardLoads() {
+		topWorkflowCount := h.config.ShardLoadTopWorkflowCount()
+		for _, shardID := range ownedShardIDs {
+			shardContext, err := h.controller.GetShardByID(shardID)
+			if err != nil {
+				continue
+			}
+			engine, err := shardContext.GetEngine(ctx)
+			if err != nil {
+				continue
+			}
+			resp.ShardLoads = append(resp.ShardLoads, engine.DescribeLoad(topWorkflowCount))
+		}
+	}
 	return resp, nil
 }
 
@@ -614,6 +635,47 @@ func (h *Handler) GetShard(ctx context.Context, request *historyservice.GetShard
 	return &historyservice.GetShardResponse{ShardInfo: resp.ShardInfo}, nil
 }
 
+// HandoffShard acquires a shard that its previous owner gracefully released and prewarms the
+// caches with the workflows that were recently used by the previous owner
+func (h *Handler) HandoffShard(ctx context.Context, request *historyservice.HandoffShardRequest) (_ *historyservice.HandoffShardResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	shardContext, err := h.controller.GetShardByID(request.GetShardId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	workflows := make([]definition.WorkflowKey, 0, len(request.GetWorkflows()))
+	for _, workflow := range request.GetWorkflows() {
+		workflows = append(workflows, definition.NewWorkflowKey(
+			workflow.GetNamespaceId(),
+			workflow.GetWorkflowId(),
+			workflow.GetRunId(),
+		))
+	}
+
+	startTime := time.Now().UTC()
+	prewarmed := engine.PrewarmCaches(ctx, workflows)
+	h.metricsHandler.Timer(metrics.ShardHandoffPrewarmLatency.GetMetricName()).Record(time.Since(startTime))
+	h.metricsHandler.Counter(metrics.ShardHandoffPrewarmedWorkflows.GetMetricName()).Record(int64(prewarmed))
+
+	h.logger.Info("Shard handed off by previous owner",
+		tag.ShardID(request.GetShardId()),
+		tag.Address(request.GetPreviousOwner()),
+		tag.Counter(prewarmed),
+	)
+	return &historyservice.HandoffShardResponse{PrewarmedWorkflows: int32(prewarmed)}, nil
+}
+
 // RebuildMutableState attempts to rebuild mutable state according to persisted history events
 func (h *Handler) RebuildMutableState(ctx context.Context, request *historyservice.RebuildMutableStateRequest) (_ *historyservice.RebuildMutableStateResponse, retError error) {
 	defer log.CapturePanic(h.logger, &retError)
@@ -630,7 +692,7 @@ func (h *Handler) RebuildMutableState(ctx context.Context, request *historyservi
 
 	workflowExecution := request.Execution
 	workflowID := workflowExecution.GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -664,7 +726,7 @@ func (h *Handler) DescribeMutableState(ctx context.Context, request *historyserv
 
 	workflowExecution := request.Execution
 	workflowID := workflowExecution.GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -696,7 +758,7 @@ func (h *Handler) GetMutableState(ctx context.Context, request *historyservice.G
 
 	workflowExecution := request.Execution
 	workflowID := workflowExecution.GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -728,7 +790,7 @@ func (h *Handler) PollMutableState(ctx context.Context, request *historyservice.
 
 	workflowExecution := request.Execution
 	workflowID := workflowExecution.GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -760,7 +822,7 @@ func (h *Handler) DescribeWorkflowExecution(ctx context.Context, request *histor
 
 	workflowExecution := request.Request.Execution
 	workflowID := workflowExecution.GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -798,7 +860,7 @@ func (h *Handler) RequestCancelWorkflowExecution(ctx context.Context, request *h
 		tag.WorkflowRunID(cancelRequest.WorkflowExecution.GetRunId()))
 
 	workflowID := cancelRequest.WorkflowExecution.GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -832,7 +894,7 @@ func (h *Handler) SignalWorkflowExecution(ctx context.Context, request *historys
 
 	workflowExecution := request.SignalRequest.WorkflowExecution
 	workflowID := workflowExecution.GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -869,7 +931,7 @@ func (h *Handler) SignalWithStartWorkflowExecution(ctx context.Context, request
 
 	signalWithStartRequest := request.SignalWithStartRequest
 	workflowID := signalWithStartRequest.GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -926,7 +988,7 @@ func (h *Handler) RemoveSignalMutableState(ctx context.Context, request *history
 
 	workflowExecution := request.WorkflowExecution
 	workflowID := workflowExecution.GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -943,6 +1005,40 @@ func (h *Handler) RemoveSignalMutableState(ctx context.Context, request *history
 	return resp, nil
 }
 
+// UpsertWorkflowSearchAttributes upserts search attributes of a running workflow on behalf of an operator, without
+// adding an event to its history.
+func (h *Handler) UpsertWorkflowSearchAttributes(ctx context.Context, request *historyservice.UpsertWorkflowSearchAttributesRequest) (_ *historyservice.UpsertWorkflowSearchAttributesResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowExecution := request.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err2 := engine.UpsertWorkflowSearchAttributes(ctx, request)
+	if err2 != nil {
+		return nil, h.convertError(err2)
+	}
+
+	return resp, nil
+}
+
 // TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
 // in the history and immediately terminating the execution instance.
 func (h *Handler) TerminateWorkflowExecution(ctx context.Context, request *historyservice.TerminateWorkflowExecutionRequest) (_ *historyservice.TerminateWorkflowExecutionResponse, retError error) {
@@ -960,7 +1056,7 @@ func (h *Handler) TerminateWorkflowExecution(ctx context.Context, request *histo
 
 	workflowExecution := request.TerminateRequest.WorkflowExecution
 	workflowID := workflowExecution.GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -992,7 +1088,7 @@ func (h *Handler) DeleteWorkflowExecution(ctx context.Context, request *historys
 
 	workflowExecution := request.WorkflowExecution
 	workflowID := workflowExecution.GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -1025,7 +1121,7 @@ func (h *Handler) ResetWorkflowExecution(ctx context.Context, request *historyse
 
 	workflowExecution := request.ResetRequest.WorkflowExecution
 	workflowID := workflowExecution.GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -1057,7 +1153,7 @@ func (h *Handler) QueryWorkflow(ctx context.Context, request *historyservice.Que
 	}
 
 	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -1097,7 +1193,7 @@ func (h *Handler) ScheduleWorkflowTask(ctx context.Context, request *historyserv
 
 	workflowExecution := request.WorkflowExecution
 	workflowID := workflowExecution.GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -1136,7 +1232,7 @@ func (h *Handler) VerifyFirstWorkflowTaskScheduled(
 
 	workflowExecution := request.WorkflowExecution
 	workflowID := workflowExecution.GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -1174,7 +1270,7 @@ func (h *Handler) RecordChildExecutionCompleted(ctx context.Context, request *hi
 
 	workflowExecution := request.WorkflowExecution
 	workflowID := workflowExecution.GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -1211,7 +1307,7 @@ func (h *Handler) VerifyChildExecutionCompletionRecorded(
 		return nil, h.convertError(errWorkflowExecutionNotSet)
 	}
 
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, request.ParentExecution.GetWorkflowId())
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, request.ParentExecution.GetWorkflowId())
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -1247,7 +1343,7 @@ func (h *Handler) ResetStickyTaskQueue(ctx context.Context, request *historyserv
 	}
 
 	workflowID := request.Execution.GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -1284,7 +1380,7 @@ func (h *Handler) ReplicateEventsV2(ctx context.Context, request *historyservice
 
 	workflowExecution := request.WorkflowExecution
 	workflowID := workflowExecution.GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -1301,6 +1397,40 @@ func (h *Handler) ReplicateEventsV2(ctx context.Context, request *historyservice
 	return &historyservice.ReplicateEventsV2Response{}, nil
 }
 
+// ImportWorkflowExecution applies history batches exported from another cluster to a workflow execution
+func (h *Handler) ImportWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ImportWorkflowExecutionRequest,
+) (_ *historyservice.ImportWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.GetExecution().GetWorkflowId()
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.ImportWorkflowExecution(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return resp, nil
+}
+
 // SyncShardStatus is called by processor to sync history shard information from another cluster
 func (h *Handler) SyncShardStatus(ctx context.Context, request *historyservice.SyncShardStatusRequest) (_ *historyservice.SyncShardStatusResponse, retError error) {
 	defer log.CapturePanic(h.logger, &retError)
@@ -1366,7 +1496,7 @@ func (h *Handler) SyncActivity(ctx context.Context, request *historyservice.Sync
 	}
 
 	workflowID := request.GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -1532,7 +1662,7 @@ func (h *Handler) ReapplyEvents(ctx context.Context, request *historyservice.Rea
 
 	namespaceID := namespace.ID(request.GetNamespaceId())
 	workflowID := request.GetRequest().GetWorkflowExecution().GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -1650,7 +1780,7 @@ func (h *Handler) RefreshWorkflowTasks(ctx context.Context, request *historyserv
 	namespaceID := namespace.ID(request.GetNamespaceId())
 	execution := request.GetRequest().GetExecution()
 	workflowID := execution.GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -1689,7 +1819,7 @@ func (h *Handler) GenerateLastHistoryReplicationTasks(
 
 	namespaceID := namespace.ID(request.GetNamespaceId())
 	workflowID := request.GetExecution().GetWorkflowId()
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	shardContext, err := h.getShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -1802,7 +1932,8 @@ func (h *Handler) UpdateWorkflow(
 		return nil, errShuttingDown
 	}
 
-	shardContext, err := h.controller.GetShardByNamespaceWorkflow(
+	shardContext, err := h.getShardByNamespaceWorkflow(
+		ctx,
 		namespace.ID(request.GetNamespaceId()),
 		request.GetRequest().GetWorkflowExecution().GetWorkflowId(),
 	)
@@ -1818,12 +1949,40 @@ func (h *Handler) UpdateWorkflow(
 	return engine.UpdateWorkflow(ctx, request)
 }
 
+// getShardByNamespaceWorkflow returns the shard context owning the workflow and records
+// the request in the load tracker of the shard.
+func (h *Handler) getShardByNamespaceWorkflow(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	workflowID string,
+) (shard.Context, error) {
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, err
+	}
+
+	api := apiName(ctx)
+	shardContext.GetLoadTracker().RecordRequest(api, namespaceID, workflowID)
+	if !h.workflowRateLimiter.Allow(h.timeSource.Now(), api, namespaceID, workflowID) {
+		return nil, ErrWorkflowRateLimitExceeded
+	}
+	return shardContext, nil
+}
+
+// apiName returns the name of the gRPC method being served, without the service prefix.
+func apiName(ctx context.Context) string {
+	method, _ := grpc.Method(ctx)
+	return method[strings.LastIndex(method, "/")+1:]
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
 func (h *Handler) convertError(err error) error {
 	switch err := err.(type) {
 	case *persistence.ShardOwnershipLostError:
+		// the shard was taken over by another host, so our view of the shard owners is stale
+		h.historyServiceResolver.RequestRefresh()
 		hostInfo := h.hostInfoProvider.HostInfo()
 		if ownerInfo, err := h.historyServiceResolver.Lookup(convert.Int32ToString(err.ShardID)); err == nil {
 			return serviceerrors.NewShardOwnershipLost(ownerInfo.GetAddress(), hostInfo.GetAddress())
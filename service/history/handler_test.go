@@ -0,0 +1,129 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tests"
+)
+
+type (
+	handlerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller      *gomock.Controller
+		shardController *shard.MockController
+		shardContext    *shard.MockContext
+		engine          *shard.MockEngine
+		resolver        *membership.MockServiceResolver
+
+		handler *Handler
+	}
+)
+
+func TestHandlerSuite(t *testing.T) {
+	s := new(handlerSuite)
+	suite.Run(t, s)
+}
+
+func (s *handlerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.shardController = shard.NewMockController(s.controller)
+	s.shardContext = shard.NewMockContext(s.controller)
+	s.engine = shard.NewMockEngine(s.controller)
+	s.resolver = membership.NewMockServiceResolver(s.controller)
+	hostInfoProvider := membership.NewMockHostInfoProvider(s.controller)
+	hostInfoProvider.EXPECT().HostInfo().Return(membership.NewHostInfo("this-host:7234", nil)).AnyTimes()
+
+	s.handler = &Handler{
+		status:                 common.DaemonStatusStarted,
+		config:                 tests.NewDynamicConfig(),
+		logger:                 log.NewNoopLogger(),
+		throttledLogger:        log.NewNoopLogger(),
+		historyServiceResolver: s.resolver,
+		metricsHandler:         metrics.NoopMetricsHandler,
+		hostInfoProvider:       hostInfoProvider,
+		controller:             s.shardController,
+	}
+}
+
+func (s *handlerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *handlerSuite) TestHandoffShard() {
+	workflowKey := definition.NewWorkflowKey(tests.NamespaceID.String(), tests.WorkflowID, tests.RunID)
+	s.shardController.EXPECT().GetShardByID(int32(1)).Return(s.shardContext, nil)
+	s.shardContext.EXPECT().GetEngine(gomock.Any()).Return(s.engine, nil)
+	s.engine.EXPECT().PrewarmCaches(gomock.Any(), []definition.WorkflowKey{workflowKey}).Return(1)
+
+	resp, err := s.handler.HandoffShard(context.Background(), &historyservice.HandoffShardRequest{
+		ShardId:       1,
+		PreviousOwner: "previous-owner:7234",
+		Workflows: []*historyservice.HandoffShardWorkflow{{
+			NamespaceId: tests.NamespaceID.String(),
+			WorkflowId:  tests.WorkflowID,
+			RunId:       tests.RunID,
+		}},
+	})
+	s.NoError(err)
+	s.Equal(int32(1), resp.GetPrewarmedWorkflows())
+}
+
+func (s *handlerSuite) TestHandoffShard_ShardOwnershipLost() {
+	s.shardController.EXPECT().GetShardByID(int32(1)).Return(nil, &persistence.ShardOwnershipLostError{ShardID: 1})
+	s.resolver.EXPECT().RequestRefresh()
+	s.resolver.EXPECT().Lookup(convert.Int32ToString(1)).Return(membership.NewHostInfo("owner:7234", nil), nil)
+
+	_, err := s.handler.HandoffShard(context.Background(), &historyservice.HandoffShardRequest{ShardId: 1})
+	s.IsType(&serviceerrors.ShardOwnershipLost{}, err)
+	s.Equal("owner:7234", err.(*serviceerrors.ShardOwnershipLost).OwnerHost)
+}
+
+func (s *handlerSuite) TestHandoffShard_ShuttingDown() {
+	s.handler.status = common.DaemonStatusStopped
+
+	_, err := s.handler.HandoffShard(context.Background(), &historyservice.HandoffShardRequest{ShardId: 1})
+	s.Equal(errShuttingDown, err)
+}
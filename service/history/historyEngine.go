@@ -26,6 +26,7 @@ package history
 
 import (
 	"context"
+	"sort"
 	"sync/atomic"
 	"time"
 
@@ -34,6 +35,7 @@ import (
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
@@ -46,6 +48,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadpolicy"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -56,6 +59,7 @@ import (
 	"go.temporal.io/server/service/history/api/deleteworkflow"
 	"go.temporal.io/server/service/history/api/describemutablestate"
 	"go.temporal.io/server/service/history/api/describeworkflow"
+	"go.temporal.io/server/service/history/api/prewarmcache"
 	"go.temporal.io/server/service/history/api/queryworkflow"
 	"go.temporal.io/server/service/history/api/reapplyevents"
 	"go.temporal.io/server/service/history/api/recordactivitytaskheartbeat"
@@ -75,6 +79,7 @@ import (
 	"go.temporal.io/server/service/history/api/signalworkflow"
 	"go.temporal.io/server/service/history/api/startworkflow"
 	"go.temporal.io/server/service/history/api/terminateworkflow"
+	"go.temporal.io/server/service/history/api/upsertsearchattributes"
 	"go.temporal.io/server/service/history/api/verifychildworkflowcompletionrecorded"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
@@ -126,6 +131,7 @@ type (
 		eventSerializer            serialization.Serializer
 		workflowConsistencyChecker api.WorkflowConsistencyChecker
 		tracer                     trace.Tracer
+		payloadPolicy              payloadpolicy.Policy
 	}
 )
 
@@ -147,6 +153,7 @@ func NewEngineWithShardContext(
 	workflowConsistencyChecker api.WorkflowConsistencyChecker,
 	tracerProvider trace.TracerProvider,
 	persistenceVisibilityMgr manager.VisibilityManager,
+	payloadPolicy payloadpolicy.Policy,
 ) shard.Engine {
 	currentClusterName := shard.GetClusterMetadata().GetCurrentClusterName()
 
@@ -182,6 +189,7 @@ func NewEngineWithShardContext(
 		eventSerializer:            eventSerializer,
 		workflowConsistencyChecker: workflowConsistencyChecker,
 		tracer:                     tracerProvider.Tracer(consts.LibraryName),
+		payloadPolicy:              payloadPolicy,
 	}
 
 	historyEngImpl.queueProcessors = make(map[tasks.Category]queues.Queue)
@@ -562,7 +570,7 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 	ctx context.Context,
 	req *historyservice.RespondActivityTaskCompletedRequest,
 ) (*historyservice.RespondActivityTaskCompletedResponse, error) {
-	return respondactivitytaskcompleted.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+	return respondactivitytaskcompleted.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker, e.payloadPolicy)
 }
 
 // RespondActivityTaskFailed completes an activity task failure.
@@ -570,7 +578,7 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 	ctx context.Context,
 	req *historyservice.RespondActivityTaskFailedRequest,
 ) (*historyservice.RespondActivityTaskFailedResponse, error) {
-	return respondactivitytaskfailed.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+	return respondactivitytaskfailed.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker, e.payloadPolicy)
 }
 
 // RespondActivityTaskCanceled completes an activity task failure.
@@ -578,7 +586,7 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(
 	ctx context.Context,
 	req *historyservice.RespondActivityTaskCanceledRequest,
 ) (*historyservice.RespondActivityTaskCanceledResponse, error) {
-	return respondactivitytaskcanceled.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+	return respondactivitytaskcanceled.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker, e.payloadPolicy)
 }
 
 // RecordActivityTaskHeartbeat records an hearbeat for a task.
@@ -589,7 +597,7 @@ func (e *historyEngineImpl) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	req *historyservice.RecordActivityTaskHeartbeatRequest,
 ) (*historyservice.RecordActivityTaskHeartbeatResponse, error) {
-	return recordactivitytaskheartbeat.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+	return recordactivitytaskheartbeat.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker, e.payloadPolicy)
 }
 
 // RequestCancelWorkflowExecution records request cancellation event for workflow execution
@@ -631,6 +639,14 @@ func (e *historyEngineImpl) RemoveSignalMutableState(
 	return removesignalmutablestate.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
 }
 
+// UpsertWorkflowSearchAttributes upserts search attributes of a running workflow without adding a history event
+func (e *historyEngineImpl) UpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	req *historyservice.UpsertWorkflowSearchAttributesRequest,
+) (*historyservice.UpsertWorkflowSearchAttributesResponse, error) {
+	return upsertsearchattributes.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+}
+
 func (e *historyEngineImpl) TerminateWorkflowExecution(
 	ctx context.Context,
 	req *historyservice.TerminateWorkflowExecutionRequest,
@@ -668,6 +684,13 @@ func (e *historyEngineImpl) ReplicateEventsV2(
 	return e.nDCReplicator.ApplyEvents(ctx, replicateRequest)
 }
 
+func (e *historyEngineImpl) ImportWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ImportWorkflowExecutionRequest,
+) (*historyservice.ImportWorkflowExecutionResponse, error) {
+	return replicationapi.ImportWorkflowExecution(ctx, request, e.shard, e.nDCReplicator)
+}
+
 // ReplicateWorkflowState is an experimental method to replicate workflow state. This should not expose outside of history service role.
 func (e *historyEngineImpl) ReplicateWorkflowState(
 	ctx context.Context,
@@ -828,3 +851,38 @@ func (e *historyEngineImpl) GetReplicationStatus(
 ) (_ *historyservice.ShardReplicationStatus, retError error) {
 	return replicationapi.GetStatus(ctx, request, e.shard, e.replicationAckMgr)
 }
+
+func (e *historyEngineImpl) ListCachedWorkflows(
+	maxCount int,
+) []definition.WorkflowKey {
+	return e.workflowConsistencyChecker.GetWorkflowCache().RecentWorkflowKeys(maxCount)
+}
+
+func (e *historyEngineImpl) PrewarmCaches(
+	ctx context.Context,
+	workflows []definition.WorkflowKey,
+) int {
+	return prewarmcache.Invoke(ctx, workflows, e.shard, e.workflowConsistencyChecker)
+}
+
+func (e *historyEngineImpl) DescribeLoad(
+	topWorkflowCount int,
+) *historyspb.ShardLoad {
+	loadTracker := e.shard.GetLoadTracker()
+	load := &historyspb.ShardLoad{
+		ShardId:                  e.shard.GetShardID(),
+		RequestRates:             loadTracker.RequestRates(),
+		MutableStateCacheHitRate: loadTracker.CacheHitRate(),
+		TopWorkflows:             loadTracker.TopWorkflows(topWorkflowCount),
+	}
+	for category, queueProcessor := range e.queueProcessors {
+		load.QueueLoads = append(load.QueueLoads, &historyspb.QueueLoad{
+			Category:         category.Name(),
+			PendingTaskCount: int64(queueProcessor.PendingTaskCount()),
+		})
+	}
+	sort.Slice(load.QueueLoads, func(i, j int) bool {
+		return load.QueueLoads[i].Category < load.QueueLoads[j].Category
+	})
+	return load
+}
@@ -60,10 +60,12 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloadpolicy"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
@@ -2803,6 +2805,85 @@ func (s *engineSuite) TestRespondActivityTaskCompletedByIdSuccess() {
 	s.Equal(common.EmptyEventID, wt.StartedEventID)
 }
 
+func (s *engineSuite) TestRespondActivityTaskCompleted_PayloadPolicyViolation() {
+	s.mockHistoryEngine.payloadPolicy = payloadpolicy.NewDynamicConfigPolicy(dynamicconfig.NewCollection(dynamicconfig.StaticClient{
+		dynamicconfig.PayloadPolicyAllowedEncodings: []dynamicconfig.ConstrainedValue{{
+			Constraints: dynamicconfig.Constraints{Namespace: tests.Namespace.String()},
+			Value:       "binary/encrypted",
+		}},
+	}, log.NewNoopLogger()))
+
+	namespaceID := tests.NamespaceID
+	we := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+	tl := "testTaskQueue"
+	tt := &tokenspb.Task{
+		Attempt:          1,
+		NamespaceId:      namespaceID.String(),
+		WorkflowId:       we.WorkflowId,
+		RunId:            we.RunId,
+		ScheduledEventId: 5,
+	}
+	taskToken, _ := tt.Marshal()
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := payloads.EncodeString("input1")
+	activityResult := payloads.EncodeString("unencrypted activity result")
+
+	ms := workflow.TestLocalMutableState(s.mockHistoryEngine.shard, s.eventsCache,
+		tests.LocalNamespaceEntry, log.NewTestLogger(), we.GetRunId())
+	addWorkflowExecutionStartedEvent(ms, we, "wType", tl, payloads.EncodeString("input"), 100*time.Second, 100*time.Second, 100*time.Second, identity)
+	wt := addWorkflowTaskScheduledEvent(ms)
+	workflowTaskStartedEvent := addWorkflowTaskStartedEvent(ms, wt.ScheduledEventID, tl, identity)
+	workflowTaskCompletedEvent := addWorkflowTaskCompletedEvent(ms, wt.ScheduledEventID, workflowTaskStartedEvent.EventId, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(ms, workflowTaskCompletedEvent.EventId, activityID, activityType, tl, activityInput, 100*time.Second, 10*time.Second, 1*time.Second, 5*time.Second)
+	addActivityTaskStartedEvent(ms, activityScheduledEvent.EventId, identity)
+
+	wfMs := workflow.TestCloneToProto(ms)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: wfMs}
+
+	var newEvents []*historypb.HistoryEvent
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(gwmsResponse, nil)
+	s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+		for _, events := range request.UpdateWorkflowEvents {
+			newEvents = append(newEvents, events.Events...)
+		}
+		return tests.UpdateWorkflowExecutionResponse, nil
+	})
+
+	_, err := s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), &historyservice.RespondActivityTaskCompletedRequest{
+		NamespaceId: tests.NamespaceID.String(),
+		CompleteRequest: &workflowservice.RespondActivityTaskCompletedRequest{
+			TaskToken: taskToken,
+			Result:    activityResult,
+			Identity:  identity,
+		},
+	})
+	s.NoError(err)
+
+	// the activity fails with a non retryable failure naming the result, and the result is not recorded
+	var activityFailedEvent *historypb.HistoryEvent
+	for _, event := range newEvents {
+		s.NotEqual(enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED, event.GetEventType())
+		if event.GetEventType() == enumspb.EVENT_TYPE_ACTIVITY_TASK_FAILED {
+			activityFailedEvent = event
+		}
+	}
+	s.NotNil(activityFailedEvent)
+	activityFailure := activityFailedEvent.GetActivityTaskFailedEventAttributes().GetFailure()
+	s.Contains(activityFailure.GetMessage(), "result.payloads[0]")
+	s.True(activityFailure.GetServerFailureInfo().GetNonRetryable())
+	s.NotContains(activityFailedEvent.String(), "unencrypted activity result")
+
+	ms2 := s.getMutableState(tests.NamespaceID, we)
+	_, isRunning := ms2.GetActivityInfo(activityScheduledEvent.EventId)
+	s.False(isRunning)
+	s.True(ms2.HasPendingWorkflowTask())
+}
+
 func (s *engineSuite) TestRespondActivityTaskFailedInvalidToken() {
 
 	invalidToken, _ := json.Marshal("bad token")
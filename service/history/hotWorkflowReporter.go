@@ -0,0 +1,178 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/shard"
+)
+
+type (
+	// hotWorkflowReporter periodically samples the busiest workflows tracked by the shards of the host,
+	// and logs and emits metrics for the ones over the hot workflow request threshold.
+	hotWorkflowReporter struct {
+		status            int32
+		config            *configs.Config
+		controller        shard.Controller
+		namespaceRegistry namespace.Registry
+		metricsHandler    metrics.Handler
+		logger            log.Logger
+
+		shutdownCh chan struct{}
+		shutdownWG sync.WaitGroup
+	}
+
+	hotWorkflow struct {
+		shardID int32
+		load    *historyspb.WorkflowLoad
+	}
+)
+
+func newHotWorkflowReporter(
+	config *configs.Config,
+	controller shard.Controller,
+	namespaceRegistry namespace.Registry,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *hotWorkflowReporter {
+	return &hotWorkflowReporter{
+		status:            common.DaemonStatusInitialized,
+		config:            config,
+		controller:        controller,
+		namespaceRegistry: namespaceRegistry,
+		metricsHandler:    metricsHandler,
+		logger:            logger,
+
+		shutdownCh: make(chan struct{}),
+	}
+}
+
+func (r *hotWorkflowReporter) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&r.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+
+	r.shutdownWG.Add(1)
+	go r.reportLoop()
+}
+
+func (r *hotWorkflowReporter) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&r.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+
+	close(r.shutdownCh)
+	r.shutdownWG.Wait()
+}
+
+func (r *hotWorkflowReporter) reportLoop() {
+	defer r.shutdownWG.Done()
+
+	timer := time.NewTimer(r.config.HotWorkflowReportInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-r.shutdownCh:
+			return
+		case <-timer.C:
+			r.report()
+			timer.Reset(r.config.HotWorkflowReportInterval())
+		}
+	}
+}
+
+func (r *hotWorkflowReporter) report() {
+	threshold := int64(r.config.HotWorkflowRequestThreshold())
+	if threshold <= 0 {
+		return
+	}
+
+	hotWorkflows := r.topHotWorkflows(threshold, r.config.ShardLoadTopWorkflowCount())
+	for _, hot := range hotWorkflows {
+		namespaceName := hot.load.NamespaceId
+		if name, err := r.namespaceRegistry.GetNamespaceName(namespace.ID(hot.load.NamespaceId)); err == nil {
+			namespaceName = name.String()
+		}
+
+		r.metricsHandler.Histogram(
+			metrics.HotWorkflowRequestCount.GetMetricName(),
+			metrics.HotWorkflowRequestCount.GetMetricUnit(),
+		).Record(hot.load.RequestCount, metrics.NamespaceTag(namespaceName))
+		r.logger.Warn("Hot workflow detected.",
+			tag.ShardID(hot.shardID),
+			tag.WorkflowNamespace(namespaceName),
+			tag.WorkflowID(hot.load.WorkflowId),
+			tag.Counter(int(hot.load.RequestCount)),
+		)
+	}
+}
+
+// topHotWorkflows returns up to maxCount workflows of the host with at least threshold requests, busiest first.
+func (r *hotWorkflowReporter) topHotWorkflows(
+	threshold int64,
+	maxCount int,
+) []hotWorkflow {
+	var hotWorkflows []hotWorkflow
+	for _, shardID := range r.controller.ShardIDs() {
+		shardContext, err := r.controller.GetShardByID(shardID)
+		if err != nil {
+			continue
+		}
+		for _, load := range shardContext.GetLoadTracker().TopWorkflows(maxCount) {
+			if load.RequestCount < threshold {
+				break
+			}
+			hotWorkflows = append(hotWorkflows, hotWorkflow{shardID: shardID, load: load})
+		}
+	}
+
+	sort.Slice(hotWorkflows, func(i, j int) bool {
+		return hotWorkflows[i].load.RequestCount > hotWorkflows[j].load.RequestCount
+	})
+	if len(hotWorkflows) > maxCount {
+		hotWorkflows = hotWorkflows[:maxCount]
+	}
+	return hotWorkflows
+}
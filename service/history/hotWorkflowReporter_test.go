@@ -0,0 +1,127 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tests"
+)
+
+type (
+	hotWorkflowReporterSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller      *gomock.Controller
+		shardController *shard.MockController
+		loadTrackers    map[int32]*shard.LoadTracker
+
+		reporter *hotWorkflowReporter
+	}
+)
+
+func TestHotWorkflowReporterSuite(t *testing.T) {
+	s := new(hotWorkflowReporterSuite)
+	suite.Run(t, s)
+}
+
+func (s *hotWorkflowReporterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.shardController = shard.NewMockController(s.controller)
+
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	s.loadTrackers = make(map[int32]*shard.LoadTracker)
+	for _, shardID := range []int32{1, 2} {
+		loadTracker := shard.NewLoadTracker(
+			timeSource,
+			dynamicconfig.GetDurationPropertyFn(time.Minute),
+			dynamicconfig.GetIntPropertyFn(100),
+		)
+		shardContext := shard.NewMockContext(s.controller)
+		shardContext.EXPECT().GetLoadTracker().Return(loadTracker).AnyTimes()
+		s.shardController.EXPECT().GetShardByID(shardID).Return(shardContext, nil).AnyTimes()
+		s.loadTrackers[shardID] = loadTracker
+	}
+	s.shardController.EXPECT().ShardIDs().Return([]int32{1, 2}).AnyTimes()
+
+	s.reporter = newHotWorkflowReporter(
+		tests.NewDynamicConfig(),
+		s.shardController,
+		namespace.NewMockRegistry(s.controller),
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+}
+
+func (s *hotWorkflowReporterSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *hotWorkflowReporterSuite) TestTopHotWorkflows() {
+	s.recordRequests(1, "workflow-1", 5)
+	s.recordRequests(1, "workflow-2", 1)
+	s.recordRequests(2, "workflow-3", 7)
+	s.recordRequests(2, "workflow-4", 3)
+
+	hotWorkflows := s.reporter.topHotWorkflows(3, 10)
+	s.Len(hotWorkflows, 3)
+	s.Equal(int32(2), hotWorkflows[0].shardID)
+	s.Equal("workflow-3", hotWorkflows[0].load.WorkflowId)
+	s.Equal(int64(7), hotWorkflows[0].load.RequestCount)
+	s.Equal(int32(1), hotWorkflows[1].shardID)
+	s.Equal("workflow-1", hotWorkflows[1].load.WorkflowId)
+	s.Equal(int32(2), hotWorkflows[2].shardID)
+	s.Equal("workflow-4", hotWorkflows[2].load.WorkflowId)
+
+	hotWorkflows = s.reporter.topHotWorkflows(3, 1)
+	s.Len(hotWorkflows, 1)
+	s.Equal("workflow-3", hotWorkflows[0].load.WorkflowId)
+
+	s.Empty(s.reporter.topHotWorkflows(8, 10))
+}
+
+func (s *hotWorkflowReporterSuite) recordRequests(
+	shardID int32,
+	workflowID string,
+	count int,
+) {
+	for i := 0; i < count; i++ {
+		s.loadTrackers[shardID].RecordRequest("SignalWorkflowExecution", tests.NamespaceID, workflowID)
+	}
+}
@@ -107,6 +107,8 @@ type (
 
 		taskQueue                          string
 		activityTaskScheduleToStartTimeout time.Duration
+		priority                           int32
+		fairnessKey                        string
 	}
 
 	workflowTaskPostActionInfo struct {
@@ -114,6 +116,8 @@ type (
 
 		workflowTaskScheduleToStartTimeout int64
 		taskqueue                          taskqueuepb.TaskQueue
+		priority                           int32
+		fairnessKey                        string
 	}
 
 	startChildExecutionPostActionInfo struct {
@@ -142,6 +146,8 @@ func newHistoryResendInfo(
 func newActivityTaskPostActionInfo(
 	mutableState workflow.MutableState,
 	activityScheduleToStartTimeout time.Duration,
+	priority int32,
+	fairnessKey string,
 ) (*activityTaskPostActionInfo, error) {
 	resendInfo, err := getHistoryResendInfo(mutableState)
 	if err != nil {
@@ -151,6 +157,8 @@ func newActivityTaskPostActionInfo(
 	return &activityTaskPostActionInfo{
 		historyResendInfo:                  resendInfo,
 		activityTaskScheduleToStartTimeout: activityScheduleToStartTimeout,
+		priority:                           priority,
+		fairnessKey:                        fairnessKey,
 	}, nil
 }
 
@@ -158,6 +166,8 @@ func newActivityRetryTimePostActionInfo(
 	mutableState workflow.MutableState,
 	taskQueue string,
 	activityScheduleToStartTimeout time.Duration,
+	priority int32,
+	fairnessKey string,
 ) (*activityTaskPostActionInfo, error) {
 	resendInfo, err := getHistoryResendInfo(mutableState)
 	if err != nil {
@@ -168,6 +178,8 @@ func newActivityRetryTimePostActionInfo(
 		historyResendInfo:                  resendInfo,
 		taskQueue:                          taskQueue,
 		activityTaskScheduleToStartTimeout: activityScheduleToStartTimeout,
+		priority:                           priority,
+		fairnessKey:                        fairnessKey,
 	}, nil
 }
 
@@ -175,6 +187,8 @@ func newWorkflowTaskPostActionInfo(
 	mutableState workflow.MutableState,
 	workflowTaskScheduleToStartTimeout int64,
 	taskqueue taskqueuepb.TaskQueue,
+	priority int32,
+	fairnessKey string,
 ) (*workflowTaskPostActionInfo, error) {
 	resendInfo, err := getHistoryResendInfo(mutableState)
 	if err != nil {
@@ -185,6 +199,8 @@ func newWorkflowTaskPostActionInfo(
 		historyResendInfo:                  resendInfo,
 		workflowTaskScheduleToStartTimeout: workflowTaskScheduleToStartTimeout,
 		taskqueue:                          taskqueue,
+		priority:                           priority,
+		fairnessKey:                        fairnessKey,
 	}, nil
 }
 
@@ -39,5 +39,7 @@ type (
 		FailoverNamespace(namespaceIDs map[string]struct{})
 		LockTaskProcessing()
 		UnlockTaskProcessing()
+		// PendingTaskCount returns the number of tasks loaded by the queue which are not yet acked
+		PendingTaskCount() int
 	}
 )
@@ -294,6 +294,10 @@ func (p *queueBase) UnlockTaskProcessing() {
 	// no-op
 }
 
+func (p *queueBase) PendingTaskCount() int {
+	return p.monitor.GetTotalPendingTaskCount()
+}
+
 func (p *queueBase) processNewRange() error {
 	var newMaxKey tasks.Key
 	switch categoryType := p.category.Type(); categoryType {
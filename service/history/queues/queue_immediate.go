@@ -132,6 +132,11 @@ func (p *immediateQueue) Stop() {
 		p.logger.Warn("", tag.LifeCycleStopTimedout)
 	}
 
+	if p.shard.IsHandingOff() {
+		// persist the latest progress so that the new shard owner
+		// won't have to reprocess tasks that are already completed
+		p.checkpoint()
+	}
 	p.queueBase.Stop()
 }
 
@@ -108,6 +108,20 @@ func (mr *MockQueueMockRecorder) NotifyNewTasks(tasks interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewTasks", reflect.TypeOf((*MockQueue)(nil).NotifyNewTasks), tasks)
 }
 
+// PendingTaskCount mocks base method.
+func (m *MockQueue) PendingTaskCount() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingTaskCount")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// PendingTaskCount indicates an expected call of PendingTaskCount.
+func (mr *MockQueueMockRecorder) PendingTaskCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingTaskCount", reflect.TypeOf((*MockQueue)(nil).PendingTaskCount))
+}
+
 // Start mocks base method.
 func (m *MockQueue) Start() {
 	m.ctrl.T.Helper()
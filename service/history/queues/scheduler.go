@@ -38,6 +38,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/tasks"
+	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/service/history/configs"
 )
 
@@ -48,6 +49,10 @@ const (
 	prioritySchedulerProcessorQueueSize = 10
 
 	taskSchedulerToken = 1
+
+	// Dynamic config changes to channel weights are picked up
+	// with this interval.
+	channelWeightRefreshInterval = time.Minute
 )
 
 type (
@@ -77,6 +82,7 @@ type (
 		WorkerCount                 dynamicconfig.IntPropertyFn
 		ActiveNamespaceWeights      dynamicconfig.MapPropertyFnWithNamespaceFilter
 		StandbyNamespaceWeights     dynamicconfig.MapPropertyFnWithNamespaceFilter
+		NamespaceWeight             dynamicconfig.IntPropertyFnWithNamespaceFilter
 		EnableRateLimiter           dynamicconfig.BoolPropertyFn
 		MaxDispatchThrottleDuration time.Duration
 	}
@@ -91,10 +97,12 @@ type (
 	schedulerImpl struct {
 		tasks.Scheduler[Executable]
 		namespaceRegistry namespace.Registry
+		monitor           *schedulerMonitor
 
 		taskChannelKeyFn      TaskChannelKeyFn
 		channelWeightFn       ChannelWeightFn
 		channelWeightUpdateCh chan struct{}
+		shutdownCh            chan struct{}
 	}
 )
 
@@ -132,10 +140,17 @@ func NewNamespacePriorityScheduler(
 			)
 		}
 
+		// namespaces with the same weight get an equal share of each priority,
+		// no matter how many tasks they have pending
+		namespaceWeight := 1
+		if options.NamespaceWeight != nil {
+			namespaceWeight = util.Max(1, options.NamespaceWeight(namespaceName.String()))
+		}
+
 		return configs.ConvertDynamicConfigValueToWeights(
 			namespaceWeights(namespaceName.String()),
 			logger,
-		)[key.Priority]
+		)[key.Priority] * namespaceWeight
 	}
 	channelWeightUpdateCh := make(chan struct{}, 1)
 	channelQuotaRequestFn := func(key TaskChannelKey) quotas.Request {
@@ -149,6 +164,13 @@ func NewNamespacePriorityScheduler(
 		QueueSize:   prioritySchedulerProcessorQueueSize,
 		WorkerCount: options.WorkerCount,
 	}
+	monitor := newSchedulerMonitor(
+		taskChannelKeyFn,
+		namespaceRegistry,
+		timeSource,
+		metricsHandler,
+		defaultSchedulerMonitorOptions,
+	)
 
 	return &schedulerImpl{
 		Scheduler: tasks.NewInterleavedWeightedRoundRobinScheduler(
@@ -161,13 +183,7 @@ func NewNamespacePriorityScheduler(
 				MaxDispatchThrottleDuration: options.MaxDispatchThrottleDuration,
 			},
 			tasks.Scheduler[Executable](tasks.NewFIFOScheduler[Executable](
-				newSchedulerMonitor(
-					taskChannelKeyFn,
-					namespaceRegistry,
-					timeSource,
-					metricsHandler,
-					defaultSchedulerMonitorOptions,
-				),
+				monitor,
 				fifoSchedulerOptions,
 				logger,
 			)),
@@ -176,9 +192,11 @@ func NewNamespacePriorityScheduler(
 			logger,
 		),
 		namespaceRegistry:     namespaceRegistry,
+		monitor:               monitor,
 		taskChannelKeyFn:      taskChannelKeyFn,
 		channelWeightFn:       channelWeightFn,
 		channelWeightUpdateCh: channelWeightUpdateCh,
+		shutdownCh:            make(chan struct{}),
 	}
 }
 
@@ -258,12 +276,10 @@ func (s *schedulerImpl) Start() {
 					return
 				}
 
-				select {
-				case s.channelWeightUpdateCh <- struct{}{}:
-				default:
-				}
+				s.notifyChannelWeightUpdate()
 			},
 		)
+		go s.channelWeightRefreshLoop()
 	}
 	s.Scheduler.Start()
 }
@@ -279,10 +295,28 @@ func (s *schedulerImpl) Stop() {
 		// channelWeightFn is only not nil when using host level scheduler
 		// so Stop is only called when host is shutting down, and we don't need
 		// to worry about open channels
+		close(s.shutdownCh)
 	}
 	s.Scheduler.Stop()
 }
 
+func (s *schedulerImpl) Submit(executable Executable) {
+	s.Scheduler.Submit(executable)
+	if s.monitor != nil {
+		s.monitor.RecordSubmit(executable)
+	}
+}
+
+func (s *schedulerImpl) TrySubmit(executable Executable) bool {
+	if !s.Scheduler.TrySubmit(executable) {
+		return false
+	}
+	if s.monitor != nil {
+		s.monitor.RecordSubmit(executable)
+	}
+	return true
+}
+
 func (s *schedulerImpl) TaskChannelKeyFn() TaskChannelKeyFn {
 	return s.taskChannelKeyFn
 }
@@ -290,3 +324,24 @@ func (s *schedulerImpl) TaskChannelKeyFn() TaskChannelKeyFn {
 func (s *schedulerImpl) ChannelWeightFn() ChannelWeightFn {
 	return s.channelWeightFn
 }
+
+func (s *schedulerImpl) channelWeightRefreshLoop() {
+	ticker := time.NewTicker(channelWeightRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.shutdownCh:
+			return
+		case <-ticker.C:
+			s.notifyChannelWeightUpdate()
+		}
+	}
+}
+
+func (s *schedulerImpl) notifyChannelWeightUpdate() {
+	select {
+	case s.channelWeightUpdateCh <- struct{}{}:
+	default:
+	}
+}
@@ -72,7 +72,13 @@ type (
 	scheduleStats struct {
 		lastStartTime time.Time
 		totalLatency  time.Duration
+		totalWaitTime time.Duration
 		numStarted    int
+		numPending    int
+		// numEarlyStarts is the number of started tasks whose submission is not recorded yet.
+		// Submission is recorded after the scheduler accepts the task, so a worker may start
+		// the task first.
+		numEarlyStarts int
 
 		taggedMetricsHandler metrics.Handler
 		lastEmissionTime     time.Time
@@ -118,6 +124,23 @@ func (m *schedulerMonitor) Stop() {
 	close(m.shutdownCh)
 }
 
+// RecordSubmit records a task accepted by the scheduler. It must only be
+// called after the submission succeeded. The task is counted as pending
+// until it's started.
+func (m *schedulerMonitor) RecordSubmit(executable Executable) {
+	taskChanKey := m.taskChannelKeyFn(executable)
+
+	m.Lock()
+	defer m.Unlock()
+
+	stats := m.getOrCreateScheduleStatsLocked(taskChanKey)
+	if stats.numEarlyStarts > 0 {
+		stats.numEarlyStarts--
+		return
+	}
+	stats.numPending++
+}
+
 func (m *schedulerMonitor) RecordStart(executable Executable) {
 	startTime := m.timeSource.Now()
 	taskChanKey := m.taskChannelKeyFn(executable)
@@ -133,13 +156,20 @@ func (m *schedulerMonitor) RecordStart(executable Executable) {
 	// long after the first task is started. In that case, the
 	// latency becomes the duration between schedule to start time
 	// for the second task.
+	waitTime := startTime.Sub(executable.GetScheduledTime())
 	latency := util.Min(
 		startTime.Sub(stats.lastStartTime),
-		startTime.Sub(executable.GetScheduledTime()),
+		waitTime,
 	)
 	stats.lastStartTime = startTime
 	stats.numStarted++
+	if stats.numPending > 0 {
+		stats.numPending--
+	} else {
+		stats.numEarlyStarts++
+	}
 	stats.totalLatency += latency
+	stats.totalWaitTime += waitTime
 
 	if stats.numStarted >= m.options.aggregationCount {
 		m.emitMetric(taskChanKey, stats)
@@ -159,6 +189,7 @@ func (m *schedulerMonitor) metricEmissionLoop() {
 
 			now := m.timeSource.Now()
 			for taskChanKey, stats := range m.scheduleStats {
+				stats.taggedMetricsHandler.Gauge(metrics.QueueSchedulePendingTasks.GetMetricName()).Record(float64(stats.numPending))
 				if now.Sub(stats.lastEmissionTime) > m.options.aggregationDuration {
 					m.emitMetric(taskChanKey, stats)
 				}
@@ -203,6 +234,7 @@ func (m *schedulerMonitor) emitMetric(
 	}
 
 	stats.taggedMetricsHandler.Timer(metrics.QueueScheduleLatency.GetMetricName()).Record(totalLatency)
+	stats.taggedMetricsHandler.Timer(metrics.QueueScheduleWaitLatency.GetMetricName()).Record(stats.totalWaitTime / time.Duration(stats.numStarted))
 
 	m.resetStats(stats)
 }
@@ -212,6 +244,7 @@ func (m *schedulerMonitor) resetStats(
 ) {
 	stats.numStarted = 0
 	stats.totalLatency = 0
+	stats.totalWaitTime = 0
 	stats.lastEmissionTime = m.timeSource.Now()
 }
 
@@ -0,0 +1,110 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/tasks"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/tests"
+)
+
+type (
+	schedulerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller            *gomock.Controller
+		mockNamespaceRegistry *namespace.MockRegistry
+	}
+)
+
+func TestSchedulerSuite(t *testing.T) {
+	s := new(schedulerSuite)
+	suite.Run(t, s)
+}
+
+func (s *schedulerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockNamespaceRegistry = namespace.NewMockRegistry(s.controller)
+}
+
+func (s *schedulerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *schedulerSuite) TestChannelWeightFn_NamespaceWeight() {
+	s.mockNamespaceRegistry.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.GlobalNamespaceEntry, nil).AnyTimes()
+	s.mockNamespaceRegistry.EXPECT().GetNamespaceByID(tests.ParentNamespaceID).Return(tests.GlobalParentNamespaceEntry, nil).AnyTimes()
+
+	scheduler := NewNamespacePriorityScheduler(
+		cluster.TestCurrentClusterName,
+		NamespacePrioritySchedulerOptions{
+			WorkerCount:             dynamicconfig.GetIntPropertyFn(1),
+			ActiveNamespaceWeights:  dynamicconfig.GetMapPropertyFnWithNamespaceFilter(configs.ConvertWeightsToDynamicConfigValue(configs.DefaultActiveTaskPriorityWeight)),
+			StandbyNamespaceWeights: dynamicconfig.GetMapPropertyFnWithNamespaceFilter(configs.ConvertWeightsToDynamicConfigValue(configs.DefaultStandbyTaskPriorityWeight)),
+			NamespaceWeight: func(namespaceName string) int {
+				if namespaceName == tests.Namespace.String() {
+					return 3
+				}
+				return 0
+			},
+			EnableRateLimiter: dynamicconfig.GetBoolPropertyFn(false),
+		},
+		s.mockNamespaceRegistry,
+		NewSchedulerRateLimiter(
+			dynamicconfig.GetIntPropertyFilteredByNamespace(0),
+			dynamicconfig.GetIntPropertyFn(0),
+			dynamicconfig.GetIntPropertyFilteredByNamespace(0),
+			dynamicconfig.GetIntPropertyFn(0),
+		),
+		clock.NewRealTimeSource(),
+		metrics.NoopMetricsHandler,
+		log.NewTestLogger(),
+	)
+	channelWeightFn := scheduler.ChannelWeightFn()
+
+	highWeight := configs.DefaultActiveTaskPriorityWeight[tasks.PriorityHigh]
+	lowWeight := configs.DefaultActiveTaskPriorityWeight[tasks.PriorityLow]
+	s.Equal(3*highWeight, channelWeightFn(TaskChannelKey{NamespaceID: tests.NamespaceID.String(), Priority: tasks.PriorityHigh}))
+	s.Equal(3*lowWeight, channelWeightFn(TaskChannelKey{NamespaceID: tests.NamespaceID.String(), Priority: tasks.PriorityLow}))
+
+	// weights less than 1 are treated as 1
+	s.Equal(highWeight, channelWeightFn(TaskChannelKey{NamespaceID: tests.ParentNamespaceID.String(), Priority: tasks.PriorityHigh}))
+	s.Equal(lowWeight, channelWeightFn(TaskChannelKey{NamespaceID: tests.ParentNamespaceID.String(), Priority: tasks.PriorityLow}))
+}
@@ -55,6 +55,8 @@ type (
 		mockNamespaceRegistry *namespace.MockRegistry
 		mockMetricsHandler    *metrics.MockHandler
 		mockTimerMetric       *metrics.MockTimerIface
+		mockWaitTimerMetric   *metrics.MockTimerIface
+		mockGaugeMetric       *metrics.MockGaugeIface
 		mockTimeSource        *clock.EventTimeSource
 
 		schedulerMonitor *schedulerMonitor
@@ -73,11 +75,16 @@ func (s *schedulerMonitorSuite) SetupTest() {
 	s.mockNamespaceRegistry = namespace.NewMockRegistry(s.controller)
 	s.mockMetricsHandler = metrics.NewMockHandler(s.controller)
 	s.mockTimerMetric = metrics.NewMockTimerIface(s.controller)
+	s.mockWaitTimerMetric = metrics.NewMockTimerIface(s.controller)
+	s.mockGaugeMetric = metrics.NewMockGaugeIface(s.controller)
 	s.mockTimeSource = clock.NewEventTimeSource()
 
 	s.mockNamespaceRegistry.EXPECT().GetNamespaceName(gomock.Any()).Return(tests.Namespace, nil).AnyTimes()
 	s.mockMetricsHandler.EXPECT().WithTags(gomock.Any()).Return(s.mockMetricsHandler).AnyTimes()
 	s.mockMetricsHandler.EXPECT().Timer(metrics.QueueScheduleLatency.GetMetricName()).Return(s.mockTimerMetric).AnyTimes()
+	s.mockMetricsHandler.EXPECT().Timer(metrics.QueueScheduleWaitLatency.GetMetricName()).Return(s.mockWaitTimerMetric).AnyTimes()
+	s.mockMetricsHandler.EXPECT().Gauge(metrics.QueueSchedulePendingTasks.GetMetricName()).Return(s.mockGaugeMetric).AnyTimes()
+	s.mockWaitTimerMetric.EXPECT().Record(gomock.Any()).AnyTimes()
 
 	s.schedulerMonitor = newSchedulerMonitor(
 		func(e Executable) TaskChannelKey {
@@ -98,6 +105,7 @@ func (s *schedulerMonitorSuite) TearDownTest() {
 }
 
 func (s *schedulerMonitorSuite) TestRecordStart_AggregationCount() {
+	s.mockGaugeMetric.EXPECT().Record(gomock.Any()).AnyTimes()
 	s.schedulerMonitor.Start()
 	defer s.schedulerMonitor.Stop()
 
@@ -123,6 +131,7 @@ func (s *schedulerMonitorSuite) TestRecordStart_AggregationCount() {
 }
 
 func (s *schedulerMonitorSuite) TestRecordStart_AggregationDuration() {
+	s.mockGaugeMetric.EXPECT().Record(gomock.Any()).AnyTimes()
 	s.schedulerMonitor.Start()
 	defer s.schedulerMonitor.Stop()
 
@@ -160,3 +169,61 @@ func (s *schedulerMonitorSuite) TestRecordStart_AggregationDuration() {
 		s.Fail("metric emission ticker should fire earlier")
 	}
 }
+
+func (s *schedulerMonitorSuite) TestRecordSubmit_PendingTasks() {
+	now := clock.NewRealTimeSource().Now()
+	s.mockTimeSource.Update(now)
+
+	numSubmitted := 5
+	numStarted := 2
+	for i := 0; i != numSubmitted; i++ {
+		s.schedulerMonitor.RecordSubmit(NewMockExecutable(s.controller))
+	}
+	for i := 0; i != numStarted; i++ {
+		mockExecutable := NewMockExecutable(s.controller)
+		mockExecutable.EXPECT().GetScheduledTime().Return(now).Times(1)
+		s.schedulerMonitor.RecordStart(mockExecutable)
+	}
+
+	done := make(chan struct{})
+	s.mockTimerMetric.EXPECT().Record(gomock.Any()).AnyTimes()
+	s.mockGaugeMetric.EXPECT().Record(float64(numSubmitted - numStarted)).Do(func(float64, ...metrics.Tag) {
+		select {
+		case <-done:
+		default:
+			close(done)
+		}
+	}).MinTimes(1)
+
+	s.schedulerMonitor.Start()
+	defer s.schedulerMonitor.Stop()
+
+	select {
+	case <-done:
+	case <-time.NewTimer(3 * testSchedulerMonitorOptions.aggregationDuration).C:
+		s.Fail("pending tasks should be emitted by the metric emission ticker")
+	}
+}
+
+func (s *schedulerMonitorSuite) TestRecordSubmit_StartedBeforeRecorded() {
+	now := clock.NewRealTimeSource().Now()
+	s.mockTimeSource.Update(now)
+	s.mockTimerMetric.EXPECT().Record(gomock.Any()).AnyTimes()
+
+	// a worker can start a task before its submission is recorded
+	for i := 0; i != 2; i++ {
+		mockExecutable := NewMockExecutable(s.controller)
+		mockExecutable.EXPECT().GetScheduledTime().Return(now).Times(1)
+		s.schedulerMonitor.RecordStart(mockExecutable)
+	}
+	s.schedulerMonitor.RecordSubmit(NewMockExecutable(s.controller))
+	stats := s.schedulerMonitor.scheduleStats[TaskChannelKey{
+		NamespaceID: tests.NamespaceID.String(),
+		Priority:    tasks.PriorityHigh,
+	}]
+	s.Equal(0, stats.numPending)
+
+	s.schedulerMonitor.RecordSubmit(NewMockExecutable(s.controller))
+	s.schedulerMonitor.RecordSubmit(NewMockExecutable(s.controller))
+	s.Equal(1, stats.numPending)
+}
@@ -64,6 +64,7 @@ type (
 		GetThrottledLogger() log.Logger
 		GetMetricsHandler() metrics.Handler
 		GetTimeSource() clock.TimeSource
+		GetLoadTracker() *LoadTracker
 
 		GetEngine(ctx context.Context) (Engine, error)
 
@@ -128,5 +129,7 @@ type (
 		GetArchivalMetadata() archiver.ArchivalMetadata
 
 		Unload()
+		// IsHandingOff returns true once the shard has started handing off to its new owner.
+		IsHandingOff() bool
 	}
 )
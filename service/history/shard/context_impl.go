@@ -97,6 +97,7 @@ type (
 		executionManager    persistence.ExecutionManager
 		metricsHandler      metrics.Handler
 		eventsCache         events.Cache
+		loadTracker         *LoadTracker
 		closeCallback       func(*ContextImpl)
 		config              *configs.Config
 		contextTaggedLogger log.Logger
@@ -120,9 +121,10 @@ type (
 		lifecycleCtx    context.Context
 		lifecycleCancel context.CancelFunc
 
-		// state is protected by stateLock
-		stateLock sync.Mutex
-		state     contextState
+		// state and handingOff are protected by stateLock
+		stateLock  sync.Mutex
+		state      contextState
+		handingOff bool
 
 		// All following fields are protected by rwLock, and only valid if state >= Acquiring:
 		rwLock                             sync.RWMutex
@@ -674,7 +676,7 @@ func (s *ContextImpl) AddTasks(
 	}
 
 	s.wLock()
-	if err := s.errorByState(); err != nil {
+	if err := s.errorByWriteState(); err != nil {
 		s.wUnlock()
 		return err
 	}
@@ -713,7 +715,7 @@ func (s *ContextImpl) CreateWorkflowExecution(
 	s.wLock()
 	defer s.wUnlock()
 
-	if err := s.errorByState(); err != nil {
+	if err := s.errorByWriteState(); err != nil {
 		return nil, err
 	}
 
@@ -762,7 +764,7 @@ func (s *ContextImpl) UpdateWorkflowExecution(
 	s.wLock()
 	defer s.wUnlock()
 
-	if err := s.errorByState(); err != nil {
+	if err := s.errorByWriteState(); err != nil {
 		return nil, err
 	}
 
@@ -837,7 +839,7 @@ func (s *ContextImpl) ConflictResolveWorkflowExecution(
 	s.wLock()
 	defer s.wUnlock()
 
-	if err := s.errorByState(); err != nil {
+	if err := s.errorByWriteState(); err != nil {
 		return nil, err
 	}
 
@@ -905,7 +907,7 @@ func (s *ContextImpl) SetWorkflowExecution(
 	s.wLock()
 	defer s.wUnlock()
 
-	if err := s.errorByState(); err != nil {
+	if err := s.errorByWriteState(); err != nil {
 		return nil, err
 	}
 
@@ -988,7 +990,7 @@ func (s *ContextImpl) AppendHistoryEvents(
 	namespaceID namespace.ID,
 	execution commonpb.WorkflowExecution,
 ) (int, error) {
-	if err := s.errorByState(); err != nil {
+	if err := s.errorByWriteState(); err != nil {
 		return 0, err
 	}
 
@@ -1085,7 +1087,7 @@ func (s *ContextImpl) DeleteWorkflowExecution(
 			s.wLock()
 			defer s.wUnlock()
 
-			if err := s.errorByState(); err != nil {
+			if err := s.errorByWriteState(); err != nil {
 				return err
 			}
 
@@ -1197,6 +1199,23 @@ func (s *ContextImpl) errorByState() error {
 	s.stateLock.Lock()
 	defer s.stateLock.Unlock()
 
+	return s.errorByStateLocked()
+}
+
+// errorByWriteState is errorByState for workflow writes, which are also
+// rejected once the shard starts handing off to its new owner. Shard info
+// updates are still allowed so that the final ack levels can be persisted.
+func (s *ContextImpl) errorByWriteState() error {
+	s.stateLock.Lock()
+	defer s.stateLock.Unlock()
+
+	if s.handingOff {
+		return s.newShardClosedErrorWithShardID()
+	}
+	return s.errorByStateLocked()
+}
+
+func (s *ContextImpl) errorByStateLocked() error {
 	switch s.state {
 	case contextStateInitialized, contextStateAcquiring:
 		return ErrShardStatusUnknown
@@ -1289,26 +1308,28 @@ func (s *ContextImpl) updateShardInfoLocked() error {
 		return err
 	}
 
-	var err error
 	now := clock.NewRealTimeSource().Now()
 	if s.lastUpdated.Add(s.config.ShardUpdateMinInterval()).After(now) {
 		return nil
 	}
+	if err := s.persistShardInfoLocked(); err != nil {
+		return s.handleWriteErrorLocked(err)
+	}
+
+	s.lastUpdated = now
+	return nil
+}
+
+func (s *ContextImpl) persistShardInfoLocked() error {
 	updatedShardInfo := copyShardInfo(s.shardInfo)
 	s.emitShardInfoMetricsLogsLocked()
 
 	ctx, cancel := s.newIOContext()
 	defer cancel()
-	err = s.persistenceShardManager.UpdateShard(ctx, &persistence.UpdateShardRequest{
+	return s.persistenceShardManager.UpdateShard(ctx, &persistence.UpdateShardRequest{
 		ShardInfo:       updatedShardInfo.ShardInfo,
 		PreviousRangeID: s.shardInfo.GetRangeId(),
 	})
-	if err != nil {
-		return s.handleWriteErrorLocked(err)
-	}
-
-	s.lastUpdated = now
-	return nil
 }
 
 // TODO: Instead of having separate metric definition for each task category, we should
@@ -1579,6 +1600,64 @@ func (s *ContextImpl) finishStop() {
 	}
 }
 
+func (s *ContextImpl) IsHandingOff() bool {
+	s.stateLock.Lock()
+	defer s.stateLock.Unlock()
+	return s.handingOff
+}
+
+// handoff prepares the shard to be taken over by its new owner. It stops accepting workflow
+// writes, stops the engine so that queue processors checkpoint their progress, and then
+// persists the shard info regardless of ShardUpdateMinInterval. It returns up to maxWorkflows
+// of the most recently used workflows, which the new owner can use to prewarm its caches.
+// If handoff fails, the shard is no longer marked as handing off.
+// handoff should only be called by the controller, before finishStop.
+func (s *ContextImpl) handoff(maxWorkflows int) (_ []definition.WorkflowKey, retErr error) {
+	s.stateLock.Lock()
+	if s.state != contextStateAcquired {
+		defer s.stateLock.Unlock()
+		return nil, s.errorByStateLocked()
+	}
+	s.handingOff = true
+	s.stateLock.Unlock()
+
+	defer func() {
+		if retErr != nil {
+			s.stateLock.Lock()
+			s.handingOff = false
+			s.stateLock.Unlock()
+		}
+	}()
+
+	// use a context that we know is cancelled so that this doesn't block
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	engine, err := s.engineFuture.Get(cancelledCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	workflows := engine.ListCachedWorkflows(maxWorkflows)
+
+	s.contextTaggedLogger.Info("", tag.LifeCycleStopping, tag.ComponentShardEngine)
+	engine.Stop()
+	s.contextTaggedLogger.Info("", tag.LifeCycleStopped, tag.ComponentShardEngine)
+
+	s.wLock()
+	defer s.wUnlock()
+
+	if err := s.errorByState(); err != nil {
+		return workflows, err
+	}
+	// The shard is about to be unloaded, so unlike updateShardInfoLocked, a failure here
+	// must not trigger a shard reacquisition.
+	if err := s.persistShardInfoLocked(); err != nil {
+		return workflows, err
+	}
+	s.lastUpdated = clock.NewRealTimeSource().Now()
+	return workflows, nil
+}
+
 func (s *ContextImpl) isValid() bool {
 	s.stateLock.Lock()
 	defer s.stateLock.Unlock()
@@ -2125,6 +2204,7 @@ func newContext(
 		lifecycleCtx:            lifecycleCtx,
 		lifecycleCancel:         lifecycleCancel,
 		engineFuture:            future.NewFuture[Engine](),
+		loadTracker:             NewLoadTracker(timeSource, config.ShardLoadWindow, config.ShardLoadMaxTrackedWorkflows),
 	}
 	shardContext.eventsCache = events.NewEventsCache(
 		shardContext.GetShardID(),
@@ -2193,6 +2273,10 @@ func (s *ContextImpl) GetTimeSource() clock.TimeSource {
 	return s.timeSource
 }
 
+func (s *ContextImpl) GetLoadTracker() *LoadTracker {
+	return s.loadTracker
+}
+
 func (s *ContextImpl) GetNamespaceRegistry() namespace.Registry {
 	return s.namespaceRegistry
 }
@@ -392,6 +392,20 @@ func (mr *MockContextMockRecorder) GetLastUpdatedTime() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastUpdatedTime", reflect.TypeOf((*MockContext)(nil).GetLastUpdatedTime))
 }
 
+// GetLoadTracker mocks base method.
+func (m *MockContext) GetLoadTracker() *LoadTracker {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadTracker")
+	ret0, _ := ret[0].(*LoadTracker)
+	return ret0
+}
+
+// GetLoadTracker indicates an expected call of GetLoadTracker.
+func (mr *MockContextMockRecorder) GetLoadTracker() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadTracker", reflect.TypeOf((*MockContext)(nil).GetLoadTracker))
+}
+
 // GetLogger mocks base method.
 func (m *MockContext) GetLogger() log.Logger {
 	m.ctrl.T.Helper()
@@ -649,6 +663,20 @@ func (mr *MockContextMockRecorder) GetWorkflowExecution(ctx, request interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecution", reflect.TypeOf((*MockContext)(nil).GetWorkflowExecution), ctx, request)
 }
 
+// IsHandingOff mocks base method.
+func (m *MockContext) IsHandingOff() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsHandingOff")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsHandingOff indicates an expected call of IsHandingOff.
+func (mr *MockContextMockRecorder) IsHandingOff() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsHandingOff", reflect.TypeOf((*MockContext)(nil).IsHandingOff))
+}
+
 // NewVectorClock mocks base method.
 func (m *MockContext) NewVectorClock() (*v11.VectorClock, error) {
 	m.ctrl.T.Helper()
@@ -423,3 +423,45 @@ func (s *contextSuite) TestAcquireShardNoError() {
 
 	s.Assert().Equal(contextStateAcquired, s.mockShard.state)
 }
+
+func (s *contextSuite) TestHandoff() {
+	workflowKey := definition.NewWorkflowKey(tests.NamespaceID.String(), tests.WorkflowID, tests.RunID)
+	s.mockHistoryEngine.EXPECT().ListCachedWorkflows(10).Return([]definition.WorkflowKey{workflowKey})
+	s.mockHistoryEngine.EXPECT().Stop()
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil)
+
+	workflows, err := s.mockShard.handoff(10)
+	s.NoError(err)
+	s.Equal([]definition.WorkflowKey{workflowKey}, workflows)
+	s.True(s.mockShard.IsHandingOff())
+	s.Equal(contextStateAcquired, s.mockShard.state)
+
+	// workflow writes are rejected once the handoff started
+	err = s.mockShard.AddTasks(context.Background(), &persistence.AddHistoryTasksRequest{
+		ShardID:     s.mockShard.GetShardID(),
+		NamespaceID: tests.NamespaceID.String(),
+		WorkflowID:  tests.WorkflowID,
+		RunID:       tests.RunID,
+	})
+	s.IsType(&persistence.ShardOwnershipLostError{}, err)
+}
+
+func (s *contextSuite) TestHandoff_NotAcquired() {
+	s.mockShard.state = contextStateAcquiring
+
+	workflows, err := s.mockShard.handoff(10)
+	s.Equal(ErrShardStatusUnknown, err)
+	s.Empty(workflows)
+	s.False(s.mockShard.IsHandingOff())
+}
+
+func (s *contextSuite) TestHandoff_FlushFailureDoesNotReacquire() {
+	s.mockHistoryEngine.EXPECT().ListCachedWorkflows(10).Return(nil)
+	s.mockHistoryEngine.EXPECT().Stop()
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(errors.New("some random error"))
+
+	_, err := s.mockShard.handoff(10)
+	s.Error(err)
+	s.Equal(contextStateAcquired, s.mockShard.state)
+	s.False(s.mockShard.IsHandingOff())
+}
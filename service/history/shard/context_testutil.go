@@ -114,6 +114,7 @@ func NewTestContext(
 		archivalMetadata:        resourceTest.GetArchivalMetadata(),
 		hostInfoProvider:        hostInfoProvider,
 	}
+	shard.loadTracker = NewLoadTracker(shard.timeSource, config.ShardLoadWindow, config.ShardLoadMaxTrackedWorkflows)
 	return &ContextTest{
 		Resource:             resourceTest,
 		ContextImpl:          shard,
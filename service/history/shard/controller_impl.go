@@ -41,6 +41,7 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
@@ -248,6 +249,9 @@ func (c *ControllerImpl) getOrCreateShardContext(shardID int32) (*ContextImpl, e
 
 	hostInfo := c.hostInfoProvider.HostInfo()
 	if ownerInfo.Identity() != hostInfo.Identity() {
+		// The caller routed this request here, so its view of the shard owner may be newer than ours,
+		// e.g. after the shard was moved by an operator.
+		c.historyServiceResolver.RequestRefresh()
 		return nil, serviceerrors.NewShardOwnershipLost(ownerInfo.Identity(), hostInfo.GetAddress())
 	}
 
@@ -435,6 +439,9 @@ LoopSubmit:
 
 func (c *ControllerImpl) doShutdown() {
 	c.contextTaggedLogger.Info("", tag.LifeCycleStopping)
+	if c.config.ShardHandoffEnabled() {
+		c.handoffShards()
+	}
 	c.Lock()
 	defer c.Unlock()
 	for _, shard := range c.historyShards {
@@ -443,6 +450,92 @@ func (c *ControllerImpl) doShutdown() {
 	c.historyShards = nil
 }
 
+// handoffShards hands off all shards owned by this host to their new owners. Shards are
+// not removed from the map here, doShutdown stops them afterwards as usual.
+func (c *ControllerImpl) handoffShards() {
+	c.RLock()
+	shards := make([]*ContextImpl, 0, len(c.historyShards))
+	for _, shard := range c.historyShards {
+		shards = append(shards, shard)
+	}
+	c.RUnlock()
+
+	shardCh := make(chan *ContextImpl, len(shards))
+	for _, shard := range shards {
+		shardCh <- shard
+	}
+	close(shardCh)
+
+	concurrency := util.Max(c.config.ShardHandoffConcurrency(), 1)
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			for shard := range shardCh {
+				c.handoffShard(shard)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// handoffShard stops the shard from taking writes, persists its latest progress and then notifies
+// the new owner, so that it can acquire the shard right away and prewarm its caches with the
+// workflows that were recently used on this host.
+func (c *ControllerImpl) handoffShard(shard *ContextImpl) {
+	startTime := time.Now().UTC()
+	defer func() {
+		c.taggedMetricsHandler.Timer(metrics.ShardHandoffLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	logger := log.With(c.contextTaggedLogger, tag.ShardID(shard.shardID))
+	workflows, err := shard.handoff(c.config.ShardHandoffPrewarmWorkflowCount())
+	if err != nil {
+		c.taggedMetricsHandler.Counter(metrics.ShardHandoffFailedCounter.GetMetricName()).Record(1)
+		logger.Warn("Unable to prepare shard for handoff", tag.Error(err))
+		return
+	}
+
+	ownerInfo, err := c.historyServiceResolver.Lookup(convert.Int32ToString(shard.shardID))
+	if err != nil {
+		c.taggedMetricsHandler.Counter(metrics.ShardHandoffFailedCounter.GetMetricName()).Record(1)
+		logger.Warn("Error looking up new owner for shard handoff", tag.Error(err))
+		return
+	}
+	hostInfo := c.hostInfoProvider.HostInfo()
+	if ownerInfo.Identity() == hostInfo.Identity() {
+		// membership hasn't propagated the eviction of this host yet, the new owner
+		// will pick up the shard on its own once it does.
+		c.taggedMetricsHandler.Counter(metrics.ShardHandoffFailedCounter.GetMetricName()).Record(1)
+		logger.Warn("No new owner found for shard handoff")
+		return
+	}
+
+	request := &historyservice.HandoffShardRequest{
+		ShardId:       shard.shardID,
+		PreviousOwner: hostInfo.GetAddress(),
+		Workflows:     make([]*historyservice.HandoffShardWorkflow, 0, len(workflows)),
+	}
+	for _, workflowKey := range workflows {
+		request.Workflows = append(request.Workflows, &historyservice.HandoffShardWorkflow{
+			NamespaceId: workflowKey.NamespaceID,
+			WorkflowId:  workflowKey.WorkflowID,
+			RunId:       workflowKey.RunID,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.ShardHandoffTimeout())
+	defer cancel()
+	ctx = headers.SetCallerInfo(ctx, headers.SystemBackgroundCallerInfo)
+	if _, err := c.historyClient.HandoffShard(ctx, request); err != nil {
+		c.taggedMetricsHandler.Counter(metrics.ShardHandoffFailedCounter.GetMetricName()).Record(1)
+		logger.Warn("Unable to hand off shard to new owner", tag.Address(ownerInfo.GetAddress()), tag.Error(err))
+		return
+	}
+	logger.Info("Shard handed off to new owner", tag.Address(ownerInfo.GetAddress()), tag.Counter(len(workflows)))
+}
+
 func (c *ControllerImpl) ShardIDs() []int32 {
 	c.RLock()
 	defer c.RUnlock()
@@ -36,6 +36,7 @@ import (
 	"time"
 
 	"go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 
 	"github.com/golang/mock/gomock"
@@ -45,6 +46,8 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
@@ -134,6 +137,8 @@ func (s *controllerSuite) SetupTest() {
 	s.hostInfo = s.mockResource.GetHostInfo()
 	s.mockHostInfoProvider = membership.NewMockHostInfoProvider(s.controller)
 	s.mockHostInfoProvider.EXPECT().HostInfo().Return(s.hostInfo).AnyTimes()
+	// requested whenever a shard is looked up on a host that does not own it
+	s.mockServiceResolver.EXPECT().RequestRefresh().AnyTimes()
 
 	s.logger = s.mockResource.Logger
 	s.config = tests.NewDynamicConfig()
@@ -988,3 +993,78 @@ func (s getOrCreateShardRequestMatcher) Matches(x interface{}) bool {
 func (s getOrCreateShardRequestMatcher) String() string {
 	return strconv.Itoa(int(s))
 }
+
+func (s *controllerSuite) TestShardHandoff() {
+	s.config.NumberOfShards = 1
+	s.config.ShardHandoffPrewarmWorkflowCount = dynamicconfig.GetIntPropertyFn(10)
+	shard, mockEngine := s.acquireShardForHandoff(0)
+
+	workflowKey := definition.NewWorkflowKey(tests.NamespaceID.String(), tests.WorkflowID, tests.RunID)
+	newOwner := membership.NewHostInfo("new-owner:7234", nil)
+	mockEngine.EXPECT().ListCachedWorkflows(10).Return([]definition.WorkflowKey{workflowKey})
+	mockEngine.EXPECT().Stop()
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil)
+	s.handoffServiceResolver().EXPECT().Lookup(convert.Int32ToString(0)).Return(newOwner, nil)
+	s.mockResource.HistoryClient.EXPECT().HandoffShard(gomock.Any(), &historyservice.HandoffShardRequest{
+		ShardId:       0,
+		PreviousOwner: s.hostInfo.GetAddress(),
+		Workflows: []*historyservice.HandoffShardWorkflow{{
+			NamespaceId: tests.NamespaceID.String(),
+			WorkflowId:  tests.WorkflowID,
+			RunId:       tests.RunID,
+		}},
+	}).Return(&historyservice.HandoffShardResponse{}, nil)
+
+	s.shardController.handoffShard(shard)
+	s.True(shard.IsHandingOff())
+}
+
+func (s *controllerSuite) TestShardHandoff_NoNewOwner() {
+	s.config.NumberOfShards = 1
+	shard, mockEngine := s.acquireShardForHandoff(0)
+
+	mockEngine.EXPECT().ListCachedWorkflows(gomock.Any()).Return(nil)
+	mockEngine.EXPECT().Stop()
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil)
+	// membership still maps the shard to this host, so there is nobody to hand it off to
+	s.handoffServiceResolver().EXPECT().Lookup(convert.Int32ToString(0)).Return(s.hostInfo, nil)
+	s.mockResource.HistoryClient.EXPECT().HandoffShard(gomock.Any(), gomock.Any()).Times(0)
+
+	s.shardController.handoffShard(shard)
+}
+
+func (s *controllerSuite) TestShardHandoff_PrepareFailure() {
+	s.config.NumberOfShards = 1
+	shard, mockEngine := s.acquireShardForHandoff(0)
+
+	mockEngine.EXPECT().ListCachedWorkflows(gomock.Any()).Return(nil)
+	mockEngine.EXPECT().Stop()
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(errors.New("some random error"))
+	s.mockResource.HistoryClient.EXPECT().HandoffShard(gomock.Any(), gomock.Any()).Times(0)
+
+	s.shardController.handoffShard(shard)
+	s.False(shard.IsHandingOff())
+}
+
+func (s *controllerSuite) acquireShardForHandoff(shardID int32) (*ContextImpl, *MockEngine) {
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	mockEngine := NewMockEngine(s.controller)
+	s.setupMocksForAcquireShard(shardID, mockEngine, 5, 6, true)
+
+	shard, err := s.shardController.getOrCreateShardContext(shardID)
+	s.NoError(err)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err = shard.GetEngine(ctx)
+	s.NoError(err)
+	return shard, mockEngine
+}
+
+// handoffServiceResolver replaces the resolver used by the controller, so that lookups made during handoff
+// don't match the expectations set up for acquiring the shard.
+func (s *controllerSuite) handoffServiceResolver() *membership.MockServiceResolver {
+	resolver := membership.NewMockServiceResolver(s.controller)
+	s.shardController.historyServiceResolver = resolver
+	return resolver
+}
@@ -36,8 +36,10 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	common "go.temporal.io/api/common/v1"
 	history "go.temporal.io/api/history/v1"
+	history0 "go.temporal.io/server/api/history/v1"
 	historyservice "go.temporal.io/server/api/historyservice/v1"
 	repication "go.temporal.io/server/api/replication/v1"
+	definition "go.temporal.io/server/common/definition"
 	namespace "go.temporal.io/server/common/namespace"
 	events "go.temporal.io/server/service/history/events"
 	tasks "go.temporal.io/server/service/history/tasks"
@@ -81,6 +83,20 @@ func (mr *MockEngineMockRecorder) DeleteWorkflowExecution(ctx, deleteRequest int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).DeleteWorkflowExecution), ctx, deleteRequest)
 }
 
+// DescribeLoad mocks base method.
+func (m *MockEngine) DescribeLoad(topWorkflowCount int) *history0.ShardLoad {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeLoad", topWorkflowCount)
+	ret0, _ := ret[0].(*history0.ShardLoad)
+	return ret0
+}
+
+// DescribeLoad indicates an expected call of DescribeLoad.
+func (mr *MockEngineMockRecorder) DescribeLoad(topWorkflowCount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLoad", reflect.TypeOf((*MockEngine)(nil).DescribeLoad), topWorkflowCount)
+}
+
 // DescribeMutableState mocks base method.
 func (m *MockEngine) DescribeMutableState(ctx context.Context, request *historyservice.DescribeMutableStateRequest) (*historyservice.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +217,35 @@ func (mr *MockEngineMockRecorder) GetReplicationStatus(ctx, request interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockEngine)(nil).GetReplicationStatus), ctx, request)
 }
 
+// ImportWorkflowExecution mocks base method.
+func (m *MockEngine) ImportWorkflowExecution(ctx context.Context, request *historyservice.ImportWorkflowExecutionRequest) (*historyservice.ImportWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*historyservice.ImportWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportWorkflowExecution indicates an expected call of ImportWorkflowExecution.
+func (mr *MockEngineMockRecorder) ImportWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).ImportWorkflowExecution), ctx, request)
+}
+
+// ListCachedWorkflows mocks base method.
+func (m *MockEngine) ListCachedWorkflows(maxCount int) []definition.WorkflowKey {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCachedWorkflows", maxCount)
+	ret0, _ := ret[0].([]definition.WorkflowKey)
+	return ret0
+}
+
+// ListCachedWorkflows indicates an expected call of ListCachedWorkflows.
+func (mr *MockEngineMockRecorder) ListCachedWorkflows(maxCount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCachedWorkflows", reflect.TypeOf((*MockEngine)(nil).ListCachedWorkflows), maxCount)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockEngine) MergeDLQMessages(ctx context.Context, messagesRequest *historyservice.MergeDLQMessagesRequest) (*historyservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -255,6 +300,20 @@ func (mr *MockEngineMockRecorder) PollMutableState(ctx, request interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollMutableState", reflect.TypeOf((*MockEngine)(nil).PollMutableState), ctx, request)
 }
 
+// PrewarmCaches mocks base method.
+func (m *MockEngine) PrewarmCaches(ctx context.Context, workflows []definition.WorkflowKey) int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrewarmCaches", ctx, workflows)
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// PrewarmCaches indicates an expected call of PrewarmCaches.
+func (mr *MockEngineMockRecorder) PrewarmCaches(ctx, workflows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrewarmCaches", reflect.TypeOf((*MockEngine)(nil).PrewarmCaches), ctx, workflows)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockEngine) PurgeDLQMessages(ctx context.Context, messagesRequest *historyservice.PurgeDLQMessagesRequest) (*historyservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -690,6 +749,21 @@ func (mr *MockEngineMockRecorder) UpdateWorkflow(ctx, request interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflow", reflect.TypeOf((*MockEngine)(nil).UpdateWorkflow), ctx, request)
 }
 
+// UpsertWorkflowSearchAttributes mocks base method.
+func (m *MockEngine) UpsertWorkflowSearchAttributes(ctx context.Context, request *historyservice.UpsertWorkflowSearchAttributesRequest) (*historyservice.UpsertWorkflowSearchAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkflowSearchAttributes", ctx, request)
+	ret0, _ := ret[0].(*historyservice.UpsertWorkflowSearchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWorkflowSearchAttributes indicates an expected call of UpsertWorkflowSearchAttributes.
+func (mr *MockEngineMockRecorder) UpsertWorkflowSearchAttributes(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowSearchAttributes", reflect.TypeOf((*MockEngine)(nil).UpsertWorkflowSearchAttributes), ctx, request)
+}
+
 // VerifyChildExecutionCompletionRecorded mocks base method.
 func (m *MockEngine) VerifyChildExecutionCompletionRecorded(ctx context.Context, request *historyservice.VerifyChildExecutionCompletionRecordedRequest) (*historyservice.VerifyChildExecutionCompletionRecordedResponse, error) {
 	m.ctrl.T.Helper()
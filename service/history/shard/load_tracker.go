@@ -0,0 +1,233 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+)
+
+type (
+	// LoadTracker counts the requests routed to a shard by API and by workflow, and the lookups of the
+	// shard's mutable state cache. Counts are kept for the current and the previous window, so reports
+	// always cover between one and two windows.
+	LoadTracker struct {
+		timeSource   clock.TimeSource
+		window       dynamicconfig.DurationPropertyFn
+		maxWorkflows dynamicconfig.IntPropertyFn
+
+		sync.Mutex
+		current  *loadWindow
+		previous *loadWindow
+	}
+
+	loadWindow struct {
+		start            time.Time
+		apiRequests      map[string]int64
+		workflowRequests map[workflowLoadKey]int64
+		cacheLookups     int64
+		cacheMisses      int64
+	}
+
+	workflowLoadKey struct {
+		namespaceID namespace.ID
+		workflowID  string
+	}
+)
+
+func NewLoadTracker(
+	timeSource clock.TimeSource,
+	window dynamicconfig.DurationPropertyFn,
+	maxWorkflows dynamicconfig.IntPropertyFn,
+) *LoadTracker {
+	return &LoadTracker{
+		timeSource:   timeSource,
+		window:       window,
+		maxWorkflows: maxWorkflows,
+		current:      newLoadWindow(timeSource.Now()),
+	}
+}
+
+func newLoadWindow(start time.Time) *loadWindow {
+	return &loadWindow{
+		start:            start,
+		apiRequests:      make(map[string]int64),
+		workflowRequests: make(map[workflowLoadKey]int64),
+	}
+}
+
+// RecordRequest records a request of the given API for a workflow of the shard.
+// Once the max number of tracked workflows is reached, requests of workflows not yet
+// seen in the current window only count towards the API.
+func (t *LoadTracker) RecordRequest(
+	api string,
+	namespaceID namespace.ID,
+	workflowID string,
+) {
+	t.Lock()
+	defer t.Unlock()
+
+	t.rotateLocked(t.timeSource.Now())
+	t.current.apiRequests[api]++
+
+	key := workflowLoadKey{namespaceID: namespaceID, workflowID: workflowID}
+	if _, ok := t.current.workflowRequests[key]; ok || len(t.current.workflowRequests) < t.maxWorkflows() {
+		t.current.workflowRequests[key]++
+	}
+}
+
+// RecordCacheLookup records a lookup of the shard's mutable state cache.
+func (t *LoadTracker) RecordCacheLookup(hit bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	t.rotateLocked(t.timeSource.Now())
+	t.current.cacheLookups++
+	if !hit {
+		t.current.cacheMisses++
+	}
+}
+
+// CacheHitRate returns the fraction of mutable state cache lookups which found the workflow in the
+// cache, or 0 if there were no lookups.
+func (t *LoadTracker) CacheHitRate() float64 {
+	t.Lock()
+	defer t.Unlock()
+
+	t.rotateLocked(t.timeSource.Now())
+
+	var lookups, misses int64
+	for _, window := range t.windowsLocked() {
+		lookups += window.cacheLookups
+		misses += window.cacheMisses
+	}
+	if lookups == 0 {
+		return 0
+	}
+	return float64(lookups-misses) / float64(lookups)
+}
+
+// RequestRates returns the requests per second of each API, highest first.
+func (t *LoadTracker) RequestRates() []*historyspb.ApiRequestRate {
+	t.Lock()
+	defer t.Unlock()
+
+	now := t.timeSource.Now()
+	t.rotateLocked(now)
+
+	apiRequests := make(map[string]int64)
+	start := t.current.start
+	for _, window := range t.windowsLocked() {
+		for api, count := range window.apiRequests {
+			apiRequests[api] += count
+		}
+		if window.start.Before(start) {
+			start = window.start
+		}
+	}
+
+	elapsed := now.Sub(start)
+	if elapsed < time.Second {
+		elapsed = time.Second
+	}
+	rates := make([]*historyspb.ApiRequestRate, 0, len(apiRequests))
+	for api, count := range apiRequests {
+		rates = append(rates, &historyspb.ApiRequestRate{
+			Api:               api,
+			RequestsPerSecond: float64(count) / elapsed.Seconds(),
+		})
+	}
+	sort.Slice(rates, func(i, j int) bool {
+		if rates[i].RequestsPerSecond != rates[j].RequestsPerSecond {
+			return rates[i].RequestsPerSecond > rates[j].RequestsPerSecond
+		}
+		return rates[i].Api < rates[j].Api
+	})
+	return rates
+}
+
+// TopWorkflows returns up to maxCount workflows with the most requests, busiest first.
+func (t *LoadTracker) TopWorkflows(maxCount int) []*historyspb.WorkflowLoad {
+	t.Lock()
+	defer t.Unlock()
+
+	t.rotateLocked(t.timeSource.Now())
+
+	workflowRequests := make(map[workflowLoadKey]int64)
+	for _, window := range t.windowsLocked() {
+		for key, count := range window.workflowRequests {
+			workflowRequests[key] += count
+		}
+	}
+
+	loads := make([]*historyspb.WorkflowLoad, 0, len(workflowRequests))
+	for key, count := range workflowRequests {
+		loads = append(loads, &historyspb.WorkflowLoad{
+			NamespaceId:  key.namespaceID.String(),
+			WorkflowId:   key.workflowID,
+			RequestCount: count,
+		})
+	}
+	sort.Slice(loads, func(i, j int) bool {
+		if loads[i].RequestCount != loads[j].RequestCount {
+			return loads[i].RequestCount > loads[j].RequestCount
+		}
+		if loads[i].NamespaceId != loads[j].NamespaceId {
+			return loads[i].NamespaceId < loads[j].NamespaceId
+		}
+		return loads[i].WorkflowId < loads[j].WorkflowId
+	})
+	if len(loads) > maxCount {
+		loads = loads[:maxCount]
+	}
+	return loads
+}
+
+func (t *LoadTracker) rotateLocked(now time.Time) {
+	window := t.window()
+	elapsed := now.Sub(t.current.start)
+	if elapsed < window {
+		return
+	}
+
+	t.previous = nil
+	if elapsed < 2*window {
+		t.previous = t.current
+	}
+	t.current = newLoadWindow(now)
+}
+
+func (t *LoadTracker) windowsLocked() []*loadWindow {
+	if t.previous == nil {
+		return []*loadWindow{t.current}
+	}
+	return []*loadWindow{t.previous, t.current}
+}
@@ -0,0 +1,130 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+type (
+	loadTrackerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		timeSource  *clock.EventTimeSource
+		loadTracker *LoadTracker
+	}
+)
+
+func TestLoadTrackerSuite(t *testing.T) {
+	s := &loadTrackerSuite{}
+	suite.Run(t, s)
+}
+
+func (s *loadTrackerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+	s.loadTracker = NewLoadTracker(
+		s.timeSource,
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		dynamicconfig.GetIntPropertyFn(2),
+	)
+}
+
+func (s *loadTrackerSuite) TestRequestRates() {
+	for i := 0; i < 60; i++ {
+		s.loadTracker.RecordRequest("SignalWorkflowExecution", "namespace", "workflow")
+	}
+	for i := 0; i < 30; i++ {
+		s.loadTracker.RecordRequest("GetMutableState", "namespace", "workflow")
+	}
+	s.timeSource.Update(s.timeSource.Now().Add(30 * time.Second))
+
+	s.Equal([]*historyspb.ApiRequestRate{
+		{Api: "SignalWorkflowExecution", RequestsPerSecond: 2},
+		{Api: "GetMutableState", RequestsPerSecond: 1},
+	}, s.loadTracker.RequestRates())
+}
+
+func (s *loadTrackerSuite) TestTopWorkflows() {
+	s.loadTracker.RecordRequest("SignalWorkflowExecution", "namespace", "workflow-1")
+	s.loadTracker.RecordRequest("SignalWorkflowExecution", "namespace", "workflow-2")
+	s.loadTracker.RecordRequest("SignalWorkflowExecution", "namespace", "workflow-2")
+	// exceeds the max number of tracked workflows
+	s.loadTracker.RecordRequest("SignalWorkflowExecution", "namespace", "workflow-3")
+
+	s.Equal([]*historyspb.WorkflowLoad{
+		{NamespaceId: "namespace", WorkflowId: "workflow-2", RequestCount: 2},
+		{NamespaceId: "namespace", WorkflowId: "workflow-1", RequestCount: 1},
+	}, s.loadTracker.TopWorkflows(10))
+	s.Len(s.loadTracker.TopWorkflows(1), 1)
+}
+
+func (s *loadTrackerSuite) TestWindowRotation() {
+	s.loadTracker.RecordRequest("SignalWorkflowExecution", "namespace", "workflow-1")
+
+	// the previous window is still reported
+	s.timeSource.Update(s.timeSource.Now().Add(90 * time.Second))
+	s.loadTracker.RecordRequest("SignalWorkflowExecution", "namespace", "workflow-2")
+	s.Len(s.loadTracker.TopWorkflows(10), 2)
+
+	// windows older than twice the window size are dropped
+	s.timeSource.Update(s.timeSource.Now().Add(3 * time.Minute))
+	s.Empty(s.loadTracker.TopWorkflows(10))
+	s.Empty(s.loadTracker.RequestRates())
+}
+
+func (s *loadTrackerSuite) TestCacheHitRate() {
+	s.Zero(s.loadTracker.CacheHitRate())
+
+	s.loadTracker.RecordCacheLookup(false)
+	s.loadTracker.RecordCacheLookup(true)
+	s.Equal(0.5, s.loadTracker.CacheHitRate())
+
+	// lookups of the previous window are still reported
+	s.timeSource.Update(s.timeSource.Now().Add(90 * time.Second))
+	s.loadTracker.RecordCacheLookup(true)
+	s.loadTracker.RecordCacheLookup(true)
+	s.Equal(0.75, s.loadTracker.CacheHitRate())
+
+	// lookups of the window before the previous one are dropped
+	s.timeSource.Update(s.timeSource.Now().Add(90 * time.Second))
+	s.loadTracker.RecordCacheLookup(false)
+	s.loadTracker.RecordCacheLookup(false)
+	s.Equal(0.5, s.loadTracker.CacheHitRate())
+
+	// windows older than twice the window size are dropped
+	s.timeSource.Update(s.timeSource.Now().Add(3 * time.Minute))
+	s.Zero(s.loadTracker.CacheHitRate())
+}
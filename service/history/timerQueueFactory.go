@@ -77,6 +77,7 @@ func NewTimerQueueFactory(
 					WorkerCount:                 params.Config.TimerProcessorSchedulerWorkerCount,
 					ActiveNamespaceWeights:      params.Config.TimerProcessorSchedulerActiveRoundRobinWeights,
 					StandbyNamespaceWeights:     params.Config.TimerProcessorSchedulerStandbyRoundRobinWeights,
+					NamespaceWeight:             params.Config.TaskSchedulerNamespaceWeight,
 					EnableRateLimiter:           params.Config.TaskSchedulerEnableRateLimiter,
 					MaxDispatchThrottleDuration: HostSchedulerMaxDispatchThrottleDuration,
 				},
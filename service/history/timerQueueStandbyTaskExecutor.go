@@ -289,7 +289,7 @@ func (t *timerQueueStandbyTaskExecutor) executeActivityRetryTimerTask(
 			return nil, nil
 		}
 
-		return newActivityRetryTimePostActionInfo(mutableState, activityInfo.TaskQueue, *activityInfo.ScheduleToStartTimeout)
+		return newActivityRetryTimePostActionInfo(mutableState, activityInfo.TaskQueue, *activityInfo.ScheduleToStartTimeout, activityInfo.Priority, activityInfo.FairnessKey)
 	}
 
 	return t.processTimer(
@@ -607,6 +607,8 @@ func (t *timerQueueStandbyTaskExecutor) pushActivity(
 		ScheduledEventId:       activityTask.EventID,
 		ScheduleToStartTimeout: activityScheduleToStartTimeout,
 		Clock:                  vclock.NewVectorClock(t.shard.GetClusterMetadata().GetClusterID(), t.shard.GetShardID(), activityTask.TaskID),
+		Priority:               pushActivityInfo.priority,
+		FairnessKey:            pushActivityInfo.fairnessKey,
 	})
 	return err
 }
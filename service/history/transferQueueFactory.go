@@ -79,6 +79,7 @@ func NewTransferQueueFactory(
 					WorkerCount:                 params.Config.TransferProcessorSchedulerWorkerCount,
 					ActiveNamespaceWeights:      params.Config.TransferProcessorSchedulerActiveRoundRobinWeights,
 					StandbyNamespaceWeights:     params.Config.TransferProcessorSchedulerStandbyRoundRobinWeights,
+					NamespaceWeight:             params.Config.TaskSchedulerNamespaceWeight,
 					EnableRateLimiter:           params.Config.TaskSchedulerEnableRateLimiter,
 					MaxDispatchThrottleDuration: HostSchedulerMaxDispatchThrottleDuration,
 				},
@@ -146,7 +146,7 @@ func (t *transferQueueStandbyTaskExecutor) processActivityTask(
 		}
 
 		if activityInfo.StartedEventId == common.EmptyEventID {
-			return newActivityTaskPostActionInfo(mutableState, *activityInfo.ScheduleToStartTimeout)
+			return newActivityTaskPostActionInfo(mutableState, *activityInfo.ScheduleToStartTimeout, activityInfo.Priority, activityInfo.FairnessKey)
 		}
 
 		return nil, nil
@@ -204,6 +204,8 @@ func (t *transferQueueStandbyTaskExecutor) processWorkflowTask(
 				mutableState,
 				taskScheduleToStartTimeoutSeconds,
 				*taskQueue,
+				executionInfo.Priority,
+				executionInfo.FairnessKey,
 			)
 		}
 
@@ -553,6 +555,8 @@ func (t *transferQueueStandbyTaskExecutor) pushActivity(
 		ctx,
 		task.(*tasks.ActivityTask),
 		&timeout,
+		pushActivityInfo.priority,
+		pushActivityInfo.fairnessKey,
 	)
 }
 
@@ -573,6 +577,8 @@ func (t *transferQueueStandbyTaskExecutor) pushWorkflowTask(
 		task.(*tasks.WorkflowTask),
 		&pushwtInfo.taskqueue,
 		timestamp.DurationFromSeconds(timeout),
+		pushwtInfo.priority,
+		pushwtInfo.fairnessKey,
 	)
 }
 
@@ -68,6 +68,7 @@ func NewVisibilityQueueFactory(
 					WorkerCount:                 params.Config.VisibilityProcessorSchedulerWorkerCount,
 					ActiveNamespaceWeights:      params.Config.VisibilityProcessorSchedulerActiveRoundRobinWeights,
 					StandbyNamespaceWeights:     params.Config.VisibilityProcessorSchedulerStandbyRoundRobinWeights,
+					NamespaceWeight:             params.Config.TaskSchedulerNamespaceWeight,
 					EnableRateLimiter:           params.Config.TaskSchedulerEnableRateLimiter,
 					MaxDispatchThrottleDuration: HostSchedulerMaxDispatchThrottleDuration,
 				},
@@ -57,6 +57,9 @@ type (
 			execution commonpb.WorkflowExecution,
 			caller workflow.CallerType,
 		) (workflow.Context, ReleaseCacheFunc, error)
+		// RecentWorkflowKeys returns up to maxCount keys of the cached workflows,
+		// most recently accessed first.
+		RecentWorkflowKeys(maxCount int) []definition.WorkflowKey
 	}
 
 	CacheImpl struct {
@@ -134,6 +137,7 @@ func (c *CacheImpl) getOrCreateWorkflowExecutionInternal(
 
 	key := definition.NewWorkflowKey(namespaceID.String(), execution.GetWorkflowId(), execution.GetRunId())
 	workflowCtx, cacheHit := c.Get(key).(workflow.Context)
+	c.shard.GetLoadTracker().RecordCacheLookup(cacheHit)
 	if !cacheHit {
 		handler.Counter(metrics.CacheMissCounter.GetMetricName()).Record(1)
 		// Let's create the workflow execution workflowCtx
@@ -187,6 +191,17 @@ func (c *CacheImpl) makeReleaseFunc(
 	}
 }
 
+func (c *CacheImpl) RecentWorkflowKeys(maxCount int) []definition.WorkflowKey {
+	it := c.Iterator()
+	defer it.Close()
+
+	var keys []definition.WorkflowKey
+	for len(keys) < maxCount && it.HasNext() {
+		keys = append(keys, it.Next().Key().(definition.WorkflowKey))
+	}
+	return keys
+}
+
 func (c *CacheImpl) validateWorkflowExecutionInfo(
 	ctx context.Context,
 	namespaceID namespace.ID,
@@ -34,6 +34,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	v1 "go.temporal.io/api/common/v1"
+	definition "go.temporal.io/server/common/definition"
 	namespace "go.temporal.io/server/common/namespace"
 	workflow "go.temporal.io/server/service/history/workflow"
 )
@@ -76,3 +77,17 @@ func (mr *MockCacheMockRecorder) GetOrCreateWorkflowExecution(ctx, namespaceID,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrCreateWorkflowExecution", reflect.TypeOf((*MockCache)(nil).GetOrCreateWorkflowExecution), ctx, namespaceID, execution, caller)
 }
+
+// RecentWorkflowKeys mocks base method.
+func (m *MockCache) RecentWorkflowKeys(maxCount int) []definition.WorkflowKey {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecentWorkflowKeys", maxCount)
+	ret0, _ := ret[0].([]definition.WorkflowKey)
+	return ret0
+}
+
+// RecentWorkflowKeys indicates an expected call of RecentWorkflowKeys.
+func (mr *MockCacheMockRecorder) RecentWorkflowKeys(maxCount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecentWorkflowKeys", reflect.TypeOf((*MockCache)(nil).RecentWorkflowKeys), maxCount)
+}
@@ -40,6 +40,7 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
@@ -192,6 +193,64 @@ func (s *workflowCacheSuite) TestHistoryCachePinning() {
 	release(err4)
 }
 
+func (s *workflowCacheSuite) TestHistoryCacheRecentWorkflowKeys() {
+	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertyFn(10)
+	namespaceID := namespace.ID("test_namespace_id")
+	s.cache = NewCache(s.mockShard)
+
+	var executions []commonpb.WorkflowExecution
+	for i := 0; i < 3; i++ {
+		executions = append(executions, commonpb.WorkflowExecution{
+			WorkflowId: "wf-cache-test-recent-keys",
+			RunId:      uuid.New(),
+		})
+	}
+	// access the first execution last so that it becomes the most recent one
+	for _, execution := range append(executions, executions[0]) {
+		_, release, err := s.cache.GetOrCreateWorkflowExecution(
+			context.Background(),
+			namespaceID,
+			execution,
+			workflow.CallerTypeAPI,
+		)
+		s.NoError(err)
+		release(nil)
+	}
+
+	keyOf := func(execution commonpb.WorkflowExecution) definition.WorkflowKey {
+		return definition.NewWorkflowKey(namespaceID.String(), execution.GetWorkflowId(), execution.GetRunId())
+	}
+	s.Equal([]definition.WorkflowKey{
+		keyOf(executions[0]),
+		keyOf(executions[2]),
+	}, s.cache.RecentWorkflowKeys(2))
+	s.Len(s.cache.RecentWorkflowKeys(10), 3)
+	s.Empty(s.cache.RecentWorkflowKeys(0))
+}
+
+func (s *workflowCacheSuite) TestHistoryCacheHitRate() {
+	s.cache = NewCache(s.mockShard)
+	s.Zero(s.mockShard.GetLoadTracker().CacheHitRate())
+
+	namespaceID := namespace.ID("test_namespace_id")
+	runID1 := uuid.New()
+	runID2 := uuid.New()
+	for _, runID := range []string{runID1, runID1, runID2, runID1} {
+		_, release, err := s.cache.GetOrCreateWorkflowExecution(
+			context.Background(),
+			namespaceID,
+			commonpb.WorkflowExecution{
+				WorkflowId: "some random workflow ID",
+				RunId:      runID,
+			},
+			workflow.CallerTypeAPI,
+		)
+		s.NoError(err)
+		release(nil)
+	}
+	s.Equal(0.5, s.mockShard.GetLoadTracker().CacheHitRate())
+}
+
 func (s *workflowCacheSuite) TestHistoryCacheClear() {
 	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertyFn(20)
 	namespaceID := namespace.ID("test_namespace_id")
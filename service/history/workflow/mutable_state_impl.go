@@ -65,6 +65,8 @@ import (
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/taskfairness"
+	"go.temporal.io/server/common/taskpriority"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/events"
@@ -1618,6 +1620,8 @@ func (ms *MutableStateImpl) ReplicateWorkflowExecutionStartedEvent(
 	ms.executionInfo.WorkflowRunTimeout = event.GetWorkflowRunTimeout()
 	ms.executionInfo.WorkflowExecutionTimeout = event.GetWorkflowExecutionTimeout()
 	ms.executionInfo.DefaultWorkflowTaskTimeout = event.GetWorkflowTaskTimeout()
+	ms.executionInfo.Priority = taskpriority.FromHeader(event.GetHeader(), taskpriority.Normal)
+	ms.executionInfo.FairnessKey = taskfairness.FromHeader(event.GetHeader(), "")
 
 	if err := ms.UpdateWorkflowStateStatus(
 		enumsspb.WORKFLOW_EXECUTION_STATE_CREATED,
@@ -2013,6 +2017,9 @@ func (ms *MutableStateImpl) ReplicateActivityTaskScheduledEvent(
 		HasRetryPolicy:          attributes.RetryPolicy != nil,
 		Attempt:                 1,
 	}
+	// activities inherit the priority and fairness key of their workflow unless they request their own
+	ai.Priority = taskpriority.FromHeader(attributes.GetHeader(), ms.executionInfo.GetPriority())
+	ai.FairnessKey = taskfairness.FromHeader(attributes.GetHeader(), ms.executionInfo.GetFairnessKey())
 	if ai.HasRetryPolicy {
 		ai.RetryInitialInterval = attributes.RetryPolicy.GetInitialInterval()
 		ai.RetryBackoffCoefficient = attributes.RetryPolicy.GetBackoffCoefficient()
@@ -0,0 +1,136 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/service/history/configs"
+)
+
+const (
+	// workflowRateLimiterTTL is how long the rate limiter of an idle workflow is kept
+	workflowRateLimiterTTL = 5 * time.Minute
+)
+
+var (
+	// ErrWorkflowRateLimitExceeded is returned when a single workflow receives more requests than allowed by
+	// the history.workflowRPS dynamic config
+	ErrWorkflowRateLimitExceeded = serviceerror.NewResourceExhausted(enumspb.RESOURCE_EXHAUSTED_CAUSE_RPS_LIMIT, "workflow rate limit exceeded")
+)
+
+type (
+	// workflowRateLimiter limits the rate of externally initiated requests per workflow, so that a single
+	// workflow, e.g. one signaled in a tight loop, cannot monopolize its shard.
+	workflowRateLimiter struct {
+		rateFn            dynamicconfig.IntPropertyFnWithNamespaceFilter
+		namespaceRegistry namespace.Registry
+		metricsHandler    metrics.Handler
+
+		rateLimiters cache.Cache
+	}
+)
+
+func newWorkflowRateLimiter(
+	rateFn dynamicconfig.IntPropertyFnWithNamespaceFilter,
+	cacheSize int,
+	namespaceRegistry namespace.Registry,
+	metricsHandler metrics.Handler,
+) *workflowRateLimiter {
+	return &workflowRateLimiter{
+		rateFn:            rateFn,
+		namespaceRegistry: namespaceRegistry,
+		metricsHandler:    metricsHandler,
+
+		rateLimiters: cache.New(cacheSize, &cache.Options{TTL: workflowRateLimiterTTL}),
+	}
+}
+
+// Allow returns false if the request of the given API for the workflow is over the workflow rate limit
+// of its namespace.
+func (r *workflowRateLimiter) Allow(
+	now time.Time,
+	api string,
+	namespaceID namespace.ID,
+	workflowID string,
+) bool {
+	if _, ok := configs.WorkflowRateLimitedAPIs[api]; !ok {
+		return true
+	}
+	namespaceName, err := r.namespaceRegistry.GetNamespaceName(namespaceID)
+	if err != nil {
+		// let the request fail on the namespace lookup down the line instead
+		return true
+	}
+	if r.rateFn(namespaceName.String()) <= 0 {
+		return true
+	}
+
+	rateLimiter, err := r.getOrInitRateLimiter(namespaceID, namespaceName, workflowID)
+	if err != nil {
+		return true
+	}
+	if rateLimiter.Allow(now, quotas.NewRequest(api, 1, namespaceName.String(), "", "")) {
+		return true
+	}
+
+	r.metricsHandler.Counter(metrics.WorkflowRateLimitedCounter.GetMetricName()).Record(
+		1,
+		metrics.OperationTag(api),
+		metrics.NamespaceTag(namespaceName.String()),
+	)
+	return false
+}
+
+func (r *workflowRateLimiter) getOrInitRateLimiter(
+	namespaceID namespace.ID,
+	namespaceName namespace.Name,
+	workflowID string,
+) (quotas.RequestRateLimiter, error) {
+	key := definition.NewWorkflowKey(namespaceID.String(), workflowID, "")
+	if rateLimiter, ok := r.rateLimiters.Get(key).(quotas.RequestRateLimiter); ok {
+		return rateLimiter, nil
+	}
+
+	newRateLimiter := quotas.NewRequestRateLimiterAdapter(
+		quotas.NewDefaultIncomingRateLimiter(func() float64 {
+			return float64(r.rateFn(namespaceName.String()))
+		}),
+	)
+	rateLimiter, err := r.rateLimiters.PutIfNotExist(key, newRateLimiter)
+	if err != nil {
+		return nil, err
+	}
+	return rateLimiter.(quotas.RequestRateLimiter), nil
+}
@@ -0,0 +1,123 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+)
+
+type (
+	workflowRateLimiterSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller        *gomock.Controller
+		namespaceRegistry *namespace.MockRegistry
+
+		rps         int
+		rateLimiter *workflowRateLimiter
+	}
+)
+
+const (
+	testRateLimitedNamespaceID   = namespace.ID("test-namespace-id")
+	testRateLimitedNamespaceName = namespace.Name("test-namespace")
+)
+
+func TestWorkflowRateLimiterSuite(t *testing.T) {
+	s := new(workflowRateLimiterSuite)
+	suite.Run(t, s)
+}
+
+func (s *workflowRateLimiterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.namespaceRegistry = namespace.NewMockRegistry(s.controller)
+	s.namespaceRegistry.EXPECT().GetNamespaceName(testRateLimitedNamespaceID).Return(testRateLimitedNamespaceName, nil).AnyTimes()
+
+	s.rps = 1
+	s.rateLimiter = newWorkflowRateLimiter(
+		func(namespaceName string) int {
+			s.Equal(testRateLimitedNamespaceName.String(), namespaceName)
+			return s.rps
+		},
+		10,
+		s.namespaceRegistry,
+		metrics.NoopMetricsHandler,
+	)
+}
+
+func (s *workflowRateLimiterSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *workflowRateLimiterSuite) TestAllow_PerWorkflow() {
+	now := time.Now()
+	for i := 0; i < 2; i++ {
+		s.True(s.rateLimiter.Allow(now, "SignalWorkflowExecution", testRateLimitedNamespaceID, "workflow-1"))
+	}
+	s.False(s.rateLimiter.Allow(now, "SignalWorkflowExecution", testRateLimitedNamespaceID, "workflow-1"))
+	s.False(s.rateLimiter.Allow(now, "QueryWorkflow", testRateLimitedNamespaceID, "workflow-1"))
+
+	s.True(s.rateLimiter.Allow(now, "SignalWorkflowExecution", testRateLimitedNamespaceID, "workflow-2"))
+	s.True(s.rateLimiter.Allow(now.Add(time.Second), "SignalWorkflowExecution", testRateLimitedNamespaceID, "workflow-1"))
+}
+
+func (s *workflowRateLimiterSuite) TestAllow_APINotLimited() {
+	now := time.Now()
+	for i := 0; i < 10; i++ {
+		s.True(s.rateLimiter.Allow(now, "RespondWorkflowTaskCompleted", testRateLimitedNamespaceID, "workflow-1"))
+		s.True(s.rateLimiter.Allow(now, "TerminateWorkflowExecution", testRateLimitedNamespaceID, "workflow-1"))
+	}
+}
+
+func (s *workflowRateLimiterSuite) TestAllow_Disabled() {
+	s.rps = 0
+
+	now := time.Now()
+	for i := 0; i < 10; i++ {
+		s.True(s.rateLimiter.Allow(now, "SignalWorkflowExecution", testRateLimitedNamespaceID, "workflow-1"))
+	}
+}
+
+func (s *workflowRateLimiterSuite) TestAllow_UnknownNamespace() {
+	namespaceID := namespace.ID("unknown-namespace-id")
+	s.namespaceRegistry.EXPECT().GetNamespaceName(namespaceID).Return(namespace.EmptyName, serviceerror.NewNamespaceNotFound(namespaceID.String())).AnyTimes()
+
+	now := time.Now()
+	for i := 0; i < 10; i++ {
+		s.True(s.rateLimiter.Allow(now, "SignalWorkflowExecution", namespaceID, "workflow-1"))
+	}
+}
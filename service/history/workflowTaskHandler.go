@@ -188,6 +188,14 @@ func (handler *workflowTaskHandlerImpl) handleCommands(
 }
 
 func (handler *workflowTaskHandlerImpl) handleCommand(ctx context.Context, command *commandpb.Command) (*handleCommandResponse, error) {
+	if err := handler.validateCommandAttr(
+		func() (enumspb.WorkflowTaskFailedCause, error) {
+			return handler.sizeLimitChecker.checkPayloadPolicy(command)
+		},
+	); err != nil || handler.stopProcessing {
+		return nil, err
+	}
+
 	switch command.GetCommandType() {
 	case enumspb.COMMAND_TYPE_SCHEDULE_ACTIVITY_TASK:
 		return handler.handleCommandScheduleActivity(ctx, command.GetScheduleActivityTaskCommandAttributes())
@@ -46,6 +46,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadpolicy"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -86,6 +87,7 @@ type (
 		commandAttrValidator       *commandAttrValidator
 		searchAttributesMapper     searchattribute.Mapper
 		searchAttributesValidator  *searchattribute.Validator
+		payloadPolicy              payloadpolicy.Policy
 	}
 )
 
@@ -108,6 +110,7 @@ func newWorkflowTaskHandlerCallback(historyEngine *historyEngineImpl) *workflowT
 		),
 		searchAttributesMapper:    historyEngine.shard.GetSearchAttributesMapper(),
 		searchAttributesValidator: historyEngine.searchAttributesValidator,
+		payloadPolicy:             historyEngine.payloadPolicy,
 	}
 }
 
@@ -461,6 +464,7 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskCompleted(
 			},
 			ms,
 			handler.searchAttributesValidator,
+			handler.payloadPolicy,
 			executionStats,
 			handler.metricsHandler.WithTags(
 				metrics.OperationTag(metrics.HistoryRespondWorkflowTaskCompletedScope),
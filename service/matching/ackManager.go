@@ -26,31 +26,46 @@ package matching
 
 import (
 	"sync"
+	"time"
 
 	"go.uber.org/atomic"
 	"golang.org/x/exp/maps"
 
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/taskpriority"
 	"go.temporal.io/server/common/util"
 )
 
 // Used to convert out of order acks into ackLevel movement.
 type ackManager struct {
 	sync.RWMutex
-	outstandingTasks map[int64]bool // key->TaskID, value->(true for acked, false->for non acked)
-	readLevel        int64          // Maximum TaskID inserted into outstandingTasks
-	ackLevel         int64          // Maximum TaskID below which all tasks are acked
+	outstandingTasks map[int64]bool      // key->TaskID, value->(true for acked, false->for non acked)
+	createTimes      map[int64]time.Time // key->TaskID of a non acked task, value->its create time
+	readLevel        int64               // Maximum TaskID inserted into outstandingTasks
+	ackLevel         int64               // Maximum TaskID below which all tasks are acked
 	backlogCounter   atomic.Int64
 	logger           log.Logger
 }
 
 func newAckManager(logger log.Logger) ackManager {
-	return ackManager{logger: logger, outstandingTasks: make(map[int64]bool), readLevel: -1, ackLevel: -1}
+	return ackManager{
+		logger:           logger,
+		outstandingTasks: make(map[int64]bool),
+		createTimes:      make(map[int64]time.Time),
+		readLevel:        -1,
+		ackLevel:         -1,
+	}
 }
 
 // Registers task as in-flight and moves read level to it. Tasks can be added in increasing order of taskID only.
 func (m *ackManager) addTask(taskID int64) {
+	m.addTaskWithCreateTime(taskID, time.Time{})
+}
+
+// addTaskWithCreateTime is addTask for a task whose create time is known, so that it can be
+// reported by getOldestTask until the task is acked.
+func (m *ackManager) addTaskWithCreateTime(taskID int64, createTime time.Time) {
 	m.Lock()
 	defer m.Unlock()
 	if m.readLevel >= taskID {
@@ -63,6 +78,9 @@ func (m *ackManager) addTask(taskID int64) {
 		m.logger.Fatal("Already present in outstanding tasks", tag.TaskID(taskID))
 	}
 	m.outstandingTasks[taskID] = false // true is for acked
+	if !createTime.IsZero() {
+		m.createTimes[taskID] = createTime
+	}
 	m.backlogCounter.Inc()
 }
 
@@ -81,13 +99,14 @@ func (m *ackManager) setReadLevel(readLevel int64) {
 func (m *ackManager) setReadLevelAfterGap(newReadLevel int64) {
 	m.Lock()
 	defer m.Unlock()
-	if m.ackLevel == m.readLevel {
-		// This is called after we read a range and find no tasks. The range we read was m.readLevel to newReadLevel.
-		// (We know this because nothing should change m.readLevel except the getTasksPump loop itself, after initialization.
-		// And getTasksPump doesn't start until it gets a signal from taskWriter that it's initialized the levels.)
-		// If we've acked all tasks up to m.readLevel, and there are no tasks between that and newReadLevel, then we've
-		// acked all tasks up to newReadLevel too. This lets us advance the ack level on a task queue with no activity
-		// but where the rangeid has moved higher, to prevent excessive reads on the next load.
+	if len(m.outstandingTasks) == 0 {
+		// This is called after we read the range from m.readLevel to newReadLevel and added the tasks of this
+		// level we found in it. (We know this because nothing should change m.readLevel except the getTasksPump loop
+		// itself, after initialization. And getTasksPump doesn't start until it gets a signal from taskWriter that it's
+		// initialized the levels.)
+		// If no task up to m.readLevel is outstanding, and there are no other tasks between that and newReadLevel, then
+		// we've acked all tasks up to newReadLevel too. This lets us advance the ack level on a task queue with no
+		// activity but where the rangeid has moved higher, to prevent excessive reads on the next load.
 		m.ackLevel = newReadLevel
 	}
 	m.readLevel = newReadLevel
@@ -117,6 +136,7 @@ func (m *ackManager) completeTask(taskID int64) (ackLevel int64) {
 	defer m.Unlock()
 	if completed, ok := m.outstandingTasks[taskID]; ok && !completed {
 		m.outstandingTasks[taskID] = true
+		delete(m.createTimes, taskID)
 		m.backlogCounter.Dec()
 	}
 
@@ -135,9 +155,103 @@ func (m *ackManager) completeTask(taskID int64) (ackLevel int64) {
 			return m.ackLevel
 		}
 	}
+	// all tasks read so far are acked. The read level can be ahead of the last of them when
+	// the tasks after it belong to other priority levels.
+	m.ackLevel = m.readLevel
 	return m.ackLevel
 }
 
 func (m *ackManager) getBacklogCountHint() int64 {
 	return m.backlogCounter.Load()
 }
+
+// getOldestTask returns the id and create time of the non acked task with the lowest task id,
+// which is the head of the backlog that was read from persistence.
+func (m *ackManager) getOldestTask() (int64, time.Time, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	oldestTaskID := int64(-1)
+	for taskID := range m.createTimes {
+		if oldestTaskID == -1 || taskID < oldestTaskID {
+			oldestTaskID = taskID
+		}
+	}
+	if oldestTaskID == -1 {
+		return 0, time.Time{}, false
+	}
+	return oldestTaskID, m.createTimes[oldestTaskID], true
+}
+
+// priorityAckManager tracks the read and ack levels of each priority level of a task queue. The
+// backlog of each level is read separately, so a level moves its read level past the tasks of
+// the other levels, and the task queue can only ack up to the lowest ack level of all levels.
+type priorityAckManager struct {
+	levels [taskpriority.NumLevels]ackManager
+}
+
+func newPriorityAckManager(logger log.Logger) *priorityAckManager {
+	m := &priorityAckManager{}
+	for i := range m.levels {
+		m.levels[i] = newAckManager(logger)
+	}
+	return m
+}
+
+// level returns the ack manager of a zero based priority level, see taskpriority.Level
+func (m *priorityAckManager) level(level int) *ackManager {
+	return &m.levels[level]
+}
+
+// setAckLevel moves the ack level of all priority levels, see ackManager.setAckLevel
+func (m *priorityAckManager) setAckLevel(ackLevel int64) {
+	for i := range m.levels {
+		m.levels[i].setAckLevel(ackLevel)
+	}
+}
+
+// getAckLevel returns the task id below which the tasks of all priority levels are acked
+func (m *priorityAckManager) getAckLevel() int64 {
+	ackLevel := m.levels[0].getAckLevel()
+	for i := 1; i < len(m.levels); i++ {
+		ackLevel = util.Min(ackLevel, m.levels[i].getAckLevel())
+	}
+	return ackLevel
+}
+
+// getReadLevel returns the task id up to which all priority levels have read the backlog
+func (m *priorityAckManager) getReadLevel() int64 {
+	readLevel := m.levels[0].getReadLevel()
+	for i := 1; i < len(m.levels); i++ {
+		readLevel = util.Min(readLevel, m.levels[i].getReadLevel())
+	}
+	return readLevel
+}
+
+// completeTask acks a task of a priority level and returns the ack level of the task queue
+func (m *priorityAckManager) completeTask(level int, taskID int64) int64 {
+	m.levels[level].completeTask(taskID)
+	return m.getAckLevel()
+}
+
+func (m *priorityAckManager) getBacklogCountHint() int64 {
+	var count int64
+	for i := range m.levels {
+		count += m.levels[i].getBacklogCountHint()
+	}
+	return count
+}
+
+// getOldestTask returns the id and create time of the non acked task with the lowest task id
+// across all priority levels.
+func (m *priorityAckManager) getOldestTask() (int64, time.Time, bool) {
+	var oldestTaskID int64
+	var oldestCreateTime time.Time
+	found := false
+	for i := range m.levels {
+		taskID, createTime, ok := m.levels[i].getOldestTask()
+		if ok && (!found || taskID < oldestTaskID) {
+			oldestTaskID, oldestCreateTime, found = taskID, createTime, true
+		}
+	}
+	return oldestTaskID, oldestCreateTime, found
+}
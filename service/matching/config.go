@@ -60,6 +60,17 @@ type (
 		MaxVersionGraphSize          dynamicconfig.IntPropertyFn
 		MetadataPollFrequency        dynamicconfig.DurationPropertyFn
 
+		// partition autoscaling configuration
+		EnablePartitionAutoscaling         dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
+		PartitionAutoscalingTargetRate     dynamicconfig.FloatPropertyFnWithTaskQueueInfoFilters
+		PartitionAutoscalingInterval       dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		PartitionAutoscalingScaleDownDelay dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+
+		// dispatch one in every PriorityStarvationInterval tasks from the lowest non-empty priority level
+		PriorityStarvationInterval dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		// weights of fairness keys for the round-robin dispatch of backlog tasks
+		FairnessKeyWeights dynamicconfig.MapPropertyFnWithNamespaceFilter
+
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
 		MinTaskThrottlingBurstSize dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
@@ -99,6 +110,14 @@ type (
 		MaxTaskBatchSize                func() int
 		NumWritePartitions              func() int
 		NumReadPartitions               func() int
+		// partition autoscaling configuration
+		EnablePartitionAutoscaling         func() bool
+		PartitionAutoscalingTargetRate     func() float64
+		PartitionAutoscalingInterval       func() time.Duration
+		PartitionAutoscalingScaleDownDelay func() time.Duration
+		// task priority and fairness configuration
+		PriorityStarvationInterval func() int
+		FairnessKeyWeights         func() map[string]any
 
 		// partition qps = AdminNamespaceToPartitionDispatchRate(namespace)
 		AdminNamespaceToPartitionDispatchRate func() float64
@@ -150,6 +169,14 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		MaxVersionGraphSize:                   dc.GetIntProperty(dynamicconfig.VersionGraphNodeLimit, 1000),
 		MetadataPollFrequency:                 dc.GetDurationProperty(dynamicconfig.MatchingMetadataPollFrequency, 5*time.Minute),
 
+		EnablePartitionAutoscaling:         dc.GetBoolPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingEnablePartitionAutoscaling, false),
+		PartitionAutoscalingTargetRate:     dc.GetFloatPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPartitionAutoscalingTargetRate, 100),
+		PartitionAutoscalingInterval:       dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPartitionAutoscalingInterval, time.Minute),
+		PartitionAutoscalingScaleDownDelay: dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPartitionAutoscalingScaleDownDelay, 10*time.Minute),
+
+		PriorityStarvationInterval: dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPriorityStarvationInterval, 10),
+		FairnessKeyWeights:         dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.MatchingFairnessKeyWeights, map[string]any{}),
+
 		AdminNamespaceToPartitionDispatchRate:          dc.GetFloatPropertyFilteredByNamespace(dynamicconfig.AdminMatchingNamespaceToPartitionDispatchRate, 10000),
 		AdminNamespaceTaskqueueToPartitionDispatchRate: dc.GetFloatPropertyFilteredByTaskQueueInfo(dynamicconfig.AdminMatchingNamespaceTaskqueueToPartitionDispatchRate, 1000),
 	}
@@ -158,6 +185,8 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 func newTaskQueueConfig(id *taskQueueID, config *Config, namespace namespace.Name) *taskQueueConfig {
 	taskQueueName := id.name
 	taskType := id.taskType
+	// all partitions of a task queue must agree on autoscaling, so it is always configured by the root name
+	rootTaskQueueName := id.GetRoot()
 
 	return &taskQueueConfig{
 		RangeSize: config.RangeSize,
@@ -197,6 +226,24 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespace namespace.Nam
 		NumReadPartitions: func() int {
 			return util.Max(1, config.NumTaskqueueReadPartitions(namespace.String(), taskQueueName, taskType))
 		},
+		EnablePartitionAutoscaling: func() bool {
+			return config.EnablePartitionAutoscaling(namespace.String(), rootTaskQueueName, taskType)
+		},
+		PartitionAutoscalingTargetRate: func() float64 {
+			return config.PartitionAutoscalingTargetRate(namespace.String(), rootTaskQueueName, taskType)
+		},
+		PartitionAutoscalingInterval: func() time.Duration {
+			return config.PartitionAutoscalingInterval(namespace.String(), rootTaskQueueName, taskType)
+		},
+		PartitionAutoscalingScaleDownDelay: func() time.Duration {
+			return config.PartitionAutoscalingScaleDownDelay(namespace.String(), rootTaskQueueName, taskType)
+		},
+		PriorityStarvationInterval: func() int {
+			return config.PriorityStarvationInterval(namespace.String(), taskQueueName, taskType)
+		},
+		FairnessKeyWeights: func() map[string]any {
+			return config.FairnessKeyWeights(namespace.String())
+		},
 		AdminNamespaceToPartitionDispatchRate: func() float64 {
 			return config.AdminNamespaceToPartitionDispatchRate(namespace.String())
 		},
@@ -315,11 +315,20 @@ func (d *dbTaskManager) BufferAndWriteTask(
 func (d *dbTaskManager) readAndDispatchTasks(
 	ctx context.Context,
 ) {
+	// tasks are dispatched in batches of up to one page, round-robin across fairness keys
+	batch := newFairQueue(func(string) int { return defaultFairnessKeyWeight })
+	dispatchBatch := func() {
+		for batch.len() > 0 {
+			d.mustDispatch(batch.pop())
+		}
+	}
+
 	iter := d.taskReader.taskIterator(ctx, d.taskQueueOwnership.getLastAllocatedTaskID())
 	for iter.HasNext() {
 		task, err := iter.Next()
 		if err != nil {
 			d.logger.Error("dbTaskManager encountered error when fetching tasks", tag.Error(err))
+			dispatchBatch()
 			if common.IsResourceExhausted(err) {
 				d.backoffDispatch(taskReaderThrottleRetryDelay)
 			} else {
@@ -328,8 +337,12 @@ func (d *dbTaskManager) readAndDispatchTasks(
 			return
 		}
 
-		d.mustDispatch(task)
+		batch.push(task)
+		if batch.len() >= dbTaskReaderPageSize {
+			dispatchBatch()
+		}
 	}
+	dispatchBatch()
 }
 
 func (d *dbTaskManager) mustDispatch(
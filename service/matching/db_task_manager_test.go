@@ -311,6 +311,43 @@ func (s *dbTaskManagerSuite) TestReadAndDispatchTasks_ReadSuccess_Dispatch() {
 	s.Equal([]*persistencespb.AllocatedTaskInfo{allocatedTaskInfo}, dispatchedTasks)
 }
 
+func (s *dbTaskManagerSuite) TestReadAndDispatchTasks_ReadSuccess_FairDispatch() {
+	var dispatchedKeys []string
+	s.dbTaskManager.dispatchTaskFn = func(_ context.Context, task *internalTask) error {
+		dispatchedKeys = append(dispatchedKeys, task.fairnessKey())
+		return nil
+	}
+	s.taskQueueOwnership.EXPECT().takeTaskQueueOwnership(gomock.Any()).Return(nil)
+	s.taskQueueOwnership.EXPECT().getAckedTaskID().Return(s.ackedTaskID)
+	err := s.dbTaskManager.acquireOwnership(context.Background())
+	s.NoError(err)
+
+	var allocatedTaskInfos []*persistencespb.AllocatedTaskInfo
+	for i, key := range []string{"a", "a", "a", "b"} {
+		allocatedTaskInfos = append(allocatedTaskInfos, &persistencespb.AllocatedTaskInfo{
+			TaskId: s.lastAllocatedTaskID + int64(i) + 1,
+			Data: &persistencespb.TaskInfo{
+				NamespaceId:      uuid.New().String(),
+				WorkflowId:       uuid.New().String(),
+				RunId:            uuid.New().String(),
+				ScheduledEventId: rand.Int63(),
+				CreateTime:       timestamp.TimePtr(time.Now().UTC()),
+				ExpiryTime:       timestamp.TimePtr(time.Unix(0, 0)),
+				FairnessKey:      key,
+			},
+		})
+	}
+	s.taskQueueOwnership.EXPECT().getLastAllocatedTaskID().Return(s.lastAllocatedTaskID)
+	s.taskReader.EXPECT().taskIterator(gomock.Any(), s.lastAllocatedTaskID).Return(collection.NewPagingIterator(
+		func(paginationToken []byte) ([]*persistencespb.AllocatedTaskInfo, []byte, error) {
+			return allocatedTaskInfos, nil, nil
+		},
+	))
+
+	s.dbTaskManager.readAndDispatchTasks(context.Background())
+	s.Equal([]string{"a", "b", "a", "a"}, dispatchedKeys)
+}
+
 func (s *dbTaskManagerSuite) TestReadAndDispatchTasks_ReadFailure() {
 	s.taskQueueOwnership.EXPECT().takeTaskQueueOwnership(gomock.Any()).Return(nil)
 	s.taskQueueOwnership.EXPECT().getAckedTaskID().Return(s.ackedTaskID)
@@ -0,0 +1,184 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+const (
+	defaultFairnessKeyWeight = 1
+	// otherFairnessKeysTagValue is the metric tag value for the backlog of fairness keys
+	// that have no configured weight
+	otherFairnessKeysTagValue = "_other"
+)
+
+type (
+	// fairQueue holds backlog tasks of one priority level and returns them in weighted
+	// round-robin order across fairness keys. A key with weight N gets N consecutive tasks
+	// dispatched before the next key gets its turn. Tasks with the same key are returned
+	// in the order they were pushed. fairQueue is not safe for concurrent use.
+	//
+	// Only the backlog tasks read ahead from persistence, at most GetTasksBatchSize per
+	// priority level and partition, are queued here. Tasks further back are read in task id
+	// order, so a key only gets its turn once one of its tasks is within that window, and a
+	// key with a longer backlog than the window still delays the keys added after it.
+	fairQueue struct {
+		weight func(key string) int
+
+		tasks  map[string][]*persistencespb.AllocatedTaskInfo
+		keys   []string // keys with queued tasks, in round-robin order
+		next   int      // index into keys of the key whose turn it is
+		credit int      // tasks keys[next] may still dispatch in its current turn
+		size   int
+	}
+
+	// fairnessBacklog counts the backlog tasks per fairness key that were read from
+	// persistence but not yet dispatched.
+	fairnessBacklog struct {
+		sync.Mutex
+		counts map[string]int64
+	}
+)
+
+func newFairQueue(weight func(key string) int) *fairQueue {
+	return &fairQueue{
+		weight: weight,
+		tasks:  make(map[string][]*persistencespb.AllocatedTaskInfo),
+	}
+}
+
+func (q *fairQueue) len() int {
+	return q.size
+}
+
+func (q *fairQueue) push(task *persistencespb.AllocatedTaskInfo) {
+	key := task.Data.GetFairnessKey()
+	if _, ok := q.tasks[key]; !ok {
+		q.keys = append(q.keys, key)
+	}
+	q.tasks[key] = append(q.tasks[key], task)
+	q.size++
+}
+
+// pop removes and returns the next task to dispatch, or nil if the queue is empty
+func (q *fairQueue) pop() *persistencespb.AllocatedTaskInfo {
+	if q.size == 0 {
+		return nil
+	}
+
+	key := q.keys[q.next]
+	if q.credit <= 0 {
+		q.credit = q.weight(key)
+	}
+	tasks := q.tasks[key]
+	task := tasks[0]
+	tasks[0] = nil
+	tasks = tasks[1:]
+	q.size--
+	q.credit--
+
+	if len(tasks) == 0 {
+		delete(q.tasks, key)
+		q.keys = append(q.keys[:q.next], q.keys[q.next+1:]...)
+		q.credit = 0
+		if q.next >= len(q.keys) {
+			q.next = 0
+		}
+		return task
+	}
+
+	q.tasks[key] = tasks
+	if q.credit <= 0 {
+		q.next = (q.next + 1) % len(q.keys)
+	}
+	return task
+}
+
+func newFairnessBacklog() *fairnessBacklog {
+	return &fairnessBacklog{counts: make(map[string]int64)}
+}
+
+func (b *fairnessBacklog) add(key string) {
+	b.Lock()
+	defer b.Unlock()
+	b.counts[key]++
+}
+
+func (b *fairnessBacklog) remove(key string) {
+	b.Lock()
+	defer b.Unlock()
+	if b.counts[key] <= 1 {
+		delete(b.counts, key)
+		return
+	}
+	b.counts[key]--
+}
+
+// hasOtherThan returns true if there are undispatched backlog tasks with a key other than
+// the given one
+func (b *fairnessBacklog) hasOtherThan(key string) bool {
+	b.Lock()
+	defer b.Unlock()
+	for k := range b.counts {
+		if k != key {
+			return true
+		}
+	}
+	return false
+}
+
+// snapshot returns a copy of the backlog counts by fairness key
+func (b *fairnessBacklog) snapshot() map[string]int64 {
+	b.Lock()
+	defer b.Unlock()
+	counts := make(map[string]int64, len(b.counts))
+	for key, count := range b.counts {
+		counts[key] = count
+	}
+	return counts
+}
+
+// fairnessKeyWeight returns the weight of key from the dynamic config weights map,
+// falling back to the default weight for unknown keys and invalid values
+func fairnessKeyWeight(weights map[string]any, key string) int {
+	var weight int
+	switch value := weights[key].(type) {
+	case int:
+		weight = value
+	case int32:
+		weight = int(value)
+	case int64:
+		weight = int(value)
+	case float64:
+		weight = int(value)
+	}
+	if weight < 1 {
+		return defaultFairnessKeyWeight
+	}
+	return weight
+}
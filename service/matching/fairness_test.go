@@ -0,0 +1,138 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+type (
+	fairQueueSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		weights map[string]any
+		queue   *fairQueue
+		nextID  int64
+	}
+)
+
+func TestFairQueueSuite(t *testing.T) {
+	s := new(fairQueueSuite)
+	suite.Run(t, s)
+}
+
+func (s *fairQueueSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.weights = map[string]any{}
+	s.queue = newFairQueue(func(key string) int { return fairnessKeyWeight(s.weights, key) })
+	s.nextID = 0
+}
+
+func (s *fairQueueSuite) push(keys ...string) {
+	for _, key := range keys {
+		s.nextID++
+		s.queue.push(&persistencespb.AllocatedTaskInfo{
+			Data:   &persistencespb.TaskInfo{FairnessKey: key},
+			TaskId: s.nextID,
+		})
+	}
+}
+
+func (s *fairQueueSuite) popAll() (keys []string, ids []int64) {
+	for s.queue.len() > 0 {
+		task := s.queue.pop()
+		keys = append(keys, task.Data.GetFairnessKey())
+		ids = append(ids, task.TaskId)
+	}
+	return keys, ids
+}
+
+func (s *fairQueueSuite) TestEmpty() {
+	s.Equal(0, s.queue.len())
+	s.Nil(s.queue.pop())
+}
+
+func (s *fairQueueSuite) TestRoundRobin() {
+	s.push("a", "a", "a", "a", "b", "c", "c")
+
+	keys, ids := s.popAll()
+	s.Equal([]string{"a", "b", "c", "a", "c", "a", "a"}, keys)
+	s.Equal([]int64{1, 5, 6, 2, 7, 3, 4}, ids)
+}
+
+func (s *fairQueueSuite) TestWeighted() {
+	s.weights["a"] = 2
+	s.weights["b"] = float64(3)
+	s.push("a", "a", "a", "a", "a", "b", "b", "b", "b", "c")
+
+	keys, _ := s.popAll()
+	s.Equal([]string{"a", "a", "b", "b", "b", "c", "a", "a", "b", "a"}, keys)
+}
+
+func (s *fairQueueSuite) TestPushWhileDraining() {
+	s.push("a", "a", "b")
+	s.Equal("a", s.queue.pop().Data.GetFairnessKey())
+	s.push("c")
+	s.Equal("b", s.queue.pop().Data.GetFairnessKey())
+	s.Equal("c", s.queue.pop().Data.GetFairnessKey())
+	s.Equal("a", s.queue.pop().Data.GetFairnessKey())
+	s.push("b")
+	s.Equal("b", s.queue.pop().Data.GetFairnessKey())
+	s.Equal(0, s.queue.len())
+}
+
+func (s *fairQueueSuite) TestInvalidWeight() {
+	s.weights["a"] = 0
+	s.weights["b"] = "many"
+	s.Equal(defaultFairnessKeyWeight, fairnessKeyWeight(s.weights, "a"))
+	s.Equal(defaultFairnessKeyWeight, fairnessKeyWeight(s.weights, "b"))
+	s.Equal(defaultFairnessKeyWeight, fairnessKeyWeight(s.weights, "c"))
+}
+
+func TestFairnessBacklog(t *testing.T) {
+	backlog := newFairnessBacklog()
+	require.False(t, backlog.hasOtherThan("a"))
+	require.False(t, backlog.hasOtherThan(""))
+
+	backlog.add("a")
+	backlog.add("a")
+	backlog.add("b")
+	require.True(t, backlog.hasOtherThan("a"))
+	require.True(t, backlog.hasOtherThan("c"))
+	require.Equal(t, map[string]int64{"a": 2, "b": 1}, backlog.snapshot())
+
+	backlog.remove("a")
+	backlog.remove("b")
+	require.False(t, backlog.hasOtherThan("a"))
+	require.True(t, backlog.hasOtherThan("b"))
+	require.Equal(t, map[string]int64{"a": 1}, backlog.snapshot())
+}
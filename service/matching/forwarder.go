@@ -129,6 +129,20 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 		return errForwarderSlowDown
 	}
 
+	return fwdr.handleErr(fwdr.addTask(ctx, task, name, fwdr.taskQueueID.name))
+}
+
+// RedirectTask hands a task received by a partition retired by partition autoscaling over
+// to the given active partition. Unlike ForwardTask, the target partition treats the task
+// as a new task and persists it when it cannot be matched synchronously.
+func (fwdr *Forwarder) RedirectTask(ctx context.Context, task *internalTask, partition int) error {
+	if fwdr.taskQueueKind == enumspb.TASK_QUEUE_KIND_STICKY {
+		return errTaskQueueKind
+	}
+	return fwdr.handleErr(fwdr.addTask(ctx, task, fwdr.taskQueueID.mkName(partition), ""))
+}
+
+func (fwdr *Forwarder) addTask(ctx context.Context, task *internalTask, name string, forwardedSource string) error {
 	var err error
 
 	var expirationDuration time.Duration
@@ -155,7 +169,9 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			Clock:                  task.event.Data.GetClock(),
 			Source:                 task.source,
 			ScheduleToStartTimeout: &expirationDuration,
-			ForwardedSource:        fwdr.taskQueueID.name,
+			ForwardedSource:        forwardedSource,
+			Priority:               task.event.Data.GetPriority(),
+			FairnessKey:            task.event.Data.GetFairnessKey(),
 		})
 	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
 		_, err = fwdr.client.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
@@ -169,13 +185,15 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			Clock:                  task.event.Data.GetClock(),
 			Source:                 task.source,
 			ScheduleToStartTimeout: &expirationDuration,
-			ForwardedSource:        fwdr.taskQueueID.name,
+			ForwardedSource:        forwardedSource,
+			Priority:               task.event.Data.GetPriority(),
+			FairnessKey:            task.event.Data.GetFairnessKey(),
 		})
 	default:
 		return errInvalidTaskQueueType
 	}
 
-	return fwdr.handleErr(err)
+	return err
 }
 
 // ForwardQueryTask forwards a query task to parent task queue partition, if it exist
@@ -217,6 +235,19 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*internalTask, error) {
 		return nil, errNoParent
 	}
 
+	return fwdr.poll(ctx, name, fwdr.taskQueueID.name)
+}
+
+// RedirectPoll hands a poll received by a partition retired by partition autoscaling over
+// to the given active partition
+func (fwdr *Forwarder) RedirectPoll(ctx context.Context, partition int) (*internalTask, error) {
+	if fwdr.taskQueueKind == enumspb.TASK_QUEUE_KIND_STICKY {
+		return nil, errTaskQueueKind
+	}
+	return fwdr.poll(ctx, fwdr.taskQueueID.mkName(partition), "")
+}
+
+func (fwdr *Forwarder) poll(ctx context.Context, name string, forwardedSource string) (*internalTask, error) {
 	pollerID, _ := ctx.Value(pollerIDKey).(string)
 	identity, _ := ctx.Value(identityKey).(string)
 
@@ -232,7 +263,7 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*internalTask, error) {
 				},
 				Identity: identity,
 			},
-			ForwardedSource: fwdr.taskQueueID.name,
+			ForwardedSource: forwardedSource,
 		})
 		if err != nil {
 			return nil, fwdr.handleErr(err)
@@ -249,7 +280,7 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*internalTask, error) {
 				},
 				Identity: identity,
 			},
-			ForwardedSource: fwdr.taskQueueID.name,
+			ForwardedSource: forwardedSource,
 		})
 		if err != nil {
 			return nil, fwdr.handleErr(err)
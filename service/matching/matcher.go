@@ -32,8 +32,10 @@ import (
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/taskpriority"
 )
 
 // TaskMatcher matches a task producer with a task consumer
@@ -42,8 +44,12 @@ import (
 type TaskMatcher struct {
 	config *taskQueueConfig
 
-	// synchronous task channel to match producer/consumer
-	taskC chan *internalTask
+	// synchronous task channels to match producer/consumer, one per priority level
+	taskC [taskpriority.NumLevels]chan *internalTask
+	// picker decides which priority level pollers consider first
+	picker *priorityPicker
+	// backlog tracks the undispatched backlog tasks per fairness key
+	backlog *fairnessBacklog
 	// synchronous task channel to match query task - the reason to have
 	// separate channel for this is because there are cases when consumers
 	// are interested in queryTasks but not others. Example is when namespace is
@@ -62,6 +68,11 @@ type TaskMatcher struct {
 	fwdr           *Forwarder
 	metricsHandler metrics.Handler // namespace metric scope
 	numPartitions  func() int      // number of task queue partitions
+
+	// syncDispatches and backlogDispatches count tasks handed to local pollers,
+	// split by whether the task was sync matched or read from the db backlog
+	syncDispatches    *rateCounter
+	backlogDispatches *rateCounter
 }
 
 const (
@@ -72,7 +83,12 @@ const (
 // newTaskMatcher returns an task matcher instance. The returned instance can be
 // used by task producers and consumers to find a match. Both sync matches and non-sync
 // matches should use this implementation
-func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, metricsHandler metrics.Handler) *TaskMatcher {
+func newTaskMatcher(
+	config *taskQueueConfig,
+	fwdr *Forwarder,
+	backlog *fairnessBacklog,
+	metricsHandler metrics.Handler,
+) *TaskMatcher {
 	dynamicRateBurst := quotas.NewMutableRateBurst(
 		defaultTaskDispatchRPS,
 		int(defaultTaskDispatchRPS),
@@ -90,6 +106,10 @@ func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, metricsHandler met
 			config.AdminNamespaceToPartitionDispatchRate,
 		),
 	})
+	var taskC [taskpriority.NumLevels]chan *internalTask
+	for i := range taskC {
+		taskC[i] = make(chan *internalTask)
+	}
 	return &TaskMatcher{
 		config:             config,
 		dynamicRateBurst:   dynamicRateBurst,
@@ -97,7 +117,11 @@ func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, metricsHandler met
 		rateLimiter:        limiter,
 		metricsHandler:     metricsHandler,
 		fwdr:               fwdr,
-		taskC:              make(chan *internalTask),
+		syncDispatches:     newRateCounter(clock.NewRealTimeSource()),
+		backlogDispatches:  newRateCounter(clock.NewRealTimeSource()),
+		taskC:              taskC,
+		picker:             newPriorityPicker(config.PriorityStarvationInterval),
+		backlog:            backlog,
 		queryTaskC:         make(chan *internalTask),
 		numPartitions:      config.NumReadPartitions,
 	}
@@ -114,6 +138,10 @@ func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, metricsHandler met
 // task queue partition is possible, this method will attempt forwarding
 // to the parent partition.
 //
+// A task is not sync matched while backlog tasks with a different fairness
+// key are waiting to be dispatched, so that it does not jump ahead of keys
+// that are waiting for their round-robin turn.
+//
 // Cases when this method will block:
 //
 // Ratelimit:
@@ -133,6 +161,10 @@ func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, metricsHandler met
 //   - context deadline is exceeded
 //   - task is matched and consumer returns error in response channel
 func (tm *TaskMatcher) Offer(ctx context.Context, task *internalTask) (bool, error) {
+	if !task.isForwarded() && tm.backlog.hasOtherThan(task.fairnessKey()) {
+		return false, nil
+	}
+
 	if !task.isForwarded() {
 		if err := tm.rateLimiter.Wait(ctx); err != nil {
 			tm.metricsHandler.Counter(metrics.SyncThrottlePerTaskQueueCounter.GetMetricName()).Record(1)
@@ -141,7 +173,7 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *internalTask) (bool, err
 	}
 
 	select {
-	case tm.taskC <- task: // poller picked up the task
+	case tm.taskCFor(task) <- task: // poller picked up the task
 		if task.responseC != nil {
 			// if there is a response channel, block until resp is received
 			// and return error if the response contains error
@@ -176,7 +208,7 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *internalTask) (bool, err
 
 func (tm *TaskMatcher) offerOrTimeout(ctx context.Context, task *internalTask) (bool, error) {
 	select {
-	case tm.taskC <- task: // poller picked up the task
+	case tm.taskCFor(task) <- task: // poller picked up the task
 		if task.responseC != nil {
 			select {
 			case err := <-task.responseC:
@@ -239,7 +271,7 @@ func (tm *TaskMatcher) MustOffer(ctx context.Context, task *internalTask) error
 	// attempt a match with local poller first. When that
 	// doesn't succeed, try both local match and remote match
 	select {
-	case tm.taskC <- task:
+	case tm.taskCFor(task) <- task:
 		return nil
 	case <-ctx.Done():
 		return ctx.Err()
@@ -249,7 +281,7 @@ func (tm *TaskMatcher) MustOffer(ctx context.Context, task *internalTask) error
 forLoop:
 	for {
 		select {
-		case tm.taskC <- task:
+		case tm.taskCFor(task) <- task:
 			return nil
 		case token := <-tm.fwdrAddReqTokenC():
 			childCtx, cancel := context.WithTimeout(ctx, time.Second*2)
@@ -262,7 +294,7 @@ forLoop:
 				// the next forwarded call after this childCtx expires. Till then, we block
 				// hoping for a local poller match
 				select {
-				case tm.taskC <- task:
+				case tm.taskCFor(task) <- task:
 					cancel()
 					return nil
 				case <-childCtx.Done():
@@ -333,16 +365,21 @@ func (tm *TaskMatcher) Rate() float64 {
 }
 
 func (tm *TaskMatcher) poll(ctx context.Context, queryOnly bool) (*internalTask, error) {
-	taskC, queryTaskC := tm.taskC, tm.queryTaskC
-	if queryOnly {
-		taskC = nil
+	var taskC [taskpriority.NumLevels]chan *internalTask
+	queryTaskC := tm.queryTaskC
+	if !queryOnly {
+		taskC = tm.taskC
 	}
+	// the blocking selects below must list a case for every priority level
+	highC := taskC[taskpriority.Level(taskpriority.High)]
+	normalC := taskC[taskpriority.Level(taskpriority.Normal)]
+	lowC := taskC[taskpriority.Level(taskpriority.Low)]
 
 	// We want to effectively do a prioritized select, but Go select is random
 	// if multiple cases are ready, so split into multiple selects.
 	// The priority order is:
 	// 1. ctx.Done
-	// 2. taskC and queryTaskC
+	// 2. taskC (by priority level) and queryTaskC
 	// 3. forwarding
 	// 4. block looking locally for remainder of context lifetime
 	// To correctly handle priorities and allow any case to succeed, all select
@@ -357,19 +394,18 @@ func (tm *TaskMatcher) poll(ctx context.Context, queryOnly bool) (*internalTask,
 	default:
 	}
 
-	// 2. taskC and queryTaskC
-	select {
-	case task := <-taskC:
-		if task.responseC != nil {
+	// 2. taskC (by priority level) and queryTaskC
+	for _, level := range tm.picker.order() {
+		select {
+		case task := <-taskC[level]:
+			tm.recordDispatch(task)
+			return task, nil
+		case task := <-queryTaskC:
 			tm.metricsHandler.Counter(metrics.PollSuccessWithSyncPerTaskQueueCounter.GetMetricName()).Record(1)
+			tm.metricsHandler.Counter(metrics.PollSuccessPerTaskQueueCounter.GetMetricName()).Record(1)
+			return task, nil
+		default:
 		}
-		tm.metricsHandler.Counter(metrics.PollSuccessPerTaskQueueCounter.GetMetricName()).Record(1)
-		return task, nil
-	case task := <-queryTaskC:
-		tm.metricsHandler.Counter(metrics.PollSuccessWithSyncPerTaskQueueCounter.GetMetricName()).Record(1)
-		tm.metricsHandler.Counter(metrics.PollSuccessPerTaskQueueCounter.GetMetricName()).Record(1)
-		return task, nil
-	default:
 	}
 
 	// 3. forwarding (and all other clauses repeated again)
@@ -377,11 +413,14 @@ func (tm *TaskMatcher) poll(ctx context.Context, queryOnly bool) (*internalTask,
 	case <-ctx.Done():
 		tm.metricsHandler.Counter(metrics.PollTimeoutPerTaskQueueCounter.GetMetricName()).Record(1)
 		return nil, ErrNoTasks
-	case task := <-taskC:
-		if task.responseC != nil {
-			tm.metricsHandler.Counter(metrics.PollSuccessWithSyncPerTaskQueueCounter.GetMetricName()).Record(1)
-		}
-		tm.metricsHandler.Counter(metrics.PollSuccessPerTaskQueueCounter.GetMetricName()).Record(1)
+	case task := <-highC:
+		tm.recordDispatch(task)
+		return task, nil
+	case task := <-normalC:
+		tm.recordDispatch(task)
+		return task, nil
+	case task := <-lowC:
+		tm.recordDispatch(task)
 		return task, nil
 	case task := <-queryTaskC:
 		tm.metricsHandler.Counter(metrics.PollSuccessWithSyncPerTaskQueueCounter.GetMetricName()).Record(1)
@@ -400,11 +439,14 @@ func (tm *TaskMatcher) poll(ctx context.Context, queryOnly bool) (*internalTask,
 	case <-ctx.Done():
 		tm.metricsHandler.Counter(metrics.PollTimeoutPerTaskQueueCounter.GetMetricName()).Record(1)
 		return nil, ErrNoTasks
-	case task := <-taskC:
-		if task.responseC != nil {
-			tm.metricsHandler.Counter(metrics.PollSuccessWithSyncPerTaskQueueCounter.GetMetricName()).Record(1)
-		}
-		tm.metricsHandler.Counter(metrics.PollSuccessPerTaskQueueCounter.GetMetricName()).Record(1)
+	case task := <-highC:
+		tm.recordDispatch(task)
+		return task, nil
+	case task := <-normalC:
+		tm.recordDispatch(task)
+		return task, nil
+	case task := <-lowC:
+		tm.recordDispatch(task)
 		return task, nil
 	case task := <-queryTaskC:
 		tm.metricsHandler.Counter(metrics.PollSuccessWithSyncPerTaskQueueCounter.GetMetricName()).Record(1)
@@ -413,6 +455,33 @@ func (tm *TaskMatcher) poll(ctx context.Context, queryOnly bool) (*internalTask,
 	}
 }
 
+// taskCFor returns the channel that task should be offered on, based on its priority
+func (tm *TaskMatcher) taskCFor(task *internalTask) chan *internalTask {
+	return tm.taskC[taskpriority.Level(task.priority())]
+}
+
+func (tm *TaskMatcher) recordDispatch(task *internalTask) {
+	if task.responseC != nil {
+		tm.syncDispatches.Inc(1)
+		tm.metricsHandler.Counter(metrics.PollSuccessWithSyncPerTaskQueueCounter.GetMetricName()).Record(1)
+	} else {
+		tm.backlogDispatches.Inc(1)
+	}
+	tm.metricsHandler.Counter(metrics.PollSuccessPerTaskQueueCounter.GetMetricName()).Record(1)
+}
+
+// DispatchStats returns the rate at which tasks were dispatched to local pollers over the
+// last minute, and the fraction of those tasks that were sync matched rather than read
+// from the db backlog.
+func (tm *TaskMatcher) DispatchStats() (rate float64, syncMatchRatio float64) {
+	syncCount, backlogCount := tm.syncDispatches.Sum(), tm.backlogDispatches.Sum()
+	rate = tm.syncDispatches.Rate() + tm.backlogDispatches.Rate()
+	if total := syncCount + backlogCount; total > 0 {
+		syncMatchRatio = float64(syncCount) / float64(total)
+	}
+	return rate, syncMatchRatio
+}
+
 func (tm *TaskMatcher) fwdrPollReqTokenC() <-chan *ForwarderReqToken {
 	if tm.fwdr == nil {
 		return nil
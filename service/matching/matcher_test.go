@@ -47,6 +47,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/taskpriority"
 )
 
 var errMatchingHostThrottleTest = serviceerror.NewResourceExhausted(enumspb.RESOURCE_EXHAUSTED_CAUSE_RPS_LIMIT, "Matching host RPS exceeded.")
@@ -80,11 +81,11 @@ func (t *MatcherTestSuite) SetupTest() {
 	}
 	t.cfg = tlCfg
 	t.fwdr = newForwarder(&t.cfg.forwarderConfig, t.taskQueue, enumspb.TASK_QUEUE_KIND_NORMAL, t.client)
-	t.matcher = newTaskMatcher(tlCfg, t.fwdr, metrics.NoopMetricsHandler)
+	t.matcher = newTaskMatcher(tlCfg, t.fwdr, newFairnessBacklog(), metrics.NoopMetricsHandler)
 
 	rootTaskQueue := newTestTaskQueueID(t.taskQueue.namespaceID, t.taskQueue.Parent(20), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
 	rootTaskqueueCfg := newTaskQueueConfig(rootTaskQueue, cfg, "test-namespace")
-	t.rootMatcher = newTaskMatcher(rootTaskqueueCfg, nil, metrics.NoopMetricsHandler)
+	t.rootMatcher = newTaskMatcher(rootTaskqueueCfg, nil, newFairnessBacklog(), metrics.NoopMetricsHandler)
 }
 
 func (t *MatcherTestSuite) TearDownTest() {
@@ -361,6 +362,85 @@ func (t *MatcherTestSuite) TestMustOfferLocalMatch() {
 	t.NoError(err)
 }
 
+func (t *MatcherTestSuite) TestPollPrefersHigherPriority() {
+	// force disable remote forwarding
+	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.PollReqTokenC()
+
+	var wg sync.WaitGroup
+	offer := func(priority int32) *internalTask {
+		info := randomTaskInfo()
+		info.Data.Priority = priority
+		task := newInternalTask(info, nil, enumsspb.TASK_SOURCE_DB_BACKLOG, "", false)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			t.NoError(t.matcher.MustOffer(ctx, task))
+		}()
+		return task
+	}
+	lowTask := offer(taskpriority.Low)
+	highTask := offer(taskpriority.High)
+	time.Sleep(10 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	task, err := t.matcher.Poll(ctx)
+	t.NoError(err)
+	t.Equal(highTask, task)
+	task, err = t.matcher.Poll(ctx)
+	t.NoError(err)
+	t.Equal(lowTask, task)
+	wg.Wait()
+}
+
+func (t *MatcherTestSuite) TestOfferSkipsSyncMatchWhileOtherFairnessKeyWaits() {
+	// force disable remote forwarding
+	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.PollReqTokenC()
+
+	t.matcher.backlog.add("tenant-a")
+
+	pollStarted := make(chan struct{})
+	polled := make(chan *internalTask, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		close(pollStarted)
+		task, err := t.matcher.Poll(ctx)
+		if err == nil {
+			task.finish(nil)
+			polled <- task
+		}
+	}()
+	<-pollStarted
+	time.Sleep(10 * time.Millisecond)
+
+	offer := func(fairnessKey string) (*internalTask, bool) {
+		info := randomTaskInfo()
+		info.Data.FairnessKey = fairnessKey
+		task := newInternalTask(info, nil, enumsspb.TASK_SOURCE_HISTORY, "", true)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		matched, err := t.matcher.Offer(ctx, task)
+		t.NoError(err)
+		return task, matched
+	}
+
+	// tenant-a is waiting in the backlog, so tenant-b must not jump ahead of it
+	_, matched := offer("tenant-b")
+	t.False(matched)
+	_, matched = offer("")
+	t.False(matched)
+
+	// no other key is waiting, so tenant-a only competes with its own backlog
+	task, matched := offer("tenant-a")
+	t.True(matched)
+	t.Equal(task, <-polled)
+}
+
 func (t *MatcherTestSuite) TestMustOfferRemoteMatch() {
 	var wg sync.WaitGroup
 	wg.Add(1)
@@ -54,6 +54,8 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/taskfairness"
+	"go.temporal.io/server/common/taskpriority"
 )
 
 const (
@@ -287,6 +289,8 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 		Clock:            addRequest.GetClock(),
 		ExpiryTime:       expirationTime,
 		CreateTime:       now,
+		Priority:         taskpriority.Normalize(addRequest.GetPriority()),
+		FairnessKey:      taskfairness.Normalize(addRequest.GetFairnessKey()),
 	}
 
 	return tqm.AddTask(hCtx.Context, addTaskParams{
@@ -333,6 +337,8 @@ func (e *matchingEngineImpl) AddActivityTask(
 		Clock:            addRequest.GetClock(),
 		CreateTime:       now,
 		ExpiryTime:       expirationTime,
+		Priority:         taskpriority.Normalize(addRequest.GetPriority()),
+		FairnessKey:      taskfairness.Normalize(addRequest.GetFairnessKey()),
 	}
 
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
@@ -657,7 +663,14 @@ func (e *matchingEngineImpl) DescribeTaskQueue(
 		return nil, err
 	}
 
-	return tlMgr.DescribeTaskQueue(request.DescRequest.GetIncludeTaskQueueStatus()), nil
+	resp := tlMgr.DescribeTaskQueue(request.DescRequest.GetIncludeTaskQueueStatus())
+	if request.GetIncludeBacklogStats() {
+		resp.BacklogStats, err = tlMgr.GetBacklogStats(hCtx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
 }
 
 func (e *matchingEngineImpl) ListTaskQueuePartitions(
@@ -787,7 +800,11 @@ func (e *matchingEngineImpl) GetTaskQueueMetadata(
 ) (*matchingservice.GetTaskQueueMetadataResponse, error) {
 	namespaceID := namespace.ID(req.GetNamespaceId())
 	taskQueueName := req.GetTaskQueue()
-	taskQueue, err := newTaskQueueID(namespaceID, taskQueueName, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	taskQueueType := req.GetTaskQueueType()
+	if taskQueueType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		taskQueueType = enumspb.TASK_QUEUE_TYPE_WORKFLOW
+	}
+	taskQueue, err := newTaskQueueID(namespaceID, taskQueueName, taskQueueType)
 	if err != nil {
 		return nil, err
 	}
@@ -796,6 +813,11 @@ func (e *matchingEngineImpl) GetTaskQueueMetadata(
 		return nil, err
 	}
 	resp := &matchingservice.GetTaskQueueMetadataResponse{}
+	if req.GetWantPartitionCounts() {
+		counts := tqMgr.GetPartitionCounts()
+		resp.NumWritePartitions = int32(counts.write)
+		resp.NumReadPartitions = int32(counts.read)
+	}
 	verDatHash := req.GetWantVersioningDataCurhash()
 	// This isn't != nil, because gogoproto will round-trip serialize an empty byte array in a request
 	// into a nil field.
@@ -68,6 +68,7 @@ import (
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/taskpriority"
 	"go.temporal.io/server/common/util"
 )
 
@@ -217,6 +218,34 @@ func (s *matchingEngineSuite) TestAckManager() {
 	s.EqualValues(t6, m.getAckLevel())
 }
 
+func (s *matchingEngineSuite) TestPriorityAckManager() {
+	m := newPriorityAckManager(s.logger)
+	low := taskpriority.Level(taskpriority.Low)
+	normal := taskpriority.Level(taskpriority.Normal)
+	high := taskpriority.Level(taskpriority.High)
+	m.setAckLevel(100)
+
+	m.level(normal).addTask(200)
+	m.level(high).addTask(300)
+	m.level(low).setReadLevelAfterGap(400)
+	s.EqualValues(100, m.getAckLevel())
+	s.EqualValues(200, m.getReadLevel())
+	s.EqualValues(2, m.getBacklogCountHint())
+
+	// high priority tasks are acked ahead of older normal priority tasks
+	s.EqualValues(100, m.completeTask(high, 300))
+	s.EqualValues(1, m.getBacklogCountHint())
+
+	// a level whose tasks are all acked is acked up to its read level
+	m.level(normal).setReadLevelAfterGap(400)
+	s.EqualValues(300, m.completeTask(normal, 200))
+	s.EqualValues(300, m.getReadLevel())
+	m.level(high).setReadLevelAfterGap(400)
+	s.EqualValues(400, m.getAckLevel())
+	s.EqualValues(400, m.getReadLevel())
+	s.EqualValues(0, m.getBacklogCountHint())
+}
+
 func (s *matchingEngineSuite) TestAckManager_Sort() {
 	m := newAckManager(s.logger)
 	const t0 = 100
@@ -1700,24 +1729,22 @@ func (s *matchingEngineSuite) TestTaskQueueManagerGetTaskBatch() {
 
 	// wait until all tasks are read by the task pump and enqeued into the in-memory buffer
 	// at the end of this step, ackManager readLevel will also be equal to the buffer size
-	expectedBufSize := util.Min(cap(tlMgr.taskReader.taskBuffer), taskCount)
-	s.True(s.awaitCondition(func() bool { return len(tlMgr.taskReader.taskBuffer) == expectedBufSize }, time.Second))
+	expectedBufSize := util.Min(tlMgr.config.GetTasksBatchSize()-1, taskCount)
+	s.True(s.awaitCondition(func() bool { return tlMgr.taskReader.numBufferedTasks() == expectedBufSize }, time.Second))
 
 	// stop all goroutines that read / write tasks in the background
 	// remainder of this test works with the in-memory buffer
 	tlMgr.Stop()
 
-	// setReadLevel should NEVER be called without updating ackManager.outstandingTasks
-	// This is only for unit test purpose
-	tlMgr.taskAckManager.setReadLevel(tlMgr.taskWriter.GetMaxReadLevel())
-	tasks, readLevel, isReadBatchDone, err := tlMgr.taskReader.getTaskBatch(context.Background())
+	normalLevel := taskpriority.Level(taskpriority.Normal)
+	batchSize := tlMgr.config.GetTasksBatchSize()
+	tasks, readLevel, isReadBatchDone, err := tlMgr.taskReader.getTaskBatch(context.Background(), tlMgr.taskWriter.GetMaxReadLevel(), batchSize, []int{normalLevel})
 	s.Nil(err)
 	s.EqualValues(0, len(tasks))
 	s.EqualValues(tlMgr.taskWriter.GetMaxReadLevel(), readLevel)
 	s.True(isReadBatchDone)
 
-	tlMgr.taskAckManager.setReadLevel(0)
-	tasks, readLevel, isReadBatchDone, err = tlMgr.taskReader.getTaskBatch(context.Background())
+	tasks, readLevel, isReadBatchDone, err = tlMgr.taskReader.getTaskBatch(context.Background(), 0, batchSize, []int{normalLevel})
 	s.Nil(err)
 	s.EqualValues(rangeSize, len(tasks))
 	s.EqualValues(rangeSize, readLevel)
@@ -1728,7 +1755,7 @@ func (s *matchingEngineSuite) TestTaskQueueManagerGetTaskBatch() {
 	// reset the ackManager readLevel to the buffer size and consume
 	// the in-memory tasks by calling Poll API - assert ackMgr state
 	// at the end
-	tlMgr.taskAckManager.setReadLevel(int64(expectedBufSize))
+	tlMgr.taskAckManager.level(normalLevel).setReadLevel(int64(expectedBufSize))
 
 	// complete rangeSize events
 	for i := int64(0); i < rangeSize; i++ {
@@ -1750,7 +1777,7 @@ func (s *matchingEngineSuite) TestTaskQueueManagerGetTaskBatch() {
 		}
 	}
 	s.EqualValues(taskCount-rangeSize, s.taskManager.getTaskCount(tlID))
-	tasks, _, isReadBatchDone, err = tlMgr.taskReader.getTaskBatch(context.Background())
+	tasks, _, isReadBatchDone, err = tlMgr.taskReader.getTaskBatch(context.Background(), int64(expectedBufSize), batchSize, []int{normalLevel})
 	s.Nil(err)
 	s.True(0 < len(tasks) && len(tasks) <= rangeSize)
 	s.True(isReadBatchDone)
@@ -1778,16 +1805,16 @@ func (s *matchingEngineSuite) TestTaskQueueManagerGetTaskBatch_ReadBatchDone() {
 	// the following few lines get clobbered as part of the taskWriter.Start()
 	time.Sleep(100 * time.Millisecond)
 
-	tlMgr.taskAckManager.setReadLevel(0)
+	normalLevel := taskpriority.Level(taskpriority.Normal)
+	batchSize := tlMgr.config.GetTasksBatchSize()
 	atomic.StoreInt64(&tlMgr.taskWriter.maxReadLevel, maxReadLevel)
-	tasks, readLevel, isReadBatchDone, err := tlMgr.taskReader.getTaskBatch(context.Background())
+	tasks, readLevel, isReadBatchDone, err := tlMgr.taskReader.getTaskBatch(context.Background(), 0, batchSize, []int{normalLevel})
 	s.Empty(tasks)
 	s.Equal(int64(rangeSize*10), readLevel)
 	s.False(isReadBatchDone)
 	s.NoError(err)
 
-	tlMgr.taskAckManager.setReadLevel(readLevel)
-	tasks, readLevel, isReadBatchDone, err = tlMgr.taskReader.getTaskBatch(context.Background())
+	tasks, readLevel, isReadBatchDone, err = tlMgr.taskReader.getTaskBatch(context.Background(), readLevel, batchSize, []int{normalLevel})
 	s.Empty(tasks)
 	s.Equal(maxReadLevel, readLevel)
 	s.True(isReadBatchDone)
@@ -1872,7 +1899,7 @@ func (s *matchingEngineSuite) TestTaskExpiryAndCompletion() {
 		// wait until all tasks are loaded by into in-memory buffers by task queue manager
 		// the buffer size should be one less than expected because dispatcher will dequeue the head
 		// 1/4 should be thrown out because they are expired before they hit the buffer
-		s.True(s.awaitCondition(func() bool { return len(tlMgr.taskReader.taskBuffer) >= (3*taskCount/4 - 1) }, time.Second))
+		s.True(s.awaitCondition(func() bool { return tlMgr.taskReader.numBufferedTasks() >= (3*taskCount/4 - 1) }, time.Second))
 
 		// ensure the 1/4 of tasks with small ScheduleToStartTimeout will be expired when they come out of the buffer
 		time.Sleep(300 * time.Millisecond)
@@ -2388,7 +2415,7 @@ func (m *testTaskManager) GetTasks(
 		if taskID < request.InclusiveMinTaskID {
 			continue
 		}
-		if taskID >= request.ExclusiveMaxTaskID {
+		if taskID >= request.ExclusiveMaxTaskID || len(tasks) == request.PageSize {
 			break
 		}
 		tasks = append(tasks, it.Value().(*persistencespb.AllocatedTaskInfo))
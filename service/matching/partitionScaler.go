@@ -0,0 +1,340 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	uberatomic "go.uber.org/atomic"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/util"
+)
+
+type (
+	// partitionCounts is the number of partitions of a task queue that accept new tasks (write)
+	// and that are still polled (read). The zero value means partition autoscaling is not in
+	// effect and all configured partitions are active.
+	partitionCounts struct {
+		write int
+		read  int
+	}
+
+	// partitionLoad is the load observed by the root partition since its last evaluation
+	partitionLoad struct {
+		addRate float64 // tasks per second added directly to the root partition
+		pollers int     // distinct pollers seen recently
+		backlog int64
+	}
+
+	// partitionScaler grows and shrinks the number of active partitions of a task queue.
+	// The root partition owns the decision: since adds and polls are spread evenly across
+	// partitions, it estimates the load of the whole task queue from its own share. Other
+	// partitions periodically fetch the decision from the root partition and, once retired,
+	// redirect new tasks and polls to the active partitions.
+	partitionScaler struct {
+		tqMgr    *taskQueueManagerImpl
+		config   *taskQueueConfig
+		fwdr     *Forwarder // nil on root and sticky partitions, which are never retired
+		addCount uberatomic.Int64
+		stopChan chan struct{}
+
+		countsLock sync.RWMutex
+		counts     partitionCounts
+
+		// only accessed by the evaluation loop
+		lastEvaluation time.Time
+		scaleDownSince time.Time
+		writeRetiredAt time.Time
+	}
+)
+
+func newPartitionScaler(tqMgr *taskQueueManagerImpl, config *taskQueueConfig, fwdr *Forwarder) *partitionScaler {
+	return &partitionScaler{
+		tqMgr:    tqMgr,
+		config:   config,
+		fwdr:     fwdr,
+		stopChan: make(chan struct{}),
+	}
+}
+
+func (s *partitionScaler) Start() {
+	if s.tqMgr.taskQueueKind == enumspb.TASK_QUEUE_KIND_STICKY {
+		return
+	}
+	go s.loop()
+}
+
+func (s *partitionScaler) Stop() {
+	close(s.stopChan)
+}
+
+// Counts returns the partition counts currently in effect
+func (s *partitionScaler) Counts() partitionCounts {
+	s.countsLock.RLock()
+	defer s.countsLock.RUnlock()
+	return s.counts
+}
+
+func (s *partitionScaler) setCounts(counts partitionCounts) {
+	s.countsLock.Lock()
+	prev := s.counts
+	s.counts = counts
+	s.countsLock.Unlock()
+
+	if prev != counts && s.tqMgr.taskQueueID.IsRoot() {
+		s.tqMgr.logger.Info("Task queue partition counts changed",
+			tag.NewInt("write-partitions", counts.write),
+			tag.NewInt("read-partitions", counts.read))
+	}
+}
+
+// recordAdd counts a task added directly to this partition, as opposed to one forwarded
+// from a child partition
+func (s *partitionScaler) recordAdd() {
+	if s.tqMgr.taskQueueID.IsRoot() {
+		s.addCount.Inc()
+	}
+}
+
+// redirectTask hands a new task over to an active partition if this partition is retired for
+// writes. Returns false if the task should be handled locally.
+func (s *partitionScaler) redirectTask(ctx context.Context, params addTaskParams) bool {
+	if s.fwdr == nil {
+		return false
+	}
+	partition, ok := s.Counts().writeTarget(s.tqMgr.taskQueueID.partition)
+	if !ok {
+		return false
+	}
+	task := newInternalTask(&persistencespb.AllocatedTaskInfo{
+		Data:   params.taskInfo,
+		TaskId: syncMatchTaskId,
+	}, nil, params.source, "", false)
+	if err := s.fwdr.RedirectTask(ctx, task, partition); err != nil {
+		// keep the task here, this partition is polled until its backlog is drained
+		s.tqMgr.logger.Warn("Failed to redirect task from retired partition", tag.Error(err))
+		return false
+	}
+	return true
+}
+
+// redirectPoll hands a poll over to an active partition if this partition is retired for
+// reads and has no backlog left. Returns false if the poll should be served locally.
+func (s *partitionScaler) redirectPoll(ctx context.Context) (*internalTask, bool) {
+	if s.fwdr == nil {
+		return nil, false
+	}
+	partition, ok := s.Counts().readTarget(s.tqMgr.taskQueueID.partition)
+	if !ok || s.tqMgr.taskAckManager.getBacklogCountHint() > 0 {
+		return nil, false
+	}
+	task, err := s.fwdr.RedirectPoll(ctx, partition)
+	if err != nil {
+		return nil, false
+	}
+	return task, true
+}
+
+// writeTarget returns the active partition that tasks added to the given partition should
+// be redirected to, or false if the partition accepts new tasks
+func (c partitionCounts) writeTarget(partition int) (int, bool) {
+	if c.write == 0 || partition < c.write {
+		return 0, false
+	}
+	return partition % c.write, true
+}
+
+// readTarget returns the active partition that polls of the given partition should be
+// redirected to, or false if the partition is still polled
+func (c partitionCounts) readTarget(partition int) (int, bool) {
+	if c.read == 0 || partition < c.read {
+		return 0, false
+	}
+	return partition % c.read, true
+}
+
+func (s *partitionScaler) loop() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-s.stopChan:
+			return
+		case <-timer.C:
+			s.runOnce()
+			timer.Reset(s.config.PartitionAutoscalingInterval())
+		}
+	}
+}
+
+func (s *partitionScaler) runOnce() {
+	if !s.config.EnablePartitionAutoscaling() {
+		s.setCounts(partitionCounts{})
+		s.lastEvaluation = time.Time{}
+		return
+	}
+
+	ctx, cancel := s.tqMgr.newIOContext()
+	defer cancel()
+
+	if s.tqMgr.taskQueueID.IsRoot() {
+		s.evaluate(ctx)
+	} else {
+		s.refresh(ctx)
+	}
+}
+
+// evaluate decides the partition counts on the root partition
+func (s *partitionScaler) evaluate(ctx context.Context) {
+	now := time.Now()
+	adds := s.addCount.Swap(0)
+	lastEvaluation := s.lastEvaluation
+	s.lastEvaluation = now
+	if lastEvaluation.IsZero() {
+		// no rate to go by yet
+		return
+	}
+
+	load := partitionLoad{
+		addRate: float64(adds) / now.Sub(lastEvaluation).Seconds(),
+		pollers: len(s.tqMgr.pollerHistory.getPollerInfo(now.Add(-noPollerThreshold))),
+		backlog: s.tqMgr.taskAckManager.getBacklogCountHint(),
+	}
+	s.setCounts(s.nextCounts(now, load, func(counts partitionCounts) bool {
+		return s.retiredPartitionsDrained(ctx, counts)
+	}))
+}
+
+// nextCounts returns the partition counts to use given the observed load. Partitions are
+// added as soon as the load requires them, but only retired for writes once the load has
+// stayed low for the scale down delay, and only retired for reads once drained reports that
+// they have no backlog left.
+func (s *partitionScaler) nextCounts(
+	now time.Time,
+	load partitionLoad,
+	drained func(partitionCounts) bool,
+) partitionCounts {
+	maxWrite := s.config.NumWritePartitions()
+	maxRead := util.Max(maxWrite, s.config.NumReadPartitions())
+
+	current := s.Counts()
+	if current.write == 0 {
+		current = partitionCounts{write: maxWrite, read: maxRead}
+	}
+	current.write = util.Min(current.write, maxWrite)
+	current.read = util.Max(current.write, util.Min(current.read, maxRead))
+
+	desired := maxWrite
+	if targetRate := s.config.PartitionAutoscalingTargetRate(); targetRate > 0 {
+		desired = int(math.Ceil(load.addRate * float64(current.write) / targetRate))
+	}
+	// partitions without a poller of their own can only hand their tasks to their parents
+	desired = util.Min(desired, util.Max(1, load.pollers))
+	desired = util.Max(1, util.Min(desired, maxWrite))
+
+	next := current
+	switch {
+	case desired > current.write:
+		next.write = desired
+		next.read = util.Max(current.read, desired)
+		s.scaleDownSince = time.Time{}
+	case desired < current.write && load.backlog == 0:
+		if s.scaleDownSince.IsZero() {
+			s.scaleDownSince = now
+		} else if now.Sub(s.scaleDownSince) >= s.config.PartitionAutoscalingScaleDownDelay() {
+			next.write = desired
+			s.scaleDownSince = time.Time{}
+			s.writeRetiredAt = now
+		}
+	default:
+		s.scaleDownSince = time.Time{}
+	}
+
+	// Give the other partitions time to learn that they are retired for writes before
+	// checking their backlog, so that no task is added to a partition found to be drained.
+	if next.read > next.write &&
+		now.Sub(s.writeRetiredAt) >= 2*s.config.PartitionAutoscalingInterval() &&
+		drained(next) {
+		next.read = next.write
+	}
+	return next
+}
+
+// retiredPartitionsDrained returns true if none of the partitions retired for writes has a
+// backlog left
+func (s *partitionScaler) retiredPartitionsDrained(ctx context.Context, counts partitionCounts) bool {
+	for partition := counts.write; partition < counts.read; partition++ {
+		resp, err := s.tqMgr.matchingClient.DescribeTaskQueue(ctx, &matchingservice.DescribeTaskQueueRequest{
+			NamespaceId: s.tqMgr.taskQueueID.namespaceID.String(),
+			DescRequest: &workflowservice.DescribeTaskQueueRequest{
+				TaskQueue: &taskqueuepb.TaskQueue{
+					Name: s.tqMgr.taskQueueID.mkName(partition),
+					Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+				},
+				TaskQueueType:          s.tqMgr.taskQueueID.taskType,
+				IncludeTaskQueueStatus: true,
+			},
+		})
+		if err != nil {
+			s.tqMgr.logger.Warn("Failed to describe retired task queue partition", tag.Error(err))
+			return false
+		}
+		status := resp.GetTaskQueueStatus()
+		if status.GetBacklogCountHint() > 0 || status.GetAckLevel() < status.GetReadLevel() {
+			return false
+		}
+	}
+	return true
+}
+
+// refresh fetches the partition counts from the root partition
+func (s *partitionScaler) refresh(ctx context.Context) {
+	resp, err := s.tqMgr.matchingClient.GetTaskQueueMetadata(ctx, &matchingservice.GetTaskQueueMetadataRequest{
+		NamespaceId:         s.tqMgr.taskQueueID.namespaceID.String(),
+		TaskQueue:           s.tqMgr.taskQueueID.GetRoot(),
+		TaskQueueType:       s.tqMgr.taskQueueID.taskType,
+		WantPartitionCounts: true,
+	})
+	if err != nil {
+		// keep using the last known counts
+		s.tqMgr.logger.Warn("Failed to fetch partition counts from root partition", tag.Error(err))
+		return
+	}
+	s.setCounts(partitionCounts{
+		write: int(resp.GetNumWritePartitions()),
+		read:  int(resp.GetNumReadPartitions()),
+	})
+}
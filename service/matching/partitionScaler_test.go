@@ -0,0 +1,161 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	partitionScalerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		now    time.Time
+		scaler *partitionScaler
+	}
+)
+
+func TestPartitionScalerSuite(t *testing.T) {
+	s := new(partitionScalerSuite)
+	suite.Run(t, s)
+}
+
+func (s *partitionScalerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.now = time.Now()
+	config := &taskQueueConfig{
+		NumWritePartitions:                 func() int { return 8 },
+		NumReadPartitions:                  func() int { return 8 },
+		PartitionAutoscalingTargetRate:     func() float64 { return 100 },
+		PartitionAutoscalingInterval:       func() time.Duration { return time.Minute },
+		PartitionAutoscalingScaleDownDelay: func() time.Duration { return 10 * time.Minute },
+	}
+	s.scaler = newPartitionScaler(nil, config, nil)
+}
+
+func (s *partitionScalerSuite) next(load partitionLoad, drained bool) partitionCounts {
+	counts := s.scaler.nextCounts(s.now, load, func(partitionCounts) bool { return drained })
+	s.scaler.counts = counts
+	return counts
+}
+
+func (s *partitionScalerSuite) TestStartsWithAllPartitions() {
+	counts := s.next(partitionLoad{addRate: 1000, pollers: 100}, true)
+	s.Equal(partitionCounts{write: 8, read: 8}, counts)
+}
+
+func (s *partitionScalerSuite) TestScaleUp() {
+	s.scaler.counts = partitionCounts{write: 2, read: 2}
+
+	// 150 tasks/s on the root partition means 300 tasks/s in total
+	counts := s.next(partitionLoad{addRate: 150, pollers: 100}, true)
+	s.Equal(partitionCounts{write: 3, read: 3}, counts)
+
+	// never beyond the configured number of partitions
+	counts = s.next(partitionLoad{addRate: 1000, pollers: 100}, true)
+	s.Equal(partitionCounts{write: 8, read: 8}, counts)
+}
+
+func (s *partitionScalerSuite) TestScaleUp_LimitedByPollers() {
+	s.scaler.counts = partitionCounts{write: 1, read: 1}
+
+	counts := s.next(partitionLoad{addRate: 500, pollers: 2}, true)
+	s.Equal(partitionCounts{write: 2, read: 2}, counts)
+}
+
+func (s *partitionScalerSuite) TestScaleDown() {
+	s.scaler.counts = partitionCounts{write: 4, read: 4}
+	low := partitionLoad{addRate: 10, pollers: 100}
+
+	// load must stay low for the scale down delay
+	s.Equal(partitionCounts{write: 4, read: 4}, s.next(low, true))
+	s.now = s.now.Add(5 * time.Minute)
+	s.Equal(partitionCounts{write: 4, read: 4}, s.next(low, true))
+	s.now = s.now.Add(5 * time.Minute)
+	s.Equal(partitionCounts{write: 1, read: 4}, s.next(low, true))
+
+	// retired partitions are polled until other partitions had time to learn about the
+	// retirement and the retired partitions are drained
+	s.now = s.now.Add(time.Minute)
+	s.Equal(partitionCounts{write: 1, read: 4}, s.next(low, true))
+	s.now = s.now.Add(time.Minute)
+	s.Equal(partitionCounts{write: 1, read: 4}, s.next(low, false))
+	s.now = s.now.Add(time.Minute)
+	s.Equal(partitionCounts{write: 1, read: 1}, s.next(low, true))
+}
+
+func (s *partitionScalerSuite) TestScaleDown_Interrupted() {
+	s.scaler.counts = partitionCounts{write: 4, read: 4}
+
+	s.next(partitionLoad{addRate: 10, pollers: 100}, true)
+	s.now = s.now.Add(5 * time.Minute)
+	s.next(partitionLoad{addRate: 100, pollers: 100}, true)
+	s.now = s.now.Add(5 * time.Minute)
+	s.Equal(partitionCounts{write: 4, read: 4}, s.next(partitionLoad{addRate: 10, pollers: 100}, true))
+}
+
+func (s *partitionScalerSuite) TestScaleDown_BlockedByBacklog() {
+	s.scaler.counts = partitionCounts{write: 4, read: 4}
+	backlogged := partitionLoad{addRate: 10, pollers: 100, backlog: 50}
+
+	s.next(backlogged, true)
+	s.now = s.now.Add(20 * time.Minute)
+	s.Equal(partitionCounts{write: 4, read: 4}, s.next(backlogged, true))
+}
+
+func (s *partitionScalerSuite) TestScaleUp_WhileDraining() {
+	s.scaler.counts = partitionCounts{write: 1, read: 4}
+	s.scaler.writeRetiredAt = s.now
+
+	counts := s.next(partitionLoad{addRate: 250, pollers: 100}, false)
+	s.Equal(partitionCounts{write: 3, read: 4}, counts)
+}
+
+func (s *partitionScalerSuite) TestRedirectTargets() {
+	counts := partitionCounts{write: 2, read: 3}
+
+	_, ok := counts.writeTarget(1)
+	s.False(ok)
+	partition, ok := counts.writeTarget(5)
+	s.True(ok)
+	s.Equal(1, partition)
+
+	_, ok = counts.readTarget(2)
+	s.False(ok)
+	partition, ok = counts.readTarget(5)
+	s.True(ok)
+	s.Equal(2, partition)
+
+	_, ok = partitionCounts{}.writeTarget(5)
+	s.False(ok)
+	_, ok = partitionCounts{}.readTarget(5)
+	s.False(ok)
+}
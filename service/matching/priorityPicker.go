@@ -0,0 +1,73 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"go.uber.org/atomic"
+
+	"go.temporal.io/server/common/taskpriority"
+)
+
+type (
+	// priorityPicker decides the order in which task priority levels are considered for
+	// the next dispatch. Levels are normally considered from highest to lowest, but every
+	// starvationInterval-th pick considers them from lowest to highest so that low priority
+	// tasks keep making progress while higher priority tasks are always available.
+	priorityPicker struct {
+		starvationInterval func() int
+		picks              atomic.Uint64
+	}
+)
+
+var (
+	highToLowLevels = makeLevelOrder(false)
+	lowToHighLevels = makeLevelOrder(true)
+)
+
+func newPriorityPicker(starvationInterval func() int) *priorityPicker {
+	return &priorityPicker{starvationInterval: starvationInterval}
+}
+
+// order returns the priority levels in the order they should be considered for the next
+// dispatch. The returned slice must not be modified.
+func (p *priorityPicker) order() []int {
+	n := p.picks.Inc()
+	if interval := p.starvationInterval(); interval > 0 && n%uint64(interval) == 0 {
+		return lowToHighLevels
+	}
+	return highToLowLevels
+}
+
+func makeLevelOrder(lowFirst bool) []int {
+	levels := make([]int, taskpriority.NumLevels)
+	for i := range levels {
+		if lowFirst {
+			levels[i] = i
+		} else {
+			levels[i] = taskpriority.NumLevels - 1 - i
+		}
+	}
+	return levels
+}
@@ -0,0 +1,83 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/taskpriority"
+)
+
+type (
+	priorityPickerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		interval int
+		picker   *priorityPicker
+	}
+)
+
+func TestPriorityPickerSuite(t *testing.T) {
+	s := new(priorityPickerSuite)
+	suite.Run(t, s)
+}
+
+func (s *priorityPickerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.interval = 4
+	s.picker = newPriorityPicker(func() int { return s.interval })
+}
+
+func (s *priorityPickerSuite) TestHighToLowByDefault() {
+	order := s.picker.order()
+	s.Equal([]int{
+		taskpriority.Level(taskpriority.High),
+		taskpriority.Level(taskpriority.Normal),
+		taskpriority.Level(taskpriority.Low),
+	}, order)
+}
+
+func (s *priorityPickerSuite) TestLowFirstEveryInterval() {
+	for i := 1; i <= 3*s.interval; i++ {
+		order := s.picker.order()
+		if i%s.interval == 0 {
+			s.Equal(taskpriority.Level(taskpriority.Low), order[0], "pick %d", i)
+		} else {
+			s.Equal(taskpriority.Level(taskpriority.High), order[0], "pick %d", i)
+		}
+	}
+}
+
+func (s *priorityPickerSuite) TestStarvationProtectionDisabled() {
+	s.interval = 0
+	for i := 0; i < 10; i++ {
+		s.Equal(taskpriority.Level(taskpriority.High), s.picker.order()[0])
+	}
+}
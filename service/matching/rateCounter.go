@@ -0,0 +1,106 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+)
+
+const (
+	rateCounterBucketWidth = 10 * time.Second
+	rateCounterNumBuckets  = 6
+	rateCounterWindow      = rateCounterBucketWidth * rateCounterNumBuckets
+)
+
+type (
+	// rateCounter counts events over a sliding one minute window made of fixed width buckets
+	rateCounter struct {
+		timeSource clock.TimeSource
+		createTime time.Time
+
+		sync.Mutex
+		counts  [rateCounterNumBuckets]int64
+		buckets [rateCounterNumBuckets]int64 // index of the time bucket each slot currently holds
+	}
+)
+
+func newRateCounter(timeSource clock.TimeSource) *rateCounter {
+	return &rateCounter{
+		timeSource: timeSource,
+		createTime: timeSource.Now(),
+	}
+}
+
+// Inc records count events at the current time
+func (r *rateCounter) Inc(count int64) {
+	bucket := r.bucket(r.timeSource.Now())
+	slot := bucket % rateCounterNumBuckets
+
+	r.Lock()
+	defer r.Unlock()
+	if r.buckets[slot] != bucket {
+		r.buckets[slot] = bucket
+		r.counts[slot] = 0
+	}
+	r.counts[slot] += count
+}
+
+// Sum returns the number of events recorded within the window
+func (r *rateCounter) Sum() int64 {
+	return r.sum(r.bucket(r.timeSource.Now()))
+}
+
+// Rate returns the per second rate of events within the window. For a counter younger
+// than the window, the rate is computed over the time since the counter was created.
+func (r *rateCounter) Rate() float64 {
+	now := r.timeSource.Now()
+	elapsed := now.Sub(r.createTime)
+	if elapsed > rateCounterWindow {
+		elapsed = rateCounterWindow
+	}
+	if elapsed < time.Second {
+		elapsed = time.Second
+	}
+	return float64(r.sum(r.bucket(now))) / elapsed.Seconds()
+}
+
+func (r *rateCounter) sum(current int64) int64 {
+	r.Lock()
+	defer r.Unlock()
+	var sum int64
+	for i := range r.counts {
+		if r.buckets[i] > current-rateCounterNumBuckets {
+			sum += r.counts[i]
+		}
+	}
+	return sum
+}
+
+func (r *rateCounter) bucket(t time.Time) int64 {
+	return t.UnixNano() / int64(rateCounterBucketWidth)
+}
@@ -0,0 +1,95 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/clock"
+)
+
+type (
+	rateCounterSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		timeSource *clock.EventTimeSource
+		counter    *rateCounter
+	}
+)
+
+func TestRateCounterSuite(t *testing.T) {
+	s := new(rateCounterSuite)
+	suite.Run(t, s)
+}
+
+func (s *rateCounterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.timeSource = clock.NewEventTimeSource().Update(time.Unix(1000, 0))
+	s.counter = newRateCounter(s.timeSource)
+}
+
+func (s *rateCounterSuite) advance(d time.Duration) {
+	s.timeSource.Update(s.timeSource.Now().Add(d))
+}
+
+func (s *rateCounterSuite) TestEmpty() {
+	s.Equal(int64(0), s.counter.Sum())
+	s.Equal(float64(0), s.counter.Rate())
+}
+
+func (s *rateCounterSuite) TestRateBeforeWindowIsFull() {
+	s.counter.Inc(10)
+	s.advance(5 * time.Second)
+	s.counter.Inc(10)
+
+	s.Equal(int64(20), s.counter.Sum())
+	s.InDelta(4.0, s.counter.Rate(), 0.001)
+}
+
+func (s *rateCounterSuite) TestRateOverFullWindow() {
+	for i := 0; i < 12; i++ {
+		s.counter.Inc(50)
+		s.advance(rateCounterBucketWidth)
+	}
+
+	// only the last rateCounterNumBuckets buckets are in the window
+	s.Equal(int64(250), s.counter.Sum())
+	s.InDelta(250.0/rateCounterWindow.Seconds(), s.counter.Rate(), 0.001)
+}
+
+func (s *rateCounterSuite) TestOldBucketsExpire() {
+	s.counter.Inc(100)
+	s.advance(rateCounterWindow)
+	s.Equal(int64(0), s.counter.Sum())
+
+	s.counter.Inc(7)
+	s.Equal(int64(7), s.counter.Sum())
+}
@@ -31,6 +31,7 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/taskpriority"
 )
 
 type (
@@ -138,6 +139,24 @@ func (task *internalTask) workflowExecution() *commonpb.WorkflowExecution {
 	return &commonpb.WorkflowExecution{}
 }
 
+// priority returns the priority of the task. Query and started tasks are always dispatched
+// at normal priority.
+func (task *internalTask) priority() int32 {
+	if task.event != nil {
+		return task.event.Data.GetPriority()
+	}
+	return taskpriority.Normal
+}
+
+// fairnessKey returns the fairness key of the task, or an empty string for query and
+// started tasks.
+func (task *internalTask) fairnessKey() string {
+	if task.event != nil {
+		return task.event.Data.GetFairnessKey()
+	}
+	return ""
+}
+
 // pollWorkflowTaskQueueResponse returns the poll response for a workflow task that is
 // already marked as started. This method should only be called when isStarted() is true
 func (task *internalTask) pollWorkflowTaskQueueResponse() *matchingservice.PollWorkflowTaskQueueResponse {
@@ -43,6 +43,7 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/clock"
@@ -55,6 +56,8 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/taskpriority"
 	"go.temporal.io/server/common/util"
 )
 
@@ -124,6 +127,12 @@ type (
 		HasPollerAfter(accessTime time.Time) bool
 		// DescribeTaskQueue returns information about the target task queue
 		DescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse
+		// GetPartitionCounts returns the number of partitions of this task queue that are active for
+		// writes and reads, or zeros if partition autoscaling is not in effect
+		GetPartitionCounts() partitionCounts
+		// GetBacklogStats returns the approximate backlog size, the age of the oldest undispatched
+		// task and the add and dispatch rates of this partition
+		GetBacklogStats(ctx context.Context) (*taskqueuespb.BacklogStats, error)
 		String() string
 		QueueID() *taskQueueID
 		TaskQueueKind() enumspb.TaskQueueKind
@@ -140,8 +149,8 @@ type (
 		taskReader           *taskReader // reads tasks from db and async matches it with poller
 		liveness             *liveness
 		taskGC               *taskGC
-		taskAckManager       ackManager   // tracks ackLevel for delivered messages
-		matcher              *TaskMatcher // for matching a task producer with a poller
+		taskAckManager       *priorityAckManager // tracks ackLevel for delivered messages
+		matcher              *TaskMatcher        // for matching a task producer with a poller
 		namespaceRegistry    namespace.Registry
 		logger               log.Logger
 		matchingClient       matchingservice.MatchingServiceClient
@@ -164,6 +173,11 @@ type (
 		// the root partition, it is fulfilled as soon as it is fetched from db.
 		metadataInitialFetch *future.FutureImpl[struct{}]
 		metadataPoller       metadataPoller
+		// partitionScaler decides (on the root partition) or tracks (on other partitions) how many
+		// partitions of this task queue are active
+		partitionScaler *partitionScaler
+		// addRate counts tasks that were added to this partition by history
+		addRate *rateCounter
 	}
 
 	metadataPoller struct {
@@ -221,7 +235,7 @@ func newTaskQueueManager(
 		taskQueueKind:        taskQueueKind,
 		logger:               logger,
 		db:                   db,
-		taskAckManager:       newAckManager(e.logger),
+		taskAckManager:       newPriorityAckManager(e.logger),
 		taskGC:               newTaskGC(db, taskQueueConfig),
 		config:               taskQueueConfig,
 		pollerHistory:        newPollerHistory(),
@@ -231,6 +245,7 @@ func newTaskQueueManager(
 		namespace:            nsName,
 		taggedMetricsHandler: taggedMetricsHandler,
 		initializedError:     future.NewFuture[struct{}](),
+		addRate:              newRateCounter(clock.NewRealTimeSource()),
 		metadataInitialFetch: future.NewFuture[struct{}](),
 		metadataPoller: metadataPoller{
 			running:           uberatomic.NewBool(false),
@@ -252,7 +267,8 @@ func newTaskQueueManager(
 	if tlMgr.isFowardingAllowed(taskQueue, taskQueueKind) {
 		fwdr = newForwarder(&taskQueueConfig.forwarderConfig, taskQueue, taskQueueKind, e.matchingClient)
 	}
-	tlMgr.matcher = newTaskMatcher(taskQueueConfig, fwdr, tlMgr.taggedMetricsHandler)
+	tlMgr.matcher = newTaskMatcher(taskQueueConfig, fwdr, tlMgr.taskReader.backlog, tlMgr.taggedMetricsHandler)
+	tlMgr.partitionScaler = newPartitionScaler(tlMgr, taskQueueConfig, fwdr)
 	for _, opt := range opts {
 		opt(tlMgr)
 	}
@@ -287,6 +303,7 @@ func (c *taskQueueManagerImpl) Start() {
 	c.liveness.Start()
 	c.taskWriter.Start()
 	c.taskReader.Start()
+	c.partitionScaler.Start()
 	go c.fetchMetadataFromRootPartitionOnInit(context.TODO())
 	c.logger.Info("", tag.LifeCycleStarted)
 	c.taggedMetricsHandler.Counter(metrics.TaskQueueStartedCounter.GetMetricName()).Record(1)
@@ -314,6 +331,7 @@ func (c *taskQueueManagerImpl) Stop() {
 		c.taskGC.RunNow(ctx, ackLevel)
 	}
 	c.metadataPoller.Stop()
+	c.partitionScaler.Stop()
 	c.liveness.Stop()
 	c.taskWriter.Stop()
 	c.taskReader.Stop()
@@ -342,6 +360,11 @@ func (c *taskQueueManagerImpl) AddTask(
 	if params.forwardedFrom == "" {
 		// request sent by history service
 		c.liveness.markAlive(time.Now())
+		c.partitionScaler.recordAdd()
+		if c.partitionScaler.redirectTask(ctx, params) {
+			return false, nil
+		}
+		c.addRate.Inc(1)
 	}
 
 	if c.QueueID().IsRoot() && !c.HasPollerAfter(time.Now().Add(-noPollerThreshold)) {
@@ -433,9 +456,12 @@ func (c *taskQueueManagerImpl) GetTask(
 		return c.matcher.PollForQuery(childCtx)
 	}
 
-	task, err := c.matcher.Poll(childCtx)
-	if err != nil {
-		return nil, err
+	task, redirected := c.partitionScaler.redirectPoll(childCtx)
+	if !redirected {
+		task, err = c.matcher.Poll(childCtx)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	task.namespace = c.namespace
@@ -578,6 +604,41 @@ func (c *taskQueueManagerImpl) DescribeTaskQueue(includeTaskQueueStatus bool) *m
 	return response
 }
 
+func (c *taskQueueManagerImpl) GetPartitionCounts() partitionCounts {
+	return c.partitionScaler.Counts()
+}
+
+func (c *taskQueueManagerImpl) GetBacklogStats(ctx context.Context) (*taskqueuespb.BacklogStats, error) {
+	// Tasks that were read from db but not yet acked are tracked by the ack manager. Tasks
+	// written after the read level are estimated from the task id gap, which is exact within
+	// the current task id block since ids are allocated sequentially. The read level is the
+	// lowest one of all priority levels, so tasks that a level read ahead of the others are
+	// counted twice.
+	readLevel := c.taskAckManager.getReadLevel()
+	taskIDBlock := rangeIDToTaskIDBlock(c.db.RangeID(), c.config.RangeSize)
+	if readLevel < taskIDBlock.start-1 {
+		readLevel = taskIDBlock.start - 1
+	}
+	unread := c.taskWriter.GetMaxReadLevel() - readLevel
+	if unread < 0 {
+		unread = 0
+	}
+
+	oldestTaskAge, err := c.taskReader.oldestTaskAge(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dispatchRate, syncMatchRatio := c.matcher.DispatchStats()
+	return &taskqueuespb.BacklogStats{
+		ApproximateBacklogCount: c.taskAckManager.getBacklogCountHint() + unread,
+		OldestTaskAge:           timestamp.DurationPtr(oldestTaskAge),
+		AddRate:                 c.addRate.Rate(),
+		DispatchRate:            dispatchRate,
+		SyncMatchRatio:          syncMatchRatio,
+	}, nil
+}
+
 func (c *taskQueueManagerImpl) String() string {
 	buf := new(bytes.Buffer)
 	if c.taskQueueID.taskType == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
@@ -589,7 +650,7 @@ func (c *taskQueueManagerImpl) String() string {
 	_, _ = fmt.Fprintf(buf, " task queue %v\n", c.taskQueueID.name)
 	_, _ = fmt.Fprintf(buf, "RangeID=%v\n", rangeID)
 	_, _ = fmt.Fprintf(buf, "TaskIDBlock=%+v\n", rangeIDToTaskIDBlock(rangeID, c.config.RangeSize))
-	_, _ = fmt.Fprintf(buf, "AckLevel=%v\n", c.taskAckManager.ackLevel)
+	_, _ = fmt.Fprintf(buf, "AckLevel=%v\n", c.taskAckManager.getAckLevel())
 	_, _ = fmt.Fprintf(buf, "MaxTaskID=%v\n", c.taskAckManager.getReadLevel())
 
 	return buf.String()
@@ -629,7 +690,7 @@ func (c *taskQueueManagerImpl) completeTask(task *persistencespb.AllocatedTaskIn
 		c.taskReader.Signal()
 	}
 
-	ackLevel := c.taskAckManager.completeTask(task.GetTaskId())
+	ackLevel := c.taskAckManager.completeTask(taskpriority.Level(task.Data.GetPriority()), task.GetTaskId())
 
 	// TODO: completeTaskFunc and task.finish() should take in a context
 	ctx, cancel := c.newIOContext()
@@ -41,6 +41,7 @@ import (
 	"github.com/stretchr/testify/require"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	"golang.org/x/exp/slices"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -48,9 +49,12 @@ import (
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/taskpriority"
+	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/internal/goro"
 )
 
@@ -118,9 +122,9 @@ func TestReadLevelForAllExpiredTasksInBatch(t *testing.T) {
 	tlm.db.rangeID = int64(1)
 	tlm.db.ackLevel = int64(0)
 	tlm.taskAckManager.setAckLevel(tlm.db.ackLevel)
-	tlm.taskAckManager.setReadLevel(tlm.db.ackLevel)
-	require.Equal(t, int64(0), tlm.taskAckManager.getAckLevel())
-	require.Equal(t, int64(0), tlm.taskAckManager.getReadLevel())
+	ackManager := tlm.taskAckManager.level(taskpriority.Level(taskpriority.Normal))
+	require.Equal(t, int64(0), ackManager.getAckLevel())
+	require.Equal(t, int64(0), ackManager.getReadLevel())
 
 	// Add all expired tasks
 	tasks := []*persistencespb.AllocatedTaskInfo{
@@ -141,8 +145,8 @@ func TestReadLevelForAllExpiredTasksInBatch(t *testing.T) {
 	}
 
 	require.NoError(t, tlm.taskReader.addTasksToBuffer(context.TODO(), tasks))
-	require.Equal(t, int64(0), tlm.taskAckManager.getAckLevel())
-	require.Equal(t, int64(12), tlm.taskAckManager.getReadLevel())
+	require.Equal(t, int64(0), ackManager.getAckLevel())
+	require.Equal(t, int64(12), ackManager.getReadLevel())
 
 	// Now add a mix of valid and expired tasks
 	require.NoError(t, tlm.taskReader.addTasksToBuffer(context.TODO(), []*persistencespb.AllocatedTaskInfo{
@@ -161,8 +165,8 @@ func TestReadLevelForAllExpiredTasksInBatch(t *testing.T) {
 			TaskId: 14,
 		},
 	}))
-	require.Equal(t, int64(0), tlm.taskAckManager.getAckLevel())
-	require.Equal(t, int64(14), tlm.taskAckManager.getReadLevel())
+	require.Equal(t, int64(0), ackManager.getAckLevel())
+	require.Equal(t, int64(14), ackManager.getReadLevel())
 }
 
 type testIDBlockAlloc struct {
@@ -362,6 +366,188 @@ func TestIsTaskAddedRecently(t *testing.T) {
 	require.False(t, tlm.taskReader.isTaskAddedRecently(time.Time{}))
 }
 
+func TestGetBacklogStats_OldestTaskAge(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := mustCreateTestTaskQueueManager(t, controller)
+	tlm.db.rangeID = int64(1)
+	tlm.taskAckManager.setAckLevel(0)
+
+	stats, err := tlm.GetBacklogStats(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, timestamp.DurationValue(stats.GetOldestTaskAge()))
+
+	// the head of the persisted backlog is read when no task has been buffered yet
+	now := time.Now().UTC()
+	tm := tlm.db.store.(*testTaskManager)
+	tm.getTaskQueueManager(tlm.taskQueueID).tasks.Put(int64(1), &persistencespb.AllocatedTaskInfo{
+		TaskId: 1,
+		Data:   &persistencespb.TaskInfo{CreateTime: timestamp.TimePtr(now.Add(-time.Hour))},
+	})
+	atomic.StoreInt64(&tlm.taskWriter.maxReadLevel, 1)
+	stats, err = tlm.GetBacklogStats(context.Background())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, timestamp.DurationValue(stats.GetOldestTaskAge()), time.Hour)
+
+	// once buffered, the oldest task that is not acked yet is reported
+	ackManager := tlm.taskAckManager.level(taskpriority.Level(taskpriority.Normal))
+	ackManager.addTaskWithCreateTime(1, now.Add(-time.Hour))
+	ackManager.addTaskWithCreateTime(2, now.Add(-time.Minute))
+	atomic.StoreInt64(&tlm.taskWriter.maxReadLevel, 2)
+	stats, err = tlm.GetBacklogStats(context.Background())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, timestamp.DurationValue(stats.GetOldestTaskAge()), time.Hour)
+
+	ackManager.completeTask(1)
+	stats, err = tlm.GetBacklogStats(context.Background())
+	require.NoError(t, err)
+	age := timestamp.DurationValue(stats.GetOldestTaskAge())
+	require.GreaterOrEqual(t, age, time.Minute)
+	require.Less(t, age, time.Hour)
+
+	ackManager.completeTask(2)
+	stats, err = tlm.GetBacklogStats(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, timestamp.DurationValue(stats.GetOldestTaskAge()))
+}
+
+// Each priority level reads its own backlog, so a high priority task is dispatched ahead of older
+// normal priority tasks even when more than GetTasksBatchSize of them are in persistence.
+func TestBacklogPriority_ReadPerLevel(t *testing.T) {
+	for _, tc := range []struct {
+		name            string
+		batchSize       int
+		minHighPosition int
+		maxHighPosition int
+	}{
+		{name: "within window", batchSize: 10, minHighPosition: 0, maxHighPosition: 1},
+		{name: "beyond window", batchSize: 2, minHighPosition: 0, maxHighPosition: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var infos []*persistencespb.TaskInfo
+			for _, priority := range []int32{taskpriority.Normal, taskpriority.Normal, taskpriority.Normal, taskpriority.Normal, taskpriority.High} {
+				infos = append(infos, &persistencespb.TaskInfo{Priority: priority})
+			}
+			highPosition := slices.IndexFunc(backlogDispatchOrder(t, tc.batchSize, infos), func(info *persistencespb.TaskInfo) bool {
+				return info.GetPriority() == taskpriority.High
+			})
+			require.GreaterOrEqual(t, highPosition, tc.minHighPosition)
+			require.LessOrEqual(t, highPosition, tc.maxHighPosition)
+		})
+	}
+}
+
+// Unlike priority, fairness only applies to the backlog tasks that were read ahead into memory. A
+// key whose first task is behind more than GetTasksBatchSize tasks of another key waits until
+// they are read.
+func TestBacklogFairness_ReadAheadWindow(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		batchSize    int
+		minBPosition int
+		maxBPosition int
+	}{
+		{name: "within window", batchSize: 10, minBPosition: 0, maxBPosition: 1},
+		{name: "beyond window", batchSize: 2, minBPosition: 2, maxBPosition: 3},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var infos []*persistencespb.TaskInfo
+			for _, key := range []string{"a", "a", "a", "b"} {
+				infos = append(infos, &persistencespb.TaskInfo{FairnessKey: key})
+			}
+			bPosition := slices.IndexFunc(backlogDispatchOrder(t, tc.batchSize, infos), func(info *persistencespb.TaskInfo) bool {
+				return info.GetFairnessKey() == "b"
+			})
+			require.GreaterOrEqual(t, bPosition, tc.minBPosition)
+			require.LessOrEqual(t, bPosition, tc.maxBPosition)
+		})
+	}
+}
+
+// backlogDispatchOrder adds the tasks to the backlog of a task queue without pollers, waits until
+// each priority level read them ahead as far as batchSize allows, then polls them one at a time.
+func backlogDispatchOrder(t *testing.T, batchSize int, infos []*persistencespb.TaskInfo) []*persistencespb.TaskInfo {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.GetTasksBatchSize = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(batchSize)
+	cfg.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueueInfo(5 * time.Second)
+	tqCfg := defaultTqmTestOpts(controller)
+	tqCfg.config = cfg
+	tlm := mustCreateTestTaskQueueManagerWithConfig(t, controller, tqCfg)
+	tlm.Start()
+	defer tlm.Stop()
+
+	for _, info := range infos {
+		info.NamespaceId = defaultNamespaceId.String()
+		info.CreateTime = timestamp.TimePtr(time.Now().UTC())
+		_, err := tlm.AddTask(context.Background(), addTaskParams{
+			execution: &commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"},
+			taskInfo:  info,
+			source:    enumsspb.TASK_SOURCE_HISTORY,
+		})
+		require.NoError(t, err)
+	}
+	// one task is waiting for a poller, the others of the window are buffered
+	var levelCounts [taskpriority.NumLevels]int
+	for _, info := range infos {
+		levelCounts[taskpriority.Level(info.GetPriority())]++
+	}
+	window := 0
+	for _, count := range levelCounts {
+		window += util.Min(batchSize, count)
+	}
+	require.Eventually(t, func() bool {
+		return tlm.taskReader.numBufferedTasks()+1 >= window
+	}, time.Second, 10*time.Millisecond)
+
+	var order []*persistencespb.TaskInfo
+	for range infos {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		task, err := tlm.GetTask(ctx, &rpsInf)
+		cancel()
+		require.NoError(t, err)
+		order = append(order, task.event.Data)
+		task.finish(nil)
+	}
+	return order
+}
+
+func TestEmitFairnessBacklogMetrics(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := mustCreateTestTaskQueueManager(t, controller)
+	tlm.config.FairnessKeyWeights = func() map[string]any { return map[string]any{"tenanta": 2} }
+
+	recorded := make(map[string]float64)
+	metricsHandler := metrics.NewMockHandler(controller)
+	metricsHandler.EXPECT().WithTags(gomock.Any()).DoAndReturn(func(tags ...metrics.Tag) metrics.Handler {
+		tagged := metrics.NewMockHandler(controller)
+		tagged.EXPECT().Gauge(metrics.TaskBacklogPerFairnessKeyGauge.GetMetricName()).Return(
+			metrics.GaugeFunc(func(v float64, _ ...metrics.Tag) { recorded[tags[0].Value()] = v }),
+		)
+		return tagged
+	}).AnyTimes()
+	tlm.metricsHandler = metricsHandler
+
+	tlm.taskReader.backlog.add("tenanta")
+	tlm.taskReader.backlog.add("tenantb")
+	tlm.taskReader.backlog.add("tenantc")
+	tlm.taskReader.backlog.add("tenantc")
+	tlm.taskReader.emitFairnessBacklogMetrics()
+	// keys without a configured weight don't get their own series
+	require.Equal(t, map[string]float64{"tenanta": 1, otherFairnessKeysTagValue: 3}, recorded)
+
+	tlm.taskReader.backlog.remove("tenantb")
+	tlm.taskReader.backlog.remove("tenantc")
+	tlm.taskReader.backlog.remove("tenantc")
+	tlm.taskReader.emitFairnessBacklogMetrics()
+	require.Equal(t, map[string]float64{"tenanta": 1, otherFairnessKeysTagValue: 0}, recorded)
+}
+
 func TestDescribeTaskQueue(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -376,8 +562,15 @@ func TestDescribeTaskQueue(t *testing.T) {
 	tlm.db.ackLevel = int64(0)
 	tlm.taskAckManager.setAckLevel(tlm.db.ackLevel)
 
+	normalLevel := taskpriority.Level(taskpriority.Normal)
 	for i := int64(0); i < taskCount; i++ {
-		tlm.taskAckManager.addTask(startTaskID + i)
+		tlm.taskAckManager.level(normalLevel).addTask(startTaskID + i)
+	}
+	// the other priority levels read past the tasks without finding any of their own
+	for level := 0; level < taskpriority.NumLevels; level++ {
+		if level != normalLevel {
+			tlm.taskAckManager.level(level).setReadLevelAfterGap(taskCount)
+		}
 	}
 
 	includeTaskStatus := false
@@ -398,7 +591,7 @@ func TestDescribeTaskQueue(t *testing.T) {
 	// Add a poller and complete all tasks
 	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), nil)
 	for i := int64(0); i < taskCount; i++ {
-		tlm.taskAckManager.completeTask(startTaskID + i)
+		tlm.taskAckManager.completeTask(normalLevel, startTaskID+i)
 	}
 
 	descResp = tlm.DescribeTaskQueue(includeTaskStatus)
@@ -30,6 +30,8 @@ import (
 	"sync/atomic"
 	"time"
 
+	"golang.org/x/exp/slices"
+
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
@@ -38,6 +40,9 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/taskpriority"
+	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/internal/goro"
 	"go.temporal.io/server/service/worker/scanner/taskqueue"
 )
@@ -48,6 +53,13 @@ const (
 )
 
 type (
+	// readGroup is a set of priority levels that read the backlog from the same read level
+	readGroup struct {
+		readLevel int64
+		batchSize int
+		levels    []int
+	}
+
 	taskReader struct {
 		status     int32
 		taskBuffer chan *persistencespb.AllocatedTaskInfo // tasks loaded from persistence
@@ -58,22 +70,43 @@ type (
 		backoffTimerLock sync.Mutex
 		backoffTimer     *time.Timer
 		retrier          backoff.Retrier
+
+		// numPending is the number of tasks moved out of taskBuffer that are waiting to be dispatched
+		numPending int64
+		// bufferSlots bound the number of tasks of each priority level held in memory across
+		// taskBuffer, the pending priority queues and the task being dispatched
+		bufferSlots [taskpriority.NumLevels]chan struct{}
+		// picker decides which priority level of buffered tasks is dispatched next
+		picker *priorityPicker
+		// backlog counts the buffered tasks per fairness key
+		backlog *fairnessBacklog
+		// reportedFairnessKeys are the fairness keys whose backlog gauge was last emitted
+		// with a non-zero value
+		reportedFairnessKeys map[string]struct{}
 	}
 )
 
 func newTaskReader(tlMgr *taskQueueManagerImpl) *taskReader {
-	return &taskReader{
+	tr := &taskReader{
 		status:  common.DaemonStatusInitialized,
 		tlMgr:   tlMgr,
 		notifyC: make(chan struct{}, 1),
+		picker:  newPriorityPicker(tlMgr.config.PriorityStarvationInterval),
+		backlog: newFairnessBacklog(),
+
+		reportedFairnessKeys: make(map[string]struct{}),
 		// we always dequeue the head of the buffer and try to dispatch it to a poller
 		// so allocate one less than desired target buffer size
-		taskBuffer: make(chan *persistencespb.AllocatedTaskInfo, tlMgr.config.GetTasksBatchSize()-1),
+		taskBuffer: make(chan *persistencespb.AllocatedTaskInfo, taskpriority.NumLevels*tlMgr.config.GetTasksBatchSize()-1),
 		retrier: backoff.NewRetrier(
 			common.CreateReadTaskRetryPolicy(),
 			backoff.SystemClock,
 		),
 	}
+	for level := range tr.bufferSlots {
+		tr.bufferSlots[level] = make(chan struct{}, tlMgr.config.GetTasksBatchSize())
+	}
+	return tr
 }
 
 // Start reading pump for the given task queue.
@@ -115,42 +148,139 @@ func (tr *taskReader) Signal() {
 func (tr *taskReader) dispatchBufferedTasks(ctx context.Context) error {
 	ctx = tr.initContext(ctx)
 
-dispatchLoop:
+	// tasks are moved out of taskBuffer into per priority level queues so that higher
+	// priority tasks can be dispatched ahead of lower priority tasks read earlier, and
+	// tasks of the same priority that were read together are interleaved round-robin
+	// across fairness keys. Fairness keys are not read separately, so unlike priority they
+	// do not keep a key with a long backlog from delaying the others.
+	// Each priority level reads its own part of the backlog into its bufferSlots, so a
+	// level with a long backlog does not keep the tasks of the other levels unread.
+	var pending [taskpriority.NumLevels]*fairQueue
+	for i := range pending {
+		pending[i] = newFairQueue(tr.fairnessKeyWeight)
+	}
+	numPending := 0
+	maxPending := cap(tr.taskBuffer) + 1
+	addPending := func(taskInfo *persistencespb.AllocatedTaskInfo) {
+		pending[taskpriority.Level(taskInfo.Data.GetPriority())].push(taskInfo)
+		tr.backlog.add(taskInfo.Data.GetFairnessKey())
+		numPending++
+		atomic.AddInt64(&tr.numPending, 1)
+	}
+
 	for {
-		select {
-		case taskInfo, ok := <-tr.taskBuffer:
-			if !ok { // Task queue getTasks pump is shutdown
-				break dispatchLoop
-			}
-			task := newInternalTask(taskInfo, tr.tlMgr.completeTask, enumsspb.TASK_SOURCE_DB_BACKLOG, "", false)
-			for {
-				// We checked if the task was expired before putting it in the buffer, but it
-				// might have expired while it sat in the buffer, so we should check again.
-				if taskqueue.IsTaskExpired(taskInfo) {
-					task.finish(nil)
-					tr.taggedMetricsHandler().Counter(metrics.ExpiredTasksPerTaskQueueCounter.GetMetricName()).Record(1)
-					// Don't try to set read level here because it may have been advanced already.
-					break
-				}
-				err := tr.tlMgr.DispatchTask(ctx, task)
-				if err == nil {
-					break
+		if numPending == 0 {
+			select {
+			case taskInfo, ok := <-tr.taskBuffer:
+				if !ok { // Task queue getTasks pump is shutdown
+					return nil
 				}
-				if err == context.Canceled {
-					tr.tlMgr.logger.Info("Taskqueue manager context is cancelled, shutting down")
-					return err
+				addPending(taskInfo)
+			case <-ctx.Done():
+				return nil
+			}
+		}
+
+	drainLoop:
+		for numPending < maxPending {
+			select {
+			case taskInfo, ok := <-tr.taskBuffer:
+				if !ok {
+					break drainLoop
 				}
-				// this should never happen unless there is a bug - don't drop the task
-				tr.taggedMetricsHandler().Counter(metrics.BufferThrottlePerTaskQueueCounter.GetMetricName()).Record(1)
-				tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
-				time.Sleep(taskReaderOfferThrottleWait)
+				addPending(taskInfo)
+			default:
+				break drainLoop
 			}
+		}
 
-		case <-ctx.Done():
+		for _, level := range tr.picker.order() {
+			if pending[level].len() == 0 {
+				continue
+			}
+			taskInfo := pending[level].pop()
+			tr.backlog.remove(taskInfo.Data.GetFairnessKey())
+			numPending--
+			atomic.AddInt64(&tr.numPending, -1)
+			if err := tr.dispatchTask(ctx, taskInfo); err != nil {
+				return err
+			}
+			tr.releaseBufferSlot(level)
+			break
+		}
+	}
+}
+
+// releaseBufferSlot frees the slot of a dispatched task and signals the pump to read more tasks
+// of its level once half of the slots of the level are free.
+func (tr *taskReader) releaseBufferSlot(level int) {
+	<-tr.bufferSlots[level]
+	if len(tr.bufferSlots[level]) <= cap(tr.bufferSlots[level])/2 {
+		tr.Signal()
+	}
+}
+
+// numBufferedTasks returns the number of tasks loaded from persistence that are waiting to be
+// dispatched, excluding the task currently waiting for a poller.
+func (tr *taskReader) numBufferedTasks() int {
+	return len(tr.taskBuffer) + int(atomic.LoadInt64(&tr.numPending))
+}
+
+func (tr *taskReader) dispatchTask(ctx context.Context, taskInfo *persistencespb.AllocatedTaskInfo) error {
+	task := newInternalTask(taskInfo, tr.tlMgr.completeTask, enumsspb.TASK_SOURCE_DB_BACKLOG, "", false)
+
+	for {
+		// We checked if the task was expired before putting it in the buffer, but it
+		// might have expired while it sat in the buffer, so we should check again.
+		if taskqueue.IsTaskExpired(taskInfo) {
+			task.finish(nil)
+			tr.taggedMetricsHandler().Counter(metrics.ExpiredTasksPerTaskQueueCounter.GetMetricName()).Record(1)
+			// Don't try to set read level here because it may have been advanced already.
+			return nil
+		}
+		err := tr.tlMgr.DispatchTask(ctx, task)
+		if err == nil {
 			return nil
 		}
+		if err == context.Canceled {
+			tr.tlMgr.logger.Info("Taskqueue manager context is cancelled, shutting down")
+			return err
+		}
+		// this should never happen unless there is a bug - don't drop the task
+		tr.taggedMetricsHandler().Counter(metrics.BufferThrottlePerTaskQueueCounter.GetMetricName()).Record(1)
+		tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
+		time.Sleep(taskReaderOfferThrottleWait)
 	}
-	return nil
+}
+
+// oldestTaskAge returns the age of the oldest task of the backlog, whether it has been read into
+// the buffer or is still only persisted. It returns zero if the backlog is empty.
+func (tr *taskReader) oldestTaskAge(ctx context.Context) (time.Duration, error) {
+	ackManager := tr.tlMgr.taskAckManager
+	oldestTaskID, oldestCreateTime, ok := ackManager.getOldestTask()
+	// tasks after the lowest read level may not be read yet by their own priority level. Only
+	// the ones before the oldest buffered task can be older than it.
+	readLevel := ackManager.getReadLevel()
+	maxReadLevel := tr.tlMgr.taskWriter.GetMaxReadLevel()
+	if ok && oldestTaskID-1 < maxReadLevel {
+		maxReadLevel = oldestTaskID - 1
+	}
+	if maxReadLevel > readLevel {
+		response, err := tr.tlMgr.db.GetTasks(ctx, readLevel+1, maxReadLevel+1, tr.tlMgr.config.GetTasksBatchSize())
+		if err != nil {
+			return 0, err
+		}
+		for _, t := range response.Tasks {
+			if t.GetTaskId() > ackManager.level(taskpriority.Level(t.Data.GetPriority())).getReadLevel() {
+				oldestCreateTime, ok = timestamp.TimeValue(t.Data.GetCreateTime()), true
+				break
+			}
+		}
+	}
+	if !ok {
+		return 0, nil
+	}
+	return time.Since(oldestCreateTime), nil
 }
 
 func (tr *taskReader) getTasksPump(ctx context.Context) error {
@@ -178,32 +308,37 @@ Loop:
 			return nil
 
 		case <-tr.notifyC:
-			tasks, readLevel, isReadBatchDone, err := tr.getTaskBatch(ctx)
-			tr.tlMgr.signalIfFatal(err)
-			if err != nil {
-				// TODO: Should we ever stop retrying on db errors?
-				if common.IsResourceExhausted(err) {
-					tr.backoff(taskReaderThrottleRetryDelay)
-				} else {
-					tr.backoff(tr.retrier.NextBackOff())
+			more := false
+			for _, group := range tr.readGroups() {
+				tasks, readLevel, isReadBatchDone, err := tr.getTaskBatch(ctx, group.readLevel, group.batchSize, group.levels)
+				tr.tlMgr.signalIfFatal(err)
+				if err != nil {
+					// TODO: Should we ever stop retrying on db errors?
+					if common.IsResourceExhausted(err) {
+						tr.backoff(taskReaderThrottleRetryDelay)
+					} else {
+						tr.backoff(tr.retrier.NextBackOff())
+					}
+					continue Loop
 				}
-				continue Loop
-			}
-			tr.retrier.Reset()
+				tr.retrier.Reset()
 
-			if len(tasks) == 0 {
-				tr.tlMgr.taskAckManager.setReadLevelAfterGap(readLevel)
-				if !isReadBatchDone {
-					tr.Signal()
+				// only error here is due to context cancelation which we also
+				// handle above
+				if err := tr.addTasksToBuffer(ctx, tasks); err != nil {
+					continue Loop
+				}
+				for _, level := range group.levels {
+					tr.tlMgr.taskAckManager.level(level).setReadLevelAfterGap(readLevel)
+				}
+				if len(tasks) > 0 || !isReadBatchDone {
+					// There maybe more tasks. We yield now, but signal pump to check again later.
+					more = true
 				}
-				continue Loop
 			}
-
-			// only error here is due to context cancelation which we also
-			// handle above
-			_ = tr.addTasksToBuffer(ctx, tasks)
-			// There maybe more tasks. We yield now, but signal pump to check again later.
-			tr.Signal()
+			if more {
+				tr.Signal()
+			}
 
 		case <-updateAckTimer.C:
 			err := tr.persistAckLevel(ctx)
@@ -220,24 +355,54 @@ Loop:
 	}
 }
 
+// readGroups returns the priority levels that have free buffer slots, grouped by read level so
+// that levels which have read up to the same task read the rest of the backlog together. Only as
+// many tasks as all levels of a group have free slots for are read, so reading the backlog of one
+// level never waits for the tasks of another level to be dispatched.
+func (tr *taskReader) readGroups() []*readGroup {
+	var groups []*readGroup
+	byReadLevel := make(map[int64]*readGroup)
+	for level := taskpriority.NumLevels - 1; level >= 0; level-- {
+		free := cap(tr.bufferSlots[level]) - len(tr.bufferSlots[level])
+		if free == 0 {
+			continue
+		}
+		readLevel := tr.tlMgr.taskAckManager.level(level).getReadLevel()
+		group, ok := byReadLevel[readLevel]
+		if !ok {
+			group = &readGroup{readLevel: readLevel, batchSize: free}
+			byReadLevel[readLevel] = group
+			groups = append(groups, group)
+		}
+		group.levels = append(group.levels, level)
+		group.batchSize = util.Min(group.batchSize, free)
+	}
+	return groups
+}
+
 func (tr *taskReader) getTaskBatchWithRange(
 	ctx context.Context,
 	readLevel int64,
 	maxReadLevel int64,
+	batchSize int,
 ) ([]*persistencespb.AllocatedTaskInfo, error) {
-	response, err := tr.tlMgr.db.GetTasks(ctx, readLevel+1, maxReadLevel+1, tr.tlMgr.config.GetTasksBatchSize())
+	response, err := tr.tlMgr.db.GetTasks(ctx, readLevel+1, maxReadLevel+1, batchSize)
 	if err != nil {
 		return nil, err
 	}
 	return response.Tasks, err
 }
 
-// Returns a batch of tasks from persistence starting form current read level.
+// Returns a batch of tasks of the given priority levels from persistence starting from readLevel.
+// At most batchSize tasks of any priority level are read.
 // Also return a number that can be used to update readLevel
 // Also return a bool to indicate whether read is finished
-func (tr *taskReader) getTaskBatch(ctx context.Context) ([]*persistencespb.AllocatedTaskInfo, int64, bool, error) {
-	var tasks []*persistencespb.AllocatedTaskInfo
-	readLevel := tr.tlMgr.taskAckManager.getReadLevel()
+func (tr *taskReader) getTaskBatch(
+	ctx context.Context,
+	readLevel int64,
+	batchSize int,
+	levels []int,
+) ([]*persistencespb.AllocatedTaskInfo, int64, bool, error) {
 	maxReadLevel := tr.tlMgr.taskWriter.GetMaxReadLevel()
 
 	// counter i is used to break and let caller check whether taskqueue is still alive and need resume read.
@@ -246,17 +411,27 @@ func (tr *taskReader) getTaskBatch(ctx context.Context) ([]*persistencespb.Alloc
 		if upper > maxReadLevel {
 			upper = maxReadLevel
 		}
-		tasks, err := tr.getTaskBatchWithRange(ctx, readLevel, upper)
+		tasks, err := tr.getTaskBatchWithRange(ctx, readLevel, upper, batchSize)
 		if err != nil {
 			return nil, readLevel, true, err
 		}
-		// return as long as it grabs any tasks
+		// the batch may not hold all tasks of the range
 		if len(tasks) > 0 {
-			return tasks, upper, true, nil
+			upper = tasks[len(tasks)-1].GetTaskId()
+		}
+		var levelTasks []*persistencespb.AllocatedTaskInfo
+		for _, t := range tasks {
+			if slices.Contains(levels, taskpriority.Level(t.Data.GetPriority())) {
+				levelTasks = append(levelTasks, t)
+			}
+		}
+		// return as long as it grabs any tasks
+		if len(levelTasks) > 0 {
+			return levelTasks, upper, true, nil
 		}
 		readLevel = upper
 	}
-	return tasks, readLevel, readLevel == maxReadLevel, nil // caller will update readLevel when no task grabbed
+	return nil, readLevel, readLevel == maxReadLevel, nil // caller will update readLevel when no task grabbed
 }
 
 func (tr *taskReader) addTasksToBuffer(
@@ -268,7 +443,7 @@ func (tr *taskReader) addTasksToBuffer(
 			tr.taggedMetricsHandler().Counter(metrics.ExpiredTasksPerTaskQueueCounter.GetMetricName()).Record(1)
 			// Also increment readLevel for expired tasks otherwise it could result in
 			// looping over the same tasks if all tasks read in the batch are expired
-			tr.tlMgr.taskAckManager.setReadLevel(t.GetTaskId())
+			tr.tlMgr.taskAckManager.level(taskpriority.Level(t.Data.GetPriority())).setReadLevel(t.GetTaskId())
 			continue
 		}
 		if err := tr.addSingleTaskToBuffer(ctx, t); err != nil {
@@ -282,7 +457,15 @@ func (tr *taskReader) addSingleTaskToBuffer(
 	ctx context.Context,
 	task *persistencespb.AllocatedTaskInfo,
 ) error {
-	tr.tlMgr.taskAckManager.addTask(task.GetTaskId())
+	level := taskpriority.Level(task.Data.GetPriority())
+	tr.tlMgr.taskAckManager.level(level).addTaskWithCreateTime(task.GetTaskId(), timestamp.TimeValue(task.Data.GetCreateTime()))
+	// tasks leave taskBuffer as soon as the dispatcher can sort them by priority, so the
+	// buffer capacity alone does not bound how many tasks are read ahead
+	select {
+	case tr.bufferSlots[level] <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 	select {
 	case tr.taskBuffer <- task:
 		return nil
@@ -294,6 +477,7 @@ func (tr *taskReader) addSingleTaskToBuffer(
 func (tr *taskReader) persistAckLevel(ctx context.Context) error {
 	ackLevel := tr.tlMgr.taskAckManager.getAckLevel()
 	tr.emitTaskLagMetric(ackLevel)
+	tr.emitFairnessBacklogMetrics()
 	return tr.tlMgr.db.UpdateState(ctx, ackLevel)
 }
 
@@ -309,6 +493,36 @@ func (tr *taskReader) taggedMetricsHandler() metrics.Handler {
 	return tr.tlMgr.metricsHandler
 }
 
+func (tr *taskReader) fairnessKeyWeight(key string) int {
+	return fairnessKeyWeight(tr.tlMgr.config.FairnessKeyWeights(), key)
+}
+
+// emitFairnessBacklogMetrics reports the backlog per fairness key. Only keys that have a
+// configured weight are reported by name, the others are summed under one tag value so that
+// the number of series stays bounded.
+func (tr *taskReader) emitFairnessBacklogMetrics() {
+	weights := tr.tlMgr.config.FairnessKeyWeights()
+	counts := make(map[string]int64)
+	for key, count := range tr.backlog.snapshot() {
+		if _, ok := weights[key]; !ok {
+			key = otherFairnessKeysTagValue
+		}
+		counts[key] += count
+	}
+	for key, count := range counts {
+		tr.taggedMetricsHandler().WithTags(metrics.FairnessKeyTag(key)).
+			Gauge(metrics.TaskBacklogPerFairnessKeyGauge.GetMetricName()).Record(float64(count))
+		tr.reportedFairnessKeys[key] = struct{}{}
+	}
+	for key := range tr.reportedFairnessKeys {
+		if _, ok := counts[key]; !ok {
+			tr.taggedMetricsHandler().WithTags(metrics.FairnessKeyTag(key)).
+				Gauge(metrics.TaskBacklogPerFairnessKeyGauge.GetMetricName()).Record(0)
+			delete(tr.reportedFairnessKeys, key)
+		}
+	}
+}
+
 func (tr *taskReader) emitTaskLagMetric(ackLevel int64) {
 	// note: this metric is only an estimation for the lag.
 	// taskID in DB may not be continuous, especially when task list ownership changes.
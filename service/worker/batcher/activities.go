@@ -27,14 +27,18 @@ package batcher
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/activity"
 	sdkclient "go.temporal.io/sdk/client"
 	"golang.org/x/time/rate"
 
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -46,6 +50,9 @@ import (
 
 var (
 	errNamespaceMismatch = errors.New("namespace mismatch")
+	// errSkipped is returned for workflows the batch operation does not apply to, they are
+	// neither retried nor counted as failures
+	errSkipped = errors.New("workflow skipped")
 )
 
 type activities struct {
@@ -106,7 +113,7 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 	taskCh := make(chan taskDetail, pageSize)
 	respCh := make(chan error, pageSize)
 	for i := 0; i < a.getOperationConcurrency(batchParams.Concurrency); i++ {
-		go startTaskProcessor(ctx, batchParams, taskCh, respCh, rateLimiter, sdkClient, a.FrontendClient, metricsHandler, logger)
+		go startTaskProcessor(ctx, batchParams, a.namespaceID, taskCh, respCh, rateLimiter, sdkClient, a.FrontendClient, a.HistoryClient, metricsHandler, logger)
 	}
 
 	for {
@@ -136,6 +143,7 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 
 		succCount := 0
 		errCount := 0
+		skippedCount := 0
 		// wait for counters indicate this batch is done
 	Loop:
 		for {
@@ -143,10 +151,12 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 			case err := <-respCh:
 				if err == nil {
 					succCount++
+				} else if errors.Is(err, errSkipped) {
+					skippedCount++
 				} else {
 					errCount++
 				}
-				if succCount+errCount == batchCount {
+				if succCount+errCount+skippedCount == batchCount {
 					break Loop
 				}
 			case <-ctx.Done():
@@ -160,6 +170,7 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 		hbd.PageToken = resp.NextPageToken
 		hbd.SuccessCount += succCount
 		hbd.ErrorCount += errCount
+		hbd.SkippedCount += skippedCount
 		activity.RecordHeartbeat(ctx, hbd)
 
 		if len(hbd.PageToken) == 0 {
@@ -197,11 +208,13 @@ func (a *activities) getOperationConcurrency(concurrency int) int {
 func startTaskProcessor(
 	ctx context.Context,
 	batchParams BatchParams,
+	namespaceID namespace.ID,
 	taskCh chan taskDetail,
 	respCh chan error,
 	limiter *rate.Limiter,
 	sdkClient sdkclient.Client,
 	frontendClient workflowservice.WorkflowServiceClient,
+	historyClient historyservice.HistoryServiceClient,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) {
@@ -243,8 +256,30 @@ func startTaskProcessor(
 						})
 						return err
 					})
+			case BatchTypeReset:
+				err = processTask(ctx, limiter, task,
+					func(workflowID, runID string) error {
+						return resetWorkflow(ctx, frontendClient, batchParams, workflowID, runID)
+					})
+			case BatchTypeUpsertSearchAttributes:
+				err = processTask(ctx, limiter, task,
+					func(workflowID, runID string) error {
+						_, err := historyClient.UpsertWorkflowSearchAttributes(ctx, &historyservice.UpsertWorkflowSearchAttributesRequest{
+							NamespaceId: namespaceID.String(),
+							WorkflowExecution: &commonpb.WorkflowExecution{
+								WorkflowId: workflowID,
+								RunId:      runID,
+							},
+							SearchAttributes: batchParams.UpsertSearchAttributesParams.SearchAttributes,
+						})
+						return skipNotFound(err)
+					})
 			}
-			if err != nil {
+			if errors.Is(err, errSkipped) {
+				metricsHandler.Counter(metrics.BatcherProcessorSkipped.GetMetricName()).Record(1)
+				logger.Info("Skipped batch operation task", tag.Error(err))
+				respCh <- err
+			} else if err != nil {
 				metricsHandler.Counter(metrics.BatcherProcessorFailures.GetMetricName()).Record(1)
 				logger.Error("Failed to process batch operation task", tag.Error(err))
 
@@ -288,6 +323,104 @@ func processTask(
 	return nil
 }
 
+// resetWorkflow resets the given execution to the reset point selected by the batch params. Executions
+// that no longer exist or have no such reset point are skipped.
+func resetWorkflow(
+	ctx context.Context,
+	frontendClient workflowservice.WorkflowServiceClient,
+	batchParams BatchParams,
+	workflowID string,
+	runID string,
+) error {
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: workflowID,
+		RunId:      runID,
+	}
+	resetEventID, err := getResetEventID(ctx, frontendClient, batchParams.Namespace, execution, batchParams.ResetParams)
+	if err == nil {
+		_, err = frontendClient.ResetWorkflowExecution(ctx, &workflowservice.ResetWorkflowExecutionRequest{
+			Namespace:                 batchParams.Namespace,
+			WorkflowExecution:         execution,
+			Reason:                    batchParams.Reason,
+			WorkflowTaskFinishEventId: resetEventID,
+			RequestId:                 uuid.New(),
+			ResetReapplyType:          batchParams.ResetParams.ResetReapplyType,
+		})
+	}
+	return skipNotFound(err)
+}
+
+// getResetEventID returns the workflow task finish event ID to reset the given execution to.
+// An errSkipped error is returned if the execution has no such reset point.
+func getResetEventID(
+	ctx context.Context,
+	frontendClient workflowservice.WorkflowServiceClient,
+	namespace string,
+	execution *commonpb.WorkflowExecution,
+	resetParams ResetParams,
+) (int64, error) {
+	if resetParams.ResetType == ResetTypeBadBinary {
+		resp, err := frontendClient.DescribeWorkflowExecution(ctx, &workflowservice.DescribeWorkflowExecutionRequest{
+			Namespace: namespace,
+			Execution: execution,
+		})
+		if err != nil {
+			return 0, err
+		}
+		for _, point := range resp.GetWorkflowExecutionInfo().GetAutoResetPoints().GetPoints() {
+			if point.GetBinaryChecksum() == resetParams.BadBinaryChecksum && point.GetResettable() {
+				return point.GetFirstWorkflowTaskCompletedId(), nil
+			}
+		}
+		return 0, fmt.Errorf("%w: no resettable workflow task completed by the bad binary", errSkipped)
+	}
+
+	var resetEventID int64
+	var nextPageToken []byte
+	for {
+		resp, err := frontendClient.GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+			Namespace:     namespace,
+			Execution:     execution,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return 0, err
+		}
+		for _, event := range resp.GetHistory().GetEvents() {
+			if event.GetEventType() != enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED {
+				continue
+			}
+			switch resetParams.ResetType {
+			case ResetTypeFirstWorkflowTask:
+				return event.GetEventId(), nil
+			case ResetTypeBuildID:
+				buildID := event.GetWorkflowTaskCompletedEventAttributes().GetWorkerVersioningId().GetWorkerBuildId()
+				if buildID == resetParams.BuildID {
+					return event.GetEventId(), nil
+				}
+			default:
+				resetEventID = event.GetEventId()
+			}
+		}
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	if resetEventID == 0 {
+		return 0, fmt.Errorf("%w: no completed workflow task to reset to", errSkipped)
+	}
+	return resetEventID, nil
+}
+
+// skipNotFound reports a workflow that is not running or deleted as skipped instead of processed.
+func skipNotFound(err error) error {
+	if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
+		return fmt.Errorf("%w: %v", errSkipped, err)
+	}
+	return err
+}
+
 func isDone(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
@@ -0,0 +1,235 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/api/workflowservicemock/v1"
+	"go.temporal.io/sdk/mocks"
+	"go.temporal.io/sdk/testsuite"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/sdk"
+)
+
+func workflowTaskCompletedEvent(eventID int64, buildID string) *historypb.HistoryEvent {
+	return &historypb.HistoryEvent{
+		EventId:   eventID,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED,
+		Attributes: &historypb.HistoryEvent_WorkflowTaskCompletedEventAttributes{
+			WorkflowTaskCompletedEventAttributes: &historypb.WorkflowTaskCompletedEventAttributes{
+				WorkerVersioningId: &taskqueuepb.VersionId{WorkerBuildId: buildID},
+			},
+		},
+	}
+}
+
+func TestGetResetEventID(t *testing.T) {
+	history := &historypb.History{
+		Events: []*historypb.HistoryEvent{
+			{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+			workflowTaskCompletedEvent(4, "good"),
+			workflowTaskCompletedEvent(10, "bad"),
+			workflowTaskCompletedEvent(16, "bad"),
+		},
+	}
+	testCases := []struct {
+		name          string
+		resetParams   ResetParams
+		expectedID    int64
+		expectSkipped bool
+	}{
+		{name: "first workflow task", resetParams: ResetParams{ResetType: ResetTypeFirstWorkflowTask}, expectedID: 4},
+		{name: "last workflow task", resetParams: ResetParams{ResetType: ResetTypeLastWorkflowTask}, expectedID: 16},
+		{name: "build ID", resetParams: ResetParams{ResetType: ResetTypeBuildID, BuildID: "bad"}, expectedID: 10},
+		{name: "unknown build ID", resetParams: ResetParams{ResetType: ResetTypeBuildID, BuildID: "other"}, expectSkipped: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			frontendClient := workflowservicemock.NewMockWorkflowServiceClient(ctrl)
+			frontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).
+				Return(&workflowservice.GetWorkflowExecutionHistoryResponse{History: history}, nil)
+
+			eventID, err := getResetEventID(context.Background(), frontendClient, "test-namespace", &commonpb.WorkflowExecution{WorkflowId: "wid"}, tc.resetParams)
+			if tc.expectSkipped {
+				require.ErrorIs(t, err, errSkipped)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedID, eventID)
+		})
+	}
+}
+
+func TestGetResetEventID_BadBinary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	frontendClient := workflowservicemock.NewMockWorkflowServiceClient(ctrl)
+	frontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&workflowservice.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+				AutoResetPoints: &workflowpb.ResetPoints{
+					Points: []*workflowpb.ResetPointInfo{
+						{BinaryChecksum: "good", FirstWorkflowTaskCompletedId: 4, Resettable: true},
+						{BinaryChecksum: "bad", FirstWorkflowTaskCompletedId: 10, Resettable: true},
+					},
+				},
+			},
+		}, nil).Times(2)
+
+	execution := &commonpb.WorkflowExecution{WorkflowId: "wid"}
+	eventID, err := getResetEventID(context.Background(), frontendClient, "test-namespace", execution, ResetParams{
+		ResetType:         ResetTypeBadBinary,
+		BadBinaryChecksum: "bad",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), eventID)
+
+	_, err = getResetEventID(context.Background(), frontendClient, "test-namespace", execution, ResetParams{
+		ResetType:         ResetTypeBadBinary,
+		BadBinaryChecksum: "other",
+	})
+	require.ErrorIs(t, err, errSkipped)
+}
+
+func TestResetWorkflow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	frontendClient := workflowservicemock.NewMockWorkflowServiceClient(ctrl)
+	batchParams := BatchParams{
+		Namespace: "test-namespace",
+		Reason:    "test-reason",
+		ResetParams: ResetParams{
+			ResetType:        ResetTypeLastWorkflowTask,
+			ResetReapplyType: enumspb.RESET_REAPPLY_TYPE_NONE,
+		},
+	}
+
+	frontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).
+		Return(&workflowservice.GetWorkflowExecutionHistoryResponse{
+			History: &historypb.History{Events: []*historypb.HistoryEvent{workflowTaskCompletedEvent(4, "")}},
+		}, nil)
+	frontendClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *workflowservice.ResetWorkflowExecutionRequest, _ ...interface{}) (*workflowservice.ResetWorkflowExecutionResponse, error) {
+			require.Equal(t, "test-namespace", request.GetNamespace())
+			require.Equal(t, "wid", request.GetWorkflowExecution().GetWorkflowId())
+			require.Equal(t, "rid", request.GetWorkflowExecution().GetRunId())
+			require.Equal(t, int64(4), request.GetWorkflowTaskFinishEventId())
+			require.Equal(t, enumspb.RESET_REAPPLY_TYPE_NONE, request.GetResetReapplyType())
+			return &workflowservice.ResetWorkflowExecutionResponse{}, nil
+		})
+	require.NoError(t, resetWorkflow(context.Background(), frontendClient, batchParams, "wid", "rid"))
+
+	// the workflow was deleted after it was listed
+	frontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewNotFound("workflow not found"))
+	require.ErrorIs(t, resetWorkflow(context.Background(), frontendClient, batchParams, "wid", "rid"), errSkipped)
+
+	frontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewUnavailable("unavailable"))
+	err := resetWorkflow(context.Background(), frontendClient, batchParams, "wid", "rid")
+	require.Error(t, err)
+	require.False(t, errors.Is(err, errSkipped))
+}
+
+func TestBatchActivity_UpsertSearchAttributes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	sdkClient := &mocks.Client{}
+	clientFactory := sdk.NewMockClientFactory(ctrl)
+	historyClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+	clientFactory.EXPECT().NewClient(gomock.Any()).Return(sdkClient)
+
+	sdkClient.On("CountWorkflow", mock.Anything, mock.Anything).
+		Return(&workflowservice.CountWorkflowExecutionsResponse{Count: 2}, nil)
+	sdkClient.On("ListWorkflow", mock.Anything, mock.Anything).
+		Return(&workflowservice.ListWorkflowExecutionsResponse{
+			Executions: []*workflowpb.WorkflowExecutionInfo{
+				{Execution: &commonpb.WorkflowExecution{WorkflowId: "running", RunId: "rid1"}},
+				{Execution: &commonpb.WorkflowExecution{WorkflowId: "closed", RunId: "rid2"}},
+			},
+		}, nil)
+
+	searchAttributes := &commonpb.SearchAttributes{
+		IndexedFields: map[string]*commonpb.Payload{"CustomKeywordField": payload.EncodeString("value")},
+	}
+	historyClient.EXPECT().UpsertWorkflowSearchAttributes(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *historyservice.UpsertWorkflowSearchAttributesRequest, _ ...interface{}) (*historyservice.UpsertWorkflowSearchAttributesResponse, error) {
+			require.Equal(t, "test-namespace-id", request.GetNamespaceId())
+			require.Equal(t, searchAttributes, request.GetSearchAttributes())
+			if request.GetWorkflowExecution().GetWorkflowId() == "closed" {
+				return nil, serviceerror.NewNotFound("workflow execution already completed")
+			}
+			return &historyservice.UpsertWorkflowSearchAttributesResponse{}, nil
+		}).Times(2)
+
+	a := &activities{
+		activityDeps: activityDeps{
+			MetricsHandler: metrics.NoopMetricsHandler,
+			Logger:         log.NewNoopLogger(),
+			ClientFactory:  clientFactory,
+			HistoryClient:  historyClient,
+		},
+		namespace:   "test-namespace",
+		namespaceID: "test-namespace-id",
+		rps:         func(namespace string) int { return 100 },
+		concurrency: func(namespace string) int { return 1 },
+	}
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	env.RegisterActivity(a.BatchActivity)
+
+	result, err := env.ExecuteActivity(a.BatchActivity, setDefaultParams(BatchParams{
+		BatchType: BatchTypeUpsertSearchAttributes,
+		Reason:    "test-reason",
+		Namespace: "test-namespace",
+		Query:     "test-query",
+		UpsertSearchAttributesParams: UpsertSearchAttributesParams{
+			SearchAttributes: searchAttributes,
+		},
+	}))
+	require.NoError(t, err)
+	var hbd HeartBeatDetails
+	require.NoError(t, result.Get(&hbd))
+	require.Equal(t, int64(2), hbd.TotalEstimate)
+	require.Equal(t, 1, hbd.SuccessCount)
+	require.Equal(t, 0, hbd.ErrorCount)
+	require.Equal(t, 1, hbd.SkippedCount)
+}
@@ -62,6 +62,21 @@ const (
 	BatchTypeSignal = "signal"
 	// BatchTypeDelete is batch type for deleting workflows
 	BatchTypeDelete = "delete"
+	// BatchTypeReset is batch type for resetting workflows
+	BatchTypeReset = "reset"
+	// BatchTypeUpsertSearchAttributes is batch type for upserting search attributes of workflows
+	BatchTypeUpsertSearchAttributes = "upsert_search_attributes"
+)
+
+const (
+	// ResetTypeFirstWorkflowTask resets to the first completed workflow task
+	ResetTypeFirstWorkflowTask = "FirstWorkflowTask"
+	// ResetTypeLastWorkflowTask resets to the last completed workflow task
+	ResetTypeLastWorkflowTask = "LastWorkflowTask"
+	// ResetTypeBadBinary resets to the first workflow task completed by the bad binary
+	ResetTypeBadBinary = "BadBinary"
+	// ResetTypeBuildID resets to the first workflow task completed by a worker with the bad build ID
+	ResetTypeBuildID = "BuildID"
 )
 
 var (
@@ -92,6 +107,24 @@ type (
 	DeleteParams struct {
 	}
 
+	// ResetParams is the parameters for resetting workflow
+	ResetParams struct {
+		// ResetType selects the reset point, one of ResetTypeFirstWorkflowTask,
+		// ResetTypeLastWorkflowTask, ResetTypeBadBinary or ResetTypeBuildID
+		ResetType string
+		// BadBinaryChecksum is the binary checksum to reset before, only for ResetTypeBadBinary
+		BadBinaryChecksum string
+		// BuildID is the worker build ID to reset before, only for ResetTypeBuildID
+		BuildID string
+		// ResetReapplyType controls which events are reapplied after the reset point
+		ResetReapplyType enumspb.ResetReapplyType
+	}
+
+	// UpsertSearchAttributesParams is the parameters for upserting search attributes of workflow
+	UpsertSearchAttributesParams struct {
+		SearchAttributes *commonpb.SearchAttributes
+	}
+
 	// BatchParams is the parameters for batch operation workflow
 	BatchParams struct {
 		// Target namespace to execute batch operation
@@ -100,7 +133,7 @@ type (
 		Query string
 		// Reason for the operation
 		Reason string
-		// Supporting: signal,cancel,terminate
+		// Supporting: signal,cancel,terminate,delete,reset,upsert_search_attributes
 		BatchType string
 
 		// Below are all optional
@@ -112,6 +145,10 @@ type (
 		SignalParams SignalParams
 		// DeleteParams is params only for BatchTypeDelete
 		DeleteParams DeleteParams
+		// ResetParams is params only for BatchTypeReset
+		ResetParams ResetParams
+		// UpsertSearchAttributesParams is params only for BatchTypeUpsertSearchAttributes
+		UpsertSearchAttributesParams UpsertSearchAttributesParams
 		// RPS of processing. Default to DefaultRPS
 		// This is moving to dynamic config.
 		// TODO: Remove it from BatchParams after 1.19+
@@ -140,6 +177,8 @@ type (
 		SuccessCount int
 		// Number of workflows that give up due to errors.
 		ErrorCount int
+		// Number of workflows the operation does not apply to, e.g. workflows without a reset point.
+		SkippedCount int
 	}
 
 	taskDetail struct {
@@ -189,6 +228,7 @@ func BatchWorkflow(ctx workflow.Context, batchParams BatchParams) (HeartBeatDeta
 type BatchOperationStats struct {
 	NumSuccess int
 	NumFailure int
+	NumSkipped int
 }
 
 // attachBatchOperationStats attaches statistics on the number of individual successes and failures to the memo of
@@ -198,6 +238,7 @@ func attachBatchOperationStats(ctx workflow.Context, result HeartBeatDetails) er
 		BatchOperationStatsMemo: BatchOperationStats{
 			NumSuccess: result.SuccessCount,
 			NumFailure: result.ErrorCount,
+			NumSkipped: result.SkippedCount,
 		},
 	}
 	return workflow.UpsertMemo(ctx, memo)
@@ -216,13 +257,39 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide signal name")
 		}
 		return nil
-	case BatchTypeCancel, BatchTypeTerminate:
+	case BatchTypeReset:
+		return validateResetParams(params.ResetParams)
+	case BatchTypeUpsertSearchAttributes:
+		if len(params.UpsertSearchAttributesParams.SearchAttributes.GetIndexedFields()) == 0 {
+			return fmt.Errorf("must provide search attributes")
+		}
+		return nil
+	case BatchTypeCancel, BatchTypeTerminate, BatchTypeDelete:
 		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
 	}
 }
 
+func validateResetParams(params ResetParams) error {
+	switch params.ResetType {
+	case ResetTypeFirstWorkflowTask, ResetTypeLastWorkflowTask:
+		return nil
+	case ResetTypeBadBinary:
+		if params.BadBinaryChecksum == "" {
+			return fmt.Errorf("must provide bad binary checksum")
+		}
+		return nil
+	case ResetTypeBuildID:
+		if params.BuildID == "" {
+			return fmt.Errorf("must provide build ID")
+		}
+		return nil
+	default:
+		return fmt.Errorf("not supported reset type: %v", params.ResetType)
+	}
+}
+
 func setDefaultParams(params BatchParams) BatchParams {
 	if params.AttemptsOnRetryableError <= 1 {
 		params.AttemptsOnRetryableError = DefaultAttemptsOnRetryableError
@@ -87,3 +87,47 @@ func (s *batcherSuite) TestBatchWorkflow_ValidParams() {
 	err := s.env.GetWorkflowError()
 	s.Require().NoError(err)
 }
+
+func (s *batcherSuite) TestBatchWorkflow_ResetMissingBadBinary() {
+	s.env.ExecuteWorkflow(BatchWorkflow, BatchParams{
+		BatchType: BatchTypeReset,
+		Reason:    "test-reason",
+		Namespace: "test-namespace",
+		Query:     "test-query",
+		ResetParams: ResetParams{
+			ResetType: ResetTypeBadBinary,
+		},
+	})
+	err := s.env.GetWorkflowError()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "must provide bad binary checksum")
+}
+
+func (s *batcherSuite) TestBatchWorkflow_ResetUnsupportedType() {
+	s.env.ExecuteWorkflow(BatchWorkflow, BatchParams{
+		BatchType: BatchTypeReset,
+		Reason:    "test-reason",
+		Namespace: "test-namespace",
+		Query:     "test-query",
+		ResetParams: ResetParams{
+			ResetType: "LastContinuedAsNew",
+		},
+	})
+	err := s.env.GetWorkflowError()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "not supported reset type")
+}
+
+func (s *batcherSuite) TestBatchWorkflow_DeleteParams() {
+	var ac *activities
+	s.env.OnActivity(ac.BatchActivity, mock.Anything, mock.Anything).Return(HeartBeatDetails{}, nil)
+	s.env.OnUpsertMemo(mock.Anything).Return(nil).Once()
+	s.env.ExecuteWorkflow(BatchWorkflow, BatchParams{
+		BatchType: BatchTypeDelete,
+		Reason:    "test-reason",
+		Namespace: "test-namespace",
+		Query:     "test-query",
+	})
+	err := s.env.GetWorkflowError()
+	s.Require().NoError(err)
+}
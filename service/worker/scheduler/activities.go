@@ -266,6 +266,26 @@ func (a *activities) TerminateWorkflow(ctx context.Context, req *schedspb.Termin
 	return translateError(err, "TerminateWorkflowExecution")
 }
 
+func (a *activities) GetBlackoutCalendars(ctx context.Context, names []string) (BlackoutCalendars, error) {
+	// go to frontend instead of the namespace registry so that we see calendar changes that
+	// the registry cache hasn't caught up with yet.
+	res, err := a.FrontendClient.DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: a.namespace.String(),
+	})
+	if err != nil {
+		return nil, translateError(err, "DescribeNamespace")
+	}
+
+	all := BlackoutCalendarsFromNamespaceData(res.GetNamespaceInfo().GetData())
+	calendars := make(BlackoutCalendars, len(names))
+	for _, name := range names {
+		if data, ok := all[name]; ok {
+			calendars[name] = data
+		}
+	}
+	return calendars, nil
+}
+
 func errType(err error) string {
 	return reflect.TypeOf(err).Name()
 }
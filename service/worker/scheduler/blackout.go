@@ -0,0 +1,150 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	schedpb "go.temporal.io/api/schedule/v1"
+	"golang.org/x/exp/slices"
+
+	"go.temporal.io/server/common/codec"
+)
+
+// Blackout calendars
+
+// A blackout calendar is a named set of exclude calendars that is stored once per namespace,
+// in the namespace data, and can be referenced by any number of schedules. The value of the
+// namespace data entry is a ScheduleSpec in JSON, of which only ExcludeCalendar,
+// ExcludeStructuredCalendar and the timezone fields are used, e.g.:
+//
+//	{"timezoneName": "America/New_York", "excludeCalendar": [
+//	  {"comment": "Christmas", "month": "12", "dayOfMonth": "25", "hour": "*", "minute": "*", "second": "*"}
+//	]}
+//
+// A schedule references a blackout calendar with an ExcludeCalendar entry that has only its
+// comment set, to "blackout:" followed by the calendar name. References are kept as-is in the
+// canonical form of the spec. The scheduler workflow loads the calendars it references when it
+// starts, when its spec is updated, and when it gets a refresh signal, which is sent to all
+// schedules that reference a calendar when the calendar is updated. The scheduler workflow keeps
+// the names of the calendars it references in the TemporalScheduleBlackoutCalendars search
+// attribute so that the frontend can find those schedules.
+
+const (
+	// BlackoutCalendarDataKeyPrefix is the prefix of namespace data keys that hold blackout
+	// calendars. The rest of the key is the calendar name.
+	BlackoutCalendarDataKeyPrefix = "temporal.schedule.blackout."
+
+	blackoutCalendarRefPrefix = "blackout:"
+)
+
+var errBlackoutCalendarNotFound = errors.New("blackout calendar not found")
+
+type (
+	// BlackoutCalendars maps blackout calendar names to their namespace data values.
+	BlackoutCalendars map[string]string
+)
+
+// BlackoutCalendarRefs returns the names of the blackout calendars referenced by spec,
+// sorted and without duplicates.
+func BlackoutCalendarRefs(spec *schedpb.ScheduleSpec) []string {
+	var names []string
+	for _, cal := range spec.GetExcludeCalendar() {
+		if name, ok := blackoutCalendarRef(cal); ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
+// BlackoutCalendarsFromNamespaceData returns the blackout calendars in namespace data.
+func BlackoutCalendarsFromNamespaceData(data map[string]string) BlackoutCalendars {
+	calendars := make(BlackoutCalendars)
+	for key, value := range data {
+		if strings.HasPrefix(key, BlackoutCalendarDataKeyPrefix) {
+			calendars[strings.TrimPrefix(key, BlackoutCalendarDataKeyPrefix)] = value
+		}
+	}
+	return calendars
+}
+
+// blackoutCalendarRef returns the name of the referenced blackout calendar if cal is a
+// reference.
+func blackoutCalendarRef(cal *schedpb.CalendarSpec) (string, bool) {
+	if !strings.HasPrefix(cal.Comment, blackoutCalendarRefPrefix) {
+		return "", false
+	}
+	ref := schedpb.CalendarSpec{Comment: cal.Comment}
+	if !cal.Equal(&ref) {
+		return "", false
+	}
+	return strings.TrimPrefix(cal.Comment, blackoutCalendarRefPrefix), true
+}
+
+// compile parses and compiles the named blackout calendar. The calendar is interpreted in
+// its own timezone if it has one, otherwise in tz.
+func (bc BlackoutCalendars) compile(name string, tz *time.Location) ([]*compiledCalendar, error) {
+	data := bc[name]
+	if data == "" {
+		return nil, fmt.Errorf("%w: %s", errBlackoutCalendarNotFound, name)
+	}
+
+	var spec schedpb.ScheduleSpec
+	if err := codec.NewJSONPBEncoder().Decode([]byte(data), &spec); err != nil {
+		return nil, fmt.Errorf("invalid blackout calendar %s: %w", name, err)
+	}
+
+	if spec.TimezoneName != "" || spec.TimezoneData != nil {
+		var err error
+		if tz, err = loadTimezone(&spec); err != nil {
+			return nil, fmt.Errorf("invalid blackout calendar %s: %w", name, err)
+		}
+	}
+
+	structured := spec.ExcludeStructuredCalendar
+	for _, cal := range spec.ExcludeCalendar {
+		if _, ok := blackoutCalendarRef(cal); ok {
+			return nil, fmt.Errorf("invalid blackout calendar %s: blackout calendars can't reference other calendars", name)
+		}
+		scs, err := parseCalendarToStructured(cal)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blackout calendar %s: %w", name, err)
+		}
+		structured = append(structured, scs)
+	}
+
+	compiled := make([]*compiledCalendar, len(structured))
+	for i, scs := range structured {
+		if err := validateStructuredCalendar(scs); err != nil {
+			return nil, fmt.Errorf("invalid blackout calendar %s: %w", name, err)
+		}
+		compiled[i] = newCompiledCalendar(scs, tz)
+	}
+	return compiled, nil
+}
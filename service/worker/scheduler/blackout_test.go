@@ -0,0 +1,102 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"time"
+
+	schedpb "go.temporal.io/api/schedule/v1"
+)
+
+const testBlackoutCalendar = `{"excludeCalendar": [
+	{"comment": "Christmas", "month": "12", "dayOfMonth": "25", "hour": "*", "minute": "*", "second": "*"},
+	{"comment": "New Year", "month": "1", "dayOfMonth": "1", "hour": "*", "minute": "*", "second": "*"}
+]}`
+
+func (s *specSuite) TestBlackoutCalendarRefs() {
+	spec := &schedpb.ScheduleSpec{
+		ExcludeCalendar: []*schedpb.CalendarSpec{
+			{Comment: "blackout:b"},
+			{Comment: "blackout:a"},
+			{Comment: "blackout:b"},
+			{Comment: "blackout:c", Hour: "5"}, // not a reference
+			{Comment: "other"},
+		},
+	}
+	s.Equal([]string{"a", "b"}, BlackoutCalendarRefs(spec))
+
+	canonical, err := canonicalizeSpec(spec)
+	s.NoError(err)
+	s.Equal(spec.ExcludeCalendar[:3], canonical.ExcludeCalendar)
+	s.Len(canonical.ExcludeStructuredCalendar, 2)
+
+	_, err = canonicalizeSpec(&schedpb.ScheduleSpec{
+		ExcludeCalendar: []*schedpb.CalendarSpec{{Comment: "blackout:"}},
+	})
+	s.Error(err)
+
+	s.Equal(BlackoutCalendars{"holidays": "data"}, BlackoutCalendarsFromNamespaceData(map[string]string{
+		"temporal.schedule.blackout.holidays": "data",
+		"other":                               "value",
+	}))
+}
+
+func (s *specSuite) TestSpecBlackoutCalendar() {
+	spec := &schedpb.ScheduleSpec{
+		Calendar: []*schedpb.CalendarSpec{
+			{Hour: "9", DayOfMonth: "*", Month: "*"},
+		},
+		ExcludeCalendar: []*schedpb.CalendarSpec{
+			{Comment: "blackout:holidays"},
+		},
+	}
+	start := time.Date(2022, 12, 24, 12, 0, 0, 0, time.UTC)
+
+	// without resolving the reference, nothing is excluded
+	cs, err := NewCompiledSpec(spec)
+	s.NoError(err)
+	s.Equal(time.Date(2022, 12, 25, 9, 0, 0, 0, time.UTC), cs.getNextTime(start).Next)
+
+	cs, err = NewCompiledSpecWithBlackouts(spec, BlackoutCalendars{"holidays": testBlackoutCalendar})
+	s.NoError(err)
+	next := cs.getNextTime(start).Next
+	s.Equal(time.Date(2022, 12, 26, 9, 0, 0, 0, time.UTC), next)
+	next = cs.getNextTime(time.Date(2022, 12, 31, 12, 0, 0, 0, time.UTC)).Next
+	s.Equal(time.Date(2023, 1, 2, 9, 0, 0, 0, time.UTC), next)
+
+	// the calendar's own timezone is used: 09:00 UTC is 18:00 in Tokyo
+	cs, err = NewCompiledSpecWithBlackouts(spec, BlackoutCalendars{
+		"holidays": `{"timezoneName": "Asia/Tokyo", "excludeCalendar": [{"month": "12", "dayOfMonth": "26", "hour": "0-12", "minute": "*", "second": "*"}]}`,
+	})
+	s.NoError(err)
+	s.Equal(time.Date(2022, 12, 26, 9, 0, 0, 0, time.UTC), cs.getNextTime(start.Add(24*time.Hour)).Next)
+
+	_, err = NewCompiledSpecWithBlackouts(spec, nil)
+	s.ErrorIs(err, errBlackoutCalendarNotFound)
+	_, err = NewCompiledSpecWithBlackouts(spec, BlackoutCalendars{"holidays": "not json"})
+	s.ErrorContains(err, "invalid blackout calendar holidays")
+	_, err = NewCompiledSpecWithBlackouts(spec, BlackoutCalendars{"holidays": `{"excludeCalendar": [{"comment": "blackout:other"}]}`})
+	s.ErrorContains(err, "can't reference other calendars")
+}
@@ -32,6 +32,7 @@ import (
 	"go.temporal.io/sdk/workflow"
 
 	"go.temporal.io/server/api/historyservice/v1"
+	schedspb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -46,8 +47,9 @@ const (
 
 type (
 	workerComponent struct {
-		activityDeps activityDeps
-		enabledForNs dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		activityDeps       activityDeps
+		enabledForNs       dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		pauseAfterFailures dynamicconfig.IntPropertyFnWithNamespaceFilter
 	}
 
 	activityDeps struct {
@@ -77,6 +79,8 @@ func NewResult(
 			activityDeps: params,
 			enabledForNs: dcCollection.GetBoolPropertyFnWithNamespaceFilter(
 				dynamicconfig.WorkerEnableScheduler, true),
+			pauseAfterFailures: dcCollection.GetIntPropertyFilteredByNamespace(
+				dynamicconfig.WorkerSchedulerPauseAfterFailures, 1),
 		},
 	}
 }
@@ -88,7 +92,11 @@ func (s *workerComponent) DedicatedWorkerOptions(ns *namespace.Namespace) *worke
 }
 
 func (s *workerComponent) Register(worker sdkworker.Worker, ns *namespace.Namespace) {
-	worker.RegisterWorkflowWithOptions(SchedulerWorkflow, workflow.RegisterOptions{Name: WorkflowType})
+	pauseAfterFailures := func() int { return s.pauseAfterFailures(ns.Name().String()) }
+	schedulerWorkflowForNs := func(ctx workflow.Context, args *schedspb.StartScheduleArgs) error {
+		return schedulerWorkflow(ctx, args, pauseAfterFailures)
+	}
+	worker.RegisterWorkflowWithOptions(schedulerWorkflowForNs, workflow.RegisterOptions{Name: WorkflowType})
 	worker.RegisterActivity(s.activities(ns.Name(), ns.ID()))
 }
 
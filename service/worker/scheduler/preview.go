@@ -0,0 +1,354 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	enumspb "go.temporal.io/api/enums/v1"
+	schedpb "go.temporal.io/api/schedule/v1"
+
+	schedspb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	// Maximum number of actions to return per Preview query.
+	maxPreviewActionCount = 1000
+)
+
+const (
+	PreviewResultStart                PreviewResult = "Start"
+	PreviewResultBuffered             PreviewResult = "Buffered"
+	PreviewResultSkippedOverlap       PreviewResult = "SkippedOverlap"
+	PreviewResultSkippedCatchupWindow PreviewResult = "SkippedCatchupWindow"
+	PreviewResultSkippedPaused        PreviewResult = "SkippedPaused"
+	PreviewResultCancelRunning        PreviewResult = "CancelRunning"
+	PreviewResultTerminateRunning     PreviewResult = "TerminateRunning"
+)
+
+type (
+	// PreviewRequest is the argument of the preview query. Nothing in the request is
+	// applied to the schedule.
+	PreviewRequest struct {
+		// Schedule to simulate instead of the current one, as if sent in an update. Optional.
+		Schedule *schedpb.Schedule
+		// Patch to simulate before any scheduled actions, e.g. a backfill. Optional.
+		Patch *schedpb.SchedulePatch
+		// Scheduled actions are simulated from the last processed time until EndTime.
+		EndTime *time.Time
+		// How long each workflow, including ones that are running now, is assumed to run
+		// for. If zero, workflows are assumed to complete right away and never overlap.
+		RunDuration time.Duration
+	}
+
+	// previewRequestJSON is the encoding of PreviewRequest. Protos with oneofs can't be
+	// encoded with encoding/json, so they are encoded with jsonpb.
+	previewRequestJSON struct {
+		Schedule    json.RawMessage `json:",omitempty"`
+		Patch       json.RawMessage `json:",omitempty"`
+		EndTime     *time.Time
+		RunDuration time.Duration
+	}
+
+	PreviewResponse struct {
+		Actions []*PreviewAction
+		// Set if the simulation stopped early after maxPreviewActionCount actions.
+		Truncated bool
+	}
+
+	PreviewAction struct {
+		// Simulated time of the action.
+		Time time.Time
+		// Times of the start the action is taken for. These are zero for
+		// CancelRunning and TerminateRunning.
+		NominalTime time.Time
+		ActualTime  time.Time
+		Manual      bool
+		Result      PreviewResult
+	}
+
+	PreviewResult string
+
+	// schedulePreview holds the simulated state of a scheduler used by the preview query.
+	schedulePreview struct {
+		now          time.Time
+		runDuration  time.Duration
+		runningUntil []time.Time
+		buffered     map[*schedspb.BufferedStart]bool
+		actions      []*PreviewAction
+		truncated    bool
+	}
+)
+
+func (r *PreviewRequest) MarshalJSON() ([]byte, error) {
+	encoder := codec.NewJSONPBEncoder()
+	enc := previewRequestJSON{EndTime: r.EndTime, RunDuration: r.RunDuration}
+	var err error
+	if r.Schedule != nil {
+		if enc.Schedule, err = encoder.Encode(r.Schedule); err != nil {
+			return nil, err
+		}
+	}
+	if r.Patch != nil {
+		if enc.Patch, err = encoder.Encode(r.Patch); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(enc)
+}
+
+func (r *PreviewRequest) UnmarshalJSON(data []byte) error {
+	encoder := codec.NewJSONPBEncoder()
+	var enc previewRequestJSON
+	if err := json.Unmarshal(data, &enc); err != nil {
+		return err
+	}
+	*r = PreviewRequest{EndTime: enc.EndTime, RunDuration: enc.RunDuration}
+	if len(enc.Schedule) > 0 {
+		r.Schedule = &schedpb.Schedule{}
+		if err := encoder.Decode(enc.Schedule, r.Schedule); err != nil {
+			return err
+		}
+	}
+	if len(enc.Patch) > 0 {
+		r.Patch = &schedpb.SchedulePatch{}
+		if err := encoder.Decode(enc.Patch, r.Patch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *scheduler) handlePreviewQuery(req *PreviewRequest) (*PreviewResponse, error) {
+	if req == nil || req.EndTime == nil {
+		return nil, errors.New("missing or invalid query")
+	}
+
+	p := s.newPreviewScheduler(req)
+	if p.cspec == nil {
+		return nil, errors.New("invalid schedule: " + p.Info.InvalidScheduleError)
+	}
+
+	if req.Patch != nil {
+		p.processPatch(req.Patch)
+	}
+
+	// This follows the main loop in run, but jumps from one wakeup to the next
+	// instead of sleeping, and doesn't process signals.
+	t1 := p.preview.now
+	endTime := timestamp.TimeValue(req.EndTime)
+	nextSleep, err := p.processTimeRange(t1, t1, enumspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED, false)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.previewBuffer()
+		if p.preview.truncated {
+			break
+		}
+		t2, ok := p.preview.nextWakeup(t1, nextSleep)
+		if !ok || t2.After(endTime) {
+			break
+		}
+		p.preview.advance(t2)
+		nextSleep, err = p.processTimeRange(t1, t2, enumspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED, false)
+		if err != nil {
+			return nil, err
+		}
+		t1 = t2
+	}
+
+	return &PreviewResponse{
+		Actions:   p.preview.actions,
+		Truncated: p.preview.truncated,
+	}, nil
+}
+
+// newPreviewScheduler returns a copy of the scheduler, with req applied, that simulates
+// actions instead of taking them.
+func (s *scheduler) newPreviewScheduler(req *PreviewRequest) *scheduler {
+	p := &scheduler{
+		StartScheduleArgs: schedspb.StartScheduleArgs{
+			Schedule: proto.Clone(s.Schedule).(*schedpb.Schedule),
+			Info:     proto.Clone(s.Info).(*schedpb.ScheduleInfo),
+			State:    proto.Clone(s.State).(*schedspb.InternalState),
+		},
+		ctx:        s.ctx,
+		logger:     log.NewSdkLogger(log.NewNoopLogger()),
+		cspec:      s.cspec,
+		blackouts:  s.blackouts,
+		tweakables: s.tweakables,
+		preview: &schedulePreview{
+			now:         timestamp.TimeValue(s.State.LastProcessedTime),
+			runDuration: req.RunDuration,
+			buffered:    make(map[*schedspb.BufferedStart]bool),
+		},
+	}
+
+	if req.Schedule != nil {
+		p.Schedule.Spec = req.Schedule.GetSpec()
+		p.Schedule.Action = req.Schedule.GetAction()
+		p.Schedule.Policies = req.Schedule.GetPolicies()
+		p.Schedule.State = req.Schedule.GetState()
+		p.ensureFields()
+		p.compileSpec()
+	}
+
+	for range p.Info.RunningWorkflows {
+		p.preview.addRunning()
+	}
+	return p
+}
+
+// previewBuffer is the counterpart of processBuffer for the preview query. Instead of
+// starting, cancelling and terminating workflows, it records the actions and updates the
+// simulated running workflows.
+func (s *scheduler) previewBuffer() {
+	if s.Schedule.Action.GetStartWorkflow() == nil {
+		s.State.BufferedStarts = nil
+		return
+	}
+
+	for len(s.State.BufferedStarts) > 0 {
+		isRunning := len(s.preview.runningUntil) > 0
+		action := processBuffer(s.State.BufferedStarts, isRunning, s.resolveOverlapPolicy)
+
+		allStarts := action.overlappingStarts
+		if action.nonOverlappingStart != nil {
+			allStarts = append(allStarts, action.nonOverlappingStart)
+		}
+		started := make(map[*schedspb.BufferedStart]bool)
+		for _, start := range allStarts {
+			started[start] = true
+		}
+		buffered := make(map[*schedspb.BufferedStart]bool)
+		for _, start := range action.newBuffer {
+			buffered[start] = true
+		}
+
+		// record actions in buffer order
+		for _, start := range s.State.BufferedStarts {
+			switch {
+			case started[start]:
+				if !s.canTakeScheduledAction(start.Manual, true) {
+					s.preview.recordStart(start, PreviewResultSkippedPaused)
+					continue
+				}
+				s.preview.recordStart(start, PreviewResultStart)
+				s.preview.addRunning()
+			case buffered[start]:
+				if !s.preview.buffered[start] {
+					s.preview.buffered[start] = true
+					s.preview.recordStart(start, PreviewResultBuffered)
+				}
+			default:
+				s.preview.recordStart(start, PreviewResultSkippedOverlap)
+			}
+		}
+		s.State.BufferedStarts = action.newBuffer
+
+		// Cancelled and terminated workflows are assumed to close right away, so the
+		// buffer can be processed again.
+		if action.needTerminate {
+			s.preview.record(time.Time{}, time.Time{}, false, PreviewResultTerminateRunning)
+		} else if action.needCancel {
+			s.preview.record(time.Time{}, time.Time{}, false, PreviewResultCancelRunning)
+		} else {
+			return
+		}
+		s.preview.runningUntil = nil
+	}
+}
+
+func (p *schedulePreview) addRunning() {
+	if p.runDuration > 0 {
+		p.runningUntil = append(p.runningUntil, p.now.Add(p.runDuration))
+	}
+}
+
+// nextWakeup returns the time the scheduler would wake up after t: either the next
+// scheduled time or the time the first running workflow closes.
+func (p *schedulePreview) nextWakeup(t time.Time, nextSleep time.Duration) (time.Time, bool) {
+	var wakeup time.Time
+	if nextSleep != invalidDuration {
+		wakeup = t.Add(nextSleep)
+	}
+	for _, until := range p.runningUntil {
+		if wakeup.IsZero() || until.Before(wakeup) {
+			wakeup = until
+		}
+	}
+	return wakeup, !wakeup.IsZero()
+}
+
+// advance moves the simulated time to t and closes the workflows that are done by then.
+func (p *schedulePreview) advance(t time.Time) {
+	p.now = t
+	running := p.runningUntil[:0]
+	for _, until := range p.runningUntil {
+		if until.After(t) {
+			running = append(running, until)
+		}
+	}
+	p.runningUntil = running
+}
+
+// full returns true, and marks the preview as truncated, if there are too many actions
+// or buffered starts to continue the simulation.
+func (p *schedulePreview) full(numBuffered int) bool {
+	if len(p.actions) >= maxPreviewActionCount || numBuffered >= maxPreviewActionCount {
+		p.truncated = true
+	}
+	return p.truncated
+}
+
+func (p *schedulePreview) recordStart(start *schedspb.BufferedStart, result PreviewResult) {
+	p.record(timestamp.TimeValue(start.NominalTime), timestamp.TimeValue(start.ActualTime), start.Manual, result)
+}
+
+// record adds an action to the preview. It's a no-op if p is nil, so it can be called
+// from code shared with the scheduler workflow.
+func (p *schedulePreview) record(nominalTime, actualTime time.Time, manual bool, result PreviewResult) {
+	if p == nil {
+		return
+	}
+	if len(p.actions) >= maxPreviewActionCount {
+		p.truncated = true
+		return
+	}
+	p.actions = append(p.actions, &PreviewAction{
+		Time:        p.now,
+		NominalTime: nominalTime,
+		ActualTime:  actualTime,
+		Manual:      manual,
+		Result:      result,
+	})
+}
@@ -0,0 +1,156 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	schedpb "go.temporal.io/api/schedule/v1"
+
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+func (s *workflowSuite) preview(req *PreviewRequest) (*PreviewResponse, error) {
+	encoded, err := s.env.QueryWorkflow(QueryNamePreview, req)
+	if err != nil {
+		return nil, err
+	}
+	var resp PreviewResponse
+	s.NoError(encoded.Get(&resp))
+	return &resp, nil
+}
+
+func (s *workflowSuite) TestPreviewBackfill() {
+	// written using low-level mocks since no workflows are started
+
+	s.env.RegisterDelayedCallback(func() {
+		resp, err := s.preview(&PreviewRequest{
+			Patch: &schedpb.SchedulePatch{
+				BackfillRequest: []*schedpb.BackfillRequest{{
+					StartTime:     timestamp.TimePtr(time.Date(2022, 5, 31, 0, 0, 0, 0, time.UTC)),
+					EndTime:       timestamp.TimePtr(time.Date(2022, 5, 31, 3, 0, 0, 0, time.UTC)),
+					OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ONE,
+				}},
+			},
+			EndTime:     timestamp.TimePtr(time.Date(2022, 6, 1, 3, 30, 0, 0, time.UTC)),
+			RunDuration: 90 * time.Minute,
+		})
+		s.NoError(err)
+		s.False(resp.Truncated)
+
+		type action struct {
+			at, nominal time.Time
+			manual      bool
+			result      PreviewResult
+		}
+		expected := []action{
+			{baseStartTime, time.Date(2022, 5, 31, 1, 0, 0, 0, time.UTC), true, PreviewResultStart},
+			{baseStartTime, time.Date(2022, 5, 31, 2, 0, 0, 0, time.UTC), true, PreviewResultBuffered},
+			{baseStartTime, time.Date(2022, 5, 31, 3, 0, 0, 0, time.UTC), true, PreviewResultSkippedOverlap},
+			{time.Date(2022, 6, 1, 1, 0, 0, 0, time.UTC), time.Date(2022, 6, 1, 1, 0, 0, 0, time.UTC), false, PreviewResultSkippedOverlap},
+			{time.Date(2022, 6, 1, 1, 30, 0, 0, time.UTC), time.Date(2022, 5, 31, 2, 0, 0, 0, time.UTC), true, PreviewResultStart},
+			{time.Date(2022, 6, 1, 2, 0, 0, 0, time.UTC), time.Date(2022, 6, 1, 2, 0, 0, 0, time.UTC), false, PreviewResultSkippedOverlap},
+			{time.Date(2022, 6, 1, 3, 0, 0, 0, time.UTC), time.Date(2022, 6, 1, 3, 0, 0, 0, time.UTC), false, PreviewResultStart},
+		}
+		s.Require().Equal(len(expected), len(resp.Actions))
+		for i, a := range expected {
+			s.True(a.at.Equal(resp.Actions[i].Time), "action %d: %v != %v", i, a.at, resp.Actions[i].Time)
+			s.True(a.nominal.Equal(resp.Actions[i].NominalTime), "action %d: %v != %v", i, a.nominal, resp.Actions[i].NominalTime)
+			s.Equal(a.manual, resp.Actions[i].Manual)
+			s.Equal(a.result, resp.Actions[i].Result)
+		}
+
+		// the preview doesn't change the schedule
+		desc := s.describe()
+		s.Equal(int64(0), desc.Info.ActionCount)
+		s.Equal(int64(0), desc.Info.OverlapSkipped)
+		s.Empty(desc.Info.RecentActions)
+	}, 1*time.Minute)
+
+	s.run(&schedpb.Schedule{
+		Spec: &schedpb.ScheduleSpec{
+			Interval: []*schedpb.IntervalSpec{{
+				Interval: timestamp.DurationPtr(1 * time.Hour),
+			}},
+		},
+		Policies: &schedpb.SchedulePolicies{
+			OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_SKIP,
+		},
+	}, 1)
+	s.True(s.env.IsWorkflowCompleted())
+}
+
+func (s *workflowSuite) TestPreviewUpdate() {
+	// written using low-level mocks since no workflows are started
+
+	s.env.RegisterDelayedCallback(func() {
+		resp, err := s.preview(&PreviewRequest{
+			Schedule: &schedpb.Schedule{
+				Spec: &schedpb.ScheduleSpec{
+					Interval: []*schedpb.IntervalSpec{{
+						Interval: timestamp.DurationPtr(30 * time.Minute),
+					}},
+				},
+				Action: s.defaultAction("myid"),
+				State: &schedpb.ScheduleState{
+					LimitedActions:   true,
+					RemainingActions: 1,
+				},
+			},
+			EndTime: timestamp.TimePtr(time.Date(2022, 6, 1, 1, 0, 0, 0, time.UTC)),
+		})
+		s.NoError(err)
+		s.Require().Equal(2, len(resp.Actions))
+		s.True(time.Date(2022, 6, 1, 0, 30, 0, 0, time.UTC).Equal(resp.Actions[0].NominalTime))
+		s.Equal(PreviewResultStart, resp.Actions[0].Result)
+		s.True(time.Date(2022, 6, 1, 1, 0, 0, 0, time.UTC).Equal(resp.Actions[1].NominalTime))
+		s.Equal(PreviewResultSkippedPaused, resp.Actions[1].Result)
+
+		_, err = s.preview(&PreviewRequest{
+			Schedule: &schedpb.Schedule{
+				Spec: &schedpb.ScheduleSpec{
+					Calendar: []*schedpb.CalendarSpec{{
+						Month: "juneuary",
+					}},
+				},
+			},
+			EndTime: timestamp.TimePtr(time.Date(2022, 6, 1, 1, 0, 0, 0, time.UTC)),
+		})
+		s.ErrorContains(err, "invalid schedule")
+
+		_, err = s.preview(&PreviewRequest{})
+		s.Error(err)
+	}, 1*time.Minute)
+
+	s.run(&schedpb.Schedule{
+		Spec: &schedpb.ScheduleSpec{
+			Interval: []*schedpb.IntervalSpec{{
+				Interval: timestamp.DurationPtr(1 * time.Hour),
+			}},
+		},
+	}, 1)
+	s.True(s.env.IsWorkflowCompleted())
+}
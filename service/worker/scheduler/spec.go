@@ -52,7 +52,23 @@ type (
 	}
 )
 
+// NewCompiledSpec compiles spec without resolving references to blackout calendars. This is
+// enough to validate and canonicalize a spec, but the resulting CompiledSpec doesn't exclude
+// times in referenced blackout calendars.
 func NewCompiledSpec(spec *schedpb.ScheduleSpec) (*CompiledSpec, error) {
+	return newCompiledSpec(spec, nil)
+}
+
+// NewCompiledSpecWithBlackouts compiles spec, resolving references to blackout calendars in
+// blackouts. It returns an error if a referenced calendar is missing or invalid.
+func NewCompiledSpecWithBlackouts(spec *schedpb.ScheduleSpec, blackouts BlackoutCalendars) (*CompiledSpec, error) {
+	if blackouts == nil {
+		blackouts = BlackoutCalendars{}
+	}
+	return newCompiledSpec(spec, blackouts)
+}
+
+func newCompiledSpec(spec *schedpb.ScheduleSpec, blackouts BlackoutCalendars) (*CompiledSpec, error) {
 	spec, err := canonicalizeSpec(spec)
 	if err != nil {
 		return nil, err
@@ -76,6 +92,17 @@ func NewCompiledSpec(spec *schedpb.ScheduleSpec) (*CompiledSpec, error) {
 		excludes[i] = newCompiledCalendar(excal, tz)
 	}
 
+	// resolve blackout calendars
+	if blackouts != nil {
+		for _, name := range BlackoutCalendarRefs(spec) {
+			compiled, err := blackouts.compile(name, tz)
+			if err != nil {
+				return nil, err
+			}
+			excludes = append(excludes, compiled...)
+		}
+	}
+
 	cspec := &CompiledSpec{
 		spec:     spec,
 		tz:       tz,
@@ -101,15 +128,23 @@ func canonicalizeSpec(spec *schedpb.ScheduleSpec) (*schedpb.ScheduleSpec, error)
 	}
 	spec.Calendar = nil
 
-	// parse ExcludeCalendars
+	// parse ExcludeCalendars, keeping references to blackout calendars
+	var blackoutRefs []*schedpb.CalendarSpec
 	for _, cal := range spec.ExcludeCalendar {
+		if name, ok := blackoutCalendarRef(cal); ok {
+			if name == "" {
+				return nil, errors.New("blackout calendar reference is missing a name")
+			}
+			blackoutRefs = append(blackoutRefs, cal)
+			continue
+		}
 		structured, err := parseCalendarToStructured(cal)
 		if err != nil {
 			return nil, err
 		}
 		spec.ExcludeStructuredCalendar = append(spec.ExcludeStructuredCalendar, structured)
 	}
-	spec.ExcludeCalendar = nil
+	spec.ExcludeCalendar = blackoutRefs
 
 	// parse CronStrings
 	const unset = "__unset__"
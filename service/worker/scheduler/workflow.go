@@ -60,9 +60,12 @@ const (
 	SignalNameUpdate  = "update"
 	SignalNamePatch   = "patch"
 	SignalNameRefresh = "refresh"
+	// Sent by the admin UpdateSchedulePolicies API with the new InternalPolicies.
+	SignalNameUpdatePolicies = "update-policies"
 
 	QueryNameDescribe          = "describe"
 	QueryNameListMatchingTimes = "listMatchingTimes"
+	QueryNamePreview           = "preview"
 
 	MemoFieldInfo = "ScheduleInfo"
 
@@ -85,17 +88,29 @@ type (
 
 		cspec *CompiledSpec
 
+		// Blackout calendars referenced by the spec. These are loaded with an activity when
+		// the workflow starts and aren't carried over continue-as-new.
+		blackouts        BlackoutCalendars
+		refreshBlackouts bool
+
 		tweakables tweakablePolicies
+		// Per-namespace default for tweakables.PauseAfterFailures, may be nil. A schedule's
+		// own Policies.PauseAfterFailures takes precedence.
+		pauseAfterFailures func() int
 
 		// We might have zero or one long-poll watcher activity running. If so, these are set:
 		watchingWorkflowId string
 		watchingFuture     workflow.Future
 
 		// Signal requests
-		pendingPatch  *schedpb.SchedulePatch
-		pendingUpdate *schedspb.FullUpdateRequest
+		pendingPatch    *schedpb.SchedulePatch
+		pendingUpdate   *schedspb.FullUpdateRequest
+		pendingPolicies *schedspb.InternalPolicies
 
 		uuidBatch []string
+
+		// Set only on the copy of the scheduler used by the preview query
+		preview *schedulePreview
 	}
 
 	tweakablePolicies struct {
@@ -109,6 +124,7 @@ type (
 		RecentActionCountForList          int           // The number of recent actual action results to include in List (search attr).
 		IterationsBeforeContinueAsNew     int
 		SleepWhilePaused                  bool // If true, don't set timers while paused/out of actions
+		PauseAfterFailures                int  // Default consecutive failed runs before pausing, if pause-on-failure is set
 	}
 )
 
@@ -139,17 +155,23 @@ var (
 		RecentActionCountForList:          5,
 		IterationsBeforeContinueAsNew:     500,
 		SleepWhilePaused:                  true,
+		PauseAfterFailures:                1,
 	}
 
 	errUpdateConflict = errors.New("conflicting concurrent update")
 )
 
 func SchedulerWorkflow(ctx workflow.Context, args *schedspb.StartScheduleArgs) error {
+	return schedulerWorkflow(ctx, args, nil)
+}
+
+func schedulerWorkflow(ctx workflow.Context, args *schedspb.StartScheduleArgs, pauseAfterFailures func() int) error {
 	scheduler := &scheduler{
-		StartScheduleArgs: *args,
-		ctx:               ctx,
-		a:                 nil,
-		logger:            sdklog.With(workflow.GetLogger(ctx), "schedule-id", args.State.ScheduleId),
+		StartScheduleArgs:  *args,
+		ctx:                ctx,
+		a:                  nil,
+		logger:             sdklog.With(workflow.GetLogger(ctx), "schedule-id", args.State.ScheduleId),
+		pauseAfterFailures: pauseAfterFailures,
 	}
 	return scheduler.run()
 }
@@ -159,6 +181,7 @@ func (s *scheduler) run() error {
 
 	s.updateTweakables()
 	s.ensureFields()
+	s.loadBlackoutCalendars()
 	s.compileSpec()
 
 	if err := workflow.SetQueryHandler(s.ctx, QueryNameDescribe, s.handleDescribeQuery); err != nil {
@@ -167,6 +190,9 @@ func (s *scheduler) run() error {
 	if err := workflow.SetQueryHandler(s.ctx, QueryNameListMatchingTimes, s.handleListMatchingTimesQuery); err != nil {
 		return err
 	}
+	if err := workflow.SetQueryHandler(s.ctx, QueryNamePreview, s.handlePreviewQuery); err != nil {
+		return err
+	}
 
 	if s.State.LastProcessedTime == nil {
 		s.logger.Debug("Initializing internal state")
@@ -261,8 +287,26 @@ func (s *scheduler) ensureFields() {
 	}
 }
 
+func (s *scheduler) loadBlackoutCalendars() {
+	names := BlackoutCalendarRefs(s.Schedule.Spec)
+	if len(names) == 0 || s.preview != nil {
+		// the preview query can't run activities, it uses the calendars that are loaded
+		return
+	}
+
+	ctx := workflow.WithLocalActivityOptions(s.ctx, defaultLocalActivityOptions)
+	var blackouts BlackoutCalendars
+	err := workflow.ExecuteLocalActivity(ctx, s.a.GetBlackoutCalendars, names).Get(s.ctx, &blackouts)
+	if err != nil {
+		// keep using the calendars we have, if any
+		s.logger.Error("failed to load blackout calendars", "error", err)
+		return
+	}
+	s.blackouts = blackouts
+}
+
 func (s *scheduler) compileSpec() {
-	cspec, err := NewCompiledSpec(s.Schedule.Spec)
+	cspec, err := NewCompiledSpecWithBlackouts(s.Schedule.Spec, s.blackouts)
 	if err != nil {
 		s.logger.Error("Invalid schedule", "error", err)
 		s.Info.InvalidScheduleError = err.Error()
@@ -291,6 +335,9 @@ func (s *scheduler) now() time.Time {
 	// of this workflow: across a continue-as-new, since monotonicity isn't preserved
 	// there (as far as I know). We'll treat that the same since we keep track of the last
 	// processed schedule time.
+	if s.preview != nil {
+		return s.preview.now
+	}
 	return workflow.Now(s.ctx)
 }
 
@@ -337,10 +384,16 @@ func (s *scheduler) processTimeRange(
 	catchupWindow := s.getCatchupWindow()
 
 	for {
-		// Run this logic in a SideEffect so that we can fix bugs there without breaking
-		// existing schedule workflows.
 		var next getNextTimeResult
-		if err := workflow.SideEffect(s.ctx, func(ctx workflow.Context) interface{} {
+		if s.preview != nil {
+			// The preview runs in a query handler, which can't have side effects.
+			if s.preview.full(len(s.State.BufferedStarts)) {
+				return invalidDuration, nil
+			}
+			next = s.cspec.getNextTime(t1)
+		} else if err := workflow.SideEffect(s.ctx, func(ctx workflow.Context) interface{} {
+			// Run this logic in a SideEffect so that we can fix bugs there without breaking
+			// existing schedule workflows.
 			return s.cspec.getNextTime(t1)
 		}).Get(&next); err != nil {
 			return 0, err
@@ -354,11 +407,13 @@ func (s *scheduler) processTimeRange(
 		if !manual && t2.Sub(t1) > catchupWindow {
 			s.logger.Warn("Schedule missed catchup window", "now", t2, "time", t1)
 			s.Info.MissedCatchupWindow++
+			s.preview.record(next.Nominal, next.Next, manual, PreviewResultSkippedCatchupWindow)
 			continue
 		}
 		// Peek at paused/remaining actions state and don't even bother adding
 		// to buffer if we're not going to take an action now.
 		if !s.canTakeScheduledAction(manual, false) {
+			s.preview.record(next.Nominal, next.Next, manual, PreviewResultSkippedPaused)
 			continue
 		}
 		s.addStart(next.Nominal, next.Next, overlapPolicy, manual)
@@ -406,6 +461,11 @@ func (s *scheduler) sleep(nextSleep time.Duration) {
 	refreshCh := workflow.GetSignalChannel(s.ctx, SignalNameRefresh)
 	sel.AddReceive(refreshCh, s.handleRefreshSignal)
 
+	policiesCh := workflow.GetSignalChannel(s.ctx, SignalNameUpdatePolicies)
+	sel.AddReceive(policiesCh, func(ch workflow.ReceiveChannel, _ bool) {
+		ch.Receive(s.ctx, &s.pendingPolicies)
+	})
+
 	// if we're paused or out of actions, we don't need to wake up until we get an update
 	if s.tweakables.SleepWhilePaused && !s.canTakeScheduledAction(false, false) {
 		nextSleep = invalidDuration
@@ -449,28 +509,50 @@ func (s *scheduler) processWatcherResult(id string, f workflow.Future) {
 	}
 
 	// now we know it's not running, remove from running workflow list
+	var execution *commonpb.WorkflowExecution
 	match := func(ex *commonpb.WorkflowExecution) bool { return ex.WorkflowId == id }
 	if idx := slices.IndexFunc(s.Info.RunningWorkflows, match); idx >= 0 {
+		execution = s.Info.RunningWorkflows[idx]
 		s.Info.RunningWorkflows = slices.Delete(s.Info.RunningWorkflows, idx, idx+1)
 	} else {
 		s.logger.Error("closed workflow not found in running list", "workflow", id)
+		execution = &commonpb.WorkflowExecution{WorkflowId: id}
 	}
 
+	s.recordRunResult(execution, &res)
+
 	// handle pause-on-failure
 	failedStatus := res.Status == enumspb.WORKFLOW_EXECUTION_STATUS_FAILED ||
 		res.Status == enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT ||
 		(s.tweakables.CanceledTerminatedCountAsFailures &&
 			(res.Status == enumspb.WORKFLOW_EXECUTION_STATUS_CANCELED || res.Status == enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED))
-	pauseOnFailure := s.Schedule.Policies.PauseOnFailure && failedStatus && !s.Schedule.State.Paused
+	if failedStatus {
+		s.State.ConsecutiveFailures++
+	} else if res.Status == enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED {
+		s.State.ConsecutiveFailures = 0
+	}
+	pauseAfterFailures := s.getPauseAfterFailures()
+	pauseOnFailure := s.Schedule.Policies.PauseOnFailure && failedStatus && !s.Schedule.State.Paused &&
+		s.State.ConsecutiveFailures >= pauseAfterFailures
 	if pauseOnFailure {
 		s.Schedule.State.Paused = true
-		if res.Status == enumspb.WORKFLOW_EXECUTION_STATUS_FAILED {
-			s.Schedule.State.Notes = fmt.Sprintf("paused due to workflow failure: %s: %s", id, res.GetFailure().GetMessage())
-			s.logger.Info("paused due to workflow failure", "workflow", id, "message", res.GetFailure().GetMessage())
-		} else if res.Status == enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT {
-			s.Schedule.State.Notes = fmt.Sprintf("paused due to workflow timeout: %s", id)
-			s.logger.Info("paused due to workflow timeout", "workflow", id)
+		var reason string
+		switch res.Status {
+		case enumspb.WORKFLOW_EXECUTION_STATUS_FAILED:
+			reason = fmt.Sprintf("workflow failure: %s: %s", id, res.GetFailure().GetMessage())
+		case enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT:
+			reason = fmt.Sprintf("workflow timeout: %s", id)
+		case enumspb.WORKFLOW_EXECUTION_STATUS_CANCELED:
+			reason = fmt.Sprintf("workflow cancellation: %s", id)
+		case enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED:
+			reason = fmt.Sprintf("workflow termination: %s", id)
 		}
+		if pauseAfterFailures > 1 {
+			s.Schedule.State.Notes = fmt.Sprintf("paused after %d consecutive failures, last due to %s", s.State.ConsecutiveFailures, reason)
+		} else {
+			s.Schedule.State.Notes = "paused due to " + reason
+		}
+		s.logger.Info("paused due to workflow failure", "workflow", id, "status", res.Status, "consecutive-failures", s.State.ConsecutiveFailures)
 		s.incSeqNo()
 	}
 
@@ -486,6 +568,23 @@ func (s *scheduler) processWatcherResult(id string, f workflow.Future) {
 	s.logger.Info("started workflow finished", "workflow", id, "status", res.Status, "pause-after-failure", pauseOnFailure)
 }
 
+// recordRunResult adds the outcome of a closed run to the recent run results.
+func (s *scheduler) recordRunResult(execution *commonpb.WorkflowExecution, res *schedspb.WatchWorkflowResponse) {
+	result := &schedspb.RunResult{
+		Execution:      execution,
+		CloseTime:      timestamp.TimePtr(s.now()),
+		Status:         res.Status,
+		FailureMessage: res.GetFailure().GetMessage(),
+	}
+	for _, action := range s.Info.RecentActions {
+		if action.GetStartWorkflowResult().GetWorkflowId() == execution.WorkflowId &&
+			action.GetStartWorkflowResult().GetRunId() == execution.RunId {
+			result.ScheduleTime = action.ScheduleTime
+		}
+	}
+	s.State.RecentRunResults = util.SliceTail(append(s.State.RecentRunResults, result), s.tweakables.RecentActionCount)
+}
+
 func (s *scheduler) processUpdate(req *schedspb.FullUpdateRequest) {
 	if err := s.checkConflict(req.ConflictToken); err != nil {
 		s.logger.Warn("Update conflicted with concurrent change")
@@ -501,6 +600,7 @@ func (s *scheduler) processUpdate(req *schedspb.FullUpdateRequest) {
 	// don't touch Info
 
 	s.ensureFields()
+	s.loadBlackoutCalendars()
 	s.compileSpec()
 
 	s.Info.UpdateTime = timestamp.TimePtr(s.now())
@@ -513,6 +613,8 @@ func (s *scheduler) handleRefreshSignal(ch workflow.ReceiveChannel, _ bool) {
 	// If we're woken up by any signal, we'll pass through processBuffer before sleeping again.
 	// processBuffer will see this flag and refresh everything.
 	s.State.NeedRefresh = true
+	// A refresh is also sent when blackout calendars change.
+	s.refreshBlackouts = len(BlackoutCalendarRefs(s.Schedule.Spec)) > 0
 }
 
 func (s *scheduler) processSignals() bool {
@@ -526,6 +628,17 @@ func (s *scheduler) processSignals() bool {
 		s.pendingUpdate = nil
 		scheduleChanged = true
 	}
+	if s.pendingPolicies != nil {
+		s.logger.Info("Schedule policies update", "new-policies", s.pendingPolicies.String())
+		s.Policies = s.pendingPolicies
+		s.pendingPolicies = nil
+	}
+	if s.refreshBlackouts {
+		s.refreshBlackouts = false
+		s.loadBlackoutCalendars()
+		s.compileSpec()
+		scheduleChanged = true
+	}
 	return scheduleChanged
 }
 
@@ -553,9 +666,11 @@ func (s *scheduler) handleDescribeQuery() (*schedspb.DescribeResponse, error) {
 	infoCopy.FutureActionTimes = s.getFutureActionTimes(s.tweakables.FutureActionCount)
 
 	return &schedspb.DescribeResponse{
-		Schedule:      s.Schedule,
-		Info:          &infoCopy,
-		ConflictToken: s.State.ConflictToken,
+		Schedule:         s.Schedule,
+		Info:             &infoCopy,
+		ConflictToken:    s.State.ConflictToken,
+		RecentRunResults: s.State.RecentRunResults,
+		Policies:         s.Policies,
 	}, nil
 }
 
@@ -637,6 +752,22 @@ func (s *scheduler) updateMemoAndSearchAttributes() {
 			s.logger.Error("error updating search attributes", "error", err)
 		}
 	}
+
+	// The blackout calendar names let the frontend refresh only the schedules that reference a
+	// changed calendar. Schedules without references don't get the search attribute at all.
+	newCalendars := BlackoutCalendarRefs(s.Schedule.Spec)
+	currentCalendarsPayload := workflowInfo.SearchAttributes.GetIndexedFields()[searchattribute.TemporalScheduleBlackoutCalendars]
+	var currentCalendars []string
+	if (currentCalendarsPayload == nil && len(newCalendars) > 0) ||
+		(currentCalendarsPayload != nil && (payload.Decode(currentCalendarsPayload, &currentCalendars) != nil ||
+			!slices.Equal(currentCalendars, newCalendars))) {
+		err := workflow.UpsertSearchAttributes(s.ctx, map[string]interface{}{
+			searchattribute.TemporalScheduleBlackoutCalendars: newCalendars,
+		})
+		if err != nil {
+			s.logger.Error("error updating search attributes", "error", err)
+		}
+	}
 }
 
 func (s *scheduler) checkConflict(token int64) error {
@@ -648,13 +779,26 @@ func (s *scheduler) checkConflict(token int64) error {
 
 func (s *scheduler) updateTweakables() {
 	// Use MutableSideEffect so that we can change the defaults without breaking determinism.
-	get := func(ctx workflow.Context) interface{} { return currentTweakablePolicies }
+	get := func(ctx workflow.Context) interface{} {
+		tweakables := currentTweakablePolicies
+		if s.pauseAfterFailures != nil {
+			tweakables.PauseAfterFailures = s.pauseAfterFailures()
+		}
+		return tweakables
+	}
 	eq := func(a, b interface{}) bool { return a.(tweakablePolicies) == b.(tweakablePolicies) }
 	if err := workflow.MutableSideEffect(s.ctx, "tweakables", get, eq).Get(&s.tweakables); err != nil {
 		panic("can't decode tweakablePolicies:" + err.Error())
 	}
 }
 
+func (s *scheduler) getPauseAfterFailures() int64 {
+	if n := s.Policies.GetPauseAfterFailures(); n > 0 {
+		return n
+	}
+	return int64(util.Max(1, s.tweakables.PauseAfterFailures))
+}
+
 func (s *scheduler) getCatchupWindow() time.Duration {
 	cw := s.Schedule.Policies.CatchupWindow
 	if cw == nil {
@@ -27,6 +27,7 @@ package scheduler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -103,6 +104,10 @@ func (s *workflowSuite) defaultAction(id string) *schedpb.ScheduleAction {
 }
 
 func (s *workflowSuite) run(sched *schedpb.Schedule, iterations int) {
+	s.runWithPolicies(sched, nil, iterations)
+}
+
+func (s *workflowSuite) runWithPolicies(sched *schedpb.Schedule, policies *schedspb.InternalPolicies, iterations int) {
 	// test workflows will run until "completion", in our case that means until
 	// continue-as-new. we only need a small number of iterations to test, though.
 	currentTweakablePolicies.IterationsBeforeContinueAsNew = iterations
@@ -121,6 +126,7 @@ func (s *workflowSuite) run(sched *schedpb.Schedule, iterations int) {
 			ScheduleId:    "myschedule",
 			ConflictToken: InitialConflictToken,
 		},
+		Policies: policies,
 	})
 }
 
@@ -955,6 +961,160 @@ func (s *workflowSuite) TestPauseOnFailure() {
 	// doesn't end properly since it sleeps forever after pausing
 }
 
+func (s *workflowSuite) TestPauseAfterConsecutiveFailures() {
+	// written using low-level mocks so we can return failures
+
+	currentTweakablePolicies.PauseAfterFailures = 2
+	defer func() { currentTweakablePolicies.PauseAfterFailures = 1 }()
+
+	for _, minute := range []int{5, 10} {
+		id := fmt.Sprintf("myid-2022-06-01T00:%02d:00Z", minute)
+		s.expectStart(func(req *schedspb.StartWorkflowRequest) (*schedspb.StartWorkflowResponse, error) {
+			s.Equal(id, req.Request.WorkflowId)
+			return nil, nil
+		})
+		s.expectWatch(func(req *schedspb.WatchWorkflowRequest) (*schedspb.WatchWorkflowResponse, error) {
+			s.Equal(id, req.Execution.WorkflowId)
+			return &schedspb.WatchWorkflowResponse{
+				Status: enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+				ResultFailure: &schedspb.WatchWorkflowResponse_Failure{
+					Failure: &failurepb.Failure{Message: "oops " + id},
+				},
+			}, nil
+		})
+	}
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.False(desc.Schedule.State.Paused)
+		s.Require().Equal(1, len(desc.RecentRunResults))
+		s.Equal("myid-2022-06-01T00:05:00Z", desc.RecentRunResults[0].Execution.WorkflowId)
+		s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED, desc.RecentRunResults[0].Status)
+		s.Equal("oops myid-2022-06-01T00:05:00Z", desc.RecentRunResults[0].FailureMessage)
+		s.True(time.Date(2022, 6, 1, 0, 5, 0, 0, time.UTC).Equal(*desc.RecentRunResults[0].ScheduleTime))
+	}, 11*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.True(desc.Schedule.State.Paused)
+		s.Equal("paused after 2 consecutive failures, last due to workflow failure: myid-2022-06-01T00:10:00Z: oops myid-2022-06-01T00:10:00Z", desc.Schedule.State.Notes)
+		s.Equal(2, len(desc.RecentRunResults))
+	}, 16*time.Minute)
+
+	s.run(&schedpb.Schedule{
+		Spec: &schedpb.ScheduleSpec{
+			Interval: []*schedpb.IntervalSpec{{
+				Interval: timestamp.DurationPtr(5 * time.Minute),
+			}},
+		},
+		Policies: &schedpb.SchedulePolicies{
+			PauseOnFailure: true,
+		},
+	}, 4)
+	s.True(s.env.IsWorkflowCompleted())
+	// doesn't end properly since it sleeps forever after pausing
+}
+
+func (s *workflowSuite) TestPauseAfterFailuresSchedulePolicy() {
+	// written using low-level mocks so we can return failures
+
+	// the schedule's own policy takes precedence over the namespace default of 1
+
+	for _, minute := range []int{5, 10} {
+		id := fmt.Sprintf("myid-2022-06-01T00:%02d:00Z", minute)
+		s.expectStart(func(req *schedspb.StartWorkflowRequest) (*schedspb.StartWorkflowResponse, error) {
+			s.Equal(id, req.Request.WorkflowId)
+			return nil, nil
+		})
+		s.expectWatch(func(req *schedspb.WatchWorkflowRequest) (*schedspb.WatchWorkflowResponse, error) {
+			s.Equal(id, req.Execution.WorkflowId)
+			return &schedspb.WatchWorkflowResponse{
+				Status: enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+				ResultFailure: &schedspb.WatchWorkflowResponse_Failure{
+					Failure: &failurepb.Failure{Message: "oops " + id},
+				},
+			}, nil
+		})
+	}
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.False(desc.Schedule.State.Paused)
+		s.Require().Equal(1, len(desc.RecentRunResults))
+		s.Equal("myid-2022-06-01T00:05:00Z", desc.RecentRunResults[0].Execution.WorkflowId)
+		s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED, desc.RecentRunResults[0].Status)
+		s.Equal("oops myid-2022-06-01T00:05:00Z", desc.RecentRunResults[0].FailureMessage)
+		s.True(time.Date(2022, 6, 1, 0, 5, 0, 0, time.UTC).Equal(*desc.RecentRunResults[0].ScheduleTime))
+	}, 11*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.True(desc.Schedule.State.Paused)
+		s.Equal("paused after 2 consecutive failures, last due to workflow failure: myid-2022-06-01T00:10:00Z: oops myid-2022-06-01T00:10:00Z", desc.Schedule.State.Notes)
+		s.Equal(2, len(desc.RecentRunResults))
+		s.Equal(int64(2), desc.Policies.GetPauseAfterFailures())
+	}, 16*time.Minute)
+
+	s.runWithPolicies(&schedpb.Schedule{
+		Spec: &schedpb.ScheduleSpec{
+			Interval: []*schedpb.IntervalSpec{{
+				Interval: timestamp.DurationPtr(5 * time.Minute),
+			}},
+		},
+		Policies: &schedpb.SchedulePolicies{
+			PauseOnFailure: true,
+		},
+	}, &schedspb.InternalPolicies{PauseAfterFailures: 2}, 4)
+	s.True(s.env.IsWorkflowCompleted())
+	// doesn't end properly since it sleeps forever after pausing
+}
+
+func (s *workflowSuite) TestUpdatePoliciesSignal() {
+	// written using low-level mocks so we can return failures
+
+	// the policies set by signal take precedence over the namespace default of 1
+
+	for _, minute := range []int{5, 10} {
+		id := fmt.Sprintf("myid-2022-06-01T00:%02d:00Z", minute)
+		s.expectStart(func(req *schedspb.StartWorkflowRequest) (*schedspb.StartWorkflowResponse, error) {
+			s.Equal(id, req.Request.WorkflowId)
+			return nil, nil
+		})
+		s.expectWatch(func(req *schedspb.WatchWorkflowRequest) (*schedspb.WatchWorkflowResponse, error) {
+			s.Equal(id, req.Execution.WorkflowId)
+			return &schedspb.WatchWorkflowResponse{
+				Status: enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+				ResultFailure: &schedspb.WatchWorkflowResponse_Failure{
+					Failure: &failurepb.Failure{Message: "oops " + id},
+				},
+			}, nil
+		})
+	}
+	s.env.RegisterDelayedCallback(func() {
+		s.Nil(s.describe().Policies)
+		s.env.SignalWorkflow(SignalNameUpdatePolicies, &schedspb.InternalPolicies{PauseAfterFailures: 2})
+	}, 1*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.False(desc.Schedule.State.Paused)
+		s.Equal(int64(2), desc.Policies.GetPauseAfterFailures())
+	}, 11*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.True(desc.Schedule.State.Paused)
+		s.Equal(2, len(desc.RecentRunResults))
+	}, 16*time.Minute)
+
+	s.run(&schedpb.Schedule{
+		Spec: &schedpb.ScheduleSpec{
+			Interval: []*schedpb.IntervalSpec{{
+				Interval: timestamp.DurationPtr(5 * time.Minute),
+			}},
+		},
+		Policies: &schedpb.SchedulePolicies{
+			PauseOnFailure: true,
+		},
+	}, 5)
+	s.True(s.env.IsWorkflowCompleted())
+	// doesn't end properly since it sleeps forever after pausing
+}
+
 func (s *workflowSuite) TestCompileError() {
 	// written using low-level mocks since it sleeps forever
 
@@ -1356,3 +1516,64 @@ func (s *workflowSuite) TestLimitedActions() {
 	s.True(s.env.IsWorkflowCompleted())
 	// doesn't end properly since it sleeps forever after pausing
 }
+
+func (s *workflowSuite) TestBlackoutCalendarRefresh() {
+	// written using low-level mocks since no workflows are started
+
+	calendar := func(hours string) BlackoutCalendars {
+		return BlackoutCalendars{
+			"maintenance": `{"excludeCalendar": [{"hour": "` + hours + `", "minute": "*", "second": "*"}]}`,
+		}
+	}
+	s.env.OnActivity(new(activities).GetBlackoutCalendars, mock.Anything, []string{"maintenance"}).
+		Once().Return(calendar("2"), nil)
+	s.env.OnActivity(new(activities).GetBlackoutCalendars, mock.Anything, []string{"maintenance"}).
+		Once().Return(calendar("2-3"), nil)
+	s.env.OnUpsertSearchAttributes(map[string]interface{}{
+		searchattribute.TemporalSchedulePaused: true,
+	}).Return(nil).Once()
+	// the referenced calendar names are upserted once, not on every refresh
+	s.env.OnUpsertSearchAttributes(map[string]interface{}{
+		searchattribute.TemporalScheduleBlackoutCalendars: []string{"maintenance"},
+	}).Return(nil).Once()
+
+	futureTimes := func() []time.Time {
+		var out []time.Time
+		for _, t := range s.describe().Info.FutureActionTimes[:3] {
+			out = append(out, *t)
+		}
+		return out
+	}
+
+	s.env.RegisterDelayedCallback(func() {
+		s.Equal([]time.Time{
+			time.Date(2022, 6, 1, 1, 0, 0, 0, time.UTC),
+			time.Date(2022, 6, 1, 3, 0, 0, 0, time.UTC),
+			time.Date(2022, 6, 1, 4, 0, 0, 0, time.UTC),
+		}, futureTimes())
+		// calendar was updated
+		s.env.SignalWorkflow(SignalNameRefresh, nil)
+	}, 1*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Equal([]time.Time{
+			time.Date(2022, 6, 1, 1, 0, 0, 0, time.UTC),
+			time.Date(2022, 6, 1, 4, 0, 0, 0, time.UTC),
+			time.Date(2022, 6, 1, 5, 0, 0, 0, time.UTC),
+		}, futureTimes())
+	}, 2*time.Minute)
+
+	s.run(&schedpb.Schedule{
+		Spec: &schedpb.ScheduleSpec{
+			Interval: []*schedpb.IntervalSpec{{
+				Interval: timestamp.DurationPtr(1 * time.Hour),
+			}},
+			ExcludeCalendar: []*schedpb.CalendarSpec{{
+				Comment: "blackout:maintenance",
+			}},
+		},
+		State: &schedpb.ScheduleState{
+			Paused: true,
+		},
+	}, 2)
+	s.True(s.env.IsWorkflowCompleted())
+}
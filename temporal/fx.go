@@ -45,6 +45,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/client"
 	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/config"
@@ -53,6 +54,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadpolicy"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/cassandra"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
@@ -114,20 +116,22 @@ type (
 		CustomDataStoreFactory persistenceClient.AbstractDataStoreFactory
 
 		SearchAttributesMapper searchattribute.Mapper
+		PayloadPolicy          payloadpolicy.Policy
 		CustomInterceptors     []grpc.UnaryServerInterceptor
 		Authorizer             authorization.Authorizer
 		ClaimMapper            authorization.ClaimMapper
 		AudienceGetter         authorization.JWTAudienceMapper
 
 		// below are things that could be over write by server options or may have default if not supplied by serverOptions.
-		Logger                  log.Logger
-		ClientFactoryProvider   client.FactoryProvider
-		DynamicConfigClient     dynamicconfig.Client
-		DynamicConfigCollection *dynamicconfig.Collection
-		TLSConfigProvider       encryption.TLSConfigProvider
-		EsConfig                *esclient.Config
-		EsClient                esclient.Client
-		MetricsHandler          metrics.Handler
+		Logger                      log.Logger
+		ClientFactoryProvider       client.FactoryProvider
+		DynamicConfigClient         dynamicconfig.Client
+		DynamicConfigOverrideClient *dynamicconfig.OverrideClient
+		DynamicConfigCollection     *dynamicconfig.Collection
+		TLSConfigProvider           encryption.TLSConfigProvider
+		EsConfig                    *esclient.Config
+		EsClient                    esclient.Client
+		MetricsHandler              metrics.Handler
 	}
 )
 
@@ -146,6 +150,7 @@ func NewServerFx(opts ...ServerOption) (*ServerFx, error) {
 		fx.Provide(WorkerServiceProvider),
 
 		fx.Provide(ApplyClusterMetadataConfigProvider),
+		fx.Invoke(DynamicConfigOverrideLifetimeHooks),
 		fx.Invoke(ServerLifetimeHooks),
 		FxLogAdapter,
 	)
@@ -202,6 +207,11 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 			if err != nil {
 				return serverOptionsProvider{}, fmt.Errorf("unable to create dynamic config client: %w", err)
 			}
+		} else if dcDirConfig := so.config.DynamicConfigDirClient; dcDirConfig != nil {
+			dcClient, err = dynamicconfig.NewDirBasedClient(dcDirConfig, logger, stopChan)
+			if err != nil {
+				return serverOptionsProvider{}, fmt.Errorf("unable to create dynamic config client: %w", err)
+			}
 		} else {
 			// noop client
 			logger.Info("Dynamic config client is not configured. Using default values.")
@@ -209,6 +219,16 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 		}
 	}
 
+	// overrides set through the admin API take precedence over the configured client
+	dcOverrideClient := dynamicconfig.NewOverrideClient(dcClient, clock.NewRealTimeSource())
+	dcCollection := dynamicconfig.NewCollection(dcOverrideClient, logger)
+
+	// PayloadPolicy
+	payloadPolicy := so.payloadPolicy
+	if payloadPolicy == nil {
+		payloadPolicy = payloadpolicy.NewDynamicConfigPolicy(dcCollection)
+	}
+
 	// TLSConfigProvider
 	tlsConfigProvider := so.tlsConfigProvider
 	if tlsConfigProvider == nil {
@@ -227,20 +247,23 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 			return serverOptionsProvider{}, fmt.Errorf("persistence config: advanced visibility datastore %q: missing config", so.config.Persistence.AdvancedVisibilityStore)
 		}
 
-		esHttpClient := so.elasticsearchHttpClient
-		if esHttpClient == nil {
-			var err error
-			esHttpClient, err = esclient.NewAwsHttpClient(advancedVisibilityStore.Elasticsearch.AWSRequestSigning)
-			if err != nil {
-				return serverOptionsProvider{}, fmt.Errorf("unable to create AWS HTTP client for Elasticsearch: %w", err)
+		// Advanced visibility on SQL database doesn't use Elasticsearch.
+		if advancedVisibilityStore.SQL == nil {
+			esHttpClient := so.elasticsearchHttpClient
+			if esHttpClient == nil {
+				var err error
+				esHttpClient, err = esclient.NewAwsHttpClient(advancedVisibilityStore.Elasticsearch.AWSRequestSigning)
+				if err != nil {
+					return serverOptionsProvider{}, fmt.Errorf("unable to create AWS HTTP client for Elasticsearch: %w", err)
+				}
 			}
-		}
 
-		esConfig = advancedVisibilityStore.Elasticsearch
+			esConfig = advancedVisibilityStore.Elasticsearch
 
-		esClient, err = esclient.NewClient(esConfig, esHttpClient, logger)
-		if err != nil {
-			return serverOptionsProvider{}, fmt.Errorf("unable to create Elasticsearch client: %w", err)
+			esClient, err = esclient.NewClient(esConfig, esHttpClient, logger)
+			if err != nil {
+				return serverOptionsProvider{}, fmt.Errorf("unable to create Elasticsearch client: %w", err)
+			}
 		}
 	}
 
@@ -259,19 +282,21 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 		CustomDataStoreFactory: so.customDataStoreFactory,
 
 		SearchAttributesMapper: so.searchAttributesMapper,
+		PayloadPolicy:          payloadPolicy,
 		CustomInterceptors:     so.customInterceptors,
 		Authorizer:             so.authorizer,
 		ClaimMapper:            so.claimMapper,
 		AudienceGetter:         so.audienceGetter,
 
-		Logger:                  logger,
-		ClientFactoryProvider:   clientFactoryProvider,
-		DynamicConfigClient:     dcClient,
-		DynamicConfigCollection: dynamicconfig.NewCollection(dcClient, logger),
-		TLSConfigProvider:       tlsConfigProvider,
-		EsConfig:                esConfig,
-		EsClient:                esClient,
-		MetricsHandler:          metricHandler,
+		Logger:                      logger,
+		ClientFactoryProvider:       clientFactoryProvider,
+		DynamicConfigClient:         dcOverrideClient,
+		DynamicConfigOverrideClient: dcOverrideClient,
+		DynamicConfigCollection:     dcCollection,
+		TLSConfigProvider:           tlsConfigProvider,
+		EsConfig:                    esConfig,
+		EsClient:                    esClient,
+		MetricsHandler:              metricHandler,
 	}, nil
 }
 
@@ -303,28 +328,30 @@ type (
 	ServiceProviderParamsCommon struct {
 		fx.In
 
-		Cfg                        *config.Config
-		ServiceNames               resource.ServiceNames
-		Logger                     log.Logger
-		NamespaceLogger            resource.NamespaceLogger
-		DynamicConfigClient        dynamicconfig.Client
-		MetricsHandler             metrics.Handler
-		EsConfig                   *esclient.Config
-		EsClient                   esclient.Client
-		TlsConfigProvider          encryption.TLSConfigProvider
-		PersistenceConfig          config.Persistence
-		ClusterMetadata            *cluster.Config
-		ClientFactoryProvider      client.FactoryProvider
-		AudienceGetter             authorization.JWTAudienceMapper
-		PersistenceServiceResolver resolver.ServiceResolver
-		PersistenceFactoryProvider persistenceClient.FactoryProviderFn
-		SearchAttributesMapper     searchattribute.Mapper
-		CustomInterceptors         []grpc.UnaryServerInterceptor
-		Authorizer                 authorization.Authorizer
-		ClaimMapper                authorization.ClaimMapper
-		DataStoreFactory           persistenceClient.AbstractDataStoreFactory
-		SpanExporters              []otelsdktrace.SpanExporter
-		InstanceID                 resource.InstanceID `optional:"true"`
+		Cfg                         *config.Config
+		ServiceNames                resource.ServiceNames
+		Logger                      log.Logger
+		NamespaceLogger             resource.NamespaceLogger
+		DynamicConfigClient         dynamicconfig.Client
+		DynamicConfigOverrideClient *dynamicconfig.OverrideClient
+		MetricsHandler              metrics.Handler
+		EsConfig                    *esclient.Config
+		EsClient                    esclient.Client
+		TlsConfigProvider           encryption.TLSConfigProvider
+		PersistenceConfig           config.Persistence
+		ClusterMetadata             *cluster.Config
+		ClientFactoryProvider       client.FactoryProvider
+		AudienceGetter              authorization.JWTAudienceMapper
+		PersistenceServiceResolver  resolver.ServiceResolver
+		PersistenceFactoryProvider  persistenceClient.FactoryProviderFn
+		SearchAttributesMapper      searchattribute.Mapper
+		PayloadPolicy               payloadpolicy.Policy
+		CustomInterceptors          []grpc.UnaryServerInterceptor
+		Authorizer                  authorization.Authorizer
+		ClaimMapper                 authorization.ClaimMapper
+		DataStoreFactory            persistenceClient.AbstractDataStoreFactory
+		SpanExporters               []otelsdktrace.SpanExporter
+		InstanceID                  resource.InstanceID `optional:"true"`
 	}
 )
 
@@ -360,6 +387,7 @@ func HistoryServiceProvider(
 		fx.Provide(func() authorization.JWTAudienceMapper { return params.AudienceGetter }),
 		fx.Provide(func() resolver.ServiceResolver { return params.PersistenceServiceResolver }),
 		fx.Provide(func() searchattribute.Mapper { return params.SearchAttributesMapper }),
+		fx.Provide(func() payloadpolicy.Policy { return params.PayloadPolicy }),
 		fx.Provide(func() []grpc.UnaryServerInterceptor { return params.CustomInterceptors }),
 		fx.Provide(func() authorization.Authorizer { return params.Authorizer }),
 		fx.Provide(func() authorization.ClaimMapper { return params.ClaimMapper }),
@@ -481,11 +509,13 @@ func FrontendServiceProvider(
 		fx.Provide(func() authorization.JWTAudienceMapper { return params.AudienceGetter }),
 		fx.Provide(func() resolver.ServiceResolver { return params.PersistenceServiceResolver }),
 		fx.Provide(func() searchattribute.Mapper { return params.SearchAttributesMapper }),
+		fx.Provide(func() payloadpolicy.Policy { return params.PayloadPolicy }),
 		fx.Provide(func() []grpc.UnaryServerInterceptor { return params.CustomInterceptors }),
 		fx.Provide(func() authorization.Authorizer { return params.Authorizer }),
 		fx.Provide(func() authorization.ClaimMapper { return params.ClaimMapper }),
 		fx.Provide(func() encryption.TLSConfigProvider { return params.TlsConfigProvider }),
 		fx.Provide(func() dynamicconfig.Client { return params.DynamicConfigClient }),
+		fx.Provide(func() *dynamicconfig.OverrideClient { return params.DynamicConfigOverrideClient }),
 		fx.Provide(func() log.Logger { return params.Logger }),
 		fx.Provide(func() metrics.Handler {
 			return params.MetricsHandler.WithTags(metrics.ServiceNameTag(serviceName))
@@ -583,31 +613,17 @@ func ApplyClusterMetadataConfigProvider(
 	ctx := context.TODO()
 	logger = log.With(logger, tag.ComponentMetadataInitializer)
 
-	clusterName := persistenceClient.ClusterName(config.ClusterMetadata.CurrentClusterName)
-	dataStoreFactory, _ := persistenceClient.DataStoreFactoryProvider(
-		clusterName,
+	factory, clusterMetadataManager, err := newClusterMetadataManager(
+		logger,
+		config,
 		persistenceServiceResolver,
-		&config.Persistence,
+		persistenceFactoryProvider,
 		customDataStoreFactory,
-		logger,
-		nil,
 	)
-	factory := persistenceFactoryProvider(persistenceClient.NewFactoryParams{
-		DataStoreFactory:           dataStoreFactory,
-		Cfg:                        &config.Persistence,
-		PersistenceMaxQPS:          nil,
-		PersistenceNamespaceMaxQPS: nil,
-		EnablePriorityRateLimiting: nil,
-		ClusterName:                persistenceClient.ClusterName(config.ClusterMetadata.CurrentClusterName),
-		MetricsHandler:             nil,
-		Logger:                     logger,
-	})
-	defer factory.Close()
-
-	clusterMetadataManager, err := factory.NewClusterMetadataManager()
 	if err != nil {
-		return config.ClusterMetadata, config.Persistence, fmt.Errorf("error initializing cluster metadata manager: %w", err)
+		return config.ClusterMetadata, config.Persistence, err
 	}
+	defer factory.Close()
 	defer clusterMetadataManager.Close()
 
 	clusterData := config.ClusterMetadata
@@ -705,6 +721,93 @@ func ApplyClusterMetadataConfigProvider(
 	return config.ClusterMetadata, config.Persistence, nil
 }
 
+func newClusterMetadataManager(
+	logger log.Logger,
+	config *config.Config,
+	persistenceServiceResolver resolver.ServiceResolver,
+	persistenceFactoryProvider persistenceClient.FactoryProviderFn,
+	customDataStoreFactory persistenceClient.AbstractDataStoreFactory,
+) (persistenceClient.Factory, persistence.ClusterMetadataManager, error) {
+	clusterName := persistenceClient.ClusterName(config.ClusterMetadata.CurrentClusterName)
+	dataStoreFactory, _ := persistenceClient.DataStoreFactoryProvider(
+		clusterName,
+		persistenceServiceResolver,
+		&config.Persistence,
+		customDataStoreFactory,
+		logger,
+		nil,
+		nil,
+	)
+	factory := persistenceFactoryProvider(persistenceClient.NewFactoryParams{
+		DataStoreFactory:           dataStoreFactory,
+		Cfg:                        &config.Persistence,
+		PersistenceMaxQPS:          nil,
+		PersistenceNamespaceMaxQPS: nil,
+		EnablePriorityRateLimiting: nil,
+		ClusterName:                clusterName,
+		MetricsHandler:             nil,
+		Logger:                     logger,
+	})
+
+	clusterMetadataManager, err := factory.NewClusterMetadataManager()
+	if err != nil {
+		factory.Close()
+		return nil, nil, fmt.Errorf("error initializing cluster metadata manager: %w", err)
+	}
+	return factory, clusterMetadataManager, nil
+}
+
+// DynamicConfigOverrideLifetimeHooks keeps the dynamic config overrides stored in the cluster
+// metadata loaded into the OverrideClient while the server is running.
+func DynamicConfigOverrideLifetimeHooks(
+	lc fx.Lifecycle,
+	logger log.Logger,
+	config *config.Config,
+	persistenceServiceResolver resolver.ServiceResolver,
+	persistenceFactoryProvider persistenceClient.FactoryProviderFn,
+	customDataStoreFactory persistenceClient.AbstractDataStoreFactory,
+	overrideClient *dynamicconfig.OverrideClient,
+	// the cluster metadata is initialized by ApplyClusterMetadataConfigProvider
+	_ *cluster.Config,
+) {
+	var factory persistenceClient.Factory
+	var clusterMetadataManager persistence.ClusterMetadataManager
+	lc.Append(
+		fx.Hook{
+			OnStart: func(context.Context) error {
+				var err error
+				factory, clusterMetadataManager, err = newClusterMetadataManager(
+					logger,
+					config,
+					persistenceServiceResolver,
+					persistenceFactoryProvider,
+					customDataStoreFactory,
+				)
+				if err != nil {
+					return err
+				}
+				return overrideClient.Start(func(ctx context.Context) ([]*persistencespb.DynamicConfigOverride, error) {
+					resp, err := clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+					if err != nil {
+						return nil, err
+					}
+					return resp.DynamicConfigOverrides, nil
+				}, logger)
+			},
+			OnStop: func(context.Context) error {
+				overrideClient.Stop()
+				if clusterMetadataManager != nil {
+					clusterMetadataManager.Close()
+				}
+				if factory != nil {
+					factory.Close()
+				}
+				return nil
+			},
+		},
+	)
+}
+
 func PersistenceFactoryProvider() persistenceClient.FactoryProviderFn {
 	return persistenceClient.FactoryProvider
 }
@@ -193,6 +193,7 @@ func initSystemNamespaces(
 		customDataStoreFactory,
 		logger,
 		nil,
+		nil,
 	)
 	factory := persistenceFactoryProvider(persistenceClient.NewFactoryParams{
 		DataStoreFactory:           dataStoreFactory,
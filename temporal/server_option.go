@@ -35,6 +35,7 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadpolicy"
 	persistenceclient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resolver"
@@ -170,6 +171,14 @@ func WithSearchAttributesMapper(m searchattribute.Mapper) ServerOption {
 	})
 }
 
+// WithPayloadPolicy sets a custom policy deciding which payloads each namespace accepts. It replaces the default policy
+// configured by the limit.payloadPolicy dynamic config keys.
+func WithPayloadPolicy(p payloadpolicy.Policy) ServerOption {
+	return applyFunc(func(s *serverOptions) {
+		s.payloadPolicy = p
+	})
+}
+
 // WithChainedFrontendGrpcInterceptors sets a chain of ordered custom grpc interceptors that will be invoked for all
 // Frontend gRPC API calls. The list of custom interceptors will be appended to the end of the internal
 // ServerInterceptors. The custom interceptors will be invoked in the order as they appear in the supplied list, after
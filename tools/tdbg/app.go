@@ -100,6 +100,18 @@ func NewCliApp() *cli.App {
 			Usage:   "Override for target server name",
 			EnvVars: []string{"TEMPORAL_CLI_TLS_SERVER_NAME"},
 		},
+		&cli.StringFlag{
+			Name:    FlagCodecEndpoint,
+			Value:   "",
+			Usage:   "Remote codec server endpoint used to decode payloads before printing",
+			EnvVars: []string{"TEMPORAL_CLI_CODEC_ENDPOINT"},
+		},
+		&cli.StringFlag{
+			Name:    FlagCodecAuth,
+			Value:   "",
+			Usage:   "Authorization header to set for requests to the codec server",
+			EnvVars: []string{"TEMPORAL_CLI_CODEC_AUTH"},
+		},
 		&cli.StringFlag{
 			Name:  color.FlagColor,
 			Usage: fmt.Sprintf("when to use color: %v, %v, %v.", color.Auto, color.Always, color.Never),
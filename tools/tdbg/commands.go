@@ -105,7 +105,7 @@ func AdminShowWorkflow(c *cli.Context) error {
 			return fmt.Errorf("unable to deserialize Events: %s", err)
 		}
 		allEvents.Events = append(allEvents.Events, historyBatch...)
-		encoder := codec.NewJSONPBEncoder()
+		encoder := codec.NewJSONPBEncoder().WithPayloadCodec(newPayloadCodec(c))
 		data, err := encoder.EncodeHistoryEvents(historyBatch)
 		if err != nil {
 			return fmt.Errorf("unable to encode History Events: %s", err)
@@ -137,10 +137,10 @@ func AdminDescribeWorkflow(c *cli.Context) error {
 	if resp != nil {
 		fmt.Println(color.Green(c, "Cache mutable state:"))
 		if resp.GetCacheMutableState() != nil {
-			prettyPrintJSONObject(resp.GetCacheMutableState())
+			prettyPrintJSONObject(c, resp.GetCacheMutableState())
 		}
 		fmt.Println(color.Green(c, "Database mutable state:"))
-		prettyPrintJSONObject(resp.GetDatabaseMutableState())
+		prettyPrintJSONObject(c, resp.GetDatabaseMutableState())
 
 		fmt.Println(color.Green(c, "Current branch token:"))
 		versionHistories := resp.GetDatabaseMutableState().GetExecutionInfo().GetVersionHistories()
@@ -154,7 +154,7 @@ func AdminDescribeWorkflow(c *cli.Context) error {
 			if err != nil {
 				fmt.Println(color.Red(c, "Unable to unmarshal current branch token:"), err)
 			} else {
-				prettyPrintJSONObject(currentBranchToken)
+				prettyPrintJSONObject(c, currentBranchToken)
 			}
 		}
 
@@ -241,6 +241,30 @@ func AdminDeleteWorkflow(c *cli.Context) error {
 	return nil
 }
 
+// AdminCountWorkflowExecutions counts workflow executions matching a visibility query
+func AdminCountWorkflowExecutions(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.CountWorkflowExecutions(ctx, &adminservice.CountWorkflowExecutionsRequest{
+		Namespace: namespace,
+		Query:     c.String(FlagQuery),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to count workflow executions: %s", err)
+	}
+
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
 // AdminGetShardID get shardID
 func AdminGetShardID(c *cli.Context) error {
 	namespaceID := c.String(FlagNamespaceID)
@@ -374,7 +398,7 @@ func AdminDescribeShard(c *cli.Context) error {
 		return fmt.Errorf("unable to initialize Shard Manager: %s", err)
 	}
 
-	prettyPrintJSONObject(response.ShardInfo)
+	prettyPrintJSONObject(c, response.ShardInfo)
 	return nil
 }
 
@@ -396,6 +420,49 @@ func AdminShardManagement(c *cli.Context) error {
 	return nil
 }
 
+// AdminMoveShard moves a shard to a specific history host
+func AdminMoveShard(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	req := &adminservice.MoveShardRequest{
+		ShardId:     int32(c.Int(FlagShardID)),
+		HostAddress: c.String(FlagHistoryAddress),
+	}
+	if req.HostAddress != "" {
+		req.Ttl = timestamp.DurationPtr(c.Duration(FlagTTL))
+	}
+
+	resp, err := adminClient.MoveShard(ctx, req)
+	if err != nil {
+		return fmt.Errorf("unable to move shard: %s", err)
+	}
+	if req.HostAddress == "" {
+		fmt.Printf("Removed ownership override of shard %d\n", req.ShardId)
+		return nil
+	}
+	fmt.Printf("Moved shard %d to %s until %s\n", req.ShardId, req.HostAddress, timestamp.TimeValue(resp.GetExpireTime()).Format(time.RFC3339))
+	return nil
+}
+
+// AdminListShardOwnershipOverrides lists shards which were moved to a specific history host
+func AdminListShardOwnershipOverrides(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ListShardOwnershipOverrides(ctx, &adminservice.ListShardOwnershipOverridesRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to list shard ownership overrides: %s", err)
+	}
+
+	prettyPrintJSONObject(c, resp.GetOverrides())
+	return nil
+}
+
 // AdminListGossipMembers outputs a list of gossip members
 func AdminListGossipMembers(c *cli.Context) error {
 	roleFlag := c.String(FlagClusterMembershipRole)
@@ -420,7 +487,7 @@ func AdminListGossipMembers(c *cli.Context) error {
 		}
 	}
 
-	prettyPrintJSONObject(members)
+	prettyPrintJSONObject(c, members)
 	return nil
 }
 
@@ -450,7 +517,7 @@ func AdminListClusterMembers(c *cli.Context) error {
 
 	members := resp.ActiveMembers
 
-	prettyPrintJSONObject(members)
+	prettyPrintJSONObject(c, members)
 	return nil
 }
 
@@ -491,6 +558,8 @@ func AdminDescribeHistoryHost(c *cli.Context) error {
 		req.HostAddress = historyAddr
 	}
 
+	req.IncludeShardLoads = c.Bool(FlagShardLoad)
+
 	resp, err := adminClient.DescribeHistoryHost(ctx, req)
 	if err != nil {
 		return fmt.Errorf("unable to describe History host: %s", err)
@@ -499,7 +568,7 @@ func AdminDescribeHistoryHost(c *cli.Context) error {
 	if !printFully {
 		resp.ShardIds = nil
 	}
-	prettyPrintJSONObject(resp)
+	prettyPrintJSONObject(c, resp)
 	return nil
 }
 
@@ -93,7 +93,7 @@ func AdminDecodeProto(c *cli.Context) error {
 		return fmt.Errorf("unable to unmarshal to %s", protoType)
 	}
 
-	encoder := codec.NewJSONPBIndentEncoder(" ")
+	encoder := codec.NewJSONPBIndentEncoder(" ").WithPayloadCodec(newPayloadCodec(c))
 	json, err := encoder.Encode(message)
 	if err != nil {
 		return fmt.Errorf("unable to encode to JSON: %s", err)
@@ -0,0 +1,271 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+func newAdminDynamicConfigCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "get",
+			Usage: "Get the values of a dynamic config key, including overrides",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagKey,
+					Usage:    "Dynamic config key",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminGetDynamicConfig(c)
+			},
+		},
+		{
+			Name:  "set",
+			Usage: "Override the value of a dynamic config key for the given constraints until the ttl expires",
+			Flags: append([]cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagKey,
+					Usage:    "Dynamic config key",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagValue,
+					Usage:    "Value in YAML, as it would be written in the dynamic config file",
+					Required: true,
+				},
+				&cli.DurationFlag{
+					Name:  FlagTTL,
+					Value: time.Hour,
+					Usage: "Duration after which the override expires",
+				},
+			}, dynamicConfigConstraintFlags()...),
+			Action: func(c *cli.Context) error {
+				return AdminSetDynamicConfigOverride(c)
+			},
+		},
+		{
+			Name:  "remove",
+			Usage: "Remove the override of a dynamic config key for the given constraints",
+			Flags: append([]cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagKey,
+					Usage:    "Dynamic config key",
+					Required: true,
+				},
+			}, dynamicConfigConstraintFlags()...),
+			Action: func(c *cli.Context) error {
+				return AdminRemoveDynamicConfigOverride(c)
+			},
+		},
+		{
+			Name:  "list",
+			Usage: "List the dynamic config keys the server reads and their values, including overrides",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagKeyPrefix,
+					Usage: "Only list the keys starting with this prefix",
+				},
+				&cli.BoolFlag{
+					Name:  FlagIncludeUnset,
+					Usage: "Also list the keys which have no value and use the server default",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListDynamicConfig(c)
+			},
+		},
+		{
+			Name:  "list-overrides",
+			Usage: "List the dynamic config overrides which have not expired yet",
+			Action: func(c *cli.Context) error {
+				return AdminListDynamicConfigOverrides(c)
+			},
+		},
+	}
+}
+
+// dynamicConfigConstraintFlags shadow the global namespace flag, so that a value without
+// namespace constraint is not accidentally constrained to the default namespace.
+func dynamicConfigConstraintFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  FlagNamespace,
+			Usage: "Namespace constraint",
+		},
+		&cli.StringFlag{
+			Name:  FlagNamespaceID,
+			Usage: "Namespace ID constraint",
+		},
+		&cli.StringFlag{
+			Name:  FlagTaskQueue,
+			Usage: "Task queue name constraint",
+		},
+		&cli.StringFlag{
+			Name:  FlagTaskQueueType,
+			Usage: "Task queue type constraint: activity, workflow",
+		},
+		&cli.IntFlag{
+			Name:  FlagShardID,
+			Usage: "Shard ID constraint",
+		},
+		&cli.StringFlag{
+			Name:  FlagTaskType,
+			Usage: "History task type constraint, e.g. TransferActivityTask",
+		},
+	}
+}
+
+func dynamicConfigConstraints(c *cli.Context) (*persistencespb.DynamicConfigConstraints, error) {
+	taskQueueType, err := stringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid task queue type: %w", err)
+	}
+	taskType, err := stringToEnum(c.String(FlagTaskType), enumsspb.TaskType_value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid task type: %w", err)
+	}
+	return &persistencespb.DynamicConfigConstraints{
+		Namespace:     c.String(FlagNamespace),
+		NamespaceId:   c.String(FlagNamespaceID),
+		TaskQueueName: c.String(FlagTaskQueue),
+		TaskQueueType: enumspb.TaskQueueType(taskQueueType),
+		ShardId:       int32(c.Int(FlagShardID)),
+		TaskType:      enumsspb.TaskType(taskType),
+	}, nil
+}
+
+// AdminGetDynamicConfig gets the values of a dynamic config key
+func AdminGetDynamicConfig(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.GetDynamicConfig(ctx, &adminservice.GetDynamicConfigRequest{
+		Key: c.String(FlagKey),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get dynamic config: %s", err)
+	}
+
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminSetDynamicConfigOverride overrides the value of a dynamic config key
+func AdminSetDynamicConfigOverride(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	constraints, err := dynamicConfigConstraints(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	req := &adminservice.SetDynamicConfigOverrideRequest{
+		Key:         c.String(FlagKey),
+		Constraints: constraints,
+		Value:       c.String(FlagValue),
+		Ttl:         timestamp.DurationPtr(c.Duration(FlagTTL)),
+	}
+	resp, err := adminClient.SetDynamicConfigOverride(ctx, req)
+	if err != nil {
+		return fmt.Errorf("unable to set dynamic config override: %s", err)
+	}
+	fmt.Printf("Overrode %s until %s\n", req.Key, timestamp.TimeValue(resp.GetExpireTime()).Format(time.RFC3339))
+	return nil
+}
+
+// AdminRemoveDynamicConfigOverride removes the override of a dynamic config key
+func AdminRemoveDynamicConfigOverride(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	constraints, err := dynamicConfigConstraints(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	req := &adminservice.RemoveDynamicConfigOverrideRequest{
+		Key:         c.String(FlagKey),
+		Constraints: constraints,
+	}
+	if _, err := adminClient.RemoveDynamicConfigOverride(ctx, req); err != nil {
+		return fmt.Errorf("unable to remove dynamic config override: %s", err)
+	}
+	fmt.Printf("Removed override of %s\n", req.Key)
+	return nil
+}
+
+// AdminListDynamicConfig lists the dynamic config keys and their values
+func AdminListDynamicConfig(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ListDynamicConfig(ctx, &adminservice.ListDynamicConfigRequest{
+		KeyPrefix:    c.String(FlagKeyPrefix),
+		IncludeUnset: c.Bool(FlagIncludeUnset),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list dynamic config: %s", err)
+	}
+
+	prettyPrintJSONObject(c, resp.GetKeys())
+	return nil
+}
+
+// AdminListDynamicConfigOverrides lists the dynamic config overrides
+func AdminListDynamicConfigOverrides(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ListDynamicConfigOverrides(ctx, &adminservice.ListDynamicConfigOverridesRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to list dynamic config overrides: %s", err)
+	}
+
+	prettyPrintJSONObject(c, resp.GetOverrides())
+	return nil
+}
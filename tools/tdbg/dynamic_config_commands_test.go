@@ -0,0 +1,74 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"github.com/urfave/cli/v2"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+func (s *utilSuite) TestDynamicConfigConstraints() {
+	var constraints *persistencespb.DynamicConfigConstraints
+	app := cli.NewApp()
+	app.Flags = []cli.Flag{
+		&cli.StringFlag{
+			Name:  FlagNamespace,
+			Value: "default",
+		},
+	}
+	app.Commands = []*cli.Command{
+		{
+			Name:  "set",
+			Flags: dynamicConfigConstraintFlags(),
+			Action: func(c *cli.Context) error {
+				var err error
+				constraints, err = dynamicConfigConstraints(c)
+				return err
+			},
+		},
+	}
+
+	// the global namespace flag does not constrain the value
+	s.NoError(app.Run([]string{"tdbg", "set"}))
+	s.Equal(&persistencespb.DynamicConfigConstraints{}, constraints)
+
+	s.NoError(app.Run([]string{"tdbg", "set",
+		"--namespace", "samples-namespace",
+		"--task-queue", "samples-task-queue",
+		"--task-queue-type", "workflow",
+		"--task-type", "TransferActivityTask",
+	}))
+	s.Equal(&persistencespb.DynamicConfigConstraints{
+		Namespace:     "samples-namespace",
+		TaskQueueName: "samples-task-queue",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		TaskType:      enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK,
+	}, constraints)
+
+	s.Error(app.Run([]string{"tdbg", "set", "--task-queue-type", "unknown"}))
+}
@@ -50,6 +50,7 @@ var (
 	FlagPrintJSON                  = "print-json"
 	FlagHeartbeatedWithin          = "heartbeated-within"
 	FlagOutputFilename             = "output-filename"
+	FlagInputFilename              = "input-filename"
 	FlagClusterMembershipRole      = "role"
 	FlagSkipErrorMode              = "skip-errors"
 	FlagTaskID                     = "task-id"
@@ -78,4 +79,15 @@ var (
 	FlagBinaryFile                 = "binary-file"
 	FlagBase64Data                 = "base64-data"
 	FlagBase64File                 = "base64-file"
+	FlagCodecEndpoint              = "codec-endpoint"
+	FlagCodecAuth                  = "codec-auth"
+	FlagTTL                        = "ttl"
+	FlagShardLoad                  = "shard-load"
+	FlagKey                        = "key"
+	FlagValue                      = "value"
+	FlagKeyPrefix                  = "key-prefix"
+	FlagIncludeUnset               = "include-unset"
+	FlagQuery                      = "query"
+	FlagScheduleID                 = "schedule-id"
+	FlagPauseAfterFailures         = "pause-after-failures"
 )
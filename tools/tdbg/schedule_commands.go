@@ -0,0 +1,124 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	schedspb "go.temporal.io/server/api/schedule/v1"
+)
+
+func newAdminScheduleCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "describe",
+			Usage: "Describe the internal policies of a schedule and the outcomes of its most recent runs",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagScheduleID,
+					Usage:    "Schedule ID",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeSchedule(c)
+			},
+		},
+		{
+			Name:  "update-policies",
+			Usage: "Update the internal policies of a schedule",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagScheduleID,
+					Usage:    "Schedule ID",
+					Required: true,
+				},
+				&cli.Int64Flag{
+					Name:     FlagPauseAfterFailures,
+					Usage:    "Consecutive failed runs before pausing, if the schedule pauses on failure. 0 uses the namespace default",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateSchedulePolicies(c)
+			},
+		},
+	}
+}
+
+// AdminDescribeSchedule describes the internal policies and recent run outcomes of a schedule
+func AdminDescribeSchedule(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeSchedule(ctx, &adminservice.DescribeScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: c.String(FlagScheduleID),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe schedule: %s", err)
+	}
+
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminUpdateSchedulePolicies replaces the internal policies of a schedule
+func AdminUpdateSchedulePolicies(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.UpdateSchedulePolicies(ctx, &adminservice.UpdateSchedulePoliciesRequest{
+		Namespace:  namespace,
+		ScheduleId: c.String(FlagScheduleID),
+		Policies: &schedspb.InternalPolicies{
+			PauseAfterFailures: c.Int64(FlagPauseAfterFailures),
+		},
+		Identity: "tdbg",
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update schedule policies: %s", err)
+	}
+
+	fmt.Printf("Updated policies of schedule %s\n", c.String(FlagScheduleID))
+	return nil
+}
@@ -26,12 +26,80 @@ package tdbg
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/urfave/cli/v2"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
 )
 
+type taskQueuePartitionRow struct {
+	Partition      string
+	OwnerHost      string
+	Pollers        int32
+	Backlog        int64
+	OldestTaskAge  time.Duration
+	AddRate        string
+	DispatchRate   string
+	SyncMatchRatio string
+	Error          string
+}
+
+// AdminDescribeTaskQueue displays backlog and rate statistics for each partition of a task queue
+func AdminDescribeTaskQueue(c *cli.Context) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	tlTypeInt, err := stringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+	if err != nil {
+		return fmt.Errorf("invalid task queue type: %v", err)
+	}
+	tqType := enumspb.TaskQueueType(tlTypeInt)
+	if tqType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return fmt.Errorf("missing Task Queue type")
+	}
+
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := client.DescribeTaskQueuePartitions(ctx, &adminservice.DescribeTaskQueuePartitionsRequest{
+		Namespace:     namespace,
+		TaskQueue:     tqName,
+		TaskQueueType: tqType,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe Task Queue: %v", err)
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(c, resp)
+		return nil
+	}
+
+	var items []interface{}
+	for _, partition := range resp.GetPartitions() {
+		stats := partition.GetBacklogStats()
+		items = append(items, &taskQueuePartitionRow{
+			Partition:      partition.GetName(),
+			OwnerHost:      partition.GetOwnerHost(),
+			Pollers:        partition.GetPollerCount(),
+			Backlog:        stats.GetApproximateBacklogCount(),
+			OldestTaskAge:  timestamp.DurationValue(stats.GetOldestTaskAge()).Truncate(time.Millisecond),
+			AddRate:        fmt.Sprintf("%.2f", stats.GetAddRate()),
+			DispatchRate:   fmt.Sprintf("%.2f", stats.GetDispatchRate()),
+			SyncMatchRatio: fmt.Sprintf("%.2f", stats.GetSyncMatchRatio()),
+			Error:          partition.GetError(),
+		})
+	}
+	return printTable(items)
+}
+
 // AdminListTaskQueueTasks displays task information
 func AdminListTaskQueueTasks(c *cli.Context) error {
 	namespace, err := getRequiredOption(c, FlagNamespace)
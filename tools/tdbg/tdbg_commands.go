@@ -25,6 +25,8 @@
 package tdbg
 
 import (
+	"time"
+
 	"github.com/urfave/cli/v2"
 )
 
@@ -64,6 +66,17 @@ var commands = []*cli.Command{
 		Usage:       "Run admin operation on DLQ",
 		Subcommands: newAdminDLQCommands(),
 	},
+	{
+		Name:        "dynamic-config",
+		Aliases:     []string{"dc"},
+		Usage:       "Run admin operation on dynamic config",
+		Subcommands: newAdminDynamicConfigCommands(),
+	},
+	{
+		Name:        "schedule",
+		Usage:       "Run admin operation on schedule",
+		Subcommands: newAdminScheduleCommands(),
+	},
 	{
 		Name:        "decode",
 		Usage:       "Decode payload",
@@ -112,6 +125,47 @@ func newAdminWorkflowCommands() []*cli.Command {
 				return AdminShowWorkflow(c)
 			},
 		},
+		{
+			Name:  "export",
+			Usage: "Export workflow history, mutable state and namespace to a bundle file",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagOutputFilename,
+					Usage: "Bundle file to write",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminExportWorkflow(c)
+			},
+		},
+		{
+			Name:  "import",
+			Usage: "Import workflow history from a bundle file into an existing namespace, warning about retention and search attribute differences",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagInputFilename,
+					Usage: "Bundle file written by workflow export",
+				},
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID of the imported workflow, defaults to the workflow ID in the bundle",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminImportWorkflow(c)
+			},
+		},
 		{
 			Name:    "describe",
 			Aliases: []string{"d"},
@@ -192,6 +246,19 @@ func newAdminWorkflowCommands() []*cli.Command {
 				return AdminDeleteWorkflow(c)
 			},
 		},
+		{
+			Name:  "count",
+			Usage: "Count workflow executions matching a visibility query, by group if the query has a GROUP BY clause",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagQuery,
+					Usage: "Visibility query, e.g. \"WorkflowType = 'payment' GROUP BY ExecutionStatus\"",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminCountWorkflowExecutions(c)
+			},
+		},
 	}
 }
 
@@ -278,6 +345,36 @@ func newAdminShardManagementCommands() []*cli.Command {
 				return AdminShardManagement(c)
 			},
 		},
+		{
+			Name:  "move",
+			Usage: "move a shard to the given history host until the ttl expires",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "ShardId",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagHistoryAddress,
+					Usage: "History Host address(IP:PORT) to move the shard to, removes the override of the shard if not set",
+				},
+				&cli.DurationFlag{
+					Name:  FlagTTL,
+					Value: time.Hour,
+					Usage: "Duration after which the shard is owned by the host it hashes to again",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminMoveShard(c)
+			},
+		},
+		{
+			Name:  "list-overrides",
+			Usage: "list the shards which were moved to a specific history host",
+			Action: func(c *cli.Context) error {
+				return AdminListShardOwnershipOverrides(c)
+			},
+		},
 		{
 			Name:    "remove-task",
 			Aliases: []string{"rmtk"},
@@ -372,6 +469,10 @@ func newAdminHistoryHostCommands() []*cli.Command {
 					Name:  FlagPrintFullyDetail,
 					Usage: "Print fully detail",
 				},
+				&cli.BoolFlag{
+					Name:  FlagShardLoad,
+					Usage: "Include the request rates, pending tasks, cache hit rate and busiest workflows of every shard of the host",
+				},
 			},
 			Action: func(c *cli.Context) error {
 				return AdminDescribeHistoryHost(c)
@@ -444,6 +545,28 @@ func newAdminTaskQueueCommands() []*cli.Command {
 				return AdminListTaskQueueTasks(c)
 			},
 		},
+		{
+			Name:  "describe",
+			Usage: "Describe backlog, rates and pollers of each partition of a task queue",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "workflow",
+					Usage: "Task Queue type: activity, workflow",
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueue,
+					Usage: "Task Queue name",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeTaskQueue(c)
+			},
+		},
 	}
 }
 
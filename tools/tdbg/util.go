@@ -41,17 +41,18 @@ import (
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli/v2"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/converter"
 
 	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/namespace"
 )
 
-func prettyPrintJSONObject(o interface{}) {
+func prettyPrintJSONObject(c *cli.Context, o interface{}) {
 	var b []byte
 	var err error
 	if pb, ok := o.(proto.Message); ok {
-		encoder := codec.NewJSONPBIndentEncoder("  ")
+		encoder := codec.NewJSONPBIndentEncoder("  ").WithPayloadCodec(newPayloadCodec(c))
 		b, err = encoder.Encode(pb)
 	} else {
 		b, err = json.MarshalIndent(o, "", "  ")
@@ -65,6 +66,19 @@ func prettyPrintJSONObject(o interface{}) {
 	fmt.Println()
 }
 
+// newPayloadCodec returns the codec server configured with --codec-endpoint, or nil if there is none.
+func newPayloadCodec(c *cli.Context) converter.PayloadCodec {
+	endpoint := c.String(FlagCodecEndpoint)
+	if endpoint == "" {
+		return nil
+	}
+	return codec.NewRemotePayloadCodec(codec.RemotePayloadCodecOptions{
+		Endpoint:      endpoint,
+		Namespace:     c.String(FlagNamespace),
+		Authorization: c.String(FlagCodecAuth),
+	})
+}
+
 func getRequiredOption(c *cli.Context, optionName string) (string, error) {
 	value := c.String(optionName)
 	if len(value) == 0 {
@@ -254,7 +268,7 @@ func paginate[V any](c *cli.Context, paginationFn collection.PaginationFn[V], pa
 					return err
 				}
 			} else {
-				prettyPrintJSONObject(pageItems)
+				prettyPrintJSONObject(c, pageItems)
 			}
 
 			if !more || !showNextPage() {
@@ -24,6 +24,15 @@
 
 package tdbg
 
+import (
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/testing/codecserver"
+)
+
 func (s *utilSuite) TestStringToEnum_MapCaseInsensitive() {
 	enumValues := map[string]int32{
 		"Unspecified": 0,
@@ -70,3 +79,40 @@ func (s *utilSuite) TestStringToEnum_MapEmptyEnum() {
 	s.Error(err)
 	s.Equal(result, int32(0))
 }
+
+func (s *utilSuite) TestNewPayloadCodec() {
+	server := codecserver.NewServer()
+	defer server.Close()
+
+	hello := payload.EncodeString("hello")
+	encoded, err := codecserver.Codec{}.Encode([]*commonpb.Payload{hello})
+	s.NoError(err)
+
+	app := NewCliApp()
+	app.Commands = []*cli.Command{
+		{
+			Name: "decode",
+			Action: func(c *cli.Context) error {
+				payloadCodec := newPayloadCodec(c)
+				s.NotNil(payloadCodec)
+				decoded, err := payloadCodec.Decode(encoded)
+				s.NoError(err)
+				s.Equal([]*commonpb.Payload{hello}, decoded)
+				return nil
+			},
+		},
+		{
+			Name: "nocodec",
+			Action: func(c *cli.Context) error {
+				s.Nil(newPayloadCodec(c))
+				return nil
+			},
+		},
+	}
+
+	s.NoError(app.Run([]string{"tdbg", "--codec-endpoint", server.URL, "--namespace", "my-namespace", "decode"}))
+	s.Len(server.Requests(), 1)
+	s.Equal("my-namespace", server.Requests()[0].Header.Get(codec.RemotePayloadCodecNamespaceHeader))
+
+	s.NoError(app.Run([]string{"tdbg", "nocodec"}))
+}
@@ -0,0 +1,361 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/util"
+)
+
+const (
+	// workflowImportPageSize is the number of history batches sent in one import request
+	workflowImportPageSize = 100
+)
+
+type (
+	// workflowBundle is the file written by "workflow export" and read by "workflow import".
+	// Messages are stored as JSON encoded protos, so the bundle can also be inspected by hand.
+	workflowBundle struct {
+		// Namespace is the DescribeNamespaceResponse of the source namespace.
+		Namespace json.RawMessage `json:"namespace"`
+		// SearchAttributes is the GetSearchAttributesResponse of the source cluster.
+		// It is empty in bundles written before it was added.
+		SearchAttributes json.RawMessage `json:"searchAttributes,omitempty"`
+		// MutableState is the database mutable state of the exported workflow execution.
+		// It holds the version histories of all branches.
+		MutableState json.RawMessage `json:"mutableState"`
+		// History is the list of history batches of the current branch.
+		History json.RawMessage `json:"history"`
+	}
+)
+
+// AdminExportWorkflow writes the history, mutable state and namespace of a workflow execution to a bundle file
+func AdminExportWorkflow(c *cli.Context) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	outputFileName, err := getRequiredOption(c, FlagOutputFilename)
+	if err != nil {
+		return err
+	}
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	nsResp, err := cFactory.WorkflowClient(c).DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: nsName,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe namespace: %s", err)
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	saResp, err := adminClient.GetSearchAttributes(ctx, &adminservice.GetSearchAttributesRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to get search attributes: %s", err)
+	}
+	msResp, err := adminClient.DescribeMutableState(ctx, &adminservice.DescribeMutableStateRequest{
+		Namespace: nsName,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get Workflow Mutable State: %s", err)
+	}
+	mutableState := msResp.GetDatabaseMutableState()
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: wid,
+		RunId:      mutableState.GetExecutionState().GetRunId(),
+	}
+
+	serializer := serialization.NewSerializer()
+	var histories []*historypb.History
+	var pageToken []byte
+	for {
+		resp, err := adminClient.GetWorkflowExecutionRawHistoryV2(ctx, &adminservice.GetWorkflowExecutionRawHistoryV2Request{
+			NamespaceId:     nsResp.GetNamespaceInfo().GetId(),
+			Execution:       execution,
+			MaximumPageSize: 100,
+			NextPageToken:   pageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to read History Branch: %s", err)
+		}
+		for _, blob := range resp.HistoryBatches {
+			events, err := serializer.DeserializeEvents(blob)
+			if err != nil {
+				return fmt.Errorf("unable to deserialize Events: %s", err)
+			}
+			histories = append(histories, &historypb.History{Events: events})
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+
+	data, err := encodeWorkflowBundle(nsResp, saResp, mutableState, histories)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(outputFileName, data, 0666); err != nil {
+		return fmt.Errorf("unable to write workflow bundle file: %s", err)
+	}
+	fmt.Printf("Exported %v history batches of workflow %s, run %s to %s\n", len(histories), execution.WorkflowId, execution.RunId, outputFileName)
+	return nil
+}
+
+// AdminImportWorkflow writes the history of a workflow execution from a bundle file into the target
+// namespace through the admin ImportWorkflowExecution API
+func AdminImportWorkflow(c *cli.Context) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	inputFileName, err := getRequiredOption(c, FlagInputFilename)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(inputFileName)
+	if err != nil {
+		return fmt.Errorf("unable to read workflow bundle file: %s", err)
+	}
+	nsResp, saResp, mutableState, histories, err := decodeWorkflowBundle(data)
+	if err != nil {
+		return err
+	}
+
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: mutableState.GetExecutionInfo().GetWorkflowId(),
+		RunId:      mutableState.GetExecutionState().GetRunId(),
+	}
+	if c.IsSet(FlagWorkflowID) {
+		execution.WorkflowId = c.String(FlagWorkflowID)
+	}
+	requests, err := newWorkflowImportRequests(nsName, execution, mutableState, histories)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	targetNsResp, err := cFactory.WorkflowClient(c).DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: nsName,
+	})
+	if err != nil {
+		if _, ok := err.(*serviceerror.NamespaceNotFound); ok {
+			return fmt.Errorf("namespace %s does not exist, register it with retention %v before importing",
+				nsName, timestamp.DurationValue(nsResp.GetConfig().GetWorkflowExecutionRetentionTtl()))
+		}
+		return fmt.Errorf("unable to describe namespace: %s", err)
+	}
+	adminClient := cFactory.AdminClient(c)
+	targetSaResp, err := adminClient.GetSearchAttributes(ctx, &adminservice.GetSearchAttributesRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to get search attributes: %s", err)
+	}
+	if warnings := workflowImportWarnings(nsResp, saResp, targetNsResp, targetSaResp, mutableState); len(warnings) != 0 {
+		fmt.Println("Warnings:")
+		for _, warning := range warnings {
+			fmt.Printf("- %s\n", warning)
+		}
+	}
+
+	for _, request := range requests {
+		if _, err := adminClient.ImportWorkflowExecution(ctx, request); err != nil {
+			return fmt.Errorf("unable to import workflow execution: %s", err)
+		}
+	}
+	fmt.Printf("Imported %v history batches of workflow %s, run %s\n", len(histories), execution.WorkflowId, execution.RunId)
+	return nil
+}
+
+func encodeWorkflowBundle(
+	nsResp *workflowservice.DescribeNamespaceResponse,
+	saResp *adminservice.GetSearchAttributesResponse,
+	mutableState *persistencespb.WorkflowMutableState,
+	histories []*historypb.History,
+) ([]byte, error) {
+	encoder := codec.NewJSONPBEncoder()
+	var bundle workflowBundle
+	var err error
+	if bundle.Namespace, err = encoder.Encode(nsResp); err != nil {
+		return nil, fmt.Errorf("unable to encode namespace: %s", err)
+	}
+	if bundle.SearchAttributes, err = encoder.Encode(saResp); err != nil {
+		return nil, fmt.Errorf("unable to encode search attributes: %s", err)
+	}
+	if bundle.MutableState, err = encoder.Encode(mutableState); err != nil {
+		return nil, fmt.Errorf("unable to encode mutable state: %s", err)
+	}
+	if bundle.History, err = encoder.EncodeHistories(histories); err != nil {
+		return nil, fmt.Errorf("unable to encode History data: %s", err)
+	}
+	return json.MarshalIndent(bundle, "", "  ")
+}
+
+func decodeWorkflowBundle(data []byte) (
+	*workflowservice.DescribeNamespaceResponse,
+	*adminservice.GetSearchAttributesResponse,
+	*persistencespb.WorkflowMutableState,
+	[]*historypb.History,
+	error,
+) {
+	var bundle workflowBundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("unable to decode workflow bundle: %s", err)
+	}
+	encoder := codec.NewJSONPBEncoder()
+	nsResp := &workflowservice.DescribeNamespaceResponse{}
+	if err := encoder.Decode(bundle.Namespace, nsResp); err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("unable to decode namespace: %s", err)
+	}
+	var saResp *adminservice.GetSearchAttributesResponse
+	if len(bundle.SearchAttributes) != 0 {
+		saResp = &adminservice.GetSearchAttributesResponse{}
+		if err := encoder.Decode(bundle.SearchAttributes, saResp); err != nil {
+			return nil, nil, nil, nil, fmt.Errorf("unable to decode search attributes: %s", err)
+		}
+	}
+	mutableState := &persistencespb.WorkflowMutableState{}
+	if err := encoder.Decode(bundle.MutableState, mutableState); err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("unable to decode mutable state: %s", err)
+	}
+	histories, err := encoder.DecodeHistories(bundle.History)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("unable to decode History data: %s", err)
+	}
+	return nsResp, saResp, mutableState, histories, nil
+}
+
+// workflowImportWarnings compares the source namespace and search attributes of a workflow bundle
+// with the target ones and describes every difference that changes how the imported workflow behaves.
+// Source search attributes are nil for bundles written before they were exported.
+func workflowImportWarnings(
+	sourceNsResp *workflowservice.DescribeNamespaceResponse,
+	sourceSaResp *adminservice.GetSearchAttributesResponse,
+	targetNsResp *workflowservice.DescribeNamespaceResponse,
+	targetSaResp *adminservice.GetSearchAttributesResponse,
+	mutableState *persistencespb.WorkflowMutableState,
+) []string {
+	var warnings []string
+	sourceRetention := timestamp.DurationValue(sourceNsResp.GetConfig().GetWorkflowExecutionRetentionTtl())
+	targetRetention := timestamp.DurationValue(targetNsResp.GetConfig().GetWorkflowExecutionRetentionTtl())
+	if sourceRetention != targetRetention {
+		warnings = append(warnings, fmt.Sprintf("namespace %s has retention %v, source namespace %s has retention %v",
+			targetNsResp.GetNamespaceInfo().GetName(), targetRetention, sourceNsResp.GetNamespaceInfo().GetName(), sourceRetention))
+	}
+
+	saNames := make([]string, 0, len(mutableState.GetExecutionInfo().GetSearchAttributes()))
+	for saName := range mutableState.GetExecutionInfo().GetSearchAttributes() {
+		saNames = append(saNames, saName)
+	}
+	sort.Strings(saNames)
+	for _, saName := range saNames {
+		targetType, ok := targetSaResp.GetCustomAttributes()[saName]
+		if !ok {
+			targetType, ok = targetSaResp.GetSystemAttributes()[saName]
+		}
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("search attribute %s is not registered", saName))
+			continue
+		}
+		sourceType, ok := sourceSaResp.GetCustomAttributes()[saName]
+		if !ok {
+			sourceType, ok = sourceSaResp.GetSystemAttributes()[saName]
+		}
+		if ok && sourceType != targetType {
+			warnings = append(warnings, fmt.Sprintf("search attribute %s has type %v, source search attribute has type %v",
+				saName, targetType, sourceType))
+		}
+	}
+	return warnings
+}
+
+// newWorkflowImportRequests returns the requests to import the history batches of the current
+// branch, with at most workflowImportPageSize batches per request.
+func newWorkflowImportRequests(
+	nsName string,
+	execution *commonpb.WorkflowExecution,
+	mutableState *persistencespb.WorkflowMutableState,
+	histories []*historypb.History,
+) ([]*adminservice.ImportWorkflowExecutionRequest, error) {
+	versionHistories := mutableState.GetExecutionInfo().GetVersionHistories()
+	if versionHistories == nil {
+		return nil, fmt.Errorf("workflow bundle mutable state has no version histories")
+	}
+	versionHistory, err := versionhistory.GetCurrentVersionHistory(versionHistories)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get current version history: %s", err)
+	}
+	if len(histories) == 0 {
+		return nil, fmt.Errorf("workflow bundle has no history")
+	}
+
+	serializer := serialization.NewSerializer()
+	var requests []*adminservice.ImportWorkflowExecutionRequest
+	for i := 0; i < len(histories); i += workflowImportPageSize {
+		request := &adminservice.ImportWorkflowExecutionRequest{
+			Namespace:      nsName,
+			Execution:      execution,
+			VersionHistory: versionHistory,
+		}
+		for _, history := range histories[i:util.Min(i+workflowImportPageSize, len(histories))] {
+			blob, err := serializer.SerializeEvents(history.Events, enumspb.ENCODING_TYPE_PROTO3)
+			if err != nil {
+				return nil, fmt.Errorf("unable to serialize Events: %s", err)
+			}
+			request.HistoryBatches = append(request.HistoryBatches, blob)
+		}
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
@@ -0,0 +1,202 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	namespacepb "go.temporal.io/api/namespace/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+func (s *utilSuite) TestWorkflowBundle_EncodeDecode() {
+	nsResp := &workflowservice.DescribeNamespaceResponse{
+		NamespaceInfo: &namespacepb.NamespaceInfo{Name: "test-namespace", Id: "test-namespace-id"},
+	}
+	mutableState := &persistencespb.WorkflowMutableState{
+		ExecutionInfo:  &persistencespb.WorkflowExecutionInfo{WorkflowId: "test-workflow-id"},
+		ExecutionState: &persistencespb.WorkflowExecutionState{RunId: "test-run-id"},
+	}
+	saResp := &adminservice.GetSearchAttributesResponse{
+		CustomAttributes: map[string]enumspb.IndexedValueType{"CustomKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD},
+	}
+	histories := []*historypb.History{{Events: newWorkflowBundleTestEvents()}}
+
+	data, err := encodeWorkflowBundle(nsResp, saResp, mutableState, histories)
+	s.NoError(err)
+
+	decodedNsResp, decodedSaResp, decodedMutableState, decodedHistories, err := decodeWorkflowBundle(data)
+	s.NoError(err)
+	s.Equal(nsResp, decodedNsResp)
+	s.Equal(saResp, decodedSaResp)
+	s.Equal(mutableState, decodedMutableState)
+	s.Equal(histories, decodedHistories)
+
+	_, _, _, _, err = decodeWorkflowBundle([]byte("not a bundle"))
+	s.Error(err)
+}
+
+func (s *utilSuite) TestWorkflowImportWarnings() {
+	sourceNsResp := &workflowservice.DescribeNamespaceResponse{
+		NamespaceInfo: &namespacepb.NamespaceInfo{Name: "source-namespace"},
+		Config:        &namespacepb.NamespaceConfig{WorkflowExecutionRetentionTtl: timestamp.DurationFromDays(7)},
+	}
+	sourceSaResp := &adminservice.GetSearchAttributesResponse{
+		CustomAttributes: map[string]enumspb.IndexedValueType{
+			"CustomKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			"CustomIntField":     enumspb.INDEXED_VALUE_TYPE_INT,
+			"CustomTextField":    enumspb.INDEXED_VALUE_TYPE_TEXT,
+		},
+		SystemAttributes: map[string]enumspb.IndexedValueType{"BinaryChecksums": enumspb.INDEXED_VALUE_TYPE_KEYWORD},
+	}
+	targetNsResp := &workflowservice.DescribeNamespaceResponse{
+		NamespaceInfo: &namespacepb.NamespaceInfo{Name: "target-namespace"},
+		Config:        &namespacepb.NamespaceConfig{WorkflowExecutionRetentionTtl: timestamp.DurationFromDays(7)},
+	}
+	targetSaResp := &adminservice.GetSearchAttributesResponse{
+		CustomAttributes: map[string]enumspb.IndexedValueType{
+			"CustomKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			"CustomIntField":     enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+		},
+		SystemAttributes: map[string]enumspb.IndexedValueType{"BinaryChecksums": enumspb.INDEXED_VALUE_TYPE_KEYWORD},
+	}
+	mutableState := &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			SearchAttributes: map[string]*commonpb.Payload{
+				"CustomKeywordField": payload.EncodeString("keyword"),
+				"BinaryChecksums":    payload.EncodeString("checksum"),
+			},
+		},
+	}
+	s.Empty(workflowImportWarnings(sourceNsResp, sourceSaResp, targetNsResp, targetSaResp, mutableState))
+
+	targetNsResp.Config.WorkflowExecutionRetentionTtl = timestamp.DurationFromDays(1)
+	mutableState.ExecutionInfo.SearchAttributes["CustomIntField"] = payload.EncodeString("1")
+	mutableState.ExecutionInfo.SearchAttributes["CustomTextField"] = payload.EncodeString("text")
+	s.Equal([]string{
+		"namespace target-namespace has retention 24h0m0s, source namespace source-namespace has retention 168h0m0s",
+		"search attribute CustomIntField has type Double, source search attribute has type Int",
+		"search attribute CustomTextField is not registered",
+	}, workflowImportWarnings(sourceNsResp, sourceSaResp, targetNsResp, targetSaResp, mutableState))
+
+	// Bundles written before search attributes were exported only check that the attributes exist.
+	s.Equal([]string{
+		"namespace target-namespace has retention 24h0m0s, source namespace source-namespace has retention 168h0m0s",
+		"search attribute CustomTextField is not registered",
+	}, workflowImportWarnings(sourceNsResp, nil, targetNsResp, targetSaResp, mutableState))
+}
+
+func (s *utilSuite) TestNewWorkflowImportRequests() {
+	execution := &commonpb.WorkflowExecution{WorkflowId: "new-workflow-id", RunId: "test-run-id"}
+	versionHistory := &historyspb.VersionHistory{
+		BranchToken: []byte("branch-token"),
+		Items:       []*historyspb.VersionHistoryItem{{EventId: 4, Version: common.EmptyVersion}},
+	}
+	mutableState := &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			VersionHistories: versionhistory.NewVersionHistories(versionHistory),
+		},
+	}
+	events := newWorkflowBundleTestEvents()
+	var histories []*historypb.History
+	for i := 0; i < workflowImportPageSize+1; i++ {
+		histories = append(histories, &historypb.History{Events: events[i%len(events) : i%len(events)+1]})
+	}
+
+	requests, err := newWorkflowImportRequests("target-namespace", execution, mutableState, histories)
+	s.NoError(err)
+	s.Len(requests, 2)
+	s.Len(requests[0].HistoryBatches, workflowImportPageSize)
+	s.Len(requests[1].HistoryBatches, 1)
+	serializer := serialization.NewSerializer()
+	for _, request := range requests {
+		s.Equal("target-namespace", request.Namespace)
+		s.Equal(execution, request.Execution)
+		s.Equal(versionHistory, request.VersionHistory)
+	}
+	batch, err := serializer.DeserializeEvents(requests[0].HistoryBatches[0])
+	s.NoError(err)
+	s.Equal(events[:1], batch)
+
+	_, err = newWorkflowImportRequests("target-namespace", execution, mutableState, nil)
+	s.Error(err)
+	_, err = newWorkflowImportRequests("target-namespace", execution, &persistencespb.WorkflowMutableState{}, histories)
+	s.Error(err)
+}
+
+func newWorkflowBundleTestEvents() []*historypb.HistoryEvent {
+	return []*historypb.HistoryEvent{
+		{
+			EventId:   1,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+				WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+					WorkflowType: &commonpb.WorkflowType{Name: "test-workflow-type"},
+					TaskQueue:    &taskqueuepb.TaskQueue{Name: "test-task-queue", Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+					Input:        payloads.EncodeString("start"),
+				},
+			},
+		},
+		{
+			EventId:   2,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+				WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+					SignalName: "signal-1",
+					Input:      payloads.EncodeString("signal-1"),
+				},
+			},
+		},
+		{
+			EventId:   3,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED,
+			Attributes: &historypb.HistoryEvent_WorkflowTaskScheduledEventAttributes{
+				WorkflowTaskScheduledEventAttributes: &historypb.WorkflowTaskScheduledEventAttributes{},
+			},
+		},
+		{
+			EventId:   4,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+				WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+					SignalName: "signal-2",
+					Input:      payloads.EncodeString("signal-2"),
+				},
+			},
+		},
+	}
+}